@@ -16,6 +16,7 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/pkg/cache"
 	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
 	"github.com/cloudreve/Cloudreve/v4/pkg/crontab"
+	"github.com/cloudreve/Cloudreve/v4/pkg/dlna"
 	"github.com/cloudreve/Cloudreve/v4/pkg/email"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver/onedrive"
 	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
@@ -48,6 +49,8 @@ type server struct {
 	config      conf.ConfigProvider
 	server      *http.Server
 	pprofServer *http.Server
+	dlnaServer  *http.Server
+	advertiser  *dlna.Advertiser
 	kv          cache.Driver
 	mailQueue   email.Driver
 }
@@ -103,11 +106,15 @@ func (s *server) Start() error {
 		// Initialize email queue before user traffic starts.
 		_ = s.dep.EmailClient(context.Background())
 
+		// Initialize media meta extractors, offline geocoding dataset is indexed here.
+		_ = s.dep.MediaMetaExtractor(context.Background())
+
 		// Start all queues
 		s.dep.MediaMetaQueue(context.Background()).Start()
 		s.dep.EntityRecycleQueue(context.Background()).Start()
 		s.dep.IoIntenseQueue(context.Background()).Start()
 		s.dep.RemoteDownloadQueue(context.Background()).Start()
+		s.dep.TranscodeQueue(context.Background()).Start()
 
 		// Start cron jobs
 		c, err := crontab.NewCron(context.Background(), s.dep)
@@ -120,6 +127,11 @@ func (s *server) Start() error {
 		if _, err := s.dep.NodePool(context.Background()); err != nil {
 			return err
 		}
+
+		// Start LAN media server if configured
+		if s.config.DLNA().Enabled {
+			s.startDLNA()
+		}
 	} else {
 		s.dep.SlaveQueue(context.Background()).Start()
 	}
@@ -204,6 +216,16 @@ func (s *server) Close() {
 		}
 	}
 
+	// Shutdown DLNA media server
+	if s.advertiser != nil {
+		s.advertiser.Close()
+	}
+	if s.dlnaServer != nil {
+		if err := s.dlnaServer.Shutdown(ctx); err != nil {
+			s.logger.Error("Failed to shutdown DLNA server: %s", err)
+		}
+	}
+
 	// Shutdown pprof server
 	if s.pprofServer != nil {
 		if err := s.pprofServer.Shutdown(ctx); err != nil {
@@ -222,6 +244,44 @@ func (s *server) Close() {
 	}
 }
 
+// startDLNA starts the DLNA media server and its SSDP advertiser. Failures are logged without
+// stopping the main server.
+func (s *server) startDLNA() {
+	config := s.config.DLNA()
+	_, port, err := net.SplitHostPort(config.Listen)
+	if err != nil {
+		s.logger.Error("Invalid DLNA listen address %q: %s", config.Listen, err)
+		return
+	}
+
+	iface, ip, err := dlna.MulticastInterface(config.Interface)
+	if err != nil {
+		s.logger.Error("Failed to find network interface for DLNA: %s", err)
+		return
+	}
+
+	s.dlnaServer = &http.Server{
+		Addr:    config.Listen,
+		Handler: routers.InitDLNARouter(s.dep),
+	}
+	go func() {
+		s.logger.Info("DLNA server listening on %q", config.Listen)
+		if err := s.dlnaServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.logger.Error("DLNA server error: %s", err)
+		}
+	}()
+
+	location := fmt.Sprintf("http://%s/%s/device.xml", net.JoinHostPort(ip.String(), port), dlna.Prefix)
+	s.advertiser = dlna.NewAdvertiser(iface, dlna.DeviceUUID(config), location, config.MaxAge, s.logger)
+	if err := s.advertiser.Start(); err != nil {
+		s.logger.Error("Failed to start SSDP advertiser: %s", err)
+		s.advertiser = nil
+		return
+	}
+
+	s.logger.Info("DLNA media server advertised on interface %q at %q", iface.Name, location)
+}
+
 func (s *server) runUnix(server *http.Server) error {
 	listener, err := net.Listen("unix", s.config.Unix().Listen)
 	if err != nil {
@@ -30,5 +30,9 @@ const (
 	FileSystemShare        = FileSystemType("share")
 	FileSystemTrash        = FileSystemType("trash")
 	FileSystemSharedWithMe = FileSystemType("shared_with_me")
+	FileSystemSnapshot     = FileSystemType("snapshot")
+	FileSystemSearch       = FileSystemType("search")
+	FileSystemRecent       = FileSystemType("recent")
+	FileSystemPhotos       = FileSystemType("photos")
 	FileSystemUnknown      = FileSystemType("unknown")
 )
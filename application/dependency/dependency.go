@@ -87,6 +87,16 @@ type Dep interface {
 	DavAccountClient() inventory.DavAccountClient
 	// DirectLinkClient Creates a new inventory.DirectLinkClient instance for access DB direct link store.
 	DirectLinkClient() inventory.DirectLinkClient
+	// SnapshotClient Creates a new inventory.SnapshotClient instance for access DB folder snapshot store.
+	SnapshotClient() inventory.SnapshotClient
+	// FileActivityClient Creates a new inventory.FileActivityClient instance for access DB file activity store.
+	FileActivityClient() inventory.FileActivityClient
+	// FeedClient Creates a new inventory.FeedClient instance for access DB feed subscription store.
+	FeedClient() inventory.FeedClient
+	// AlbumClient Creates a new inventory.AlbumClient instance for access DB photo album store.
+	AlbumClient() inventory.AlbumClient
+	// PlaylistClient Creates a new inventory.PlaylistClient instance for access DB music playlist store.
+	PlaylistClient() inventory.PlaylistClient
 	// HashIDEncoder Get a singleton hashid.Encoder instance for encoding/decoding hashids.
 	HashIDEncoder() hashid.Encoder
 	// TokenAuth Get a singleton auth.TokenAuth instance for token authentication.
@@ -123,6 +133,8 @@ type Dep interface {
 	IoIntenseQueue(ctx context.Context) queue.Queue
 	// RemoteDownloadQueue Get a singleton queue.Queue instance for remote download tasks.
 	RemoteDownloadQueue(ctx context.Context) queue.Queue
+	// TranscodeQueue Get a singleton queue.Queue instance for video transcoding tasks.
+	TranscodeQueue(ctx context.Context) queue.Queue
 	// NodePool Get a singleton cluster.NodePool instance for node pool management.
 	NodePool(ctx context.Context) (cluster.NodePool, error)
 	// TaskRegistry Get a singleton queue.TaskRegistry instance for task registration.
@@ -160,6 +172,11 @@ type dependency struct {
 	davAccountClient      inventory.DavAccountClient
 	directLinkClient      inventory.DirectLinkClient
 	fsEventClient         inventory.FsEventClient
+	snapshotClient        inventory.SnapshotClient
+	fileActivityClient    inventory.FileActivityClient
+	feedClient            inventory.FeedClient
+	albumClient           inventory.AlbumClient
+	playlistClient        inventory.PlaylistClient
 	emailClient           email.Driver
 	generalAuth           auth.Auth
 	hashidEncoder         hashid.Encoder
@@ -172,6 +189,7 @@ type dependency struct {
 	entityRecycleQueue    queue.Queue
 	slaveQueue            queue.Queue
 	remoteDownloadQueue   queue.Queue
+	transcodeQueue        queue.Queue
 	ioIntenseQueueTask    queue.Task
 	mediaMeta             mediameta.Extractor
 	thumbPipeline         thumb.Generator
@@ -596,7 +614,7 @@ func (d *dependency) IoIntenseQueue(ctx context.Context) queue.Queue {
 		queue.WithWorkerCount(queueSetting.WorkerNum),
 		queue.WithName("IoIntenseQueue"),
 		queue.WithMaxTaskExecution(queueSetting.MaxExecution),
-		queue.WithResumeTaskType(queue.CreateArchiveTaskType, queue.ExtractArchiveTaskType, queue.RelocateTaskType, queue.ImportTaskType),
+		queue.WithResumeTaskType(queue.CreateArchiveTaskType, queue.ExtractArchiveTaskType, queue.RelocateTaskType, queue.ImportTaskType, queue.SnapshotRestoreTaskType, queue.DuplicateFinderTaskType, queue.MediaRegenerateTaskType, queue.UsageScanTaskType),
 		queue.WithTaskPullInterval(10*time.Second),
 	)
 	return d.ioIntenseQueue
@@ -632,6 +650,36 @@ func (d *dependency) RemoteDownloadQueue(ctx context.Context) queue.Queue {
 	return d.remoteDownloadQueue
 }
 
+func (d *dependency) TranscodeQueue(ctx context.Context) queue.Queue {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	_, reload := ctx.Value(ReloadCtx{}).(bool)
+	if d.transcodeQueue != nil && !reload {
+		return d.transcodeQueue
+	}
+
+	if d.transcodeQueue != nil {
+		d.transcodeQueue.Shutdown()
+	}
+
+	settings := d.SettingProvider()
+	queueSetting := settings.Queue(context.Background(), setting.QueueTypeTranscode)
+
+	d.transcodeQueue = queue.New(d.Logger(), d.TaskClient(), d.TaskRegistry(), d,
+		queue.WithBackoffFactor(queueSetting.BackoffFactor),
+		queue.WithMaxRetry(queueSetting.MaxRetry),
+		queue.WithBackoffMaxDuration(queueSetting.BackoffMaxDuration),
+		queue.WithRetryDelay(queueSetting.RetryDelay),
+		queue.WithWorkerCount(queueSetting.WorkerNum),
+		queue.WithName("TranscodeQueue"),
+		queue.WithMaxTaskExecution(queueSetting.MaxExecution),
+		queue.WithResumeTaskType(queue.TranscodeTaskType),
+		queue.WithTaskPullInterval(10*time.Second),
+	)
+	return d.transcodeQueue
+}
+
 func (d *dependency) EntityRecycleQueue(ctx context.Context) queue.Queue {
 	d.mu.Lock()
 	defer d.mu.Unlock()
@@ -752,6 +800,46 @@ func (d *dependency) DirectLinkClient() inventory.DirectLinkClient {
 	return inventory.NewDirectLinkClient(d.DBClient(), d.ConfigProvider().Database().Type, d.HashIDEncoder())
 }
 
+func (d *dependency) SnapshotClient() inventory.SnapshotClient {
+	if d.snapshotClient != nil {
+		return d.snapshotClient
+	}
+
+	return inventory.NewSnapshotClient(d.DBClient(), d.ConfigProvider().Database().Type)
+}
+
+func (d *dependency) FileActivityClient() inventory.FileActivityClient {
+	if d.fileActivityClient != nil {
+		return d.fileActivityClient
+	}
+
+	return inventory.NewFileActivityClient(d.DBClient(), d.ConfigProvider().Database().Type)
+}
+
+func (d *dependency) FeedClient() inventory.FeedClient {
+	if d.feedClient != nil {
+		return d.feedClient
+	}
+
+	return inventory.NewFeedClient(d.DBClient(), d.ConfigProvider().Database().Type)
+}
+
+func (d *dependency) AlbumClient() inventory.AlbumClient {
+	if d.albumClient != nil {
+		return d.albumClient
+	}
+
+	return inventory.NewAlbumClient(d.DBClient(), d.ConfigProvider().Database().Type)
+}
+
+func (d *dependency) PlaylistClient() inventory.PlaylistClient {
+	if d.playlistClient != nil {
+		return d.playlistClient
+	}
+
+	return inventory.NewPlaylistClient(d.DBClient())
+}
+
 func (d *dependency) HashIDEncoder() hashid.Encoder {
 	if d.hashidEncoder != nil {
 		return d.hashidEncoder
@@ -881,6 +969,14 @@ func (d *dependency) Shutdown(ctx context.Context) error {
 		}()
 	}
 
+	if d.transcodeQueue != nil {
+		wg.Add(1)
+		go func() {
+			d.transcodeQueue.Shutdown()
+			defer wg.Done()
+		}()
+	}
+
 	if d.eventHub != nil {
 		wg.Add(1)
 		go func() {
@@ -0,0 +1,604 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/album"
+	"github.com/cloudreve/Cloudreve/v4/ent/file"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+)
+
+// AlbumUpdate is the builder for updating Album entities.
+type AlbumUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AlbumMutation
+}
+
+// Where appends a list predicates to the AlbumUpdate builder.
+func (au *AlbumUpdate) Where(ps ...predicate.Album) *AlbumUpdate {
+	au.mutation.Where(ps...)
+	return au
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (au *AlbumUpdate) SetUpdatedAt(t time.Time) *AlbumUpdate {
+	au.mutation.SetUpdatedAt(t)
+	return au
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (au *AlbumUpdate) SetDeletedAt(t time.Time) *AlbumUpdate {
+	au.mutation.SetDeletedAt(t)
+	return au
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (au *AlbumUpdate) SetNillableDeletedAt(t *time.Time) *AlbumUpdate {
+	if t != nil {
+		au.SetDeletedAt(*t)
+	}
+	return au
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (au *AlbumUpdate) ClearDeletedAt() *AlbumUpdate {
+	au.mutation.ClearDeletedAt()
+	return au
+}
+
+// SetName sets the "name" field.
+func (au *AlbumUpdate) SetName(s string) *AlbumUpdate {
+	au.mutation.SetName(s)
+	return au
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (au *AlbumUpdate) SetNillableName(s *string) *AlbumUpdate {
+	if s != nil {
+		au.SetName(*s)
+	}
+	return au
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (au *AlbumUpdate) SetOwnerID(i int) *AlbumUpdate {
+	au.mutation.SetOwnerID(i)
+	return au
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (au *AlbumUpdate) SetNillableOwnerID(i *int) *AlbumUpdate {
+	if i != nil {
+		au.SetOwnerID(*i)
+	}
+	return au
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (au *AlbumUpdate) SetOwner(u *User) *AlbumUpdate {
+	return au.SetOwnerID(u.ID)
+}
+
+// AddFileIDs adds the "files" edge to the File entity by IDs.
+func (au *AlbumUpdate) AddFileIDs(ids ...int) *AlbumUpdate {
+	au.mutation.AddFileIDs(ids...)
+	return au
+}
+
+// AddFiles adds the "files" edges to the File entity.
+func (au *AlbumUpdate) AddFiles(f ...*File) *AlbumUpdate {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return au.AddFileIDs(ids...)
+}
+
+// Mutation returns the AlbumMutation object of the builder.
+func (au *AlbumUpdate) Mutation() *AlbumMutation {
+	return au.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (au *AlbumUpdate) ClearOwner() *AlbumUpdate {
+	au.mutation.ClearOwner()
+	return au
+}
+
+// ClearFiles clears all "files" edges to the File entity.
+func (au *AlbumUpdate) ClearFiles() *AlbumUpdate {
+	au.mutation.ClearFiles()
+	return au
+}
+
+// RemoveFileIDs removes the "files" edge to File entities by IDs.
+func (au *AlbumUpdate) RemoveFileIDs(ids ...int) *AlbumUpdate {
+	au.mutation.RemoveFileIDs(ids...)
+	return au
+}
+
+// RemoveFiles removes "files" edges to File entities.
+func (au *AlbumUpdate) RemoveFiles(f ...*File) *AlbumUpdate {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return au.RemoveFileIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (au *AlbumUpdate) Save(ctx context.Context) (int, error) {
+	if err := au.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, au.sqlSave, au.mutation, au.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (au *AlbumUpdate) SaveX(ctx context.Context) int {
+	affected, err := au.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (au *AlbumUpdate) Exec(ctx context.Context) error {
+	_, err := au.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (au *AlbumUpdate) ExecX(ctx context.Context) {
+	if err := au.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (au *AlbumUpdate) defaults() error {
+	if _, ok := au.mutation.UpdatedAt(); !ok {
+		if album.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized album.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := album.UpdateDefaultUpdatedAt()
+		au.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (au *AlbumUpdate) check() error {
+	if _, ok := au.mutation.OwnerID(); au.mutation.OwnerCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Album.owner"`)
+	}
+	return nil
+}
+
+func (au *AlbumUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := au.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(album.Table, album.Columns, sqlgraph.NewFieldSpec(album.FieldID, field.TypeInt))
+	if ps := au.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := au.mutation.UpdatedAt(); ok {
+		_spec.SetField(album.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := au.mutation.DeletedAt(); ok {
+		_spec.SetField(album.FieldDeletedAt, field.TypeTime, value)
+	}
+	if au.mutation.DeletedAtCleared() {
+		_spec.ClearField(album.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := au.mutation.Name(); ok {
+		_spec.SetField(album.FieldName, field.TypeString, value)
+	}
+	if au.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   album.OwnerTable,
+			Columns: []string{album.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   album.OwnerTable,
+			Columns: []string{album.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.FilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   album.FilesTable,
+			Columns: album.FilesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RemovedFilesIDs(); len(nodes) > 0 && !au.mutation.FilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   album.FilesTable,
+			Columns: album.FilesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.FilesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   album.FilesTable,
+			Columns: album.FilesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{album.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	au.mutation.done = true
+	return n, nil
+}
+
+// AlbumUpdateOne is the builder for updating a single Album entity.
+type AlbumUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AlbumMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (auo *AlbumUpdateOne) SetUpdatedAt(t time.Time) *AlbumUpdateOne {
+	auo.mutation.SetUpdatedAt(t)
+	return auo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (auo *AlbumUpdateOne) SetDeletedAt(t time.Time) *AlbumUpdateOne {
+	auo.mutation.SetDeletedAt(t)
+	return auo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (auo *AlbumUpdateOne) SetNillableDeletedAt(t *time.Time) *AlbumUpdateOne {
+	if t != nil {
+		auo.SetDeletedAt(*t)
+	}
+	return auo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (auo *AlbumUpdateOne) ClearDeletedAt() *AlbumUpdateOne {
+	auo.mutation.ClearDeletedAt()
+	return auo
+}
+
+// SetName sets the "name" field.
+func (auo *AlbumUpdateOne) SetName(s string) *AlbumUpdateOne {
+	auo.mutation.SetName(s)
+	return auo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (auo *AlbumUpdateOne) SetNillableName(s *string) *AlbumUpdateOne {
+	if s != nil {
+		auo.SetName(*s)
+	}
+	return auo
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (auo *AlbumUpdateOne) SetOwnerID(i int) *AlbumUpdateOne {
+	auo.mutation.SetOwnerID(i)
+	return auo
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (auo *AlbumUpdateOne) SetNillableOwnerID(i *int) *AlbumUpdateOne {
+	if i != nil {
+		auo.SetOwnerID(*i)
+	}
+	return auo
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (auo *AlbumUpdateOne) SetOwner(u *User) *AlbumUpdateOne {
+	return auo.SetOwnerID(u.ID)
+}
+
+// AddFileIDs adds the "files" edge to the File entity by IDs.
+func (auo *AlbumUpdateOne) AddFileIDs(ids ...int) *AlbumUpdateOne {
+	auo.mutation.AddFileIDs(ids...)
+	return auo
+}
+
+// AddFiles adds the "files" edges to the File entity.
+func (auo *AlbumUpdateOne) AddFiles(f ...*File) *AlbumUpdateOne {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return auo.AddFileIDs(ids...)
+}
+
+// Mutation returns the AlbumMutation object of the builder.
+func (auo *AlbumUpdateOne) Mutation() *AlbumMutation {
+	return auo.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (auo *AlbumUpdateOne) ClearOwner() *AlbumUpdateOne {
+	auo.mutation.ClearOwner()
+	return auo
+}
+
+// ClearFiles clears all "files" edges to the File entity.
+func (auo *AlbumUpdateOne) ClearFiles() *AlbumUpdateOne {
+	auo.mutation.ClearFiles()
+	return auo
+}
+
+// RemoveFileIDs removes the "files" edge to File entities by IDs.
+func (auo *AlbumUpdateOne) RemoveFileIDs(ids ...int) *AlbumUpdateOne {
+	auo.mutation.RemoveFileIDs(ids...)
+	return auo
+}
+
+// RemoveFiles removes "files" edges to File entities.
+func (auo *AlbumUpdateOne) RemoveFiles(f ...*File) *AlbumUpdateOne {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return auo.RemoveFileIDs(ids...)
+}
+
+// Where appends a list predicates to the AlbumUpdate builder.
+func (auo *AlbumUpdateOne) Where(ps ...predicate.Album) *AlbumUpdateOne {
+	auo.mutation.Where(ps...)
+	return auo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (auo *AlbumUpdateOne) Select(field string, fields ...string) *AlbumUpdateOne {
+	auo.fields = append([]string{field}, fields...)
+	return auo
+}
+
+// Save executes the query and returns the updated Album entity.
+func (auo *AlbumUpdateOne) Save(ctx context.Context) (*Album, error) {
+	if err := auo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, auo.sqlSave, auo.mutation, auo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (auo *AlbumUpdateOne) SaveX(ctx context.Context) *Album {
+	node, err := auo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (auo *AlbumUpdateOne) Exec(ctx context.Context) error {
+	_, err := auo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (auo *AlbumUpdateOne) ExecX(ctx context.Context) {
+	if err := auo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (auo *AlbumUpdateOne) defaults() error {
+	if _, ok := auo.mutation.UpdatedAt(); !ok {
+		if album.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized album.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := album.UpdateDefaultUpdatedAt()
+		auo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (auo *AlbumUpdateOne) check() error {
+	if _, ok := auo.mutation.OwnerID(); auo.mutation.OwnerCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Album.owner"`)
+	}
+	return nil
+}
+
+func (auo *AlbumUpdateOne) sqlSave(ctx context.Context) (_node *Album, err error) {
+	if err := auo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(album.Table, album.Columns, sqlgraph.NewFieldSpec(album.FieldID, field.TypeInt))
+	id, ok := auo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Album.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := auo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, album.FieldID)
+		for _, f := range fields {
+			if !album.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != album.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := auo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := auo.mutation.UpdatedAt(); ok {
+		_spec.SetField(album.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := auo.mutation.DeletedAt(); ok {
+		_spec.SetField(album.FieldDeletedAt, field.TypeTime, value)
+	}
+	if auo.mutation.DeletedAtCleared() {
+		_spec.ClearField(album.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := auo.mutation.Name(); ok {
+		_spec.SetField(album.FieldName, field.TypeString, value)
+	}
+	if auo.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   album.OwnerTable,
+			Columns: []string{album.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   album.OwnerTable,
+			Columns: []string{album.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.FilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   album.FilesTable,
+			Columns: album.FilesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedFilesIDs(); len(nodes) > 0 && !auo.mutation.FilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   album.FilesTable,
+			Columns: album.FilesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.FilesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   album.FilesTable,
+			Columns: album.FilesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Album{config: auo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, auo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{album.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	auo.mutation.done = true
+	return _node, nil
+}
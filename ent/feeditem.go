@@ -0,0 +1,239 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent/feeditem"
+	"github.com/cloudreve/Cloudreve/v4/ent/feedsubscription"
+)
+
+// FeedItem is the model entity for the FeedItem schema.
+type FeedItem struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// GUID holds the value of the "guid" field.
+	GUID string `json:"guid,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Link holds the value of the "link" field.
+	Link string `json:"link,omitempty"`
+	// PublishedAt holds the value of the "published_at" field.
+	PublishedAt *time.Time `json:"published_at,omitempty"`
+	// Matched holds the value of the "matched" field.
+	Matched bool `json:"matched,omitempty"`
+	// TaskID holds the value of the "task_id" field.
+	TaskID *int `json:"task_id,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// SeenAt holds the value of the "seen_at" field.
+	SeenAt time.Time `json:"seen_at,omitempty"`
+	// SubscriptionID holds the value of the "subscription_id" field.
+	SubscriptionID int `json:"subscription_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the FeedItemQuery when eager-loading is set.
+	Edges        FeedItemEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// FeedItemEdges holds the relations/edges for other nodes in the graph.
+type FeedItemEdges struct {
+	// Subscription holds the value of the subscription edge.
+	Subscription *FeedSubscription `json:"subscription,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// SubscriptionOrErr returns the Subscription value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FeedItemEdges) SubscriptionOrErr() (*FeedSubscription, error) {
+	if e.loadedTypes[0] {
+		if e.Subscription == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: feedsubscription.Label}
+		}
+		return e.Subscription, nil
+	}
+	return nil, &NotLoadedError{edge: "subscription"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*FeedItem) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case feeditem.FieldMatched:
+			values[i] = new(sql.NullBool)
+		case feeditem.FieldID, feeditem.FieldTaskID, feeditem.FieldSubscriptionID:
+			values[i] = new(sql.NullInt64)
+		case feeditem.FieldGUID, feeditem.FieldTitle, feeditem.FieldLink, feeditem.FieldError:
+			values[i] = new(sql.NullString)
+		case feeditem.FieldPublishedAt, feeditem.FieldSeenAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the FeedItem fields.
+func (fi *FeedItem) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case feeditem.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			fi.ID = int(value.Int64)
+		case feeditem.FieldGUID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field guid", values[i])
+			} else if value.Valid {
+				fi.GUID = value.String
+			}
+		case feeditem.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				fi.Title = value.String
+			}
+		case feeditem.FieldLink:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field link", values[i])
+			} else if value.Valid {
+				fi.Link = value.String
+			}
+		case feeditem.FieldPublishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field published_at", values[i])
+			} else if value.Valid {
+				fi.PublishedAt = new(time.Time)
+				*fi.PublishedAt = value.Time
+			}
+		case feeditem.FieldMatched:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field matched", values[i])
+			} else if value.Valid {
+				fi.Matched = value.Bool
+			}
+		case feeditem.FieldTaskID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field task_id", values[i])
+			} else if value.Valid {
+				fi.TaskID = new(int)
+				*fi.TaskID = int(value.Int64)
+			}
+		case feeditem.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				fi.Error = value.String
+			}
+		case feeditem.FieldSeenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field seen_at", values[i])
+			} else if value.Valid {
+				fi.SeenAt = value.Time
+			}
+		case feeditem.FieldSubscriptionID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field subscription_id", values[i])
+			} else if value.Valid {
+				fi.SubscriptionID = int(value.Int64)
+			}
+		default:
+			fi.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the FeedItem.
+// This includes values selected through modifiers, order, etc.
+func (fi *FeedItem) Value(name string) (ent.Value, error) {
+	return fi.selectValues.Get(name)
+}
+
+// QuerySubscription queries the "subscription" edge of the FeedItem entity.
+func (fi *FeedItem) QuerySubscription() *FeedSubscriptionQuery {
+	return NewFeedItemClient(fi.config).QuerySubscription(fi)
+}
+
+// Update returns a builder for updating this FeedItem.
+// Note that you need to call FeedItem.Unwrap() before calling this method if this FeedItem
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (fi *FeedItem) Update() *FeedItemUpdateOne {
+	return NewFeedItemClient(fi.config).UpdateOne(fi)
+}
+
+// Unwrap unwraps the FeedItem entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (fi *FeedItem) Unwrap() *FeedItem {
+	_tx, ok := fi.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FeedItem is not a transactional entity")
+	}
+	fi.config.driver = _tx.drv
+	return fi
+}
+
+// String implements the fmt.Stringer.
+func (fi *FeedItem) String() string {
+	var builder strings.Builder
+	builder.WriteString("FeedItem(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", fi.ID))
+	builder.WriteString("guid=")
+	builder.WriteString(fi.GUID)
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(fi.Title)
+	builder.WriteString(", ")
+	builder.WriteString("link=")
+	builder.WriteString(fi.Link)
+	builder.WriteString(", ")
+	if v := fi.PublishedAt; v != nil {
+		builder.WriteString("published_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("matched=")
+	builder.WriteString(fmt.Sprintf("%v", fi.Matched))
+	builder.WriteString(", ")
+	if v := fi.TaskID; v != nil {
+		builder.WriteString("task_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(fi.Error)
+	builder.WriteString(", ")
+	builder.WriteString("seen_at=")
+	builder.WriteString(fi.SeenAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("subscription_id=")
+	builder.WriteString(fmt.Sprintf("%v", fi.SubscriptionID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SetSubscription manually set the edge as loaded state.
+func (e *FeedItem) SetSubscription(v *FeedSubscription) {
+	e.Edges.Subscription = v
+	e.Edges.loadedTypes[0] = true
+}
+
+// FeedItems is a parsable slice of FeedItem.
+type FeedItems []*FeedItem
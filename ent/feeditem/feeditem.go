@@ -0,0 +1,144 @@
+// Code generated by ent, DO NOT EDIT.
+
+package feeditem
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the feeditem type in the database.
+	Label = "feed_item"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldGUID holds the string denoting the guid field in the database.
+	FieldGUID = "guid"
+	// FieldTitle holds the string denoting the title field in the database.
+	FieldTitle = "title"
+	// FieldLink holds the string denoting the link field in the database.
+	FieldLink = "link"
+	// FieldPublishedAt holds the string denoting the published_at field in the database.
+	FieldPublishedAt = "published_at"
+	// FieldMatched holds the string denoting the matched field in the database.
+	FieldMatched = "matched"
+	// FieldTaskID holds the string denoting the task_id field in the database.
+	FieldTaskID = "task_id"
+	// FieldError holds the string denoting the error field in the database.
+	FieldError = "error"
+	// FieldSeenAt holds the string denoting the seen_at field in the database.
+	FieldSeenAt = "seen_at"
+	// FieldSubscriptionID holds the string denoting the subscription_id field in the database.
+	FieldSubscriptionID = "subscription_id"
+	// EdgeSubscription holds the string denoting the subscription edge name in mutations.
+	EdgeSubscription = "subscription"
+	// Table holds the table name of the feeditem in the database.
+	Table = "feed_items"
+	// SubscriptionTable is the table that holds the subscription relation/edge.
+	SubscriptionTable = "feed_items"
+	// SubscriptionInverseTable is the table name for the FeedSubscription entity.
+	// It exists in this package in order to avoid circular dependency with the "feedsubscription" package.
+	SubscriptionInverseTable = "feed_subscriptions"
+	// SubscriptionColumn is the table column denoting the subscription relation/edge.
+	SubscriptionColumn = "subscription_id"
+)
+
+// Columns holds all SQL columns for feeditem fields.
+var Columns = []string{
+	FieldID,
+	FieldGUID,
+	FieldTitle,
+	FieldLink,
+	FieldPublishedAt,
+	FieldMatched,
+	FieldTaskID,
+	FieldError,
+	FieldSeenAt,
+	FieldSubscriptionID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultMatched holds the default value on creation for the "matched" field.
+	DefaultMatched bool
+	// DefaultSeenAt holds the default value on creation for the "seen_at" field.
+	DefaultSeenAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the FeedItem queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByGUID orders the results by the guid field.
+func ByGUID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGUID, opts...).ToFunc()
+}
+
+// ByTitle orders the results by the title field.
+func ByTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTitle, opts...).ToFunc()
+}
+
+// ByLink orders the results by the link field.
+func ByLink(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLink, opts...).ToFunc()
+}
+
+// ByPublishedAt orders the results by the published_at field.
+func ByPublishedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPublishedAt, opts...).ToFunc()
+}
+
+// ByMatched orders the results by the matched field.
+func ByMatched(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMatched, opts...).ToFunc()
+}
+
+// ByTaskID orders the results by the task_id field.
+func ByTaskID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTaskID, opts...).ToFunc()
+}
+
+// ByError orders the results by the error field.
+func ByError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldError, opts...).ToFunc()
+}
+
+// BySeenAt orders the results by the seen_at field.
+func BySeenAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeenAt, opts...).ToFunc()
+}
+
+// BySubscriptionID orders the results by the subscription_id field.
+func BySubscriptionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubscriptionID, opts...).ToFunc()
+}
+
+// BySubscriptionField orders the results by subscription field.
+func BySubscriptionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSubscriptionStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newSubscriptionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SubscriptionInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SubscriptionTable, SubscriptionColumn),
+	)
+}
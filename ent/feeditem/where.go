@@ -0,0 +1,579 @@
+// Code generated by ent, DO NOT EDIT.
+
+package feeditem
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldLTE(FieldID, id))
+}
+
+// GUID applies equality check predicate on the "guid" field. It's identical to GUIDEQ.
+func GUID(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldEQ(FieldGUID, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldEQ(FieldTitle, v))
+}
+
+// Link applies equality check predicate on the "link" field. It's identical to LinkEQ.
+func Link(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldEQ(FieldLink, v))
+}
+
+// PublishedAt applies equality check predicate on the "published_at" field. It's identical to PublishedAtEQ.
+func PublishedAt(v time.Time) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldEQ(FieldPublishedAt, v))
+}
+
+// Matched applies equality check predicate on the "matched" field. It's identical to MatchedEQ.
+func Matched(v bool) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldEQ(FieldMatched, v))
+}
+
+// TaskID applies equality check predicate on the "task_id" field. It's identical to TaskIDEQ.
+func TaskID(v int) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldEQ(FieldTaskID, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldEQ(FieldError, v))
+}
+
+// SeenAt applies equality check predicate on the "seen_at" field. It's identical to SeenAtEQ.
+func SeenAt(v time.Time) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldEQ(FieldSeenAt, v))
+}
+
+// SubscriptionID applies equality check predicate on the "subscription_id" field. It's identical to SubscriptionIDEQ.
+func SubscriptionID(v int) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldEQ(FieldSubscriptionID, v))
+}
+
+// GUIDEQ applies the EQ predicate on the "guid" field.
+func GUIDEQ(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldEQ(FieldGUID, v))
+}
+
+// GUIDNEQ applies the NEQ predicate on the "guid" field.
+func GUIDNEQ(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldNEQ(FieldGUID, v))
+}
+
+// GUIDIn applies the In predicate on the "guid" field.
+func GUIDIn(vs ...string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldIn(FieldGUID, vs...))
+}
+
+// GUIDNotIn applies the NotIn predicate on the "guid" field.
+func GUIDNotIn(vs ...string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldNotIn(FieldGUID, vs...))
+}
+
+// GUIDGT applies the GT predicate on the "guid" field.
+func GUIDGT(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldGT(FieldGUID, v))
+}
+
+// GUIDGTE applies the GTE predicate on the "guid" field.
+func GUIDGTE(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldGTE(FieldGUID, v))
+}
+
+// GUIDLT applies the LT predicate on the "guid" field.
+func GUIDLT(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldLT(FieldGUID, v))
+}
+
+// GUIDLTE applies the LTE predicate on the "guid" field.
+func GUIDLTE(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldLTE(FieldGUID, v))
+}
+
+// GUIDContains applies the Contains predicate on the "guid" field.
+func GUIDContains(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldContains(FieldGUID, v))
+}
+
+// GUIDHasPrefix applies the HasPrefix predicate on the "guid" field.
+func GUIDHasPrefix(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldHasPrefix(FieldGUID, v))
+}
+
+// GUIDHasSuffix applies the HasSuffix predicate on the "guid" field.
+func GUIDHasSuffix(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldHasSuffix(FieldGUID, v))
+}
+
+// GUIDEqualFold applies the EqualFold predicate on the "guid" field.
+func GUIDEqualFold(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldEqualFold(FieldGUID, v))
+}
+
+// GUIDContainsFold applies the ContainsFold predicate on the "guid" field.
+func GUIDContainsFold(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldContainsFold(FieldGUID, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// LinkEQ applies the EQ predicate on the "link" field.
+func LinkEQ(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldEQ(FieldLink, v))
+}
+
+// LinkNEQ applies the NEQ predicate on the "link" field.
+func LinkNEQ(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldNEQ(FieldLink, v))
+}
+
+// LinkIn applies the In predicate on the "link" field.
+func LinkIn(vs ...string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldIn(FieldLink, vs...))
+}
+
+// LinkNotIn applies the NotIn predicate on the "link" field.
+func LinkNotIn(vs ...string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldNotIn(FieldLink, vs...))
+}
+
+// LinkGT applies the GT predicate on the "link" field.
+func LinkGT(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldGT(FieldLink, v))
+}
+
+// LinkGTE applies the GTE predicate on the "link" field.
+func LinkGTE(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldGTE(FieldLink, v))
+}
+
+// LinkLT applies the LT predicate on the "link" field.
+func LinkLT(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldLT(FieldLink, v))
+}
+
+// LinkLTE applies the LTE predicate on the "link" field.
+func LinkLTE(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldLTE(FieldLink, v))
+}
+
+// LinkContains applies the Contains predicate on the "link" field.
+func LinkContains(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldContains(FieldLink, v))
+}
+
+// LinkHasPrefix applies the HasPrefix predicate on the "link" field.
+func LinkHasPrefix(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldHasPrefix(FieldLink, v))
+}
+
+// LinkHasSuffix applies the HasSuffix predicate on the "link" field.
+func LinkHasSuffix(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldHasSuffix(FieldLink, v))
+}
+
+// LinkEqualFold applies the EqualFold predicate on the "link" field.
+func LinkEqualFold(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldEqualFold(FieldLink, v))
+}
+
+// LinkContainsFold applies the ContainsFold predicate on the "link" field.
+func LinkContainsFold(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldContainsFold(FieldLink, v))
+}
+
+// PublishedAtEQ applies the EQ predicate on the "published_at" field.
+func PublishedAtEQ(v time.Time) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldEQ(FieldPublishedAt, v))
+}
+
+// PublishedAtNEQ applies the NEQ predicate on the "published_at" field.
+func PublishedAtNEQ(v time.Time) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldNEQ(FieldPublishedAt, v))
+}
+
+// PublishedAtIn applies the In predicate on the "published_at" field.
+func PublishedAtIn(vs ...time.Time) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldIn(FieldPublishedAt, vs...))
+}
+
+// PublishedAtNotIn applies the NotIn predicate on the "published_at" field.
+func PublishedAtNotIn(vs ...time.Time) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldNotIn(FieldPublishedAt, vs...))
+}
+
+// PublishedAtGT applies the GT predicate on the "published_at" field.
+func PublishedAtGT(v time.Time) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldGT(FieldPublishedAt, v))
+}
+
+// PublishedAtGTE applies the GTE predicate on the "published_at" field.
+func PublishedAtGTE(v time.Time) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldGTE(FieldPublishedAt, v))
+}
+
+// PublishedAtLT applies the LT predicate on the "published_at" field.
+func PublishedAtLT(v time.Time) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldLT(FieldPublishedAt, v))
+}
+
+// PublishedAtLTE applies the LTE predicate on the "published_at" field.
+func PublishedAtLTE(v time.Time) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldLTE(FieldPublishedAt, v))
+}
+
+// PublishedAtIsNil applies the IsNil predicate on the "published_at" field.
+func PublishedAtIsNil() predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldIsNull(FieldPublishedAt))
+}
+
+// PublishedAtNotNil applies the NotNil predicate on the "published_at" field.
+func PublishedAtNotNil() predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldNotNull(FieldPublishedAt))
+}
+
+// MatchedEQ applies the EQ predicate on the "matched" field.
+func MatchedEQ(v bool) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldEQ(FieldMatched, v))
+}
+
+// MatchedNEQ applies the NEQ predicate on the "matched" field.
+func MatchedNEQ(v bool) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldNEQ(FieldMatched, v))
+}
+
+// TaskIDEQ applies the EQ predicate on the "task_id" field.
+func TaskIDEQ(v int) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldEQ(FieldTaskID, v))
+}
+
+// TaskIDNEQ applies the NEQ predicate on the "task_id" field.
+func TaskIDNEQ(v int) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldNEQ(FieldTaskID, v))
+}
+
+// TaskIDIn applies the In predicate on the "task_id" field.
+func TaskIDIn(vs ...int) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldIn(FieldTaskID, vs...))
+}
+
+// TaskIDNotIn applies the NotIn predicate on the "task_id" field.
+func TaskIDNotIn(vs ...int) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldNotIn(FieldTaskID, vs...))
+}
+
+// TaskIDGT applies the GT predicate on the "task_id" field.
+func TaskIDGT(v int) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldGT(FieldTaskID, v))
+}
+
+// TaskIDGTE applies the GTE predicate on the "task_id" field.
+func TaskIDGTE(v int) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldGTE(FieldTaskID, v))
+}
+
+// TaskIDLT applies the LT predicate on the "task_id" field.
+func TaskIDLT(v int) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldLT(FieldTaskID, v))
+}
+
+// TaskIDLTE applies the LTE predicate on the "task_id" field.
+func TaskIDLTE(v int) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldLTE(FieldTaskID, v))
+}
+
+// TaskIDIsNil applies the IsNil predicate on the "task_id" field.
+func TaskIDIsNil() predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldIsNull(FieldTaskID))
+}
+
+// TaskIDNotNil applies the NotNil predicate on the "task_id" field.
+func TaskIDNotNil() predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldNotNull(FieldTaskID))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldContainsFold(FieldError, v))
+}
+
+// SeenAtEQ applies the EQ predicate on the "seen_at" field.
+func SeenAtEQ(v time.Time) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldEQ(FieldSeenAt, v))
+}
+
+// SeenAtNEQ applies the NEQ predicate on the "seen_at" field.
+func SeenAtNEQ(v time.Time) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldNEQ(FieldSeenAt, v))
+}
+
+// SeenAtIn applies the In predicate on the "seen_at" field.
+func SeenAtIn(vs ...time.Time) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldIn(FieldSeenAt, vs...))
+}
+
+// SeenAtNotIn applies the NotIn predicate on the "seen_at" field.
+func SeenAtNotIn(vs ...time.Time) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldNotIn(FieldSeenAt, vs...))
+}
+
+// SeenAtGT applies the GT predicate on the "seen_at" field.
+func SeenAtGT(v time.Time) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldGT(FieldSeenAt, v))
+}
+
+// SeenAtGTE applies the GTE predicate on the "seen_at" field.
+func SeenAtGTE(v time.Time) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldGTE(FieldSeenAt, v))
+}
+
+// SeenAtLT applies the LT predicate on the "seen_at" field.
+func SeenAtLT(v time.Time) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldLT(FieldSeenAt, v))
+}
+
+// SeenAtLTE applies the LTE predicate on the "seen_at" field.
+func SeenAtLTE(v time.Time) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldLTE(FieldSeenAt, v))
+}
+
+// SubscriptionIDEQ applies the EQ predicate on the "subscription_id" field.
+func SubscriptionIDEQ(v int) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldEQ(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDNEQ applies the NEQ predicate on the "subscription_id" field.
+func SubscriptionIDNEQ(v int) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldNEQ(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDIn applies the In predicate on the "subscription_id" field.
+func SubscriptionIDIn(vs ...int) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldIn(FieldSubscriptionID, vs...))
+}
+
+// SubscriptionIDNotIn applies the NotIn predicate on the "subscription_id" field.
+func SubscriptionIDNotIn(vs ...int) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldNotIn(FieldSubscriptionID, vs...))
+}
+
+// HasSubscription applies the HasEdge predicate on the "subscription" edge.
+func HasSubscription() predicate.FeedItem {
+	return predicate.FeedItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SubscriptionTable, SubscriptionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSubscriptionWith applies the HasEdge predicate on the "subscription" edge with a given conditions (other predicates).
+func HasSubscriptionWith(preds ...predicate.FeedSubscription) predicate.FeedItem {
+	return predicate.FeedItem(func(s *sql.Selector) {
+		step := newSubscriptionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.FeedItem) predicate.FeedItem {
+	return predicate.FeedItem(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.FeedItem) predicate.FeedItem {
+	return predicate.FeedItem(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.FeedItem) predicate.FeedItem {
+	return predicate.FeedItem(sql.NotPredicates(p))
+}
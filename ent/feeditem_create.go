@@ -0,0 +1,1038 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/feeditem"
+	"github.com/cloudreve/Cloudreve/v4/ent/feedsubscription"
+)
+
+// FeedItemCreate is the builder for creating a FeedItem entity.
+type FeedItemCreate struct {
+	config
+	mutation *FeedItemMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetGUID sets the "guid" field.
+func (fic *FeedItemCreate) SetGUID(s string) *FeedItemCreate {
+	fic.mutation.SetGUID(s)
+	return fic
+}
+
+// SetTitle sets the "title" field.
+func (fic *FeedItemCreate) SetTitle(s string) *FeedItemCreate {
+	fic.mutation.SetTitle(s)
+	return fic
+}
+
+// SetLink sets the "link" field.
+func (fic *FeedItemCreate) SetLink(s string) *FeedItemCreate {
+	fic.mutation.SetLink(s)
+	return fic
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (fic *FeedItemCreate) SetPublishedAt(t time.Time) *FeedItemCreate {
+	fic.mutation.SetPublishedAt(t)
+	return fic
+}
+
+// SetNillablePublishedAt sets the "published_at" field if the given value is not nil.
+func (fic *FeedItemCreate) SetNillablePublishedAt(t *time.Time) *FeedItemCreate {
+	if t != nil {
+		fic.SetPublishedAt(*t)
+	}
+	return fic
+}
+
+// SetMatched sets the "matched" field.
+func (fic *FeedItemCreate) SetMatched(b bool) *FeedItemCreate {
+	fic.mutation.SetMatched(b)
+	return fic
+}
+
+// SetNillableMatched sets the "matched" field if the given value is not nil.
+func (fic *FeedItemCreate) SetNillableMatched(b *bool) *FeedItemCreate {
+	if b != nil {
+		fic.SetMatched(*b)
+	}
+	return fic
+}
+
+// SetTaskID sets the "task_id" field.
+func (fic *FeedItemCreate) SetTaskID(i int) *FeedItemCreate {
+	fic.mutation.SetTaskID(i)
+	return fic
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (fic *FeedItemCreate) SetNillableTaskID(i *int) *FeedItemCreate {
+	if i != nil {
+		fic.SetTaskID(*i)
+	}
+	return fic
+}
+
+// SetError sets the "error" field.
+func (fic *FeedItemCreate) SetError(s string) *FeedItemCreate {
+	fic.mutation.SetError(s)
+	return fic
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (fic *FeedItemCreate) SetNillableError(s *string) *FeedItemCreate {
+	if s != nil {
+		fic.SetError(*s)
+	}
+	return fic
+}
+
+// SetSeenAt sets the "seen_at" field.
+func (fic *FeedItemCreate) SetSeenAt(t time.Time) *FeedItemCreate {
+	fic.mutation.SetSeenAt(t)
+	return fic
+}
+
+// SetNillableSeenAt sets the "seen_at" field if the given value is not nil.
+func (fic *FeedItemCreate) SetNillableSeenAt(t *time.Time) *FeedItemCreate {
+	if t != nil {
+		fic.SetSeenAt(*t)
+	}
+	return fic
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (fic *FeedItemCreate) SetSubscriptionID(i int) *FeedItemCreate {
+	fic.mutation.SetSubscriptionID(i)
+	return fic
+}
+
+// SetSubscription sets the "subscription" edge to the FeedSubscription entity.
+func (fic *FeedItemCreate) SetSubscription(f *FeedSubscription) *FeedItemCreate {
+	return fic.SetSubscriptionID(f.ID)
+}
+
+// Mutation returns the FeedItemMutation object of the builder.
+func (fic *FeedItemCreate) Mutation() *FeedItemMutation {
+	return fic.mutation
+}
+
+// Save creates the FeedItem in the database.
+func (fic *FeedItemCreate) Save(ctx context.Context) (*FeedItem, error) {
+	fic.defaults()
+	return withHooks(ctx, fic.sqlSave, fic.mutation, fic.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (fic *FeedItemCreate) SaveX(ctx context.Context) *FeedItem {
+	v, err := fic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (fic *FeedItemCreate) Exec(ctx context.Context) error {
+	_, err := fic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fic *FeedItemCreate) ExecX(ctx context.Context) {
+	if err := fic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (fic *FeedItemCreate) defaults() {
+	if _, ok := fic.mutation.Matched(); !ok {
+		v := feeditem.DefaultMatched
+		fic.mutation.SetMatched(v)
+	}
+	if _, ok := fic.mutation.SeenAt(); !ok {
+		v := feeditem.DefaultSeenAt()
+		fic.mutation.SetSeenAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fic *FeedItemCreate) check() error {
+	if _, ok := fic.mutation.GUID(); !ok {
+		return &ValidationError{Name: "guid", err: errors.New(`ent: missing required field "FeedItem.guid"`)}
+	}
+	if _, ok := fic.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "FeedItem.title"`)}
+	}
+	if _, ok := fic.mutation.Link(); !ok {
+		return &ValidationError{Name: "link", err: errors.New(`ent: missing required field "FeedItem.link"`)}
+	}
+	if _, ok := fic.mutation.Matched(); !ok {
+		return &ValidationError{Name: "matched", err: errors.New(`ent: missing required field "FeedItem.matched"`)}
+	}
+	if _, ok := fic.mutation.SeenAt(); !ok {
+		return &ValidationError{Name: "seen_at", err: errors.New(`ent: missing required field "FeedItem.seen_at"`)}
+	}
+	if _, ok := fic.mutation.SubscriptionID(); !ok {
+		return &ValidationError{Name: "subscription_id", err: errors.New(`ent: missing required field "FeedItem.subscription_id"`)}
+	}
+	if _, ok := fic.mutation.SubscriptionID(); !ok {
+		return &ValidationError{Name: "subscription", err: errors.New(`ent: missing required edge "FeedItem.subscription"`)}
+	}
+	return nil
+}
+
+func (fic *FeedItemCreate) sqlSave(ctx context.Context) (*FeedItem, error) {
+	if err := fic.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := fic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, fic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	fic.mutation.id = &_node.ID
+	fic.mutation.done = true
+	return _node, nil
+}
+
+func (fic *FeedItemCreate) createSpec() (*FeedItem, *sqlgraph.CreateSpec) {
+	var (
+		_node = &FeedItem{config: fic.config}
+		_spec = sqlgraph.NewCreateSpec(feeditem.Table, sqlgraph.NewFieldSpec(feeditem.FieldID, field.TypeInt))
+	)
+
+	if id, ok := fic.mutation.ID(); ok {
+		_node.ID = id
+		id64 := int64(id)
+		_spec.ID.Value = id64
+	}
+
+	_spec.OnConflict = fic.conflict
+	if value, ok := fic.mutation.GUID(); ok {
+		_spec.SetField(feeditem.FieldGUID, field.TypeString, value)
+		_node.GUID = value
+	}
+	if value, ok := fic.mutation.Title(); ok {
+		_spec.SetField(feeditem.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := fic.mutation.Link(); ok {
+		_spec.SetField(feeditem.FieldLink, field.TypeString, value)
+		_node.Link = value
+	}
+	if value, ok := fic.mutation.PublishedAt(); ok {
+		_spec.SetField(feeditem.FieldPublishedAt, field.TypeTime, value)
+		_node.PublishedAt = &value
+	}
+	if value, ok := fic.mutation.Matched(); ok {
+		_spec.SetField(feeditem.FieldMatched, field.TypeBool, value)
+		_node.Matched = value
+	}
+	if value, ok := fic.mutation.TaskID(); ok {
+		_spec.SetField(feeditem.FieldTaskID, field.TypeInt, value)
+		_node.TaskID = &value
+	}
+	if value, ok := fic.mutation.Error(); ok {
+		_spec.SetField(feeditem.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := fic.mutation.SeenAt(); ok {
+		_spec.SetField(feeditem.FieldSeenAt, field.TypeTime, value)
+		_node.SeenAt = value
+	}
+	if nodes := fic.mutation.SubscriptionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   feeditem.SubscriptionTable,
+			Columns: []string{feeditem.SubscriptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(feedsubscription.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SubscriptionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.FeedItem.Create().
+//		SetGUID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.FeedItemUpsert) {
+//			SetGUID(v+v).
+//		}).
+//		Exec(ctx)
+func (fic *FeedItemCreate) OnConflict(opts ...sql.ConflictOption) *FeedItemUpsertOne {
+	fic.conflict = opts
+	return &FeedItemUpsertOne{
+		create: fic,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.FeedItem.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (fic *FeedItemCreate) OnConflictColumns(columns ...string) *FeedItemUpsertOne {
+	fic.conflict = append(fic.conflict, sql.ConflictColumns(columns...))
+	return &FeedItemUpsertOne{
+		create: fic,
+	}
+}
+
+type (
+	// FeedItemUpsertOne is the builder for "upsert"-ing
+	//  one FeedItem node.
+	FeedItemUpsertOne struct {
+		create *FeedItemCreate
+	}
+
+	// FeedItemUpsert is the "OnConflict" setter.
+	FeedItemUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetGUID sets the "guid" field.
+func (u *FeedItemUpsert) SetGUID(v string) *FeedItemUpsert {
+	u.Set(feeditem.FieldGUID, v)
+	return u
+}
+
+// UpdateGUID sets the "guid" field to the value that was provided on create.
+func (u *FeedItemUpsert) UpdateGUID() *FeedItemUpsert {
+	u.SetExcluded(feeditem.FieldGUID)
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *FeedItemUpsert) SetTitle(v string) *FeedItemUpsert {
+	u.Set(feeditem.FieldTitle, v)
+	return u
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *FeedItemUpsert) UpdateTitle() *FeedItemUpsert {
+	u.SetExcluded(feeditem.FieldTitle)
+	return u
+}
+
+// SetLink sets the "link" field.
+func (u *FeedItemUpsert) SetLink(v string) *FeedItemUpsert {
+	u.Set(feeditem.FieldLink, v)
+	return u
+}
+
+// UpdateLink sets the "link" field to the value that was provided on create.
+func (u *FeedItemUpsert) UpdateLink() *FeedItemUpsert {
+	u.SetExcluded(feeditem.FieldLink)
+	return u
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (u *FeedItemUpsert) SetPublishedAt(v time.Time) *FeedItemUpsert {
+	u.Set(feeditem.FieldPublishedAt, v)
+	return u
+}
+
+// UpdatePublishedAt sets the "published_at" field to the value that was provided on create.
+func (u *FeedItemUpsert) UpdatePublishedAt() *FeedItemUpsert {
+	u.SetExcluded(feeditem.FieldPublishedAt)
+	return u
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (u *FeedItemUpsert) ClearPublishedAt() *FeedItemUpsert {
+	u.SetNull(feeditem.FieldPublishedAt)
+	return u
+}
+
+// SetMatched sets the "matched" field.
+func (u *FeedItemUpsert) SetMatched(v bool) *FeedItemUpsert {
+	u.Set(feeditem.FieldMatched, v)
+	return u
+}
+
+// UpdateMatched sets the "matched" field to the value that was provided on create.
+func (u *FeedItemUpsert) UpdateMatched() *FeedItemUpsert {
+	u.SetExcluded(feeditem.FieldMatched)
+	return u
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *FeedItemUpsert) SetTaskID(v int) *FeedItemUpsert {
+	u.Set(feeditem.FieldTaskID, v)
+	return u
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *FeedItemUpsert) UpdateTaskID() *FeedItemUpsert {
+	u.SetExcluded(feeditem.FieldTaskID)
+	return u
+}
+
+// AddTaskID adds v to the "task_id" field.
+func (u *FeedItemUpsert) AddTaskID(v int) *FeedItemUpsert {
+	u.Add(feeditem.FieldTaskID, v)
+	return u
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (u *FeedItemUpsert) ClearTaskID() *FeedItemUpsert {
+	u.SetNull(feeditem.FieldTaskID)
+	return u
+}
+
+// SetError sets the "error" field.
+func (u *FeedItemUpsert) SetError(v string) *FeedItemUpsert {
+	u.Set(feeditem.FieldError, v)
+	return u
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *FeedItemUpsert) UpdateError() *FeedItemUpsert {
+	u.SetExcluded(feeditem.FieldError)
+	return u
+}
+
+// ClearError clears the value of the "error" field.
+func (u *FeedItemUpsert) ClearError() *FeedItemUpsert {
+	u.SetNull(feeditem.FieldError)
+	return u
+}
+
+// SetSeenAt sets the "seen_at" field.
+func (u *FeedItemUpsert) SetSeenAt(v time.Time) *FeedItemUpsert {
+	u.Set(feeditem.FieldSeenAt, v)
+	return u
+}
+
+// UpdateSeenAt sets the "seen_at" field to the value that was provided on create.
+func (u *FeedItemUpsert) UpdateSeenAt() *FeedItemUpsert {
+	u.SetExcluded(feeditem.FieldSeenAt)
+	return u
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (u *FeedItemUpsert) SetSubscriptionID(v int) *FeedItemUpsert {
+	u.Set(feeditem.FieldSubscriptionID, v)
+	return u
+}
+
+// UpdateSubscriptionID sets the "subscription_id" field to the value that was provided on create.
+func (u *FeedItemUpsert) UpdateSubscriptionID() *FeedItemUpsert {
+	u.SetExcluded(feeditem.FieldSubscriptionID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.FeedItem.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *FeedItemUpsertOne) UpdateNewValues() *FeedItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.FeedItem.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *FeedItemUpsertOne) Ignore() *FeedItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *FeedItemUpsertOne) DoNothing() *FeedItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the FeedItemCreate.OnConflict
+// documentation for more info.
+func (u *FeedItemUpsertOne) Update(set func(*FeedItemUpsert)) *FeedItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&FeedItemUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetGUID sets the "guid" field.
+func (u *FeedItemUpsertOne) SetGUID(v string) *FeedItemUpsertOne {
+	return u.Update(func(s *FeedItemUpsert) {
+		s.SetGUID(v)
+	})
+}
+
+// UpdateGUID sets the "guid" field to the value that was provided on create.
+func (u *FeedItemUpsertOne) UpdateGUID() *FeedItemUpsertOne {
+	return u.Update(func(s *FeedItemUpsert) {
+		s.UpdateGUID()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *FeedItemUpsertOne) SetTitle(v string) *FeedItemUpsertOne {
+	return u.Update(func(s *FeedItemUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *FeedItemUpsertOne) UpdateTitle() *FeedItemUpsertOne {
+	return u.Update(func(s *FeedItemUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetLink sets the "link" field.
+func (u *FeedItemUpsertOne) SetLink(v string) *FeedItemUpsertOne {
+	return u.Update(func(s *FeedItemUpsert) {
+		s.SetLink(v)
+	})
+}
+
+// UpdateLink sets the "link" field to the value that was provided on create.
+func (u *FeedItemUpsertOne) UpdateLink() *FeedItemUpsertOne {
+	return u.Update(func(s *FeedItemUpsert) {
+		s.UpdateLink()
+	})
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (u *FeedItemUpsertOne) SetPublishedAt(v time.Time) *FeedItemUpsertOne {
+	return u.Update(func(s *FeedItemUpsert) {
+		s.SetPublishedAt(v)
+	})
+}
+
+// UpdatePublishedAt sets the "published_at" field to the value that was provided on create.
+func (u *FeedItemUpsertOne) UpdatePublishedAt() *FeedItemUpsertOne {
+	return u.Update(func(s *FeedItemUpsert) {
+		s.UpdatePublishedAt()
+	})
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (u *FeedItemUpsertOne) ClearPublishedAt() *FeedItemUpsertOne {
+	return u.Update(func(s *FeedItemUpsert) {
+		s.ClearPublishedAt()
+	})
+}
+
+// SetMatched sets the "matched" field.
+func (u *FeedItemUpsertOne) SetMatched(v bool) *FeedItemUpsertOne {
+	return u.Update(func(s *FeedItemUpsert) {
+		s.SetMatched(v)
+	})
+}
+
+// UpdateMatched sets the "matched" field to the value that was provided on create.
+func (u *FeedItemUpsertOne) UpdateMatched() *FeedItemUpsertOne {
+	return u.Update(func(s *FeedItemUpsert) {
+		s.UpdateMatched()
+	})
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *FeedItemUpsertOne) SetTaskID(v int) *FeedItemUpsertOne {
+	return u.Update(func(s *FeedItemUpsert) {
+		s.SetTaskID(v)
+	})
+}
+
+// AddTaskID adds v to the "task_id" field.
+func (u *FeedItemUpsertOne) AddTaskID(v int) *FeedItemUpsertOne {
+	return u.Update(func(s *FeedItemUpsert) {
+		s.AddTaskID(v)
+	})
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *FeedItemUpsertOne) UpdateTaskID() *FeedItemUpsertOne {
+	return u.Update(func(s *FeedItemUpsert) {
+		s.UpdateTaskID()
+	})
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (u *FeedItemUpsertOne) ClearTaskID() *FeedItemUpsertOne {
+	return u.Update(func(s *FeedItemUpsert) {
+		s.ClearTaskID()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *FeedItemUpsertOne) SetError(v string) *FeedItemUpsertOne {
+	return u.Update(func(s *FeedItemUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *FeedItemUpsertOne) UpdateError() *FeedItemUpsertOne {
+	return u.Update(func(s *FeedItemUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *FeedItemUpsertOne) ClearError() *FeedItemUpsertOne {
+	return u.Update(func(s *FeedItemUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetSeenAt sets the "seen_at" field.
+func (u *FeedItemUpsertOne) SetSeenAt(v time.Time) *FeedItemUpsertOne {
+	return u.Update(func(s *FeedItemUpsert) {
+		s.SetSeenAt(v)
+	})
+}
+
+// UpdateSeenAt sets the "seen_at" field to the value that was provided on create.
+func (u *FeedItemUpsertOne) UpdateSeenAt() *FeedItemUpsertOne {
+	return u.Update(func(s *FeedItemUpsert) {
+		s.UpdateSeenAt()
+	})
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (u *FeedItemUpsertOne) SetSubscriptionID(v int) *FeedItemUpsertOne {
+	return u.Update(func(s *FeedItemUpsert) {
+		s.SetSubscriptionID(v)
+	})
+}
+
+// UpdateSubscriptionID sets the "subscription_id" field to the value that was provided on create.
+func (u *FeedItemUpsertOne) UpdateSubscriptionID() *FeedItemUpsertOne {
+	return u.Update(func(s *FeedItemUpsert) {
+		s.UpdateSubscriptionID()
+	})
+}
+
+// Exec executes the query.
+func (u *FeedItemUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for FeedItemCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *FeedItemUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *FeedItemUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *FeedItemUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (m *FeedItemCreate) SetRawID(t int) *FeedItemCreate {
+	m.mutation.SetRawID(t)
+	return m
+}
+
+// FeedItemCreateBulk is the builder for creating many FeedItem entities in bulk.
+type FeedItemCreateBulk struct {
+	config
+	err      error
+	builders []*FeedItemCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the FeedItem entities in the database.
+func (ficb *FeedItemCreateBulk) Save(ctx context.Context) ([]*FeedItem, error) {
+	if ficb.err != nil {
+		return nil, ficb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ficb.builders))
+	nodes := make([]*FeedItem, len(ficb.builders))
+	mutators := make([]Mutator, len(ficb.builders))
+	for i := range ficb.builders {
+		func(i int, root context.Context) {
+			builder := ficb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FeedItemMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ficb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ficb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ficb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ficb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ficb *FeedItemCreateBulk) SaveX(ctx context.Context) []*FeedItem {
+	v, err := ficb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ficb *FeedItemCreateBulk) Exec(ctx context.Context) error {
+	_, err := ficb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ficb *FeedItemCreateBulk) ExecX(ctx context.Context) {
+	if err := ficb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.FeedItem.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.FeedItemUpsert) {
+//			SetGUID(v+v).
+//		}).
+//		Exec(ctx)
+func (ficb *FeedItemCreateBulk) OnConflict(opts ...sql.ConflictOption) *FeedItemUpsertBulk {
+	ficb.conflict = opts
+	return &FeedItemUpsertBulk{
+		create: ficb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.FeedItem.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ficb *FeedItemCreateBulk) OnConflictColumns(columns ...string) *FeedItemUpsertBulk {
+	ficb.conflict = append(ficb.conflict, sql.ConflictColumns(columns...))
+	return &FeedItemUpsertBulk{
+		create: ficb,
+	}
+}
+
+// FeedItemUpsertBulk is the builder for "upsert"-ing
+// a bulk of FeedItem nodes.
+type FeedItemUpsertBulk struct {
+	create *FeedItemCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.FeedItem.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *FeedItemUpsertBulk) UpdateNewValues() *FeedItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.FeedItem.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *FeedItemUpsertBulk) Ignore() *FeedItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *FeedItemUpsertBulk) DoNothing() *FeedItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the FeedItemCreateBulk.OnConflict
+// documentation for more info.
+func (u *FeedItemUpsertBulk) Update(set func(*FeedItemUpsert)) *FeedItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&FeedItemUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetGUID sets the "guid" field.
+func (u *FeedItemUpsertBulk) SetGUID(v string) *FeedItemUpsertBulk {
+	return u.Update(func(s *FeedItemUpsert) {
+		s.SetGUID(v)
+	})
+}
+
+// UpdateGUID sets the "guid" field to the value that was provided on create.
+func (u *FeedItemUpsertBulk) UpdateGUID() *FeedItemUpsertBulk {
+	return u.Update(func(s *FeedItemUpsert) {
+		s.UpdateGUID()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *FeedItemUpsertBulk) SetTitle(v string) *FeedItemUpsertBulk {
+	return u.Update(func(s *FeedItemUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *FeedItemUpsertBulk) UpdateTitle() *FeedItemUpsertBulk {
+	return u.Update(func(s *FeedItemUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetLink sets the "link" field.
+func (u *FeedItemUpsertBulk) SetLink(v string) *FeedItemUpsertBulk {
+	return u.Update(func(s *FeedItemUpsert) {
+		s.SetLink(v)
+	})
+}
+
+// UpdateLink sets the "link" field to the value that was provided on create.
+func (u *FeedItemUpsertBulk) UpdateLink() *FeedItemUpsertBulk {
+	return u.Update(func(s *FeedItemUpsert) {
+		s.UpdateLink()
+	})
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (u *FeedItemUpsertBulk) SetPublishedAt(v time.Time) *FeedItemUpsertBulk {
+	return u.Update(func(s *FeedItemUpsert) {
+		s.SetPublishedAt(v)
+	})
+}
+
+// UpdatePublishedAt sets the "published_at" field to the value that was provided on create.
+func (u *FeedItemUpsertBulk) UpdatePublishedAt() *FeedItemUpsertBulk {
+	return u.Update(func(s *FeedItemUpsert) {
+		s.UpdatePublishedAt()
+	})
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (u *FeedItemUpsertBulk) ClearPublishedAt() *FeedItemUpsertBulk {
+	return u.Update(func(s *FeedItemUpsert) {
+		s.ClearPublishedAt()
+	})
+}
+
+// SetMatched sets the "matched" field.
+func (u *FeedItemUpsertBulk) SetMatched(v bool) *FeedItemUpsertBulk {
+	return u.Update(func(s *FeedItemUpsert) {
+		s.SetMatched(v)
+	})
+}
+
+// UpdateMatched sets the "matched" field to the value that was provided on create.
+func (u *FeedItemUpsertBulk) UpdateMatched() *FeedItemUpsertBulk {
+	return u.Update(func(s *FeedItemUpsert) {
+		s.UpdateMatched()
+	})
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *FeedItemUpsertBulk) SetTaskID(v int) *FeedItemUpsertBulk {
+	return u.Update(func(s *FeedItemUpsert) {
+		s.SetTaskID(v)
+	})
+}
+
+// AddTaskID adds v to the "task_id" field.
+func (u *FeedItemUpsertBulk) AddTaskID(v int) *FeedItemUpsertBulk {
+	return u.Update(func(s *FeedItemUpsert) {
+		s.AddTaskID(v)
+	})
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *FeedItemUpsertBulk) UpdateTaskID() *FeedItemUpsertBulk {
+	return u.Update(func(s *FeedItemUpsert) {
+		s.UpdateTaskID()
+	})
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (u *FeedItemUpsertBulk) ClearTaskID() *FeedItemUpsertBulk {
+	return u.Update(func(s *FeedItemUpsert) {
+		s.ClearTaskID()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *FeedItemUpsertBulk) SetError(v string) *FeedItemUpsertBulk {
+	return u.Update(func(s *FeedItemUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *FeedItemUpsertBulk) UpdateError() *FeedItemUpsertBulk {
+	return u.Update(func(s *FeedItemUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *FeedItemUpsertBulk) ClearError() *FeedItemUpsertBulk {
+	return u.Update(func(s *FeedItemUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetSeenAt sets the "seen_at" field.
+func (u *FeedItemUpsertBulk) SetSeenAt(v time.Time) *FeedItemUpsertBulk {
+	return u.Update(func(s *FeedItemUpsert) {
+		s.SetSeenAt(v)
+	})
+}
+
+// UpdateSeenAt sets the "seen_at" field to the value that was provided on create.
+func (u *FeedItemUpsertBulk) UpdateSeenAt() *FeedItemUpsertBulk {
+	return u.Update(func(s *FeedItemUpsert) {
+		s.UpdateSeenAt()
+	})
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (u *FeedItemUpsertBulk) SetSubscriptionID(v int) *FeedItemUpsertBulk {
+	return u.Update(func(s *FeedItemUpsert) {
+		s.SetSubscriptionID(v)
+	})
+}
+
+// UpdateSubscriptionID sets the "subscription_id" field to the value that was provided on create.
+func (u *FeedItemUpsertBulk) UpdateSubscriptionID() *FeedItemUpsertBulk {
+	return u.Update(func(s *FeedItemUpsert) {
+		s.UpdateSubscriptionID()
+	})
+}
+
+// Exec executes the query.
+func (u *FeedItemUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the FeedItemCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for FeedItemCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *FeedItemUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/feeditem"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+)
+
+// FeedItemDelete is the builder for deleting a FeedItem entity.
+type FeedItemDelete struct {
+	config
+	hooks    []Hook
+	mutation *FeedItemMutation
+}
+
+// Where appends a list predicates to the FeedItemDelete builder.
+func (fid *FeedItemDelete) Where(ps ...predicate.FeedItem) *FeedItemDelete {
+	fid.mutation.Where(ps...)
+	return fid
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (fid *FeedItemDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, fid.sqlExec, fid.mutation, fid.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fid *FeedItemDelete) ExecX(ctx context.Context) int {
+	n, err := fid.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (fid *FeedItemDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(feeditem.Table, sqlgraph.NewFieldSpec(feeditem.FieldID, field.TypeInt))
+	if ps := fid.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, fid.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	fid.mutation.done = true
+	return affected, err
+}
+
+// FeedItemDeleteOne is the builder for deleting a single FeedItem entity.
+type FeedItemDeleteOne struct {
+	fid *FeedItemDelete
+}
+
+// Where appends a list predicates to the FeedItemDelete builder.
+func (fido *FeedItemDeleteOne) Where(ps ...predicate.FeedItem) *FeedItemDeleteOne {
+	fido.fid.mutation.Where(ps...)
+	return fido
+}
+
+// Exec executes the deletion query.
+func (fido *FeedItemDeleteOne) Exec(ctx context.Context) error {
+	n, err := fido.fid.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{feeditem.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fido *FeedItemDeleteOne) ExecX(ctx context.Context) {
+	if err := fido.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
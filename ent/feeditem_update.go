@@ -0,0 +1,653 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/feeditem"
+	"github.com/cloudreve/Cloudreve/v4/ent/feedsubscription"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+)
+
+// FeedItemUpdate is the builder for updating FeedItem entities.
+type FeedItemUpdate struct {
+	config
+	hooks    []Hook
+	mutation *FeedItemMutation
+}
+
+// Where appends a list predicates to the FeedItemUpdate builder.
+func (fiu *FeedItemUpdate) Where(ps ...predicate.FeedItem) *FeedItemUpdate {
+	fiu.mutation.Where(ps...)
+	return fiu
+}
+
+// SetGUID sets the "guid" field.
+func (fiu *FeedItemUpdate) SetGUID(s string) *FeedItemUpdate {
+	fiu.mutation.SetGUID(s)
+	return fiu
+}
+
+// SetNillableGUID sets the "guid" field if the given value is not nil.
+func (fiu *FeedItemUpdate) SetNillableGUID(s *string) *FeedItemUpdate {
+	if s != nil {
+		fiu.SetGUID(*s)
+	}
+	return fiu
+}
+
+// SetTitle sets the "title" field.
+func (fiu *FeedItemUpdate) SetTitle(s string) *FeedItemUpdate {
+	fiu.mutation.SetTitle(s)
+	return fiu
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (fiu *FeedItemUpdate) SetNillableTitle(s *string) *FeedItemUpdate {
+	if s != nil {
+		fiu.SetTitle(*s)
+	}
+	return fiu
+}
+
+// SetLink sets the "link" field.
+func (fiu *FeedItemUpdate) SetLink(s string) *FeedItemUpdate {
+	fiu.mutation.SetLink(s)
+	return fiu
+}
+
+// SetNillableLink sets the "link" field if the given value is not nil.
+func (fiu *FeedItemUpdate) SetNillableLink(s *string) *FeedItemUpdate {
+	if s != nil {
+		fiu.SetLink(*s)
+	}
+	return fiu
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (fiu *FeedItemUpdate) SetPublishedAt(t time.Time) *FeedItemUpdate {
+	fiu.mutation.SetPublishedAt(t)
+	return fiu
+}
+
+// SetNillablePublishedAt sets the "published_at" field if the given value is not nil.
+func (fiu *FeedItemUpdate) SetNillablePublishedAt(t *time.Time) *FeedItemUpdate {
+	if t != nil {
+		fiu.SetPublishedAt(*t)
+	}
+	return fiu
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (fiu *FeedItemUpdate) ClearPublishedAt() *FeedItemUpdate {
+	fiu.mutation.ClearPublishedAt()
+	return fiu
+}
+
+// SetMatched sets the "matched" field.
+func (fiu *FeedItemUpdate) SetMatched(b bool) *FeedItemUpdate {
+	fiu.mutation.SetMatched(b)
+	return fiu
+}
+
+// SetNillableMatched sets the "matched" field if the given value is not nil.
+func (fiu *FeedItemUpdate) SetNillableMatched(b *bool) *FeedItemUpdate {
+	if b != nil {
+		fiu.SetMatched(*b)
+	}
+	return fiu
+}
+
+// SetTaskID sets the "task_id" field.
+func (fiu *FeedItemUpdate) SetTaskID(i int) *FeedItemUpdate {
+	fiu.mutation.ResetTaskID()
+	fiu.mutation.SetTaskID(i)
+	return fiu
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (fiu *FeedItemUpdate) SetNillableTaskID(i *int) *FeedItemUpdate {
+	if i != nil {
+		fiu.SetTaskID(*i)
+	}
+	return fiu
+}
+
+// AddTaskID adds i to the "task_id" field.
+func (fiu *FeedItemUpdate) AddTaskID(i int) *FeedItemUpdate {
+	fiu.mutation.AddTaskID(i)
+	return fiu
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (fiu *FeedItemUpdate) ClearTaskID() *FeedItemUpdate {
+	fiu.mutation.ClearTaskID()
+	return fiu
+}
+
+// SetError sets the "error" field.
+func (fiu *FeedItemUpdate) SetError(s string) *FeedItemUpdate {
+	fiu.mutation.SetError(s)
+	return fiu
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (fiu *FeedItemUpdate) SetNillableError(s *string) *FeedItemUpdate {
+	if s != nil {
+		fiu.SetError(*s)
+	}
+	return fiu
+}
+
+// ClearError clears the value of the "error" field.
+func (fiu *FeedItemUpdate) ClearError() *FeedItemUpdate {
+	fiu.mutation.ClearError()
+	return fiu
+}
+
+// SetSeenAt sets the "seen_at" field.
+func (fiu *FeedItemUpdate) SetSeenAt(t time.Time) *FeedItemUpdate {
+	fiu.mutation.SetSeenAt(t)
+	return fiu
+}
+
+// SetNillableSeenAt sets the "seen_at" field if the given value is not nil.
+func (fiu *FeedItemUpdate) SetNillableSeenAt(t *time.Time) *FeedItemUpdate {
+	if t != nil {
+		fiu.SetSeenAt(*t)
+	}
+	return fiu
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (fiu *FeedItemUpdate) SetSubscriptionID(i int) *FeedItemUpdate {
+	fiu.mutation.SetSubscriptionID(i)
+	return fiu
+}
+
+// SetNillableSubscriptionID sets the "subscription_id" field if the given value is not nil.
+func (fiu *FeedItemUpdate) SetNillableSubscriptionID(i *int) *FeedItemUpdate {
+	if i != nil {
+		fiu.SetSubscriptionID(*i)
+	}
+	return fiu
+}
+
+// SetSubscription sets the "subscription" edge to the FeedSubscription entity.
+func (fiu *FeedItemUpdate) SetSubscription(f *FeedSubscription) *FeedItemUpdate {
+	return fiu.SetSubscriptionID(f.ID)
+}
+
+// Mutation returns the FeedItemMutation object of the builder.
+func (fiu *FeedItemUpdate) Mutation() *FeedItemMutation {
+	return fiu.mutation
+}
+
+// ClearSubscription clears the "subscription" edge to the FeedSubscription entity.
+func (fiu *FeedItemUpdate) ClearSubscription() *FeedItemUpdate {
+	fiu.mutation.ClearSubscription()
+	return fiu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (fiu *FeedItemUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, fiu.sqlSave, fiu.mutation, fiu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fiu *FeedItemUpdate) SaveX(ctx context.Context) int {
+	affected, err := fiu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (fiu *FeedItemUpdate) Exec(ctx context.Context) error {
+	_, err := fiu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fiu *FeedItemUpdate) ExecX(ctx context.Context) {
+	if err := fiu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fiu *FeedItemUpdate) check() error {
+	if _, ok := fiu.mutation.SubscriptionID(); fiu.mutation.SubscriptionCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "FeedItem.subscription"`)
+	}
+	return nil
+}
+
+func (fiu *FeedItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := fiu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(feeditem.Table, feeditem.Columns, sqlgraph.NewFieldSpec(feeditem.FieldID, field.TypeInt))
+	if ps := fiu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := fiu.mutation.GUID(); ok {
+		_spec.SetField(feeditem.FieldGUID, field.TypeString, value)
+	}
+	if value, ok := fiu.mutation.Title(); ok {
+		_spec.SetField(feeditem.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := fiu.mutation.Link(); ok {
+		_spec.SetField(feeditem.FieldLink, field.TypeString, value)
+	}
+	if value, ok := fiu.mutation.PublishedAt(); ok {
+		_spec.SetField(feeditem.FieldPublishedAt, field.TypeTime, value)
+	}
+	if fiu.mutation.PublishedAtCleared() {
+		_spec.ClearField(feeditem.FieldPublishedAt, field.TypeTime)
+	}
+	if value, ok := fiu.mutation.Matched(); ok {
+		_spec.SetField(feeditem.FieldMatched, field.TypeBool, value)
+	}
+	if value, ok := fiu.mutation.TaskID(); ok {
+		_spec.SetField(feeditem.FieldTaskID, field.TypeInt, value)
+	}
+	if value, ok := fiu.mutation.AddedTaskID(); ok {
+		_spec.AddField(feeditem.FieldTaskID, field.TypeInt, value)
+	}
+	if fiu.mutation.TaskIDCleared() {
+		_spec.ClearField(feeditem.FieldTaskID, field.TypeInt)
+	}
+	if value, ok := fiu.mutation.Error(); ok {
+		_spec.SetField(feeditem.FieldError, field.TypeString, value)
+	}
+	if fiu.mutation.ErrorCleared() {
+		_spec.ClearField(feeditem.FieldError, field.TypeString)
+	}
+	if value, ok := fiu.mutation.SeenAt(); ok {
+		_spec.SetField(feeditem.FieldSeenAt, field.TypeTime, value)
+	}
+	if fiu.mutation.SubscriptionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   feeditem.SubscriptionTable,
+			Columns: []string{feeditem.SubscriptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(feedsubscription.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fiu.mutation.SubscriptionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   feeditem.SubscriptionTable,
+			Columns: []string{feeditem.SubscriptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(feedsubscription.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, fiu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{feeditem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	fiu.mutation.done = true
+	return n, nil
+}
+
+// FeedItemUpdateOne is the builder for updating a single FeedItem entity.
+type FeedItemUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *FeedItemMutation
+}
+
+// SetGUID sets the "guid" field.
+func (fiuo *FeedItemUpdateOne) SetGUID(s string) *FeedItemUpdateOne {
+	fiuo.mutation.SetGUID(s)
+	return fiuo
+}
+
+// SetNillableGUID sets the "guid" field if the given value is not nil.
+func (fiuo *FeedItemUpdateOne) SetNillableGUID(s *string) *FeedItemUpdateOne {
+	if s != nil {
+		fiuo.SetGUID(*s)
+	}
+	return fiuo
+}
+
+// SetTitle sets the "title" field.
+func (fiuo *FeedItemUpdateOne) SetTitle(s string) *FeedItemUpdateOne {
+	fiuo.mutation.SetTitle(s)
+	return fiuo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (fiuo *FeedItemUpdateOne) SetNillableTitle(s *string) *FeedItemUpdateOne {
+	if s != nil {
+		fiuo.SetTitle(*s)
+	}
+	return fiuo
+}
+
+// SetLink sets the "link" field.
+func (fiuo *FeedItemUpdateOne) SetLink(s string) *FeedItemUpdateOne {
+	fiuo.mutation.SetLink(s)
+	return fiuo
+}
+
+// SetNillableLink sets the "link" field if the given value is not nil.
+func (fiuo *FeedItemUpdateOne) SetNillableLink(s *string) *FeedItemUpdateOne {
+	if s != nil {
+		fiuo.SetLink(*s)
+	}
+	return fiuo
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (fiuo *FeedItemUpdateOne) SetPublishedAt(t time.Time) *FeedItemUpdateOne {
+	fiuo.mutation.SetPublishedAt(t)
+	return fiuo
+}
+
+// SetNillablePublishedAt sets the "published_at" field if the given value is not nil.
+func (fiuo *FeedItemUpdateOne) SetNillablePublishedAt(t *time.Time) *FeedItemUpdateOne {
+	if t != nil {
+		fiuo.SetPublishedAt(*t)
+	}
+	return fiuo
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (fiuo *FeedItemUpdateOne) ClearPublishedAt() *FeedItemUpdateOne {
+	fiuo.mutation.ClearPublishedAt()
+	return fiuo
+}
+
+// SetMatched sets the "matched" field.
+func (fiuo *FeedItemUpdateOne) SetMatched(b bool) *FeedItemUpdateOne {
+	fiuo.mutation.SetMatched(b)
+	return fiuo
+}
+
+// SetNillableMatched sets the "matched" field if the given value is not nil.
+func (fiuo *FeedItemUpdateOne) SetNillableMatched(b *bool) *FeedItemUpdateOne {
+	if b != nil {
+		fiuo.SetMatched(*b)
+	}
+	return fiuo
+}
+
+// SetTaskID sets the "task_id" field.
+func (fiuo *FeedItemUpdateOne) SetTaskID(i int) *FeedItemUpdateOne {
+	fiuo.mutation.ResetTaskID()
+	fiuo.mutation.SetTaskID(i)
+	return fiuo
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (fiuo *FeedItemUpdateOne) SetNillableTaskID(i *int) *FeedItemUpdateOne {
+	if i != nil {
+		fiuo.SetTaskID(*i)
+	}
+	return fiuo
+}
+
+// AddTaskID adds i to the "task_id" field.
+func (fiuo *FeedItemUpdateOne) AddTaskID(i int) *FeedItemUpdateOne {
+	fiuo.mutation.AddTaskID(i)
+	return fiuo
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (fiuo *FeedItemUpdateOne) ClearTaskID() *FeedItemUpdateOne {
+	fiuo.mutation.ClearTaskID()
+	return fiuo
+}
+
+// SetError sets the "error" field.
+func (fiuo *FeedItemUpdateOne) SetError(s string) *FeedItemUpdateOne {
+	fiuo.mutation.SetError(s)
+	return fiuo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (fiuo *FeedItemUpdateOne) SetNillableError(s *string) *FeedItemUpdateOne {
+	if s != nil {
+		fiuo.SetError(*s)
+	}
+	return fiuo
+}
+
+// ClearError clears the value of the "error" field.
+func (fiuo *FeedItemUpdateOne) ClearError() *FeedItemUpdateOne {
+	fiuo.mutation.ClearError()
+	return fiuo
+}
+
+// SetSeenAt sets the "seen_at" field.
+func (fiuo *FeedItemUpdateOne) SetSeenAt(t time.Time) *FeedItemUpdateOne {
+	fiuo.mutation.SetSeenAt(t)
+	return fiuo
+}
+
+// SetNillableSeenAt sets the "seen_at" field if the given value is not nil.
+func (fiuo *FeedItemUpdateOne) SetNillableSeenAt(t *time.Time) *FeedItemUpdateOne {
+	if t != nil {
+		fiuo.SetSeenAt(*t)
+	}
+	return fiuo
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (fiuo *FeedItemUpdateOne) SetSubscriptionID(i int) *FeedItemUpdateOne {
+	fiuo.mutation.SetSubscriptionID(i)
+	return fiuo
+}
+
+// SetNillableSubscriptionID sets the "subscription_id" field if the given value is not nil.
+func (fiuo *FeedItemUpdateOne) SetNillableSubscriptionID(i *int) *FeedItemUpdateOne {
+	if i != nil {
+		fiuo.SetSubscriptionID(*i)
+	}
+	return fiuo
+}
+
+// SetSubscription sets the "subscription" edge to the FeedSubscription entity.
+func (fiuo *FeedItemUpdateOne) SetSubscription(f *FeedSubscription) *FeedItemUpdateOne {
+	return fiuo.SetSubscriptionID(f.ID)
+}
+
+// Mutation returns the FeedItemMutation object of the builder.
+func (fiuo *FeedItemUpdateOne) Mutation() *FeedItemMutation {
+	return fiuo.mutation
+}
+
+// ClearSubscription clears the "subscription" edge to the FeedSubscription entity.
+func (fiuo *FeedItemUpdateOne) ClearSubscription() *FeedItemUpdateOne {
+	fiuo.mutation.ClearSubscription()
+	return fiuo
+}
+
+// Where appends a list predicates to the FeedItemUpdate builder.
+func (fiuo *FeedItemUpdateOne) Where(ps ...predicate.FeedItem) *FeedItemUpdateOne {
+	fiuo.mutation.Where(ps...)
+	return fiuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (fiuo *FeedItemUpdateOne) Select(field string, fields ...string) *FeedItemUpdateOne {
+	fiuo.fields = append([]string{field}, fields...)
+	return fiuo
+}
+
+// Save executes the query and returns the updated FeedItem entity.
+func (fiuo *FeedItemUpdateOne) Save(ctx context.Context) (*FeedItem, error) {
+	return withHooks(ctx, fiuo.sqlSave, fiuo.mutation, fiuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fiuo *FeedItemUpdateOne) SaveX(ctx context.Context) *FeedItem {
+	node, err := fiuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (fiuo *FeedItemUpdateOne) Exec(ctx context.Context) error {
+	_, err := fiuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fiuo *FeedItemUpdateOne) ExecX(ctx context.Context) {
+	if err := fiuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fiuo *FeedItemUpdateOne) check() error {
+	if _, ok := fiuo.mutation.SubscriptionID(); fiuo.mutation.SubscriptionCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "FeedItem.subscription"`)
+	}
+	return nil
+}
+
+func (fiuo *FeedItemUpdateOne) sqlSave(ctx context.Context) (_node *FeedItem, err error) {
+	if err := fiuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(feeditem.Table, feeditem.Columns, sqlgraph.NewFieldSpec(feeditem.FieldID, field.TypeInt))
+	id, ok := fiuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "FeedItem.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := fiuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, feeditem.FieldID)
+		for _, f := range fields {
+			if !feeditem.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != feeditem.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := fiuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := fiuo.mutation.GUID(); ok {
+		_spec.SetField(feeditem.FieldGUID, field.TypeString, value)
+	}
+	if value, ok := fiuo.mutation.Title(); ok {
+		_spec.SetField(feeditem.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := fiuo.mutation.Link(); ok {
+		_spec.SetField(feeditem.FieldLink, field.TypeString, value)
+	}
+	if value, ok := fiuo.mutation.PublishedAt(); ok {
+		_spec.SetField(feeditem.FieldPublishedAt, field.TypeTime, value)
+	}
+	if fiuo.mutation.PublishedAtCleared() {
+		_spec.ClearField(feeditem.FieldPublishedAt, field.TypeTime)
+	}
+	if value, ok := fiuo.mutation.Matched(); ok {
+		_spec.SetField(feeditem.FieldMatched, field.TypeBool, value)
+	}
+	if value, ok := fiuo.mutation.TaskID(); ok {
+		_spec.SetField(feeditem.FieldTaskID, field.TypeInt, value)
+	}
+	if value, ok := fiuo.mutation.AddedTaskID(); ok {
+		_spec.AddField(feeditem.FieldTaskID, field.TypeInt, value)
+	}
+	if fiuo.mutation.TaskIDCleared() {
+		_spec.ClearField(feeditem.FieldTaskID, field.TypeInt)
+	}
+	if value, ok := fiuo.mutation.Error(); ok {
+		_spec.SetField(feeditem.FieldError, field.TypeString, value)
+	}
+	if fiuo.mutation.ErrorCleared() {
+		_spec.ClearField(feeditem.FieldError, field.TypeString)
+	}
+	if value, ok := fiuo.mutation.SeenAt(); ok {
+		_spec.SetField(feeditem.FieldSeenAt, field.TypeTime, value)
+	}
+	if fiuo.mutation.SubscriptionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   feeditem.SubscriptionTable,
+			Columns: []string{feeditem.SubscriptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(feedsubscription.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fiuo.mutation.SubscriptionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   feeditem.SubscriptionTable,
+			Columns: []string{feeditem.SubscriptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(feedsubscription.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &FeedItem{config: fiuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, fiuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{feeditem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	fiuo.mutation.done = true
+	return _node, nil
+}
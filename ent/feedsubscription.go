@@ -0,0 +1,294 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent/feedsubscription"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+)
+
+// FeedSubscription is the model entity for the FeedSubscription schema.
+type FeedSubscription struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// URL holds the value of the "url" field.
+	URL string `json:"url,omitempty"`
+	// Include holds the value of the "include" field.
+	Include string `json:"include,omitempty"`
+	// Exclude holds the value of the "exclude" field.
+	Exclude string `json:"exclude,omitempty"`
+	// Dst holds the value of the "dst" field.
+	Dst string `json:"dst,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// CheckedAt holds the value of the "checked_at" field.
+	CheckedAt *time.Time `json:"checked_at,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// OwnerID holds the value of the "owner_id" field.
+	OwnerID int `json:"owner_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the FeedSubscriptionQuery when eager-loading is set.
+	Edges        FeedSubscriptionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// FeedSubscriptionEdges holds the relations/edges for other nodes in the graph.
+type FeedSubscriptionEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *User `json:"owner,omitempty"`
+	// Items holds the value of the items edge.
+	Items []*FeedItem `json:"items,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FeedSubscriptionEdges) OwnerOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.Owner == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.Owner, nil
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// ItemsOrErr returns the Items value or an error if the edge
+// was not loaded in eager-loading.
+func (e FeedSubscriptionEdges) ItemsOrErr() ([]*FeedItem, error) {
+	if e.loadedTypes[1] {
+		return e.Items, nil
+	}
+	return nil, &NotLoadedError{edge: "items"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*FeedSubscription) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case feedsubscription.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case feedsubscription.FieldID, feedsubscription.FieldOwnerID:
+			values[i] = new(sql.NullInt64)
+		case feedsubscription.FieldName, feedsubscription.FieldURL, feedsubscription.FieldInclude, feedsubscription.FieldExclude, feedsubscription.FieldDst, feedsubscription.FieldError:
+			values[i] = new(sql.NullString)
+		case feedsubscription.FieldCreatedAt, feedsubscription.FieldUpdatedAt, feedsubscription.FieldDeletedAt, feedsubscription.FieldCheckedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the FeedSubscription fields.
+func (fs *FeedSubscription) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case feedsubscription.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			fs.ID = int(value.Int64)
+		case feedsubscription.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				fs.CreatedAt = value.Time
+			}
+		case feedsubscription.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				fs.UpdatedAt = value.Time
+			}
+		case feedsubscription.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				fs.DeletedAt = new(time.Time)
+				*fs.DeletedAt = value.Time
+			}
+		case feedsubscription.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				fs.Name = value.String
+			}
+		case feedsubscription.FieldURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field url", values[i])
+			} else if value.Valid {
+				fs.URL = value.String
+			}
+		case feedsubscription.FieldInclude:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field include", values[i])
+			} else if value.Valid {
+				fs.Include = value.String
+			}
+		case feedsubscription.FieldExclude:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field exclude", values[i])
+			} else if value.Valid {
+				fs.Exclude = value.String
+			}
+		case feedsubscription.FieldDst:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field dst", values[i])
+			} else if value.Valid {
+				fs.Dst = value.String
+			}
+		case feedsubscription.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				fs.Enabled = value.Bool
+			}
+		case feedsubscription.FieldCheckedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field checked_at", values[i])
+			} else if value.Valid {
+				fs.CheckedAt = new(time.Time)
+				*fs.CheckedAt = value.Time
+			}
+		case feedsubscription.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				fs.Error = value.String
+			}
+		case feedsubscription.FieldOwnerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_id", values[i])
+			} else if value.Valid {
+				fs.OwnerID = int(value.Int64)
+			}
+		default:
+			fs.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the FeedSubscription.
+// This includes values selected through modifiers, order, etc.
+func (fs *FeedSubscription) Value(name string) (ent.Value, error) {
+	return fs.selectValues.Get(name)
+}
+
+// QueryOwner queries the "owner" edge of the FeedSubscription entity.
+func (fs *FeedSubscription) QueryOwner() *UserQuery {
+	return NewFeedSubscriptionClient(fs.config).QueryOwner(fs)
+}
+
+// QueryItems queries the "items" edge of the FeedSubscription entity.
+func (fs *FeedSubscription) QueryItems() *FeedItemQuery {
+	return NewFeedSubscriptionClient(fs.config).QueryItems(fs)
+}
+
+// Update returns a builder for updating this FeedSubscription.
+// Note that you need to call FeedSubscription.Unwrap() before calling this method if this FeedSubscription
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (fs *FeedSubscription) Update() *FeedSubscriptionUpdateOne {
+	return NewFeedSubscriptionClient(fs.config).UpdateOne(fs)
+}
+
+// Unwrap unwraps the FeedSubscription entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (fs *FeedSubscription) Unwrap() *FeedSubscription {
+	_tx, ok := fs.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FeedSubscription is not a transactional entity")
+	}
+	fs.config.driver = _tx.drv
+	return fs
+}
+
+// String implements the fmt.Stringer.
+func (fs *FeedSubscription) String() string {
+	var builder strings.Builder
+	builder.WriteString("FeedSubscription(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", fs.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(fs.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fs.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := fs.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(fs.Name)
+	builder.WriteString(", ")
+	builder.WriteString("url=")
+	builder.WriteString(fs.URL)
+	builder.WriteString(", ")
+	builder.WriteString("include=")
+	builder.WriteString(fs.Include)
+	builder.WriteString(", ")
+	builder.WriteString("exclude=")
+	builder.WriteString(fs.Exclude)
+	builder.WriteString(", ")
+	builder.WriteString("dst=")
+	builder.WriteString(fs.Dst)
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", fs.Enabled))
+	builder.WriteString(", ")
+	if v := fs.CheckedAt; v != nil {
+		builder.WriteString("checked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(fs.Error)
+	builder.WriteString(", ")
+	builder.WriteString("owner_id=")
+	builder.WriteString(fmt.Sprintf("%v", fs.OwnerID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SetOwner manually set the edge as loaded state.
+func (e *FeedSubscription) SetOwner(v *User) {
+	e.Edges.Owner = v
+	e.Edges.loadedTypes[0] = true
+}
+
+// SetItems manually set the edge as loaded state.
+func (e *FeedSubscription) SetItems(v []*FeedItem) {
+	e.Edges.Items = v
+	e.Edges.loadedTypes[1] = true
+}
+
+// FeedSubscriptions is a parsable slice of FeedSubscription.
+type FeedSubscriptions []*FeedSubscription
@@ -0,0 +1,807 @@
+// Code generated by ent, DO NOT EDIT.
+
+package feedsubscription
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldEQ(FieldName, v))
+}
+
+// URL applies equality check predicate on the "url" field. It's identical to URLEQ.
+func URL(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldEQ(FieldURL, v))
+}
+
+// Include applies equality check predicate on the "include" field. It's identical to IncludeEQ.
+func Include(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldEQ(FieldInclude, v))
+}
+
+// Exclude applies equality check predicate on the "exclude" field. It's identical to ExcludeEQ.
+func Exclude(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldEQ(FieldExclude, v))
+}
+
+// Dst applies equality check predicate on the "dst" field. It's identical to DstEQ.
+func Dst(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldEQ(FieldDst, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldEQ(FieldEnabled, v))
+}
+
+// CheckedAt applies equality check predicate on the "checked_at" field. It's identical to CheckedAtEQ.
+func CheckedAt(v time.Time) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldEQ(FieldCheckedAt, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldEQ(FieldError, v))
+}
+
+// OwnerID applies equality check predicate on the "owner_id" field. It's identical to OwnerIDEQ.
+func OwnerID(v int) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldContainsFold(FieldName, v))
+}
+
+// URLEQ applies the EQ predicate on the "url" field.
+func URLEQ(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldEQ(FieldURL, v))
+}
+
+// URLNEQ applies the NEQ predicate on the "url" field.
+func URLNEQ(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldNEQ(FieldURL, v))
+}
+
+// URLIn applies the In predicate on the "url" field.
+func URLIn(vs ...string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldIn(FieldURL, vs...))
+}
+
+// URLNotIn applies the NotIn predicate on the "url" field.
+func URLNotIn(vs ...string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldNotIn(FieldURL, vs...))
+}
+
+// URLGT applies the GT predicate on the "url" field.
+func URLGT(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldGT(FieldURL, v))
+}
+
+// URLGTE applies the GTE predicate on the "url" field.
+func URLGTE(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldGTE(FieldURL, v))
+}
+
+// URLLT applies the LT predicate on the "url" field.
+func URLLT(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldLT(FieldURL, v))
+}
+
+// URLLTE applies the LTE predicate on the "url" field.
+func URLLTE(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldLTE(FieldURL, v))
+}
+
+// URLContains applies the Contains predicate on the "url" field.
+func URLContains(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldContains(FieldURL, v))
+}
+
+// URLHasPrefix applies the HasPrefix predicate on the "url" field.
+func URLHasPrefix(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldHasPrefix(FieldURL, v))
+}
+
+// URLHasSuffix applies the HasSuffix predicate on the "url" field.
+func URLHasSuffix(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldHasSuffix(FieldURL, v))
+}
+
+// URLEqualFold applies the EqualFold predicate on the "url" field.
+func URLEqualFold(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldEqualFold(FieldURL, v))
+}
+
+// URLContainsFold applies the ContainsFold predicate on the "url" field.
+func URLContainsFold(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldContainsFold(FieldURL, v))
+}
+
+// IncludeEQ applies the EQ predicate on the "include" field.
+func IncludeEQ(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldEQ(FieldInclude, v))
+}
+
+// IncludeNEQ applies the NEQ predicate on the "include" field.
+func IncludeNEQ(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldNEQ(FieldInclude, v))
+}
+
+// IncludeIn applies the In predicate on the "include" field.
+func IncludeIn(vs ...string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldIn(FieldInclude, vs...))
+}
+
+// IncludeNotIn applies the NotIn predicate on the "include" field.
+func IncludeNotIn(vs ...string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldNotIn(FieldInclude, vs...))
+}
+
+// IncludeGT applies the GT predicate on the "include" field.
+func IncludeGT(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldGT(FieldInclude, v))
+}
+
+// IncludeGTE applies the GTE predicate on the "include" field.
+func IncludeGTE(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldGTE(FieldInclude, v))
+}
+
+// IncludeLT applies the LT predicate on the "include" field.
+func IncludeLT(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldLT(FieldInclude, v))
+}
+
+// IncludeLTE applies the LTE predicate on the "include" field.
+func IncludeLTE(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldLTE(FieldInclude, v))
+}
+
+// IncludeContains applies the Contains predicate on the "include" field.
+func IncludeContains(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldContains(FieldInclude, v))
+}
+
+// IncludeHasPrefix applies the HasPrefix predicate on the "include" field.
+func IncludeHasPrefix(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldHasPrefix(FieldInclude, v))
+}
+
+// IncludeHasSuffix applies the HasSuffix predicate on the "include" field.
+func IncludeHasSuffix(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldHasSuffix(FieldInclude, v))
+}
+
+// IncludeIsNil applies the IsNil predicate on the "include" field.
+func IncludeIsNil() predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldIsNull(FieldInclude))
+}
+
+// IncludeNotNil applies the NotNil predicate on the "include" field.
+func IncludeNotNil() predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldNotNull(FieldInclude))
+}
+
+// IncludeEqualFold applies the EqualFold predicate on the "include" field.
+func IncludeEqualFold(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldEqualFold(FieldInclude, v))
+}
+
+// IncludeContainsFold applies the ContainsFold predicate on the "include" field.
+func IncludeContainsFold(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldContainsFold(FieldInclude, v))
+}
+
+// ExcludeEQ applies the EQ predicate on the "exclude" field.
+func ExcludeEQ(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldEQ(FieldExclude, v))
+}
+
+// ExcludeNEQ applies the NEQ predicate on the "exclude" field.
+func ExcludeNEQ(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldNEQ(FieldExclude, v))
+}
+
+// ExcludeIn applies the In predicate on the "exclude" field.
+func ExcludeIn(vs ...string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldIn(FieldExclude, vs...))
+}
+
+// ExcludeNotIn applies the NotIn predicate on the "exclude" field.
+func ExcludeNotIn(vs ...string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldNotIn(FieldExclude, vs...))
+}
+
+// ExcludeGT applies the GT predicate on the "exclude" field.
+func ExcludeGT(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldGT(FieldExclude, v))
+}
+
+// ExcludeGTE applies the GTE predicate on the "exclude" field.
+func ExcludeGTE(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldGTE(FieldExclude, v))
+}
+
+// ExcludeLT applies the LT predicate on the "exclude" field.
+func ExcludeLT(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldLT(FieldExclude, v))
+}
+
+// ExcludeLTE applies the LTE predicate on the "exclude" field.
+func ExcludeLTE(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldLTE(FieldExclude, v))
+}
+
+// ExcludeContains applies the Contains predicate on the "exclude" field.
+func ExcludeContains(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldContains(FieldExclude, v))
+}
+
+// ExcludeHasPrefix applies the HasPrefix predicate on the "exclude" field.
+func ExcludeHasPrefix(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldHasPrefix(FieldExclude, v))
+}
+
+// ExcludeHasSuffix applies the HasSuffix predicate on the "exclude" field.
+func ExcludeHasSuffix(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldHasSuffix(FieldExclude, v))
+}
+
+// ExcludeIsNil applies the IsNil predicate on the "exclude" field.
+func ExcludeIsNil() predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldIsNull(FieldExclude))
+}
+
+// ExcludeNotNil applies the NotNil predicate on the "exclude" field.
+func ExcludeNotNil() predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldNotNull(FieldExclude))
+}
+
+// ExcludeEqualFold applies the EqualFold predicate on the "exclude" field.
+func ExcludeEqualFold(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldEqualFold(FieldExclude, v))
+}
+
+// ExcludeContainsFold applies the ContainsFold predicate on the "exclude" field.
+func ExcludeContainsFold(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldContainsFold(FieldExclude, v))
+}
+
+// DstEQ applies the EQ predicate on the "dst" field.
+func DstEQ(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldEQ(FieldDst, v))
+}
+
+// DstNEQ applies the NEQ predicate on the "dst" field.
+func DstNEQ(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldNEQ(FieldDst, v))
+}
+
+// DstIn applies the In predicate on the "dst" field.
+func DstIn(vs ...string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldIn(FieldDst, vs...))
+}
+
+// DstNotIn applies the NotIn predicate on the "dst" field.
+func DstNotIn(vs ...string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldNotIn(FieldDst, vs...))
+}
+
+// DstGT applies the GT predicate on the "dst" field.
+func DstGT(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldGT(FieldDst, v))
+}
+
+// DstGTE applies the GTE predicate on the "dst" field.
+func DstGTE(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldGTE(FieldDst, v))
+}
+
+// DstLT applies the LT predicate on the "dst" field.
+func DstLT(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldLT(FieldDst, v))
+}
+
+// DstLTE applies the LTE predicate on the "dst" field.
+func DstLTE(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldLTE(FieldDst, v))
+}
+
+// DstContains applies the Contains predicate on the "dst" field.
+func DstContains(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldContains(FieldDst, v))
+}
+
+// DstHasPrefix applies the HasPrefix predicate on the "dst" field.
+func DstHasPrefix(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldHasPrefix(FieldDst, v))
+}
+
+// DstHasSuffix applies the HasSuffix predicate on the "dst" field.
+func DstHasSuffix(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldHasSuffix(FieldDst, v))
+}
+
+// DstEqualFold applies the EqualFold predicate on the "dst" field.
+func DstEqualFold(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldEqualFold(FieldDst, v))
+}
+
+// DstContainsFold applies the ContainsFold predicate on the "dst" field.
+func DstContainsFold(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldContainsFold(FieldDst, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// CheckedAtEQ applies the EQ predicate on the "checked_at" field.
+func CheckedAtEQ(v time.Time) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldEQ(FieldCheckedAt, v))
+}
+
+// CheckedAtNEQ applies the NEQ predicate on the "checked_at" field.
+func CheckedAtNEQ(v time.Time) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldNEQ(FieldCheckedAt, v))
+}
+
+// CheckedAtIn applies the In predicate on the "checked_at" field.
+func CheckedAtIn(vs ...time.Time) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldIn(FieldCheckedAt, vs...))
+}
+
+// CheckedAtNotIn applies the NotIn predicate on the "checked_at" field.
+func CheckedAtNotIn(vs ...time.Time) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldNotIn(FieldCheckedAt, vs...))
+}
+
+// CheckedAtGT applies the GT predicate on the "checked_at" field.
+func CheckedAtGT(v time.Time) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldGT(FieldCheckedAt, v))
+}
+
+// CheckedAtGTE applies the GTE predicate on the "checked_at" field.
+func CheckedAtGTE(v time.Time) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldGTE(FieldCheckedAt, v))
+}
+
+// CheckedAtLT applies the LT predicate on the "checked_at" field.
+func CheckedAtLT(v time.Time) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldLT(FieldCheckedAt, v))
+}
+
+// CheckedAtLTE applies the LTE predicate on the "checked_at" field.
+func CheckedAtLTE(v time.Time) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldLTE(FieldCheckedAt, v))
+}
+
+// CheckedAtIsNil applies the IsNil predicate on the "checked_at" field.
+func CheckedAtIsNil() predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldIsNull(FieldCheckedAt))
+}
+
+// CheckedAtNotNil applies the NotNil predicate on the "checked_at" field.
+func CheckedAtNotNil() predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldNotNull(FieldCheckedAt))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldContainsFold(FieldError, v))
+}
+
+// OwnerIDEQ applies the EQ predicate on the "owner_id" field.
+func OwnerIDEQ(v int) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// OwnerIDNEQ applies the NEQ predicate on the "owner_id" field.
+func OwnerIDNEQ(v int) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldNEQ(FieldOwnerID, v))
+}
+
+// OwnerIDIn applies the In predicate on the "owner_id" field.
+func OwnerIDIn(vs ...int) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDNotIn applies the NotIn predicate on the "owner_id" field.
+func OwnerIDNotIn(vs ...int) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.FieldNotIn(FieldOwnerID, vs...))
+}
+
+// HasOwner applies the HasEdge predicate on the "owner" edge.
+func HasOwner() predicate.FeedSubscription {
+	return predicate.FeedSubscription(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OwnerTable, OwnerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOwnerWith applies the HasEdge predicate on the "owner" edge with a given conditions (other predicates).
+func HasOwnerWith(preds ...predicate.User) predicate.FeedSubscription {
+	return predicate.FeedSubscription(func(s *sql.Selector) {
+		step := newOwnerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasItems applies the HasEdge predicate on the "items" edge.
+func HasItems() predicate.FeedSubscription {
+	return predicate.FeedSubscription(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ItemsTable, ItemsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasItemsWith applies the HasEdge predicate on the "items" edge with a given conditions (other predicates).
+func HasItemsWith(preds ...predicate.FeedItem) predicate.FeedSubscription {
+	return predicate.FeedSubscription(func(s *sql.Selector) {
+		step := newItemsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.FeedSubscription) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.FeedSubscription) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.FeedSubscription) predicate.FeedSubscription {
+	return predicate.FeedSubscription(sql.NotPredicates(p))
+}
@@ -0,0 +1,1252 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/feeditem"
+	"github.com/cloudreve/Cloudreve/v4/ent/feedsubscription"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+)
+
+// FeedSubscriptionCreate is the builder for creating a FeedSubscription entity.
+type FeedSubscriptionCreate struct {
+	config
+	mutation *FeedSubscriptionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (fsc *FeedSubscriptionCreate) SetCreatedAt(t time.Time) *FeedSubscriptionCreate {
+	fsc.mutation.SetCreatedAt(t)
+	return fsc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (fsc *FeedSubscriptionCreate) SetNillableCreatedAt(t *time.Time) *FeedSubscriptionCreate {
+	if t != nil {
+		fsc.SetCreatedAt(*t)
+	}
+	return fsc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (fsc *FeedSubscriptionCreate) SetUpdatedAt(t time.Time) *FeedSubscriptionCreate {
+	fsc.mutation.SetUpdatedAt(t)
+	return fsc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (fsc *FeedSubscriptionCreate) SetNillableUpdatedAt(t *time.Time) *FeedSubscriptionCreate {
+	if t != nil {
+		fsc.SetUpdatedAt(*t)
+	}
+	return fsc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (fsc *FeedSubscriptionCreate) SetDeletedAt(t time.Time) *FeedSubscriptionCreate {
+	fsc.mutation.SetDeletedAt(t)
+	return fsc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (fsc *FeedSubscriptionCreate) SetNillableDeletedAt(t *time.Time) *FeedSubscriptionCreate {
+	if t != nil {
+		fsc.SetDeletedAt(*t)
+	}
+	return fsc
+}
+
+// SetName sets the "name" field.
+func (fsc *FeedSubscriptionCreate) SetName(s string) *FeedSubscriptionCreate {
+	fsc.mutation.SetName(s)
+	return fsc
+}
+
+// SetURL sets the "url" field.
+func (fsc *FeedSubscriptionCreate) SetURL(s string) *FeedSubscriptionCreate {
+	fsc.mutation.SetURL(s)
+	return fsc
+}
+
+// SetInclude sets the "include" field.
+func (fsc *FeedSubscriptionCreate) SetInclude(s string) *FeedSubscriptionCreate {
+	fsc.mutation.SetInclude(s)
+	return fsc
+}
+
+// SetNillableInclude sets the "include" field if the given value is not nil.
+func (fsc *FeedSubscriptionCreate) SetNillableInclude(s *string) *FeedSubscriptionCreate {
+	if s != nil {
+		fsc.SetInclude(*s)
+	}
+	return fsc
+}
+
+// SetExclude sets the "exclude" field.
+func (fsc *FeedSubscriptionCreate) SetExclude(s string) *FeedSubscriptionCreate {
+	fsc.mutation.SetExclude(s)
+	return fsc
+}
+
+// SetNillableExclude sets the "exclude" field if the given value is not nil.
+func (fsc *FeedSubscriptionCreate) SetNillableExclude(s *string) *FeedSubscriptionCreate {
+	if s != nil {
+		fsc.SetExclude(*s)
+	}
+	return fsc
+}
+
+// SetDst sets the "dst" field.
+func (fsc *FeedSubscriptionCreate) SetDst(s string) *FeedSubscriptionCreate {
+	fsc.mutation.SetDst(s)
+	return fsc
+}
+
+// SetEnabled sets the "enabled" field.
+func (fsc *FeedSubscriptionCreate) SetEnabled(b bool) *FeedSubscriptionCreate {
+	fsc.mutation.SetEnabled(b)
+	return fsc
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (fsc *FeedSubscriptionCreate) SetNillableEnabled(b *bool) *FeedSubscriptionCreate {
+	if b != nil {
+		fsc.SetEnabled(*b)
+	}
+	return fsc
+}
+
+// SetCheckedAt sets the "checked_at" field.
+func (fsc *FeedSubscriptionCreate) SetCheckedAt(t time.Time) *FeedSubscriptionCreate {
+	fsc.mutation.SetCheckedAt(t)
+	return fsc
+}
+
+// SetNillableCheckedAt sets the "checked_at" field if the given value is not nil.
+func (fsc *FeedSubscriptionCreate) SetNillableCheckedAt(t *time.Time) *FeedSubscriptionCreate {
+	if t != nil {
+		fsc.SetCheckedAt(*t)
+	}
+	return fsc
+}
+
+// SetError sets the "error" field.
+func (fsc *FeedSubscriptionCreate) SetError(s string) *FeedSubscriptionCreate {
+	fsc.mutation.SetError(s)
+	return fsc
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (fsc *FeedSubscriptionCreate) SetNillableError(s *string) *FeedSubscriptionCreate {
+	if s != nil {
+		fsc.SetError(*s)
+	}
+	return fsc
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (fsc *FeedSubscriptionCreate) SetOwnerID(i int) *FeedSubscriptionCreate {
+	fsc.mutation.SetOwnerID(i)
+	return fsc
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (fsc *FeedSubscriptionCreate) SetOwner(u *User) *FeedSubscriptionCreate {
+	return fsc.SetOwnerID(u.ID)
+}
+
+// AddItemIDs adds the "items" edge to the FeedItem entity by IDs.
+func (fsc *FeedSubscriptionCreate) AddItemIDs(ids ...int) *FeedSubscriptionCreate {
+	fsc.mutation.AddItemIDs(ids...)
+	return fsc
+}
+
+// AddItems adds the "items" edges to the FeedItem entity.
+func (fsc *FeedSubscriptionCreate) AddItems(f ...*FeedItem) *FeedSubscriptionCreate {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return fsc.AddItemIDs(ids...)
+}
+
+// Mutation returns the FeedSubscriptionMutation object of the builder.
+func (fsc *FeedSubscriptionCreate) Mutation() *FeedSubscriptionMutation {
+	return fsc.mutation
+}
+
+// Save creates the FeedSubscription in the database.
+func (fsc *FeedSubscriptionCreate) Save(ctx context.Context) (*FeedSubscription, error) {
+	if err := fsc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, fsc.sqlSave, fsc.mutation, fsc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (fsc *FeedSubscriptionCreate) SaveX(ctx context.Context) *FeedSubscription {
+	v, err := fsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (fsc *FeedSubscriptionCreate) Exec(ctx context.Context) error {
+	_, err := fsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fsc *FeedSubscriptionCreate) ExecX(ctx context.Context) {
+	if err := fsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (fsc *FeedSubscriptionCreate) defaults() error {
+	if _, ok := fsc.mutation.CreatedAt(); !ok {
+		if feedsubscription.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized feedsubscription.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := feedsubscription.DefaultCreatedAt()
+		fsc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := fsc.mutation.UpdatedAt(); !ok {
+		if feedsubscription.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized feedsubscription.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := feedsubscription.DefaultUpdatedAt()
+		fsc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := fsc.mutation.Enabled(); !ok {
+		v := feedsubscription.DefaultEnabled
+		fsc.mutation.SetEnabled(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fsc *FeedSubscriptionCreate) check() error {
+	if _, ok := fsc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "FeedSubscription.created_at"`)}
+	}
+	if _, ok := fsc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "FeedSubscription.updated_at"`)}
+	}
+	if _, ok := fsc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "FeedSubscription.name"`)}
+	}
+	if _, ok := fsc.mutation.URL(); !ok {
+		return &ValidationError{Name: "url", err: errors.New(`ent: missing required field "FeedSubscription.url"`)}
+	}
+	if _, ok := fsc.mutation.Dst(); !ok {
+		return &ValidationError{Name: "dst", err: errors.New(`ent: missing required field "FeedSubscription.dst"`)}
+	}
+	if _, ok := fsc.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "FeedSubscription.enabled"`)}
+	}
+	if _, ok := fsc.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner_id", err: errors.New(`ent: missing required field "FeedSubscription.owner_id"`)}
+	}
+	if _, ok := fsc.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required edge "FeedSubscription.owner"`)}
+	}
+	return nil
+}
+
+func (fsc *FeedSubscriptionCreate) sqlSave(ctx context.Context) (*FeedSubscription, error) {
+	if err := fsc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := fsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, fsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	fsc.mutation.id = &_node.ID
+	fsc.mutation.done = true
+	return _node, nil
+}
+
+func (fsc *FeedSubscriptionCreate) createSpec() (*FeedSubscription, *sqlgraph.CreateSpec) {
+	var (
+		_node = &FeedSubscription{config: fsc.config}
+		_spec = sqlgraph.NewCreateSpec(feedsubscription.Table, sqlgraph.NewFieldSpec(feedsubscription.FieldID, field.TypeInt))
+	)
+
+	if id, ok := fsc.mutation.ID(); ok {
+		_node.ID = id
+		id64 := int64(id)
+		_spec.ID.Value = id64
+	}
+
+	_spec.OnConflict = fsc.conflict
+	if value, ok := fsc.mutation.CreatedAt(); ok {
+		_spec.SetField(feedsubscription.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := fsc.mutation.UpdatedAt(); ok {
+		_spec.SetField(feedsubscription.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := fsc.mutation.DeletedAt(); ok {
+		_spec.SetField(feedsubscription.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := fsc.mutation.Name(); ok {
+		_spec.SetField(feedsubscription.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := fsc.mutation.URL(); ok {
+		_spec.SetField(feedsubscription.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := fsc.mutation.Include(); ok {
+		_spec.SetField(feedsubscription.FieldInclude, field.TypeString, value)
+		_node.Include = value
+	}
+	if value, ok := fsc.mutation.Exclude(); ok {
+		_spec.SetField(feedsubscription.FieldExclude, field.TypeString, value)
+		_node.Exclude = value
+	}
+	if value, ok := fsc.mutation.Dst(); ok {
+		_spec.SetField(feedsubscription.FieldDst, field.TypeString, value)
+		_node.Dst = value
+	}
+	if value, ok := fsc.mutation.Enabled(); ok {
+		_spec.SetField(feedsubscription.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := fsc.mutation.CheckedAt(); ok {
+		_spec.SetField(feedsubscription.FieldCheckedAt, field.TypeTime, value)
+		_node.CheckedAt = &value
+	}
+	if value, ok := fsc.mutation.Error(); ok {
+		_spec.SetField(feedsubscription.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if nodes := fsc.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   feedsubscription.OwnerTable,
+			Columns: []string{feedsubscription.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OwnerID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := fsc.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   feedsubscription.ItemsTable,
+			Columns: []string{feedsubscription.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(feeditem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.FeedSubscription.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.FeedSubscriptionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (fsc *FeedSubscriptionCreate) OnConflict(opts ...sql.ConflictOption) *FeedSubscriptionUpsertOne {
+	fsc.conflict = opts
+	return &FeedSubscriptionUpsertOne{
+		create: fsc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.FeedSubscription.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (fsc *FeedSubscriptionCreate) OnConflictColumns(columns ...string) *FeedSubscriptionUpsertOne {
+	fsc.conflict = append(fsc.conflict, sql.ConflictColumns(columns...))
+	return &FeedSubscriptionUpsertOne{
+		create: fsc,
+	}
+}
+
+type (
+	// FeedSubscriptionUpsertOne is the builder for "upsert"-ing
+	//  one FeedSubscription node.
+	FeedSubscriptionUpsertOne struct {
+		create *FeedSubscriptionCreate
+	}
+
+	// FeedSubscriptionUpsert is the "OnConflict" setter.
+	FeedSubscriptionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *FeedSubscriptionUpsert) SetUpdatedAt(v time.Time) *FeedSubscriptionUpsert {
+	u.Set(feedsubscription.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *FeedSubscriptionUpsert) UpdateUpdatedAt() *FeedSubscriptionUpsert {
+	u.SetExcluded(feedsubscription.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *FeedSubscriptionUpsert) SetDeletedAt(v time.Time) *FeedSubscriptionUpsert {
+	u.Set(feedsubscription.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *FeedSubscriptionUpsert) UpdateDeletedAt() *FeedSubscriptionUpsert {
+	u.SetExcluded(feedsubscription.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *FeedSubscriptionUpsert) ClearDeletedAt() *FeedSubscriptionUpsert {
+	u.SetNull(feedsubscription.FieldDeletedAt)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *FeedSubscriptionUpsert) SetName(v string) *FeedSubscriptionUpsert {
+	u.Set(feedsubscription.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *FeedSubscriptionUpsert) UpdateName() *FeedSubscriptionUpsert {
+	u.SetExcluded(feedsubscription.FieldName)
+	return u
+}
+
+// SetURL sets the "url" field.
+func (u *FeedSubscriptionUpsert) SetURL(v string) *FeedSubscriptionUpsert {
+	u.Set(feedsubscription.FieldURL, v)
+	return u
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *FeedSubscriptionUpsert) UpdateURL() *FeedSubscriptionUpsert {
+	u.SetExcluded(feedsubscription.FieldURL)
+	return u
+}
+
+// SetInclude sets the "include" field.
+func (u *FeedSubscriptionUpsert) SetInclude(v string) *FeedSubscriptionUpsert {
+	u.Set(feedsubscription.FieldInclude, v)
+	return u
+}
+
+// UpdateInclude sets the "include" field to the value that was provided on create.
+func (u *FeedSubscriptionUpsert) UpdateInclude() *FeedSubscriptionUpsert {
+	u.SetExcluded(feedsubscription.FieldInclude)
+	return u
+}
+
+// ClearInclude clears the value of the "include" field.
+func (u *FeedSubscriptionUpsert) ClearInclude() *FeedSubscriptionUpsert {
+	u.SetNull(feedsubscription.FieldInclude)
+	return u
+}
+
+// SetExclude sets the "exclude" field.
+func (u *FeedSubscriptionUpsert) SetExclude(v string) *FeedSubscriptionUpsert {
+	u.Set(feedsubscription.FieldExclude, v)
+	return u
+}
+
+// UpdateExclude sets the "exclude" field to the value that was provided on create.
+func (u *FeedSubscriptionUpsert) UpdateExclude() *FeedSubscriptionUpsert {
+	u.SetExcluded(feedsubscription.FieldExclude)
+	return u
+}
+
+// ClearExclude clears the value of the "exclude" field.
+func (u *FeedSubscriptionUpsert) ClearExclude() *FeedSubscriptionUpsert {
+	u.SetNull(feedsubscription.FieldExclude)
+	return u
+}
+
+// SetDst sets the "dst" field.
+func (u *FeedSubscriptionUpsert) SetDst(v string) *FeedSubscriptionUpsert {
+	u.Set(feedsubscription.FieldDst, v)
+	return u
+}
+
+// UpdateDst sets the "dst" field to the value that was provided on create.
+func (u *FeedSubscriptionUpsert) UpdateDst() *FeedSubscriptionUpsert {
+	u.SetExcluded(feedsubscription.FieldDst)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *FeedSubscriptionUpsert) SetEnabled(v bool) *FeedSubscriptionUpsert {
+	u.Set(feedsubscription.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *FeedSubscriptionUpsert) UpdateEnabled() *FeedSubscriptionUpsert {
+	u.SetExcluded(feedsubscription.FieldEnabled)
+	return u
+}
+
+// SetCheckedAt sets the "checked_at" field.
+func (u *FeedSubscriptionUpsert) SetCheckedAt(v time.Time) *FeedSubscriptionUpsert {
+	u.Set(feedsubscription.FieldCheckedAt, v)
+	return u
+}
+
+// UpdateCheckedAt sets the "checked_at" field to the value that was provided on create.
+func (u *FeedSubscriptionUpsert) UpdateCheckedAt() *FeedSubscriptionUpsert {
+	u.SetExcluded(feedsubscription.FieldCheckedAt)
+	return u
+}
+
+// ClearCheckedAt clears the value of the "checked_at" field.
+func (u *FeedSubscriptionUpsert) ClearCheckedAt() *FeedSubscriptionUpsert {
+	u.SetNull(feedsubscription.FieldCheckedAt)
+	return u
+}
+
+// SetError sets the "error" field.
+func (u *FeedSubscriptionUpsert) SetError(v string) *FeedSubscriptionUpsert {
+	u.Set(feedsubscription.FieldError, v)
+	return u
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *FeedSubscriptionUpsert) UpdateError() *FeedSubscriptionUpsert {
+	u.SetExcluded(feedsubscription.FieldError)
+	return u
+}
+
+// ClearError clears the value of the "error" field.
+func (u *FeedSubscriptionUpsert) ClearError() *FeedSubscriptionUpsert {
+	u.SetNull(feedsubscription.FieldError)
+	return u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (u *FeedSubscriptionUpsert) SetOwnerID(v int) *FeedSubscriptionUpsert {
+	u.Set(feedsubscription.FieldOwnerID, v)
+	return u
+}
+
+// UpdateOwnerID sets the "owner_id" field to the value that was provided on create.
+func (u *FeedSubscriptionUpsert) UpdateOwnerID() *FeedSubscriptionUpsert {
+	u.SetExcluded(feedsubscription.FieldOwnerID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.FeedSubscription.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *FeedSubscriptionUpsertOne) UpdateNewValues() *FeedSubscriptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(feedsubscription.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.FeedSubscription.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *FeedSubscriptionUpsertOne) Ignore() *FeedSubscriptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *FeedSubscriptionUpsertOne) DoNothing() *FeedSubscriptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the FeedSubscriptionCreate.OnConflict
+// documentation for more info.
+func (u *FeedSubscriptionUpsertOne) Update(set func(*FeedSubscriptionUpsert)) *FeedSubscriptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&FeedSubscriptionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *FeedSubscriptionUpsertOne) SetUpdatedAt(v time.Time) *FeedSubscriptionUpsertOne {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *FeedSubscriptionUpsertOne) UpdateUpdatedAt() *FeedSubscriptionUpsertOne {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *FeedSubscriptionUpsertOne) SetDeletedAt(v time.Time) *FeedSubscriptionUpsertOne {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *FeedSubscriptionUpsertOne) UpdateDeletedAt() *FeedSubscriptionUpsertOne {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *FeedSubscriptionUpsertOne) ClearDeletedAt() *FeedSubscriptionUpsertOne {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *FeedSubscriptionUpsertOne) SetName(v string) *FeedSubscriptionUpsertOne {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *FeedSubscriptionUpsertOne) UpdateName() *FeedSubscriptionUpsertOne {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetURL sets the "url" field.
+func (u *FeedSubscriptionUpsertOne) SetURL(v string) *FeedSubscriptionUpsertOne {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.SetURL(v)
+	})
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *FeedSubscriptionUpsertOne) UpdateURL() *FeedSubscriptionUpsertOne {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.UpdateURL()
+	})
+}
+
+// SetInclude sets the "include" field.
+func (u *FeedSubscriptionUpsertOne) SetInclude(v string) *FeedSubscriptionUpsertOne {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.SetInclude(v)
+	})
+}
+
+// UpdateInclude sets the "include" field to the value that was provided on create.
+func (u *FeedSubscriptionUpsertOne) UpdateInclude() *FeedSubscriptionUpsertOne {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.UpdateInclude()
+	})
+}
+
+// ClearInclude clears the value of the "include" field.
+func (u *FeedSubscriptionUpsertOne) ClearInclude() *FeedSubscriptionUpsertOne {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.ClearInclude()
+	})
+}
+
+// SetExclude sets the "exclude" field.
+func (u *FeedSubscriptionUpsertOne) SetExclude(v string) *FeedSubscriptionUpsertOne {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.SetExclude(v)
+	})
+}
+
+// UpdateExclude sets the "exclude" field to the value that was provided on create.
+func (u *FeedSubscriptionUpsertOne) UpdateExclude() *FeedSubscriptionUpsertOne {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.UpdateExclude()
+	})
+}
+
+// ClearExclude clears the value of the "exclude" field.
+func (u *FeedSubscriptionUpsertOne) ClearExclude() *FeedSubscriptionUpsertOne {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.ClearExclude()
+	})
+}
+
+// SetDst sets the "dst" field.
+func (u *FeedSubscriptionUpsertOne) SetDst(v string) *FeedSubscriptionUpsertOne {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.SetDst(v)
+	})
+}
+
+// UpdateDst sets the "dst" field to the value that was provided on create.
+func (u *FeedSubscriptionUpsertOne) UpdateDst() *FeedSubscriptionUpsertOne {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.UpdateDst()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *FeedSubscriptionUpsertOne) SetEnabled(v bool) *FeedSubscriptionUpsertOne {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *FeedSubscriptionUpsertOne) UpdateEnabled() *FeedSubscriptionUpsertOne {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetCheckedAt sets the "checked_at" field.
+func (u *FeedSubscriptionUpsertOne) SetCheckedAt(v time.Time) *FeedSubscriptionUpsertOne {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.SetCheckedAt(v)
+	})
+}
+
+// UpdateCheckedAt sets the "checked_at" field to the value that was provided on create.
+func (u *FeedSubscriptionUpsertOne) UpdateCheckedAt() *FeedSubscriptionUpsertOne {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.UpdateCheckedAt()
+	})
+}
+
+// ClearCheckedAt clears the value of the "checked_at" field.
+func (u *FeedSubscriptionUpsertOne) ClearCheckedAt() *FeedSubscriptionUpsertOne {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.ClearCheckedAt()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *FeedSubscriptionUpsertOne) SetError(v string) *FeedSubscriptionUpsertOne {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *FeedSubscriptionUpsertOne) UpdateError() *FeedSubscriptionUpsertOne {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *FeedSubscriptionUpsertOne) ClearError() *FeedSubscriptionUpsertOne {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (u *FeedSubscriptionUpsertOne) SetOwnerID(v int) *FeedSubscriptionUpsertOne {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.SetOwnerID(v)
+	})
+}
+
+// UpdateOwnerID sets the "owner_id" field to the value that was provided on create.
+func (u *FeedSubscriptionUpsertOne) UpdateOwnerID() *FeedSubscriptionUpsertOne {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.UpdateOwnerID()
+	})
+}
+
+// Exec executes the query.
+func (u *FeedSubscriptionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for FeedSubscriptionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *FeedSubscriptionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *FeedSubscriptionUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *FeedSubscriptionUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (m *FeedSubscriptionCreate) SetRawID(t int) *FeedSubscriptionCreate {
+	m.mutation.SetRawID(t)
+	return m
+}
+
+// FeedSubscriptionCreateBulk is the builder for creating many FeedSubscription entities in bulk.
+type FeedSubscriptionCreateBulk struct {
+	config
+	err      error
+	builders []*FeedSubscriptionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the FeedSubscription entities in the database.
+func (fscb *FeedSubscriptionCreateBulk) Save(ctx context.Context) ([]*FeedSubscription, error) {
+	if fscb.err != nil {
+		return nil, fscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(fscb.builders))
+	nodes := make([]*FeedSubscription, len(fscb.builders))
+	mutators := make([]Mutator, len(fscb.builders))
+	for i := range fscb.builders {
+		func(i int, root context.Context) {
+			builder := fscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FeedSubscriptionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, fscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = fscb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, fscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, fscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fscb *FeedSubscriptionCreateBulk) SaveX(ctx context.Context) []*FeedSubscription {
+	v, err := fscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (fscb *FeedSubscriptionCreateBulk) Exec(ctx context.Context) error {
+	_, err := fscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fscb *FeedSubscriptionCreateBulk) ExecX(ctx context.Context) {
+	if err := fscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.FeedSubscription.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.FeedSubscriptionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (fscb *FeedSubscriptionCreateBulk) OnConflict(opts ...sql.ConflictOption) *FeedSubscriptionUpsertBulk {
+	fscb.conflict = opts
+	return &FeedSubscriptionUpsertBulk{
+		create: fscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.FeedSubscription.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (fscb *FeedSubscriptionCreateBulk) OnConflictColumns(columns ...string) *FeedSubscriptionUpsertBulk {
+	fscb.conflict = append(fscb.conflict, sql.ConflictColumns(columns...))
+	return &FeedSubscriptionUpsertBulk{
+		create: fscb,
+	}
+}
+
+// FeedSubscriptionUpsertBulk is the builder for "upsert"-ing
+// a bulk of FeedSubscription nodes.
+type FeedSubscriptionUpsertBulk struct {
+	create *FeedSubscriptionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.FeedSubscription.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *FeedSubscriptionUpsertBulk) UpdateNewValues() *FeedSubscriptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(feedsubscription.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.FeedSubscription.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *FeedSubscriptionUpsertBulk) Ignore() *FeedSubscriptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *FeedSubscriptionUpsertBulk) DoNothing() *FeedSubscriptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the FeedSubscriptionCreateBulk.OnConflict
+// documentation for more info.
+func (u *FeedSubscriptionUpsertBulk) Update(set func(*FeedSubscriptionUpsert)) *FeedSubscriptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&FeedSubscriptionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *FeedSubscriptionUpsertBulk) SetUpdatedAt(v time.Time) *FeedSubscriptionUpsertBulk {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *FeedSubscriptionUpsertBulk) UpdateUpdatedAt() *FeedSubscriptionUpsertBulk {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *FeedSubscriptionUpsertBulk) SetDeletedAt(v time.Time) *FeedSubscriptionUpsertBulk {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *FeedSubscriptionUpsertBulk) UpdateDeletedAt() *FeedSubscriptionUpsertBulk {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *FeedSubscriptionUpsertBulk) ClearDeletedAt() *FeedSubscriptionUpsertBulk {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *FeedSubscriptionUpsertBulk) SetName(v string) *FeedSubscriptionUpsertBulk {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *FeedSubscriptionUpsertBulk) UpdateName() *FeedSubscriptionUpsertBulk {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetURL sets the "url" field.
+func (u *FeedSubscriptionUpsertBulk) SetURL(v string) *FeedSubscriptionUpsertBulk {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.SetURL(v)
+	})
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *FeedSubscriptionUpsertBulk) UpdateURL() *FeedSubscriptionUpsertBulk {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.UpdateURL()
+	})
+}
+
+// SetInclude sets the "include" field.
+func (u *FeedSubscriptionUpsertBulk) SetInclude(v string) *FeedSubscriptionUpsertBulk {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.SetInclude(v)
+	})
+}
+
+// UpdateInclude sets the "include" field to the value that was provided on create.
+func (u *FeedSubscriptionUpsertBulk) UpdateInclude() *FeedSubscriptionUpsertBulk {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.UpdateInclude()
+	})
+}
+
+// ClearInclude clears the value of the "include" field.
+func (u *FeedSubscriptionUpsertBulk) ClearInclude() *FeedSubscriptionUpsertBulk {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.ClearInclude()
+	})
+}
+
+// SetExclude sets the "exclude" field.
+func (u *FeedSubscriptionUpsertBulk) SetExclude(v string) *FeedSubscriptionUpsertBulk {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.SetExclude(v)
+	})
+}
+
+// UpdateExclude sets the "exclude" field to the value that was provided on create.
+func (u *FeedSubscriptionUpsertBulk) UpdateExclude() *FeedSubscriptionUpsertBulk {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.UpdateExclude()
+	})
+}
+
+// ClearExclude clears the value of the "exclude" field.
+func (u *FeedSubscriptionUpsertBulk) ClearExclude() *FeedSubscriptionUpsertBulk {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.ClearExclude()
+	})
+}
+
+// SetDst sets the "dst" field.
+func (u *FeedSubscriptionUpsertBulk) SetDst(v string) *FeedSubscriptionUpsertBulk {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.SetDst(v)
+	})
+}
+
+// UpdateDst sets the "dst" field to the value that was provided on create.
+func (u *FeedSubscriptionUpsertBulk) UpdateDst() *FeedSubscriptionUpsertBulk {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.UpdateDst()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *FeedSubscriptionUpsertBulk) SetEnabled(v bool) *FeedSubscriptionUpsertBulk {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *FeedSubscriptionUpsertBulk) UpdateEnabled() *FeedSubscriptionUpsertBulk {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetCheckedAt sets the "checked_at" field.
+func (u *FeedSubscriptionUpsertBulk) SetCheckedAt(v time.Time) *FeedSubscriptionUpsertBulk {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.SetCheckedAt(v)
+	})
+}
+
+// UpdateCheckedAt sets the "checked_at" field to the value that was provided on create.
+func (u *FeedSubscriptionUpsertBulk) UpdateCheckedAt() *FeedSubscriptionUpsertBulk {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.UpdateCheckedAt()
+	})
+}
+
+// ClearCheckedAt clears the value of the "checked_at" field.
+func (u *FeedSubscriptionUpsertBulk) ClearCheckedAt() *FeedSubscriptionUpsertBulk {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.ClearCheckedAt()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *FeedSubscriptionUpsertBulk) SetError(v string) *FeedSubscriptionUpsertBulk {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *FeedSubscriptionUpsertBulk) UpdateError() *FeedSubscriptionUpsertBulk {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *FeedSubscriptionUpsertBulk) ClearError() *FeedSubscriptionUpsertBulk {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (u *FeedSubscriptionUpsertBulk) SetOwnerID(v int) *FeedSubscriptionUpsertBulk {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.SetOwnerID(v)
+	})
+}
+
+// UpdateOwnerID sets the "owner_id" field to the value that was provided on create.
+func (u *FeedSubscriptionUpsertBulk) UpdateOwnerID() *FeedSubscriptionUpsertBulk {
+	return u.Update(func(s *FeedSubscriptionUpsert) {
+		s.UpdateOwnerID()
+	})
+}
+
+// Exec executes the query.
+func (u *FeedSubscriptionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the FeedSubscriptionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for FeedSubscriptionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *FeedSubscriptionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
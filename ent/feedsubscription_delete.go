@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/feedsubscription"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+)
+
+// FeedSubscriptionDelete is the builder for deleting a FeedSubscription entity.
+type FeedSubscriptionDelete struct {
+	config
+	hooks    []Hook
+	mutation *FeedSubscriptionMutation
+}
+
+// Where appends a list predicates to the FeedSubscriptionDelete builder.
+func (fsd *FeedSubscriptionDelete) Where(ps ...predicate.FeedSubscription) *FeedSubscriptionDelete {
+	fsd.mutation.Where(ps...)
+	return fsd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (fsd *FeedSubscriptionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, fsd.sqlExec, fsd.mutation, fsd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fsd *FeedSubscriptionDelete) ExecX(ctx context.Context) int {
+	n, err := fsd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (fsd *FeedSubscriptionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(feedsubscription.Table, sqlgraph.NewFieldSpec(feedsubscription.FieldID, field.TypeInt))
+	if ps := fsd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, fsd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	fsd.mutation.done = true
+	return affected, err
+}
+
+// FeedSubscriptionDeleteOne is the builder for deleting a single FeedSubscription entity.
+type FeedSubscriptionDeleteOne struct {
+	fsd *FeedSubscriptionDelete
+}
+
+// Where appends a list predicates to the FeedSubscriptionDelete builder.
+func (fsdo *FeedSubscriptionDeleteOne) Where(ps ...predicate.FeedSubscription) *FeedSubscriptionDeleteOne {
+	fsdo.fsd.mutation.Where(ps...)
+	return fsdo
+}
+
+// Exec executes the deletion query.
+func (fsdo *FeedSubscriptionDeleteOne) Exec(ctx context.Context) error {
+	n, err := fsdo.fsd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{feedsubscription.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fsdo *FeedSubscriptionDeleteOne) ExecX(ctx context.Context) {
+	if err := fsdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
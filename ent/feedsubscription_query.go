@@ -0,0 +1,680 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/feeditem"
+	"github.com/cloudreve/Cloudreve/v4/ent/feedsubscription"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+)
+
+// FeedSubscriptionQuery is the builder for querying FeedSubscription entities.
+type FeedSubscriptionQuery struct {
+	config
+	ctx        *QueryContext
+	order      []feedsubscription.OrderOption
+	inters     []Interceptor
+	predicates []predicate.FeedSubscription
+	withOwner  *UserQuery
+	withItems  *FeedItemQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the FeedSubscriptionQuery builder.
+func (fsq *FeedSubscriptionQuery) Where(ps ...predicate.FeedSubscription) *FeedSubscriptionQuery {
+	fsq.predicates = append(fsq.predicates, ps...)
+	return fsq
+}
+
+// Limit the number of records to be returned by this query.
+func (fsq *FeedSubscriptionQuery) Limit(limit int) *FeedSubscriptionQuery {
+	fsq.ctx.Limit = &limit
+	return fsq
+}
+
+// Offset to start from.
+func (fsq *FeedSubscriptionQuery) Offset(offset int) *FeedSubscriptionQuery {
+	fsq.ctx.Offset = &offset
+	return fsq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (fsq *FeedSubscriptionQuery) Unique(unique bool) *FeedSubscriptionQuery {
+	fsq.ctx.Unique = &unique
+	return fsq
+}
+
+// Order specifies how the records should be ordered.
+func (fsq *FeedSubscriptionQuery) Order(o ...feedsubscription.OrderOption) *FeedSubscriptionQuery {
+	fsq.order = append(fsq.order, o...)
+	return fsq
+}
+
+// QueryOwner chains the current query on the "owner" edge.
+func (fsq *FeedSubscriptionQuery) QueryOwner() *UserQuery {
+	query := (&UserClient{config: fsq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := fsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := fsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(feedsubscription.Table, feedsubscription.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, feedsubscription.OwnerTable, feedsubscription.OwnerColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(fsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryItems chains the current query on the "items" edge.
+func (fsq *FeedSubscriptionQuery) QueryItems() *FeedItemQuery {
+	query := (&FeedItemClient{config: fsq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := fsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := fsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(feedsubscription.Table, feedsubscription.FieldID, selector),
+			sqlgraph.To(feeditem.Table, feeditem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, feedsubscription.ItemsTable, feedsubscription.ItemsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(fsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first FeedSubscription entity from the query.
+// Returns a *NotFoundError when no FeedSubscription was found.
+func (fsq *FeedSubscriptionQuery) First(ctx context.Context) (*FeedSubscription, error) {
+	nodes, err := fsq.Limit(1).All(setContextOp(ctx, fsq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{feedsubscription.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (fsq *FeedSubscriptionQuery) FirstX(ctx context.Context) *FeedSubscription {
+	node, err := fsq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first FeedSubscription ID from the query.
+// Returns a *NotFoundError when no FeedSubscription ID was found.
+func (fsq *FeedSubscriptionQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = fsq.Limit(1).IDs(setContextOp(ctx, fsq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{feedsubscription.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (fsq *FeedSubscriptionQuery) FirstIDX(ctx context.Context) int {
+	id, err := fsq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single FeedSubscription entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one FeedSubscription entity is found.
+// Returns a *NotFoundError when no FeedSubscription entities are found.
+func (fsq *FeedSubscriptionQuery) Only(ctx context.Context) (*FeedSubscription, error) {
+	nodes, err := fsq.Limit(2).All(setContextOp(ctx, fsq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{feedsubscription.Label}
+	default:
+		return nil, &NotSingularError{feedsubscription.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (fsq *FeedSubscriptionQuery) OnlyX(ctx context.Context) *FeedSubscription {
+	node, err := fsq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only FeedSubscription ID in the query.
+// Returns a *NotSingularError when more than one FeedSubscription ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (fsq *FeedSubscriptionQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = fsq.Limit(2).IDs(setContextOp(ctx, fsq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{feedsubscription.Label}
+	default:
+		err = &NotSingularError{feedsubscription.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (fsq *FeedSubscriptionQuery) OnlyIDX(ctx context.Context) int {
+	id, err := fsq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of FeedSubscriptions.
+func (fsq *FeedSubscriptionQuery) All(ctx context.Context) ([]*FeedSubscription, error) {
+	ctx = setContextOp(ctx, fsq.ctx, "All")
+	if err := fsq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*FeedSubscription, *FeedSubscriptionQuery]()
+	return withInterceptors[[]*FeedSubscription](ctx, fsq, qr, fsq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (fsq *FeedSubscriptionQuery) AllX(ctx context.Context) []*FeedSubscription {
+	nodes, err := fsq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of FeedSubscription IDs.
+func (fsq *FeedSubscriptionQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if fsq.ctx.Unique == nil && fsq.path != nil {
+		fsq.Unique(true)
+	}
+	ctx = setContextOp(ctx, fsq.ctx, "IDs")
+	if err = fsq.Select(feedsubscription.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (fsq *FeedSubscriptionQuery) IDsX(ctx context.Context) []int {
+	ids, err := fsq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (fsq *FeedSubscriptionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, fsq.ctx, "Count")
+	if err := fsq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, fsq, querierCount[*FeedSubscriptionQuery](), fsq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (fsq *FeedSubscriptionQuery) CountX(ctx context.Context) int {
+	count, err := fsq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (fsq *FeedSubscriptionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, fsq.ctx, "Exist")
+	switch _, err := fsq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (fsq *FeedSubscriptionQuery) ExistX(ctx context.Context) bool {
+	exist, err := fsq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the FeedSubscriptionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (fsq *FeedSubscriptionQuery) Clone() *FeedSubscriptionQuery {
+	if fsq == nil {
+		return nil
+	}
+	return &FeedSubscriptionQuery{
+		config:     fsq.config,
+		ctx:        fsq.ctx.Clone(),
+		order:      append([]feedsubscription.OrderOption{}, fsq.order...),
+		inters:     append([]Interceptor{}, fsq.inters...),
+		predicates: append([]predicate.FeedSubscription{}, fsq.predicates...),
+		withOwner:  fsq.withOwner.Clone(),
+		withItems:  fsq.withItems.Clone(),
+		// clone intermediate query.
+		sql:  fsq.sql.Clone(),
+		path: fsq.path,
+	}
+}
+
+// WithOwner tells the query-builder to eager-load the nodes that are connected to
+// the "owner" edge. The optional arguments are used to configure the query builder of the edge.
+func (fsq *FeedSubscriptionQuery) WithOwner(opts ...func(*UserQuery)) *FeedSubscriptionQuery {
+	query := (&UserClient{config: fsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	fsq.withOwner = query
+	return fsq
+}
+
+// WithItems tells the query-builder to eager-load the nodes that are connected to
+// the "items" edge. The optional arguments are used to configure the query builder of the edge.
+func (fsq *FeedSubscriptionQuery) WithItems(opts ...func(*FeedItemQuery)) *FeedSubscriptionQuery {
+	query := (&FeedItemClient{config: fsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	fsq.withItems = query
+	return fsq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.FeedSubscription.Query().
+//		GroupBy(feedsubscription.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (fsq *FeedSubscriptionQuery) GroupBy(field string, fields ...string) *FeedSubscriptionGroupBy {
+	fsq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &FeedSubscriptionGroupBy{build: fsq}
+	grbuild.flds = &fsq.ctx.Fields
+	grbuild.label = feedsubscription.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.FeedSubscription.Query().
+//		Select(feedsubscription.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (fsq *FeedSubscriptionQuery) Select(fields ...string) *FeedSubscriptionSelect {
+	fsq.ctx.Fields = append(fsq.ctx.Fields, fields...)
+	sbuild := &FeedSubscriptionSelect{FeedSubscriptionQuery: fsq}
+	sbuild.label = feedsubscription.Label
+	sbuild.flds, sbuild.scan = &fsq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a FeedSubscriptionSelect configured with the given aggregations.
+func (fsq *FeedSubscriptionQuery) Aggregate(fns ...AggregateFunc) *FeedSubscriptionSelect {
+	return fsq.Select().Aggregate(fns...)
+}
+
+func (fsq *FeedSubscriptionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range fsq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, fsq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range fsq.ctx.Fields {
+		if !feedsubscription.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if fsq.path != nil {
+		prev, err := fsq.path(ctx)
+		if err != nil {
+			return err
+		}
+		fsq.sql = prev
+	}
+	return nil
+}
+
+func (fsq *FeedSubscriptionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*FeedSubscription, error) {
+	var (
+		nodes       = []*FeedSubscription{}
+		_spec       = fsq.querySpec()
+		loadedTypes = [2]bool{
+			fsq.withOwner != nil,
+			fsq.withItems != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*FeedSubscription).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &FeedSubscription{config: fsq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, fsq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := fsq.withOwner; query != nil {
+		if err := fsq.loadOwner(ctx, query, nodes, nil,
+			func(n *FeedSubscription, e *User) { n.Edges.Owner = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := fsq.withItems; query != nil {
+		if err := fsq.loadItems(ctx, query, nodes,
+			func(n *FeedSubscription) { n.Edges.Items = []*FeedItem{} },
+			func(n *FeedSubscription, e *FeedItem) { n.Edges.Items = append(n.Edges.Items, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (fsq *FeedSubscriptionQuery) loadOwner(ctx context.Context, query *UserQuery, nodes []*FeedSubscription, init func(*FeedSubscription), assign func(*FeedSubscription, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*FeedSubscription)
+	for i := range nodes {
+		fk := nodes[i].OwnerID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "owner_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (fsq *FeedSubscriptionQuery) loadItems(ctx context.Context, query *FeedItemQuery, nodes []*FeedSubscription, init func(*FeedSubscription), assign func(*FeedSubscription, *FeedItem)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*FeedSubscription)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(feeditem.FieldSubscriptionID)
+	}
+	query.Where(predicate.FeedItem(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(feedsubscription.ItemsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.SubscriptionID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "subscription_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (fsq *FeedSubscriptionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := fsq.querySpec()
+	_spec.Node.Columns = fsq.ctx.Fields
+	if len(fsq.ctx.Fields) > 0 {
+		_spec.Unique = fsq.ctx.Unique != nil && *fsq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, fsq.driver, _spec)
+}
+
+func (fsq *FeedSubscriptionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(feedsubscription.Table, feedsubscription.Columns, sqlgraph.NewFieldSpec(feedsubscription.FieldID, field.TypeInt))
+	_spec.From = fsq.sql
+	if unique := fsq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if fsq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := fsq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, feedsubscription.FieldID)
+		for i := range fields {
+			if fields[i] != feedsubscription.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if fsq.withOwner != nil {
+			_spec.Node.AddColumnOnce(feedsubscription.FieldOwnerID)
+		}
+	}
+	if ps := fsq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := fsq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := fsq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := fsq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (fsq *FeedSubscriptionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(fsq.driver.Dialect())
+	t1 := builder.Table(feedsubscription.Table)
+	columns := fsq.ctx.Fields
+	if len(columns) == 0 {
+		columns = feedsubscription.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if fsq.sql != nil {
+		selector = fsq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if fsq.ctx.Unique != nil && *fsq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range fsq.predicates {
+		p(selector)
+	}
+	for _, p := range fsq.order {
+		p(selector)
+	}
+	if offset := fsq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := fsq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// FeedSubscriptionGroupBy is the group-by builder for FeedSubscription entities.
+type FeedSubscriptionGroupBy struct {
+	selector
+	build *FeedSubscriptionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (fsgb *FeedSubscriptionGroupBy) Aggregate(fns ...AggregateFunc) *FeedSubscriptionGroupBy {
+	fsgb.fns = append(fsgb.fns, fns...)
+	return fsgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (fsgb *FeedSubscriptionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, fsgb.build.ctx, "GroupBy")
+	if err := fsgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*FeedSubscriptionQuery, *FeedSubscriptionGroupBy](ctx, fsgb.build, fsgb, fsgb.build.inters, v)
+}
+
+func (fsgb *FeedSubscriptionGroupBy) sqlScan(ctx context.Context, root *FeedSubscriptionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(fsgb.fns))
+	for _, fn := range fsgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*fsgb.flds)+len(fsgb.fns))
+		for _, f := range *fsgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*fsgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := fsgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// FeedSubscriptionSelect is the builder for selecting fields of FeedSubscription entities.
+type FeedSubscriptionSelect struct {
+	*FeedSubscriptionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (fss *FeedSubscriptionSelect) Aggregate(fns ...AggregateFunc) *FeedSubscriptionSelect {
+	fss.fns = append(fss.fns, fns...)
+	return fss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (fss *FeedSubscriptionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, fss.ctx, "Select")
+	if err := fss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*FeedSubscriptionQuery, *FeedSubscriptionSelect](ctx, fss.FeedSubscriptionQuery, fss, fss.inters, v)
+}
+
+func (fss *FeedSubscriptionSelect) sqlScan(ctx context.Context, root *FeedSubscriptionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(fss.fns))
+	for _, fn := range fss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*fss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := fss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,914 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/feeditem"
+	"github.com/cloudreve/Cloudreve/v4/ent/feedsubscription"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+)
+
+// FeedSubscriptionUpdate is the builder for updating FeedSubscription entities.
+type FeedSubscriptionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *FeedSubscriptionMutation
+}
+
+// Where appends a list predicates to the FeedSubscriptionUpdate builder.
+func (fsu *FeedSubscriptionUpdate) Where(ps ...predicate.FeedSubscription) *FeedSubscriptionUpdate {
+	fsu.mutation.Where(ps...)
+	return fsu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (fsu *FeedSubscriptionUpdate) SetUpdatedAt(t time.Time) *FeedSubscriptionUpdate {
+	fsu.mutation.SetUpdatedAt(t)
+	return fsu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (fsu *FeedSubscriptionUpdate) SetDeletedAt(t time.Time) *FeedSubscriptionUpdate {
+	fsu.mutation.SetDeletedAt(t)
+	return fsu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (fsu *FeedSubscriptionUpdate) SetNillableDeletedAt(t *time.Time) *FeedSubscriptionUpdate {
+	if t != nil {
+		fsu.SetDeletedAt(*t)
+	}
+	return fsu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (fsu *FeedSubscriptionUpdate) ClearDeletedAt() *FeedSubscriptionUpdate {
+	fsu.mutation.ClearDeletedAt()
+	return fsu
+}
+
+// SetName sets the "name" field.
+func (fsu *FeedSubscriptionUpdate) SetName(s string) *FeedSubscriptionUpdate {
+	fsu.mutation.SetName(s)
+	return fsu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (fsu *FeedSubscriptionUpdate) SetNillableName(s *string) *FeedSubscriptionUpdate {
+	if s != nil {
+		fsu.SetName(*s)
+	}
+	return fsu
+}
+
+// SetURL sets the "url" field.
+func (fsu *FeedSubscriptionUpdate) SetURL(s string) *FeedSubscriptionUpdate {
+	fsu.mutation.SetURL(s)
+	return fsu
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (fsu *FeedSubscriptionUpdate) SetNillableURL(s *string) *FeedSubscriptionUpdate {
+	if s != nil {
+		fsu.SetURL(*s)
+	}
+	return fsu
+}
+
+// SetInclude sets the "include" field.
+func (fsu *FeedSubscriptionUpdate) SetInclude(s string) *FeedSubscriptionUpdate {
+	fsu.mutation.SetInclude(s)
+	return fsu
+}
+
+// SetNillableInclude sets the "include" field if the given value is not nil.
+func (fsu *FeedSubscriptionUpdate) SetNillableInclude(s *string) *FeedSubscriptionUpdate {
+	if s != nil {
+		fsu.SetInclude(*s)
+	}
+	return fsu
+}
+
+// ClearInclude clears the value of the "include" field.
+func (fsu *FeedSubscriptionUpdate) ClearInclude() *FeedSubscriptionUpdate {
+	fsu.mutation.ClearInclude()
+	return fsu
+}
+
+// SetExclude sets the "exclude" field.
+func (fsu *FeedSubscriptionUpdate) SetExclude(s string) *FeedSubscriptionUpdate {
+	fsu.mutation.SetExclude(s)
+	return fsu
+}
+
+// SetNillableExclude sets the "exclude" field if the given value is not nil.
+func (fsu *FeedSubscriptionUpdate) SetNillableExclude(s *string) *FeedSubscriptionUpdate {
+	if s != nil {
+		fsu.SetExclude(*s)
+	}
+	return fsu
+}
+
+// ClearExclude clears the value of the "exclude" field.
+func (fsu *FeedSubscriptionUpdate) ClearExclude() *FeedSubscriptionUpdate {
+	fsu.mutation.ClearExclude()
+	return fsu
+}
+
+// SetDst sets the "dst" field.
+func (fsu *FeedSubscriptionUpdate) SetDst(s string) *FeedSubscriptionUpdate {
+	fsu.mutation.SetDst(s)
+	return fsu
+}
+
+// SetNillableDst sets the "dst" field if the given value is not nil.
+func (fsu *FeedSubscriptionUpdate) SetNillableDst(s *string) *FeedSubscriptionUpdate {
+	if s != nil {
+		fsu.SetDst(*s)
+	}
+	return fsu
+}
+
+// SetEnabled sets the "enabled" field.
+func (fsu *FeedSubscriptionUpdate) SetEnabled(b bool) *FeedSubscriptionUpdate {
+	fsu.mutation.SetEnabled(b)
+	return fsu
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (fsu *FeedSubscriptionUpdate) SetNillableEnabled(b *bool) *FeedSubscriptionUpdate {
+	if b != nil {
+		fsu.SetEnabled(*b)
+	}
+	return fsu
+}
+
+// SetCheckedAt sets the "checked_at" field.
+func (fsu *FeedSubscriptionUpdate) SetCheckedAt(t time.Time) *FeedSubscriptionUpdate {
+	fsu.mutation.SetCheckedAt(t)
+	return fsu
+}
+
+// SetNillableCheckedAt sets the "checked_at" field if the given value is not nil.
+func (fsu *FeedSubscriptionUpdate) SetNillableCheckedAt(t *time.Time) *FeedSubscriptionUpdate {
+	if t != nil {
+		fsu.SetCheckedAt(*t)
+	}
+	return fsu
+}
+
+// ClearCheckedAt clears the value of the "checked_at" field.
+func (fsu *FeedSubscriptionUpdate) ClearCheckedAt() *FeedSubscriptionUpdate {
+	fsu.mutation.ClearCheckedAt()
+	return fsu
+}
+
+// SetError sets the "error" field.
+func (fsu *FeedSubscriptionUpdate) SetError(s string) *FeedSubscriptionUpdate {
+	fsu.mutation.SetError(s)
+	return fsu
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (fsu *FeedSubscriptionUpdate) SetNillableError(s *string) *FeedSubscriptionUpdate {
+	if s != nil {
+		fsu.SetError(*s)
+	}
+	return fsu
+}
+
+// ClearError clears the value of the "error" field.
+func (fsu *FeedSubscriptionUpdate) ClearError() *FeedSubscriptionUpdate {
+	fsu.mutation.ClearError()
+	return fsu
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (fsu *FeedSubscriptionUpdate) SetOwnerID(i int) *FeedSubscriptionUpdate {
+	fsu.mutation.SetOwnerID(i)
+	return fsu
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (fsu *FeedSubscriptionUpdate) SetNillableOwnerID(i *int) *FeedSubscriptionUpdate {
+	if i != nil {
+		fsu.SetOwnerID(*i)
+	}
+	return fsu
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (fsu *FeedSubscriptionUpdate) SetOwner(u *User) *FeedSubscriptionUpdate {
+	return fsu.SetOwnerID(u.ID)
+}
+
+// AddItemIDs adds the "items" edge to the FeedItem entity by IDs.
+func (fsu *FeedSubscriptionUpdate) AddItemIDs(ids ...int) *FeedSubscriptionUpdate {
+	fsu.mutation.AddItemIDs(ids...)
+	return fsu
+}
+
+// AddItems adds the "items" edges to the FeedItem entity.
+func (fsu *FeedSubscriptionUpdate) AddItems(f ...*FeedItem) *FeedSubscriptionUpdate {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return fsu.AddItemIDs(ids...)
+}
+
+// Mutation returns the FeedSubscriptionMutation object of the builder.
+func (fsu *FeedSubscriptionUpdate) Mutation() *FeedSubscriptionMutation {
+	return fsu.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (fsu *FeedSubscriptionUpdate) ClearOwner() *FeedSubscriptionUpdate {
+	fsu.mutation.ClearOwner()
+	return fsu
+}
+
+// ClearItems clears all "items" edges to the FeedItem entity.
+func (fsu *FeedSubscriptionUpdate) ClearItems() *FeedSubscriptionUpdate {
+	fsu.mutation.ClearItems()
+	return fsu
+}
+
+// RemoveItemIDs removes the "items" edge to FeedItem entities by IDs.
+func (fsu *FeedSubscriptionUpdate) RemoveItemIDs(ids ...int) *FeedSubscriptionUpdate {
+	fsu.mutation.RemoveItemIDs(ids...)
+	return fsu
+}
+
+// RemoveItems removes "items" edges to FeedItem entities.
+func (fsu *FeedSubscriptionUpdate) RemoveItems(f ...*FeedItem) *FeedSubscriptionUpdate {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return fsu.RemoveItemIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (fsu *FeedSubscriptionUpdate) Save(ctx context.Context) (int, error) {
+	if err := fsu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, fsu.sqlSave, fsu.mutation, fsu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fsu *FeedSubscriptionUpdate) SaveX(ctx context.Context) int {
+	affected, err := fsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (fsu *FeedSubscriptionUpdate) Exec(ctx context.Context) error {
+	_, err := fsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fsu *FeedSubscriptionUpdate) ExecX(ctx context.Context) {
+	if err := fsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (fsu *FeedSubscriptionUpdate) defaults() error {
+	if _, ok := fsu.mutation.UpdatedAt(); !ok {
+		if feedsubscription.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized feedsubscription.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := feedsubscription.UpdateDefaultUpdatedAt()
+		fsu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fsu *FeedSubscriptionUpdate) check() error {
+	if _, ok := fsu.mutation.OwnerID(); fsu.mutation.OwnerCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "FeedSubscription.owner"`)
+	}
+	return nil
+}
+
+func (fsu *FeedSubscriptionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := fsu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(feedsubscription.Table, feedsubscription.Columns, sqlgraph.NewFieldSpec(feedsubscription.FieldID, field.TypeInt))
+	if ps := fsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := fsu.mutation.UpdatedAt(); ok {
+		_spec.SetField(feedsubscription.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := fsu.mutation.DeletedAt(); ok {
+		_spec.SetField(feedsubscription.FieldDeletedAt, field.TypeTime, value)
+	}
+	if fsu.mutation.DeletedAtCleared() {
+		_spec.ClearField(feedsubscription.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := fsu.mutation.Name(); ok {
+		_spec.SetField(feedsubscription.FieldName, field.TypeString, value)
+	}
+	if value, ok := fsu.mutation.URL(); ok {
+		_spec.SetField(feedsubscription.FieldURL, field.TypeString, value)
+	}
+	if value, ok := fsu.mutation.Include(); ok {
+		_spec.SetField(feedsubscription.FieldInclude, field.TypeString, value)
+	}
+	if fsu.mutation.IncludeCleared() {
+		_spec.ClearField(feedsubscription.FieldInclude, field.TypeString)
+	}
+	if value, ok := fsu.mutation.Exclude(); ok {
+		_spec.SetField(feedsubscription.FieldExclude, field.TypeString, value)
+	}
+	if fsu.mutation.ExcludeCleared() {
+		_spec.ClearField(feedsubscription.FieldExclude, field.TypeString)
+	}
+	if value, ok := fsu.mutation.Dst(); ok {
+		_spec.SetField(feedsubscription.FieldDst, field.TypeString, value)
+	}
+	if value, ok := fsu.mutation.Enabled(); ok {
+		_spec.SetField(feedsubscription.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := fsu.mutation.CheckedAt(); ok {
+		_spec.SetField(feedsubscription.FieldCheckedAt, field.TypeTime, value)
+	}
+	if fsu.mutation.CheckedAtCleared() {
+		_spec.ClearField(feedsubscription.FieldCheckedAt, field.TypeTime)
+	}
+	if value, ok := fsu.mutation.Error(); ok {
+		_spec.SetField(feedsubscription.FieldError, field.TypeString, value)
+	}
+	if fsu.mutation.ErrorCleared() {
+		_spec.ClearField(feedsubscription.FieldError, field.TypeString)
+	}
+	if fsu.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   feedsubscription.OwnerTable,
+			Columns: []string{feedsubscription.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fsu.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   feedsubscription.OwnerTable,
+			Columns: []string{feedsubscription.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fsu.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   feedsubscription.ItemsTable,
+			Columns: []string{feedsubscription.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(feeditem.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fsu.mutation.RemovedItemsIDs(); len(nodes) > 0 && !fsu.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   feedsubscription.ItemsTable,
+			Columns: []string{feedsubscription.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(feeditem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fsu.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   feedsubscription.ItemsTable,
+			Columns: []string{feedsubscription.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(feeditem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, fsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{feedsubscription.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	fsu.mutation.done = true
+	return n, nil
+}
+
+// FeedSubscriptionUpdateOne is the builder for updating a single FeedSubscription entity.
+type FeedSubscriptionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *FeedSubscriptionMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (fsuo *FeedSubscriptionUpdateOne) SetUpdatedAt(t time.Time) *FeedSubscriptionUpdateOne {
+	fsuo.mutation.SetUpdatedAt(t)
+	return fsuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (fsuo *FeedSubscriptionUpdateOne) SetDeletedAt(t time.Time) *FeedSubscriptionUpdateOne {
+	fsuo.mutation.SetDeletedAt(t)
+	return fsuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (fsuo *FeedSubscriptionUpdateOne) SetNillableDeletedAt(t *time.Time) *FeedSubscriptionUpdateOne {
+	if t != nil {
+		fsuo.SetDeletedAt(*t)
+	}
+	return fsuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (fsuo *FeedSubscriptionUpdateOne) ClearDeletedAt() *FeedSubscriptionUpdateOne {
+	fsuo.mutation.ClearDeletedAt()
+	return fsuo
+}
+
+// SetName sets the "name" field.
+func (fsuo *FeedSubscriptionUpdateOne) SetName(s string) *FeedSubscriptionUpdateOne {
+	fsuo.mutation.SetName(s)
+	return fsuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (fsuo *FeedSubscriptionUpdateOne) SetNillableName(s *string) *FeedSubscriptionUpdateOne {
+	if s != nil {
+		fsuo.SetName(*s)
+	}
+	return fsuo
+}
+
+// SetURL sets the "url" field.
+func (fsuo *FeedSubscriptionUpdateOne) SetURL(s string) *FeedSubscriptionUpdateOne {
+	fsuo.mutation.SetURL(s)
+	return fsuo
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (fsuo *FeedSubscriptionUpdateOne) SetNillableURL(s *string) *FeedSubscriptionUpdateOne {
+	if s != nil {
+		fsuo.SetURL(*s)
+	}
+	return fsuo
+}
+
+// SetInclude sets the "include" field.
+func (fsuo *FeedSubscriptionUpdateOne) SetInclude(s string) *FeedSubscriptionUpdateOne {
+	fsuo.mutation.SetInclude(s)
+	return fsuo
+}
+
+// SetNillableInclude sets the "include" field if the given value is not nil.
+func (fsuo *FeedSubscriptionUpdateOne) SetNillableInclude(s *string) *FeedSubscriptionUpdateOne {
+	if s != nil {
+		fsuo.SetInclude(*s)
+	}
+	return fsuo
+}
+
+// ClearInclude clears the value of the "include" field.
+func (fsuo *FeedSubscriptionUpdateOne) ClearInclude() *FeedSubscriptionUpdateOne {
+	fsuo.mutation.ClearInclude()
+	return fsuo
+}
+
+// SetExclude sets the "exclude" field.
+func (fsuo *FeedSubscriptionUpdateOne) SetExclude(s string) *FeedSubscriptionUpdateOne {
+	fsuo.mutation.SetExclude(s)
+	return fsuo
+}
+
+// SetNillableExclude sets the "exclude" field if the given value is not nil.
+func (fsuo *FeedSubscriptionUpdateOne) SetNillableExclude(s *string) *FeedSubscriptionUpdateOne {
+	if s != nil {
+		fsuo.SetExclude(*s)
+	}
+	return fsuo
+}
+
+// ClearExclude clears the value of the "exclude" field.
+func (fsuo *FeedSubscriptionUpdateOne) ClearExclude() *FeedSubscriptionUpdateOne {
+	fsuo.mutation.ClearExclude()
+	return fsuo
+}
+
+// SetDst sets the "dst" field.
+func (fsuo *FeedSubscriptionUpdateOne) SetDst(s string) *FeedSubscriptionUpdateOne {
+	fsuo.mutation.SetDst(s)
+	return fsuo
+}
+
+// SetNillableDst sets the "dst" field if the given value is not nil.
+func (fsuo *FeedSubscriptionUpdateOne) SetNillableDst(s *string) *FeedSubscriptionUpdateOne {
+	if s != nil {
+		fsuo.SetDst(*s)
+	}
+	return fsuo
+}
+
+// SetEnabled sets the "enabled" field.
+func (fsuo *FeedSubscriptionUpdateOne) SetEnabled(b bool) *FeedSubscriptionUpdateOne {
+	fsuo.mutation.SetEnabled(b)
+	return fsuo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (fsuo *FeedSubscriptionUpdateOne) SetNillableEnabled(b *bool) *FeedSubscriptionUpdateOne {
+	if b != nil {
+		fsuo.SetEnabled(*b)
+	}
+	return fsuo
+}
+
+// SetCheckedAt sets the "checked_at" field.
+func (fsuo *FeedSubscriptionUpdateOne) SetCheckedAt(t time.Time) *FeedSubscriptionUpdateOne {
+	fsuo.mutation.SetCheckedAt(t)
+	return fsuo
+}
+
+// SetNillableCheckedAt sets the "checked_at" field if the given value is not nil.
+func (fsuo *FeedSubscriptionUpdateOne) SetNillableCheckedAt(t *time.Time) *FeedSubscriptionUpdateOne {
+	if t != nil {
+		fsuo.SetCheckedAt(*t)
+	}
+	return fsuo
+}
+
+// ClearCheckedAt clears the value of the "checked_at" field.
+func (fsuo *FeedSubscriptionUpdateOne) ClearCheckedAt() *FeedSubscriptionUpdateOne {
+	fsuo.mutation.ClearCheckedAt()
+	return fsuo
+}
+
+// SetError sets the "error" field.
+func (fsuo *FeedSubscriptionUpdateOne) SetError(s string) *FeedSubscriptionUpdateOne {
+	fsuo.mutation.SetError(s)
+	return fsuo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (fsuo *FeedSubscriptionUpdateOne) SetNillableError(s *string) *FeedSubscriptionUpdateOne {
+	if s != nil {
+		fsuo.SetError(*s)
+	}
+	return fsuo
+}
+
+// ClearError clears the value of the "error" field.
+func (fsuo *FeedSubscriptionUpdateOne) ClearError() *FeedSubscriptionUpdateOne {
+	fsuo.mutation.ClearError()
+	return fsuo
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (fsuo *FeedSubscriptionUpdateOne) SetOwnerID(i int) *FeedSubscriptionUpdateOne {
+	fsuo.mutation.SetOwnerID(i)
+	return fsuo
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (fsuo *FeedSubscriptionUpdateOne) SetNillableOwnerID(i *int) *FeedSubscriptionUpdateOne {
+	if i != nil {
+		fsuo.SetOwnerID(*i)
+	}
+	return fsuo
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (fsuo *FeedSubscriptionUpdateOne) SetOwner(u *User) *FeedSubscriptionUpdateOne {
+	return fsuo.SetOwnerID(u.ID)
+}
+
+// AddItemIDs adds the "items" edge to the FeedItem entity by IDs.
+func (fsuo *FeedSubscriptionUpdateOne) AddItemIDs(ids ...int) *FeedSubscriptionUpdateOne {
+	fsuo.mutation.AddItemIDs(ids...)
+	return fsuo
+}
+
+// AddItems adds the "items" edges to the FeedItem entity.
+func (fsuo *FeedSubscriptionUpdateOne) AddItems(f ...*FeedItem) *FeedSubscriptionUpdateOne {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return fsuo.AddItemIDs(ids...)
+}
+
+// Mutation returns the FeedSubscriptionMutation object of the builder.
+func (fsuo *FeedSubscriptionUpdateOne) Mutation() *FeedSubscriptionMutation {
+	return fsuo.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (fsuo *FeedSubscriptionUpdateOne) ClearOwner() *FeedSubscriptionUpdateOne {
+	fsuo.mutation.ClearOwner()
+	return fsuo
+}
+
+// ClearItems clears all "items" edges to the FeedItem entity.
+func (fsuo *FeedSubscriptionUpdateOne) ClearItems() *FeedSubscriptionUpdateOne {
+	fsuo.mutation.ClearItems()
+	return fsuo
+}
+
+// RemoveItemIDs removes the "items" edge to FeedItem entities by IDs.
+func (fsuo *FeedSubscriptionUpdateOne) RemoveItemIDs(ids ...int) *FeedSubscriptionUpdateOne {
+	fsuo.mutation.RemoveItemIDs(ids...)
+	return fsuo
+}
+
+// RemoveItems removes "items" edges to FeedItem entities.
+func (fsuo *FeedSubscriptionUpdateOne) RemoveItems(f ...*FeedItem) *FeedSubscriptionUpdateOne {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return fsuo.RemoveItemIDs(ids...)
+}
+
+// Where appends a list predicates to the FeedSubscriptionUpdate builder.
+func (fsuo *FeedSubscriptionUpdateOne) Where(ps ...predicate.FeedSubscription) *FeedSubscriptionUpdateOne {
+	fsuo.mutation.Where(ps...)
+	return fsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (fsuo *FeedSubscriptionUpdateOne) Select(field string, fields ...string) *FeedSubscriptionUpdateOne {
+	fsuo.fields = append([]string{field}, fields...)
+	return fsuo
+}
+
+// Save executes the query and returns the updated FeedSubscription entity.
+func (fsuo *FeedSubscriptionUpdateOne) Save(ctx context.Context) (*FeedSubscription, error) {
+	if err := fsuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, fsuo.sqlSave, fsuo.mutation, fsuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fsuo *FeedSubscriptionUpdateOne) SaveX(ctx context.Context) *FeedSubscription {
+	node, err := fsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (fsuo *FeedSubscriptionUpdateOne) Exec(ctx context.Context) error {
+	_, err := fsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fsuo *FeedSubscriptionUpdateOne) ExecX(ctx context.Context) {
+	if err := fsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (fsuo *FeedSubscriptionUpdateOne) defaults() error {
+	if _, ok := fsuo.mutation.UpdatedAt(); !ok {
+		if feedsubscription.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized feedsubscription.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := feedsubscription.UpdateDefaultUpdatedAt()
+		fsuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fsuo *FeedSubscriptionUpdateOne) check() error {
+	if _, ok := fsuo.mutation.OwnerID(); fsuo.mutation.OwnerCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "FeedSubscription.owner"`)
+	}
+	return nil
+}
+
+func (fsuo *FeedSubscriptionUpdateOne) sqlSave(ctx context.Context) (_node *FeedSubscription, err error) {
+	if err := fsuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(feedsubscription.Table, feedsubscription.Columns, sqlgraph.NewFieldSpec(feedsubscription.FieldID, field.TypeInt))
+	id, ok := fsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "FeedSubscription.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := fsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, feedsubscription.FieldID)
+		for _, f := range fields {
+			if !feedsubscription.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != feedsubscription.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := fsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := fsuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(feedsubscription.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := fsuo.mutation.DeletedAt(); ok {
+		_spec.SetField(feedsubscription.FieldDeletedAt, field.TypeTime, value)
+	}
+	if fsuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(feedsubscription.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := fsuo.mutation.Name(); ok {
+		_spec.SetField(feedsubscription.FieldName, field.TypeString, value)
+	}
+	if value, ok := fsuo.mutation.URL(); ok {
+		_spec.SetField(feedsubscription.FieldURL, field.TypeString, value)
+	}
+	if value, ok := fsuo.mutation.Include(); ok {
+		_spec.SetField(feedsubscription.FieldInclude, field.TypeString, value)
+	}
+	if fsuo.mutation.IncludeCleared() {
+		_spec.ClearField(feedsubscription.FieldInclude, field.TypeString)
+	}
+	if value, ok := fsuo.mutation.Exclude(); ok {
+		_spec.SetField(feedsubscription.FieldExclude, field.TypeString, value)
+	}
+	if fsuo.mutation.ExcludeCleared() {
+		_spec.ClearField(feedsubscription.FieldExclude, field.TypeString)
+	}
+	if value, ok := fsuo.mutation.Dst(); ok {
+		_spec.SetField(feedsubscription.FieldDst, field.TypeString, value)
+	}
+	if value, ok := fsuo.mutation.Enabled(); ok {
+		_spec.SetField(feedsubscription.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := fsuo.mutation.CheckedAt(); ok {
+		_spec.SetField(feedsubscription.FieldCheckedAt, field.TypeTime, value)
+	}
+	if fsuo.mutation.CheckedAtCleared() {
+		_spec.ClearField(feedsubscription.FieldCheckedAt, field.TypeTime)
+	}
+	if value, ok := fsuo.mutation.Error(); ok {
+		_spec.SetField(feedsubscription.FieldError, field.TypeString, value)
+	}
+	if fsuo.mutation.ErrorCleared() {
+		_spec.ClearField(feedsubscription.FieldError, field.TypeString)
+	}
+	if fsuo.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   feedsubscription.OwnerTable,
+			Columns: []string{feedsubscription.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fsuo.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   feedsubscription.OwnerTable,
+			Columns: []string{feedsubscription.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fsuo.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   feedsubscription.ItemsTable,
+			Columns: []string{feedsubscription.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(feeditem.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fsuo.mutation.RemovedItemsIDs(); len(nodes) > 0 && !fsuo.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   feedsubscription.ItemsTable,
+			Columns: []string{feedsubscription.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(feeditem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fsuo.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   feedsubscription.ItemsTable,
+			Columns: []string{feedsubscription.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(feeditem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &FeedSubscription{config: fsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, fsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{feedsubscription.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	fsuo.mutation.done = true
+	return _node, nil
+}
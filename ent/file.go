@@ -67,9 +67,13 @@ type FileEdges struct {
 	Shares []*Share `json:"shares,omitempty"`
 	// DirectLinks holds the value of the direct_links edge.
 	DirectLinks []*DirectLink `json:"direct_links,omitempty"`
+	// Activities holds the value of the activities edge.
+	Activities []*FileActivity `json:"activities,omitempty"`
+	// Albums holds the value of the albums edge.
+	Albums []*Album `json:"albums,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [8]bool
+	loadedTypes [10]bool
 }
 
 // OwnerOrErr returns the Owner value or an error if the edge
@@ -156,6 +160,24 @@ func (e FileEdges) DirectLinksOrErr() ([]*DirectLink, error) {
 	return nil, &NotLoadedError{edge: "direct_links"}
 }
 
+// ActivitiesOrErr returns the Activities value or an error if the edge
+// was not loaded in eager-loading.
+func (e FileEdges) ActivitiesOrErr() ([]*FileActivity, error) {
+	if e.loadedTypes[8] {
+		return e.Activities, nil
+	}
+	return nil, &NotLoadedError{edge: "activities"}
+}
+
+// AlbumsOrErr returns the Albums value or an error if the edge
+// was not loaded in eager-loading.
+func (e FileEdges) AlbumsOrErr() ([]*Album, error) {
+	if e.loadedTypes[9] {
+		return e.Albums, nil
+	}
+	return nil, &NotLoadedError{edge: "albums"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*File) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -313,6 +335,16 @@ func (f *File) QueryDirectLinks() *DirectLinkQuery {
 	return NewFileClient(f.config).QueryDirectLinks(f)
 }
 
+// QueryActivities queries the "activities" edge of the File entity.
+func (f *File) QueryActivities() *FileActivityQuery {
+	return NewFileClient(f.config).QueryActivities(f)
+}
+
+// QueryAlbums queries the "albums" edge of the File entity.
+func (f *File) QueryAlbums() *AlbumQuery {
+	return NewFileClient(f.config).QueryAlbums(f)
+}
+
 // Update returns a builder for updating this File.
 // Note that you need to call File.Unwrap() before calling this method if this File
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -420,5 +452,17 @@ func (e *File) SetDirectLinks(v []*DirectLink) {
 	e.Edges.loadedTypes[7] = true
 }
 
+// SetActivities manually set the edge as loaded state.
+func (e *File) SetActivities(v []*FileActivity) {
+	e.Edges.Activities = v
+	e.Edges.loadedTypes[8] = true
+}
+
+// SetAlbums manually set the edge as loaded state.
+func (e *File) SetAlbums(v []*Album) {
+	e.Edges.Albums = v
+	e.Edges.loadedTypes[9] = true
+}
+
 // Files is a parsable slice of File.
 type Files []*File
@@ -53,6 +53,10 @@ const (
 	EdgeShares = "shares"
 	// EdgeDirectLinks holds the string denoting the direct_links edge name in mutations.
 	EdgeDirectLinks = "direct_links"
+	// EdgeActivities holds the string denoting the activities edge name in mutations.
+	EdgeActivities = "activities"
+	// EdgeAlbums holds the string denoting the albums edge name in mutations.
+	EdgeAlbums = "albums"
 	// Table holds the table name of the file in the database.
 	Table = "files"
 	// OwnerTable is the table that holds the owner relation/edge.
@@ -103,6 +107,18 @@ const (
 	DirectLinksInverseTable = "direct_links"
 	// DirectLinksColumn is the table column denoting the direct_links relation/edge.
 	DirectLinksColumn = "file_id"
+	// ActivitiesTable is the table that holds the activities relation/edge.
+	ActivitiesTable = "file_activities"
+	// ActivitiesInverseTable is the table name for the FileActivity entity.
+	// It exists in this package in order to avoid circular dependency with the "fileactivity" package.
+	ActivitiesInverseTable = "file_activities"
+	// ActivitiesColumn is the table column denoting the activities relation/edge.
+	ActivitiesColumn = "file_id"
+	// AlbumsTable is the table that holds the albums relation/edge. The primary key declared below.
+	AlbumsTable = "album_files"
+	// AlbumsInverseTable is the table name for the Album entity.
+	// It exists in this package in order to avoid circular dependency with the "album" package.
+	AlbumsInverseTable = "albums"
 )
 
 // Columns holds all SQL columns for file fields.
@@ -125,6 +141,9 @@ var (
 	// EntitiesPrimaryKey and EntitiesColumn2 are the table columns denoting the
 	// primary key for the entities relation (M2M).
 	EntitiesPrimaryKey = []string{"file_id", "entity_id"}
+	// AlbumsPrimaryKey and AlbumsColumn2 are the table columns denoting the
+	// primary key for the albums relation (M2M).
+	AlbumsPrimaryKey = []string{"album_id", "file_id"}
 )
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -302,6 +321,34 @@ func ByDirectLinks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newDirectLinksStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByActivitiesCount orders the results by activities count.
+func ByActivitiesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newActivitiesStep(), opts...)
+	}
+}
+
+// ByActivities orders the results by activities terms.
+func ByActivities(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newActivitiesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAlbumsCount orders the results by albums count.
+func ByAlbumsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAlbumsStep(), opts...)
+	}
+}
+
+// ByAlbums orders the results by albums terms.
+func ByAlbums(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAlbumsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newOwnerStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -358,3 +405,17 @@ func newDirectLinksStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, DirectLinksTable, DirectLinksColumn),
 	)
 }
+func newActivitiesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ActivitiesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ActivitiesTable, ActivitiesColumn),
+	)
+}
+func newAlbumsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AlbumsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, AlbumsTable, AlbumsPrimaryKey...),
+	)
+}
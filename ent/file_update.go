@@ -11,9 +11,11 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/album"
 	"github.com/cloudreve/Cloudreve/v4/ent/directlink"
 	"github.com/cloudreve/Cloudreve/v4/ent/entity"
 	"github.com/cloudreve/Cloudreve/v4/ent/file"
+	"github.com/cloudreve/Cloudreve/v4/ent/fileactivity"
 	"github.com/cloudreve/Cloudreve/v4/ent/metadata"
 	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
 	"github.com/cloudreve/Cloudreve/v4/ent/share"
@@ -330,6 +332,36 @@ func (fu *FileUpdate) AddDirectLinks(d ...*DirectLink) *FileUpdate {
 	return fu.AddDirectLinkIDs(ids...)
 }
 
+// AddActivityIDs adds the "activities" edge to the FileActivity entity by IDs.
+func (fu *FileUpdate) AddActivityIDs(ids ...int) *FileUpdate {
+	fu.mutation.AddActivityIDs(ids...)
+	return fu
+}
+
+// AddActivities adds the "activities" edges to the FileActivity entity.
+func (fu *FileUpdate) AddActivities(f ...*FileActivity) *FileUpdate {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return fu.AddActivityIDs(ids...)
+}
+
+// AddAlbumIDs adds the "albums" edge to the Album entity by IDs.
+func (fu *FileUpdate) AddAlbumIDs(ids ...int) *FileUpdate {
+	fu.mutation.AddAlbumIDs(ids...)
+	return fu
+}
+
+// AddAlbums adds the "albums" edges to the Album entity.
+func (fu *FileUpdate) AddAlbums(a ...*Album) *FileUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return fu.AddAlbumIDs(ids...)
+}
+
 // Mutation returns the FileMutation object of the builder.
 func (fu *FileUpdate) Mutation() *FileMutation {
 	return fu.mutation
@@ -458,6 +490,48 @@ func (fu *FileUpdate) RemoveDirectLinks(d ...*DirectLink) *FileUpdate {
 	return fu.RemoveDirectLinkIDs(ids...)
 }
 
+// ClearActivities clears all "activities" edges to the FileActivity entity.
+func (fu *FileUpdate) ClearActivities() *FileUpdate {
+	fu.mutation.ClearActivities()
+	return fu
+}
+
+// RemoveActivityIDs removes the "activities" edge to FileActivity entities by IDs.
+func (fu *FileUpdate) RemoveActivityIDs(ids ...int) *FileUpdate {
+	fu.mutation.RemoveActivityIDs(ids...)
+	return fu
+}
+
+// RemoveActivities removes "activities" edges to FileActivity entities.
+func (fu *FileUpdate) RemoveActivities(f ...*FileActivity) *FileUpdate {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return fu.RemoveActivityIDs(ids...)
+}
+
+// ClearAlbums clears all "albums" edges to the Album entity.
+func (fu *FileUpdate) ClearAlbums() *FileUpdate {
+	fu.mutation.ClearAlbums()
+	return fu
+}
+
+// RemoveAlbumIDs removes the "albums" edge to Album entities by IDs.
+func (fu *FileUpdate) RemoveAlbumIDs(ids ...int) *FileUpdate {
+	fu.mutation.RemoveAlbumIDs(ids...)
+	return fu
+}
+
+// RemoveAlbums removes "albums" edges to Album entities.
+func (fu *FileUpdate) RemoveAlbums(a ...*Album) *FileUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return fu.RemoveAlbumIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (fu *FileUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, fu.sqlSave, fu.mutation, fu.hooks)
@@ -853,6 +927,96 @@ func (fu *FileUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if fu.mutation.ActivitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.ActivitiesTable,
+			Columns: []string{file.ActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fileactivity.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fu.mutation.RemovedActivitiesIDs(); len(nodes) > 0 && !fu.mutation.ActivitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.ActivitiesTable,
+			Columns: []string{file.ActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fileactivity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fu.mutation.ActivitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.ActivitiesTable,
+			Columns: []string{file.ActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fileactivity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fu.mutation.AlbumsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   file.AlbumsTable,
+			Columns: file.AlbumsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(album.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fu.mutation.RemovedAlbumsIDs(); len(nodes) > 0 && !fu.mutation.AlbumsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   file.AlbumsTable,
+			Columns: file.AlbumsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(album.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fu.mutation.AlbumsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   file.AlbumsTable,
+			Columns: file.AlbumsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(album.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, fu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{file.Label}
@@ -1168,6 +1332,36 @@ func (fuo *FileUpdateOne) AddDirectLinks(d ...*DirectLink) *FileUpdateOne {
 	return fuo.AddDirectLinkIDs(ids...)
 }
 
+// AddActivityIDs adds the "activities" edge to the FileActivity entity by IDs.
+func (fuo *FileUpdateOne) AddActivityIDs(ids ...int) *FileUpdateOne {
+	fuo.mutation.AddActivityIDs(ids...)
+	return fuo
+}
+
+// AddActivities adds the "activities" edges to the FileActivity entity.
+func (fuo *FileUpdateOne) AddActivities(f ...*FileActivity) *FileUpdateOne {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return fuo.AddActivityIDs(ids...)
+}
+
+// AddAlbumIDs adds the "albums" edge to the Album entity by IDs.
+func (fuo *FileUpdateOne) AddAlbumIDs(ids ...int) *FileUpdateOne {
+	fuo.mutation.AddAlbumIDs(ids...)
+	return fuo
+}
+
+// AddAlbums adds the "albums" edges to the Album entity.
+func (fuo *FileUpdateOne) AddAlbums(a ...*Album) *FileUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return fuo.AddAlbumIDs(ids...)
+}
+
 // Mutation returns the FileMutation object of the builder.
 func (fuo *FileUpdateOne) Mutation() *FileMutation {
 	return fuo.mutation
@@ -1296,6 +1490,48 @@ func (fuo *FileUpdateOne) RemoveDirectLinks(d ...*DirectLink) *FileUpdateOne {
 	return fuo.RemoveDirectLinkIDs(ids...)
 }
 
+// ClearActivities clears all "activities" edges to the FileActivity entity.
+func (fuo *FileUpdateOne) ClearActivities() *FileUpdateOne {
+	fuo.mutation.ClearActivities()
+	return fuo
+}
+
+// RemoveActivityIDs removes the "activities" edge to FileActivity entities by IDs.
+func (fuo *FileUpdateOne) RemoveActivityIDs(ids ...int) *FileUpdateOne {
+	fuo.mutation.RemoveActivityIDs(ids...)
+	return fuo
+}
+
+// RemoveActivities removes "activities" edges to FileActivity entities.
+func (fuo *FileUpdateOne) RemoveActivities(f ...*FileActivity) *FileUpdateOne {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return fuo.RemoveActivityIDs(ids...)
+}
+
+// ClearAlbums clears all "albums" edges to the Album entity.
+func (fuo *FileUpdateOne) ClearAlbums() *FileUpdateOne {
+	fuo.mutation.ClearAlbums()
+	return fuo
+}
+
+// RemoveAlbumIDs removes the "albums" edge to Album entities by IDs.
+func (fuo *FileUpdateOne) RemoveAlbumIDs(ids ...int) *FileUpdateOne {
+	fuo.mutation.RemoveAlbumIDs(ids...)
+	return fuo
+}
+
+// RemoveAlbums removes "albums" edges to Album entities.
+func (fuo *FileUpdateOne) RemoveAlbums(a ...*Album) *FileUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return fuo.RemoveAlbumIDs(ids...)
+}
+
 // Where appends a list predicates to the FileUpdate builder.
 func (fuo *FileUpdateOne) Where(ps ...predicate.File) *FileUpdateOne {
 	fuo.mutation.Where(ps...)
@@ -1721,6 +1957,96 @@ func (fuo *FileUpdateOne) sqlSave(ctx context.Context) (_node *File, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if fuo.mutation.ActivitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.ActivitiesTable,
+			Columns: []string{file.ActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fileactivity.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fuo.mutation.RemovedActivitiesIDs(); len(nodes) > 0 && !fuo.mutation.ActivitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.ActivitiesTable,
+			Columns: []string{file.ActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fileactivity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fuo.mutation.ActivitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.ActivitiesTable,
+			Columns: []string{file.ActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fileactivity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fuo.mutation.AlbumsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   file.AlbumsTable,
+			Columns: file.AlbumsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(album.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fuo.mutation.RemovedAlbumsIDs(); len(nodes) > 0 && !fuo.mutation.AlbumsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   file.AlbumsTable,
+			Columns: file.AlbumsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(album.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fuo.mutation.AlbumsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   file.AlbumsTable,
+			Columns: file.AlbumsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(album.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &File{config: fuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
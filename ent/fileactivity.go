@@ -0,0 +1,251 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent/file"
+	"github.com/cloudreve/Cloudreve/v4/ent/fileactivity"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+)
+
+// FileActivity is the model entity for the FileActivity schema.
+type FileActivity struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Action holds the value of the "action" field.
+	Action int `json:"action,omitempty"`
+	// AccessedAt holds the value of the "accessed_at" field.
+	AccessedAt time.Time `json:"accessed_at,omitempty"`
+	// OpenedAt holds the value of the "opened_at" field.
+	OpenedAt *time.Time `json:"opened_at,omitempty"`
+	// FileID holds the value of the "file_id" field.
+	FileID int `json:"file_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the FileActivityQuery when eager-loading is set.
+	Edges        FileActivityEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// FileActivityEdges holds the relations/edges for other nodes in the graph.
+type FileActivityEdges struct {
+	// File holds the value of the file edge.
+	File *File `json:"file,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// FileOrErr returns the File value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FileActivityEdges) FileOrErr() (*File, error) {
+	if e.loadedTypes[0] {
+		if e.File == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: file.Label}
+		}
+		return e.File, nil
+	}
+	return nil, &NotLoadedError{edge: "file"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FileActivityEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[1] {
+		if e.User == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*FileActivity) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case fileactivity.FieldID, fileactivity.FieldAction, fileactivity.FieldFileID, fileactivity.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case fileactivity.FieldCreatedAt, fileactivity.FieldUpdatedAt, fileactivity.FieldDeletedAt, fileactivity.FieldAccessedAt, fileactivity.FieldOpenedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the FileActivity fields.
+func (fa *FileActivity) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case fileactivity.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			fa.ID = int(value.Int64)
+		case fileactivity.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				fa.CreatedAt = value.Time
+			}
+		case fileactivity.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				fa.UpdatedAt = value.Time
+			}
+		case fileactivity.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				fa.DeletedAt = new(time.Time)
+				*fa.DeletedAt = value.Time
+			}
+		case fileactivity.FieldAction:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				fa.Action = int(value.Int64)
+			}
+		case fileactivity.FieldAccessedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field accessed_at", values[i])
+			} else if value.Valid {
+				fa.AccessedAt = value.Time
+			}
+		case fileactivity.FieldOpenedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field opened_at", values[i])
+			} else if value.Valid {
+				fa.OpenedAt = new(time.Time)
+				*fa.OpenedAt = value.Time
+			}
+		case fileactivity.FieldFileID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field file_id", values[i])
+			} else if value.Valid {
+				fa.FileID = int(value.Int64)
+			}
+		case fileactivity.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				fa.UserID = int(value.Int64)
+			}
+		default:
+			fa.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the FileActivity.
+// This includes values selected through modifiers, order, etc.
+func (fa *FileActivity) Value(name string) (ent.Value, error) {
+	return fa.selectValues.Get(name)
+}
+
+// QueryFile queries the "file" edge of the FileActivity entity.
+func (fa *FileActivity) QueryFile() *FileQuery {
+	return NewFileActivityClient(fa.config).QueryFile(fa)
+}
+
+// QueryUser queries the "user" edge of the FileActivity entity.
+func (fa *FileActivity) QueryUser() *UserQuery {
+	return NewFileActivityClient(fa.config).QueryUser(fa)
+}
+
+// Update returns a builder for updating this FileActivity.
+// Note that you need to call FileActivity.Unwrap() before calling this method if this FileActivity
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (fa *FileActivity) Update() *FileActivityUpdateOne {
+	return NewFileActivityClient(fa.config).UpdateOne(fa)
+}
+
+// Unwrap unwraps the FileActivity entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (fa *FileActivity) Unwrap() *FileActivity {
+	_tx, ok := fa.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FileActivity is not a transactional entity")
+	}
+	fa.config.driver = _tx.drv
+	return fa
+}
+
+// String implements the fmt.Stringer.
+func (fa *FileActivity) String() string {
+	var builder strings.Builder
+	builder.WriteString("FileActivity(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", fa.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(fa.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fa.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := fa.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(fmt.Sprintf("%v", fa.Action))
+	builder.WriteString(", ")
+	builder.WriteString("accessed_at=")
+	builder.WriteString(fa.AccessedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := fa.OpenedAt; v != nil {
+		builder.WriteString("opened_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("file_id=")
+	builder.WriteString(fmt.Sprintf("%v", fa.FileID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", fa.UserID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SetFile manually set the edge as loaded state.
+func (e *FileActivity) SetFile(v *File) {
+	e.Edges.File = v
+	e.Edges.loadedTypes[0] = true
+}
+
+// SetUser manually set the edge as loaded state.
+func (e *FileActivity) SetUser(v *User) {
+	e.Edges.User = v
+	e.Edges.loadedTypes[1] = true
+}
+
+// FileActivities is a parsable slice of FileActivity.
+type FileActivities []*FileActivity
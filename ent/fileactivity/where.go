@@ -0,0 +1,457 @@
+// Code generated by ent, DO NOT EDIT.
+
+package fileactivity
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// Action applies equality check predicate on the "action" field. It's identical to ActionEQ.
+func Action(v int) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldEQ(FieldAction, v))
+}
+
+// AccessedAt applies equality check predicate on the "accessed_at" field. It's identical to AccessedAtEQ.
+func AccessedAt(v time.Time) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldEQ(FieldAccessedAt, v))
+}
+
+// OpenedAt applies equality check predicate on the "opened_at" field. It's identical to OpenedAtEQ.
+func OpenedAt(v time.Time) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldEQ(FieldOpenedAt, v))
+}
+
+// FileID applies equality check predicate on the "file_id" field. It's identical to FileIDEQ.
+func FileID(v int) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldEQ(FieldFileID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldEQ(FieldUserID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v int) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v int) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...int) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...int) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// ActionGT applies the GT predicate on the "action" field.
+func ActionGT(v int) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldGT(FieldAction, v))
+}
+
+// ActionGTE applies the GTE predicate on the "action" field.
+func ActionGTE(v int) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldGTE(FieldAction, v))
+}
+
+// ActionLT applies the LT predicate on the "action" field.
+func ActionLT(v int) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldLT(FieldAction, v))
+}
+
+// ActionLTE applies the LTE predicate on the "action" field.
+func ActionLTE(v int) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldLTE(FieldAction, v))
+}
+
+// AccessedAtEQ applies the EQ predicate on the "accessed_at" field.
+func AccessedAtEQ(v time.Time) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldEQ(FieldAccessedAt, v))
+}
+
+// AccessedAtNEQ applies the NEQ predicate on the "accessed_at" field.
+func AccessedAtNEQ(v time.Time) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldNEQ(FieldAccessedAt, v))
+}
+
+// AccessedAtIn applies the In predicate on the "accessed_at" field.
+func AccessedAtIn(vs ...time.Time) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldIn(FieldAccessedAt, vs...))
+}
+
+// AccessedAtNotIn applies the NotIn predicate on the "accessed_at" field.
+func AccessedAtNotIn(vs ...time.Time) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldNotIn(FieldAccessedAt, vs...))
+}
+
+// AccessedAtGT applies the GT predicate on the "accessed_at" field.
+func AccessedAtGT(v time.Time) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldGT(FieldAccessedAt, v))
+}
+
+// AccessedAtGTE applies the GTE predicate on the "accessed_at" field.
+func AccessedAtGTE(v time.Time) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldGTE(FieldAccessedAt, v))
+}
+
+// AccessedAtLT applies the LT predicate on the "accessed_at" field.
+func AccessedAtLT(v time.Time) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldLT(FieldAccessedAt, v))
+}
+
+// AccessedAtLTE applies the LTE predicate on the "accessed_at" field.
+func AccessedAtLTE(v time.Time) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldLTE(FieldAccessedAt, v))
+}
+
+// OpenedAtEQ applies the EQ predicate on the "opened_at" field.
+func OpenedAtEQ(v time.Time) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldEQ(FieldOpenedAt, v))
+}
+
+// OpenedAtNEQ applies the NEQ predicate on the "opened_at" field.
+func OpenedAtNEQ(v time.Time) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldNEQ(FieldOpenedAt, v))
+}
+
+// OpenedAtIn applies the In predicate on the "opened_at" field.
+func OpenedAtIn(vs ...time.Time) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldIn(FieldOpenedAt, vs...))
+}
+
+// OpenedAtNotIn applies the NotIn predicate on the "opened_at" field.
+func OpenedAtNotIn(vs ...time.Time) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldNotIn(FieldOpenedAt, vs...))
+}
+
+// OpenedAtGT applies the GT predicate on the "opened_at" field.
+func OpenedAtGT(v time.Time) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldGT(FieldOpenedAt, v))
+}
+
+// OpenedAtGTE applies the GTE predicate on the "opened_at" field.
+func OpenedAtGTE(v time.Time) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldGTE(FieldOpenedAt, v))
+}
+
+// OpenedAtLT applies the LT predicate on the "opened_at" field.
+func OpenedAtLT(v time.Time) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldLT(FieldOpenedAt, v))
+}
+
+// OpenedAtLTE applies the LTE predicate on the "opened_at" field.
+func OpenedAtLTE(v time.Time) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldLTE(FieldOpenedAt, v))
+}
+
+// OpenedAtIsNil applies the IsNil predicate on the "opened_at" field.
+func OpenedAtIsNil() predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldIsNull(FieldOpenedAt))
+}
+
+// OpenedAtNotNil applies the NotNil predicate on the "opened_at" field.
+func OpenedAtNotNil() predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldNotNull(FieldOpenedAt))
+}
+
+// FileIDEQ applies the EQ predicate on the "file_id" field.
+func FileIDEQ(v int) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldEQ(FieldFileID, v))
+}
+
+// FileIDNEQ applies the NEQ predicate on the "file_id" field.
+func FileIDNEQ(v int) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldNEQ(FieldFileID, v))
+}
+
+// FileIDIn applies the In predicate on the "file_id" field.
+func FileIDIn(vs ...int) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldIn(FieldFileID, vs...))
+}
+
+// FileIDNotIn applies the NotIn predicate on the "file_id" field.
+func FileIDNotIn(vs ...int) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldNotIn(FieldFileID, vs...))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.FileActivity {
+	return predicate.FileActivity(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// HasFile applies the HasEdge predicate on the "file" edge.
+func HasFile() predicate.FileActivity {
+	return predicate.FileActivity(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, FileTable, FileColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFileWith applies the HasEdge predicate on the "file" edge with a given conditions (other predicates).
+func HasFileWith(preds ...predicate.File) predicate.FileActivity {
+	return predicate.FileActivity(func(s *sql.Selector) {
+		step := newFileStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.FileActivity {
+	return predicate.FileActivity(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.FileActivity {
+	return predicate.FileActivity(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.FileActivity) predicate.FileActivity {
+	return predicate.FileActivity(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.FileActivity) predicate.FileActivity {
+	return predicate.FileActivity(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.FileActivity) predicate.FileActivity {
+	return predicate.FileActivity(sql.NotPredicates(p))
+}
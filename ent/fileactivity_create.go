@@ -0,0 +1,978 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/file"
+	"github.com/cloudreve/Cloudreve/v4/ent/fileactivity"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+)
+
+// FileActivityCreate is the builder for creating a FileActivity entity.
+type FileActivityCreate struct {
+	config
+	mutation *FileActivityMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (fac *FileActivityCreate) SetCreatedAt(t time.Time) *FileActivityCreate {
+	fac.mutation.SetCreatedAt(t)
+	return fac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (fac *FileActivityCreate) SetNillableCreatedAt(t *time.Time) *FileActivityCreate {
+	if t != nil {
+		fac.SetCreatedAt(*t)
+	}
+	return fac
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (fac *FileActivityCreate) SetUpdatedAt(t time.Time) *FileActivityCreate {
+	fac.mutation.SetUpdatedAt(t)
+	return fac
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (fac *FileActivityCreate) SetNillableUpdatedAt(t *time.Time) *FileActivityCreate {
+	if t != nil {
+		fac.SetUpdatedAt(*t)
+	}
+	return fac
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (fac *FileActivityCreate) SetDeletedAt(t time.Time) *FileActivityCreate {
+	fac.mutation.SetDeletedAt(t)
+	return fac
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (fac *FileActivityCreate) SetNillableDeletedAt(t *time.Time) *FileActivityCreate {
+	if t != nil {
+		fac.SetDeletedAt(*t)
+	}
+	return fac
+}
+
+// SetAction sets the "action" field.
+func (fac *FileActivityCreate) SetAction(i int) *FileActivityCreate {
+	fac.mutation.SetAction(i)
+	return fac
+}
+
+// SetAccessedAt sets the "accessed_at" field.
+func (fac *FileActivityCreate) SetAccessedAt(t time.Time) *FileActivityCreate {
+	fac.mutation.SetAccessedAt(t)
+	return fac
+}
+
+// SetNillableAccessedAt sets the "accessed_at" field if the given value is not nil.
+func (fac *FileActivityCreate) SetNillableAccessedAt(t *time.Time) *FileActivityCreate {
+	if t != nil {
+		fac.SetAccessedAt(*t)
+	}
+	return fac
+}
+
+// SetOpenedAt sets the "opened_at" field.
+func (fac *FileActivityCreate) SetOpenedAt(t time.Time) *FileActivityCreate {
+	fac.mutation.SetOpenedAt(t)
+	return fac
+}
+
+// SetNillableOpenedAt sets the "opened_at" field if the given value is not nil.
+func (fac *FileActivityCreate) SetNillableOpenedAt(t *time.Time) *FileActivityCreate {
+	if t != nil {
+		fac.SetOpenedAt(*t)
+	}
+	return fac
+}
+
+// SetFileID sets the "file_id" field.
+func (fac *FileActivityCreate) SetFileID(i int) *FileActivityCreate {
+	fac.mutation.SetFileID(i)
+	return fac
+}
+
+// SetUserID sets the "user_id" field.
+func (fac *FileActivityCreate) SetUserID(i int) *FileActivityCreate {
+	fac.mutation.SetUserID(i)
+	return fac
+}
+
+// SetFile sets the "file" edge to the File entity.
+func (fac *FileActivityCreate) SetFile(f *File) *FileActivityCreate {
+	return fac.SetFileID(f.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (fac *FileActivityCreate) SetUser(u *User) *FileActivityCreate {
+	return fac.SetUserID(u.ID)
+}
+
+// Mutation returns the FileActivityMutation object of the builder.
+func (fac *FileActivityCreate) Mutation() *FileActivityMutation {
+	return fac.mutation
+}
+
+// Save creates the FileActivity in the database.
+func (fac *FileActivityCreate) Save(ctx context.Context) (*FileActivity, error) {
+	if err := fac.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, fac.sqlSave, fac.mutation, fac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (fac *FileActivityCreate) SaveX(ctx context.Context) *FileActivity {
+	v, err := fac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (fac *FileActivityCreate) Exec(ctx context.Context) error {
+	_, err := fac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fac *FileActivityCreate) ExecX(ctx context.Context) {
+	if err := fac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (fac *FileActivityCreate) defaults() error {
+	if _, ok := fac.mutation.CreatedAt(); !ok {
+		if fileactivity.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized fileactivity.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := fileactivity.DefaultCreatedAt()
+		fac.mutation.SetCreatedAt(v)
+	}
+	if _, ok := fac.mutation.UpdatedAt(); !ok {
+		if fileactivity.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized fileactivity.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := fileactivity.DefaultUpdatedAt()
+		fac.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := fac.mutation.AccessedAt(); !ok {
+		if fileactivity.DefaultAccessedAt == nil {
+			return fmt.Errorf("ent: uninitialized fileactivity.DefaultAccessedAt (forgotten import ent/runtime?)")
+		}
+		v := fileactivity.DefaultAccessedAt()
+		fac.mutation.SetAccessedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fac *FileActivityCreate) check() error {
+	if _, ok := fac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "FileActivity.created_at"`)}
+	}
+	if _, ok := fac.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "FileActivity.updated_at"`)}
+	}
+	if _, ok := fac.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "FileActivity.action"`)}
+	}
+	if _, ok := fac.mutation.AccessedAt(); !ok {
+		return &ValidationError{Name: "accessed_at", err: errors.New(`ent: missing required field "FileActivity.accessed_at"`)}
+	}
+	if _, ok := fac.mutation.FileID(); !ok {
+		return &ValidationError{Name: "file_id", err: errors.New(`ent: missing required field "FileActivity.file_id"`)}
+	}
+	if _, ok := fac.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "FileActivity.user_id"`)}
+	}
+	if _, ok := fac.mutation.FileID(); !ok {
+		return &ValidationError{Name: "file", err: errors.New(`ent: missing required edge "FileActivity.file"`)}
+	}
+	if _, ok := fac.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "FileActivity.user"`)}
+	}
+	return nil
+}
+
+func (fac *FileActivityCreate) sqlSave(ctx context.Context) (*FileActivity, error) {
+	if err := fac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := fac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, fac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	fac.mutation.id = &_node.ID
+	fac.mutation.done = true
+	return _node, nil
+}
+
+func (fac *FileActivityCreate) createSpec() (*FileActivity, *sqlgraph.CreateSpec) {
+	var (
+		_node = &FileActivity{config: fac.config}
+		_spec = sqlgraph.NewCreateSpec(fileactivity.Table, sqlgraph.NewFieldSpec(fileactivity.FieldID, field.TypeInt))
+	)
+
+	if id, ok := fac.mutation.ID(); ok {
+		_node.ID = id
+		id64 := int64(id)
+		_spec.ID.Value = id64
+	}
+
+	_spec.OnConflict = fac.conflict
+	if value, ok := fac.mutation.CreatedAt(); ok {
+		_spec.SetField(fileactivity.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := fac.mutation.UpdatedAt(); ok {
+		_spec.SetField(fileactivity.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := fac.mutation.DeletedAt(); ok {
+		_spec.SetField(fileactivity.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := fac.mutation.Action(); ok {
+		_spec.SetField(fileactivity.FieldAction, field.TypeInt, value)
+		_node.Action = value
+	}
+	if value, ok := fac.mutation.AccessedAt(); ok {
+		_spec.SetField(fileactivity.FieldAccessedAt, field.TypeTime, value)
+		_node.AccessedAt = value
+	}
+	if value, ok := fac.mutation.OpenedAt(); ok {
+		_spec.SetField(fileactivity.FieldOpenedAt, field.TypeTime, value)
+		_node.OpenedAt = &value
+	}
+	if nodes := fac.mutation.FileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   fileactivity.FileTable,
+			Columns: []string{fileactivity.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.FileID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := fac.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   fileactivity.UserTable,
+			Columns: []string{fileactivity.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.FileActivity.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.FileActivityUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (fac *FileActivityCreate) OnConflict(opts ...sql.ConflictOption) *FileActivityUpsertOne {
+	fac.conflict = opts
+	return &FileActivityUpsertOne{
+		create: fac,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.FileActivity.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (fac *FileActivityCreate) OnConflictColumns(columns ...string) *FileActivityUpsertOne {
+	fac.conflict = append(fac.conflict, sql.ConflictColumns(columns...))
+	return &FileActivityUpsertOne{
+		create: fac,
+	}
+}
+
+type (
+	// FileActivityUpsertOne is the builder for "upsert"-ing
+	//  one FileActivity node.
+	FileActivityUpsertOne struct {
+		create *FileActivityCreate
+	}
+
+	// FileActivityUpsert is the "OnConflict" setter.
+	FileActivityUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *FileActivityUpsert) SetUpdatedAt(v time.Time) *FileActivityUpsert {
+	u.Set(fileactivity.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *FileActivityUpsert) UpdateUpdatedAt() *FileActivityUpsert {
+	u.SetExcluded(fileactivity.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *FileActivityUpsert) SetDeletedAt(v time.Time) *FileActivityUpsert {
+	u.Set(fileactivity.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *FileActivityUpsert) UpdateDeletedAt() *FileActivityUpsert {
+	u.SetExcluded(fileactivity.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *FileActivityUpsert) ClearDeletedAt() *FileActivityUpsert {
+	u.SetNull(fileactivity.FieldDeletedAt)
+	return u
+}
+
+// SetAction sets the "action" field.
+func (u *FileActivityUpsert) SetAction(v int) *FileActivityUpsert {
+	u.Set(fileactivity.FieldAction, v)
+	return u
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *FileActivityUpsert) UpdateAction() *FileActivityUpsert {
+	u.SetExcluded(fileactivity.FieldAction)
+	return u
+}
+
+// AddAction adds v to the "action" field.
+func (u *FileActivityUpsert) AddAction(v int) *FileActivityUpsert {
+	u.Add(fileactivity.FieldAction, v)
+	return u
+}
+
+// SetAccessedAt sets the "accessed_at" field.
+func (u *FileActivityUpsert) SetAccessedAt(v time.Time) *FileActivityUpsert {
+	u.Set(fileactivity.FieldAccessedAt, v)
+	return u
+}
+
+// UpdateAccessedAt sets the "accessed_at" field to the value that was provided on create.
+func (u *FileActivityUpsert) UpdateAccessedAt() *FileActivityUpsert {
+	u.SetExcluded(fileactivity.FieldAccessedAt)
+	return u
+}
+
+// SetOpenedAt sets the "opened_at" field.
+func (u *FileActivityUpsert) SetOpenedAt(v time.Time) *FileActivityUpsert {
+	u.Set(fileactivity.FieldOpenedAt, v)
+	return u
+}
+
+// UpdateOpenedAt sets the "opened_at" field to the value that was provided on create.
+func (u *FileActivityUpsert) UpdateOpenedAt() *FileActivityUpsert {
+	u.SetExcluded(fileactivity.FieldOpenedAt)
+	return u
+}
+
+// ClearOpenedAt clears the value of the "opened_at" field.
+func (u *FileActivityUpsert) ClearOpenedAt() *FileActivityUpsert {
+	u.SetNull(fileactivity.FieldOpenedAt)
+	return u
+}
+
+// SetFileID sets the "file_id" field.
+func (u *FileActivityUpsert) SetFileID(v int) *FileActivityUpsert {
+	u.Set(fileactivity.FieldFileID, v)
+	return u
+}
+
+// UpdateFileID sets the "file_id" field to the value that was provided on create.
+func (u *FileActivityUpsert) UpdateFileID() *FileActivityUpsert {
+	u.SetExcluded(fileactivity.FieldFileID)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *FileActivityUpsert) SetUserID(v int) *FileActivityUpsert {
+	u.Set(fileactivity.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *FileActivityUpsert) UpdateUserID() *FileActivityUpsert {
+	u.SetExcluded(fileactivity.FieldUserID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.FileActivity.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *FileActivityUpsertOne) UpdateNewValues() *FileActivityUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(fileactivity.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.FileActivity.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *FileActivityUpsertOne) Ignore() *FileActivityUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *FileActivityUpsertOne) DoNothing() *FileActivityUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the FileActivityCreate.OnConflict
+// documentation for more info.
+func (u *FileActivityUpsertOne) Update(set func(*FileActivityUpsert)) *FileActivityUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&FileActivityUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *FileActivityUpsertOne) SetUpdatedAt(v time.Time) *FileActivityUpsertOne {
+	return u.Update(func(s *FileActivityUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *FileActivityUpsertOne) UpdateUpdatedAt() *FileActivityUpsertOne {
+	return u.Update(func(s *FileActivityUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *FileActivityUpsertOne) SetDeletedAt(v time.Time) *FileActivityUpsertOne {
+	return u.Update(func(s *FileActivityUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *FileActivityUpsertOne) UpdateDeletedAt() *FileActivityUpsertOne {
+	return u.Update(func(s *FileActivityUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *FileActivityUpsertOne) ClearDeletedAt() *FileActivityUpsertOne {
+	return u.Update(func(s *FileActivityUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetAction sets the "action" field.
+func (u *FileActivityUpsertOne) SetAction(v int) *FileActivityUpsertOne {
+	return u.Update(func(s *FileActivityUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// AddAction adds v to the "action" field.
+func (u *FileActivityUpsertOne) AddAction(v int) *FileActivityUpsertOne {
+	return u.Update(func(s *FileActivityUpsert) {
+		s.AddAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *FileActivityUpsertOne) UpdateAction() *FileActivityUpsertOne {
+	return u.Update(func(s *FileActivityUpsert) {
+		s.UpdateAction()
+	})
+}
+
+// SetAccessedAt sets the "accessed_at" field.
+func (u *FileActivityUpsertOne) SetAccessedAt(v time.Time) *FileActivityUpsertOne {
+	return u.Update(func(s *FileActivityUpsert) {
+		s.SetAccessedAt(v)
+	})
+}
+
+// UpdateAccessedAt sets the "accessed_at" field to the value that was provided on create.
+func (u *FileActivityUpsertOne) UpdateAccessedAt() *FileActivityUpsertOne {
+	return u.Update(func(s *FileActivityUpsert) {
+		s.UpdateAccessedAt()
+	})
+}
+
+// SetOpenedAt sets the "opened_at" field.
+func (u *FileActivityUpsertOne) SetOpenedAt(v time.Time) *FileActivityUpsertOne {
+	return u.Update(func(s *FileActivityUpsert) {
+		s.SetOpenedAt(v)
+	})
+}
+
+// UpdateOpenedAt sets the "opened_at" field to the value that was provided on create.
+func (u *FileActivityUpsertOne) UpdateOpenedAt() *FileActivityUpsertOne {
+	return u.Update(func(s *FileActivityUpsert) {
+		s.UpdateOpenedAt()
+	})
+}
+
+// ClearOpenedAt clears the value of the "opened_at" field.
+func (u *FileActivityUpsertOne) ClearOpenedAt() *FileActivityUpsertOne {
+	return u.Update(func(s *FileActivityUpsert) {
+		s.ClearOpenedAt()
+	})
+}
+
+// SetFileID sets the "file_id" field.
+func (u *FileActivityUpsertOne) SetFileID(v int) *FileActivityUpsertOne {
+	return u.Update(func(s *FileActivityUpsert) {
+		s.SetFileID(v)
+	})
+}
+
+// UpdateFileID sets the "file_id" field to the value that was provided on create.
+func (u *FileActivityUpsertOne) UpdateFileID() *FileActivityUpsertOne {
+	return u.Update(func(s *FileActivityUpsert) {
+		s.UpdateFileID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *FileActivityUpsertOne) SetUserID(v int) *FileActivityUpsertOne {
+	return u.Update(func(s *FileActivityUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *FileActivityUpsertOne) UpdateUserID() *FileActivityUpsertOne {
+	return u.Update(func(s *FileActivityUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// Exec executes the query.
+func (u *FileActivityUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for FileActivityCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *FileActivityUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *FileActivityUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *FileActivityUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (m *FileActivityCreate) SetRawID(t int) *FileActivityCreate {
+	m.mutation.SetRawID(t)
+	return m
+}
+
+// FileActivityCreateBulk is the builder for creating many FileActivity entities in bulk.
+type FileActivityCreateBulk struct {
+	config
+	err      error
+	builders []*FileActivityCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the FileActivity entities in the database.
+func (facb *FileActivityCreateBulk) Save(ctx context.Context) ([]*FileActivity, error) {
+	if facb.err != nil {
+		return nil, facb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(facb.builders))
+	nodes := make([]*FileActivity, len(facb.builders))
+	mutators := make([]Mutator, len(facb.builders))
+	for i := range facb.builders {
+		func(i int, root context.Context) {
+			builder := facb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FileActivityMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, facb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = facb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, facb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, facb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (facb *FileActivityCreateBulk) SaveX(ctx context.Context) []*FileActivity {
+	v, err := facb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (facb *FileActivityCreateBulk) Exec(ctx context.Context) error {
+	_, err := facb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (facb *FileActivityCreateBulk) ExecX(ctx context.Context) {
+	if err := facb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.FileActivity.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.FileActivityUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (facb *FileActivityCreateBulk) OnConflict(opts ...sql.ConflictOption) *FileActivityUpsertBulk {
+	facb.conflict = opts
+	return &FileActivityUpsertBulk{
+		create: facb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.FileActivity.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (facb *FileActivityCreateBulk) OnConflictColumns(columns ...string) *FileActivityUpsertBulk {
+	facb.conflict = append(facb.conflict, sql.ConflictColumns(columns...))
+	return &FileActivityUpsertBulk{
+		create: facb,
+	}
+}
+
+// FileActivityUpsertBulk is the builder for "upsert"-ing
+// a bulk of FileActivity nodes.
+type FileActivityUpsertBulk struct {
+	create *FileActivityCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.FileActivity.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *FileActivityUpsertBulk) UpdateNewValues() *FileActivityUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(fileactivity.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.FileActivity.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *FileActivityUpsertBulk) Ignore() *FileActivityUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *FileActivityUpsertBulk) DoNothing() *FileActivityUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the FileActivityCreateBulk.OnConflict
+// documentation for more info.
+func (u *FileActivityUpsertBulk) Update(set func(*FileActivityUpsert)) *FileActivityUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&FileActivityUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *FileActivityUpsertBulk) SetUpdatedAt(v time.Time) *FileActivityUpsertBulk {
+	return u.Update(func(s *FileActivityUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *FileActivityUpsertBulk) UpdateUpdatedAt() *FileActivityUpsertBulk {
+	return u.Update(func(s *FileActivityUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *FileActivityUpsertBulk) SetDeletedAt(v time.Time) *FileActivityUpsertBulk {
+	return u.Update(func(s *FileActivityUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *FileActivityUpsertBulk) UpdateDeletedAt() *FileActivityUpsertBulk {
+	return u.Update(func(s *FileActivityUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *FileActivityUpsertBulk) ClearDeletedAt() *FileActivityUpsertBulk {
+	return u.Update(func(s *FileActivityUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetAction sets the "action" field.
+func (u *FileActivityUpsertBulk) SetAction(v int) *FileActivityUpsertBulk {
+	return u.Update(func(s *FileActivityUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// AddAction adds v to the "action" field.
+func (u *FileActivityUpsertBulk) AddAction(v int) *FileActivityUpsertBulk {
+	return u.Update(func(s *FileActivityUpsert) {
+		s.AddAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *FileActivityUpsertBulk) UpdateAction() *FileActivityUpsertBulk {
+	return u.Update(func(s *FileActivityUpsert) {
+		s.UpdateAction()
+	})
+}
+
+// SetAccessedAt sets the "accessed_at" field.
+func (u *FileActivityUpsertBulk) SetAccessedAt(v time.Time) *FileActivityUpsertBulk {
+	return u.Update(func(s *FileActivityUpsert) {
+		s.SetAccessedAt(v)
+	})
+}
+
+// UpdateAccessedAt sets the "accessed_at" field to the value that was provided on create.
+func (u *FileActivityUpsertBulk) UpdateAccessedAt() *FileActivityUpsertBulk {
+	return u.Update(func(s *FileActivityUpsert) {
+		s.UpdateAccessedAt()
+	})
+}
+
+// SetOpenedAt sets the "opened_at" field.
+func (u *FileActivityUpsertBulk) SetOpenedAt(v time.Time) *FileActivityUpsertBulk {
+	return u.Update(func(s *FileActivityUpsert) {
+		s.SetOpenedAt(v)
+	})
+}
+
+// UpdateOpenedAt sets the "opened_at" field to the value that was provided on create.
+func (u *FileActivityUpsertBulk) UpdateOpenedAt() *FileActivityUpsertBulk {
+	return u.Update(func(s *FileActivityUpsert) {
+		s.UpdateOpenedAt()
+	})
+}
+
+// ClearOpenedAt clears the value of the "opened_at" field.
+func (u *FileActivityUpsertBulk) ClearOpenedAt() *FileActivityUpsertBulk {
+	return u.Update(func(s *FileActivityUpsert) {
+		s.ClearOpenedAt()
+	})
+}
+
+// SetFileID sets the "file_id" field.
+func (u *FileActivityUpsertBulk) SetFileID(v int) *FileActivityUpsertBulk {
+	return u.Update(func(s *FileActivityUpsert) {
+		s.SetFileID(v)
+	})
+}
+
+// UpdateFileID sets the "file_id" field to the value that was provided on create.
+func (u *FileActivityUpsertBulk) UpdateFileID() *FileActivityUpsertBulk {
+	return u.Update(func(s *FileActivityUpsert) {
+		s.UpdateFileID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *FileActivityUpsertBulk) SetUserID(v int) *FileActivityUpsertBulk {
+	return u.Update(func(s *FileActivityUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *FileActivityUpsertBulk) UpdateUserID() *FileActivityUpsertBulk {
+	return u.Update(func(s *FileActivityUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// Exec executes the query.
+func (u *FileActivityUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the FileActivityCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for FileActivityCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *FileActivityUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/fileactivity"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+)
+
+// FileActivityDelete is the builder for deleting a FileActivity entity.
+type FileActivityDelete struct {
+	config
+	hooks    []Hook
+	mutation *FileActivityMutation
+}
+
+// Where appends a list predicates to the FileActivityDelete builder.
+func (fad *FileActivityDelete) Where(ps ...predicate.FileActivity) *FileActivityDelete {
+	fad.mutation.Where(ps...)
+	return fad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (fad *FileActivityDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, fad.sqlExec, fad.mutation, fad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fad *FileActivityDelete) ExecX(ctx context.Context) int {
+	n, err := fad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (fad *FileActivityDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(fileactivity.Table, sqlgraph.NewFieldSpec(fileactivity.FieldID, field.TypeInt))
+	if ps := fad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, fad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	fad.mutation.done = true
+	return affected, err
+}
+
+// FileActivityDeleteOne is the builder for deleting a single FileActivity entity.
+type FileActivityDeleteOne struct {
+	fad *FileActivityDelete
+}
+
+// Where appends a list predicates to the FileActivityDelete builder.
+func (fado *FileActivityDeleteOne) Where(ps ...predicate.FileActivity) *FileActivityDeleteOne {
+	fado.fad.mutation.Where(ps...)
+	return fado
+}
+
+// Exec executes the deletion query.
+func (fado *FileActivityDeleteOne) Exec(ctx context.Context) error {
+	n, err := fado.fad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{fileactivity.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fado *FileActivityDeleteOne) ExecX(ctx context.Context) {
+	if err := fado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,680 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/file"
+	"github.com/cloudreve/Cloudreve/v4/ent/fileactivity"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+)
+
+// FileActivityQuery is the builder for querying FileActivity entities.
+type FileActivityQuery struct {
+	config
+	ctx        *QueryContext
+	order      []fileactivity.OrderOption
+	inters     []Interceptor
+	predicates []predicate.FileActivity
+	withFile   *FileQuery
+	withUser   *UserQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the FileActivityQuery builder.
+func (faq *FileActivityQuery) Where(ps ...predicate.FileActivity) *FileActivityQuery {
+	faq.predicates = append(faq.predicates, ps...)
+	return faq
+}
+
+// Limit the number of records to be returned by this query.
+func (faq *FileActivityQuery) Limit(limit int) *FileActivityQuery {
+	faq.ctx.Limit = &limit
+	return faq
+}
+
+// Offset to start from.
+func (faq *FileActivityQuery) Offset(offset int) *FileActivityQuery {
+	faq.ctx.Offset = &offset
+	return faq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (faq *FileActivityQuery) Unique(unique bool) *FileActivityQuery {
+	faq.ctx.Unique = &unique
+	return faq
+}
+
+// Order specifies how the records should be ordered.
+func (faq *FileActivityQuery) Order(o ...fileactivity.OrderOption) *FileActivityQuery {
+	faq.order = append(faq.order, o...)
+	return faq
+}
+
+// QueryFile chains the current query on the "file" edge.
+func (faq *FileActivityQuery) QueryFile() *FileQuery {
+	query := (&FileClient{config: faq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := faq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := faq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(fileactivity.Table, fileactivity.FieldID, selector),
+			sqlgraph.To(file.Table, file.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, fileactivity.FileTable, fileactivity.FileColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(faq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (faq *FileActivityQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: faq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := faq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := faq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(fileactivity.Table, fileactivity.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, fileactivity.UserTable, fileactivity.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(faq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first FileActivity entity from the query.
+// Returns a *NotFoundError when no FileActivity was found.
+func (faq *FileActivityQuery) First(ctx context.Context) (*FileActivity, error) {
+	nodes, err := faq.Limit(1).All(setContextOp(ctx, faq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{fileactivity.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (faq *FileActivityQuery) FirstX(ctx context.Context) *FileActivity {
+	node, err := faq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first FileActivity ID from the query.
+// Returns a *NotFoundError when no FileActivity ID was found.
+func (faq *FileActivityQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = faq.Limit(1).IDs(setContextOp(ctx, faq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{fileactivity.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (faq *FileActivityQuery) FirstIDX(ctx context.Context) int {
+	id, err := faq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single FileActivity entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one FileActivity entity is found.
+// Returns a *NotFoundError when no FileActivity entities are found.
+func (faq *FileActivityQuery) Only(ctx context.Context) (*FileActivity, error) {
+	nodes, err := faq.Limit(2).All(setContextOp(ctx, faq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{fileactivity.Label}
+	default:
+		return nil, &NotSingularError{fileactivity.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (faq *FileActivityQuery) OnlyX(ctx context.Context) *FileActivity {
+	node, err := faq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only FileActivity ID in the query.
+// Returns a *NotSingularError when more than one FileActivity ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (faq *FileActivityQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = faq.Limit(2).IDs(setContextOp(ctx, faq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{fileactivity.Label}
+	default:
+		err = &NotSingularError{fileactivity.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (faq *FileActivityQuery) OnlyIDX(ctx context.Context) int {
+	id, err := faq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of FileActivities.
+func (faq *FileActivityQuery) All(ctx context.Context) ([]*FileActivity, error) {
+	ctx = setContextOp(ctx, faq.ctx, "All")
+	if err := faq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*FileActivity, *FileActivityQuery]()
+	return withInterceptors[[]*FileActivity](ctx, faq, qr, faq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (faq *FileActivityQuery) AllX(ctx context.Context) []*FileActivity {
+	nodes, err := faq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of FileActivity IDs.
+func (faq *FileActivityQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if faq.ctx.Unique == nil && faq.path != nil {
+		faq.Unique(true)
+	}
+	ctx = setContextOp(ctx, faq.ctx, "IDs")
+	if err = faq.Select(fileactivity.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (faq *FileActivityQuery) IDsX(ctx context.Context) []int {
+	ids, err := faq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (faq *FileActivityQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, faq.ctx, "Count")
+	if err := faq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, faq, querierCount[*FileActivityQuery](), faq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (faq *FileActivityQuery) CountX(ctx context.Context) int {
+	count, err := faq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (faq *FileActivityQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, faq.ctx, "Exist")
+	switch _, err := faq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (faq *FileActivityQuery) ExistX(ctx context.Context) bool {
+	exist, err := faq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the FileActivityQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (faq *FileActivityQuery) Clone() *FileActivityQuery {
+	if faq == nil {
+		return nil
+	}
+	return &FileActivityQuery{
+		config:     faq.config,
+		ctx:        faq.ctx.Clone(),
+		order:      append([]fileactivity.OrderOption{}, faq.order...),
+		inters:     append([]Interceptor{}, faq.inters...),
+		predicates: append([]predicate.FileActivity{}, faq.predicates...),
+		withFile:   faq.withFile.Clone(),
+		withUser:   faq.withUser.Clone(),
+		// clone intermediate query.
+		sql:  faq.sql.Clone(),
+		path: faq.path,
+	}
+}
+
+// WithFile tells the query-builder to eager-load the nodes that are connected to
+// the "file" edge. The optional arguments are used to configure the query builder of the edge.
+func (faq *FileActivityQuery) WithFile(opts ...func(*FileQuery)) *FileActivityQuery {
+	query := (&FileClient{config: faq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	faq.withFile = query
+	return faq
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (faq *FileActivityQuery) WithUser(opts ...func(*UserQuery)) *FileActivityQuery {
+	query := (&UserClient{config: faq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	faq.withUser = query
+	return faq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.FileActivity.Query().
+//		GroupBy(fileactivity.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (faq *FileActivityQuery) GroupBy(field string, fields ...string) *FileActivityGroupBy {
+	faq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &FileActivityGroupBy{build: faq}
+	grbuild.flds = &faq.ctx.Fields
+	grbuild.label = fileactivity.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.FileActivity.Query().
+//		Select(fileactivity.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (faq *FileActivityQuery) Select(fields ...string) *FileActivitySelect {
+	faq.ctx.Fields = append(faq.ctx.Fields, fields...)
+	sbuild := &FileActivitySelect{FileActivityQuery: faq}
+	sbuild.label = fileactivity.Label
+	sbuild.flds, sbuild.scan = &faq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a FileActivitySelect configured with the given aggregations.
+func (faq *FileActivityQuery) Aggregate(fns ...AggregateFunc) *FileActivitySelect {
+	return faq.Select().Aggregate(fns...)
+}
+
+func (faq *FileActivityQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range faq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, faq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range faq.ctx.Fields {
+		if !fileactivity.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if faq.path != nil {
+		prev, err := faq.path(ctx)
+		if err != nil {
+			return err
+		}
+		faq.sql = prev
+	}
+	return nil
+}
+
+func (faq *FileActivityQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*FileActivity, error) {
+	var (
+		nodes       = []*FileActivity{}
+		_spec       = faq.querySpec()
+		loadedTypes = [2]bool{
+			faq.withFile != nil,
+			faq.withUser != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*FileActivity).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &FileActivity{config: faq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, faq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := faq.withFile; query != nil {
+		if err := faq.loadFile(ctx, query, nodes, nil,
+			func(n *FileActivity, e *File) { n.Edges.File = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := faq.withUser; query != nil {
+		if err := faq.loadUser(ctx, query, nodes, nil,
+			func(n *FileActivity, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (faq *FileActivityQuery) loadFile(ctx context.Context, query *FileQuery, nodes []*FileActivity, init func(*FileActivity), assign func(*FileActivity, *File)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*FileActivity)
+	for i := range nodes {
+		fk := nodes[i].FileID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(file.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "file_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (faq *FileActivityQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*FileActivity, init func(*FileActivity), assign func(*FileActivity, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*FileActivity)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (faq *FileActivityQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := faq.querySpec()
+	_spec.Node.Columns = faq.ctx.Fields
+	if len(faq.ctx.Fields) > 0 {
+		_spec.Unique = faq.ctx.Unique != nil && *faq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, faq.driver, _spec)
+}
+
+func (faq *FileActivityQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(fileactivity.Table, fileactivity.Columns, sqlgraph.NewFieldSpec(fileactivity.FieldID, field.TypeInt))
+	_spec.From = faq.sql
+	if unique := faq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if faq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := faq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, fileactivity.FieldID)
+		for i := range fields {
+			if fields[i] != fileactivity.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if faq.withFile != nil {
+			_spec.Node.AddColumnOnce(fileactivity.FieldFileID)
+		}
+		if faq.withUser != nil {
+			_spec.Node.AddColumnOnce(fileactivity.FieldUserID)
+		}
+	}
+	if ps := faq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := faq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := faq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := faq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (faq *FileActivityQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(faq.driver.Dialect())
+	t1 := builder.Table(fileactivity.Table)
+	columns := faq.ctx.Fields
+	if len(columns) == 0 {
+		columns = fileactivity.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if faq.sql != nil {
+		selector = faq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if faq.ctx.Unique != nil && *faq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range faq.predicates {
+		p(selector)
+	}
+	for _, p := range faq.order {
+		p(selector)
+	}
+	if offset := faq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := faq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// FileActivityGroupBy is the group-by builder for FileActivity entities.
+type FileActivityGroupBy struct {
+	selector
+	build *FileActivityQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (fagb *FileActivityGroupBy) Aggregate(fns ...AggregateFunc) *FileActivityGroupBy {
+	fagb.fns = append(fagb.fns, fns...)
+	return fagb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (fagb *FileActivityGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, fagb.build.ctx, "GroupBy")
+	if err := fagb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*FileActivityQuery, *FileActivityGroupBy](ctx, fagb.build, fagb, fagb.build.inters, v)
+}
+
+func (fagb *FileActivityGroupBy) sqlScan(ctx context.Context, root *FileActivityQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(fagb.fns))
+	for _, fn := range fagb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*fagb.flds)+len(fagb.fns))
+		for _, f := range *fagb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*fagb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := fagb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// FileActivitySelect is the builder for selecting fields of FileActivity entities.
+type FileActivitySelect struct {
+	*FileActivityQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (fas *FileActivitySelect) Aggregate(fns ...AggregateFunc) *FileActivitySelect {
+	fas.fns = append(fas.fns, fns...)
+	return fas
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (fas *FileActivitySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, fas.ctx, "Select")
+	if err := fas.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*FileActivityQuery, *FileActivitySelect](ctx, fas.FileActivityQuery, fas, fas.inters, v)
+}
+
+func (fas *FileActivitySelect) sqlScan(ctx context.Context, root *FileActivityQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(fas.fns))
+	for _, fn := range fas.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*fas.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := fas.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,662 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/file"
+	"github.com/cloudreve/Cloudreve/v4/ent/fileactivity"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+)
+
+// FileActivityUpdate is the builder for updating FileActivity entities.
+type FileActivityUpdate struct {
+	config
+	hooks    []Hook
+	mutation *FileActivityMutation
+}
+
+// Where appends a list predicates to the FileActivityUpdate builder.
+func (fau *FileActivityUpdate) Where(ps ...predicate.FileActivity) *FileActivityUpdate {
+	fau.mutation.Where(ps...)
+	return fau
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (fau *FileActivityUpdate) SetUpdatedAt(t time.Time) *FileActivityUpdate {
+	fau.mutation.SetUpdatedAt(t)
+	return fau
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (fau *FileActivityUpdate) SetDeletedAt(t time.Time) *FileActivityUpdate {
+	fau.mutation.SetDeletedAt(t)
+	return fau
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (fau *FileActivityUpdate) SetNillableDeletedAt(t *time.Time) *FileActivityUpdate {
+	if t != nil {
+		fau.SetDeletedAt(*t)
+	}
+	return fau
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (fau *FileActivityUpdate) ClearDeletedAt() *FileActivityUpdate {
+	fau.mutation.ClearDeletedAt()
+	return fau
+}
+
+// SetAction sets the "action" field.
+func (fau *FileActivityUpdate) SetAction(i int) *FileActivityUpdate {
+	fau.mutation.ResetAction()
+	fau.mutation.SetAction(i)
+	return fau
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (fau *FileActivityUpdate) SetNillableAction(i *int) *FileActivityUpdate {
+	if i != nil {
+		fau.SetAction(*i)
+	}
+	return fau
+}
+
+// AddAction adds i to the "action" field.
+func (fau *FileActivityUpdate) AddAction(i int) *FileActivityUpdate {
+	fau.mutation.AddAction(i)
+	return fau
+}
+
+// SetAccessedAt sets the "accessed_at" field.
+func (fau *FileActivityUpdate) SetAccessedAt(t time.Time) *FileActivityUpdate {
+	fau.mutation.SetAccessedAt(t)
+	return fau
+}
+
+// SetNillableAccessedAt sets the "accessed_at" field if the given value is not nil.
+func (fau *FileActivityUpdate) SetNillableAccessedAt(t *time.Time) *FileActivityUpdate {
+	if t != nil {
+		fau.SetAccessedAt(*t)
+	}
+	return fau
+}
+
+// SetOpenedAt sets the "opened_at" field.
+func (fau *FileActivityUpdate) SetOpenedAt(t time.Time) *FileActivityUpdate {
+	fau.mutation.SetOpenedAt(t)
+	return fau
+}
+
+// SetNillableOpenedAt sets the "opened_at" field if the given value is not nil.
+func (fau *FileActivityUpdate) SetNillableOpenedAt(t *time.Time) *FileActivityUpdate {
+	if t != nil {
+		fau.SetOpenedAt(*t)
+	}
+	return fau
+}
+
+// ClearOpenedAt clears the value of the "opened_at" field.
+func (fau *FileActivityUpdate) ClearOpenedAt() *FileActivityUpdate {
+	fau.mutation.ClearOpenedAt()
+	return fau
+}
+
+// SetFileID sets the "file_id" field.
+func (fau *FileActivityUpdate) SetFileID(i int) *FileActivityUpdate {
+	fau.mutation.SetFileID(i)
+	return fau
+}
+
+// SetNillableFileID sets the "file_id" field if the given value is not nil.
+func (fau *FileActivityUpdate) SetNillableFileID(i *int) *FileActivityUpdate {
+	if i != nil {
+		fau.SetFileID(*i)
+	}
+	return fau
+}
+
+// SetUserID sets the "user_id" field.
+func (fau *FileActivityUpdate) SetUserID(i int) *FileActivityUpdate {
+	fau.mutation.SetUserID(i)
+	return fau
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (fau *FileActivityUpdate) SetNillableUserID(i *int) *FileActivityUpdate {
+	if i != nil {
+		fau.SetUserID(*i)
+	}
+	return fau
+}
+
+// SetFile sets the "file" edge to the File entity.
+func (fau *FileActivityUpdate) SetFile(f *File) *FileActivityUpdate {
+	return fau.SetFileID(f.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (fau *FileActivityUpdate) SetUser(u *User) *FileActivityUpdate {
+	return fau.SetUserID(u.ID)
+}
+
+// Mutation returns the FileActivityMutation object of the builder.
+func (fau *FileActivityUpdate) Mutation() *FileActivityMutation {
+	return fau.mutation
+}
+
+// ClearFile clears the "file" edge to the File entity.
+func (fau *FileActivityUpdate) ClearFile() *FileActivityUpdate {
+	fau.mutation.ClearFile()
+	return fau
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (fau *FileActivityUpdate) ClearUser() *FileActivityUpdate {
+	fau.mutation.ClearUser()
+	return fau
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (fau *FileActivityUpdate) Save(ctx context.Context) (int, error) {
+	if err := fau.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, fau.sqlSave, fau.mutation, fau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fau *FileActivityUpdate) SaveX(ctx context.Context) int {
+	affected, err := fau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (fau *FileActivityUpdate) Exec(ctx context.Context) error {
+	_, err := fau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fau *FileActivityUpdate) ExecX(ctx context.Context) {
+	if err := fau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (fau *FileActivityUpdate) defaults() error {
+	if _, ok := fau.mutation.UpdatedAt(); !ok {
+		if fileactivity.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized fileactivity.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := fileactivity.UpdateDefaultUpdatedAt()
+		fau.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fau *FileActivityUpdate) check() error {
+	if _, ok := fau.mutation.FileID(); fau.mutation.FileCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "FileActivity.file"`)
+	}
+	if _, ok := fau.mutation.UserID(); fau.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "FileActivity.user"`)
+	}
+	return nil
+}
+
+func (fau *FileActivityUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := fau.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(fileactivity.Table, fileactivity.Columns, sqlgraph.NewFieldSpec(fileactivity.FieldID, field.TypeInt))
+	if ps := fau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := fau.mutation.UpdatedAt(); ok {
+		_spec.SetField(fileactivity.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := fau.mutation.DeletedAt(); ok {
+		_spec.SetField(fileactivity.FieldDeletedAt, field.TypeTime, value)
+	}
+	if fau.mutation.DeletedAtCleared() {
+		_spec.ClearField(fileactivity.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := fau.mutation.Action(); ok {
+		_spec.SetField(fileactivity.FieldAction, field.TypeInt, value)
+	}
+	if value, ok := fau.mutation.AddedAction(); ok {
+		_spec.AddField(fileactivity.FieldAction, field.TypeInt, value)
+	}
+	if value, ok := fau.mutation.AccessedAt(); ok {
+		_spec.SetField(fileactivity.FieldAccessedAt, field.TypeTime, value)
+	}
+	if value, ok := fau.mutation.OpenedAt(); ok {
+		_spec.SetField(fileactivity.FieldOpenedAt, field.TypeTime, value)
+	}
+	if fau.mutation.OpenedAtCleared() {
+		_spec.ClearField(fileactivity.FieldOpenedAt, field.TypeTime)
+	}
+	if fau.mutation.FileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   fileactivity.FileTable,
+			Columns: []string{fileactivity.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fau.mutation.FileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   fileactivity.FileTable,
+			Columns: []string{fileactivity.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fau.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   fileactivity.UserTable,
+			Columns: []string{fileactivity.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fau.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   fileactivity.UserTable,
+			Columns: []string{fileactivity.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, fau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{fileactivity.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	fau.mutation.done = true
+	return n, nil
+}
+
+// FileActivityUpdateOne is the builder for updating a single FileActivity entity.
+type FileActivityUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *FileActivityMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (fauo *FileActivityUpdateOne) SetUpdatedAt(t time.Time) *FileActivityUpdateOne {
+	fauo.mutation.SetUpdatedAt(t)
+	return fauo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (fauo *FileActivityUpdateOne) SetDeletedAt(t time.Time) *FileActivityUpdateOne {
+	fauo.mutation.SetDeletedAt(t)
+	return fauo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (fauo *FileActivityUpdateOne) SetNillableDeletedAt(t *time.Time) *FileActivityUpdateOne {
+	if t != nil {
+		fauo.SetDeletedAt(*t)
+	}
+	return fauo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (fauo *FileActivityUpdateOne) ClearDeletedAt() *FileActivityUpdateOne {
+	fauo.mutation.ClearDeletedAt()
+	return fauo
+}
+
+// SetAction sets the "action" field.
+func (fauo *FileActivityUpdateOne) SetAction(i int) *FileActivityUpdateOne {
+	fauo.mutation.ResetAction()
+	fauo.mutation.SetAction(i)
+	return fauo
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (fauo *FileActivityUpdateOne) SetNillableAction(i *int) *FileActivityUpdateOne {
+	if i != nil {
+		fauo.SetAction(*i)
+	}
+	return fauo
+}
+
+// AddAction adds i to the "action" field.
+func (fauo *FileActivityUpdateOne) AddAction(i int) *FileActivityUpdateOne {
+	fauo.mutation.AddAction(i)
+	return fauo
+}
+
+// SetAccessedAt sets the "accessed_at" field.
+func (fauo *FileActivityUpdateOne) SetAccessedAt(t time.Time) *FileActivityUpdateOne {
+	fauo.mutation.SetAccessedAt(t)
+	return fauo
+}
+
+// SetNillableAccessedAt sets the "accessed_at" field if the given value is not nil.
+func (fauo *FileActivityUpdateOne) SetNillableAccessedAt(t *time.Time) *FileActivityUpdateOne {
+	if t != nil {
+		fauo.SetAccessedAt(*t)
+	}
+	return fauo
+}
+
+// SetOpenedAt sets the "opened_at" field.
+func (fauo *FileActivityUpdateOne) SetOpenedAt(t time.Time) *FileActivityUpdateOne {
+	fauo.mutation.SetOpenedAt(t)
+	return fauo
+}
+
+// SetNillableOpenedAt sets the "opened_at" field if the given value is not nil.
+func (fauo *FileActivityUpdateOne) SetNillableOpenedAt(t *time.Time) *FileActivityUpdateOne {
+	if t != nil {
+		fauo.SetOpenedAt(*t)
+	}
+	return fauo
+}
+
+// ClearOpenedAt clears the value of the "opened_at" field.
+func (fauo *FileActivityUpdateOne) ClearOpenedAt() *FileActivityUpdateOne {
+	fauo.mutation.ClearOpenedAt()
+	return fauo
+}
+
+// SetFileID sets the "file_id" field.
+func (fauo *FileActivityUpdateOne) SetFileID(i int) *FileActivityUpdateOne {
+	fauo.mutation.SetFileID(i)
+	return fauo
+}
+
+// SetNillableFileID sets the "file_id" field if the given value is not nil.
+func (fauo *FileActivityUpdateOne) SetNillableFileID(i *int) *FileActivityUpdateOne {
+	if i != nil {
+		fauo.SetFileID(*i)
+	}
+	return fauo
+}
+
+// SetUserID sets the "user_id" field.
+func (fauo *FileActivityUpdateOne) SetUserID(i int) *FileActivityUpdateOne {
+	fauo.mutation.SetUserID(i)
+	return fauo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (fauo *FileActivityUpdateOne) SetNillableUserID(i *int) *FileActivityUpdateOne {
+	if i != nil {
+		fauo.SetUserID(*i)
+	}
+	return fauo
+}
+
+// SetFile sets the "file" edge to the File entity.
+func (fauo *FileActivityUpdateOne) SetFile(f *File) *FileActivityUpdateOne {
+	return fauo.SetFileID(f.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (fauo *FileActivityUpdateOne) SetUser(u *User) *FileActivityUpdateOne {
+	return fauo.SetUserID(u.ID)
+}
+
+// Mutation returns the FileActivityMutation object of the builder.
+func (fauo *FileActivityUpdateOne) Mutation() *FileActivityMutation {
+	return fauo.mutation
+}
+
+// ClearFile clears the "file" edge to the File entity.
+func (fauo *FileActivityUpdateOne) ClearFile() *FileActivityUpdateOne {
+	fauo.mutation.ClearFile()
+	return fauo
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (fauo *FileActivityUpdateOne) ClearUser() *FileActivityUpdateOne {
+	fauo.mutation.ClearUser()
+	return fauo
+}
+
+// Where appends a list predicates to the FileActivityUpdate builder.
+func (fauo *FileActivityUpdateOne) Where(ps ...predicate.FileActivity) *FileActivityUpdateOne {
+	fauo.mutation.Where(ps...)
+	return fauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (fauo *FileActivityUpdateOne) Select(field string, fields ...string) *FileActivityUpdateOne {
+	fauo.fields = append([]string{field}, fields...)
+	return fauo
+}
+
+// Save executes the query and returns the updated FileActivity entity.
+func (fauo *FileActivityUpdateOne) Save(ctx context.Context) (*FileActivity, error) {
+	if err := fauo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, fauo.sqlSave, fauo.mutation, fauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fauo *FileActivityUpdateOne) SaveX(ctx context.Context) *FileActivity {
+	node, err := fauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (fauo *FileActivityUpdateOne) Exec(ctx context.Context) error {
+	_, err := fauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fauo *FileActivityUpdateOne) ExecX(ctx context.Context) {
+	if err := fauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (fauo *FileActivityUpdateOne) defaults() error {
+	if _, ok := fauo.mutation.UpdatedAt(); !ok {
+		if fileactivity.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized fileactivity.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := fileactivity.UpdateDefaultUpdatedAt()
+		fauo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fauo *FileActivityUpdateOne) check() error {
+	if _, ok := fauo.mutation.FileID(); fauo.mutation.FileCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "FileActivity.file"`)
+	}
+	if _, ok := fauo.mutation.UserID(); fauo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "FileActivity.user"`)
+	}
+	return nil
+}
+
+func (fauo *FileActivityUpdateOne) sqlSave(ctx context.Context) (_node *FileActivity, err error) {
+	if err := fauo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(fileactivity.Table, fileactivity.Columns, sqlgraph.NewFieldSpec(fileactivity.FieldID, field.TypeInt))
+	id, ok := fauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "FileActivity.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := fauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, fileactivity.FieldID)
+		for _, f := range fields {
+			if !fileactivity.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != fileactivity.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := fauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := fauo.mutation.UpdatedAt(); ok {
+		_spec.SetField(fileactivity.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := fauo.mutation.DeletedAt(); ok {
+		_spec.SetField(fileactivity.FieldDeletedAt, field.TypeTime, value)
+	}
+	if fauo.mutation.DeletedAtCleared() {
+		_spec.ClearField(fileactivity.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := fauo.mutation.Action(); ok {
+		_spec.SetField(fileactivity.FieldAction, field.TypeInt, value)
+	}
+	if value, ok := fauo.mutation.AddedAction(); ok {
+		_spec.AddField(fileactivity.FieldAction, field.TypeInt, value)
+	}
+	if value, ok := fauo.mutation.AccessedAt(); ok {
+		_spec.SetField(fileactivity.FieldAccessedAt, field.TypeTime, value)
+	}
+	if value, ok := fauo.mutation.OpenedAt(); ok {
+		_spec.SetField(fileactivity.FieldOpenedAt, field.TypeTime, value)
+	}
+	if fauo.mutation.OpenedAtCleared() {
+		_spec.ClearField(fileactivity.FieldOpenedAt, field.TypeTime)
+	}
+	if fauo.mutation.FileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   fileactivity.FileTable,
+			Columns: []string{fileactivity.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fauo.mutation.FileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   fileactivity.FileTable,
+			Columns: []string{fileactivity.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fauo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   fileactivity.UserTable,
+			Columns: []string{fileactivity.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fauo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   fileactivity.UserTable,
+			Columns: []string{fileactivity.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &FileActivity{config: fauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, fauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{fileactivity.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	fauo.mutation.done = true
+	return _node, nil
+}
@@ -8,18 +8,24 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/album"
 	"github.com/cloudreve/Cloudreve/v4/ent/davaccount"
 	"github.com/cloudreve/Cloudreve/v4/ent/directlink"
 	"github.com/cloudreve/Cloudreve/v4/ent/entity"
+	"github.com/cloudreve/Cloudreve/v4/ent/feeditem"
+	"github.com/cloudreve/Cloudreve/v4/ent/feedsubscription"
 	"github.com/cloudreve/Cloudreve/v4/ent/file"
+	"github.com/cloudreve/Cloudreve/v4/ent/fileactivity"
 	"github.com/cloudreve/Cloudreve/v4/ent/fsevent"
 	"github.com/cloudreve/Cloudreve/v4/ent/group"
 	"github.com/cloudreve/Cloudreve/v4/ent/metadata"
 	"github.com/cloudreve/Cloudreve/v4/ent/node"
 	"github.com/cloudreve/Cloudreve/v4/ent/passkey"
+	"github.com/cloudreve/Cloudreve/v4/ent/playlist"
 	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
 	"github.com/cloudreve/Cloudreve/v4/ent/setting"
 	"github.com/cloudreve/Cloudreve/v4/ent/share"
+	"github.com/cloudreve/Cloudreve/v4/ent/snapshot"
 	"github.com/cloudreve/Cloudreve/v4/ent/storagepolicy"
 	"github.com/cloudreve/Cloudreve/v4/ent/task"
 	"github.com/cloudreve/Cloudreve/v4/ent/user"
@@ -81,6 +87,33 @@ func (f TraverseFunc) Traverse(ctx context.Context, q ent.Query) error {
 	return f(ctx, query)
 }
 
+// The AlbumFunc type is an adapter to allow the use of ordinary function as a Querier.
+type AlbumFunc func(context.Context, *ent.AlbumQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f AlbumFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.AlbumQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.AlbumQuery", q)
+}
+
+// The TraverseAlbum type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseAlbum func(context.Context, *ent.AlbumQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseAlbum) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseAlbum) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.AlbumQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.AlbumQuery", q)
+}
+
 // The DavAccountFunc type is an adapter to allow the use of ordinary function as a Querier.
 type DavAccountFunc func(context.Context, *ent.DavAccountQuery) (ent.Value, error)
 
@@ -162,6 +195,60 @@ func (f TraverseEntity) Traverse(ctx context.Context, q ent.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *ent.EntityQuery", q)
 }
 
+// The FeedItemFunc type is an adapter to allow the use of ordinary function as a Querier.
+type FeedItemFunc func(context.Context, *ent.FeedItemQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f FeedItemFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.FeedItemQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.FeedItemQuery", q)
+}
+
+// The TraverseFeedItem type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseFeedItem func(context.Context, *ent.FeedItemQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseFeedItem) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseFeedItem) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.FeedItemQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.FeedItemQuery", q)
+}
+
+// The FeedSubscriptionFunc type is an adapter to allow the use of ordinary function as a Querier.
+type FeedSubscriptionFunc func(context.Context, *ent.FeedSubscriptionQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f FeedSubscriptionFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.FeedSubscriptionQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.FeedSubscriptionQuery", q)
+}
+
+// The TraverseFeedSubscription type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseFeedSubscription func(context.Context, *ent.FeedSubscriptionQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseFeedSubscription) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseFeedSubscription) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.FeedSubscriptionQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.FeedSubscriptionQuery", q)
+}
+
 // The FileFunc type is an adapter to allow the use of ordinary function as a Querier.
 type FileFunc func(context.Context, *ent.FileQuery) (ent.Value, error)
 
@@ -189,6 +276,33 @@ func (f TraverseFile) Traverse(ctx context.Context, q ent.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *ent.FileQuery", q)
 }
 
+// The FileActivityFunc type is an adapter to allow the use of ordinary function as a Querier.
+type FileActivityFunc func(context.Context, *ent.FileActivityQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f FileActivityFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.FileActivityQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.FileActivityQuery", q)
+}
+
+// The TraverseFileActivity type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseFileActivity func(context.Context, *ent.FileActivityQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseFileActivity) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseFileActivity) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.FileActivityQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.FileActivityQuery", q)
+}
+
 // The FsEventFunc type is an adapter to allow the use of ordinary function as a Querier.
 type FsEventFunc func(context.Context, *ent.FsEventQuery) (ent.Value, error)
 
@@ -324,6 +438,33 @@ func (f TraversePasskey) Traverse(ctx context.Context, q ent.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *ent.PasskeyQuery", q)
 }
 
+// The PlaylistFunc type is an adapter to allow the use of ordinary function as a Querier.
+type PlaylistFunc func(context.Context, *ent.PlaylistQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f PlaylistFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.PlaylistQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.PlaylistQuery", q)
+}
+
+// The TraversePlaylist type is an adapter to allow the use of ordinary function as Traverser.
+type TraversePlaylist func(context.Context, *ent.PlaylistQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraversePlaylist) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraversePlaylist) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.PlaylistQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.PlaylistQuery", q)
+}
+
 // The SettingFunc type is an adapter to allow the use of ordinary function as a Querier.
 type SettingFunc func(context.Context, *ent.SettingQuery) (ent.Value, error)
 
@@ -378,6 +519,33 @@ func (f TraverseShare) Traverse(ctx context.Context, q ent.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *ent.ShareQuery", q)
 }
 
+// The SnapshotFunc type is an adapter to allow the use of ordinary function as a Querier.
+type SnapshotFunc func(context.Context, *ent.SnapshotQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f SnapshotFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.SnapshotQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.SnapshotQuery", q)
+}
+
+// The TraverseSnapshot type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseSnapshot func(context.Context, *ent.SnapshotQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseSnapshot) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseSnapshot) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SnapshotQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.SnapshotQuery", q)
+}
+
 // The StoragePolicyFunc type is an adapter to allow the use of ordinary function as a Querier.
 type StoragePolicyFunc func(context.Context, *ent.StoragePolicyQuery) (ent.Value, error)
 
@@ -462,14 +630,22 @@ func (f TraverseUser) Traverse(ctx context.Context, q ent.Query) error {
 // NewQuery returns the generic Query interface for the given typed query.
 func NewQuery(q ent.Query) (Query, error) {
 	switch q := q.(type) {
+	case *ent.AlbumQuery:
+		return &query[*ent.AlbumQuery, predicate.Album, album.OrderOption]{typ: ent.TypeAlbum, tq: q}, nil
 	case *ent.DavAccountQuery:
 		return &query[*ent.DavAccountQuery, predicate.DavAccount, davaccount.OrderOption]{typ: ent.TypeDavAccount, tq: q}, nil
 	case *ent.DirectLinkQuery:
 		return &query[*ent.DirectLinkQuery, predicate.DirectLink, directlink.OrderOption]{typ: ent.TypeDirectLink, tq: q}, nil
 	case *ent.EntityQuery:
 		return &query[*ent.EntityQuery, predicate.Entity, entity.OrderOption]{typ: ent.TypeEntity, tq: q}, nil
+	case *ent.FeedItemQuery:
+		return &query[*ent.FeedItemQuery, predicate.FeedItem, feeditem.OrderOption]{typ: ent.TypeFeedItem, tq: q}, nil
+	case *ent.FeedSubscriptionQuery:
+		return &query[*ent.FeedSubscriptionQuery, predicate.FeedSubscription, feedsubscription.OrderOption]{typ: ent.TypeFeedSubscription, tq: q}, nil
 	case *ent.FileQuery:
 		return &query[*ent.FileQuery, predicate.File, file.OrderOption]{typ: ent.TypeFile, tq: q}, nil
+	case *ent.FileActivityQuery:
+		return &query[*ent.FileActivityQuery, predicate.FileActivity, fileactivity.OrderOption]{typ: ent.TypeFileActivity, tq: q}, nil
 	case *ent.FsEventQuery:
 		return &query[*ent.FsEventQuery, predicate.FsEvent, fsevent.OrderOption]{typ: ent.TypeFsEvent, tq: q}, nil
 	case *ent.GroupQuery:
@@ -480,10 +656,14 @@ func NewQuery(q ent.Query) (Query, error) {
 		return &query[*ent.NodeQuery, predicate.Node, node.OrderOption]{typ: ent.TypeNode, tq: q}, nil
 	case *ent.PasskeyQuery:
 		return &query[*ent.PasskeyQuery, predicate.Passkey, passkey.OrderOption]{typ: ent.TypePasskey, tq: q}, nil
+	case *ent.PlaylistQuery:
+		return &query[*ent.PlaylistQuery, predicate.Playlist, playlist.OrderOption]{typ: ent.TypePlaylist, tq: q}, nil
 	case *ent.SettingQuery:
 		return &query[*ent.SettingQuery, predicate.Setting, setting.OrderOption]{typ: ent.TypeSetting, tq: q}, nil
 	case *ent.ShareQuery:
 		return &query[*ent.ShareQuery, predicate.Share, share.OrderOption]{typ: ent.TypeShare, tq: q}, nil
+	case *ent.SnapshotQuery:
+		return &query[*ent.SnapshotQuery, predicate.Snapshot, snapshot.OrderOption]{typ: ent.TypeSnapshot, tq: q}, nil
 	case *ent.StoragePolicyQuery:
 		return &query[*ent.StoragePolicyQuery, predicate.StoragePolicy, storagepolicy.OrderOption]{typ: ent.TypeStoragePolicy, tq: q}, nil
 	case *ent.TaskQuery:
@@ -8,6 +8,36 @@ import (
 )
 
 var (
+	// AlbumsColumns holds the columns for the "albums" table.
+	AlbumsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "name", Type: field.TypeString},
+		{Name: "owner_id", Type: field.TypeInt},
+	}
+	// AlbumsTable holds the schema information for the "albums" table.
+	AlbumsTable = &schema.Table{
+		Name:       "albums",
+		Columns:    AlbumsColumns,
+		PrimaryKey: []*schema.Column{AlbumsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "albums_users_albums",
+				Columns:    []*schema.Column{AlbumsColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "album_owner_id_name",
+				Unique:  false,
+				Columns: []*schema.Column{AlbumsColumns[5], AlbumsColumns[4]},
+			},
+		},
+	}
 	// DavAccountsColumns holds the columns for the "dav_accounts" table.
 	DavAccountsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -102,6 +132,82 @@ var (
 			},
 		},
 	}
+	// FeedItemsColumns holds the columns for the "feed_items" table.
+	FeedItemsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "guid", Type: field.TypeString},
+		{Name: "title", Type: field.TypeString, Size: 2147483647},
+		{Name: "link", Type: field.TypeString, Size: 2147483647},
+		{Name: "published_at", Type: field.TypeTime, Nullable: true},
+		{Name: "matched", Type: field.TypeBool, Default: false},
+		{Name: "task_id", Type: field.TypeInt, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "seen_at", Type: field.TypeTime},
+		{Name: "subscription_id", Type: field.TypeInt},
+	}
+	// FeedItemsTable holds the schema information for the "feed_items" table.
+	FeedItemsTable = &schema.Table{
+		Name:       "feed_items",
+		Columns:    FeedItemsColumns,
+		PrimaryKey: []*schema.Column{FeedItemsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "feed_items_feed_subscriptions_items",
+				Columns:    []*schema.Column{FeedItemsColumns[9]},
+				RefColumns: []*schema.Column{FeedSubscriptionsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "feeditem_subscription_id_guid",
+				Unique:  true,
+				Columns: []*schema.Column{FeedItemsColumns[9], FeedItemsColumns[1]},
+			},
+			{
+				Name:    "feeditem_subscription_id_matched_seen_at",
+				Unique:  false,
+				Columns: []*schema.Column{FeedItemsColumns[9], FeedItemsColumns[5], FeedItemsColumns[8]},
+			},
+		},
+	}
+	// FeedSubscriptionsColumns holds the columns for the "feed_subscriptions" table.
+	FeedSubscriptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "name", Type: field.TypeString},
+		{Name: "url", Type: field.TypeString, Size: 2147483647},
+		{Name: "include", Type: field.TypeString, Nullable: true},
+		{Name: "exclude", Type: field.TypeString, Nullable: true},
+		{Name: "dst", Type: field.TypeString, Size: 2147483647},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "checked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "owner_id", Type: field.TypeInt},
+	}
+	// FeedSubscriptionsTable holds the schema information for the "feed_subscriptions" table.
+	FeedSubscriptionsTable = &schema.Table{
+		Name:       "feed_subscriptions",
+		Columns:    FeedSubscriptionsColumns,
+		PrimaryKey: []*schema.Column{FeedSubscriptionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "feed_subscriptions_users_feed_subscriptions",
+				Columns:    []*schema.Column{FeedSubscriptionsColumns[12]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "feedsubscription_owner_id",
+				Unique:  false,
+				Columns: []*schema.Column{FeedSubscriptionsColumns[12]},
+			},
+		},
+	}
 	// FilesColumns holds the columns for the "files" table.
 	FilesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -160,6 +266,50 @@ var (
 			},
 		},
 	}
+	// FileActivitiesColumns holds the columns for the "file_activities" table.
+	FileActivitiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "action", Type: field.TypeInt},
+		{Name: "accessed_at", Type: field.TypeTime},
+		{Name: "opened_at", Type: field.TypeTime, Nullable: true},
+		{Name: "file_id", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// FileActivitiesTable holds the schema information for the "file_activities" table.
+	FileActivitiesTable = &schema.Table{
+		Name:       "file_activities",
+		Columns:    FileActivitiesColumns,
+		PrimaryKey: []*schema.Column{FileActivitiesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "file_activities_files_activities",
+				Columns:    []*schema.Column{FileActivitiesColumns[7]},
+				RefColumns: []*schema.Column{FilesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "file_activities_users_file_activities",
+				Columns:    []*schema.Column{FileActivitiesColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "fileactivity_user_id_file_id",
+				Unique:  true,
+				Columns: []*schema.Column{FileActivitiesColumns[8], FileActivitiesColumns[7]},
+			},
+			{
+				Name:    "fileactivity_user_id_accessed_at",
+				Unique:  false,
+				Columns: []*schema.Column{FileActivitiesColumns[8], FileActivitiesColumns[5]},
+			},
+		},
+	}
 	// FsEventsColumns holds the columns for the "fs_events" table.
 	FsEventsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -297,6 +447,38 @@ var (
 			},
 		},
 	}
+	// PlaylistsColumns holds the columns for the "playlists" table.
+	PlaylistsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "name", Type: field.TypeString},
+		{Name: "comment", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "file_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "owner_id", Type: field.TypeInt},
+	}
+	// PlaylistsTable holds the schema information for the "playlists" table.
+	PlaylistsTable = &schema.Table{
+		Name:       "playlists",
+		Columns:    PlaylistsColumns,
+		PrimaryKey: []*schema.Column{PlaylistsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "playlists_users_playlists",
+				Columns:    []*schema.Column{PlaylistsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "playlist_owner_id_name",
+				Unique:  false,
+				Columns: []*schema.Column{PlaylistsColumns[7], PlaylistsColumns[4]},
+			},
+		},
+	}
 	// SettingsColumns holds the columns for the "settings" table.
 	SettingsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -347,6 +529,42 @@ var (
 			},
 		},
 	}
+	// SnapshotsColumns holds the columns for the "snapshots" table.
+	SnapshotsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "name", Type: field.TypeString},
+		{Name: "file_id", Type: field.TypeInt},
+		{Name: "root_uri", Type: field.TypeString, Size: 2147483647},
+		{Name: "files", Type: field.TypeInt, Default: 0},
+		{Name: "size", Type: field.TypeInt64, Default: 0},
+		{Name: "scheduled", Type: field.TypeBool, Default: false},
+		{Name: "manifest", Type: field.TypeJSON, Nullable: true},
+		{Name: "owner_id", Type: field.TypeInt},
+	}
+	// SnapshotsTable holds the schema information for the "snapshots" table.
+	SnapshotsTable = &schema.Table{
+		Name:       "snapshots",
+		Columns:    SnapshotsColumns,
+		PrimaryKey: []*schema.Column{SnapshotsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "snapshots_users_snapshots",
+				Columns:    []*schema.Column{SnapshotsColumns[11]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "snapshot_owner_id_file_id",
+				Unique:  false,
+				Columns: []*schema.Column{SnapshotsColumns[11], SnapshotsColumns[5]},
+			},
+		},
+	}
 	// StoragePoliciesColumns holds the columns for the "storage_policies" table.
 	StoragePoliciesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -437,6 +655,31 @@ var (
 			},
 		},
 	}
+	// AlbumFilesColumns holds the columns for the "album_files" table.
+	AlbumFilesColumns = []*schema.Column{
+		{Name: "album_id", Type: field.TypeInt},
+		{Name: "file_id", Type: field.TypeInt},
+	}
+	// AlbumFilesTable holds the schema information for the "album_files" table.
+	AlbumFilesTable = &schema.Table{
+		Name:       "album_files",
+		Columns:    AlbumFilesColumns,
+		PrimaryKey: []*schema.Column{AlbumFilesColumns[0], AlbumFilesColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "album_files_album_id",
+				Columns:    []*schema.Column{AlbumFilesColumns[0]},
+				RefColumns: []*schema.Column{AlbumsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "album_files_file_id",
+				Columns:    []*schema.Column{AlbumFilesColumns[1]},
+				RefColumns: []*schema.Column{FilesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// FileEntitiesColumns holds the columns for the "file_entities" table.
 	FileEntitiesColumns = []*schema.Column{
 		{Name: "file_id", Type: field.TypeInt},
@@ -464,41 +707,57 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AlbumsTable,
 		DavAccountsTable,
 		DirectLinksTable,
 		EntitiesTable,
+		FeedItemsTable,
+		FeedSubscriptionsTable,
 		FilesTable,
+		FileActivitiesTable,
 		FsEventsTable,
 		GroupsTable,
 		MetadataTable,
 		NodesTable,
 		PasskeysTable,
+		PlaylistsTable,
 		SettingsTable,
 		SharesTable,
+		SnapshotsTable,
 		StoragePoliciesTable,
 		TasksTable,
 		UsersTable,
+		AlbumFilesTable,
 		FileEntitiesTable,
 	}
 )
 
 func init() {
+	AlbumsTable.ForeignKeys[0].RefTable = UsersTable
 	DavAccountsTable.ForeignKeys[0].RefTable = UsersTable
 	DirectLinksTable.ForeignKeys[0].RefTable = FilesTable
 	EntitiesTable.ForeignKeys[0].RefTable = StoragePoliciesTable
 	EntitiesTable.ForeignKeys[1].RefTable = UsersTable
+	FeedItemsTable.ForeignKeys[0].RefTable = FeedSubscriptionsTable
+	FeedSubscriptionsTable.ForeignKeys[0].RefTable = UsersTable
 	FilesTable.ForeignKeys[0].RefTable = FilesTable
 	FilesTable.ForeignKeys[1].RefTable = StoragePoliciesTable
 	FilesTable.ForeignKeys[2].RefTable = UsersTable
+	FileActivitiesTable.ForeignKeys[0].RefTable = FilesTable
+	FileActivitiesTable.ForeignKeys[1].RefTable = UsersTable
 	FsEventsTable.ForeignKeys[0].RefTable = UsersTable
 	GroupsTable.ForeignKeys[0].RefTable = StoragePoliciesTable
 	MetadataTable.ForeignKeys[0].RefTable = FilesTable
 	PasskeysTable.ForeignKeys[0].RefTable = UsersTable
+	PlaylistsTable.ForeignKeys[0].RefTable = UsersTable
 	SharesTable.ForeignKeys[0].RefTable = FilesTable
 	SharesTable.ForeignKeys[1].RefTable = UsersTable
+	SnapshotsTable.ForeignKeys[0].RefTable = UsersTable
 	StoragePoliciesTable.ForeignKeys[0].RefTable = NodesTable
 	TasksTable.ForeignKeys[0].RefTable = UsersTable
 	UsersTable.ForeignKeys[0].RefTable = GroupsTable
+	AlbumFilesTable.ForeignKeys[0].RefTable = AlbumsTable
+	AlbumFilesTable.ForeignKeys[1].RefTable = FilesTable
 	FileEntitiesTable.ForeignKeys[0].RefTable = FilesTable
 	FileEntitiesTable.ForeignKeys[1].RefTable = EntitiesTable
 }
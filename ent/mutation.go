@@ -11,18 +11,24 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent/album"
 	"github.com/cloudreve/Cloudreve/v4/ent/davaccount"
 	"github.com/cloudreve/Cloudreve/v4/ent/directlink"
 	"github.com/cloudreve/Cloudreve/v4/ent/entity"
+	"github.com/cloudreve/Cloudreve/v4/ent/feeditem"
+	"github.com/cloudreve/Cloudreve/v4/ent/feedsubscription"
 	"github.com/cloudreve/Cloudreve/v4/ent/file"
+	"github.com/cloudreve/Cloudreve/v4/ent/fileactivity"
 	"github.com/cloudreve/Cloudreve/v4/ent/fsevent"
 	"github.com/cloudreve/Cloudreve/v4/ent/group"
 	"github.com/cloudreve/Cloudreve/v4/ent/metadata"
 	"github.com/cloudreve/Cloudreve/v4/ent/node"
 	"github.com/cloudreve/Cloudreve/v4/ent/passkey"
+	"github.com/cloudreve/Cloudreve/v4/ent/playlist"
 	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
 	"github.com/cloudreve/Cloudreve/v4/ent/setting"
 	"github.com/cloudreve/Cloudreve/v4/ent/share"
+	"github.com/cloudreve/Cloudreve/v4/ent/snapshot"
 	"github.com/cloudreve/Cloudreve/v4/ent/storagepolicy"
 	"github.com/cloudreve/Cloudreve/v4/ent/task"
 	"github.com/cloudreve/Cloudreve/v4/ent/user"
@@ -41,24 +47,30 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeDavAccount    = "DavAccount"
-	TypeDirectLink    = "DirectLink"
-	TypeEntity        = "Entity"
-	TypeFile          = "File"
-	TypeFsEvent       = "FsEvent"
-	TypeGroup         = "Group"
-	TypeMetadata      = "Metadata"
-	TypeNode          = "Node"
-	TypePasskey       = "Passkey"
-	TypeSetting       = "Setting"
-	TypeShare         = "Share"
-	TypeStoragePolicy = "StoragePolicy"
-	TypeTask          = "Task"
-	TypeUser          = "User"
+	TypeAlbum            = "Album"
+	TypeDavAccount       = "DavAccount"
+	TypeDirectLink       = "DirectLink"
+	TypeEntity           = "Entity"
+	TypeFeedItem         = "FeedItem"
+	TypeFeedSubscription = "FeedSubscription"
+	TypeFile             = "File"
+	TypeFileActivity     = "FileActivity"
+	TypeFsEvent          = "FsEvent"
+	TypeGroup            = "Group"
+	TypeMetadata         = "Metadata"
+	TypeNode             = "Node"
+	TypePasskey          = "Passkey"
+	TypePlaylist         = "Playlist"
+	TypeSetting          = "Setting"
+	TypeShare            = "Share"
+	TypeSnapshot         = "Snapshot"
+	TypeStoragePolicy    = "StoragePolicy"
+	TypeTask             = "Task"
+	TypeUser             = "User"
 )
 
-// DavAccountMutation represents an operation that mutates the DavAccount nodes in the graph.
-type DavAccountMutation struct {
+// AlbumMutation represents an operation that mutates the Album nodes in the graph.
+type AlbumMutation struct {
 	config
 	op            Op
 	typ           string
@@ -67,29 +79,28 @@ type DavAccountMutation struct {
 	updated_at    *time.Time
 	deleted_at    *time.Time
 	name          *string
-	uri           *string
-	password      *string
-	options       **boolset.BooleanSet
-	props         **types.DavAccountProps
 	clearedFields map[string]struct{}
 	owner         *int
 	clearedowner  bool
+	files         map[int]struct{}
+	removedfiles  map[int]struct{}
+	clearedfiles  bool
 	done          bool
-	oldValue      func(context.Context) (*DavAccount, error)
-	predicates    []predicate.DavAccount
+	oldValue      func(context.Context) (*Album, error)
+	predicates    []predicate.Album
 }
 
-var _ ent.Mutation = (*DavAccountMutation)(nil)
+var _ ent.Mutation = (*AlbumMutation)(nil)
 
-// davaccountOption allows management of the mutation configuration using functional options.
-type davaccountOption func(*DavAccountMutation)
+// albumOption allows management of the mutation configuration using functional options.
+type albumOption func(*AlbumMutation)
 
-// newDavAccountMutation creates new mutation for the DavAccount entity.
-func newDavAccountMutation(c config, op Op, opts ...davaccountOption) *DavAccountMutation {
-	m := &DavAccountMutation{
+// newAlbumMutation creates new mutation for the Album entity.
+func newAlbumMutation(c config, op Op, opts ...albumOption) *AlbumMutation {
+	m := &AlbumMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDavAccount,
+		typ:           TypeAlbum,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -98,20 +109,20 @@ func newDavAccountMutation(c config, op Op, opts ...davaccountOption) *DavAccoun
 	return m
 }
 
-// withDavAccountID sets the ID field of the mutation.
-func withDavAccountID(id int) davaccountOption {
-	return func(m *DavAccountMutation) {
+// withAlbumID sets the ID field of the mutation.
+func withAlbumID(id int) albumOption {
+	return func(m *AlbumMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *DavAccount
+			value *Album
 		)
-		m.oldValue = func(ctx context.Context) (*DavAccount, error) {
+		m.oldValue = func(ctx context.Context) (*Album, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().DavAccount.Get(ctx, id)
+					value, err = m.Client().Album.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -120,10 +131,10 @@ func withDavAccountID(id int) davaccountOption {
 	}
 }
 
-// withDavAccount sets the old DavAccount of the mutation.
-func withDavAccount(node *DavAccount) davaccountOption {
-	return func(m *DavAccountMutation) {
-		m.oldValue = func(context.Context) (*DavAccount, error) {
+// withAlbum sets the old Album of the mutation.
+func withAlbum(node *Album) albumOption {
+	return func(m *AlbumMutation) {
+		m.oldValue = func(context.Context) (*Album, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -132,7 +143,7 @@ func withDavAccount(node *DavAccount) davaccountOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DavAccountMutation) Client() *Client {
+func (m AlbumMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -140,7 +151,7 @@ func (m DavAccountMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DavAccountMutation) Tx() (*Tx, error) {
+func (m AlbumMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -151,7 +162,7 @@ func (m DavAccountMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DavAccountMutation) ID() (id int, exists bool) {
+func (m *AlbumMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -162,7 +173,7 @@ func (m *DavAccountMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DavAccountMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *AlbumMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -171,19 +182,19 @@ func (m *DavAccountMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().DavAccount.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Album.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *DavAccountMutation) SetCreatedAt(t time.Time) {
+func (m *AlbumMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *DavAccountMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *AlbumMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -191,10 +202,10 @@ func (m *DavAccountMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the DavAccount entity.
-// If the DavAccount object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Album entity.
+// If the Album object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DavAccountMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AlbumMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -209,17 +220,17 @@ func (m *DavAccountMutation) OldCreatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *DavAccountMutation) ResetCreatedAt() {
+func (m *AlbumMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *DavAccountMutation) SetUpdatedAt(t time.Time) {
+func (m *AlbumMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *DavAccountMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *AlbumMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -227,10 +238,10 @@ func (m *DavAccountMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the DavAccount entity.
-// If the DavAccount object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Album entity.
+// If the Album object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DavAccountMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AlbumMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -245,17 +256,17 @@ func (m *DavAccountMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *DavAccountMutation) ResetUpdatedAt() {
+func (m *AlbumMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // SetDeletedAt sets the "deleted_at" field.
-func (m *DavAccountMutation) SetDeletedAt(t time.Time) {
+func (m *AlbumMutation) SetDeletedAt(t time.Time) {
 	m.deleted_at = &t
 }
 
 // DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *DavAccountMutation) DeletedAt() (r time.Time, exists bool) {
+func (m *AlbumMutation) DeletedAt() (r time.Time, exists bool) {
 	v := m.deleted_at
 	if v == nil {
 		return
@@ -263,10 +274,10 @@ func (m *DavAccountMutation) DeletedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the DavAccount entity.
-// If the DavAccount object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedAt returns the old "deleted_at" field's value of the Album entity.
+// If the Album object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DavAccountMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *AlbumMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
@@ -281,30 +292,30 @@ func (m *DavAccountMutation) OldDeletedAt(ctx context.Context) (v *time.Time, er
 }
 
 // ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *DavAccountMutation) ClearDeletedAt() {
+func (m *AlbumMutation) ClearDeletedAt() {
 	m.deleted_at = nil
-	m.clearedFields[davaccount.FieldDeletedAt] = struct{}{}
+	m.clearedFields[album.FieldDeletedAt] = struct{}{}
 }
 
 // DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *DavAccountMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[davaccount.FieldDeletedAt]
+func (m *AlbumMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[album.FieldDeletedAt]
 	return ok
 }
 
 // ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *DavAccountMutation) ResetDeletedAt() {
+func (m *AlbumMutation) ResetDeletedAt() {
 	m.deleted_at = nil
-	delete(m.clearedFields, davaccount.FieldDeletedAt)
+	delete(m.clearedFields, album.FieldDeletedAt)
 }
 
 // SetName sets the "name" field.
-func (m *DavAccountMutation) SetName(s string) {
+func (m *AlbumMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *DavAccountMutation) Name() (r string, exists bool) {
+func (m *AlbumMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -312,10 +323,10 @@ func (m *DavAccountMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the DavAccount entity.
-// If the DavAccount object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Album entity.
+// If the Album object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DavAccountMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *AlbumMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -330,174 +341,17 @@ func (m *DavAccountMutation) OldName(ctx context.Context) (v string, err error)
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *DavAccountMutation) ResetName() {
+func (m *AlbumMutation) ResetName() {
 	m.name = nil
 }
 
-// SetURI sets the "uri" field.
-func (m *DavAccountMutation) SetURI(s string) {
-	m.uri = &s
-}
-
-// URI returns the value of the "uri" field in the mutation.
-func (m *DavAccountMutation) URI() (r string, exists bool) {
-	v := m.uri
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldURI returns the old "uri" field's value of the DavAccount entity.
-// If the DavAccount object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DavAccountMutation) OldURI(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldURI is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldURI requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldURI: %w", err)
-	}
-	return oldValue.URI, nil
-}
-
-// ResetURI resets all changes to the "uri" field.
-func (m *DavAccountMutation) ResetURI() {
-	m.uri = nil
-}
-
-// SetPassword sets the "password" field.
-func (m *DavAccountMutation) SetPassword(s string) {
-	m.password = &s
-}
-
-// Password returns the value of the "password" field in the mutation.
-func (m *DavAccountMutation) Password() (r string, exists bool) {
-	v := m.password
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldPassword returns the old "password" field's value of the DavAccount entity.
-// If the DavAccount object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DavAccountMutation) OldPassword(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPassword requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
-	}
-	return oldValue.Password, nil
-}
-
-// ResetPassword resets all changes to the "password" field.
-func (m *DavAccountMutation) ResetPassword() {
-	m.password = nil
-}
-
-// SetOptions sets the "options" field.
-func (m *DavAccountMutation) SetOptions(bs *boolset.BooleanSet) {
-	m.options = &bs
-}
-
-// Options returns the value of the "options" field in the mutation.
-func (m *DavAccountMutation) Options() (r *boolset.BooleanSet, exists bool) {
-	v := m.options
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldOptions returns the old "options" field's value of the DavAccount entity.
-// If the DavAccount object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DavAccountMutation) OldOptions(ctx context.Context) (v *boolset.BooleanSet, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOptions is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOptions requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOptions: %w", err)
-	}
-	return oldValue.Options, nil
-}
-
-// ResetOptions resets all changes to the "options" field.
-func (m *DavAccountMutation) ResetOptions() {
-	m.options = nil
-}
-
-// SetProps sets the "props" field.
-func (m *DavAccountMutation) SetProps(tap *types.DavAccountProps) {
-	m.props = &tap
-}
-
-// Props returns the value of the "props" field in the mutation.
-func (m *DavAccountMutation) Props() (r *types.DavAccountProps, exists bool) {
-	v := m.props
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldProps returns the old "props" field's value of the DavAccount entity.
-// If the DavAccount object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DavAccountMutation) OldProps(ctx context.Context) (v *types.DavAccountProps, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProps is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProps requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProps: %w", err)
-	}
-	return oldValue.Props, nil
-}
-
-// ClearProps clears the value of the "props" field.
-func (m *DavAccountMutation) ClearProps() {
-	m.props = nil
-	m.clearedFields[davaccount.FieldProps] = struct{}{}
-}
-
-// PropsCleared returns if the "props" field was cleared in this mutation.
-func (m *DavAccountMutation) PropsCleared() bool {
-	_, ok := m.clearedFields[davaccount.FieldProps]
-	return ok
-}
-
-// ResetProps resets all changes to the "props" field.
-func (m *DavAccountMutation) ResetProps() {
-	m.props = nil
-	delete(m.clearedFields, davaccount.FieldProps)
-}
-
 // SetOwnerID sets the "owner_id" field.
-func (m *DavAccountMutation) SetOwnerID(i int) {
+func (m *AlbumMutation) SetOwnerID(i int) {
 	m.owner = &i
 }
 
 // OwnerID returns the value of the "owner_id" field in the mutation.
-func (m *DavAccountMutation) OwnerID() (r int, exists bool) {
+func (m *AlbumMutation) OwnerID() (r int, exists bool) {
 	v := m.owner
 	if v == nil {
 		return
@@ -505,10 +359,10 @@ func (m *DavAccountMutation) OwnerID() (r int, exists bool) {
 	return *v, true
 }
 
-// OldOwnerID returns the old "owner_id" field's value of the DavAccount entity.
-// If the DavAccount object wasn't provided to the builder, the object is fetched from the database.
+// OldOwnerID returns the old "owner_id" field's value of the Album entity.
+// If the Album object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DavAccountMutation) OldOwnerID(ctx context.Context) (v int, err error) {
+func (m *AlbumMutation) OldOwnerID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
 	}
@@ -523,25 +377,25 @@ func (m *DavAccountMutation) OldOwnerID(ctx context.Context) (v int, err error)
 }
 
 // ResetOwnerID resets all changes to the "owner_id" field.
-func (m *DavAccountMutation) ResetOwnerID() {
+func (m *AlbumMutation) ResetOwnerID() {
 	m.owner = nil
 }
 
 // ClearOwner clears the "owner" edge to the User entity.
-func (m *DavAccountMutation) ClearOwner() {
+func (m *AlbumMutation) ClearOwner() {
 	m.clearedowner = true
-	m.clearedFields[davaccount.FieldOwnerID] = struct{}{}
+	m.clearedFields[album.FieldOwnerID] = struct{}{}
 }
 
 // OwnerCleared reports if the "owner" edge to the User entity was cleared.
-func (m *DavAccountMutation) OwnerCleared() bool {
+func (m *AlbumMutation) OwnerCleared() bool {
 	return m.clearedowner
 }
 
 // OwnerIDs returns the "owner" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // OwnerID instead. It exists only for internal usage by the builders.
-func (m *DavAccountMutation) OwnerIDs() (ids []int) {
+func (m *AlbumMutation) OwnerIDs() (ids []int) {
 	if id := m.owner; id != nil {
 		ids = append(ids, *id)
 	}
@@ -549,72 +403,114 @@ func (m *DavAccountMutation) OwnerIDs() (ids []int) {
 }
 
 // ResetOwner resets all changes to the "owner" edge.
-func (m *DavAccountMutation) ResetOwner() {
+func (m *AlbumMutation) ResetOwner() {
 	m.owner = nil
 	m.clearedowner = false
 }
 
-// Where appends a list predicates to the DavAccountMutation builder.
-func (m *DavAccountMutation) Where(ps ...predicate.DavAccount) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the DavAccountMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DavAccountMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.DavAccount, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// AddFileIDs adds the "files" edge to the File entity by ids.
+func (m *AlbumMutation) AddFileIDs(ids ...int) {
+	if m.files == nil {
+		m.files = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.files[ids[i]] = struct{}{}
 	}
-	m.Where(p...)
 }
 
-// Op returns the operation name.
-func (m *DavAccountMutation) Op() Op {
-	return m.op
+// ClearFiles clears the "files" edge to the File entity.
+func (m *AlbumMutation) ClearFiles() {
+	m.clearedfiles = true
 }
 
-// SetOp allows setting the mutation operation.
-func (m *DavAccountMutation) SetOp(op Op) {
-	m.op = op
+// FilesCleared reports if the "files" edge to the File entity was cleared.
+func (m *AlbumMutation) FilesCleared() bool {
+	return m.clearedfiles
 }
 
-// Type returns the node type of this mutation (DavAccount).
-func (m *DavAccountMutation) Type() string {
+// RemoveFileIDs removes the "files" edge to the File entity by IDs.
+func (m *AlbumMutation) RemoveFileIDs(ids ...int) {
+	if m.removedfiles == nil {
+		m.removedfiles = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.files, ids[i])
+		m.removedfiles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFiles returns the removed IDs of the "files" edge to the File entity.
+func (m *AlbumMutation) RemovedFilesIDs() (ids []int) {
+	for id := range m.removedfiles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FilesIDs returns the "files" edge IDs in the mutation.
+func (m *AlbumMutation) FilesIDs() (ids []int) {
+	for id := range m.files {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFiles resets all changes to the "files" edge.
+func (m *AlbumMutation) ResetFiles() {
+	m.files = nil
+	m.clearedfiles = false
+	m.removedfiles = nil
+}
+
+// Where appends a list predicates to the AlbumMutation builder.
+func (m *AlbumMutation) Where(ps ...predicate.Album) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AlbumMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AlbumMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Album, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *AlbumMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *AlbumMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Album).
+func (m *AlbumMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DavAccountMutation) Fields() []string {
-	fields := make([]string, 0, 9)
+func (m *AlbumMutation) Fields() []string {
+	fields := make([]string, 0, 5)
 	if m.created_at != nil {
-		fields = append(fields, davaccount.FieldCreatedAt)
+		fields = append(fields, album.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, davaccount.FieldUpdatedAt)
+		fields = append(fields, album.FieldUpdatedAt)
 	}
 	if m.deleted_at != nil {
-		fields = append(fields, davaccount.FieldDeletedAt)
+		fields = append(fields, album.FieldDeletedAt)
 	}
 	if m.name != nil {
-		fields = append(fields, davaccount.FieldName)
-	}
-	if m.uri != nil {
-		fields = append(fields, davaccount.FieldURI)
-	}
-	if m.password != nil {
-		fields = append(fields, davaccount.FieldPassword)
-	}
-	if m.options != nil {
-		fields = append(fields, davaccount.FieldOptions)
-	}
-	if m.props != nil {
-		fields = append(fields, davaccount.FieldProps)
+		fields = append(fields, album.FieldName)
 	}
 	if m.owner != nil {
-		fields = append(fields, davaccount.FieldOwnerID)
+		fields = append(fields, album.FieldOwnerID)
 	}
 	return fields
 }
@@ -622,25 +518,17 @@ func (m *DavAccountMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DavAccountMutation) Field(name string) (ent.Value, bool) {
+func (m *AlbumMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case davaccount.FieldCreatedAt:
+	case album.FieldCreatedAt:
 		return m.CreatedAt()
-	case davaccount.FieldUpdatedAt:
+	case album.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case davaccount.FieldDeletedAt:
+	case album.FieldDeletedAt:
 		return m.DeletedAt()
-	case davaccount.FieldName:
+	case album.FieldName:
 		return m.Name()
-	case davaccount.FieldURI:
-		return m.URI()
-	case davaccount.FieldPassword:
-		return m.Password()
-	case davaccount.FieldOptions:
-		return m.Options()
-	case davaccount.FieldProps:
-		return m.Props()
-	case davaccount.FieldOwnerID:
+	case album.FieldOwnerID:
 		return m.OwnerID()
 	}
 	return nil, false
@@ -649,92 +537,56 @@ func (m *DavAccountMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DavAccountMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AlbumMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case davaccount.FieldCreatedAt:
+	case album.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case davaccount.FieldUpdatedAt:
+	case album.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case davaccount.FieldDeletedAt:
+	case album.FieldDeletedAt:
 		return m.OldDeletedAt(ctx)
-	case davaccount.FieldName:
+	case album.FieldName:
 		return m.OldName(ctx)
-	case davaccount.FieldURI:
-		return m.OldURI(ctx)
-	case davaccount.FieldPassword:
-		return m.OldPassword(ctx)
-	case davaccount.FieldOptions:
-		return m.OldOptions(ctx)
-	case davaccount.FieldProps:
-		return m.OldProps(ctx)
-	case davaccount.FieldOwnerID:
+	case album.FieldOwnerID:
 		return m.OldOwnerID(ctx)
 	}
-	return nil, fmt.Errorf("unknown DavAccount field %s", name)
+	return nil, fmt.Errorf("unknown Album field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DavAccountMutation) SetField(name string, value ent.Value) error {
+func (m *AlbumMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case davaccount.FieldCreatedAt:
+	case album.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case davaccount.FieldUpdatedAt:
+	case album.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case davaccount.FieldDeletedAt:
+	case album.FieldDeletedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDeletedAt(v)
 		return nil
-	case davaccount.FieldName:
+	case album.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case davaccount.FieldURI:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetURI(v)
-		return nil
-	case davaccount.FieldPassword:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPassword(v)
-		return nil
-	case davaccount.FieldOptions:
-		v, ok := value.(*boolset.BooleanSet)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetOptions(v)
-		return nil
-	case davaccount.FieldProps:
-		v, ok := value.(*types.DavAccountProps)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetProps(v)
-		return nil
-	case davaccount.FieldOwnerID:
+	case album.FieldOwnerID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -742,12 +594,12 @@ func (m *DavAccountMutation) SetField(name string, value ent.Value) error {
 		m.SetOwnerID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown DavAccount field %s", name)
+	return fmt.Errorf("unknown Album field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DavAccountMutation) AddedFields() []string {
+func (m *AlbumMutation) AddedFields() []string {
 	var fields []string
 	return fields
 }
@@ -755,7 +607,7 @@ func (m *DavAccountMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DavAccountMutation) AddedField(name string) (ent.Value, bool) {
+func (m *AlbumMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
 	}
 	return nil, false
@@ -764,157 +616,167 @@ func (m *DavAccountMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DavAccountMutation) AddField(name string, value ent.Value) error {
+func (m *AlbumMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown DavAccount numeric field %s", name)
+	return fmt.Errorf("unknown Album numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DavAccountMutation) ClearedFields() []string {
+func (m *AlbumMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(davaccount.FieldDeletedAt) {
-		fields = append(fields, davaccount.FieldDeletedAt)
-	}
-	if m.FieldCleared(davaccount.FieldProps) {
-		fields = append(fields, davaccount.FieldProps)
+	if m.FieldCleared(album.FieldDeletedAt) {
+		fields = append(fields, album.FieldDeletedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DavAccountMutation) FieldCleared(name string) bool {
+func (m *AlbumMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DavAccountMutation) ClearField(name string) error {
+func (m *AlbumMutation) ClearField(name string) error {
 	switch name {
-	case davaccount.FieldDeletedAt:
+	case album.FieldDeletedAt:
 		m.ClearDeletedAt()
 		return nil
-	case davaccount.FieldProps:
-		m.ClearProps()
-		return nil
 	}
-	return fmt.Errorf("unknown DavAccount nullable field %s", name)
+	return fmt.Errorf("unknown Album nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DavAccountMutation) ResetField(name string) error {
+func (m *AlbumMutation) ResetField(name string) error {
 	switch name {
-	case davaccount.FieldCreatedAt:
+	case album.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case davaccount.FieldUpdatedAt:
+	case album.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case davaccount.FieldDeletedAt:
+	case album.FieldDeletedAt:
 		m.ResetDeletedAt()
 		return nil
-	case davaccount.FieldName:
+	case album.FieldName:
 		m.ResetName()
 		return nil
-	case davaccount.FieldURI:
-		m.ResetURI()
-		return nil
-	case davaccount.FieldPassword:
-		m.ResetPassword()
-		return nil
-	case davaccount.FieldOptions:
-		m.ResetOptions()
-		return nil
-	case davaccount.FieldProps:
-		m.ResetProps()
-		return nil
-	case davaccount.FieldOwnerID:
+	case album.FieldOwnerID:
 		m.ResetOwnerID()
 		return nil
 	}
-	return fmt.Errorf("unknown DavAccount field %s", name)
+	return fmt.Errorf("unknown Album field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DavAccountMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *AlbumMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
 	if m.owner != nil {
-		edges = append(edges, davaccount.EdgeOwner)
+		edges = append(edges, album.EdgeOwner)
+	}
+	if m.files != nil {
+		edges = append(edges, album.EdgeFiles)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DavAccountMutation) AddedIDs(name string) []ent.Value {
+func (m *AlbumMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case davaccount.EdgeOwner:
+	case album.EdgeOwner:
 		if id := m.owner; id != nil {
 			return []ent.Value{*id}
 		}
+	case album.EdgeFiles:
+		ids := make([]ent.Value, 0, len(m.files))
+		for id := range m.files {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DavAccountMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *AlbumMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedfiles != nil {
+		edges = append(edges, album.EdgeFiles)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DavAccountMutation) RemovedIDs(name string) []ent.Value {
+func (m *AlbumMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case album.EdgeFiles:
+		ids := make([]ent.Value, 0, len(m.removedfiles))
+		for id := range m.removedfiles {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DavAccountMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *AlbumMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
 	if m.clearedowner {
-		edges = append(edges, davaccount.EdgeOwner)
+		edges = append(edges, album.EdgeOwner)
+	}
+	if m.clearedfiles {
+		edges = append(edges, album.EdgeFiles)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DavAccountMutation) EdgeCleared(name string) bool {
+func (m *AlbumMutation) EdgeCleared(name string) bool {
 	switch name {
-	case davaccount.EdgeOwner:
+	case album.EdgeOwner:
 		return m.clearedowner
+	case album.EdgeFiles:
+		return m.clearedfiles
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DavAccountMutation) ClearEdge(name string) error {
+func (m *AlbumMutation) ClearEdge(name string) error {
 	switch name {
-	case davaccount.EdgeOwner:
+	case album.EdgeOwner:
 		m.ClearOwner()
 		return nil
 	}
-	return fmt.Errorf("unknown DavAccount unique edge %s", name)
+	return fmt.Errorf("unknown Album unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DavAccountMutation) ResetEdge(name string) error {
+func (m *AlbumMutation) ResetEdge(name string) error {
 	switch name {
-	case davaccount.EdgeOwner:
+	case album.EdgeOwner:
 		m.ResetOwner()
 		return nil
+	case album.EdgeFiles:
+		m.ResetFiles()
+		return nil
 	}
-	return fmt.Errorf("unknown DavAccount edge %s", name)
+	return fmt.Errorf("unknown Album edge %s", name)
 }
 
-// DirectLinkMutation represents an operation that mutates the DirectLink nodes in the graph.
-type DirectLinkMutation struct {
+// DavAccountMutation represents an operation that mutates the DavAccount nodes in the graph.
+type DavAccountMutation struct {
 	config
 	op            Op
 	typ           string
@@ -923,29 +785,29 @@ type DirectLinkMutation struct {
 	updated_at    *time.Time
 	deleted_at    *time.Time
 	name          *string
-	downloads     *int
-	adddownloads  *int
-	speed         *int
-	addspeed      *int
+	uri           *string
+	password      *string
+	options       **boolset.BooleanSet
+	props         **types.DavAccountProps
 	clearedFields map[string]struct{}
-	file          *int
-	clearedfile   bool
+	owner         *int
+	clearedowner  bool
 	done          bool
-	oldValue      func(context.Context) (*DirectLink, error)
-	predicates    []predicate.DirectLink
+	oldValue      func(context.Context) (*DavAccount, error)
+	predicates    []predicate.DavAccount
 }
 
-var _ ent.Mutation = (*DirectLinkMutation)(nil)
+var _ ent.Mutation = (*DavAccountMutation)(nil)
 
-// directlinkOption allows management of the mutation configuration using functional options.
-type directlinkOption func(*DirectLinkMutation)
+// davaccountOption allows management of the mutation configuration using functional options.
+type davaccountOption func(*DavAccountMutation)
 
-// newDirectLinkMutation creates new mutation for the DirectLink entity.
-func newDirectLinkMutation(c config, op Op, opts ...directlinkOption) *DirectLinkMutation {
-	m := &DirectLinkMutation{
+// newDavAccountMutation creates new mutation for the DavAccount entity.
+func newDavAccountMutation(c config, op Op, opts ...davaccountOption) *DavAccountMutation {
+	m := &DavAccountMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDirectLink,
+		typ:           TypeDavAccount,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -954,20 +816,20 @@ func newDirectLinkMutation(c config, op Op, opts ...directlinkOption) *DirectLin
 	return m
 }
 
-// withDirectLinkID sets the ID field of the mutation.
-func withDirectLinkID(id int) directlinkOption {
-	return func(m *DirectLinkMutation) {
+// withDavAccountID sets the ID field of the mutation.
+func withDavAccountID(id int) davaccountOption {
+	return func(m *DavAccountMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *DirectLink
+			value *DavAccount
 		)
-		m.oldValue = func(ctx context.Context) (*DirectLink, error) {
+		m.oldValue = func(ctx context.Context) (*DavAccount, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().DirectLink.Get(ctx, id)
+					value, err = m.Client().DavAccount.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -976,10 +838,10 @@ func withDirectLinkID(id int) directlinkOption {
 	}
 }
 
-// withDirectLink sets the old DirectLink of the mutation.
-func withDirectLink(node *DirectLink) directlinkOption {
-	return func(m *DirectLinkMutation) {
-		m.oldValue = func(context.Context) (*DirectLink, error) {
+// withDavAccount sets the old DavAccount of the mutation.
+func withDavAccount(node *DavAccount) davaccountOption {
+	return func(m *DavAccountMutation) {
+		m.oldValue = func(context.Context) (*DavAccount, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -988,7 +850,7 @@ func withDirectLink(node *DirectLink) directlinkOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DirectLinkMutation) Client() *Client {
+func (m DavAccountMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -996,7 +858,7 @@ func (m DirectLinkMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DirectLinkMutation) Tx() (*Tx, error) {
+func (m DavAccountMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1007,7 +869,7 @@ func (m DirectLinkMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DirectLinkMutation) ID() (id int, exists bool) {
+func (m *DavAccountMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1018,7 +880,7 @@ func (m *DirectLinkMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DirectLinkMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *DavAccountMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1027,19 +889,19 @@ func (m *DirectLinkMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().DirectLink.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().DavAccount.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *DirectLinkMutation) SetCreatedAt(t time.Time) {
+func (m *DavAccountMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *DirectLinkMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *DavAccountMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -1047,10 +909,10 @@ func (m *DirectLinkMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the DirectLink entity.
-// If the DirectLink object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the DavAccount entity.
+// If the DavAccount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DirectLinkMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *DavAccountMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -1065,17 +927,17 @@ func (m *DirectLinkMutation) OldCreatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *DirectLinkMutation) ResetCreatedAt() {
+func (m *DavAccountMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *DirectLinkMutation) SetUpdatedAt(t time.Time) {
+func (m *DavAccountMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *DirectLinkMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *DavAccountMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -1083,10 +945,10 @@ func (m *DirectLinkMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the DirectLink entity.
-// If the DirectLink object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the DavAccount entity.
+// If the DavAccount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DirectLinkMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *DavAccountMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -1101,17 +963,17 @@ func (m *DirectLinkMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *DirectLinkMutation) ResetUpdatedAt() {
+func (m *DavAccountMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // SetDeletedAt sets the "deleted_at" field.
-func (m *DirectLinkMutation) SetDeletedAt(t time.Time) {
+func (m *DavAccountMutation) SetDeletedAt(t time.Time) {
 	m.deleted_at = &t
 }
 
 // DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *DirectLinkMutation) DeletedAt() (r time.Time, exists bool) {
+func (m *DavAccountMutation) DeletedAt() (r time.Time, exists bool) {
 	v := m.deleted_at
 	if v == nil {
 		return
@@ -1119,10 +981,10 @@ func (m *DirectLinkMutation) DeletedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the DirectLink entity.
-// If the DirectLink object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedAt returns the old "deleted_at" field's value of the DavAccount entity.
+// If the DavAccount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DirectLinkMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *DavAccountMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
@@ -1137,30 +999,30 @@ func (m *DirectLinkMutation) OldDeletedAt(ctx context.Context) (v *time.Time, er
 }
 
 // ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *DirectLinkMutation) ClearDeletedAt() {
+func (m *DavAccountMutation) ClearDeletedAt() {
 	m.deleted_at = nil
-	m.clearedFields[directlink.FieldDeletedAt] = struct{}{}
+	m.clearedFields[davaccount.FieldDeletedAt] = struct{}{}
 }
 
 // DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *DirectLinkMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[directlink.FieldDeletedAt]
+func (m *DavAccountMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[davaccount.FieldDeletedAt]
 	return ok
 }
 
 // ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *DirectLinkMutation) ResetDeletedAt() {
+func (m *DavAccountMutation) ResetDeletedAt() {
 	m.deleted_at = nil
-	delete(m.clearedFields, directlink.FieldDeletedAt)
+	delete(m.clearedFields, davaccount.FieldDeletedAt)
 }
 
 // SetName sets the "name" field.
-func (m *DirectLinkMutation) SetName(s string) {
+func (m *DavAccountMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *DirectLinkMutation) Name() (r string, exists bool) {
+func (m *DavAccountMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -1168,10 +1030,10 @@ func (m *DirectLinkMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the DirectLink entity.
-// If the DirectLink object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the DavAccount entity.
+// If the DavAccount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DirectLinkMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *DavAccountMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -1186,194 +1048,239 @@ func (m *DirectLinkMutation) OldName(ctx context.Context) (v string, err error)
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *DirectLinkMutation) ResetName() {
+func (m *DavAccountMutation) ResetName() {
 	m.name = nil
 }
 
-// SetDownloads sets the "downloads" field.
-func (m *DirectLinkMutation) SetDownloads(i int) {
-	m.downloads = &i
-	m.adddownloads = nil
+// SetURI sets the "uri" field.
+func (m *DavAccountMutation) SetURI(s string) {
+	m.uri = &s
 }
 
-// Downloads returns the value of the "downloads" field in the mutation.
-func (m *DirectLinkMutation) Downloads() (r int, exists bool) {
-	v := m.downloads
+// URI returns the value of the "uri" field in the mutation.
+func (m *DavAccountMutation) URI() (r string, exists bool) {
+	v := m.uri
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDownloads returns the old "downloads" field's value of the DirectLink entity.
-// If the DirectLink object wasn't provided to the builder, the object is fetched from the database.
+// OldURI returns the old "uri" field's value of the DavAccount entity.
+// If the DavAccount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DirectLinkMutation) OldDownloads(ctx context.Context) (v int, err error) {
+func (m *DavAccountMutation) OldURI(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDownloads is only allowed on UpdateOne operations")
+		return v, errors.New("OldURI is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDownloads requires an ID field in the mutation")
+		return v, errors.New("OldURI requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDownloads: %w", err)
+		return v, fmt.Errorf("querying old value for OldURI: %w", err)
 	}
-	return oldValue.Downloads, nil
+	return oldValue.URI, nil
 }
 
-// AddDownloads adds i to the "downloads" field.
-func (m *DirectLinkMutation) AddDownloads(i int) {
-	if m.adddownloads != nil {
-		*m.adddownloads += i
-	} else {
-		m.adddownloads = &i
-	}
+// ResetURI resets all changes to the "uri" field.
+func (m *DavAccountMutation) ResetURI() {
+	m.uri = nil
 }
 
-// AddedDownloads returns the value that was added to the "downloads" field in this mutation.
-func (m *DirectLinkMutation) AddedDownloads() (r int, exists bool) {
-	v := m.adddownloads
+// SetPassword sets the "password" field.
+func (m *DavAccountMutation) SetPassword(s string) {
+	m.password = &s
+}
+
+// Password returns the value of the "password" field in the mutation.
+func (m *DavAccountMutation) Password() (r string, exists bool) {
+	v := m.password
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetDownloads resets all changes to the "downloads" field.
-func (m *DirectLinkMutation) ResetDownloads() {
-	m.downloads = nil
-	m.adddownloads = nil
+// OldPassword returns the old "password" field's value of the DavAccount entity.
+// If the DavAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DavAccountMutation) OldPassword(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPassword requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+	}
+	return oldValue.Password, nil
 }
 
-// SetFileID sets the "file_id" field.
-func (m *DirectLinkMutation) SetFileID(i int) {
-	m.file = &i
+// ResetPassword resets all changes to the "password" field.
+func (m *DavAccountMutation) ResetPassword() {
+	m.password = nil
 }
 
-// FileID returns the value of the "file_id" field in the mutation.
-func (m *DirectLinkMutation) FileID() (r int, exists bool) {
-	v := m.file
+// SetOptions sets the "options" field.
+func (m *DavAccountMutation) SetOptions(bs *boolset.BooleanSet) {
+	m.options = &bs
+}
+
+// Options returns the value of the "options" field in the mutation.
+func (m *DavAccountMutation) Options() (r *boolset.BooleanSet, exists bool) {
+	v := m.options
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFileID returns the old "file_id" field's value of the DirectLink entity.
-// If the DirectLink object wasn't provided to the builder, the object is fetched from the database.
+// OldOptions returns the old "options" field's value of the DavAccount entity.
+// If the DavAccount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DirectLinkMutation) OldFileID(ctx context.Context) (v int, err error) {
+func (m *DavAccountMutation) OldOptions(ctx context.Context) (v *boolset.BooleanSet, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFileID is only allowed on UpdateOne operations")
+		return v, errors.New("OldOptions is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFileID requires an ID field in the mutation")
+		return v, errors.New("OldOptions requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFileID: %w", err)
+		return v, fmt.Errorf("querying old value for OldOptions: %w", err)
 	}
-	return oldValue.FileID, nil
+	return oldValue.Options, nil
 }
 
-// ResetFileID resets all changes to the "file_id" field.
-func (m *DirectLinkMutation) ResetFileID() {
-	m.file = nil
+// ResetOptions resets all changes to the "options" field.
+func (m *DavAccountMutation) ResetOptions() {
+	m.options = nil
 }
 
-// SetSpeed sets the "speed" field.
-func (m *DirectLinkMutation) SetSpeed(i int) {
-	m.speed = &i
-	m.addspeed = nil
+// SetProps sets the "props" field.
+func (m *DavAccountMutation) SetProps(tap *types.DavAccountProps) {
+	m.props = &tap
 }
 
-// Speed returns the value of the "speed" field in the mutation.
-func (m *DirectLinkMutation) Speed() (r int, exists bool) {
-	v := m.speed
+// Props returns the value of the "props" field in the mutation.
+func (m *DavAccountMutation) Props() (r *types.DavAccountProps, exists bool) {
+	v := m.props
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSpeed returns the old "speed" field's value of the DirectLink entity.
-// If the DirectLink object wasn't provided to the builder, the object is fetched from the database.
+// OldProps returns the old "props" field's value of the DavAccount entity.
+// If the DavAccount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DirectLinkMutation) OldSpeed(ctx context.Context) (v int, err error) {
+func (m *DavAccountMutation) OldProps(ctx context.Context) (v *types.DavAccountProps, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSpeed is only allowed on UpdateOne operations")
+		return v, errors.New("OldProps is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSpeed requires an ID field in the mutation")
+		return v, errors.New("OldProps requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSpeed: %w", err)
+		return v, fmt.Errorf("querying old value for OldProps: %w", err)
 	}
-	return oldValue.Speed, nil
+	return oldValue.Props, nil
 }
 
-// AddSpeed adds i to the "speed" field.
-func (m *DirectLinkMutation) AddSpeed(i int) {
-	if m.addspeed != nil {
-		*m.addspeed += i
-	} else {
-		m.addspeed = &i
-	}
+// ClearProps clears the value of the "props" field.
+func (m *DavAccountMutation) ClearProps() {
+	m.props = nil
+	m.clearedFields[davaccount.FieldProps] = struct{}{}
 }
 
-// AddedSpeed returns the value that was added to the "speed" field in this mutation.
-func (m *DirectLinkMutation) AddedSpeed() (r int, exists bool) {
-	v := m.addspeed
-	if v == nil {
-		return
-	}
-	return *v, true
+// PropsCleared returns if the "props" field was cleared in this mutation.
+func (m *DavAccountMutation) PropsCleared() bool {
+	_, ok := m.clearedFields[davaccount.FieldProps]
+	return ok
 }
 
-// ResetSpeed resets all changes to the "speed" field.
-func (m *DirectLinkMutation) ResetSpeed() {
-	m.speed = nil
-	m.addspeed = nil
+// ResetProps resets all changes to the "props" field.
+func (m *DavAccountMutation) ResetProps() {
+	m.props = nil
+	delete(m.clearedFields, davaccount.FieldProps)
 }
 
-// ClearFile clears the "file" edge to the File entity.
-func (m *DirectLinkMutation) ClearFile() {
-	m.clearedfile = true
-	m.clearedFields[directlink.FieldFileID] = struct{}{}
+// SetOwnerID sets the "owner_id" field.
+func (m *DavAccountMutation) SetOwnerID(i int) {
+	m.owner = &i
 }
 
-// FileCleared reports if the "file" edge to the File entity was cleared.
-func (m *DirectLinkMutation) FileCleared() bool {
-	return m.clearedfile
+// OwnerID returns the value of the "owner_id" field in the mutation.
+func (m *DavAccountMutation) OwnerID() (r int, exists bool) {
+	v := m.owner
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// FileIDs returns the "file" edge IDs in the mutation.
+// OldOwnerID returns the old "owner_id" field's value of the DavAccount entity.
+// If the DavAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DavAccountMutation) OldOwnerID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
+	}
+	return oldValue.OwnerID, nil
+}
+
+// ResetOwnerID resets all changes to the "owner_id" field.
+func (m *DavAccountMutation) ResetOwnerID() {
+	m.owner = nil
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *DavAccountMutation) ClearOwner() {
+	m.clearedowner = true
+	m.clearedFields[davaccount.FieldOwnerID] = struct{}{}
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *DavAccountMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// FileID instead. It exists only for internal usage by the builders.
-func (m *DirectLinkMutation) FileIDs() (ids []int) {
-	if id := m.file; id != nil {
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *DavAccountMutation) OwnerIDs() (ids []int) {
+	if id := m.owner; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetFile resets all changes to the "file" edge.
-func (m *DirectLinkMutation) ResetFile() {
-	m.file = nil
-	m.clearedfile = false
+// ResetOwner resets all changes to the "owner" edge.
+func (m *DavAccountMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
 }
 
-// Where appends a list predicates to the DirectLinkMutation builder.
-func (m *DirectLinkMutation) Where(ps ...predicate.DirectLink) {
+// Where appends a list predicates to the DavAccountMutation builder.
+func (m *DavAccountMutation) Where(ps ...predicate.DavAccount) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the DirectLinkMutation builder. Using this method,
+// WhereP appends storage-level predicates to the DavAccountMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DirectLinkMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.DirectLink, len(ps))
+func (m *DavAccountMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DavAccount, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1381,45 +1288,51 @@ func (m *DirectLinkMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *DirectLinkMutation) Op() Op {
+func (m *DavAccountMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *DirectLinkMutation) SetOp(op Op) {
+func (m *DavAccountMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (DirectLink).
-func (m *DirectLinkMutation) Type() string {
+// Type returns the node type of this mutation (DavAccount).
+func (m *DavAccountMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DirectLinkMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+func (m *DavAccountMutation) Fields() []string {
+	fields := make([]string, 0, 9)
 	if m.created_at != nil {
-		fields = append(fields, directlink.FieldCreatedAt)
+		fields = append(fields, davaccount.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, directlink.FieldUpdatedAt)
+		fields = append(fields, davaccount.FieldUpdatedAt)
 	}
 	if m.deleted_at != nil {
-		fields = append(fields, directlink.FieldDeletedAt)
+		fields = append(fields, davaccount.FieldDeletedAt)
 	}
 	if m.name != nil {
-		fields = append(fields, directlink.FieldName)
+		fields = append(fields, davaccount.FieldName)
 	}
-	if m.downloads != nil {
-		fields = append(fields, directlink.FieldDownloads)
+	if m.uri != nil {
+		fields = append(fields, davaccount.FieldURI)
 	}
-	if m.file != nil {
-		fields = append(fields, directlink.FieldFileID)
+	if m.password != nil {
+		fields = append(fields, davaccount.FieldPassword)
 	}
-	if m.speed != nil {
-		fields = append(fields, directlink.FieldSpeed)
+	if m.options != nil {
+		fields = append(fields, davaccount.FieldOptions)
+	}
+	if m.props != nil {
+		fields = append(fields, davaccount.FieldProps)
+	}
+	if m.owner != nil {
+		fields = append(fields, davaccount.FieldOwnerID)
 	}
 	return fields
 }
@@ -1427,22 +1340,26 @@ func (m *DirectLinkMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DirectLinkMutation) Field(name string) (ent.Value, bool) {
+func (m *DavAccountMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case directlink.FieldCreatedAt:
+	case davaccount.FieldCreatedAt:
 		return m.CreatedAt()
-	case directlink.FieldUpdatedAt:
+	case davaccount.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case directlink.FieldDeletedAt:
+	case davaccount.FieldDeletedAt:
 		return m.DeletedAt()
-	case directlink.FieldName:
+	case davaccount.FieldName:
 		return m.Name()
-	case directlink.FieldDownloads:
-		return m.Downloads()
-	case directlink.FieldFileID:
-		return m.FileID()
-	case directlink.FieldSpeed:
-		return m.Speed()
+	case davaccount.FieldURI:
+		return m.URI()
+	case davaccount.FieldPassword:
+		return m.Password()
+	case davaccount.FieldOptions:
+		return m.Options()
+	case davaccount.FieldProps:
+		return m.Props()
+	case davaccount.FieldOwnerID:
+		return m.OwnerID()
 	}
 	return nil, false
 }
@@ -1450,106 +1367,114 @@ func (m *DirectLinkMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DirectLinkMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *DavAccountMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case directlink.FieldCreatedAt:
+	case davaccount.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case directlink.FieldUpdatedAt:
+	case davaccount.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case directlink.FieldDeletedAt:
+	case davaccount.FieldDeletedAt:
 		return m.OldDeletedAt(ctx)
-	case directlink.FieldName:
+	case davaccount.FieldName:
 		return m.OldName(ctx)
-	case directlink.FieldDownloads:
-		return m.OldDownloads(ctx)
-	case directlink.FieldFileID:
-		return m.OldFileID(ctx)
-	case directlink.FieldSpeed:
-		return m.OldSpeed(ctx)
+	case davaccount.FieldURI:
+		return m.OldURI(ctx)
+	case davaccount.FieldPassword:
+		return m.OldPassword(ctx)
+	case davaccount.FieldOptions:
+		return m.OldOptions(ctx)
+	case davaccount.FieldProps:
+		return m.OldProps(ctx)
+	case davaccount.FieldOwnerID:
+		return m.OldOwnerID(ctx)
 	}
-	return nil, fmt.Errorf("unknown DirectLink field %s", name)
+	return nil, fmt.Errorf("unknown DavAccount field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DirectLinkMutation) SetField(name string, value ent.Value) error {
+func (m *DavAccountMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case directlink.FieldCreatedAt:
+	case davaccount.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case directlink.FieldUpdatedAt:
+	case davaccount.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case directlink.FieldDeletedAt:
+	case davaccount.FieldDeletedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDeletedAt(v)
 		return nil
-	case directlink.FieldName:
+	case davaccount.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case directlink.FieldDownloads:
-		v, ok := value.(int)
+	case davaccount.FieldURI:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDownloads(v)
+		m.SetURI(v)
 		return nil
-	case directlink.FieldFileID:
-		v, ok := value.(int)
+	case davaccount.FieldPassword:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFileID(v)
+		m.SetPassword(v)
 		return nil
-	case directlink.FieldSpeed:
+	case davaccount.FieldOptions:
+		v, ok := value.(*boolset.BooleanSet)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOptions(v)
+		return nil
+	case davaccount.FieldProps:
+		v, ok := value.(*types.DavAccountProps)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProps(v)
+		return nil
+	case davaccount.FieldOwnerID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSpeed(v)
+		m.SetOwnerID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown DirectLink field %s", name)
+	return fmt.Errorf("unknown DavAccount field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DirectLinkMutation) AddedFields() []string {
+func (m *DavAccountMutation) AddedFields() []string {
 	var fields []string
-	if m.adddownloads != nil {
-		fields = append(fields, directlink.FieldDownloads)
-	}
-	if m.addspeed != nil {
-		fields = append(fields, directlink.FieldSpeed)
-	}
 	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DirectLinkMutation) AddedField(name string) (ent.Value, bool) {
+func (m *DavAccountMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case directlink.FieldDownloads:
-		return m.AddedDownloads()
-	case directlink.FieldSpeed:
-		return m.AddedSpeed()
 	}
 	return nil, false
 }
@@ -1557,98 +1482,96 @@ func (m *DirectLinkMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DirectLinkMutation) AddField(name string, value ent.Value) error {
+func (m *DavAccountMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case directlink.FieldDownloads:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddDownloads(v)
-		return nil
-	case directlink.FieldSpeed:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSpeed(v)
-		return nil
 	}
-	return fmt.Errorf("unknown DirectLink numeric field %s", name)
+	return fmt.Errorf("unknown DavAccount numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DirectLinkMutation) ClearedFields() []string {
+func (m *DavAccountMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(directlink.FieldDeletedAt) {
-		fields = append(fields, directlink.FieldDeletedAt)
+	if m.FieldCleared(davaccount.FieldDeletedAt) {
+		fields = append(fields, davaccount.FieldDeletedAt)
+	}
+	if m.FieldCleared(davaccount.FieldProps) {
+		fields = append(fields, davaccount.FieldProps)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DirectLinkMutation) FieldCleared(name string) bool {
+func (m *DavAccountMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DirectLinkMutation) ClearField(name string) error {
+func (m *DavAccountMutation) ClearField(name string) error {
 	switch name {
-	case directlink.FieldDeletedAt:
+	case davaccount.FieldDeletedAt:
 		m.ClearDeletedAt()
 		return nil
+	case davaccount.FieldProps:
+		m.ClearProps()
+		return nil
 	}
-	return fmt.Errorf("unknown DirectLink nullable field %s", name)
+	return fmt.Errorf("unknown DavAccount nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DirectLinkMutation) ResetField(name string) error {
+func (m *DavAccountMutation) ResetField(name string) error {
 	switch name {
-	case directlink.FieldCreatedAt:
+	case davaccount.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case directlink.FieldUpdatedAt:
+	case davaccount.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case directlink.FieldDeletedAt:
+	case davaccount.FieldDeletedAt:
 		m.ResetDeletedAt()
 		return nil
-	case directlink.FieldName:
+	case davaccount.FieldName:
 		m.ResetName()
 		return nil
-	case directlink.FieldDownloads:
-		m.ResetDownloads()
+	case davaccount.FieldURI:
+		m.ResetURI()
 		return nil
-	case directlink.FieldFileID:
-		m.ResetFileID()
+	case davaccount.FieldPassword:
+		m.ResetPassword()
 		return nil
-	case directlink.FieldSpeed:
-		m.ResetSpeed()
+	case davaccount.FieldOptions:
+		m.ResetOptions()
+		return nil
+	case davaccount.FieldProps:
+		m.ResetProps()
+		return nil
+	case davaccount.FieldOwnerID:
+		m.ResetOwnerID()
 		return nil
 	}
-	return fmt.Errorf("unknown DirectLink field %s", name)
+	return fmt.Errorf("unknown DavAccount field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DirectLinkMutation) AddedEdges() []string {
+func (m *DavAccountMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.file != nil {
-		edges = append(edges, directlink.EdgeFile)
+	if m.owner != nil {
+		edges = append(edges, davaccount.EdgeOwner)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DirectLinkMutation) AddedIDs(name string) []ent.Value {
+func (m *DavAccountMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case directlink.EdgeFile:
-		if id := m.file; id != nil {
+	case davaccount.EdgeOwner:
+		if id := m.owner; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -1656,100 +1579,91 @@ func (m *DirectLinkMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DirectLinkMutation) RemovedEdges() []string {
+func (m *DavAccountMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DirectLinkMutation) RemovedIDs(name string) []ent.Value {
+func (m *DavAccountMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DirectLinkMutation) ClearedEdges() []string {
+func (m *DavAccountMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedfile {
-		edges = append(edges, directlink.EdgeFile)
+	if m.clearedowner {
+		edges = append(edges, davaccount.EdgeOwner)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DirectLinkMutation) EdgeCleared(name string) bool {
+func (m *DavAccountMutation) EdgeCleared(name string) bool {
 	switch name {
-	case directlink.EdgeFile:
-		return m.clearedfile
+	case davaccount.EdgeOwner:
+		return m.clearedowner
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DirectLinkMutation) ClearEdge(name string) error {
+func (m *DavAccountMutation) ClearEdge(name string) error {
 	switch name {
-	case directlink.EdgeFile:
-		m.ClearFile()
+	case davaccount.EdgeOwner:
+		m.ClearOwner()
 		return nil
 	}
-	return fmt.Errorf("unknown DirectLink unique edge %s", name)
+	return fmt.Errorf("unknown DavAccount unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DirectLinkMutation) ResetEdge(name string) error {
+func (m *DavAccountMutation) ResetEdge(name string) error {
 	switch name {
-	case directlink.EdgeFile:
-		m.ResetFile()
+	case davaccount.EdgeOwner:
+		m.ResetOwner()
 		return nil
 	}
-	return fmt.Errorf("unknown DirectLink edge %s", name)
+	return fmt.Errorf("unknown DavAccount edge %s", name)
 }
 
-// EntityMutation represents an operation that mutates the Entity nodes in the graph.
-type EntityMutation struct {
+// DirectLinkMutation represents an operation that mutates the DirectLink nodes in the graph.
+type DirectLinkMutation struct {
 	config
-	op                    Op
-	typ                   string
-	id                    *int
-	created_at            *time.Time
-	updated_at            *time.Time
-	deleted_at            *time.Time
-	_type                 *int
-	add_type              *int
-	source                *string
-	size                  *int64
-	addsize               *int64
-	reference_count       *int
-	addreference_count    *int
-	upload_session_id     *uuid.UUID
-	props                 **types.EntityProps
-	clearedFields         map[string]struct{}
-	file                  map[int]struct{}
-	removedfile           map[int]struct{}
-	clearedfile           bool
-	user                  *int
-	cleareduser           bool
-	storage_policy        *int
-	clearedstorage_policy bool
-	done                  bool
-	oldValue              func(context.Context) (*Entity, error)
-	predicates            []predicate.Entity
+	op            Op
+	typ           string
+	id            *int
+	created_at    *time.Time
+	updated_at    *time.Time
+	deleted_at    *time.Time
+	name          *string
+	downloads     *int
+	adddownloads  *int
+	speed         *int
+	addspeed      *int
+	clearedFields map[string]struct{}
+	file          *int
+	clearedfile   bool
+	done          bool
+	oldValue      func(context.Context) (*DirectLink, error)
+	predicates    []predicate.DirectLink
 }
 
-var _ ent.Mutation = (*EntityMutation)(nil)
+var _ ent.Mutation = (*DirectLinkMutation)(nil)
 
-// entityOption allows management of the mutation configuration using functional options.
-type entityOption func(*EntityMutation)
+// directlinkOption allows management of the mutation configuration using functional options.
+type directlinkOption func(*DirectLinkMutation)
 
-// newEntityMutation creates new mutation for the Entity entity.
-func newEntityMutation(c config, op Op, opts ...entityOption) *EntityMutation {
-	m := &EntityMutation{
+// newDirectLinkMutation creates new mutation for the DirectLink entity.
+func newDirectLinkMutation(c config, op Op, opts ...directlinkOption) *DirectLinkMutation {
+	m := &DirectLinkMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeEntity,
+		typ:           TypeDirectLink,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1758,20 +1672,20 @@ func newEntityMutation(c config, op Op, opts ...entityOption) *EntityMutation {
 	return m
 }
 
-// withEntityID sets the ID field of the mutation.
-func withEntityID(id int) entityOption {
-	return func(m *EntityMutation) {
+// withDirectLinkID sets the ID field of the mutation.
+func withDirectLinkID(id int) directlinkOption {
+	return func(m *DirectLinkMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Entity
+			value *DirectLink
 		)
-		m.oldValue = func(ctx context.Context) (*Entity, error) {
+		m.oldValue = func(ctx context.Context) (*DirectLink, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Entity.Get(ctx, id)
+					value, err = m.Client().DirectLink.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1780,10 +1694,10 @@ func withEntityID(id int) entityOption {
 	}
 }
 
-// withEntity sets the old Entity of the mutation.
-func withEntity(node *Entity) entityOption {
-	return func(m *EntityMutation) {
-		m.oldValue = func(context.Context) (*Entity, error) {
+// withDirectLink sets the old DirectLink of the mutation.
+func withDirectLink(node *DirectLink) directlinkOption {
+	return func(m *DirectLinkMutation) {
+		m.oldValue = func(context.Context) (*DirectLink, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1792,7 +1706,7 @@ func withEntity(node *Entity) entityOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m EntityMutation) Client() *Client {
+func (m DirectLinkMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1800,7 +1714,7 @@ func (m EntityMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m EntityMutation) Tx() (*Tx, error) {
+func (m DirectLinkMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1811,7 +1725,7 @@ func (m EntityMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *EntityMutation) ID() (id int, exists bool) {
+func (m *DirectLinkMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1822,7 +1736,7 @@ func (m *EntityMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *EntityMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *DirectLinkMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1831,19 +1745,19 @@ func (m *EntityMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Entity.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().DirectLink.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *EntityMutation) SetCreatedAt(t time.Time) {
+func (m *DirectLinkMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *EntityMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *DirectLinkMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -1851,10 +1765,10 @@ func (m *EntityMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Entity entity.
-// If the Entity object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the DirectLink entity.
+// If the DirectLink object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EntityMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *DirectLinkMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -1869,17 +1783,17 @@ func (m *EntityMutation) OldCreatedAt(ctx context.Context) (v time.Time, err err
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *EntityMutation) ResetCreatedAt() {
+func (m *DirectLinkMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *EntityMutation) SetUpdatedAt(t time.Time) {
+func (m *DirectLinkMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *EntityMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *DirectLinkMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -1887,10 +1801,10 @@ func (m *EntityMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Entity entity.
-// If the Entity object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the DirectLink entity.
+// If the DirectLink object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EntityMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *DirectLinkMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -1905,17 +1819,17 @@ func (m *EntityMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err err
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *EntityMutation) ResetUpdatedAt() {
+func (m *DirectLinkMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // SetDeletedAt sets the "deleted_at" field.
-func (m *EntityMutation) SetDeletedAt(t time.Time) {
+func (m *DirectLinkMutation) SetDeletedAt(t time.Time) {
 	m.deleted_at = &t
 }
 
 // DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *EntityMutation) DeletedAt() (r time.Time, exists bool) {
+func (m *DirectLinkMutation) DeletedAt() (r time.Time, exists bool) {
 	v := m.deleted_at
 	if v == nil {
 		return
@@ -1923,10 +1837,10 @@ func (m *EntityMutation) DeletedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the Entity entity.
-// If the Entity object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedAt returns the old "deleted_at" field's value of the DirectLink entity.
+// If the DirectLink object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EntityMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *DirectLinkMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
@@ -1941,2068 +1855,7954 @@ func (m *EntityMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err er
 }
 
 // ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *EntityMutation) ClearDeletedAt() {
+func (m *DirectLinkMutation) ClearDeletedAt() {
 	m.deleted_at = nil
-	m.clearedFields[entity.FieldDeletedAt] = struct{}{}
+	m.clearedFields[directlink.FieldDeletedAt] = struct{}{}
 }
 
 // DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *EntityMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[entity.FieldDeletedAt]
+func (m *DirectLinkMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[directlink.FieldDeletedAt]
 	return ok
 }
 
 // ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *EntityMutation) ResetDeletedAt() {
+func (m *DirectLinkMutation) ResetDeletedAt() {
 	m.deleted_at = nil
-	delete(m.clearedFields, entity.FieldDeletedAt)
+	delete(m.clearedFields, directlink.FieldDeletedAt)
 }
 
-// SetType sets the "type" field.
-func (m *EntityMutation) SetType(i int) {
-	m._type = &i
-	m.add_type = nil
+// SetName sets the "name" field.
+func (m *DirectLinkMutation) SetName(s string) {
+	m.name = &s
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *EntityMutation) GetType() (r int, exists bool) {
-	v := m._type
+// Name returns the value of the "name" field in the mutation.
+func (m *DirectLinkMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the Entity entity.
-// If the Entity object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the DirectLink entity.
+// If the DirectLink object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EntityMutation) OldType(ctx context.Context) (v int, err error) {
+func (m *DirectLinkMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Type, nil
+	return oldValue.Name, nil
 }
 
-// AddType adds i to the "type" field.
-func (m *EntityMutation) AddType(i int) {
-	if m.add_type != nil {
-		*m.add_type += i
-	} else {
-		m.add_type = &i
-	}
+// ResetName resets all changes to the "name" field.
+func (m *DirectLinkMutation) ResetName() {
+	m.name = nil
 }
 
-// AddedType returns the value that was added to the "type" field in this mutation.
-func (m *EntityMutation) AddedType() (r int, exists bool) {
-	v := m.add_type
+// SetDownloads sets the "downloads" field.
+func (m *DirectLinkMutation) SetDownloads(i int) {
+	m.downloads = &i
+	m.adddownloads = nil
+}
+
+// Downloads returns the value of the "downloads" field in the mutation.
+func (m *DirectLinkMutation) Downloads() (r int, exists bool) {
+	v := m.downloads
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *EntityMutation) ResetType() {
-	m._type = nil
-	m.add_type = nil
-}
-
-// SetSource sets the "source" field.
-func (m *EntityMutation) SetSource(s string) {
-	m.source = &s
-}
-
-// Source returns the value of the "source" field in the mutation.
-func (m *EntityMutation) Source() (r string, exists bool) {
-	v := m.source
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldSource returns the old "source" field's value of the Entity entity.
-// If the Entity object wasn't provided to the builder, the object is fetched from the database.
+// OldDownloads returns the old "downloads" field's value of the DirectLink entity.
+// If the DirectLink object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EntityMutation) OldSource(ctx context.Context) (v string, err error) {
+func (m *DirectLinkMutation) OldDownloads(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+		return v, errors.New("OldDownloads is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSource requires an ID field in the mutation")
+		return v, errors.New("OldDownloads requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+		return v, fmt.Errorf("querying old value for OldDownloads: %w", err)
 	}
-	return oldValue.Source, nil
+	return oldValue.Downloads, nil
 }
 
-// ResetSource resets all changes to the "source" field.
-func (m *EntityMutation) ResetSource() {
-	m.source = nil
+// AddDownloads adds i to the "downloads" field.
+func (m *DirectLinkMutation) AddDownloads(i int) {
+	if m.adddownloads != nil {
+		*m.adddownloads += i
+	} else {
+		m.adddownloads = &i
+	}
 }
 
-// SetSize sets the "size" field.
-func (m *EntityMutation) SetSize(i int64) {
-	m.size = &i
-	m.addsize = nil
+// AddedDownloads returns the value that was added to the "downloads" field in this mutation.
+func (m *DirectLinkMutation) AddedDownloads() (r int, exists bool) {
+	v := m.adddownloads
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Size returns the value of the "size" field in the mutation.
-func (m *EntityMutation) Size() (r int64, exists bool) {
-	v := m.size
+// ResetDownloads resets all changes to the "downloads" field.
+func (m *DirectLinkMutation) ResetDownloads() {
+	m.downloads = nil
+	m.adddownloads = nil
+}
+
+// SetFileID sets the "file_id" field.
+func (m *DirectLinkMutation) SetFileID(i int) {
+	m.file = &i
+}
+
+// FileID returns the value of the "file_id" field in the mutation.
+func (m *DirectLinkMutation) FileID() (r int, exists bool) {
+	v := m.file
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSize returns the old "size" field's value of the Entity entity.
-// If the Entity object wasn't provided to the builder, the object is fetched from the database.
+// OldFileID returns the old "file_id" field's value of the DirectLink entity.
+// If the DirectLink object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EntityMutation) OldSize(ctx context.Context) (v int64, err error) {
+func (m *DirectLinkMutation) OldFileID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSize is only allowed on UpdateOne operations")
+		return v, errors.New("OldFileID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSize requires an ID field in the mutation")
+		return v, errors.New("OldFileID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSize: %w", err)
-	}
-	return oldValue.Size, nil
-}
-
-// AddSize adds i to the "size" field.
-func (m *EntityMutation) AddSize(i int64) {
-	if m.addsize != nil {
-		*m.addsize += i
-	} else {
-		m.addsize = &i
-	}
-}
-
-// AddedSize returns the value that was added to the "size" field in this mutation.
-func (m *EntityMutation) AddedSize() (r int64, exists bool) {
-	v := m.addsize
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldFileID: %w", err)
 	}
-	return *v, true
+	return oldValue.FileID, nil
 }
 
-// ResetSize resets all changes to the "size" field.
-func (m *EntityMutation) ResetSize() {
-	m.size = nil
-	m.addsize = nil
+// ResetFileID resets all changes to the "file_id" field.
+func (m *DirectLinkMutation) ResetFileID() {
+	m.file = nil
 }
 
-// SetReferenceCount sets the "reference_count" field.
-func (m *EntityMutation) SetReferenceCount(i int) {
-	m.reference_count = &i
-	m.addreference_count = nil
+// SetSpeed sets the "speed" field.
+func (m *DirectLinkMutation) SetSpeed(i int) {
+	m.speed = &i
+	m.addspeed = nil
 }
 
-// ReferenceCount returns the value of the "reference_count" field in the mutation.
-func (m *EntityMutation) ReferenceCount() (r int, exists bool) {
-	v := m.reference_count
+// Speed returns the value of the "speed" field in the mutation.
+func (m *DirectLinkMutation) Speed() (r int, exists bool) {
+	v := m.speed
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldReferenceCount returns the old "reference_count" field's value of the Entity entity.
-// If the Entity object wasn't provided to the builder, the object is fetched from the database.
+// OldSpeed returns the old "speed" field's value of the DirectLink entity.
+// If the DirectLink object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EntityMutation) OldReferenceCount(ctx context.Context) (v int, err error) {
+func (m *DirectLinkMutation) OldSpeed(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReferenceCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldSpeed is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReferenceCount requires an ID field in the mutation")
+		return v, errors.New("OldSpeed requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReferenceCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldSpeed: %w", err)
 	}
-	return oldValue.ReferenceCount, nil
+	return oldValue.Speed, nil
 }
 
-// AddReferenceCount adds i to the "reference_count" field.
-func (m *EntityMutation) AddReferenceCount(i int) {
-	if m.addreference_count != nil {
-		*m.addreference_count += i
+// AddSpeed adds i to the "speed" field.
+func (m *DirectLinkMutation) AddSpeed(i int) {
+	if m.addspeed != nil {
+		*m.addspeed += i
 	} else {
-		m.addreference_count = &i
+		m.addspeed = &i
 	}
 }
 
-// AddedReferenceCount returns the value that was added to the "reference_count" field in this mutation.
-func (m *EntityMutation) AddedReferenceCount() (r int, exists bool) {
-	v := m.addreference_count
+// AddedSpeed returns the value that was added to the "speed" field in this mutation.
+func (m *DirectLinkMutation) AddedSpeed() (r int, exists bool) {
+	v := m.addspeed
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetReferenceCount resets all changes to the "reference_count" field.
-func (m *EntityMutation) ResetReferenceCount() {
-	m.reference_count = nil
-	m.addreference_count = nil
+// ResetSpeed resets all changes to the "speed" field.
+func (m *DirectLinkMutation) ResetSpeed() {
+	m.speed = nil
+	m.addspeed = nil
 }
 
-// SetStoragePolicyEntities sets the "storage_policy_entities" field.
-func (m *EntityMutation) SetStoragePolicyEntities(i int) {
-	m.storage_policy = &i
+// ClearFile clears the "file" edge to the File entity.
+func (m *DirectLinkMutation) ClearFile() {
+	m.clearedfile = true
+	m.clearedFields[directlink.FieldFileID] = struct{}{}
 }
 
-// StoragePolicyEntities returns the value of the "storage_policy_entities" field in the mutation.
-func (m *EntityMutation) StoragePolicyEntities() (r int, exists bool) {
-	v := m.storage_policy
-	if v == nil {
-		return
-	}
-	return *v, true
+// FileCleared reports if the "file" edge to the File entity was cleared.
+func (m *DirectLinkMutation) FileCleared() bool {
+	return m.clearedfile
 }
 
-// OldStoragePolicyEntities returns the old "storage_policy_entities" field's value of the Entity entity.
-// If the Entity object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EntityMutation) OldStoragePolicyEntities(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStoragePolicyEntities is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStoragePolicyEntities requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStoragePolicyEntities: %w", err)
+// FileIDs returns the "file" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FileID instead. It exists only for internal usage by the builders.
+func (m *DirectLinkMutation) FileIDs() (ids []int) {
+	if id := m.file; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.StoragePolicyEntities, nil
+	return
 }
 
-// ResetStoragePolicyEntities resets all changes to the "storage_policy_entities" field.
-func (m *EntityMutation) ResetStoragePolicyEntities() {
-	m.storage_policy = nil
+// ResetFile resets all changes to the "file" edge.
+func (m *DirectLinkMutation) ResetFile() {
+	m.file = nil
+	m.clearedfile = false
 }
 
-// SetCreatedBy sets the "created_by" field.
-func (m *EntityMutation) SetCreatedBy(i int) {
-	m.user = &i
+// Where appends a list predicates to the DirectLinkMutation builder.
+func (m *DirectLinkMutation) Where(ps ...predicate.DirectLink) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// CreatedBy returns the value of the "created_by" field in the mutation.
-func (m *EntityMutation) CreatedBy() (r int, exists bool) {
-	v := m.user
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the DirectLinkMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DirectLinkMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DirectLink, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldCreatedBy returns the old "created_by" field's value of the Entity entity.
-// If the Entity object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EntityMutation) OldCreatedBy(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
-	}
-	return oldValue.CreatedBy, nil
+// Op returns the operation name.
+func (m *DirectLinkMutation) Op() Op {
+	return m.op
 }
 
-// ClearCreatedBy clears the value of the "created_by" field.
-func (m *EntityMutation) ClearCreatedBy() {
-	m.user = nil
-	m.clearedFields[entity.FieldCreatedBy] = struct{}{}
+// SetOp allows setting the mutation operation.
+func (m *DirectLinkMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
-func (m *EntityMutation) CreatedByCleared() bool {
-	_, ok := m.clearedFields[entity.FieldCreatedBy]
-	return ok
+// Type returns the node type of this mutation (DirectLink).
+func (m *DirectLinkMutation) Type() string {
+	return m.typ
 }
 
-// ResetCreatedBy resets all changes to the "created_by" field.
-func (m *EntityMutation) ResetCreatedBy() {
-	m.user = nil
-	delete(m.clearedFields, entity.FieldCreatedBy)
-}
-
-// SetUploadSessionID sets the "upload_session_id" field.
-func (m *EntityMutation) SetUploadSessionID(u uuid.UUID) {
-	m.upload_session_id = &u
-}
-
-// UploadSessionID returns the value of the "upload_session_id" field in the mutation.
-func (m *EntityMutation) UploadSessionID() (r uuid.UUID, exists bool) {
-	v := m.upload_session_id
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DirectLinkMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.created_at != nil {
+		fields = append(fields, directlink.FieldCreatedAt)
 	}
-	return *v, true
-}
-
-// OldUploadSessionID returns the old "upload_session_id" field's value of the Entity entity.
-// If the Entity object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EntityMutation) OldUploadSessionID(ctx context.Context) (v *uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUploadSessionID is only allowed on UpdateOne operations")
+	if m.updated_at != nil {
+		fields = append(fields, directlink.FieldUpdatedAt)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUploadSessionID requires an ID field in the mutation")
+	if m.deleted_at != nil {
+		fields = append(fields, directlink.FieldDeletedAt)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUploadSessionID: %w", err)
+	if m.name != nil {
+		fields = append(fields, directlink.FieldName)
 	}
-	return oldValue.UploadSessionID, nil
+	if m.downloads != nil {
+		fields = append(fields, directlink.FieldDownloads)
+	}
+	if m.file != nil {
+		fields = append(fields, directlink.FieldFileID)
+	}
+	if m.speed != nil {
+		fields = append(fields, directlink.FieldSpeed)
+	}
+	return fields
 }
 
-// ClearUploadSessionID clears the value of the "upload_session_id" field.
-func (m *EntityMutation) ClearUploadSessionID() {
-	m.upload_session_id = nil
-	m.clearedFields[entity.FieldUploadSessionID] = struct{}{}
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DirectLinkMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case directlink.FieldCreatedAt:
+		return m.CreatedAt()
+	case directlink.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case directlink.FieldDeletedAt:
+		return m.DeletedAt()
+	case directlink.FieldName:
+		return m.Name()
+	case directlink.FieldDownloads:
+		return m.Downloads()
+	case directlink.FieldFileID:
+		return m.FileID()
+	case directlink.FieldSpeed:
+		return m.Speed()
+	}
+	return nil, false
 }
 
-// UploadSessionIDCleared returns if the "upload_session_id" field was cleared in this mutation.
-func (m *EntityMutation) UploadSessionIDCleared() bool {
-	_, ok := m.clearedFields[entity.FieldUploadSessionID]
-	return ok
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DirectLinkMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case directlink.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case directlink.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case directlink.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case directlink.FieldName:
+		return m.OldName(ctx)
+	case directlink.FieldDownloads:
+		return m.OldDownloads(ctx)
+	case directlink.FieldFileID:
+		return m.OldFileID(ctx)
+	case directlink.FieldSpeed:
+		return m.OldSpeed(ctx)
+	}
+	return nil, fmt.Errorf("unknown DirectLink field %s", name)
 }
 
-// ResetUploadSessionID resets all changes to the "upload_session_id" field.
-func (m *EntityMutation) ResetUploadSessionID() {
-	m.upload_session_id = nil
-	delete(m.clearedFields, entity.FieldUploadSessionID)
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DirectLinkMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case directlink.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case directlink.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case directlink.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case directlink.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case directlink.FieldDownloads:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDownloads(v)
+		return nil
+	case directlink.FieldFileID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFileID(v)
+		return nil
+	case directlink.FieldSpeed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSpeed(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DirectLink field %s", name)
 }
 
-// SetProps sets the "props" field.
-func (m *EntityMutation) SetProps(tp *types.EntityProps) {
-	m.props = &tp
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DirectLinkMutation) AddedFields() []string {
+	var fields []string
+	if m.adddownloads != nil {
+		fields = append(fields, directlink.FieldDownloads)
+	}
+	if m.addspeed != nil {
+		fields = append(fields, directlink.FieldSpeed)
+	}
+	return fields
 }
 
-// Props returns the value of the "props" field in the mutation.
-func (m *EntityMutation) Props() (r *types.EntityProps, exists bool) {
-	v := m.props
-	if v == nil {
-		return
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DirectLinkMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case directlink.FieldDownloads:
+		return m.AddedDownloads()
+	case directlink.FieldSpeed:
+		return m.AddedSpeed()
 	}
-	return *v, true
+	return nil, false
 }
 
-// OldProps returns the old "props" field's value of the Entity entity.
-// If the Entity object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EntityMutation) OldProps(ctx context.Context) (v *types.EntityProps, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProps is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProps requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProps: %w", err)
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DirectLinkMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case directlink.FieldDownloads:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDownloads(v)
+		return nil
+	case directlink.FieldSpeed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSpeed(v)
+		return nil
 	}
-	return oldValue.Props, nil
+	return fmt.Errorf("unknown DirectLink numeric field %s", name)
 }
 
-// ClearProps clears the value of the "props" field.
-func (m *EntityMutation) ClearProps() {
-	m.props = nil
-	m.clearedFields[entity.FieldProps] = struct{}{}
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DirectLinkMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(directlink.FieldDeletedAt) {
+		fields = append(fields, directlink.FieldDeletedAt)
+	}
+	return fields
 }
 
-// PropsCleared returns if the "props" field was cleared in this mutation.
-func (m *EntityMutation) PropsCleared() bool {
-	_, ok := m.clearedFields[entity.FieldProps]
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DirectLinkMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
 	return ok
 }
 
-// ResetProps resets all changes to the "props" field.
-func (m *EntityMutation) ResetProps() {
-	m.props = nil
-	delete(m.clearedFields, entity.FieldProps)
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DirectLinkMutation) ClearField(name string) error {
+	switch name {
+	case directlink.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DirectLink nullable field %s", name)
 }
 
-// AddFileIDs adds the "file" edge to the File entity by ids.
-func (m *EntityMutation) AddFileIDs(ids ...int) {
-	if m.file == nil {
-		m.file = make(map[int]struct{})
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DirectLinkMutation) ResetField(name string) error {
+	switch name {
+	case directlink.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case directlink.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case directlink.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case directlink.FieldName:
+		m.ResetName()
+		return nil
+	case directlink.FieldDownloads:
+		m.ResetDownloads()
+		return nil
+	case directlink.FieldFileID:
+		m.ResetFileID()
+		return nil
+	case directlink.FieldSpeed:
+		m.ResetSpeed()
+		return nil
 	}
-	for i := range ids {
-		m.file[ids[i]] = struct{}{}
+	return fmt.Errorf("unknown DirectLink field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DirectLinkMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.file != nil {
+		edges = append(edges, directlink.EdgeFile)
 	}
+	return edges
 }
 
-// ClearFile clears the "file" edge to the File entity.
-func (m *EntityMutation) ClearFile() {
-	m.clearedfile = true
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DirectLinkMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case directlink.EdgeFile:
+		if id := m.file; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
 }
 
-// FileCleared reports if the "file" edge to the File entity was cleared.
-func (m *EntityMutation) FileCleared() bool {
-	return m.clearedfile
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DirectLinkMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
 }
 
-// RemoveFileIDs removes the "file" edge to the File entity by IDs.
-func (m *EntityMutation) RemoveFileIDs(ids ...int) {
-	if m.removedfile == nil {
-		m.removedfile = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.file, ids[i])
-		m.removedfile[ids[i]] = struct{}{}
-	}
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DirectLinkMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// RemovedFile returns the removed IDs of the "file" edge to the File entity.
-func (m *EntityMutation) RemovedFileIDs() (ids []int) {
-	for id := range m.removedfile {
-		ids = append(ids, id)
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DirectLinkMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedfile {
+		edges = append(edges, directlink.EdgeFile)
 	}
-	return
+	return edges
 }
 
-// FileIDs returns the "file" edge IDs in the mutation.
-func (m *EntityMutation) FileIDs() (ids []int) {
-	for id := range m.file {
-		ids = append(ids, id)
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DirectLinkMutation) EdgeCleared(name string) bool {
+	switch name {
+	case directlink.EdgeFile:
+		return m.clearedfile
 	}
-	return
+	return false
 }
 
-// ResetFile resets all changes to the "file" edge.
-func (m *EntityMutation) ResetFile() {
-	m.file = nil
-	m.clearedfile = false
-	m.removedfile = nil
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DirectLinkMutation) ClearEdge(name string) error {
+	switch name {
+	case directlink.EdgeFile:
+		m.ClearFile()
+		return nil
+	}
+	return fmt.Errorf("unknown DirectLink unique edge %s", name)
 }
 
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *EntityMutation) SetUserID(id int) {
-	m.user = &id
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DirectLinkMutation) ResetEdge(name string) error {
+	switch name {
+	case directlink.EdgeFile:
+		m.ResetFile()
+		return nil
+	}
+	return fmt.Errorf("unknown DirectLink edge %s", name)
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *EntityMutation) ClearUser() {
-	m.cleareduser = true
-	m.clearedFields[entity.FieldCreatedBy] = struct{}{}
+// EntityMutation represents an operation that mutates the Entity nodes in the graph.
+type EntityMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *int
+	created_at            *time.Time
+	updated_at            *time.Time
+	deleted_at            *time.Time
+	_type                 *int
+	add_type              *int
+	source                *string
+	size                  *int64
+	addsize               *int64
+	reference_count       *int
+	addreference_count    *int
+	upload_session_id     *uuid.UUID
+	props                 **types.EntityProps
+	clearedFields         map[string]struct{}
+	file                  map[int]struct{}
+	removedfile           map[int]struct{}
+	clearedfile           bool
+	user                  *int
+	cleareduser           bool
+	storage_policy        *int
+	clearedstorage_policy bool
+	done                  bool
+	oldValue              func(context.Context) (*Entity, error)
+	predicates            []predicate.Entity
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *EntityMutation) UserCleared() bool {
-	return m.CreatedByCleared() || m.cleareduser
-}
+var _ ent.Mutation = (*EntityMutation)(nil)
 
-// UserID returns the "user" edge ID in the mutation.
-func (m *EntityMutation) UserID() (id int, exists bool) {
-	if m.user != nil {
-		return *m.user, true
-	}
-	return
-}
+// entityOption allows management of the mutation configuration using functional options.
+type entityOption func(*EntityMutation)
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *EntityMutation) UserIDs() (ids []int) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// newEntityMutation creates new mutation for the Entity entity.
+func newEntityMutation(c config, op Op, opts ...entityOption) *EntityMutation {
+	m := &EntityMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEntity,
+		clearedFields: make(map[string]struct{}),
 	}
-	return
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *EntityMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// withEntityID sets the ID field of the mutation.
+func withEntityID(id int) entityOption {
+	return func(m *EntityMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Entity
+		)
+		m.oldValue = func(ctx context.Context) (*Entity, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Entity.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// SetStoragePolicyID sets the "storage_policy" edge to the StoragePolicy entity by id.
-func (m *EntityMutation) SetStoragePolicyID(id int) {
-	m.storage_policy = &id
+// withEntity sets the old Entity of the mutation.
+func withEntity(node *Entity) entityOption {
+	return func(m *EntityMutation) {
+		m.oldValue = func(context.Context) (*Entity, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// ClearStoragePolicy clears the "storage_policy" edge to the StoragePolicy entity.
-func (m *EntityMutation) ClearStoragePolicy() {
-	m.clearedstorage_policy = true
-	m.clearedFields[entity.FieldStoragePolicyEntities] = struct{}{}
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EntityMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// StoragePolicyCleared reports if the "storage_policy" edge to the StoragePolicy entity was cleared.
-func (m *EntityMutation) StoragePolicyCleared() bool {
-	return m.clearedstorage_policy
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EntityMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// StoragePolicyID returns the "storage_policy" edge ID in the mutation.
-func (m *EntityMutation) StoragePolicyID() (id int, exists bool) {
-	if m.storage_policy != nil {
-		return *m.storage_policy, true
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EntityMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
 	}
-	return
+	return *m.id, true
 }
 
-// StoragePolicyIDs returns the "storage_policy" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// StoragePolicyID instead. It exists only for internal usage by the builders.
-func (m *EntityMutation) StoragePolicyIDs() (ids []int) {
-	if id := m.storage_policy; id != nil {
-		ids = append(ids, *id)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EntityMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Entity.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return
 }
 
-// ResetStoragePolicy resets all changes to the "storage_policy" edge.
-func (m *EntityMutation) ResetStoragePolicy() {
-	m.storage_policy = nil
-	m.clearedstorage_policy = false
+// SetCreatedAt sets the "created_at" field.
+func (m *EntityMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Where appends a list predicates to the EntityMutation builder.
-func (m *EntityMutation) Where(ps ...predicate.Entity) {
-	m.predicates = append(m.predicates, ps...)
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EntityMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// WhereP appends storage-level predicates to the EntityMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *EntityMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Entity, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// OldCreatedAt returns the old "created_at" field's value of the Entity entity.
+// If the Entity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntityMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	m.Where(p...)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// Op returns the operation name.
-func (m *EntityMutation) Op() Op {
-	return m.op
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EntityMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *EntityMutation) SetOp(op Op) {
-	m.op = op
+// SetUpdatedAt sets the "updated_at" field.
+func (m *EntityMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// Type returns the node type of this mutation (Entity).
-func (m *EntityMutation) Type() string {
-	return m.typ
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *EntityMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *EntityMutation) Fields() []string {
-	fields := make([]string, 0, 11)
-	if m.created_at != nil {
-		fields = append(fields, entity.FieldCreatedAt)
+// OldUpdatedAt returns the old "updated_at" field's value of the Entity entity.
+// If the Entity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntityMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	if m.updated_at != nil {
-		fields = append(fields, entity.FieldUpdatedAt)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
-	if m.deleted_at != nil {
-		fields = append(fields, entity.FieldDeletedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	if m._type != nil {
-		fields = append(fields, entity.FieldType)
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *EntityMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *EntityMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *EntityMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
 	}
-	if m.source != nil {
-		fields = append(fields, entity.FieldSource)
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Entity entity.
+// If the Entity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntityMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
-	if m.size != nil {
-		fields = append(fields, entity.FieldSize)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
 	}
-	if m.reference_count != nil {
-		fields = append(fields, entity.FieldReferenceCount)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
 	}
-	if m.storage_policy != nil {
-		fields = append(fields, entity.FieldStoragePolicyEntities)
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *EntityMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[entity.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *EntityMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[entity.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *EntityMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, entity.FieldDeletedAt)
+}
+
+// SetType sets the "type" field.
+func (m *EntityMutation) SetType(i int) {
+	m._type = &i
+	m.add_type = nil
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *EntityMutation) GetType() (r int, exists bool) {
+	v := m._type
+	if v == nil {
+		return
 	}
-	if m.user != nil {
-		fields = append(fields, entity.FieldCreatedBy)
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the Entity entity.
+// If the Entity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntityMutation) OldType(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
-	if m.upload_session_id != nil {
-		fields = append(fields, entity.FieldUploadSessionID)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
 	}
-	if m.props != nil {
-		fields = append(fields, entity.FieldProps)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
 	}
-	return fields
+	return oldValue.Type, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *EntityMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case entity.FieldCreatedAt:
-		return m.CreatedAt()
-	case entity.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case entity.FieldDeletedAt:
-		return m.DeletedAt()
-	case entity.FieldType:
-		return m.GetType()
-	case entity.FieldSource:
-		return m.Source()
-	case entity.FieldSize:
-		return m.Size()
-	case entity.FieldReferenceCount:
-		return m.ReferenceCount()
-	case entity.FieldStoragePolicyEntities:
-		return m.StoragePolicyEntities()
-	case entity.FieldCreatedBy:
-		return m.CreatedBy()
-	case entity.FieldUploadSessionID:
-		return m.UploadSessionID()
-	case entity.FieldProps:
-		return m.Props()
+// AddType adds i to the "type" field.
+func (m *EntityMutation) AddType(i int) {
+	if m.add_type != nil {
+		*m.add_type += i
+	} else {
+		m.add_type = &i
 	}
-	return nil, false
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *EntityMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case entity.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case entity.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case entity.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
-	case entity.FieldType:
-		return m.OldType(ctx)
-	case entity.FieldSource:
-		return m.OldSource(ctx)
-	case entity.FieldSize:
-		return m.OldSize(ctx)
-	case entity.FieldReferenceCount:
-		return m.OldReferenceCount(ctx)
-	case entity.FieldStoragePolicyEntities:
-		return m.OldStoragePolicyEntities(ctx)
-	case entity.FieldCreatedBy:
+// AddedType returns the value that was added to the "type" field in this mutation.
+func (m *EntityMutation) AddedType() (r int, exists bool) {
+	v := m.add_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *EntityMutation) ResetType() {
+	m._type = nil
+	m.add_type = nil
+}
+
+// SetSource sets the "source" field.
+func (m *EntityMutation) SetSource(s string) {
+	m.source = &s
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *EntityMutation) Source() (r string, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old "source" field's value of the Entity entity.
+// If the Entity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntityMutation) OldSource(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *EntityMutation) ResetSource() {
+	m.source = nil
+}
+
+// SetSize sets the "size" field.
+func (m *EntityMutation) SetSize(i int64) {
+	m.size = &i
+	m.addsize = nil
+}
+
+// Size returns the value of the "size" field in the mutation.
+func (m *EntityMutation) Size() (r int64, exists bool) {
+	v := m.size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSize returns the old "size" field's value of the Entity entity.
+// If the Entity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntityMutation) OldSize(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
+	}
+	return oldValue.Size, nil
+}
+
+// AddSize adds i to the "size" field.
+func (m *EntityMutation) AddSize(i int64) {
+	if m.addsize != nil {
+		*m.addsize += i
+	} else {
+		m.addsize = &i
+	}
+}
+
+// AddedSize returns the value that was added to the "size" field in this mutation.
+func (m *EntityMutation) AddedSize() (r int64, exists bool) {
+	v := m.addsize
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSize resets all changes to the "size" field.
+func (m *EntityMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
+}
+
+// SetReferenceCount sets the "reference_count" field.
+func (m *EntityMutation) SetReferenceCount(i int) {
+	m.reference_count = &i
+	m.addreference_count = nil
+}
+
+// ReferenceCount returns the value of the "reference_count" field in the mutation.
+func (m *EntityMutation) ReferenceCount() (r int, exists bool) {
+	v := m.reference_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReferenceCount returns the old "reference_count" field's value of the Entity entity.
+// If the Entity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntityMutation) OldReferenceCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReferenceCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReferenceCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReferenceCount: %w", err)
+	}
+	return oldValue.ReferenceCount, nil
+}
+
+// AddReferenceCount adds i to the "reference_count" field.
+func (m *EntityMutation) AddReferenceCount(i int) {
+	if m.addreference_count != nil {
+		*m.addreference_count += i
+	} else {
+		m.addreference_count = &i
+	}
+}
+
+// AddedReferenceCount returns the value that was added to the "reference_count" field in this mutation.
+func (m *EntityMutation) AddedReferenceCount() (r int, exists bool) {
+	v := m.addreference_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetReferenceCount resets all changes to the "reference_count" field.
+func (m *EntityMutation) ResetReferenceCount() {
+	m.reference_count = nil
+	m.addreference_count = nil
+}
+
+// SetStoragePolicyEntities sets the "storage_policy_entities" field.
+func (m *EntityMutation) SetStoragePolicyEntities(i int) {
+	m.storage_policy = &i
+}
+
+// StoragePolicyEntities returns the value of the "storage_policy_entities" field in the mutation.
+func (m *EntityMutation) StoragePolicyEntities() (r int, exists bool) {
+	v := m.storage_policy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStoragePolicyEntities returns the old "storage_policy_entities" field's value of the Entity entity.
+// If the Entity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntityMutation) OldStoragePolicyEntities(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStoragePolicyEntities is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStoragePolicyEntities requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStoragePolicyEntities: %w", err)
+	}
+	return oldValue.StoragePolicyEntities, nil
+}
+
+// ResetStoragePolicyEntities resets all changes to the "storage_policy_entities" field.
+func (m *EntityMutation) ResetStoragePolicyEntities() {
+	m.storage_policy = nil
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (m *EntityMutation) SetCreatedBy(i int) {
+	m.user = &i
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *EntityMutation) CreatedBy() (r int, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedBy returns the old "created_by" field's value of the Entity entity.
+// If the Entity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntityMutation) OldCreatedBy(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (m *EntityMutation) ClearCreatedBy() {
+	m.user = nil
+	m.clearedFields[entity.FieldCreatedBy] = struct{}{}
+}
+
+// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
+func (m *EntityMutation) CreatedByCleared() bool {
+	_, ok := m.clearedFields[entity.FieldCreatedBy]
+	return ok
+}
+
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *EntityMutation) ResetCreatedBy() {
+	m.user = nil
+	delete(m.clearedFields, entity.FieldCreatedBy)
+}
+
+// SetUploadSessionID sets the "upload_session_id" field.
+func (m *EntityMutation) SetUploadSessionID(u uuid.UUID) {
+	m.upload_session_id = &u
+}
+
+// UploadSessionID returns the value of the "upload_session_id" field in the mutation.
+func (m *EntityMutation) UploadSessionID() (r uuid.UUID, exists bool) {
+	v := m.upload_session_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUploadSessionID returns the old "upload_session_id" field's value of the Entity entity.
+// If the Entity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntityMutation) OldUploadSessionID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUploadSessionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUploadSessionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUploadSessionID: %w", err)
+	}
+	return oldValue.UploadSessionID, nil
+}
+
+// ClearUploadSessionID clears the value of the "upload_session_id" field.
+func (m *EntityMutation) ClearUploadSessionID() {
+	m.upload_session_id = nil
+	m.clearedFields[entity.FieldUploadSessionID] = struct{}{}
+}
+
+// UploadSessionIDCleared returns if the "upload_session_id" field was cleared in this mutation.
+func (m *EntityMutation) UploadSessionIDCleared() bool {
+	_, ok := m.clearedFields[entity.FieldUploadSessionID]
+	return ok
+}
+
+// ResetUploadSessionID resets all changes to the "upload_session_id" field.
+func (m *EntityMutation) ResetUploadSessionID() {
+	m.upload_session_id = nil
+	delete(m.clearedFields, entity.FieldUploadSessionID)
+}
+
+// SetProps sets the "props" field.
+func (m *EntityMutation) SetProps(tp *types.EntityProps) {
+	m.props = &tp
+}
+
+// Props returns the value of the "props" field in the mutation.
+func (m *EntityMutation) Props() (r *types.EntityProps, exists bool) {
+	v := m.props
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProps returns the old "props" field's value of the Entity entity.
+// If the Entity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntityMutation) OldProps(ctx context.Context) (v *types.EntityProps, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProps is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProps requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProps: %w", err)
+	}
+	return oldValue.Props, nil
+}
+
+// ClearProps clears the value of the "props" field.
+func (m *EntityMutation) ClearProps() {
+	m.props = nil
+	m.clearedFields[entity.FieldProps] = struct{}{}
+}
+
+// PropsCleared returns if the "props" field was cleared in this mutation.
+func (m *EntityMutation) PropsCleared() bool {
+	_, ok := m.clearedFields[entity.FieldProps]
+	return ok
+}
+
+// ResetProps resets all changes to the "props" field.
+func (m *EntityMutation) ResetProps() {
+	m.props = nil
+	delete(m.clearedFields, entity.FieldProps)
+}
+
+// AddFileIDs adds the "file" edge to the File entity by ids.
+func (m *EntityMutation) AddFileIDs(ids ...int) {
+	if m.file == nil {
+		m.file = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.file[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFile clears the "file" edge to the File entity.
+func (m *EntityMutation) ClearFile() {
+	m.clearedfile = true
+}
+
+// FileCleared reports if the "file" edge to the File entity was cleared.
+func (m *EntityMutation) FileCleared() bool {
+	return m.clearedfile
+}
+
+// RemoveFileIDs removes the "file" edge to the File entity by IDs.
+func (m *EntityMutation) RemoveFileIDs(ids ...int) {
+	if m.removedfile == nil {
+		m.removedfile = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.file, ids[i])
+		m.removedfile[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFile returns the removed IDs of the "file" edge to the File entity.
+func (m *EntityMutation) RemovedFileIDs() (ids []int) {
+	for id := range m.removedfile {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FileIDs returns the "file" edge IDs in the mutation.
+func (m *EntityMutation) FileIDs() (ids []int) {
+	for id := range m.file {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFile resets all changes to the "file" edge.
+func (m *EntityMutation) ResetFile() {
+	m.file = nil
+	m.clearedfile = false
+	m.removedfile = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *EntityMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *EntityMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[entity.FieldCreatedBy] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *EntityMutation) UserCleared() bool {
+	return m.CreatedByCleared() || m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *EntityMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *EntityMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *EntityMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// SetStoragePolicyID sets the "storage_policy" edge to the StoragePolicy entity by id.
+func (m *EntityMutation) SetStoragePolicyID(id int) {
+	m.storage_policy = &id
+}
+
+// ClearStoragePolicy clears the "storage_policy" edge to the StoragePolicy entity.
+func (m *EntityMutation) ClearStoragePolicy() {
+	m.clearedstorage_policy = true
+	m.clearedFields[entity.FieldStoragePolicyEntities] = struct{}{}
+}
+
+// StoragePolicyCleared reports if the "storage_policy" edge to the StoragePolicy entity was cleared.
+func (m *EntityMutation) StoragePolicyCleared() bool {
+	return m.clearedstorage_policy
+}
+
+// StoragePolicyID returns the "storage_policy" edge ID in the mutation.
+func (m *EntityMutation) StoragePolicyID() (id int, exists bool) {
+	if m.storage_policy != nil {
+		return *m.storage_policy, true
+	}
+	return
+}
+
+// StoragePolicyIDs returns the "storage_policy" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// StoragePolicyID instead. It exists only for internal usage by the builders.
+func (m *EntityMutation) StoragePolicyIDs() (ids []int) {
+	if id := m.storage_policy; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetStoragePolicy resets all changes to the "storage_policy" edge.
+func (m *EntityMutation) ResetStoragePolicy() {
+	m.storage_policy = nil
+	m.clearedstorage_policy = false
+}
+
+// Where appends a list predicates to the EntityMutation builder.
+func (m *EntityMutation) Where(ps ...predicate.Entity) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EntityMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EntityMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Entity, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EntityMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EntityMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Entity).
+func (m *EntityMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EntityMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.created_at != nil {
+		fields = append(fields, entity.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, entity.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, entity.FieldDeletedAt)
+	}
+	if m._type != nil {
+		fields = append(fields, entity.FieldType)
+	}
+	if m.source != nil {
+		fields = append(fields, entity.FieldSource)
+	}
+	if m.size != nil {
+		fields = append(fields, entity.FieldSize)
+	}
+	if m.reference_count != nil {
+		fields = append(fields, entity.FieldReferenceCount)
+	}
+	if m.storage_policy != nil {
+		fields = append(fields, entity.FieldStoragePolicyEntities)
+	}
+	if m.user != nil {
+		fields = append(fields, entity.FieldCreatedBy)
+	}
+	if m.upload_session_id != nil {
+		fields = append(fields, entity.FieldUploadSessionID)
+	}
+	if m.props != nil {
+		fields = append(fields, entity.FieldProps)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EntityMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case entity.FieldCreatedAt:
+		return m.CreatedAt()
+	case entity.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case entity.FieldDeletedAt:
+		return m.DeletedAt()
+	case entity.FieldType:
+		return m.GetType()
+	case entity.FieldSource:
+		return m.Source()
+	case entity.FieldSize:
+		return m.Size()
+	case entity.FieldReferenceCount:
+		return m.ReferenceCount()
+	case entity.FieldStoragePolicyEntities:
+		return m.StoragePolicyEntities()
+	case entity.FieldCreatedBy:
+		return m.CreatedBy()
+	case entity.FieldUploadSessionID:
+		return m.UploadSessionID()
+	case entity.FieldProps:
+		return m.Props()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EntityMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case entity.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case entity.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case entity.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case entity.FieldType:
+		return m.OldType(ctx)
+	case entity.FieldSource:
+		return m.OldSource(ctx)
+	case entity.FieldSize:
+		return m.OldSize(ctx)
+	case entity.FieldReferenceCount:
+		return m.OldReferenceCount(ctx)
+	case entity.FieldStoragePolicyEntities:
+		return m.OldStoragePolicyEntities(ctx)
+	case entity.FieldCreatedBy:
 		return m.OldCreatedBy(ctx)
 	case entity.FieldUploadSessionID:
-		return m.OldUploadSessionID(ctx)
+		return m.OldUploadSessionID(ctx)
+	case entity.FieldProps:
+		return m.OldProps(ctx)
+	}
+	return nil, fmt.Errorf("unknown Entity field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EntityMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case entity.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case entity.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case entity.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case entity.FieldType:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case entity.FieldSource:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case entity.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSize(v)
+		return nil
+	case entity.FieldReferenceCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReferenceCount(v)
+		return nil
+	case entity.FieldStoragePolicyEntities:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStoragePolicyEntities(v)
+		return nil
+	case entity.FieldCreatedBy:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case entity.FieldUploadSessionID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUploadSessionID(v)
+		return nil
+	case entity.FieldProps:
+		v, ok := value.(*types.EntityProps)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProps(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Entity field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EntityMutation) AddedFields() []string {
+	var fields []string
+	if m.add_type != nil {
+		fields = append(fields, entity.FieldType)
+	}
+	if m.addsize != nil {
+		fields = append(fields, entity.FieldSize)
+	}
+	if m.addreference_count != nil {
+		fields = append(fields, entity.FieldReferenceCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EntityMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case entity.FieldType:
+		return m.AddedType()
+	case entity.FieldSize:
+		return m.AddedSize()
+	case entity.FieldReferenceCount:
+		return m.AddedReferenceCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EntityMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case entity.FieldType:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddType(v)
+		return nil
+	case entity.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSize(v)
+		return nil
+	case entity.FieldReferenceCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddReferenceCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Entity numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EntityMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(entity.FieldDeletedAt) {
+		fields = append(fields, entity.FieldDeletedAt)
+	}
+	if m.FieldCleared(entity.FieldCreatedBy) {
+		fields = append(fields, entity.FieldCreatedBy)
+	}
+	if m.FieldCleared(entity.FieldUploadSessionID) {
+		fields = append(fields, entity.FieldUploadSessionID)
+	}
+	if m.FieldCleared(entity.FieldProps) {
+		fields = append(fields, entity.FieldProps)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EntityMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EntityMutation) ClearField(name string) error {
+	switch name {
+	case entity.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case entity.FieldCreatedBy:
+		m.ClearCreatedBy()
+		return nil
+	case entity.FieldUploadSessionID:
+		m.ClearUploadSessionID()
+		return nil
+	case entity.FieldProps:
+		m.ClearProps()
+		return nil
+	}
+	return fmt.Errorf("unknown Entity nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EntityMutation) ResetField(name string) error {
+	switch name {
+	case entity.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case entity.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case entity.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case entity.FieldType:
+		m.ResetType()
+		return nil
+	case entity.FieldSource:
+		m.ResetSource()
+		return nil
+	case entity.FieldSize:
+		m.ResetSize()
+		return nil
+	case entity.FieldReferenceCount:
+		m.ResetReferenceCount()
+		return nil
+	case entity.FieldStoragePolicyEntities:
+		m.ResetStoragePolicyEntities()
+		return nil
+	case entity.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case entity.FieldUploadSessionID:
+		m.ResetUploadSessionID()
+		return nil
 	case entity.FieldProps:
+		m.ResetProps()
+		return nil
+	}
+	return fmt.Errorf("unknown Entity field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EntityMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.file != nil {
+		edges = append(edges, entity.EdgeFile)
+	}
+	if m.user != nil {
+		edges = append(edges, entity.EdgeUser)
+	}
+	if m.storage_policy != nil {
+		edges = append(edges, entity.EdgeStoragePolicy)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EntityMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case entity.EdgeFile:
+		ids := make([]ent.Value, 0, len(m.file))
+		for id := range m.file {
+			ids = append(ids, id)
+		}
+		return ids
+	case entity.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case entity.EdgeStoragePolicy:
+		if id := m.storage_policy; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EntityMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedfile != nil {
+		edges = append(edges, entity.EdgeFile)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EntityMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case entity.EdgeFile:
+		ids := make([]ent.Value, 0, len(m.removedfile))
+		for id := range m.removedfile {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EntityMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedfile {
+		edges = append(edges, entity.EdgeFile)
+	}
+	if m.cleareduser {
+		edges = append(edges, entity.EdgeUser)
+	}
+	if m.clearedstorage_policy {
+		edges = append(edges, entity.EdgeStoragePolicy)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EntityMutation) EdgeCleared(name string) bool {
+	switch name {
+	case entity.EdgeFile:
+		return m.clearedfile
+	case entity.EdgeUser:
+		return m.cleareduser
+	case entity.EdgeStoragePolicy:
+		return m.clearedstorage_policy
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EntityMutation) ClearEdge(name string) error {
+	switch name {
+	case entity.EdgeUser:
+		m.ClearUser()
+		return nil
+	case entity.EdgeStoragePolicy:
+		m.ClearStoragePolicy()
+		return nil
+	}
+	return fmt.Errorf("unknown Entity unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EntityMutation) ResetEdge(name string) error {
+	switch name {
+	case entity.EdgeFile:
+		m.ResetFile()
+		return nil
+	case entity.EdgeUser:
+		m.ResetUser()
+		return nil
+	case entity.EdgeStoragePolicy:
+		m.ResetStoragePolicy()
+		return nil
+	}
+	return fmt.Errorf("unknown Entity edge %s", name)
+}
+
+// FeedItemMutation represents an operation that mutates the FeedItem nodes in the graph.
+type FeedItemMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	guid                *string
+	title               *string
+	link                *string
+	published_at        *time.Time
+	matched             *bool
+	task_id             *int
+	addtask_id          *int
+	error               *string
+	seen_at             *time.Time
+	clearedFields       map[string]struct{}
+	subscription        *int
+	clearedsubscription bool
+	done                bool
+	oldValue            func(context.Context) (*FeedItem, error)
+	predicates          []predicate.FeedItem
+}
+
+var _ ent.Mutation = (*FeedItemMutation)(nil)
+
+// feeditemOption allows management of the mutation configuration using functional options.
+type feeditemOption func(*FeedItemMutation)
+
+// newFeedItemMutation creates new mutation for the FeedItem entity.
+func newFeedItemMutation(c config, op Op, opts ...feeditemOption) *FeedItemMutation {
+	m := &FeedItemMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFeedItem,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withFeedItemID sets the ID field of the mutation.
+func withFeedItemID(id int) feeditemOption {
+	return func(m *FeedItemMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *FeedItem
+		)
+		m.oldValue = func(ctx context.Context) (*FeedItem, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().FeedItem.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withFeedItem sets the old FeedItem of the mutation.
+func withFeedItem(node *FeedItem) feeditemOption {
+	return func(m *FeedItemMutation) {
+		m.oldValue = func(context.Context) (*FeedItem, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FeedItemMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FeedItemMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FeedItemMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FeedItemMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().FeedItem.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetGUID sets the "guid" field.
+func (m *FeedItemMutation) SetGUID(s string) {
+	m.guid = &s
+}
+
+// GUID returns the value of the "guid" field in the mutation.
+func (m *FeedItemMutation) GUID() (r string, exists bool) {
+	v := m.guid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGUID returns the old "guid" field's value of the FeedItem entity.
+// If the FeedItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeedItemMutation) OldGUID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGUID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGUID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGUID: %w", err)
+	}
+	return oldValue.GUID, nil
+}
+
+// ResetGUID resets all changes to the "guid" field.
+func (m *FeedItemMutation) ResetGUID() {
+	m.guid = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *FeedItemMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *FeedItemMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the FeedItem entity.
+// If the FeedItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeedItemMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *FeedItemMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetLink sets the "link" field.
+func (m *FeedItemMutation) SetLink(s string) {
+	m.link = &s
+}
+
+// Link returns the value of the "link" field in the mutation.
+func (m *FeedItemMutation) Link() (r string, exists bool) {
+	v := m.link
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLink returns the old "link" field's value of the FeedItem entity.
+// If the FeedItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeedItemMutation) OldLink(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLink is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLink requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLink: %w", err)
+	}
+	return oldValue.Link, nil
+}
+
+// ResetLink resets all changes to the "link" field.
+func (m *FeedItemMutation) ResetLink() {
+	m.link = nil
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (m *FeedItemMutation) SetPublishedAt(t time.Time) {
+	m.published_at = &t
+}
+
+// PublishedAt returns the value of the "published_at" field in the mutation.
+func (m *FeedItemMutation) PublishedAt() (r time.Time, exists bool) {
+	v := m.published_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublishedAt returns the old "published_at" field's value of the FeedItem entity.
+// If the FeedItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeedItemMutation) OldPublishedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublishedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublishedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublishedAt: %w", err)
+	}
+	return oldValue.PublishedAt, nil
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (m *FeedItemMutation) ClearPublishedAt() {
+	m.published_at = nil
+	m.clearedFields[feeditem.FieldPublishedAt] = struct{}{}
+}
+
+// PublishedAtCleared returns if the "published_at" field was cleared in this mutation.
+func (m *FeedItemMutation) PublishedAtCleared() bool {
+	_, ok := m.clearedFields[feeditem.FieldPublishedAt]
+	return ok
+}
+
+// ResetPublishedAt resets all changes to the "published_at" field.
+func (m *FeedItemMutation) ResetPublishedAt() {
+	m.published_at = nil
+	delete(m.clearedFields, feeditem.FieldPublishedAt)
+}
+
+// SetMatched sets the "matched" field.
+func (m *FeedItemMutation) SetMatched(b bool) {
+	m.matched = &b
+}
+
+// Matched returns the value of the "matched" field in the mutation.
+func (m *FeedItemMutation) Matched() (r bool, exists bool) {
+	v := m.matched
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMatched returns the old "matched" field's value of the FeedItem entity.
+// If the FeedItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeedItemMutation) OldMatched(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMatched is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMatched requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMatched: %w", err)
+	}
+	return oldValue.Matched, nil
+}
+
+// ResetMatched resets all changes to the "matched" field.
+func (m *FeedItemMutation) ResetMatched() {
+	m.matched = nil
+}
+
+// SetTaskID sets the "task_id" field.
+func (m *FeedItemMutation) SetTaskID(i int) {
+	m.task_id = &i
+	m.addtask_id = nil
+}
+
+// TaskID returns the value of the "task_id" field in the mutation.
+func (m *FeedItemMutation) TaskID() (r int, exists bool) {
+	v := m.task_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTaskID returns the old "task_id" field's value of the FeedItem entity.
+// If the FeedItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeedItemMutation) OldTaskID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTaskID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTaskID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTaskID: %w", err)
+	}
+	return oldValue.TaskID, nil
+}
+
+// AddTaskID adds i to the "task_id" field.
+func (m *FeedItemMutation) AddTaskID(i int) {
+	if m.addtask_id != nil {
+		*m.addtask_id += i
+	} else {
+		m.addtask_id = &i
+	}
+}
+
+// AddedTaskID returns the value that was added to the "task_id" field in this mutation.
+func (m *FeedItemMutation) AddedTaskID() (r int, exists bool) {
+	v := m.addtask_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (m *FeedItemMutation) ClearTaskID() {
+	m.task_id = nil
+	m.addtask_id = nil
+	m.clearedFields[feeditem.FieldTaskID] = struct{}{}
+}
+
+// TaskIDCleared returns if the "task_id" field was cleared in this mutation.
+func (m *FeedItemMutation) TaskIDCleared() bool {
+	_, ok := m.clearedFields[feeditem.FieldTaskID]
+	return ok
+}
+
+// ResetTaskID resets all changes to the "task_id" field.
+func (m *FeedItemMutation) ResetTaskID() {
+	m.task_id = nil
+	m.addtask_id = nil
+	delete(m.clearedFields, feeditem.FieldTaskID)
+}
+
+// SetError sets the "error" field.
+func (m *FeedItemMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *FeedItemMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the FeedItem entity.
+// If the FeedItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeedItemMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *FeedItemMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[feeditem.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *FeedItemMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[feeditem.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *FeedItemMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, feeditem.FieldError)
+}
+
+// SetSeenAt sets the "seen_at" field.
+func (m *FeedItemMutation) SetSeenAt(t time.Time) {
+	m.seen_at = &t
+}
+
+// SeenAt returns the value of the "seen_at" field in the mutation.
+func (m *FeedItemMutation) SeenAt() (r time.Time, exists bool) {
+	v := m.seen_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeenAt returns the old "seen_at" field's value of the FeedItem entity.
+// If the FeedItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeedItemMutation) OldSeenAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeenAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeenAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeenAt: %w", err)
+	}
+	return oldValue.SeenAt, nil
+}
+
+// ResetSeenAt resets all changes to the "seen_at" field.
+func (m *FeedItemMutation) ResetSeenAt() {
+	m.seen_at = nil
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (m *FeedItemMutation) SetSubscriptionID(i int) {
+	m.subscription = &i
+}
+
+// SubscriptionID returns the value of the "subscription_id" field in the mutation.
+func (m *FeedItemMutation) SubscriptionID() (r int, exists bool) {
+	v := m.subscription
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubscriptionID returns the old "subscription_id" field's value of the FeedItem entity.
+// If the FeedItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeedItemMutation) OldSubscriptionID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubscriptionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubscriptionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubscriptionID: %w", err)
+	}
+	return oldValue.SubscriptionID, nil
+}
+
+// ResetSubscriptionID resets all changes to the "subscription_id" field.
+func (m *FeedItemMutation) ResetSubscriptionID() {
+	m.subscription = nil
+}
+
+// ClearSubscription clears the "subscription" edge to the FeedSubscription entity.
+func (m *FeedItemMutation) ClearSubscription() {
+	m.clearedsubscription = true
+	m.clearedFields[feeditem.FieldSubscriptionID] = struct{}{}
+}
+
+// SubscriptionCleared reports if the "subscription" edge to the FeedSubscription entity was cleared.
+func (m *FeedItemMutation) SubscriptionCleared() bool {
+	return m.clearedsubscription
+}
+
+// SubscriptionIDs returns the "subscription" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SubscriptionID instead. It exists only for internal usage by the builders.
+func (m *FeedItemMutation) SubscriptionIDs() (ids []int) {
+	if id := m.subscription; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSubscription resets all changes to the "subscription" edge.
+func (m *FeedItemMutation) ResetSubscription() {
+	m.subscription = nil
+	m.clearedsubscription = false
+}
+
+// Where appends a list predicates to the FeedItemMutation builder.
+func (m *FeedItemMutation) Where(ps ...predicate.FeedItem) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FeedItemMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FeedItemMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.FeedItem, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FeedItemMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FeedItemMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (FeedItem).
+func (m *FeedItemMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FeedItemMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.guid != nil {
+		fields = append(fields, feeditem.FieldGUID)
+	}
+	if m.title != nil {
+		fields = append(fields, feeditem.FieldTitle)
+	}
+	if m.link != nil {
+		fields = append(fields, feeditem.FieldLink)
+	}
+	if m.published_at != nil {
+		fields = append(fields, feeditem.FieldPublishedAt)
+	}
+	if m.matched != nil {
+		fields = append(fields, feeditem.FieldMatched)
+	}
+	if m.task_id != nil {
+		fields = append(fields, feeditem.FieldTaskID)
+	}
+	if m.error != nil {
+		fields = append(fields, feeditem.FieldError)
+	}
+	if m.seen_at != nil {
+		fields = append(fields, feeditem.FieldSeenAt)
+	}
+	if m.subscription != nil {
+		fields = append(fields, feeditem.FieldSubscriptionID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FeedItemMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case feeditem.FieldGUID:
+		return m.GUID()
+	case feeditem.FieldTitle:
+		return m.Title()
+	case feeditem.FieldLink:
+		return m.Link()
+	case feeditem.FieldPublishedAt:
+		return m.PublishedAt()
+	case feeditem.FieldMatched:
+		return m.Matched()
+	case feeditem.FieldTaskID:
+		return m.TaskID()
+	case feeditem.FieldError:
+		return m.Error()
+	case feeditem.FieldSeenAt:
+		return m.SeenAt()
+	case feeditem.FieldSubscriptionID:
+		return m.SubscriptionID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FeedItemMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case feeditem.FieldGUID:
+		return m.OldGUID(ctx)
+	case feeditem.FieldTitle:
+		return m.OldTitle(ctx)
+	case feeditem.FieldLink:
+		return m.OldLink(ctx)
+	case feeditem.FieldPublishedAt:
+		return m.OldPublishedAt(ctx)
+	case feeditem.FieldMatched:
+		return m.OldMatched(ctx)
+	case feeditem.FieldTaskID:
+		return m.OldTaskID(ctx)
+	case feeditem.FieldError:
+		return m.OldError(ctx)
+	case feeditem.FieldSeenAt:
+		return m.OldSeenAt(ctx)
+	case feeditem.FieldSubscriptionID:
+		return m.OldSubscriptionID(ctx)
+	}
+	return nil, fmt.Errorf("unknown FeedItem field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FeedItemMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case feeditem.FieldGUID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGUID(v)
+		return nil
+	case feeditem.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case feeditem.FieldLink:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLink(v)
+		return nil
+	case feeditem.FieldPublishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublishedAt(v)
+		return nil
+	case feeditem.FieldMatched:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMatched(v)
+		return nil
+	case feeditem.FieldTaskID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTaskID(v)
+		return nil
+	case feeditem.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case feeditem.FieldSeenAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeenAt(v)
+		return nil
+	case feeditem.FieldSubscriptionID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubscriptionID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FeedItem field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FeedItemMutation) AddedFields() []string {
+	var fields []string
+	if m.addtask_id != nil {
+		fields = append(fields, feeditem.FieldTaskID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FeedItemMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case feeditem.FieldTaskID:
+		return m.AddedTaskID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FeedItemMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case feeditem.FieldTaskID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTaskID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FeedItem numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FeedItemMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(feeditem.FieldPublishedAt) {
+		fields = append(fields, feeditem.FieldPublishedAt)
+	}
+	if m.FieldCleared(feeditem.FieldTaskID) {
+		fields = append(fields, feeditem.FieldTaskID)
+	}
+	if m.FieldCleared(feeditem.FieldError) {
+		fields = append(fields, feeditem.FieldError)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FeedItemMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FeedItemMutation) ClearField(name string) error {
+	switch name {
+	case feeditem.FieldPublishedAt:
+		m.ClearPublishedAt()
+		return nil
+	case feeditem.FieldTaskID:
+		m.ClearTaskID()
+		return nil
+	case feeditem.FieldError:
+		m.ClearError()
+		return nil
+	}
+	return fmt.Errorf("unknown FeedItem nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FeedItemMutation) ResetField(name string) error {
+	switch name {
+	case feeditem.FieldGUID:
+		m.ResetGUID()
+		return nil
+	case feeditem.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case feeditem.FieldLink:
+		m.ResetLink()
+		return nil
+	case feeditem.FieldPublishedAt:
+		m.ResetPublishedAt()
+		return nil
+	case feeditem.FieldMatched:
+		m.ResetMatched()
+		return nil
+	case feeditem.FieldTaskID:
+		m.ResetTaskID()
+		return nil
+	case feeditem.FieldError:
+		m.ResetError()
+		return nil
+	case feeditem.FieldSeenAt:
+		m.ResetSeenAt()
+		return nil
+	case feeditem.FieldSubscriptionID:
+		m.ResetSubscriptionID()
+		return nil
+	}
+	return fmt.Errorf("unknown FeedItem field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FeedItemMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.subscription != nil {
+		edges = append(edges, feeditem.EdgeSubscription)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FeedItemMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case feeditem.EdgeSubscription:
+		if id := m.subscription; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FeedItemMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FeedItemMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FeedItemMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedsubscription {
+		edges = append(edges, feeditem.EdgeSubscription)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FeedItemMutation) EdgeCleared(name string) bool {
+	switch name {
+	case feeditem.EdgeSubscription:
+		return m.clearedsubscription
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FeedItemMutation) ClearEdge(name string) error {
+	switch name {
+	case feeditem.EdgeSubscription:
+		m.ClearSubscription()
+		return nil
+	}
+	return fmt.Errorf("unknown FeedItem unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FeedItemMutation) ResetEdge(name string) error {
+	switch name {
+	case feeditem.EdgeSubscription:
+		m.ResetSubscription()
+		return nil
+	}
+	return fmt.Errorf("unknown FeedItem edge %s", name)
+}
+
+// FeedSubscriptionMutation represents an operation that mutates the FeedSubscription nodes in the graph.
+type FeedSubscriptionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	created_at    *time.Time
+	updated_at    *time.Time
+	deleted_at    *time.Time
+	name          *string
+	url           *string
+	include       *string
+	exclude       *string
+	dst           *string
+	enabled       *bool
+	checked_at    *time.Time
+	error         *string
+	clearedFields map[string]struct{}
+	owner         *int
+	clearedowner  bool
+	items         map[int]struct{}
+	removeditems  map[int]struct{}
+	cleareditems  bool
+	done          bool
+	oldValue      func(context.Context) (*FeedSubscription, error)
+	predicates    []predicate.FeedSubscription
+}
+
+var _ ent.Mutation = (*FeedSubscriptionMutation)(nil)
+
+// feedsubscriptionOption allows management of the mutation configuration using functional options.
+type feedsubscriptionOption func(*FeedSubscriptionMutation)
+
+// newFeedSubscriptionMutation creates new mutation for the FeedSubscription entity.
+func newFeedSubscriptionMutation(c config, op Op, opts ...feedsubscriptionOption) *FeedSubscriptionMutation {
+	m := &FeedSubscriptionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFeedSubscription,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withFeedSubscriptionID sets the ID field of the mutation.
+func withFeedSubscriptionID(id int) feedsubscriptionOption {
+	return func(m *FeedSubscriptionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *FeedSubscription
+		)
+		m.oldValue = func(ctx context.Context) (*FeedSubscription, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().FeedSubscription.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withFeedSubscription sets the old FeedSubscription of the mutation.
+func withFeedSubscription(node *FeedSubscription) feedsubscriptionOption {
+	return func(m *FeedSubscriptionMutation) {
+		m.oldValue = func(context.Context) (*FeedSubscription, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FeedSubscriptionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FeedSubscriptionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FeedSubscriptionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FeedSubscriptionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().FeedSubscription.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *FeedSubscriptionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *FeedSubscriptionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the FeedSubscription entity.
+// If the FeedSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeedSubscriptionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *FeedSubscriptionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *FeedSubscriptionMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *FeedSubscriptionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the FeedSubscription entity.
+// If the FeedSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeedSubscriptionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *FeedSubscriptionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *FeedSubscriptionMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *FeedSubscriptionMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the FeedSubscription entity.
+// If the FeedSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeedSubscriptionMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *FeedSubscriptionMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[feedsubscription.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *FeedSubscriptionMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[feedsubscription.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *FeedSubscriptionMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, feedsubscription.FieldDeletedAt)
+}
+
+// SetName sets the "name" field.
+func (m *FeedSubscriptionMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *FeedSubscriptionMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the FeedSubscription entity.
+// If the FeedSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeedSubscriptionMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *FeedSubscriptionMutation) ResetName() {
+	m.name = nil
+}
+
+// SetURL sets the "url" field.
+func (m *FeedSubscriptionMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *FeedSubscriptionMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURL returns the old "url" field's value of the FeedSubscription entity.
+// If the FeedSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeedSubscriptionMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
+}
+
+// ResetURL resets all changes to the "url" field.
+func (m *FeedSubscriptionMutation) ResetURL() {
+	m.url = nil
+}
+
+// SetInclude sets the "include" field.
+func (m *FeedSubscriptionMutation) SetInclude(s string) {
+	m.include = &s
+}
+
+// Include returns the value of the "include" field in the mutation.
+func (m *FeedSubscriptionMutation) Include() (r string, exists bool) {
+	v := m.include
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInclude returns the old "include" field's value of the FeedSubscription entity.
+// If the FeedSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeedSubscriptionMutation) OldInclude(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInclude is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInclude requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInclude: %w", err)
+	}
+	return oldValue.Include, nil
+}
+
+// ClearInclude clears the value of the "include" field.
+func (m *FeedSubscriptionMutation) ClearInclude() {
+	m.include = nil
+	m.clearedFields[feedsubscription.FieldInclude] = struct{}{}
+}
+
+// IncludeCleared returns if the "include" field was cleared in this mutation.
+func (m *FeedSubscriptionMutation) IncludeCleared() bool {
+	_, ok := m.clearedFields[feedsubscription.FieldInclude]
+	return ok
+}
+
+// ResetInclude resets all changes to the "include" field.
+func (m *FeedSubscriptionMutation) ResetInclude() {
+	m.include = nil
+	delete(m.clearedFields, feedsubscription.FieldInclude)
+}
+
+// SetExclude sets the "exclude" field.
+func (m *FeedSubscriptionMutation) SetExclude(s string) {
+	m.exclude = &s
+}
+
+// Exclude returns the value of the "exclude" field in the mutation.
+func (m *FeedSubscriptionMutation) Exclude() (r string, exists bool) {
+	v := m.exclude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExclude returns the old "exclude" field's value of the FeedSubscription entity.
+// If the FeedSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeedSubscriptionMutation) OldExclude(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExclude is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExclude requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExclude: %w", err)
+	}
+	return oldValue.Exclude, nil
+}
+
+// ClearExclude clears the value of the "exclude" field.
+func (m *FeedSubscriptionMutation) ClearExclude() {
+	m.exclude = nil
+	m.clearedFields[feedsubscription.FieldExclude] = struct{}{}
+}
+
+// ExcludeCleared returns if the "exclude" field was cleared in this mutation.
+func (m *FeedSubscriptionMutation) ExcludeCleared() bool {
+	_, ok := m.clearedFields[feedsubscription.FieldExclude]
+	return ok
+}
+
+// ResetExclude resets all changes to the "exclude" field.
+func (m *FeedSubscriptionMutation) ResetExclude() {
+	m.exclude = nil
+	delete(m.clearedFields, feedsubscription.FieldExclude)
+}
+
+// SetDst sets the "dst" field.
+func (m *FeedSubscriptionMutation) SetDst(s string) {
+	m.dst = &s
+}
+
+// Dst returns the value of the "dst" field in the mutation.
+func (m *FeedSubscriptionMutation) Dst() (r string, exists bool) {
+	v := m.dst
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDst returns the old "dst" field's value of the FeedSubscription entity.
+// If the FeedSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeedSubscriptionMutation) OldDst(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDst is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDst requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDst: %w", err)
+	}
+	return oldValue.Dst, nil
+}
+
+// ResetDst resets all changes to the "dst" field.
+func (m *FeedSubscriptionMutation) ResetDst() {
+	m.dst = nil
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *FeedSubscriptionMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *FeedSubscriptionMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the FeedSubscription entity.
+// If the FeedSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeedSubscriptionMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *FeedSubscriptionMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// SetCheckedAt sets the "checked_at" field.
+func (m *FeedSubscriptionMutation) SetCheckedAt(t time.Time) {
+	m.checked_at = &t
+}
+
+// CheckedAt returns the value of the "checked_at" field in the mutation.
+func (m *FeedSubscriptionMutation) CheckedAt() (r time.Time, exists bool) {
+	v := m.checked_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCheckedAt returns the old "checked_at" field's value of the FeedSubscription entity.
+// If the FeedSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeedSubscriptionMutation) OldCheckedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCheckedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCheckedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCheckedAt: %w", err)
+	}
+	return oldValue.CheckedAt, nil
+}
+
+// ClearCheckedAt clears the value of the "checked_at" field.
+func (m *FeedSubscriptionMutation) ClearCheckedAt() {
+	m.checked_at = nil
+	m.clearedFields[feedsubscription.FieldCheckedAt] = struct{}{}
+}
+
+// CheckedAtCleared returns if the "checked_at" field was cleared in this mutation.
+func (m *FeedSubscriptionMutation) CheckedAtCleared() bool {
+	_, ok := m.clearedFields[feedsubscription.FieldCheckedAt]
+	return ok
+}
+
+// ResetCheckedAt resets all changes to the "checked_at" field.
+func (m *FeedSubscriptionMutation) ResetCheckedAt() {
+	m.checked_at = nil
+	delete(m.clearedFields, feedsubscription.FieldCheckedAt)
+}
+
+// SetError sets the "error" field.
+func (m *FeedSubscriptionMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *FeedSubscriptionMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the FeedSubscription entity.
+// If the FeedSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeedSubscriptionMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *FeedSubscriptionMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[feedsubscription.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *FeedSubscriptionMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[feedsubscription.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *FeedSubscriptionMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, feedsubscription.FieldError)
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (m *FeedSubscriptionMutation) SetOwnerID(i int) {
+	m.owner = &i
+}
+
+// OwnerID returns the value of the "owner_id" field in the mutation.
+func (m *FeedSubscriptionMutation) OwnerID() (r int, exists bool) {
+	v := m.owner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwnerID returns the old "owner_id" field's value of the FeedSubscription entity.
+// If the FeedSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeedSubscriptionMutation) OldOwnerID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
+	}
+	return oldValue.OwnerID, nil
+}
+
+// ResetOwnerID resets all changes to the "owner_id" field.
+func (m *FeedSubscriptionMutation) ResetOwnerID() {
+	m.owner = nil
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *FeedSubscriptionMutation) ClearOwner() {
+	m.clearedowner = true
+	m.clearedFields[feedsubscription.FieldOwnerID] = struct{}{}
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *FeedSubscriptionMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *FeedSubscriptionMutation) OwnerIDs() (ids []int) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOwner resets all changes to the "owner" edge.
+func (m *FeedSubscriptionMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
+// AddItemIDs adds the "items" edge to the FeedItem entity by ids.
+func (m *FeedSubscriptionMutation) AddItemIDs(ids ...int) {
+	if m.items == nil {
+		m.items = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.items[ids[i]] = struct{}{}
+	}
+}
+
+// ClearItems clears the "items" edge to the FeedItem entity.
+func (m *FeedSubscriptionMutation) ClearItems() {
+	m.cleareditems = true
+}
+
+// ItemsCleared reports if the "items" edge to the FeedItem entity was cleared.
+func (m *FeedSubscriptionMutation) ItemsCleared() bool {
+	return m.cleareditems
+}
+
+// RemoveItemIDs removes the "items" edge to the FeedItem entity by IDs.
+func (m *FeedSubscriptionMutation) RemoveItemIDs(ids ...int) {
+	if m.removeditems == nil {
+		m.removeditems = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.items, ids[i])
+		m.removeditems[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedItems returns the removed IDs of the "items" edge to the FeedItem entity.
+func (m *FeedSubscriptionMutation) RemovedItemsIDs() (ids []int) {
+	for id := range m.removeditems {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ItemsIDs returns the "items" edge IDs in the mutation.
+func (m *FeedSubscriptionMutation) ItemsIDs() (ids []int) {
+	for id := range m.items {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetItems resets all changes to the "items" edge.
+func (m *FeedSubscriptionMutation) ResetItems() {
+	m.items = nil
+	m.cleareditems = false
+	m.removeditems = nil
+}
+
+// Where appends a list predicates to the FeedSubscriptionMutation builder.
+func (m *FeedSubscriptionMutation) Where(ps ...predicate.FeedSubscription) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FeedSubscriptionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FeedSubscriptionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.FeedSubscription, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FeedSubscriptionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FeedSubscriptionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (FeedSubscription).
+func (m *FeedSubscriptionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FeedSubscriptionMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.created_at != nil {
+		fields = append(fields, feedsubscription.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, feedsubscription.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, feedsubscription.FieldDeletedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, feedsubscription.FieldName)
+	}
+	if m.url != nil {
+		fields = append(fields, feedsubscription.FieldURL)
+	}
+	if m.include != nil {
+		fields = append(fields, feedsubscription.FieldInclude)
+	}
+	if m.exclude != nil {
+		fields = append(fields, feedsubscription.FieldExclude)
+	}
+	if m.dst != nil {
+		fields = append(fields, feedsubscription.FieldDst)
+	}
+	if m.enabled != nil {
+		fields = append(fields, feedsubscription.FieldEnabled)
+	}
+	if m.checked_at != nil {
+		fields = append(fields, feedsubscription.FieldCheckedAt)
+	}
+	if m.error != nil {
+		fields = append(fields, feedsubscription.FieldError)
+	}
+	if m.owner != nil {
+		fields = append(fields, feedsubscription.FieldOwnerID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FeedSubscriptionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case feedsubscription.FieldCreatedAt:
+		return m.CreatedAt()
+	case feedsubscription.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case feedsubscription.FieldDeletedAt:
+		return m.DeletedAt()
+	case feedsubscription.FieldName:
+		return m.Name()
+	case feedsubscription.FieldURL:
+		return m.URL()
+	case feedsubscription.FieldInclude:
+		return m.Include()
+	case feedsubscription.FieldExclude:
+		return m.Exclude()
+	case feedsubscription.FieldDst:
+		return m.Dst()
+	case feedsubscription.FieldEnabled:
+		return m.Enabled()
+	case feedsubscription.FieldCheckedAt:
+		return m.CheckedAt()
+	case feedsubscription.FieldError:
+		return m.Error()
+	case feedsubscription.FieldOwnerID:
+		return m.OwnerID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FeedSubscriptionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case feedsubscription.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case feedsubscription.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case feedsubscription.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case feedsubscription.FieldName:
+		return m.OldName(ctx)
+	case feedsubscription.FieldURL:
+		return m.OldURL(ctx)
+	case feedsubscription.FieldInclude:
+		return m.OldInclude(ctx)
+	case feedsubscription.FieldExclude:
+		return m.OldExclude(ctx)
+	case feedsubscription.FieldDst:
+		return m.OldDst(ctx)
+	case feedsubscription.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case feedsubscription.FieldCheckedAt:
+		return m.OldCheckedAt(ctx)
+	case feedsubscription.FieldError:
+		return m.OldError(ctx)
+	case feedsubscription.FieldOwnerID:
+		return m.OldOwnerID(ctx)
+	}
+	return nil, fmt.Errorf("unknown FeedSubscription field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FeedSubscriptionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case feedsubscription.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case feedsubscription.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case feedsubscription.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case feedsubscription.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case feedsubscription.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case feedsubscription.FieldInclude:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInclude(v)
+		return nil
+	case feedsubscription.FieldExclude:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExclude(v)
+		return nil
+	case feedsubscription.FieldDst:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDst(v)
+		return nil
+	case feedsubscription.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case feedsubscription.FieldCheckedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCheckedAt(v)
+		return nil
+	case feedsubscription.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case feedsubscription.FieldOwnerID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnerID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FeedSubscription field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FeedSubscriptionMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FeedSubscriptionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FeedSubscriptionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown FeedSubscription numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FeedSubscriptionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(feedsubscription.FieldDeletedAt) {
+		fields = append(fields, feedsubscription.FieldDeletedAt)
+	}
+	if m.FieldCleared(feedsubscription.FieldInclude) {
+		fields = append(fields, feedsubscription.FieldInclude)
+	}
+	if m.FieldCleared(feedsubscription.FieldExclude) {
+		fields = append(fields, feedsubscription.FieldExclude)
+	}
+	if m.FieldCleared(feedsubscription.FieldCheckedAt) {
+		fields = append(fields, feedsubscription.FieldCheckedAt)
+	}
+	if m.FieldCleared(feedsubscription.FieldError) {
+		fields = append(fields, feedsubscription.FieldError)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FeedSubscriptionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FeedSubscriptionMutation) ClearField(name string) error {
+	switch name {
+	case feedsubscription.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case feedsubscription.FieldInclude:
+		m.ClearInclude()
+		return nil
+	case feedsubscription.FieldExclude:
+		m.ClearExclude()
+		return nil
+	case feedsubscription.FieldCheckedAt:
+		m.ClearCheckedAt()
+		return nil
+	case feedsubscription.FieldError:
+		m.ClearError()
+		return nil
+	}
+	return fmt.Errorf("unknown FeedSubscription nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FeedSubscriptionMutation) ResetField(name string) error {
+	switch name {
+	case feedsubscription.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case feedsubscription.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case feedsubscription.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case feedsubscription.FieldName:
+		m.ResetName()
+		return nil
+	case feedsubscription.FieldURL:
+		m.ResetURL()
+		return nil
+	case feedsubscription.FieldInclude:
+		m.ResetInclude()
+		return nil
+	case feedsubscription.FieldExclude:
+		m.ResetExclude()
+		return nil
+	case feedsubscription.FieldDst:
+		m.ResetDst()
+		return nil
+	case feedsubscription.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case feedsubscription.FieldCheckedAt:
+		m.ResetCheckedAt()
+		return nil
+	case feedsubscription.FieldError:
+		m.ResetError()
+		return nil
+	case feedsubscription.FieldOwnerID:
+		m.ResetOwnerID()
+		return nil
+	}
+	return fmt.Errorf("unknown FeedSubscription field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FeedSubscriptionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.owner != nil {
+		edges = append(edges, feedsubscription.EdgeOwner)
+	}
+	if m.items != nil {
+		edges = append(edges, feedsubscription.EdgeItems)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FeedSubscriptionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case feedsubscription.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
+	case feedsubscription.EdgeItems:
+		ids := make([]ent.Value, 0, len(m.items))
+		for id := range m.items {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FeedSubscriptionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removeditems != nil {
+		edges = append(edges, feedsubscription.EdgeItems)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FeedSubscriptionMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case feedsubscription.EdgeItems:
+		ids := make([]ent.Value, 0, len(m.removeditems))
+		for id := range m.removeditems {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FeedSubscriptionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedowner {
+		edges = append(edges, feedsubscription.EdgeOwner)
+	}
+	if m.cleareditems {
+		edges = append(edges, feedsubscription.EdgeItems)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FeedSubscriptionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case feedsubscription.EdgeOwner:
+		return m.clearedowner
+	case feedsubscription.EdgeItems:
+		return m.cleareditems
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FeedSubscriptionMutation) ClearEdge(name string) error {
+	switch name {
+	case feedsubscription.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown FeedSubscription unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FeedSubscriptionMutation) ResetEdge(name string) error {
+	switch name {
+	case feedsubscription.EdgeOwner:
+		m.ResetOwner()
+		return nil
+	case feedsubscription.EdgeItems:
+		m.ResetItems()
+		return nil
+	}
+	return fmt.Errorf("unknown FeedSubscription edge %s", name)
+}
+
+// FileMutation represents an operation that mutates the File nodes in the graph.
+type FileMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *int
+	created_at              *time.Time
+	updated_at              *time.Time
+	_type                   *int
+	add_type                *int
+	name                    *string
+	size                    *int64
+	addsize                 *int64
+	primary_entity          *int
+	addprimary_entity       *int
+	is_symbolic             *bool
+	props                   **types.FileProps
+	clearedFields           map[string]struct{}
+	owner                   *int
+	clearedowner            bool
+	storage_policies        *int
+	clearedstorage_policies bool
+	parent                  *int
+	clearedparent           bool
+	children                map[int]struct{}
+	removedchildren         map[int]struct{}
+	clearedchildren         bool
+	metadata                map[int]struct{}
+	removedmetadata         map[int]struct{}
+	clearedmetadata         bool
+	entities                map[int]struct{}
+	removedentities         map[int]struct{}
+	clearedentities         bool
+	shares                  map[int]struct{}
+	removedshares           map[int]struct{}
+	clearedshares           bool
+	direct_links            map[int]struct{}
+	removeddirect_links     map[int]struct{}
+	cleareddirect_links     bool
+	activities              map[int]struct{}
+	removedactivities       map[int]struct{}
+	clearedactivities       bool
+	albums                  map[int]struct{}
+	removedalbums           map[int]struct{}
+	clearedalbums           bool
+	done                    bool
+	oldValue                func(context.Context) (*File, error)
+	predicates              []predicate.File
+}
+
+var _ ent.Mutation = (*FileMutation)(nil)
+
+// fileOption allows management of the mutation configuration using functional options.
+type fileOption func(*FileMutation)
+
+// newFileMutation creates new mutation for the File entity.
+func newFileMutation(c config, op Op, opts ...fileOption) *FileMutation {
+	m := &FileMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFile,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withFileID sets the ID field of the mutation.
+func withFileID(id int) fileOption {
+	return func(m *FileMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *File
+		)
+		m.oldValue = func(ctx context.Context) (*File, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().File.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withFile sets the old File of the mutation.
+func withFile(node *File) fileOption {
+	return func(m *FileMutation) {
+		m.oldValue = func(context.Context) (*File, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FileMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FileMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FileMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FileMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().File.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *FileMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *FileMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *FileMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *FileMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *FileMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *FileMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetType sets the "type" field.
+func (m *FileMutation) SetType(i int) {
+	m._type = &i
+	m.add_type = nil
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *FileMutation) GetType() (r int, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldType(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// AddType adds i to the "type" field.
+func (m *FileMutation) AddType(i int) {
+	if m.add_type != nil {
+		*m.add_type += i
+	} else {
+		m.add_type = &i
+	}
+}
+
+// AddedType returns the value that was added to the "type" field in this mutation.
+func (m *FileMutation) AddedType() (r int, exists bool) {
+	v := m.add_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *FileMutation) ResetType() {
+	m._type = nil
+	m.add_type = nil
+}
+
+// SetName sets the "name" field.
+func (m *FileMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *FileMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *FileMutation) ResetName() {
+	m.name = nil
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (m *FileMutation) SetOwnerID(i int) {
+	m.owner = &i
+}
+
+// OwnerID returns the value of the "owner_id" field in the mutation.
+func (m *FileMutation) OwnerID() (r int, exists bool) {
+	v := m.owner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwnerID returns the old "owner_id" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldOwnerID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
+	}
+	return oldValue.OwnerID, nil
+}
+
+// ResetOwnerID resets all changes to the "owner_id" field.
+func (m *FileMutation) ResetOwnerID() {
+	m.owner = nil
+}
+
+// SetSize sets the "size" field.
+func (m *FileMutation) SetSize(i int64) {
+	m.size = &i
+	m.addsize = nil
+}
+
+// Size returns the value of the "size" field in the mutation.
+func (m *FileMutation) Size() (r int64, exists bool) {
+	v := m.size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSize returns the old "size" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldSize(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
+	}
+	return oldValue.Size, nil
+}
+
+// AddSize adds i to the "size" field.
+func (m *FileMutation) AddSize(i int64) {
+	if m.addsize != nil {
+		*m.addsize += i
+	} else {
+		m.addsize = &i
+	}
+}
+
+// AddedSize returns the value that was added to the "size" field in this mutation.
+func (m *FileMutation) AddedSize() (r int64, exists bool) {
+	v := m.addsize
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSize resets all changes to the "size" field.
+func (m *FileMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
+}
+
+// SetPrimaryEntity sets the "primary_entity" field.
+func (m *FileMutation) SetPrimaryEntity(i int) {
+	m.primary_entity = &i
+	m.addprimary_entity = nil
+}
+
+// PrimaryEntity returns the value of the "primary_entity" field in the mutation.
+func (m *FileMutation) PrimaryEntity() (r int, exists bool) {
+	v := m.primary_entity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrimaryEntity returns the old "primary_entity" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldPrimaryEntity(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrimaryEntity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrimaryEntity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrimaryEntity: %w", err)
+	}
+	return oldValue.PrimaryEntity, nil
+}
+
+// AddPrimaryEntity adds i to the "primary_entity" field.
+func (m *FileMutation) AddPrimaryEntity(i int) {
+	if m.addprimary_entity != nil {
+		*m.addprimary_entity += i
+	} else {
+		m.addprimary_entity = &i
+	}
+}
+
+// AddedPrimaryEntity returns the value that was added to the "primary_entity" field in this mutation.
+func (m *FileMutation) AddedPrimaryEntity() (r int, exists bool) {
+	v := m.addprimary_entity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearPrimaryEntity clears the value of the "primary_entity" field.
+func (m *FileMutation) ClearPrimaryEntity() {
+	m.primary_entity = nil
+	m.addprimary_entity = nil
+	m.clearedFields[file.FieldPrimaryEntity] = struct{}{}
+}
+
+// PrimaryEntityCleared returns if the "primary_entity" field was cleared in this mutation.
+func (m *FileMutation) PrimaryEntityCleared() bool {
+	_, ok := m.clearedFields[file.FieldPrimaryEntity]
+	return ok
+}
+
+// ResetPrimaryEntity resets all changes to the "primary_entity" field.
+func (m *FileMutation) ResetPrimaryEntity() {
+	m.primary_entity = nil
+	m.addprimary_entity = nil
+	delete(m.clearedFields, file.FieldPrimaryEntity)
+}
+
+// SetFileChildren sets the "file_children" field.
+func (m *FileMutation) SetFileChildren(i int) {
+	m.parent = &i
+}
+
+// FileChildren returns the value of the "file_children" field in the mutation.
+func (m *FileMutation) FileChildren() (r int, exists bool) {
+	v := m.parent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFileChildren returns the old "file_children" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldFileChildren(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFileChildren is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFileChildren requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFileChildren: %w", err)
+	}
+	return oldValue.FileChildren, nil
+}
+
+// ClearFileChildren clears the value of the "file_children" field.
+func (m *FileMutation) ClearFileChildren() {
+	m.parent = nil
+	m.clearedFields[file.FieldFileChildren] = struct{}{}
+}
+
+// FileChildrenCleared returns if the "file_children" field was cleared in this mutation.
+func (m *FileMutation) FileChildrenCleared() bool {
+	_, ok := m.clearedFields[file.FieldFileChildren]
+	return ok
+}
+
+// ResetFileChildren resets all changes to the "file_children" field.
+func (m *FileMutation) ResetFileChildren() {
+	m.parent = nil
+	delete(m.clearedFields, file.FieldFileChildren)
+}
+
+// SetIsSymbolic sets the "is_symbolic" field.
+func (m *FileMutation) SetIsSymbolic(b bool) {
+	m.is_symbolic = &b
+}
+
+// IsSymbolic returns the value of the "is_symbolic" field in the mutation.
+func (m *FileMutation) IsSymbolic() (r bool, exists bool) {
+	v := m.is_symbolic
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsSymbolic returns the old "is_symbolic" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldIsSymbolic(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsSymbolic is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsSymbolic requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsSymbolic: %w", err)
+	}
+	return oldValue.IsSymbolic, nil
+}
+
+// ResetIsSymbolic resets all changes to the "is_symbolic" field.
+func (m *FileMutation) ResetIsSymbolic() {
+	m.is_symbolic = nil
+}
+
+// SetProps sets the "props" field.
+func (m *FileMutation) SetProps(tp *types.FileProps) {
+	m.props = &tp
+}
+
+// Props returns the value of the "props" field in the mutation.
+func (m *FileMutation) Props() (r *types.FileProps, exists bool) {
+	v := m.props
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProps returns the old "props" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldProps(ctx context.Context) (v *types.FileProps, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProps is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProps requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProps: %w", err)
+	}
+	return oldValue.Props, nil
+}
+
+// ClearProps clears the value of the "props" field.
+func (m *FileMutation) ClearProps() {
+	m.props = nil
+	m.clearedFields[file.FieldProps] = struct{}{}
+}
+
+// PropsCleared returns if the "props" field was cleared in this mutation.
+func (m *FileMutation) PropsCleared() bool {
+	_, ok := m.clearedFields[file.FieldProps]
+	return ok
+}
+
+// ResetProps resets all changes to the "props" field.
+func (m *FileMutation) ResetProps() {
+	m.props = nil
+	delete(m.clearedFields, file.FieldProps)
+}
+
+// SetStoragePolicyFiles sets the "storage_policy_files" field.
+func (m *FileMutation) SetStoragePolicyFiles(i int) {
+	m.storage_policies = &i
+}
+
+// StoragePolicyFiles returns the value of the "storage_policy_files" field in the mutation.
+func (m *FileMutation) StoragePolicyFiles() (r int, exists bool) {
+	v := m.storage_policies
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStoragePolicyFiles returns the old "storage_policy_files" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldStoragePolicyFiles(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStoragePolicyFiles is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStoragePolicyFiles requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStoragePolicyFiles: %w", err)
+	}
+	return oldValue.StoragePolicyFiles, nil
+}
+
+// ClearStoragePolicyFiles clears the value of the "storage_policy_files" field.
+func (m *FileMutation) ClearStoragePolicyFiles() {
+	m.storage_policies = nil
+	m.clearedFields[file.FieldStoragePolicyFiles] = struct{}{}
+}
+
+// StoragePolicyFilesCleared returns if the "storage_policy_files" field was cleared in this mutation.
+func (m *FileMutation) StoragePolicyFilesCleared() bool {
+	_, ok := m.clearedFields[file.FieldStoragePolicyFiles]
+	return ok
+}
+
+// ResetStoragePolicyFiles resets all changes to the "storage_policy_files" field.
+func (m *FileMutation) ResetStoragePolicyFiles() {
+	m.storage_policies = nil
+	delete(m.clearedFields, file.FieldStoragePolicyFiles)
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *FileMutation) ClearOwner() {
+	m.clearedowner = true
+	m.clearedFields[file.FieldOwnerID] = struct{}{}
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *FileMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *FileMutation) OwnerIDs() (ids []int) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOwner resets all changes to the "owner" edge.
+func (m *FileMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
+// SetStoragePoliciesID sets the "storage_policies" edge to the StoragePolicy entity by id.
+func (m *FileMutation) SetStoragePoliciesID(id int) {
+	m.storage_policies = &id
+}
+
+// ClearStoragePolicies clears the "storage_policies" edge to the StoragePolicy entity.
+func (m *FileMutation) ClearStoragePolicies() {
+	m.clearedstorage_policies = true
+	m.clearedFields[file.FieldStoragePolicyFiles] = struct{}{}
+}
+
+// StoragePoliciesCleared reports if the "storage_policies" edge to the StoragePolicy entity was cleared.
+func (m *FileMutation) StoragePoliciesCleared() bool {
+	return m.StoragePolicyFilesCleared() || m.clearedstorage_policies
+}
+
+// StoragePoliciesID returns the "storage_policies" edge ID in the mutation.
+func (m *FileMutation) StoragePoliciesID() (id int, exists bool) {
+	if m.storage_policies != nil {
+		return *m.storage_policies, true
+	}
+	return
+}
+
+// StoragePoliciesIDs returns the "storage_policies" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// StoragePoliciesID instead. It exists only for internal usage by the builders.
+func (m *FileMutation) StoragePoliciesIDs() (ids []int) {
+	if id := m.storage_policies; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetStoragePolicies resets all changes to the "storage_policies" edge.
+func (m *FileMutation) ResetStoragePolicies() {
+	m.storage_policies = nil
+	m.clearedstorage_policies = false
+}
+
+// SetParentID sets the "parent" edge to the File entity by id.
+func (m *FileMutation) SetParentID(id int) {
+	m.parent = &id
+}
+
+// ClearParent clears the "parent" edge to the File entity.
+func (m *FileMutation) ClearParent() {
+	m.clearedparent = true
+	m.clearedFields[file.FieldFileChildren] = struct{}{}
+}
+
+// ParentCleared reports if the "parent" edge to the File entity was cleared.
+func (m *FileMutation) ParentCleared() bool {
+	return m.FileChildrenCleared() || m.clearedparent
+}
+
+// ParentID returns the "parent" edge ID in the mutation.
+func (m *FileMutation) ParentID() (id int, exists bool) {
+	if m.parent != nil {
+		return *m.parent, true
+	}
+	return
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *FileMutation) ParentIDs() (ids []int) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *FileMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// AddChildIDs adds the "children" edge to the File entity by ids.
+func (m *FileMutation) AddChildIDs(ids ...int) {
+	if m.children == nil {
+		m.children = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.children[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChildren clears the "children" edge to the File entity.
+func (m *FileMutation) ClearChildren() {
+	m.clearedchildren = true
+}
+
+// ChildrenCleared reports if the "children" edge to the File entity was cleared.
+func (m *FileMutation) ChildrenCleared() bool {
+	return m.clearedchildren
+}
+
+// RemoveChildIDs removes the "children" edge to the File entity by IDs.
+func (m *FileMutation) RemoveChildIDs(ids ...int) {
+	if m.removedchildren == nil {
+		m.removedchildren = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.children, ids[i])
+		m.removedchildren[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChildren returns the removed IDs of the "children" edge to the File entity.
+func (m *FileMutation) RemovedChildrenIDs() (ids []int) {
+	for id := range m.removedchildren {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChildrenIDs returns the "children" edge IDs in the mutation.
+func (m *FileMutation) ChildrenIDs() (ids []int) {
+	for id := range m.children {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChildren resets all changes to the "children" edge.
+func (m *FileMutation) ResetChildren() {
+	m.children = nil
+	m.clearedchildren = false
+	m.removedchildren = nil
+}
+
+// AddMetadatumIDs adds the "metadata" edge to the Metadata entity by ids.
+func (m *FileMutation) AddMetadatumIDs(ids ...int) {
+	if m.metadata == nil {
+		m.metadata = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.metadata[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMetadata clears the "metadata" edge to the Metadata entity.
+func (m *FileMutation) ClearMetadata() {
+	m.clearedmetadata = true
+}
+
+// MetadataCleared reports if the "metadata" edge to the Metadata entity was cleared.
+func (m *FileMutation) MetadataCleared() bool {
+	return m.clearedmetadata
+}
+
+// RemoveMetadatumIDs removes the "metadata" edge to the Metadata entity by IDs.
+func (m *FileMutation) RemoveMetadatumIDs(ids ...int) {
+	if m.removedmetadata == nil {
+		m.removedmetadata = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.metadata, ids[i])
+		m.removedmetadata[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMetadata returns the removed IDs of the "metadata" edge to the Metadata entity.
+func (m *FileMutation) RemovedMetadataIDs() (ids []int) {
+	for id := range m.removedmetadata {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MetadataIDs returns the "metadata" edge IDs in the mutation.
+func (m *FileMutation) MetadataIDs() (ids []int) {
+	for id := range m.metadata {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMetadata resets all changes to the "metadata" edge.
+func (m *FileMutation) ResetMetadata() {
+	m.metadata = nil
+	m.clearedmetadata = false
+	m.removedmetadata = nil
+}
+
+// AddEntityIDs adds the "entities" edge to the Entity entity by ids.
+func (m *FileMutation) AddEntityIDs(ids ...int) {
+	if m.entities == nil {
+		m.entities = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.entities[ids[i]] = struct{}{}
+	}
+}
+
+// ClearEntities clears the "entities" edge to the Entity entity.
+func (m *FileMutation) ClearEntities() {
+	m.clearedentities = true
+}
+
+// EntitiesCleared reports if the "entities" edge to the Entity entity was cleared.
+func (m *FileMutation) EntitiesCleared() bool {
+	return m.clearedentities
+}
+
+// RemoveEntityIDs removes the "entities" edge to the Entity entity by IDs.
+func (m *FileMutation) RemoveEntityIDs(ids ...int) {
+	if m.removedentities == nil {
+		m.removedentities = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.entities, ids[i])
+		m.removedentities[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedEntities returns the removed IDs of the "entities" edge to the Entity entity.
+func (m *FileMutation) RemovedEntitiesIDs() (ids []int) {
+	for id := range m.removedentities {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// EntitiesIDs returns the "entities" edge IDs in the mutation.
+func (m *FileMutation) EntitiesIDs() (ids []int) {
+	for id := range m.entities {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetEntities resets all changes to the "entities" edge.
+func (m *FileMutation) ResetEntities() {
+	m.entities = nil
+	m.clearedentities = false
+	m.removedentities = nil
+}
+
+// AddShareIDs adds the "shares" edge to the Share entity by ids.
+func (m *FileMutation) AddShareIDs(ids ...int) {
+	if m.shares == nil {
+		m.shares = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.shares[ids[i]] = struct{}{}
+	}
+}
+
+// ClearShares clears the "shares" edge to the Share entity.
+func (m *FileMutation) ClearShares() {
+	m.clearedshares = true
+}
+
+// SharesCleared reports if the "shares" edge to the Share entity was cleared.
+func (m *FileMutation) SharesCleared() bool {
+	return m.clearedshares
+}
+
+// RemoveShareIDs removes the "shares" edge to the Share entity by IDs.
+func (m *FileMutation) RemoveShareIDs(ids ...int) {
+	if m.removedshares == nil {
+		m.removedshares = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.shares, ids[i])
+		m.removedshares[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedShares returns the removed IDs of the "shares" edge to the Share entity.
+func (m *FileMutation) RemovedSharesIDs() (ids []int) {
+	for id := range m.removedshares {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SharesIDs returns the "shares" edge IDs in the mutation.
+func (m *FileMutation) SharesIDs() (ids []int) {
+	for id := range m.shares {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetShares resets all changes to the "shares" edge.
+func (m *FileMutation) ResetShares() {
+	m.shares = nil
+	m.clearedshares = false
+	m.removedshares = nil
+}
+
+// AddDirectLinkIDs adds the "direct_links" edge to the DirectLink entity by ids.
+func (m *FileMutation) AddDirectLinkIDs(ids ...int) {
+	if m.direct_links == nil {
+		m.direct_links = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.direct_links[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDirectLinks clears the "direct_links" edge to the DirectLink entity.
+func (m *FileMutation) ClearDirectLinks() {
+	m.cleareddirect_links = true
+}
+
+// DirectLinksCleared reports if the "direct_links" edge to the DirectLink entity was cleared.
+func (m *FileMutation) DirectLinksCleared() bool {
+	return m.cleareddirect_links
+}
+
+// RemoveDirectLinkIDs removes the "direct_links" edge to the DirectLink entity by IDs.
+func (m *FileMutation) RemoveDirectLinkIDs(ids ...int) {
+	if m.removeddirect_links == nil {
+		m.removeddirect_links = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.direct_links, ids[i])
+		m.removeddirect_links[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDirectLinks returns the removed IDs of the "direct_links" edge to the DirectLink entity.
+func (m *FileMutation) RemovedDirectLinksIDs() (ids []int) {
+	for id := range m.removeddirect_links {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DirectLinksIDs returns the "direct_links" edge IDs in the mutation.
+func (m *FileMutation) DirectLinksIDs() (ids []int) {
+	for id := range m.direct_links {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDirectLinks resets all changes to the "direct_links" edge.
+func (m *FileMutation) ResetDirectLinks() {
+	m.direct_links = nil
+	m.cleareddirect_links = false
+	m.removeddirect_links = nil
+}
+
+// AddActivityIDs adds the "activities" edge to the FileActivity entity by ids.
+func (m *FileMutation) AddActivityIDs(ids ...int) {
+	if m.activities == nil {
+		m.activities = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.activities[ids[i]] = struct{}{}
+	}
+}
+
+// ClearActivities clears the "activities" edge to the FileActivity entity.
+func (m *FileMutation) ClearActivities() {
+	m.clearedactivities = true
+}
+
+// ActivitiesCleared reports if the "activities" edge to the FileActivity entity was cleared.
+func (m *FileMutation) ActivitiesCleared() bool {
+	return m.clearedactivities
+}
+
+// RemoveActivityIDs removes the "activities" edge to the FileActivity entity by IDs.
+func (m *FileMutation) RemoveActivityIDs(ids ...int) {
+	if m.removedactivities == nil {
+		m.removedactivities = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.activities, ids[i])
+		m.removedactivities[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedActivities returns the removed IDs of the "activities" edge to the FileActivity entity.
+func (m *FileMutation) RemovedActivitiesIDs() (ids []int) {
+	for id := range m.removedactivities {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ActivitiesIDs returns the "activities" edge IDs in the mutation.
+func (m *FileMutation) ActivitiesIDs() (ids []int) {
+	for id := range m.activities {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetActivities resets all changes to the "activities" edge.
+func (m *FileMutation) ResetActivities() {
+	m.activities = nil
+	m.clearedactivities = false
+	m.removedactivities = nil
+}
+
+// AddAlbumIDs adds the "albums" edge to the Album entity by ids.
+func (m *FileMutation) AddAlbumIDs(ids ...int) {
+	if m.albums == nil {
+		m.albums = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.albums[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAlbums clears the "albums" edge to the Album entity.
+func (m *FileMutation) ClearAlbums() {
+	m.clearedalbums = true
+}
+
+// AlbumsCleared reports if the "albums" edge to the Album entity was cleared.
+func (m *FileMutation) AlbumsCleared() bool {
+	return m.clearedalbums
+}
+
+// RemoveAlbumIDs removes the "albums" edge to the Album entity by IDs.
+func (m *FileMutation) RemoveAlbumIDs(ids ...int) {
+	if m.removedalbums == nil {
+		m.removedalbums = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.albums, ids[i])
+		m.removedalbums[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAlbums returns the removed IDs of the "albums" edge to the Album entity.
+func (m *FileMutation) RemovedAlbumsIDs() (ids []int) {
+	for id := range m.removedalbums {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AlbumsIDs returns the "albums" edge IDs in the mutation.
+func (m *FileMutation) AlbumsIDs() (ids []int) {
+	for id := range m.albums {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAlbums resets all changes to the "albums" edge.
+func (m *FileMutation) ResetAlbums() {
+	m.albums = nil
+	m.clearedalbums = false
+	m.removedalbums = nil
+}
+
+// Where appends a list predicates to the FileMutation builder.
+func (m *FileMutation) Where(ps ...predicate.File) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FileMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FileMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.File, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FileMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FileMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (File).
+func (m *FileMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FileMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.created_at != nil {
+		fields = append(fields, file.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, file.FieldUpdatedAt)
+	}
+	if m._type != nil {
+		fields = append(fields, file.FieldType)
+	}
+	if m.name != nil {
+		fields = append(fields, file.FieldName)
+	}
+	if m.owner != nil {
+		fields = append(fields, file.FieldOwnerID)
+	}
+	if m.size != nil {
+		fields = append(fields, file.FieldSize)
+	}
+	if m.primary_entity != nil {
+		fields = append(fields, file.FieldPrimaryEntity)
+	}
+	if m.parent != nil {
+		fields = append(fields, file.FieldFileChildren)
+	}
+	if m.is_symbolic != nil {
+		fields = append(fields, file.FieldIsSymbolic)
+	}
+	if m.props != nil {
+		fields = append(fields, file.FieldProps)
+	}
+	if m.storage_policies != nil {
+		fields = append(fields, file.FieldStoragePolicyFiles)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FileMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case file.FieldCreatedAt:
+		return m.CreatedAt()
+	case file.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case file.FieldType:
+		return m.GetType()
+	case file.FieldName:
+		return m.Name()
+	case file.FieldOwnerID:
+		return m.OwnerID()
+	case file.FieldSize:
+		return m.Size()
+	case file.FieldPrimaryEntity:
+		return m.PrimaryEntity()
+	case file.FieldFileChildren:
+		return m.FileChildren()
+	case file.FieldIsSymbolic:
+		return m.IsSymbolic()
+	case file.FieldProps:
+		return m.Props()
+	case file.FieldStoragePolicyFiles:
+		return m.StoragePolicyFiles()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FileMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case file.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case file.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case file.FieldType:
+		return m.OldType(ctx)
+	case file.FieldName:
+		return m.OldName(ctx)
+	case file.FieldOwnerID:
+		return m.OldOwnerID(ctx)
+	case file.FieldSize:
+		return m.OldSize(ctx)
+	case file.FieldPrimaryEntity:
+		return m.OldPrimaryEntity(ctx)
+	case file.FieldFileChildren:
+		return m.OldFileChildren(ctx)
+	case file.FieldIsSymbolic:
+		return m.OldIsSymbolic(ctx)
+	case file.FieldProps:
 		return m.OldProps(ctx)
+	case file.FieldStoragePolicyFiles:
+		return m.OldStoragePolicyFiles(ctx)
+	}
+	return nil, fmt.Errorf("unknown File field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FileMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case file.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case file.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case file.FieldType:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case file.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case file.FieldOwnerID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnerID(v)
+		return nil
+	case file.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSize(v)
+		return nil
+	case file.FieldPrimaryEntity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrimaryEntity(v)
+		return nil
+	case file.FieldFileChildren:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFileChildren(v)
+		return nil
+	case file.FieldIsSymbolic:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsSymbolic(v)
+		return nil
+	case file.FieldProps:
+		v, ok := value.(*types.FileProps)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProps(v)
+		return nil
+	case file.FieldStoragePolicyFiles:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStoragePolicyFiles(v)
+		return nil
+	}
+	return fmt.Errorf("unknown File field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FileMutation) AddedFields() []string {
+	var fields []string
+	if m.add_type != nil {
+		fields = append(fields, file.FieldType)
+	}
+	if m.addsize != nil {
+		fields = append(fields, file.FieldSize)
+	}
+	if m.addprimary_entity != nil {
+		fields = append(fields, file.FieldPrimaryEntity)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FileMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case file.FieldType:
+		return m.AddedType()
+	case file.FieldSize:
+		return m.AddedSize()
+	case file.FieldPrimaryEntity:
+		return m.AddedPrimaryEntity()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FileMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case file.FieldType:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddType(v)
+		return nil
+	case file.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSize(v)
+		return nil
+	case file.FieldPrimaryEntity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPrimaryEntity(v)
+		return nil
+	}
+	return fmt.Errorf("unknown File numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FileMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(file.FieldPrimaryEntity) {
+		fields = append(fields, file.FieldPrimaryEntity)
+	}
+	if m.FieldCleared(file.FieldFileChildren) {
+		fields = append(fields, file.FieldFileChildren)
+	}
+	if m.FieldCleared(file.FieldProps) {
+		fields = append(fields, file.FieldProps)
+	}
+	if m.FieldCleared(file.FieldStoragePolicyFiles) {
+		fields = append(fields, file.FieldStoragePolicyFiles)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FileMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FileMutation) ClearField(name string) error {
+	switch name {
+	case file.FieldPrimaryEntity:
+		m.ClearPrimaryEntity()
+		return nil
+	case file.FieldFileChildren:
+		m.ClearFileChildren()
+		return nil
+	case file.FieldProps:
+		m.ClearProps()
+		return nil
+	case file.FieldStoragePolicyFiles:
+		m.ClearStoragePolicyFiles()
+		return nil
+	}
+	return fmt.Errorf("unknown File nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FileMutation) ResetField(name string) error {
+	switch name {
+	case file.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case file.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case file.FieldType:
+		m.ResetType()
+		return nil
+	case file.FieldName:
+		m.ResetName()
+		return nil
+	case file.FieldOwnerID:
+		m.ResetOwnerID()
+		return nil
+	case file.FieldSize:
+		m.ResetSize()
+		return nil
+	case file.FieldPrimaryEntity:
+		m.ResetPrimaryEntity()
+		return nil
+	case file.FieldFileChildren:
+		m.ResetFileChildren()
+		return nil
+	case file.FieldIsSymbolic:
+		m.ResetIsSymbolic()
+		return nil
+	case file.FieldProps:
+		m.ResetProps()
+		return nil
+	case file.FieldStoragePolicyFiles:
+		m.ResetStoragePolicyFiles()
+		return nil
+	}
+	return fmt.Errorf("unknown File field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FileMutation) AddedEdges() []string {
+	edges := make([]string, 0, 10)
+	if m.owner != nil {
+		edges = append(edges, file.EdgeOwner)
+	}
+	if m.storage_policies != nil {
+		edges = append(edges, file.EdgeStoragePolicies)
+	}
+	if m.parent != nil {
+		edges = append(edges, file.EdgeParent)
+	}
+	if m.children != nil {
+		edges = append(edges, file.EdgeChildren)
+	}
+	if m.metadata != nil {
+		edges = append(edges, file.EdgeMetadata)
+	}
+	if m.entities != nil {
+		edges = append(edges, file.EdgeEntities)
+	}
+	if m.shares != nil {
+		edges = append(edges, file.EdgeShares)
+	}
+	if m.direct_links != nil {
+		edges = append(edges, file.EdgeDirectLinks)
+	}
+	if m.activities != nil {
+		edges = append(edges, file.EdgeActivities)
+	}
+	if m.albums != nil {
+		edges = append(edges, file.EdgeAlbums)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FileMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case file.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
+	case file.EdgeStoragePolicies:
+		if id := m.storage_policies; id != nil {
+			return []ent.Value{*id}
+		}
+	case file.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case file.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.children))
+		for id := range m.children {
+			ids = append(ids, id)
+		}
+		return ids
+	case file.EdgeMetadata:
+		ids := make([]ent.Value, 0, len(m.metadata))
+		for id := range m.metadata {
+			ids = append(ids, id)
+		}
+		return ids
+	case file.EdgeEntities:
+		ids := make([]ent.Value, 0, len(m.entities))
+		for id := range m.entities {
+			ids = append(ids, id)
+		}
+		return ids
+	case file.EdgeShares:
+		ids := make([]ent.Value, 0, len(m.shares))
+		for id := range m.shares {
+			ids = append(ids, id)
+		}
+		return ids
+	case file.EdgeDirectLinks:
+		ids := make([]ent.Value, 0, len(m.direct_links))
+		for id := range m.direct_links {
+			ids = append(ids, id)
+		}
+		return ids
+	case file.EdgeActivities:
+		ids := make([]ent.Value, 0, len(m.activities))
+		for id := range m.activities {
+			ids = append(ids, id)
+		}
+		return ids
+	case file.EdgeAlbums:
+		ids := make([]ent.Value, 0, len(m.albums))
+		for id := range m.albums {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FileMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 10)
+	if m.removedchildren != nil {
+		edges = append(edges, file.EdgeChildren)
+	}
+	if m.removedmetadata != nil {
+		edges = append(edges, file.EdgeMetadata)
+	}
+	if m.removedentities != nil {
+		edges = append(edges, file.EdgeEntities)
+	}
+	if m.removedshares != nil {
+		edges = append(edges, file.EdgeShares)
+	}
+	if m.removeddirect_links != nil {
+		edges = append(edges, file.EdgeDirectLinks)
+	}
+	if m.removedactivities != nil {
+		edges = append(edges, file.EdgeActivities)
+	}
+	if m.removedalbums != nil {
+		edges = append(edges, file.EdgeAlbums)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FileMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case file.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.removedchildren))
+		for id := range m.removedchildren {
+			ids = append(ids, id)
+		}
+		return ids
+	case file.EdgeMetadata:
+		ids := make([]ent.Value, 0, len(m.removedmetadata))
+		for id := range m.removedmetadata {
+			ids = append(ids, id)
+		}
+		return ids
+	case file.EdgeEntities:
+		ids := make([]ent.Value, 0, len(m.removedentities))
+		for id := range m.removedentities {
+			ids = append(ids, id)
+		}
+		return ids
+	case file.EdgeShares:
+		ids := make([]ent.Value, 0, len(m.removedshares))
+		for id := range m.removedshares {
+			ids = append(ids, id)
+		}
+		return ids
+	case file.EdgeDirectLinks:
+		ids := make([]ent.Value, 0, len(m.removeddirect_links))
+		for id := range m.removeddirect_links {
+			ids = append(ids, id)
+		}
+		return ids
+	case file.EdgeActivities:
+		ids := make([]ent.Value, 0, len(m.removedactivities))
+		for id := range m.removedactivities {
+			ids = append(ids, id)
+		}
+		return ids
+	case file.EdgeAlbums:
+		ids := make([]ent.Value, 0, len(m.removedalbums))
+		for id := range m.removedalbums {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FileMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 10)
+	if m.clearedowner {
+		edges = append(edges, file.EdgeOwner)
+	}
+	if m.clearedstorage_policies {
+		edges = append(edges, file.EdgeStoragePolicies)
+	}
+	if m.clearedparent {
+		edges = append(edges, file.EdgeParent)
+	}
+	if m.clearedchildren {
+		edges = append(edges, file.EdgeChildren)
+	}
+	if m.clearedmetadata {
+		edges = append(edges, file.EdgeMetadata)
+	}
+	if m.clearedentities {
+		edges = append(edges, file.EdgeEntities)
+	}
+	if m.clearedshares {
+		edges = append(edges, file.EdgeShares)
+	}
+	if m.cleareddirect_links {
+		edges = append(edges, file.EdgeDirectLinks)
+	}
+	if m.clearedactivities {
+		edges = append(edges, file.EdgeActivities)
+	}
+	if m.clearedalbums {
+		edges = append(edges, file.EdgeAlbums)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FileMutation) EdgeCleared(name string) bool {
+	switch name {
+	case file.EdgeOwner:
+		return m.clearedowner
+	case file.EdgeStoragePolicies:
+		return m.clearedstorage_policies
+	case file.EdgeParent:
+		return m.clearedparent
+	case file.EdgeChildren:
+		return m.clearedchildren
+	case file.EdgeMetadata:
+		return m.clearedmetadata
+	case file.EdgeEntities:
+		return m.clearedentities
+	case file.EdgeShares:
+		return m.clearedshares
+	case file.EdgeDirectLinks:
+		return m.cleareddirect_links
+	case file.EdgeActivities:
+		return m.clearedactivities
+	case file.EdgeAlbums:
+		return m.clearedalbums
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FileMutation) ClearEdge(name string) error {
+	switch name {
+	case file.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	case file.EdgeStoragePolicies:
+		m.ClearStoragePolicies()
+		return nil
+	case file.EdgeParent:
+		m.ClearParent()
+		return nil
+	}
+	return fmt.Errorf("unknown File unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FileMutation) ResetEdge(name string) error {
+	switch name {
+	case file.EdgeOwner:
+		m.ResetOwner()
+		return nil
+	case file.EdgeStoragePolicies:
+		m.ResetStoragePolicies()
+		return nil
+	case file.EdgeParent:
+		m.ResetParent()
+		return nil
+	case file.EdgeChildren:
+		m.ResetChildren()
+		return nil
+	case file.EdgeMetadata:
+		m.ResetMetadata()
+		return nil
+	case file.EdgeEntities:
+		m.ResetEntities()
+		return nil
+	case file.EdgeShares:
+		m.ResetShares()
+		return nil
+	case file.EdgeDirectLinks:
+		m.ResetDirectLinks()
+		return nil
+	case file.EdgeActivities:
+		m.ResetActivities()
+		return nil
+	case file.EdgeAlbums:
+		m.ResetAlbums()
+		return nil
+	}
+	return fmt.Errorf("unknown File edge %s", name)
+}
+
+// FileActivityMutation represents an operation that mutates the FileActivity nodes in the graph.
+type FileActivityMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	created_at    *time.Time
+	updated_at    *time.Time
+	deleted_at    *time.Time
+	action        *int
+	addaction     *int
+	accessed_at   *time.Time
+	opened_at     *time.Time
+	clearedFields map[string]struct{}
+	file          *int
+	clearedfile   bool
+	user          *int
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*FileActivity, error)
+	predicates    []predicate.FileActivity
+}
+
+var _ ent.Mutation = (*FileActivityMutation)(nil)
+
+// fileactivityOption allows management of the mutation configuration using functional options.
+type fileactivityOption func(*FileActivityMutation)
+
+// newFileActivityMutation creates new mutation for the FileActivity entity.
+func newFileActivityMutation(c config, op Op, opts ...fileactivityOption) *FileActivityMutation {
+	m := &FileActivityMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFileActivity,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withFileActivityID sets the ID field of the mutation.
+func withFileActivityID(id int) fileactivityOption {
+	return func(m *FileActivityMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *FileActivity
+		)
+		m.oldValue = func(ctx context.Context) (*FileActivity, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().FileActivity.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withFileActivity sets the old FileActivity of the mutation.
+func withFileActivity(node *FileActivity) fileactivityOption {
+	return func(m *FileActivityMutation) {
+		m.oldValue = func(context.Context) (*FileActivity, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FileActivityMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FileActivityMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FileActivityMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FileActivityMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().FileActivity.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *FileActivityMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *FileActivityMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the FileActivity entity.
+// If the FileActivity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileActivityMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *FileActivityMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *FileActivityMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *FileActivityMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the FileActivity entity.
+// If the FileActivity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileActivityMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *FileActivityMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *FileActivityMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *FileActivityMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the FileActivity entity.
+// If the FileActivity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileActivityMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *FileActivityMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[fileactivity.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *FileActivityMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[fileactivity.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *FileActivityMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, fileactivity.FieldDeletedAt)
+}
+
+// SetAction sets the "action" field.
+func (m *FileActivityMutation) SetAction(i int) {
+	m.action = &i
+	m.addaction = nil
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *FileActivityMutation) Action() (r int, exists bool) {
+	v := m.action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the FileActivity entity.
+// If the FileActivity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileActivityMutation) OldAction(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
+}
+
+// AddAction adds i to the "action" field.
+func (m *FileActivityMutation) AddAction(i int) {
+	if m.addaction != nil {
+		*m.addaction += i
+	} else {
+		m.addaction = &i
+	}
+}
+
+// AddedAction returns the value that was added to the "action" field in this mutation.
+func (m *FileActivityMutation) AddedAction() (r int, exists bool) {
+	v := m.addaction
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAction resets all changes to the "action" field.
+func (m *FileActivityMutation) ResetAction() {
+	m.action = nil
+	m.addaction = nil
+}
+
+// SetAccessedAt sets the "accessed_at" field.
+func (m *FileActivityMutation) SetAccessedAt(t time.Time) {
+	m.accessed_at = &t
+}
+
+// AccessedAt returns the value of the "accessed_at" field in the mutation.
+func (m *FileActivityMutation) AccessedAt() (r time.Time, exists bool) {
+	v := m.accessed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccessedAt returns the old "accessed_at" field's value of the FileActivity entity.
+// If the FileActivity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileActivityMutation) OldAccessedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccessedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccessedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccessedAt: %w", err)
+	}
+	return oldValue.AccessedAt, nil
+}
+
+// ResetAccessedAt resets all changes to the "accessed_at" field.
+func (m *FileActivityMutation) ResetAccessedAt() {
+	m.accessed_at = nil
+}
+
+// SetOpenedAt sets the "opened_at" field.
+func (m *FileActivityMutation) SetOpenedAt(t time.Time) {
+	m.opened_at = &t
+}
+
+// OpenedAt returns the value of the "opened_at" field in the mutation.
+func (m *FileActivityMutation) OpenedAt() (r time.Time, exists bool) {
+	v := m.opened_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOpenedAt returns the old "opened_at" field's value of the FileActivity entity.
+// If the FileActivity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileActivityMutation) OldOpenedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOpenedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOpenedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOpenedAt: %w", err)
+	}
+	return oldValue.OpenedAt, nil
+}
+
+// ClearOpenedAt clears the value of the "opened_at" field.
+func (m *FileActivityMutation) ClearOpenedAt() {
+	m.opened_at = nil
+	m.clearedFields[fileactivity.FieldOpenedAt] = struct{}{}
+}
+
+// OpenedAtCleared returns if the "opened_at" field was cleared in this mutation.
+func (m *FileActivityMutation) OpenedAtCleared() bool {
+	_, ok := m.clearedFields[fileactivity.FieldOpenedAt]
+	return ok
+}
+
+// ResetOpenedAt resets all changes to the "opened_at" field.
+func (m *FileActivityMutation) ResetOpenedAt() {
+	m.opened_at = nil
+	delete(m.clearedFields, fileactivity.FieldOpenedAt)
+}
+
+// SetFileID sets the "file_id" field.
+func (m *FileActivityMutation) SetFileID(i int) {
+	m.file = &i
+}
+
+// FileID returns the value of the "file_id" field in the mutation.
+func (m *FileActivityMutation) FileID() (r int, exists bool) {
+	v := m.file
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFileID returns the old "file_id" field's value of the FileActivity entity.
+// If the FileActivity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileActivityMutation) OldFileID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFileID is only allowed on UpdateOne operations")
 	}
-	return nil, fmt.Errorf("unknown Entity field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFileID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFileID: %w", err)
+	}
+	return oldValue.FileID, nil
+}
+
+// ResetFileID resets all changes to the "file_id" field.
+func (m *FileActivityMutation) ResetFileID() {
+	m.file = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *FileActivityMutation) SetUserID(i int) {
+	m.user = &i
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *FileActivityMutation) UserID() (r int, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the FileActivity entity.
+// If the FileActivity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileActivityMutation) OldUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *FileActivityMutation) ResetUserID() {
+	m.user = nil
+}
+
+// ClearFile clears the "file" edge to the File entity.
+func (m *FileActivityMutation) ClearFile() {
+	m.clearedfile = true
+	m.clearedFields[fileactivity.FieldFileID] = struct{}{}
+}
+
+// FileCleared reports if the "file" edge to the File entity was cleared.
+func (m *FileActivityMutation) FileCleared() bool {
+	return m.clearedfile
+}
+
+// FileIDs returns the "file" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FileID instead. It exists only for internal usage by the builders.
+func (m *FileActivityMutation) FileIDs() (ids []int) {
+	if id := m.file; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFile resets all changes to the "file" edge.
+func (m *FileActivityMutation) ResetFile() {
+	m.file = nil
+	m.clearedfile = false
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *FileActivityMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[fileactivity.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *FileActivityMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *FileActivityMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *FileActivityMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the FileActivityMutation builder.
+func (m *FileActivityMutation) Where(ps ...predicate.FileActivity) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FileActivityMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FileActivityMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.FileActivity, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FileActivityMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FileActivityMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (FileActivity).
+func (m *FileActivityMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FileActivityMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.created_at != nil {
+		fields = append(fields, fileactivity.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, fileactivity.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, fileactivity.FieldDeletedAt)
+	}
+	if m.action != nil {
+		fields = append(fields, fileactivity.FieldAction)
+	}
+	if m.accessed_at != nil {
+		fields = append(fields, fileactivity.FieldAccessedAt)
+	}
+	if m.opened_at != nil {
+		fields = append(fields, fileactivity.FieldOpenedAt)
+	}
+	if m.file != nil {
+		fields = append(fields, fileactivity.FieldFileID)
+	}
+	if m.user != nil {
+		fields = append(fields, fileactivity.FieldUserID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FileActivityMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case fileactivity.FieldCreatedAt:
+		return m.CreatedAt()
+	case fileactivity.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case fileactivity.FieldDeletedAt:
+		return m.DeletedAt()
+	case fileactivity.FieldAction:
+		return m.Action()
+	case fileactivity.FieldAccessedAt:
+		return m.AccessedAt()
+	case fileactivity.FieldOpenedAt:
+		return m.OpenedAt()
+	case fileactivity.FieldFileID:
+		return m.FileID()
+	case fileactivity.FieldUserID:
+		return m.UserID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FileActivityMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case fileactivity.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case fileactivity.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case fileactivity.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case fileactivity.FieldAction:
+		return m.OldAction(ctx)
+	case fileactivity.FieldAccessedAt:
+		return m.OldAccessedAt(ctx)
+	case fileactivity.FieldOpenedAt:
+		return m.OldOpenedAt(ctx)
+	case fileactivity.FieldFileID:
+		return m.OldFileID(ctx)
+	case fileactivity.FieldUserID:
+		return m.OldUserID(ctx)
+	}
+	return nil, fmt.Errorf("unknown FileActivity field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EntityMutation) SetField(name string, value ent.Value) error {
+func (m *FileActivityMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case entity.FieldCreatedAt:
+	case fileactivity.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case entity.FieldUpdatedAt:
+	case fileactivity.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case entity.FieldDeletedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDeletedAt(v)
+	case fileactivity.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case fileactivity.FieldAction:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case fileactivity.FieldAccessedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccessedAt(v)
+		return nil
+	case fileactivity.FieldOpenedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOpenedAt(v)
+		return nil
+	case fileactivity.FieldFileID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFileID(v)
+		return nil
+	case fileactivity.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FileActivity field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FileActivityMutation) AddedFields() []string {
+	var fields []string
+	if m.addaction != nil {
+		fields = append(fields, fileactivity.FieldAction)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FileActivityMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case fileactivity.FieldAction:
+		return m.AddedAction()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FileActivityMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case fileactivity.FieldAction:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAction(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FileActivity numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FileActivityMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(fileactivity.FieldDeletedAt) {
+		fields = append(fields, fileactivity.FieldDeletedAt)
+	}
+	if m.FieldCleared(fileactivity.FieldOpenedAt) {
+		fields = append(fields, fileactivity.FieldOpenedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FileActivityMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FileActivityMutation) ClearField(name string) error {
+	switch name {
+	case fileactivity.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case fileactivity.FieldOpenedAt:
+		m.ClearOpenedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown FileActivity nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FileActivityMutation) ResetField(name string) error {
+	switch name {
+	case fileactivity.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case fileactivity.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case fileactivity.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case fileactivity.FieldAction:
+		m.ResetAction()
+		return nil
+	case fileactivity.FieldAccessedAt:
+		m.ResetAccessedAt()
 		return nil
-	case entity.FieldType:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetType(v)
+	case fileactivity.FieldOpenedAt:
+		m.ResetOpenedAt()
 		return nil
-	case entity.FieldSource:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSource(v)
+	case fileactivity.FieldFileID:
+		m.ResetFileID()
 		return nil
-	case entity.FieldSize:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSize(v)
+	case fileactivity.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case entity.FieldReferenceCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
+	}
+	return fmt.Errorf("unknown FileActivity field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FileActivityMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.file != nil {
+		edges = append(edges, fileactivity.EdgeFile)
+	}
+	if m.user != nil {
+		edges = append(edges, fileactivity.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FileActivityMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case fileactivity.EdgeFile:
+		if id := m.file; id != nil {
+			return []ent.Value{*id}
 		}
-		m.SetReferenceCount(v)
-		return nil
-	case entity.FieldStoragePolicyEntities:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
+	case fileactivity.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
 		}
-		m.SetStoragePolicyEntities(v)
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FileActivityMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FileActivityMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FileActivityMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedfile {
+		edges = append(edges, fileactivity.EdgeFile)
+	}
+	if m.cleareduser {
+		edges = append(edges, fileactivity.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FileActivityMutation) EdgeCleared(name string) bool {
+	switch name {
+	case fileactivity.EdgeFile:
+		return m.clearedfile
+	case fileactivity.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FileActivityMutation) ClearEdge(name string) error {
+	switch name {
+	case fileactivity.EdgeFile:
+		m.ClearFile()
 		return nil
-	case entity.FieldCreatedBy:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedBy(v)
+	case fileactivity.EdgeUser:
+		m.ClearUser()
 		return nil
-	case entity.FieldUploadSessionID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUploadSessionID(v)
+	}
+	return fmt.Errorf("unknown FileActivity unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FileActivityMutation) ResetEdge(name string) error {
+	switch name {
+	case fileactivity.EdgeFile:
+		m.ResetFile()
 		return nil
-	case entity.FieldProps:
-		v, ok := value.(*types.EntityProps)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetProps(v)
+	case fileactivity.EdgeUser:
+		m.ResetUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Entity field %s", name)
+	return fmt.Errorf("unknown FileActivity edge %s", name)
+}
+
+// FsEventMutation represents an operation that mutates the FsEvent nodes in the graph.
+type FsEventMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	created_at    *time.Time
+	updated_at    *time.Time
+	deleted_at    *time.Time
+	event         *string
+	subscriber    *uuid.UUID
+	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*FsEvent, error)
+	predicates    []predicate.FsEvent
+}
+
+var _ ent.Mutation = (*FsEventMutation)(nil)
+
+// fseventOption allows management of the mutation configuration using functional options.
+type fseventOption func(*FsEventMutation)
+
+// newFsEventMutation creates new mutation for the FsEvent entity.
+func newFsEventMutation(c config, op Op, opts ...fseventOption) *FsEventMutation {
+	m := &FsEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFsEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withFsEventID sets the ID field of the mutation.
+func withFsEventID(id int) fseventOption {
+	return func(m *FsEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *FsEvent
+		)
+		m.oldValue = func(ctx context.Context) (*FsEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().FsEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withFsEvent sets the old FsEvent of the mutation.
+func withFsEvent(node *FsEvent) fseventOption {
+	return func(m *FsEventMutation) {
+		m.oldValue = func(context.Context) (*FsEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FsEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FsEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FsEventMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FsEventMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().FsEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *FsEventMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *FsEventMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the FsEvent entity.
+// If the FsEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FsEventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *EntityMutation) AddedFields() []string {
-	var fields []string
-	if m.add_type != nil {
-		fields = append(fields, entity.FieldType)
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *FsEventMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *FsEventMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *FsEventMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
-	if m.addsize != nil {
-		fields = append(fields, entity.FieldSize)
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the FsEvent entity.
+// If the FsEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FsEventMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	if m.addreference_count != nil {
-		fields = append(fields, entity.FieldReferenceCount)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
-	return fields
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *EntityMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case entity.FieldType:
-		return m.AddedType()
-	case entity.FieldSize:
-		return m.AddedSize()
-	case entity.FieldReferenceCount:
-		return m.AddedReferenceCount()
-	}
-	return nil, false
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *FsEventMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *EntityMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case entity.FieldType:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddType(v)
-		return nil
-	case entity.FieldSize:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSize(v)
-		return nil
-	case entity.FieldReferenceCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddReferenceCount(v)
-		return nil
-	}
-	return fmt.Errorf("unknown Entity numeric field %s", name)
+// SetDeletedAt sets the "deleted_at" field.
+func (m *FsEventMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *EntityMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(entity.FieldDeletedAt) {
-		fields = append(fields, entity.FieldDeletedAt)
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *FsEventMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
 	}
-	if m.FieldCleared(entity.FieldCreatedBy) {
-		fields = append(fields, entity.FieldCreatedBy)
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the FsEvent entity.
+// If the FsEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FsEventMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(entity.FieldUploadSessionID) {
-		fields = append(fields, entity.FieldUploadSessionID)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
 	}
-	if m.FieldCleared(entity.FieldProps) {
-		fields = append(fields, entity.FieldProps)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
 	}
-	return fields
+	return oldValue.DeletedAt, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *EntityMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *FsEventMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[fsevent.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *FsEventMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[fsevent.FieldDeletedAt]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *EntityMutation) ClearField(name string) error {
-	switch name {
-	case entity.FieldDeletedAt:
-		m.ClearDeletedAt()
-		return nil
-	case entity.FieldCreatedBy:
-		m.ClearCreatedBy()
-		return nil
-	case entity.FieldUploadSessionID:
-		m.ClearUploadSessionID()
-		return nil
-	case entity.FieldProps:
-		m.ClearProps()
-		return nil
-	}
-	return fmt.Errorf("unknown Entity nullable field %s", name)
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *FsEventMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, fsevent.FieldDeletedAt)
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *EntityMutation) ResetField(name string) error {
-	switch name {
-	case entity.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case entity.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case entity.FieldDeletedAt:
-		m.ResetDeletedAt()
-		return nil
-	case entity.FieldType:
-		m.ResetType()
-		return nil
-	case entity.FieldSource:
-		m.ResetSource()
-		return nil
-	case entity.FieldSize:
-		m.ResetSize()
-		return nil
-	case entity.FieldReferenceCount:
-		m.ResetReferenceCount()
-		return nil
-	case entity.FieldStoragePolicyEntities:
-		m.ResetStoragePolicyEntities()
-		return nil
-	case entity.FieldCreatedBy:
-		m.ResetCreatedBy()
-		return nil
-	case entity.FieldUploadSessionID:
-		m.ResetUploadSessionID()
-		return nil
-	case entity.FieldProps:
-		m.ResetProps()
-		return nil
-	}
-	return fmt.Errorf("unknown Entity field %s", name)
+// SetEvent sets the "event" field.
+func (m *FsEventMutation) SetEvent(s string) {
+	m.event = &s
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *EntityMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.file != nil {
-		edges = append(edges, entity.EdgeFile)
-	}
-	if m.user != nil {
-		edges = append(edges, entity.EdgeUser)
-	}
-	if m.storage_policy != nil {
-		edges = append(edges, entity.EdgeStoragePolicy)
+// Event returns the value of the "event" field in the mutation.
+func (m *FsEventMutation) Event() (r string, exists bool) {
+	v := m.event
+	if v == nil {
+		return
 	}
-	return edges
+	return *v, true
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *EntityMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case entity.EdgeFile:
-		ids := make([]ent.Value, 0, len(m.file))
-		for id := range m.file {
-			ids = append(ids, id)
-		}
-		return ids
-	case entity.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
-	case entity.EdgeStoragePolicy:
-		if id := m.storage_policy; id != nil {
-			return []ent.Value{*id}
-		}
+// OldEvent returns the old "event" field's value of the FsEvent entity.
+// If the FsEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FsEventMutation) OldEvent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEvent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEvent requires an ID field in the mutation")
 	}
-	return nil
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEvent: %w", err)
+	}
+	return oldValue.Event, nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *EntityMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedfile != nil {
-		edges = append(edges, entity.EdgeFile)
-	}
-	return edges
+// ResetEvent resets all changes to the "event" field.
+func (m *FsEventMutation) ResetEvent() {
+	m.event = nil
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *EntityMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case entity.EdgeFile:
-		ids := make([]ent.Value, 0, len(m.removedfile))
-		for id := range m.removedfile {
-			ids = append(ids, id)
-		}
-		return ids
+// SetSubscriber sets the "subscriber" field.
+func (m *FsEventMutation) SetSubscriber(u uuid.UUID) {
+	m.subscriber = &u
+}
+
+// Subscriber returns the value of the "subscriber" field in the mutation.
+func (m *FsEventMutation) Subscriber() (r uuid.UUID, exists bool) {
+	v := m.subscriber
+	if v == nil {
+		return
 	}
-	return nil
+	return *v, true
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *EntityMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedfile {
-		edges = append(edges, entity.EdgeFile)
+// OldSubscriber returns the old "subscriber" field's value of the FsEvent entity.
+// If the FsEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FsEventMutation) OldSubscriber(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubscriber is only allowed on UpdateOne operations")
 	}
-	if m.cleareduser {
-		edges = append(edges, entity.EdgeUser)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubscriber requires an ID field in the mutation")
 	}
-	if m.clearedstorage_policy {
-		edges = append(edges, entity.EdgeStoragePolicy)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubscriber: %w", err)
 	}
-	return edges
+	return oldValue.Subscriber, nil
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *EntityMutation) EdgeCleared(name string) bool {
-	switch name {
-	case entity.EdgeFile:
-		return m.clearedfile
-	case entity.EdgeUser:
-		return m.cleareduser
-	case entity.EdgeStoragePolicy:
-		return m.clearedstorage_policy
-	}
-	return false
+// ResetSubscriber resets all changes to the "subscriber" field.
+func (m *FsEventMutation) ResetSubscriber() {
+	m.subscriber = nil
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *EntityMutation) ClearEdge(name string) error {
-	switch name {
-	case entity.EdgeUser:
-		m.ClearUser()
-		return nil
-	case entity.EdgeStoragePolicy:
-		m.ClearStoragePolicy()
-		return nil
-	}
-	return fmt.Errorf("unknown Entity unique edge %s", name)
+// SetUserFsevent sets the "user_fsevent" field.
+func (m *FsEventMutation) SetUserFsevent(i int) {
+	m.user = &i
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *EntityMutation) ResetEdge(name string) error {
-	switch name {
-	case entity.EdgeFile:
-		m.ResetFile()
-		return nil
-	case entity.EdgeUser:
-		m.ResetUser()
-		return nil
-	case entity.EdgeStoragePolicy:
-		m.ResetStoragePolicy()
-		return nil
+// UserFsevent returns the value of the "user_fsevent" field in the mutation.
+func (m *FsEventMutation) UserFsevent() (r int, exists bool) {
+	v := m.user
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Entity edge %s", name)
+	return *v, true
 }
 
-// FileMutation represents an operation that mutates the File nodes in the graph.
-type FileMutation struct {
-	config
-	op                      Op
-	typ                     string
-	id                      *int
-	created_at              *time.Time
-	updated_at              *time.Time
-	_type                   *int
-	add_type                *int
-	name                    *string
-	size                    *int64
-	addsize                 *int64
-	primary_entity          *int
-	addprimary_entity       *int
-	is_symbolic             *bool
-	props                   **types.FileProps
-	clearedFields           map[string]struct{}
-	owner                   *int
-	clearedowner            bool
-	storage_policies        *int
-	clearedstorage_policies bool
-	parent                  *int
-	clearedparent           bool
-	children                map[int]struct{}
-	removedchildren         map[int]struct{}
-	clearedchildren         bool
-	metadata                map[int]struct{}
-	removedmetadata         map[int]struct{}
-	clearedmetadata         bool
-	entities                map[int]struct{}
-	removedentities         map[int]struct{}
-	clearedentities         bool
-	shares                  map[int]struct{}
-	removedshares           map[int]struct{}
-	clearedshares           bool
-	direct_links            map[int]struct{}
-	removeddirect_links     map[int]struct{}
-	cleareddirect_links     bool
-	done                    bool
-	oldValue                func(context.Context) (*File, error)
-	predicates              []predicate.File
+// OldUserFsevent returns the old "user_fsevent" field's value of the FsEvent entity.
+// If the FsEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FsEventMutation) OldUserFsevent(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserFsevent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserFsevent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserFsevent: %w", err)
+	}
+	return oldValue.UserFsevent, nil
 }
 
-var _ ent.Mutation = (*FileMutation)(nil)
-
-// fileOption allows management of the mutation configuration using functional options.
-type fileOption func(*FileMutation)
+// ClearUserFsevent clears the value of the "user_fsevent" field.
+func (m *FsEventMutation) ClearUserFsevent() {
+	m.user = nil
+	m.clearedFields[fsevent.FieldUserFsevent] = struct{}{}
+}
 
-// newFileMutation creates new mutation for the File entity.
-func newFileMutation(c config, op Op, opts ...fileOption) *FileMutation {
-	m := &FileMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeFile,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
-	}
-	return m
+// UserFseventCleared returns if the "user_fsevent" field was cleared in this mutation.
+func (m *FsEventMutation) UserFseventCleared() bool {
+	_, ok := m.clearedFields[fsevent.FieldUserFsevent]
+	return ok
 }
 
-// withFileID sets the ID field of the mutation.
-func withFileID(id int) fileOption {
-	return func(m *FileMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *File
-		)
-		m.oldValue = func(ctx context.Context) (*File, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().File.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
-	}
+// ResetUserFsevent resets all changes to the "user_fsevent" field.
+func (m *FsEventMutation) ResetUserFsevent() {
+	m.user = nil
+	delete(m.clearedFields, fsevent.FieldUserFsevent)
 }
 
-// withFile sets the old File of the mutation.
-func withFile(node *File) fileOption {
-	return func(m *FileMutation) {
-		m.oldValue = func(context.Context) (*File, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *FsEventMutation) SetUserID(id int) {
+	m.user = &id
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m FileMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ClearUser clears the "user" edge to the User entity.
+func (m *FsEventMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[fsevent.FieldUserFsevent] = struct{}{}
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m FileMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *FsEventMutation) UserCleared() bool {
+	return m.UserFseventCleared() || m.cleareduser
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *FileMutation) ID() (id int, exists bool) {
-	if m.id == nil {
-		return
+// UserID returns the "user" edge ID in the mutation.
+func (m *FsEventMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
 	}
-	return *m.id, true
+	return
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *FileMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().File.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *FsEventMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
+	return
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *FileMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// ResetUser resets all changes to the "user" edge.
+func (m *FsEventMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *FileMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// Where appends a list predicates to the FsEventMutation builder.
+func (m *FsEventMutation) Where(ps ...predicate.FsEvent) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+// WhereP appends storage-level predicates to the FsEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FsEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.FsEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return oldValue.CreatedAt, nil
+	m.Where(p...)
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *FileMutation) ResetCreatedAt() {
-	m.created_at = nil
+// Op returns the operation name.
+func (m *FsEventMutation) Op() Op {
+	return m.op
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *FileMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetOp allows setting the mutation operation.
+func (m *FsEventMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *FileMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (FsEvent).
+func (m *FsEventMutation) Type() string {
+	return m.typ
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FsEventMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.created_at != nil {
+		fields = append(fields, fsevent.FieldCreatedAt)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	if m.updated_at != nil {
+		fields = append(fields, fsevent.FieldUpdatedAt)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	if m.deleted_at != nil {
+		fields = append(fields, fsevent.FieldDeletedAt)
 	}
-	return oldValue.UpdatedAt, nil
+	if m.event != nil {
+		fields = append(fields, fsevent.FieldEvent)
+	}
+	if m.subscriber != nil {
+		fields = append(fields, fsevent.FieldSubscriber)
+	}
+	if m.user != nil {
+		fields = append(fields, fsevent.FieldUserFsevent)
+	}
+	return fields
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *FileMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FsEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case fsevent.FieldCreatedAt:
+		return m.CreatedAt()
+	case fsevent.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case fsevent.FieldDeletedAt:
+		return m.DeletedAt()
+	case fsevent.FieldEvent:
+		return m.Event()
+	case fsevent.FieldSubscriber:
+		return m.Subscriber()
+	case fsevent.FieldUserFsevent:
+		return m.UserFsevent()
+	}
+	return nil, false
 }
 
-// SetType sets the "type" field.
-func (m *FileMutation) SetType(i int) {
-	m._type = &i
-	m.add_type = nil
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FsEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case fsevent.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case fsevent.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case fsevent.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case fsevent.FieldEvent:
+		return m.OldEvent(ctx)
+	case fsevent.FieldSubscriber:
+		return m.OldSubscriber(ctx)
+	case fsevent.FieldUserFsevent:
+		return m.OldUserFsevent(ctx)
+	}
+	return nil, fmt.Errorf("unknown FsEvent field %s", name)
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *FileMutation) GetType() (r int, exists bool) {
-	v := m._type
-	if v == nil {
-		return
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FsEventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case fsevent.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case fsevent.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case fsevent.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case fsevent.FieldEvent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEvent(v)
+		return nil
+	case fsevent.FieldSubscriber:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubscriber(v)
+		return nil
+	case fsevent.FieldUserFsevent:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserFsevent(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown FsEvent field %s", name)
 }
 
-// OldType returns the old "type" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldType(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FsEventMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FsEventMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
 	}
-	return oldValue.Type, nil
+	return nil, false
 }
 
-// AddType adds i to the "type" field.
-func (m *FileMutation) AddType(i int) {
-	if m.add_type != nil {
-		*m.add_type += i
-	} else {
-		m.add_type = &i
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FsEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
+	return fmt.Errorf("unknown FsEvent numeric field %s", name)
 }
 
-// AddedType returns the value that was added to the "type" field in this mutation.
-func (m *FileMutation) AddedType() (r int, exists bool) {
-	v := m.add_type
-	if v == nil {
-		return
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FsEventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(fsevent.FieldDeletedAt) {
+		fields = append(fields, fsevent.FieldDeletedAt)
 	}
-	return *v, true
+	if m.FieldCleared(fsevent.FieldUserFsevent) {
+		fields = append(fields, fsevent.FieldUserFsevent)
+	}
+	return fields
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *FileMutation) ResetType() {
-	m._type = nil
-	m.add_type = nil
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FsEventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// SetName sets the "name" field.
-func (m *FileMutation) SetName(s string) {
-	m.name = &s
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FsEventMutation) ClearField(name string) error {
+	switch name {
+	case fsevent.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case fsevent.FieldUserFsevent:
+		m.ClearUserFsevent()
+		return nil
+	}
+	return fmt.Errorf("unknown FsEvent nullable field %s", name)
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *FileMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FsEventMutation) ResetField(name string) error {
+	switch name {
+	case fsevent.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case fsevent.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case fsevent.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case fsevent.FieldEvent:
+		m.ResetEvent()
+		return nil
+	case fsevent.FieldSubscriber:
+		m.ResetSubscriber()
+		return nil
+	case fsevent.FieldUserFsevent:
+		m.ResetUserFsevent()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown FsEvent field %s", name)
 }
 
-// OldName returns the old "name" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FsEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, fsevent.EdgeUser)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FsEventMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case fsevent.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
 	}
-	return oldValue.Name, nil
+	return nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *FileMutation) ResetName() {
-	m.name = nil
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FsEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
 }
 
-// SetOwnerID sets the "owner_id" field.
-func (m *FileMutation) SetOwnerID(i int) {
-	m.owner = &i
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FsEventMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// OwnerID returns the value of the "owner_id" field in the mutation.
-func (m *FileMutation) OwnerID() (r int, exists bool) {
-	v := m.owner
-	if v == nil {
-		return
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FsEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, fsevent.EdgeUser)
 	}
-	return *v, true
+	return edges
 }
 
-// OldOwnerID returns the old "owner_id" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldOwnerID(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOwnerID requires an ID field in the mutation")
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FsEventMutation) EdgeCleared(name string) bool {
+	switch name {
+	case fsevent.EdgeUser:
+		return m.cleareduser
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FsEventMutation) ClearEdge(name string) error {
+	switch name {
+	case fsevent.EdgeUser:
+		m.ClearUser()
+		return nil
 	}
-	return oldValue.OwnerID, nil
+	return fmt.Errorf("unknown FsEvent unique edge %s", name)
 }
 
-// ResetOwnerID resets all changes to the "owner_id" field.
-func (m *FileMutation) ResetOwnerID() {
-	m.owner = nil
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FsEventMutation) ResetEdge(name string) error {
+	switch name {
+	case fsevent.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown FsEvent edge %s", name)
 }
 
-// SetSize sets the "size" field.
-func (m *FileMutation) SetSize(i int64) {
-	m.size = &i
-	m.addsize = nil
+// GroupMutation represents an operation that mutates the Group nodes in the graph.
+type GroupMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *int
+	created_at              *time.Time
+	updated_at              *time.Time
+	deleted_at              *time.Time
+	name                    *string
+	max_storage             *int64
+	addmax_storage          *int64
+	speed_limit             *int
+	addspeed_limit          *int
+	permissions             **boolset.BooleanSet
+	settings                **types.GroupSetting
+	clearedFields           map[string]struct{}
+	users                   map[int]struct{}
+	removedusers            map[int]struct{}
+	clearedusers            bool
+	storage_policies        *int
+	clearedstorage_policies bool
+	done                    bool
+	oldValue                func(context.Context) (*Group, error)
+	predicates              []predicate.Group
 }
 
-// Size returns the value of the "size" field in the mutation.
-func (m *FileMutation) Size() (r int64, exists bool) {
-	v := m.size
-	if v == nil {
-		return
+var _ ent.Mutation = (*GroupMutation)(nil)
+
+// groupOption allows management of the mutation configuration using functional options.
+type groupOption func(*GroupMutation)
+
+// newGroupMutation creates new mutation for the Group entity.
+func newGroupMutation(c config, op Op, opts ...groupOption) *GroupMutation {
+	m := &GroupMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGroup,
+		clearedFields: make(map[string]struct{}),
 	}
-	return *v, true
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// OldSize returns the old "size" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldSize(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSize is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSize requires an ID field in the mutation")
+// withGroupID sets the ID field of the mutation.
+func withGroupID(id int) groupOption {
+	return func(m *GroupMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Group
+		)
+		m.oldValue = func(ctx context.Context) (*Group, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Group.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSize: %w", err)
+}
+
+// withGroup sets the old Group of the mutation.
+func withGroup(node *Group) groupOption {
+	return func(m *GroupMutation) {
+		m.oldValue = func(context.Context) (*Group, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return oldValue.Size, nil
 }
 
-// AddSize adds i to the "size" field.
-func (m *FileMutation) AddSize(i int64) {
-	if m.addsize != nil {
-		*m.addsize += i
-	} else {
-		m.addsize = &i
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GroupMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GroupMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// AddedSize returns the value that was added to the "size" field in this mutation.
-func (m *FileMutation) AddedSize() (r int64, exists bool) {
-	v := m.addsize
-	if v == nil {
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GroupMutation) ID() (id int, exists bool) {
+	if m.id == nil {
 		return
 	}
-	return *v, true
+	return *m.id, true
 }
 
-// ResetSize resets all changes to the "size" field.
-func (m *FileMutation) ResetSize() {
-	m.size = nil
-	m.addsize = nil
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GroupMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Group.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetPrimaryEntity sets the "primary_entity" field.
-func (m *FileMutation) SetPrimaryEntity(i int) {
-	m.primary_entity = &i
-	m.addprimary_entity = nil
+// SetCreatedAt sets the "created_at" field.
+func (m *GroupMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// PrimaryEntity returns the value of the "primary_entity" field in the mutation.
-func (m *FileMutation) PrimaryEntity() (r int, exists bool) {
-	v := m.primary_entity
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *GroupMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPrimaryEntity returns the old "primary_entity" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldPrimaryEntity(ctx context.Context) (v int, err error) {
+func (m *GroupMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPrimaryEntity is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPrimaryEntity requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPrimaryEntity: %w", err)
-	}
-	return oldValue.PrimaryEntity, nil
-}
-
-// AddPrimaryEntity adds i to the "primary_entity" field.
-func (m *FileMutation) AddPrimaryEntity(i int) {
-	if m.addprimary_entity != nil {
-		*m.addprimary_entity += i
-	} else {
-		m.addprimary_entity = &i
-	}
-}
-
-// AddedPrimaryEntity returns the value that was added to the "primary_entity" field in this mutation.
-func (m *FileMutation) AddedPrimaryEntity() (r int, exists bool) {
-	v := m.addprimary_entity
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return *v, true
-}
-
-// ClearPrimaryEntity clears the value of the "primary_entity" field.
-func (m *FileMutation) ClearPrimaryEntity() {
-	m.primary_entity = nil
-	m.addprimary_entity = nil
-	m.clearedFields[file.FieldPrimaryEntity] = struct{}{}
-}
-
-// PrimaryEntityCleared returns if the "primary_entity" field was cleared in this mutation.
-func (m *FileMutation) PrimaryEntityCleared() bool {
-	_, ok := m.clearedFields[file.FieldPrimaryEntity]
-	return ok
+	return oldValue.CreatedAt, nil
 }
 
-// ResetPrimaryEntity resets all changes to the "primary_entity" field.
-func (m *FileMutation) ResetPrimaryEntity() {
-	m.primary_entity = nil
-	m.addprimary_entity = nil
-	delete(m.clearedFields, file.FieldPrimaryEntity)
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *GroupMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetFileChildren sets the "file_children" field.
-func (m *FileMutation) SetFileChildren(i int) {
-	m.parent = &i
+// SetUpdatedAt sets the "updated_at" field.
+func (m *GroupMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// FileChildren returns the value of the "file_children" field in the mutation.
-func (m *FileMutation) FileChildren() (r int, exists bool) {
-	v := m.parent
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *GroupMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFileChildren returns the old "file_children" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldFileChildren(ctx context.Context) (v int, err error) {
+func (m *GroupMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFileChildren is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFileChildren requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFileChildren: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.FileChildren, nil
-}
-
-// ClearFileChildren clears the value of the "file_children" field.
-func (m *FileMutation) ClearFileChildren() {
-	m.parent = nil
-	m.clearedFields[file.FieldFileChildren] = struct{}{}
-}
-
-// FileChildrenCleared returns if the "file_children" field was cleared in this mutation.
-func (m *FileMutation) FileChildrenCleared() bool {
-	_, ok := m.clearedFields[file.FieldFileChildren]
-	return ok
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetFileChildren resets all changes to the "file_children" field.
-func (m *FileMutation) ResetFileChildren() {
-	m.parent = nil
-	delete(m.clearedFields, file.FieldFileChildren)
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *GroupMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetIsSymbolic sets the "is_symbolic" field.
-func (m *FileMutation) SetIsSymbolic(b bool) {
-	m.is_symbolic = &b
+// SetDeletedAt sets the "deleted_at" field.
+func (m *GroupMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
 }
 
-// IsSymbolic returns the value of the "is_symbolic" field in the mutation.
-func (m *FileMutation) IsSymbolic() (r bool, exists bool) {
-	v := m.is_symbolic
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *GroupMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsSymbolic returns the old "is_symbolic" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedAt returns the old "deleted_at" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldIsSymbolic(ctx context.Context) (v bool, err error) {
+func (m *GroupMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsSymbolic is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsSymbolic requires an ID field in the mutation")
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsSymbolic: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
 	}
-	return oldValue.IsSymbolic, nil
+	return oldValue.DeletedAt, nil
 }
 
-// ResetIsSymbolic resets all changes to the "is_symbolic" field.
-func (m *FileMutation) ResetIsSymbolic() {
-	m.is_symbolic = nil
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *GroupMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[group.FieldDeletedAt] = struct{}{}
 }
 
-// SetProps sets the "props" field.
-func (m *FileMutation) SetProps(tp *types.FileProps) {
-	m.props = &tp
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *GroupMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[group.FieldDeletedAt]
+	return ok
 }
 
-// Props returns the value of the "props" field in the mutation.
-func (m *FileMutation) Props() (r *types.FileProps, exists bool) {
-	v := m.props
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *GroupMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, group.FieldDeletedAt)
+}
+
+// SetName sets the "name" field.
+func (m *GroupMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *GroupMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProps returns the old "props" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldProps(ctx context.Context) (v *types.FileProps, err error) {
+func (m *GroupMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProps is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProps requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProps: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Props, nil
-}
-
-// ClearProps clears the value of the "props" field.
-func (m *FileMutation) ClearProps() {
-	m.props = nil
-	m.clearedFields[file.FieldProps] = struct{}{}
-}
-
-// PropsCleared returns if the "props" field was cleared in this mutation.
-func (m *FileMutation) PropsCleared() bool {
-	_, ok := m.clearedFields[file.FieldProps]
-	return ok
+	return oldValue.Name, nil
 }
 
-// ResetProps resets all changes to the "props" field.
-func (m *FileMutation) ResetProps() {
-	m.props = nil
-	delete(m.clearedFields, file.FieldProps)
+// ResetName resets all changes to the "name" field.
+func (m *GroupMutation) ResetName() {
+	m.name = nil
 }
 
-// SetStoragePolicyFiles sets the "storage_policy_files" field.
-func (m *FileMutation) SetStoragePolicyFiles(i int) {
-	m.storage_policies = &i
+// SetMaxStorage sets the "max_storage" field.
+func (m *GroupMutation) SetMaxStorage(i int64) {
+	m.max_storage = &i
+	m.addmax_storage = nil
 }
 
-// StoragePolicyFiles returns the value of the "storage_policy_files" field in the mutation.
-func (m *FileMutation) StoragePolicyFiles() (r int, exists bool) {
-	v := m.storage_policies
+// MaxStorage returns the value of the "max_storage" field in the mutation.
+func (m *GroupMutation) MaxStorage() (r int64, exists bool) {
+	v := m.max_storage
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStoragePolicyFiles returns the old "storage_policy_files" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
+// OldMaxStorage returns the old "max_storage" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldStoragePolicyFiles(ctx context.Context) (v int, err error) {
+func (m *GroupMutation) OldMaxStorage(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStoragePolicyFiles is only allowed on UpdateOne operations")
+		return v, errors.New("OldMaxStorage is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStoragePolicyFiles requires an ID field in the mutation")
+		return v, errors.New("OldMaxStorage requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStoragePolicyFiles: %w", err)
+		return v, fmt.Errorf("querying old value for OldMaxStorage: %w", err)
 	}
-	return oldValue.StoragePolicyFiles, nil
-}
-
-// ClearStoragePolicyFiles clears the value of the "storage_policy_files" field.
-func (m *FileMutation) ClearStoragePolicyFiles() {
-	m.storage_policies = nil
-	m.clearedFields[file.FieldStoragePolicyFiles] = struct{}{}
-}
-
-// StoragePolicyFilesCleared returns if the "storage_policy_files" field was cleared in this mutation.
-func (m *FileMutation) StoragePolicyFilesCleared() bool {
-	_, ok := m.clearedFields[file.FieldStoragePolicyFiles]
-	return ok
-}
-
-// ResetStoragePolicyFiles resets all changes to the "storage_policy_files" field.
-func (m *FileMutation) ResetStoragePolicyFiles() {
-	m.storage_policies = nil
-	delete(m.clearedFields, file.FieldStoragePolicyFiles)
-}
-
-// ClearOwner clears the "owner" edge to the User entity.
-func (m *FileMutation) ClearOwner() {
-	m.clearedowner = true
-	m.clearedFields[file.FieldOwnerID] = struct{}{}
+	return oldValue.MaxStorage, nil
 }
 
-// OwnerCleared reports if the "owner" edge to the User entity was cleared.
-func (m *FileMutation) OwnerCleared() bool {
-	return m.clearedowner
+// AddMaxStorage adds i to the "max_storage" field.
+func (m *GroupMutation) AddMaxStorage(i int64) {
+	if m.addmax_storage != nil {
+		*m.addmax_storage += i
+	} else {
+		m.addmax_storage = &i
+	}
 }
 
-// OwnerIDs returns the "owner" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// OwnerID instead. It exists only for internal usage by the builders.
-func (m *FileMutation) OwnerIDs() (ids []int) {
-	if id := m.owner; id != nil {
-		ids = append(ids, *id)
+// AddedMaxStorage returns the value that was added to the "max_storage" field in this mutation.
+func (m *GroupMutation) AddedMaxStorage() (r int64, exists bool) {
+	v := m.addmax_storage
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetOwner resets all changes to the "owner" edge.
-func (m *FileMutation) ResetOwner() {
-	m.owner = nil
-	m.clearedowner = false
+// ClearMaxStorage clears the value of the "max_storage" field.
+func (m *GroupMutation) ClearMaxStorage() {
+	m.max_storage = nil
+	m.addmax_storage = nil
+	m.clearedFields[group.FieldMaxStorage] = struct{}{}
 }
 
-// SetStoragePoliciesID sets the "storage_policies" edge to the StoragePolicy entity by id.
-func (m *FileMutation) SetStoragePoliciesID(id int) {
-	m.storage_policies = &id
+// MaxStorageCleared returns if the "max_storage" field was cleared in this mutation.
+func (m *GroupMutation) MaxStorageCleared() bool {
+	_, ok := m.clearedFields[group.FieldMaxStorage]
+	return ok
 }
 
-// ClearStoragePolicies clears the "storage_policies" edge to the StoragePolicy entity.
-func (m *FileMutation) ClearStoragePolicies() {
-	m.clearedstorage_policies = true
-	m.clearedFields[file.FieldStoragePolicyFiles] = struct{}{}
+// ResetMaxStorage resets all changes to the "max_storage" field.
+func (m *GroupMutation) ResetMaxStorage() {
+	m.max_storage = nil
+	m.addmax_storage = nil
+	delete(m.clearedFields, group.FieldMaxStorage)
 }
 
-// StoragePoliciesCleared reports if the "storage_policies" edge to the StoragePolicy entity was cleared.
-func (m *FileMutation) StoragePoliciesCleared() bool {
-	return m.StoragePolicyFilesCleared() || m.clearedstorage_policies
+// SetSpeedLimit sets the "speed_limit" field.
+func (m *GroupMutation) SetSpeedLimit(i int) {
+	m.speed_limit = &i
+	m.addspeed_limit = nil
 }
 
-// StoragePoliciesID returns the "storage_policies" edge ID in the mutation.
-func (m *FileMutation) StoragePoliciesID() (id int, exists bool) {
-	if m.storage_policies != nil {
-		return *m.storage_policies, true
+// SpeedLimit returns the value of the "speed_limit" field in the mutation.
+func (m *GroupMutation) SpeedLimit() (r int, exists bool) {
+	v := m.speed_limit
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// StoragePoliciesIDs returns the "storage_policies" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// StoragePoliciesID instead. It exists only for internal usage by the builders.
-func (m *FileMutation) StoragePoliciesIDs() (ids []int) {
-	if id := m.storage_policies; id != nil {
-		ids = append(ids, *id)
+// OldSpeedLimit returns the old "speed_limit" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMutation) OldSpeedLimit(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSpeedLimit is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// ResetStoragePolicies resets all changes to the "storage_policies" edge.
-func (m *FileMutation) ResetStoragePolicies() {
-	m.storage_policies = nil
-	m.clearedstorage_policies = false
-}
-
-// SetParentID sets the "parent" edge to the File entity by id.
-func (m *FileMutation) SetParentID(id int) {
-	m.parent = &id
-}
-
-// ClearParent clears the "parent" edge to the File entity.
-func (m *FileMutation) ClearParent() {
-	m.clearedparent = true
-	m.clearedFields[file.FieldFileChildren] = struct{}{}
-}
-
-// ParentCleared reports if the "parent" edge to the File entity was cleared.
-func (m *FileMutation) ParentCleared() bool {
-	return m.FileChildrenCleared() || m.clearedparent
-}
-
-// ParentID returns the "parent" edge ID in the mutation.
-func (m *FileMutation) ParentID() (id int, exists bool) {
-	if m.parent != nil {
-		return *m.parent, true
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSpeedLimit requires an ID field in the mutation")
 	}
-	return
-}
-
-// ParentIDs returns the "parent" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ParentID instead. It exists only for internal usage by the builders.
-func (m *FileMutation) ParentIDs() (ids []int) {
-	if id := m.parent; id != nil {
-		ids = append(ids, *id)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSpeedLimit: %w", err)
 	}
-	return
+	return oldValue.SpeedLimit, nil
 }
 
-// ResetParent resets all changes to the "parent" edge.
-func (m *FileMutation) ResetParent() {
-	m.parent = nil
-	m.clearedparent = false
+// AddSpeedLimit adds i to the "speed_limit" field.
+func (m *GroupMutation) AddSpeedLimit(i int) {
+	if m.addspeed_limit != nil {
+		*m.addspeed_limit += i
+	} else {
+		m.addspeed_limit = &i
+	}
 }
 
-// AddChildIDs adds the "children" edge to the File entity by ids.
-func (m *FileMutation) AddChildIDs(ids ...int) {
-	if m.children == nil {
-		m.children = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.children[ids[i]] = struct{}{}
+// AddedSpeedLimit returns the value that was added to the "speed_limit" field in this mutation.
+func (m *GroupMutation) AddedSpeedLimit() (r int, exists bool) {
+	v := m.addspeed_limit
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearChildren clears the "children" edge to the File entity.
-func (m *FileMutation) ClearChildren() {
-	m.clearedchildren = true
+// ClearSpeedLimit clears the value of the "speed_limit" field.
+func (m *GroupMutation) ClearSpeedLimit() {
+	m.speed_limit = nil
+	m.addspeed_limit = nil
+	m.clearedFields[group.FieldSpeedLimit] = struct{}{}
 }
 
-// ChildrenCleared reports if the "children" edge to the File entity was cleared.
-func (m *FileMutation) ChildrenCleared() bool {
-	return m.clearedchildren
+// SpeedLimitCleared returns if the "speed_limit" field was cleared in this mutation.
+func (m *GroupMutation) SpeedLimitCleared() bool {
+	_, ok := m.clearedFields[group.FieldSpeedLimit]
+	return ok
 }
 
-// RemoveChildIDs removes the "children" edge to the File entity by IDs.
-func (m *FileMutation) RemoveChildIDs(ids ...int) {
-	if m.removedchildren == nil {
-		m.removedchildren = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.children, ids[i])
-		m.removedchildren[ids[i]] = struct{}{}
-	}
+// ResetSpeedLimit resets all changes to the "speed_limit" field.
+func (m *GroupMutation) ResetSpeedLimit() {
+	m.speed_limit = nil
+	m.addspeed_limit = nil
+	delete(m.clearedFields, group.FieldSpeedLimit)
 }
 
-// RemovedChildren returns the removed IDs of the "children" edge to the File entity.
-func (m *FileMutation) RemovedChildrenIDs() (ids []int) {
-	for id := range m.removedchildren {
-		ids = append(ids, id)
-	}
-	return
+// SetPermissions sets the "permissions" field.
+func (m *GroupMutation) SetPermissions(bs *boolset.BooleanSet) {
+	m.permissions = &bs
 }
 
-// ChildrenIDs returns the "children" edge IDs in the mutation.
-func (m *FileMutation) ChildrenIDs() (ids []int) {
-	for id := range m.children {
-		ids = append(ids, id)
+// Permissions returns the value of the "permissions" field in the mutation.
+func (m *GroupMutation) Permissions() (r *boolset.BooleanSet, exists bool) {
+	v := m.permissions
+	if v == nil {
+		return
 	}
-	return
-}
-
-// ResetChildren resets all changes to the "children" edge.
-func (m *FileMutation) ResetChildren() {
-	m.children = nil
-	m.clearedchildren = false
-	m.removedchildren = nil
+	return *v, true
 }
 
-// AddMetadatumIDs adds the "metadata" edge to the Metadata entity by ids.
-func (m *FileMutation) AddMetadatumIDs(ids ...int) {
-	if m.metadata == nil {
-		m.metadata = make(map[int]struct{})
+// OldPermissions returns the old "permissions" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMutation) OldPermissions(ctx context.Context) (v *boolset.BooleanSet, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPermissions is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.metadata[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPermissions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPermissions: %w", err)
 	}
+	return oldValue.Permissions, nil
 }
 
-// ClearMetadata clears the "metadata" edge to the Metadata entity.
-func (m *FileMutation) ClearMetadata() {
-	m.clearedmetadata = true
+// ResetPermissions resets all changes to the "permissions" field.
+func (m *GroupMutation) ResetPermissions() {
+	m.permissions = nil
 }
 
-// MetadataCleared reports if the "metadata" edge to the Metadata entity was cleared.
-func (m *FileMutation) MetadataCleared() bool {
-	return m.clearedmetadata
+// SetSettings sets the "settings" field.
+func (m *GroupMutation) SetSettings(ts *types.GroupSetting) {
+	m.settings = &ts
 }
 
-// RemoveMetadatumIDs removes the "metadata" edge to the Metadata entity by IDs.
-func (m *FileMutation) RemoveMetadatumIDs(ids ...int) {
-	if m.removedmetadata == nil {
-		m.removedmetadata = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.metadata, ids[i])
-		m.removedmetadata[ids[i]] = struct{}{}
+// Settings returns the value of the "settings" field in the mutation.
+func (m *GroupMutation) Settings() (r *types.GroupSetting, exists bool) {
+	v := m.settings
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedMetadata returns the removed IDs of the "metadata" edge to the Metadata entity.
-func (m *FileMutation) RemovedMetadataIDs() (ids []int) {
-	for id := range m.removedmetadata {
-		ids = append(ids, id)
+// OldSettings returns the old "settings" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMutation) OldSettings(ctx context.Context) (v *types.GroupSetting, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSettings is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSettings requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSettings: %w", err)
+	}
+	return oldValue.Settings, nil
 }
 
-// MetadataIDs returns the "metadata" edge IDs in the mutation.
-func (m *FileMutation) MetadataIDs() (ids []int) {
-	for id := range m.metadata {
-		ids = append(ids, id)
-	}
-	return
+// ClearSettings clears the value of the "settings" field.
+func (m *GroupMutation) ClearSettings() {
+	m.settings = nil
+	m.clearedFields[group.FieldSettings] = struct{}{}
 }
 
-// ResetMetadata resets all changes to the "metadata" edge.
-func (m *FileMutation) ResetMetadata() {
-	m.metadata = nil
-	m.clearedmetadata = false
-	m.removedmetadata = nil
+// SettingsCleared returns if the "settings" field was cleared in this mutation.
+func (m *GroupMutation) SettingsCleared() bool {
+	_, ok := m.clearedFields[group.FieldSettings]
+	return ok
 }
 
-// AddEntityIDs adds the "entities" edge to the Entity entity by ids.
-func (m *FileMutation) AddEntityIDs(ids ...int) {
-	if m.entities == nil {
-		m.entities = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.entities[ids[i]] = struct{}{}
-	}
+// ResetSettings resets all changes to the "settings" field.
+func (m *GroupMutation) ResetSettings() {
+	m.settings = nil
+	delete(m.clearedFields, group.FieldSettings)
 }
 
-// ClearEntities clears the "entities" edge to the Entity entity.
-func (m *FileMutation) ClearEntities() {
-	m.clearedentities = true
+// SetStoragePolicyID sets the "storage_policy_id" field.
+func (m *GroupMutation) SetStoragePolicyID(i int) {
+	m.storage_policies = &i
 }
 
-// EntitiesCleared reports if the "entities" edge to the Entity entity was cleared.
-func (m *FileMutation) EntitiesCleared() bool {
-	return m.clearedentities
+// StoragePolicyID returns the value of the "storage_policy_id" field in the mutation.
+func (m *GroupMutation) StoragePolicyID() (r int, exists bool) {
+	v := m.storage_policies
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveEntityIDs removes the "entities" edge to the Entity entity by IDs.
-func (m *FileMutation) RemoveEntityIDs(ids ...int) {
-	if m.removedentities == nil {
-		m.removedentities = make(map[int]struct{})
+// OldStoragePolicyID returns the old "storage_policy_id" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMutation) OldStoragePolicyID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStoragePolicyID is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.entities, ids[i])
-		m.removedentities[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStoragePolicyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStoragePolicyID: %w", err)
 	}
+	return oldValue.StoragePolicyID, nil
 }
 
-// RemovedEntities returns the removed IDs of the "entities" edge to the Entity entity.
-func (m *FileMutation) RemovedEntitiesIDs() (ids []int) {
-	for id := range m.removedentities {
-		ids = append(ids, id)
-	}
-	return
+// ClearStoragePolicyID clears the value of the "storage_policy_id" field.
+func (m *GroupMutation) ClearStoragePolicyID() {
+	m.storage_policies = nil
+	m.clearedFields[group.FieldStoragePolicyID] = struct{}{}
 }
 
-// EntitiesIDs returns the "entities" edge IDs in the mutation.
-func (m *FileMutation) EntitiesIDs() (ids []int) {
-	for id := range m.entities {
-		ids = append(ids, id)
-	}
-	return
+// StoragePolicyIDCleared returns if the "storage_policy_id" field was cleared in this mutation.
+func (m *GroupMutation) StoragePolicyIDCleared() bool {
+	_, ok := m.clearedFields[group.FieldStoragePolicyID]
+	return ok
 }
 
-// ResetEntities resets all changes to the "entities" edge.
-func (m *FileMutation) ResetEntities() {
-	m.entities = nil
-	m.clearedentities = false
-	m.removedentities = nil
+// ResetStoragePolicyID resets all changes to the "storage_policy_id" field.
+func (m *GroupMutation) ResetStoragePolicyID() {
+	m.storage_policies = nil
+	delete(m.clearedFields, group.FieldStoragePolicyID)
 }
 
-// AddShareIDs adds the "shares" edge to the Share entity by ids.
-func (m *FileMutation) AddShareIDs(ids ...int) {
-	if m.shares == nil {
-		m.shares = make(map[int]struct{})
+// AddUserIDs adds the "users" edge to the User entity by ids.
+func (m *GroupMutation) AddUserIDs(ids ...int) {
+	if m.users == nil {
+		m.users = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.shares[ids[i]] = struct{}{}
+		m.users[ids[i]] = struct{}{}
 	}
 }
 
-// ClearShares clears the "shares" edge to the Share entity.
-func (m *FileMutation) ClearShares() {
-	m.clearedshares = true
+// ClearUsers clears the "users" edge to the User entity.
+func (m *GroupMutation) ClearUsers() {
+	m.clearedusers = true
 }
 
-// SharesCleared reports if the "shares" edge to the Share entity was cleared.
-func (m *FileMutation) SharesCleared() bool {
-	return m.clearedshares
+// UsersCleared reports if the "users" edge to the User entity was cleared.
+func (m *GroupMutation) UsersCleared() bool {
+	return m.clearedusers
 }
 
-// RemoveShareIDs removes the "shares" edge to the Share entity by IDs.
-func (m *FileMutation) RemoveShareIDs(ids ...int) {
-	if m.removedshares == nil {
-		m.removedshares = make(map[int]struct{})
+// RemoveUserIDs removes the "users" edge to the User entity by IDs.
+func (m *GroupMutation) RemoveUserIDs(ids ...int) {
+	if m.removedusers == nil {
+		m.removedusers = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.shares, ids[i])
-		m.removedshares[ids[i]] = struct{}{}
+		delete(m.users, ids[i])
+		m.removedusers[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedShares returns the removed IDs of the "shares" edge to the Share entity.
-func (m *FileMutation) RemovedSharesIDs() (ids []int) {
-	for id := range m.removedshares {
+// RemovedUsers returns the removed IDs of the "users" edge to the User entity.
+func (m *GroupMutation) RemovedUsersIDs() (ids []int) {
+	for id := range m.removedusers {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// SharesIDs returns the "shares" edge IDs in the mutation.
-func (m *FileMutation) SharesIDs() (ids []int) {
-	for id := range m.shares {
+// UsersIDs returns the "users" edge IDs in the mutation.
+func (m *GroupMutation) UsersIDs() (ids []int) {
+	for id := range m.users {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetShares resets all changes to the "shares" edge.
-func (m *FileMutation) ResetShares() {
-	m.shares = nil
-	m.clearedshares = false
-	m.removedshares = nil
-}
-
-// AddDirectLinkIDs adds the "direct_links" edge to the DirectLink entity by ids.
-func (m *FileMutation) AddDirectLinkIDs(ids ...int) {
-	if m.direct_links == nil {
-		m.direct_links = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.direct_links[ids[i]] = struct{}{}
-	}
+// ResetUsers resets all changes to the "users" edge.
+func (m *GroupMutation) ResetUsers() {
+	m.users = nil
+	m.clearedusers = false
+	m.removedusers = nil
 }
 
-// ClearDirectLinks clears the "direct_links" edge to the DirectLink entity.
-func (m *FileMutation) ClearDirectLinks() {
-	m.cleareddirect_links = true
+// SetStoragePoliciesID sets the "storage_policies" edge to the StoragePolicy entity by id.
+func (m *GroupMutation) SetStoragePoliciesID(id int) {
+	m.storage_policies = &id
 }
 
-// DirectLinksCleared reports if the "direct_links" edge to the DirectLink entity was cleared.
-func (m *FileMutation) DirectLinksCleared() bool {
-	return m.cleareddirect_links
+// ClearStoragePolicies clears the "storage_policies" edge to the StoragePolicy entity.
+func (m *GroupMutation) ClearStoragePolicies() {
+	m.clearedstorage_policies = true
+	m.clearedFields[group.FieldStoragePolicyID] = struct{}{}
 }
 
-// RemoveDirectLinkIDs removes the "direct_links" edge to the DirectLink entity by IDs.
-func (m *FileMutation) RemoveDirectLinkIDs(ids ...int) {
-	if m.removeddirect_links == nil {
-		m.removeddirect_links = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.direct_links, ids[i])
-		m.removeddirect_links[ids[i]] = struct{}{}
-	}
+// StoragePoliciesCleared reports if the "storage_policies" edge to the StoragePolicy entity was cleared.
+func (m *GroupMutation) StoragePoliciesCleared() bool {
+	return m.StoragePolicyIDCleared() || m.clearedstorage_policies
 }
 
-// RemovedDirectLinks returns the removed IDs of the "direct_links" edge to the DirectLink entity.
-func (m *FileMutation) RemovedDirectLinksIDs() (ids []int) {
-	for id := range m.removeddirect_links {
-		ids = append(ids, id)
+// StoragePoliciesID returns the "storage_policies" edge ID in the mutation.
+func (m *GroupMutation) StoragePoliciesID() (id int, exists bool) {
+	if m.storage_policies != nil {
+		return *m.storage_policies, true
 	}
 	return
 }
 
-// DirectLinksIDs returns the "direct_links" edge IDs in the mutation.
-func (m *FileMutation) DirectLinksIDs() (ids []int) {
-	for id := range m.direct_links {
-		ids = append(ids, id)
+// StoragePoliciesIDs returns the "storage_policies" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// StoragePoliciesID instead. It exists only for internal usage by the builders.
+func (m *GroupMutation) StoragePoliciesIDs() (ids []int) {
+	if id := m.storage_policies; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetDirectLinks resets all changes to the "direct_links" edge.
-func (m *FileMutation) ResetDirectLinks() {
-	m.direct_links = nil
-	m.cleareddirect_links = false
-	m.removeddirect_links = nil
+// ResetStoragePolicies resets all changes to the "storage_policies" edge.
+func (m *GroupMutation) ResetStoragePolicies() {
+	m.storage_policies = nil
+	m.clearedstorage_policies = false
 }
 
-// Where appends a list predicates to the FileMutation builder.
-func (m *FileMutation) Where(ps ...predicate.File) {
+// Where appends a list predicates to the GroupMutation builder.
+func (m *GroupMutation) Where(ps ...predicate.Group) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the FileMutation builder. Using this method,
+// WhereP appends storage-level predicates to the GroupMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *FileMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.File, len(ps))
+func (m *GroupMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Group, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4010,57 +9810,51 @@ func (m *FileMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *FileMutation) Op() Op {
+func (m *GroupMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *FileMutation) SetOp(op Op) {
+func (m *GroupMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (File).
-func (m *FileMutation) Type() string {
+// Type returns the node type of this mutation (Group).
+func (m *GroupMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *FileMutation) Fields() []string {
-	fields := make([]string, 0, 11)
+func (m *GroupMutation) Fields() []string {
+	fields := make([]string, 0, 9)
 	if m.created_at != nil {
-		fields = append(fields, file.FieldCreatedAt)
+		fields = append(fields, group.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, file.FieldUpdatedAt)
+		fields = append(fields, group.FieldUpdatedAt)
 	}
-	if m._type != nil {
-		fields = append(fields, file.FieldType)
+	if m.deleted_at != nil {
+		fields = append(fields, group.FieldDeletedAt)
 	}
 	if m.name != nil {
-		fields = append(fields, file.FieldName)
-	}
-	if m.owner != nil {
-		fields = append(fields, file.FieldOwnerID)
-	}
-	if m.size != nil {
-		fields = append(fields, file.FieldSize)
+		fields = append(fields, group.FieldName)
 	}
-	if m.primary_entity != nil {
-		fields = append(fields, file.FieldPrimaryEntity)
+	if m.max_storage != nil {
+		fields = append(fields, group.FieldMaxStorage)
 	}
-	if m.parent != nil {
-		fields = append(fields, file.FieldFileChildren)
+	if m.speed_limit != nil {
+		fields = append(fields, group.FieldSpeedLimit)
 	}
-	if m.is_symbolic != nil {
-		fields = append(fields, file.FieldIsSymbolic)
+	if m.permissions != nil {
+		fields = append(fields, group.FieldPermissions)
 	}
-	if m.props != nil {
-		fields = append(fields, file.FieldProps)
+	if m.settings != nil {
+		fields = append(fields, group.FieldSettings)
 	}
 	if m.storage_policies != nil {
-		fields = append(fields, file.FieldStoragePolicyFiles)
+		fields = append(fields, group.FieldStoragePolicyID)
 	}
 	return fields
 }
@@ -4068,30 +9862,26 @@ func (m *FileMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *FileMutation) Field(name string) (ent.Value, bool) {
+func (m *GroupMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case file.FieldCreatedAt:
+	case group.FieldCreatedAt:
 		return m.CreatedAt()
-	case file.FieldUpdatedAt:
+	case group.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case file.FieldType:
-		return m.GetType()
-	case file.FieldName:
+	case group.FieldDeletedAt:
+		return m.DeletedAt()
+	case group.FieldName:
 		return m.Name()
-	case file.FieldOwnerID:
-		return m.OwnerID()
-	case file.FieldSize:
-		return m.Size()
-	case file.FieldPrimaryEntity:
-		return m.PrimaryEntity()
-	case file.FieldFileChildren:
-		return m.FileChildren()
-	case file.FieldIsSymbolic:
-		return m.IsSymbolic()
-	case file.FieldProps:
-		return m.Props()
-	case file.FieldStoragePolicyFiles:
-		return m.StoragePolicyFiles()
+	case group.FieldMaxStorage:
+		return m.MaxStorage()
+	case group.FieldSpeedLimit:
+		return m.SpeedLimit()
+	case group.FieldPermissions:
+		return m.Permissions()
+	case group.FieldSettings:
+		return m.Settings()
+	case group.FieldStoragePolicyID:
+		return m.StoragePolicyID()
 	}
 	return nil, false
 }
@@ -4099,132 +9889,111 @@ func (m *FileMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *FileMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *GroupMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case file.FieldCreatedAt:
+	case group.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case file.FieldUpdatedAt:
+	case group.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case file.FieldType:
-		return m.OldType(ctx)
-	case file.FieldName:
+	case group.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case group.FieldName:
 		return m.OldName(ctx)
-	case file.FieldOwnerID:
-		return m.OldOwnerID(ctx)
-	case file.FieldSize:
-		return m.OldSize(ctx)
-	case file.FieldPrimaryEntity:
-		return m.OldPrimaryEntity(ctx)
-	case file.FieldFileChildren:
-		return m.OldFileChildren(ctx)
-	case file.FieldIsSymbolic:
-		return m.OldIsSymbolic(ctx)
-	case file.FieldProps:
-		return m.OldProps(ctx)
-	case file.FieldStoragePolicyFiles:
-		return m.OldStoragePolicyFiles(ctx)
+	case group.FieldMaxStorage:
+		return m.OldMaxStorage(ctx)
+	case group.FieldSpeedLimit:
+		return m.OldSpeedLimit(ctx)
+	case group.FieldPermissions:
+		return m.OldPermissions(ctx)
+	case group.FieldSettings:
+		return m.OldSettings(ctx)
+	case group.FieldStoragePolicyID:
+		return m.OldStoragePolicyID(ctx)
 	}
-	return nil, fmt.Errorf("unknown File field %s", name)
+	return nil, fmt.Errorf("unknown Group field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FileMutation) SetField(name string, value ent.Value) error {
+func (m *GroupMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case file.FieldCreatedAt:
+	case group.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case file.FieldUpdatedAt:
+	case group.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case file.FieldType:
-		v, ok := value.(int)
+	case group.FieldDeletedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetType(v)
+		m.SetDeletedAt(v)
 		return nil
-	case file.FieldName:
+	case group.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case file.FieldOwnerID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetOwnerID(v)
-		return nil
-	case file.FieldSize:
+	case group.FieldMaxStorage:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSize(v)
-		return nil
-	case file.FieldPrimaryEntity:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPrimaryEntity(v)
+		m.SetMaxStorage(v)
 		return nil
-	case file.FieldFileChildren:
+	case group.FieldSpeedLimit:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFileChildren(v)
+		m.SetSpeedLimit(v)
 		return nil
-	case file.FieldIsSymbolic:
-		v, ok := value.(bool)
+	case group.FieldPermissions:
+		v, ok := value.(*boolset.BooleanSet)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsSymbolic(v)
+		m.SetPermissions(v)
 		return nil
-	case file.FieldProps:
-		v, ok := value.(*types.FileProps)
+	case group.FieldSettings:
+		v, ok := value.(*types.GroupSetting)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProps(v)
+		m.SetSettings(v)
 		return nil
-	case file.FieldStoragePolicyFiles:
+	case group.FieldStoragePolicyID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStoragePolicyFiles(v)
+		m.SetStoragePolicyID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown File field %s", name)
+	return fmt.Errorf("unknown Group field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *FileMutation) AddedFields() []string {
+func (m *GroupMutation) AddedFields() []string {
 	var fields []string
-	if m.add_type != nil {
-		fields = append(fields, file.FieldType)
-	}
-	if m.addsize != nil {
-		fields = append(fields, file.FieldSize)
+	if m.addmax_storage != nil {
+		fields = append(fields, group.FieldMaxStorage)
 	}
-	if m.addprimary_entity != nil {
-		fields = append(fields, file.FieldPrimaryEntity)
+	if m.addspeed_limit != nil {
+		fields = append(fields, group.FieldSpeedLimit)
 	}
 	return fields
 }
@@ -4232,14 +10001,12 @@ func (m *FileMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *FileMutation) AddedField(name string) (ent.Value, bool) {
+func (m *GroupMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case file.FieldType:
-		return m.AddedType()
-	case file.FieldSize:
-		return m.AddedSize()
-	case file.FieldPrimaryEntity:
-		return m.AddedPrimaryEntity()
+	case group.FieldMaxStorage:
+		return m.AddedMaxStorage()
+	case group.FieldSpeedLimit:
+		return m.AddedSpeedLimit()
 	}
 	return nil, false
 }
@@ -4247,252 +10014,159 @@ func (m *FileMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FileMutation) AddField(name string, value ent.Value) error {
+func (m *GroupMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case file.FieldType:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddType(v)
-		return nil
-	case file.FieldSize:
+	case group.FieldMaxStorage:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddSize(v)
+		m.AddMaxStorage(v)
 		return nil
-	case file.FieldPrimaryEntity:
+	case group.FieldSpeedLimit:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddPrimaryEntity(v)
+		m.AddSpeedLimit(v)
 		return nil
 	}
-	return fmt.Errorf("unknown File numeric field %s", name)
+	return fmt.Errorf("unknown Group numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *FileMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(file.FieldPrimaryEntity) {
-		fields = append(fields, file.FieldPrimaryEntity)
+func (m *GroupMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(group.FieldDeletedAt) {
+		fields = append(fields, group.FieldDeletedAt)
 	}
-	if m.FieldCleared(file.FieldFileChildren) {
-		fields = append(fields, file.FieldFileChildren)
+	if m.FieldCleared(group.FieldMaxStorage) {
+		fields = append(fields, group.FieldMaxStorage)
 	}
-	if m.FieldCleared(file.FieldProps) {
-		fields = append(fields, file.FieldProps)
+	if m.FieldCleared(group.FieldSpeedLimit) {
+		fields = append(fields, group.FieldSpeedLimit)
 	}
-	if m.FieldCleared(file.FieldStoragePolicyFiles) {
-		fields = append(fields, file.FieldStoragePolicyFiles)
+	if m.FieldCleared(group.FieldSettings) {
+		fields = append(fields, group.FieldSettings)
+	}
+	if m.FieldCleared(group.FieldStoragePolicyID) {
+		fields = append(fields, group.FieldStoragePolicyID)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *FileMutation) FieldCleared(name string) bool {
+func (m *GroupMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *FileMutation) ClearField(name string) error {
+func (m *GroupMutation) ClearField(name string) error {
 	switch name {
-	case file.FieldPrimaryEntity:
-		m.ClearPrimaryEntity()
+	case group.FieldDeletedAt:
+		m.ClearDeletedAt()
 		return nil
-	case file.FieldFileChildren:
-		m.ClearFileChildren()
+	case group.FieldMaxStorage:
+		m.ClearMaxStorage()
 		return nil
-	case file.FieldProps:
-		m.ClearProps()
+	case group.FieldSpeedLimit:
+		m.ClearSpeedLimit()
 		return nil
-	case file.FieldStoragePolicyFiles:
-		m.ClearStoragePolicyFiles()
+	case group.FieldSettings:
+		m.ClearSettings()
+		return nil
+	case group.FieldStoragePolicyID:
+		m.ClearStoragePolicyID()
 		return nil
 	}
-	return fmt.Errorf("unknown File nullable field %s", name)
+	return fmt.Errorf("unknown Group nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *FileMutation) ResetField(name string) error {
+func (m *GroupMutation) ResetField(name string) error {
 	switch name {
-	case file.FieldCreatedAt:
+	case group.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case file.FieldUpdatedAt:
+	case group.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case file.FieldType:
-		m.ResetType()
+	case group.FieldDeletedAt:
+		m.ResetDeletedAt()
 		return nil
-	case file.FieldName:
+	case group.FieldName:
 		m.ResetName()
 		return nil
-	case file.FieldOwnerID:
-		m.ResetOwnerID()
-		return nil
-	case file.FieldSize:
-		m.ResetSize()
-		return nil
-	case file.FieldPrimaryEntity:
-		m.ResetPrimaryEntity()
+	case group.FieldMaxStorage:
+		m.ResetMaxStorage()
 		return nil
-	case file.FieldFileChildren:
-		m.ResetFileChildren()
+	case group.FieldSpeedLimit:
+		m.ResetSpeedLimit()
 		return nil
-	case file.FieldIsSymbolic:
-		m.ResetIsSymbolic()
+	case group.FieldPermissions:
+		m.ResetPermissions()
 		return nil
-	case file.FieldProps:
-		m.ResetProps()
+	case group.FieldSettings:
+		m.ResetSettings()
 		return nil
-	case file.FieldStoragePolicyFiles:
-		m.ResetStoragePolicyFiles()
+	case group.FieldStoragePolicyID:
+		m.ResetStoragePolicyID()
 		return nil
 	}
-	return fmt.Errorf("unknown File field %s", name)
+	return fmt.Errorf("unknown Group field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *FileMutation) AddedEdges() []string {
-	edges := make([]string, 0, 8)
-	if m.owner != nil {
-		edges = append(edges, file.EdgeOwner)
+func (m *GroupMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.users != nil {
+		edges = append(edges, group.EdgeUsers)
 	}
 	if m.storage_policies != nil {
-		edges = append(edges, file.EdgeStoragePolicies)
-	}
-	if m.parent != nil {
-		edges = append(edges, file.EdgeParent)
-	}
-	if m.children != nil {
-		edges = append(edges, file.EdgeChildren)
-	}
-	if m.metadata != nil {
-		edges = append(edges, file.EdgeMetadata)
-	}
-	if m.entities != nil {
-		edges = append(edges, file.EdgeEntities)
-	}
-	if m.shares != nil {
-		edges = append(edges, file.EdgeShares)
-	}
-	if m.direct_links != nil {
-		edges = append(edges, file.EdgeDirectLinks)
+		edges = append(edges, group.EdgeStoragePolicies)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *FileMutation) AddedIDs(name string) []ent.Value {
+func (m *GroupMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case file.EdgeOwner:
-		if id := m.owner; id != nil {
-			return []ent.Value{*id}
-		}
-	case file.EdgeStoragePolicies:
-		if id := m.storage_policies; id != nil {
-			return []ent.Value{*id}
-		}
-	case file.EdgeParent:
-		if id := m.parent; id != nil {
-			return []ent.Value{*id}
-		}
-	case file.EdgeChildren:
-		ids := make([]ent.Value, 0, len(m.children))
-		for id := range m.children {
-			ids = append(ids, id)
-		}
-		return ids
-	case file.EdgeMetadata:
-		ids := make([]ent.Value, 0, len(m.metadata))
-		for id := range m.metadata {
-			ids = append(ids, id)
-		}
-		return ids
-	case file.EdgeEntities:
-		ids := make([]ent.Value, 0, len(m.entities))
-		for id := range m.entities {
-			ids = append(ids, id)
-		}
-		return ids
-	case file.EdgeShares:
-		ids := make([]ent.Value, 0, len(m.shares))
-		for id := range m.shares {
+	case group.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.users))
+		for id := range m.users {
 			ids = append(ids, id)
 		}
 		return ids
-	case file.EdgeDirectLinks:
-		ids := make([]ent.Value, 0, len(m.direct_links))
-		for id := range m.direct_links {
-			ids = append(ids, id)
+	case group.EdgeStoragePolicies:
+		if id := m.storage_policies; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *FileMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 8)
-	if m.removedchildren != nil {
-		edges = append(edges, file.EdgeChildren)
-	}
-	if m.removedmetadata != nil {
-		edges = append(edges, file.EdgeMetadata)
-	}
-	if m.removedentities != nil {
-		edges = append(edges, file.EdgeEntities)
-	}
-	if m.removedshares != nil {
-		edges = append(edges, file.EdgeShares)
-	}
-	if m.removeddirect_links != nil {
-		edges = append(edges, file.EdgeDirectLinks)
+func (m *GroupMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedusers != nil {
+		edges = append(edges, group.EdgeUsers)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *FileMutation) RemovedIDs(name string) []ent.Value {
+func (m *GroupMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case file.EdgeChildren:
-		ids := make([]ent.Value, 0, len(m.removedchildren))
-		for id := range m.removedchildren {
-			ids = append(ids, id)
-		}
-		return ids
-	case file.EdgeMetadata:
-		ids := make([]ent.Value, 0, len(m.removedmetadata))
-		for id := range m.removedmetadata {
-			ids = append(ids, id)
-		}
-		return ids
-	case file.EdgeEntities:
-		ids := make([]ent.Value, 0, len(m.removedentities))
-		for id := range m.removedentities {
-			ids = append(ids, id)
-		}
-		return ids
-	case file.EdgeShares:
-		ids := make([]ent.Value, 0, len(m.removedshares))
-		for id := range m.removedshares {
-			ids = append(ids, id)
-		}
-		return ids
-	case file.EdgeDirectLinks:
-		ids := make([]ent.Value, 0, len(m.removeddirect_links))
-		for id := range m.removeddirect_links {
+	case group.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.removedusers))
+		for id := range m.removedusers {
 			ids = append(ids, id)
 		}
 		return ids
@@ -4501,110 +10175,56 @@ func (m *FileMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *FileMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 8)
-	if m.clearedowner {
-		edges = append(edges, file.EdgeOwner)
+func (m *GroupMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedusers {
+		edges = append(edges, group.EdgeUsers)
 	}
 	if m.clearedstorage_policies {
-		edges = append(edges, file.EdgeStoragePolicies)
-	}
-	if m.clearedparent {
-		edges = append(edges, file.EdgeParent)
-	}
-	if m.clearedchildren {
-		edges = append(edges, file.EdgeChildren)
-	}
-	if m.clearedmetadata {
-		edges = append(edges, file.EdgeMetadata)
-	}
-	if m.clearedentities {
-		edges = append(edges, file.EdgeEntities)
-	}
-	if m.clearedshares {
-		edges = append(edges, file.EdgeShares)
-	}
-	if m.cleareddirect_links {
-		edges = append(edges, file.EdgeDirectLinks)
+		edges = append(edges, group.EdgeStoragePolicies)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *FileMutation) EdgeCleared(name string) bool {
+func (m *GroupMutation) EdgeCleared(name string) bool {
 	switch name {
-	case file.EdgeOwner:
-		return m.clearedowner
-	case file.EdgeStoragePolicies:
+	case group.EdgeUsers:
+		return m.clearedusers
+	case group.EdgeStoragePolicies:
 		return m.clearedstorage_policies
-	case file.EdgeParent:
-		return m.clearedparent
-	case file.EdgeChildren:
-		return m.clearedchildren
-	case file.EdgeMetadata:
-		return m.clearedmetadata
-	case file.EdgeEntities:
-		return m.clearedentities
-	case file.EdgeShares:
-		return m.clearedshares
-	case file.EdgeDirectLinks:
-		return m.cleareddirect_links
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *FileMutation) ClearEdge(name string) error {
+func (m *GroupMutation) ClearEdge(name string) error {
 	switch name {
-	case file.EdgeOwner:
-		m.ClearOwner()
-		return nil
-	case file.EdgeStoragePolicies:
+	case group.EdgeStoragePolicies:
 		m.ClearStoragePolicies()
 		return nil
-	case file.EdgeParent:
-		m.ClearParent()
-		return nil
 	}
-	return fmt.Errorf("unknown File unique edge %s", name)
+	return fmt.Errorf("unknown Group unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *FileMutation) ResetEdge(name string) error {
+func (m *GroupMutation) ResetEdge(name string) error {
 	switch name {
-	case file.EdgeOwner:
-		m.ResetOwner()
+	case group.EdgeUsers:
+		m.ResetUsers()
 		return nil
-	case file.EdgeStoragePolicies:
+	case group.EdgeStoragePolicies:
 		m.ResetStoragePolicies()
 		return nil
-	case file.EdgeParent:
-		m.ResetParent()
-		return nil
-	case file.EdgeChildren:
-		m.ResetChildren()
-		return nil
-	case file.EdgeMetadata:
-		m.ResetMetadata()
-		return nil
-	case file.EdgeEntities:
-		m.ResetEntities()
-		return nil
-	case file.EdgeShares:
-		m.ResetShares()
-		return nil
-	case file.EdgeDirectLinks:
-		m.ResetDirectLinks()
-		return nil
 	}
-	return fmt.Errorf("unknown File edge %s", name)
+	return fmt.Errorf("unknown Group edge %s", name)
 }
 
-// FsEventMutation represents an operation that mutates the FsEvent nodes in the graph.
-type FsEventMutation struct {
+// MetadataMutation represents an operation that mutates the Metadata nodes in the graph.
+type MetadataMutation struct {
 	config
 	op            Op
 	typ           string
@@ -4612,27 +10232,28 @@ type FsEventMutation struct {
 	created_at    *time.Time
 	updated_at    *time.Time
 	deleted_at    *time.Time
-	event         *string
-	subscriber    *uuid.UUID
+	name          *string
+	value         *string
+	is_public     *bool
 	clearedFields map[string]struct{}
-	user          *int
-	cleareduser   bool
+	file          *int
+	clearedfile   bool
 	done          bool
-	oldValue      func(context.Context) (*FsEvent, error)
-	predicates    []predicate.FsEvent
+	oldValue      func(context.Context) (*Metadata, error)
+	predicates    []predicate.Metadata
 }
 
-var _ ent.Mutation = (*FsEventMutation)(nil)
+var _ ent.Mutation = (*MetadataMutation)(nil)
 
-// fseventOption allows management of the mutation configuration using functional options.
-type fseventOption func(*FsEventMutation)
+// metadataOption allows management of the mutation configuration using functional options.
+type metadataOption func(*MetadataMutation)
 
-// newFsEventMutation creates new mutation for the FsEvent entity.
-func newFsEventMutation(c config, op Op, opts ...fseventOption) *FsEventMutation {
-	m := &FsEventMutation{
+// newMetadataMutation creates new mutation for the Metadata entity.
+func newMetadataMutation(c config, op Op, opts ...metadataOption) *MetadataMutation {
+	m := &MetadataMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeFsEvent,
+		typ:           TypeMetadata,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4641,20 +10262,20 @@ func newFsEventMutation(c config, op Op, opts ...fseventOption) *FsEventMutation
 	return m
 }
 
-// withFsEventID sets the ID field of the mutation.
-func withFsEventID(id int) fseventOption {
-	return func(m *FsEventMutation) {
+// withMetadataID sets the ID field of the mutation.
+func withMetadataID(id int) metadataOption {
+	return func(m *MetadataMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *FsEvent
+			value *Metadata
 		)
-		m.oldValue = func(ctx context.Context) (*FsEvent, error) {
+		m.oldValue = func(ctx context.Context) (*Metadata, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().FsEvent.Get(ctx, id)
+					value, err = m.Client().Metadata.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4663,10 +10284,10 @@ func withFsEventID(id int) fseventOption {
 	}
 }
 
-// withFsEvent sets the old FsEvent of the mutation.
-func withFsEvent(node *FsEvent) fseventOption {
-	return func(m *FsEventMutation) {
-		m.oldValue = func(context.Context) (*FsEvent, error) {
+// withMetadata sets the old Metadata of the mutation.
+func withMetadata(node *Metadata) metadataOption {
+	return func(m *MetadataMutation) {
+		m.oldValue = func(context.Context) (*Metadata, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4675,7 +10296,7 @@ func withFsEvent(node *FsEvent) fseventOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m FsEventMutation) Client() *Client {
+func (m MetadataMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4683,7 +10304,7 @@ func (m FsEventMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m FsEventMutation) Tx() (*Tx, error) {
+func (m MetadataMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4694,7 +10315,7 @@ func (m FsEventMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *FsEventMutation) ID() (id int, exists bool) {
+func (m *MetadataMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4705,7 +10326,7 @@ func (m *FsEventMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *FsEventMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *MetadataMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4714,19 +10335,19 @@ func (m *FsEventMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().FsEvent.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Metadata.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *FsEventMutation) SetCreatedAt(t time.Time) {
+func (m *MetadataMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *FsEventMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *MetadataMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -4734,10 +10355,10 @@ func (m *FsEventMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the FsEvent entity.
-// If the FsEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Metadata entity.
+// If the Metadata object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FsEventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *MetadataMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -4752,17 +10373,17 @@ func (m *FsEventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err er
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *FsEventMutation) ResetCreatedAt() {
+func (m *MetadataMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *FsEventMutation) SetUpdatedAt(t time.Time) {
+func (m *MetadataMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *FsEventMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *MetadataMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -4770,10 +10391,10 @@ func (m *FsEventMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the FsEvent entity.
-// If the FsEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Metadata entity.
+// If the Metadata object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FsEventMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *MetadataMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -4788,17 +10409,17 @@ func (m *FsEventMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err er
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *FsEventMutation) ResetUpdatedAt() {
+func (m *MetadataMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // SetDeletedAt sets the "deleted_at" field.
-func (m *FsEventMutation) SetDeletedAt(t time.Time) {
+func (m *MetadataMutation) SetDeletedAt(t time.Time) {
 	m.deleted_at = &t
 }
 
 // DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *FsEventMutation) DeletedAt() (r time.Time, exists bool) {
+func (m *MetadataMutation) DeletedAt() (r time.Time, exists bool) {
 	v := m.deleted_at
 	if v == nil {
 		return
@@ -4806,10 +10427,10 @@ func (m *FsEventMutation) DeletedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the FsEvent entity.
-// If the FsEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedAt returns the old "deleted_at" field's value of the Metadata entity.
+// If the Metadata object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FsEventMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *MetadataMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
@@ -4824,193 +10445,203 @@ func (m *FsEventMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err e
 }
 
 // ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *FsEventMutation) ClearDeletedAt() {
+func (m *MetadataMutation) ClearDeletedAt() {
 	m.deleted_at = nil
-	m.clearedFields[fsevent.FieldDeletedAt] = struct{}{}
+	m.clearedFields[metadata.FieldDeletedAt] = struct{}{}
 }
 
 // DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *FsEventMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[fsevent.FieldDeletedAt]
+func (m *MetadataMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[metadata.FieldDeletedAt]
 	return ok
 }
 
 // ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *FsEventMutation) ResetDeletedAt() {
+func (m *MetadataMutation) ResetDeletedAt() {
 	m.deleted_at = nil
-	delete(m.clearedFields, fsevent.FieldDeletedAt)
+	delete(m.clearedFields, metadata.FieldDeletedAt)
 }
 
-// SetEvent sets the "event" field.
-func (m *FsEventMutation) SetEvent(s string) {
-	m.event = &s
+// SetName sets the "name" field.
+func (m *MetadataMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Event returns the value of the "event" field in the mutation.
-func (m *FsEventMutation) Event() (r string, exists bool) {
-	v := m.event
+// Name returns the value of the "name" field in the mutation.
+func (m *MetadataMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEvent returns the old "event" field's value of the FsEvent entity.
-// If the FsEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Metadata entity.
+// If the Metadata object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FsEventMutation) OldEvent(ctx context.Context) (v string, err error) {
+func (m *MetadataMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEvent is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEvent requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEvent: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Event, nil
+	return oldValue.Name, nil
 }
 
-// ResetEvent resets all changes to the "event" field.
-func (m *FsEventMutation) ResetEvent() {
-	m.event = nil
+// ResetName resets all changes to the "name" field.
+func (m *MetadataMutation) ResetName() {
+	m.name = nil
 }
 
-// SetSubscriber sets the "subscriber" field.
-func (m *FsEventMutation) SetSubscriber(u uuid.UUID) {
-	m.subscriber = &u
+// SetValue sets the "value" field.
+func (m *MetadataMutation) SetValue(s string) {
+	m.value = &s
 }
 
-// Subscriber returns the value of the "subscriber" field in the mutation.
-func (m *FsEventMutation) Subscriber() (r uuid.UUID, exists bool) {
-	v := m.subscriber
+// Value returns the value of the "value" field in the mutation.
+func (m *MetadataMutation) Value() (r string, exists bool) {
+	v := m.value
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSubscriber returns the old "subscriber" field's value of the FsEvent entity.
-// If the FsEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldValue returns the old "value" field's value of the Metadata entity.
+// If the Metadata object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FsEventMutation) OldSubscriber(ctx context.Context) (v uuid.UUID, err error) {
+func (m *MetadataMutation) OldValue(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSubscriber is only allowed on UpdateOne operations")
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSubscriber requires an ID field in the mutation")
+		return v, errors.New("OldValue requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSubscriber: %w", err)
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
 	}
-	return oldValue.Subscriber, nil
+	return oldValue.Value, nil
 }
 
-// ResetSubscriber resets all changes to the "subscriber" field.
-func (m *FsEventMutation) ResetSubscriber() {
-	m.subscriber = nil
+// ResetValue resets all changes to the "value" field.
+func (m *MetadataMutation) ResetValue() {
+	m.value = nil
 }
 
-// SetUserFsevent sets the "user_fsevent" field.
-func (m *FsEventMutation) SetUserFsevent(i int) {
-	m.user = &i
+// SetFileID sets the "file_id" field.
+func (m *MetadataMutation) SetFileID(i int) {
+	m.file = &i
 }
 
-// UserFsevent returns the value of the "user_fsevent" field in the mutation.
-func (m *FsEventMutation) UserFsevent() (r int, exists bool) {
-	v := m.user
+// FileID returns the value of the "file_id" field in the mutation.
+func (m *MetadataMutation) FileID() (r int, exists bool) {
+	v := m.file
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserFsevent returns the old "user_fsevent" field's value of the FsEvent entity.
-// If the FsEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldFileID returns the old "file_id" field's value of the Metadata entity.
+// If the Metadata object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FsEventMutation) OldUserFsevent(ctx context.Context) (v int, err error) {
+func (m *MetadataMutation) OldFileID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserFsevent is only allowed on UpdateOne operations")
+		return v, errors.New("OldFileID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserFsevent requires an ID field in the mutation")
+		return v, errors.New("OldFileID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserFsevent: %w", err)
+		return v, fmt.Errorf("querying old value for OldFileID: %w", err)
 	}
-	return oldValue.UserFsevent, nil
+	return oldValue.FileID, nil
 }
 
-// ClearUserFsevent clears the value of the "user_fsevent" field.
-func (m *FsEventMutation) ClearUserFsevent() {
-	m.user = nil
-	m.clearedFields[fsevent.FieldUserFsevent] = struct{}{}
+// ResetFileID resets all changes to the "file_id" field.
+func (m *MetadataMutation) ResetFileID() {
+	m.file = nil
 }
 
-// UserFseventCleared returns if the "user_fsevent" field was cleared in this mutation.
-func (m *FsEventMutation) UserFseventCleared() bool {
-	_, ok := m.clearedFields[fsevent.FieldUserFsevent]
-	return ok
+// SetIsPublic sets the "is_public" field.
+func (m *MetadataMutation) SetIsPublic(b bool) {
+	m.is_public = &b
 }
 
-// ResetUserFsevent resets all changes to the "user_fsevent" field.
-func (m *FsEventMutation) ResetUserFsevent() {
-	m.user = nil
-	delete(m.clearedFields, fsevent.FieldUserFsevent)
+// IsPublic returns the value of the "is_public" field in the mutation.
+func (m *MetadataMutation) IsPublic() (r bool, exists bool) {
+	v := m.is_public
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *FsEventMutation) SetUserID(id int) {
-	m.user = &id
+// OldIsPublic returns the old "is_public" field's value of the Metadata entity.
+// If the Metadata object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MetadataMutation) OldIsPublic(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsPublic is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsPublic requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsPublic: %w", err)
+	}
+	return oldValue.IsPublic, nil
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *FsEventMutation) ClearUser() {
-	m.cleareduser = true
-	m.clearedFields[fsevent.FieldUserFsevent] = struct{}{}
+// ResetIsPublic resets all changes to the "is_public" field.
+func (m *MetadataMutation) ResetIsPublic() {
+	m.is_public = nil
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *FsEventMutation) UserCleared() bool {
-	return m.UserFseventCleared() || m.cleareduser
+// ClearFile clears the "file" edge to the File entity.
+func (m *MetadataMutation) ClearFile() {
+	m.clearedfile = true
+	m.clearedFields[metadata.FieldFileID] = struct{}{}
 }
 
-// UserID returns the "user" edge ID in the mutation.
-func (m *FsEventMutation) UserID() (id int, exists bool) {
-	if m.user != nil {
-		return *m.user, true
-	}
-	return
+// FileCleared reports if the "file" edge to the File entity was cleared.
+func (m *MetadataMutation) FileCleared() bool {
+	return m.clearedfile
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
+// FileIDs returns the "file" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *FsEventMutation) UserIDs() (ids []int) {
-	if id := m.user; id != nil {
+// FileID instead. It exists only for internal usage by the builders.
+func (m *MetadataMutation) FileIDs() (ids []int) {
+	if id := m.file; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *FsEventMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// ResetFile resets all changes to the "file" edge.
+func (m *MetadataMutation) ResetFile() {
+	m.file = nil
+	m.clearedfile = false
 }
 
-// Where appends a list predicates to the FsEventMutation builder.
-func (m *FsEventMutation) Where(ps ...predicate.FsEvent) {
+// Where appends a list predicates to the MetadataMutation builder.
+func (m *MetadataMutation) Where(ps ...predicate.Metadata) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the FsEventMutation builder. Using this method,
+// WhereP appends storage-level predicates to the MetadataMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *FsEventMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.FsEvent, len(ps))
+func (m *MetadataMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Metadata, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5018,42 +10649,45 @@ func (m *FsEventMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *FsEventMutation) Op() Op {
+func (m *MetadataMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *FsEventMutation) SetOp(op Op) {
+func (m *MetadataMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (FsEvent).
-func (m *FsEventMutation) Type() string {
+// Type returns the node type of this mutation (Metadata).
+func (m *MetadataMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *FsEventMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+func (m *MetadataMutation) Fields() []string {
+	fields := make([]string, 0, 7)
 	if m.created_at != nil {
-		fields = append(fields, fsevent.FieldCreatedAt)
+		fields = append(fields, metadata.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, fsevent.FieldUpdatedAt)
+		fields = append(fields, metadata.FieldUpdatedAt)
 	}
 	if m.deleted_at != nil {
-		fields = append(fields, fsevent.FieldDeletedAt)
+		fields = append(fields, metadata.FieldDeletedAt)
 	}
-	if m.event != nil {
-		fields = append(fields, fsevent.FieldEvent)
+	if m.name != nil {
+		fields = append(fields, metadata.FieldName)
 	}
-	if m.subscriber != nil {
-		fields = append(fields, fsevent.FieldSubscriber)
+	if m.value != nil {
+		fields = append(fields, metadata.FieldValue)
 	}
-	if m.user != nil {
-		fields = append(fields, fsevent.FieldUserFsevent)
+	if m.file != nil {
+		fields = append(fields, metadata.FieldFileID)
+	}
+	if m.is_public != nil {
+		fields = append(fields, metadata.FieldIsPublic)
 	}
 	return fields
 }
@@ -5061,20 +10695,22 @@ func (m *FsEventMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *FsEventMutation) Field(name string) (ent.Value, bool) {
+func (m *MetadataMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case fsevent.FieldCreatedAt:
+	case metadata.FieldCreatedAt:
 		return m.CreatedAt()
-	case fsevent.FieldUpdatedAt:
+	case metadata.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case fsevent.FieldDeletedAt:
+	case metadata.FieldDeletedAt:
 		return m.DeletedAt()
-	case fsevent.FieldEvent:
-		return m.Event()
-	case fsevent.FieldSubscriber:
-		return m.Subscriber()
-	case fsevent.FieldUserFsevent:
-		return m.UserFsevent()
+	case metadata.FieldName:
+		return m.Name()
+	case metadata.FieldValue:
+		return m.Value()
+	case metadata.FieldFileID:
+		return m.FileID()
+	case metadata.FieldIsPublic:
+		return m.IsPublic()
 	}
 	return nil, false
 }
@@ -5082,78 +10718,87 @@ func (m *FsEventMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *FsEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *MetadataMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case fsevent.FieldCreatedAt:
+	case metadata.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case fsevent.FieldUpdatedAt:
+	case metadata.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case fsevent.FieldDeletedAt:
+	case metadata.FieldDeletedAt:
 		return m.OldDeletedAt(ctx)
-	case fsevent.FieldEvent:
-		return m.OldEvent(ctx)
-	case fsevent.FieldSubscriber:
-		return m.OldSubscriber(ctx)
-	case fsevent.FieldUserFsevent:
-		return m.OldUserFsevent(ctx)
+	case metadata.FieldName:
+		return m.OldName(ctx)
+	case metadata.FieldValue:
+		return m.OldValue(ctx)
+	case metadata.FieldFileID:
+		return m.OldFileID(ctx)
+	case metadata.FieldIsPublic:
+		return m.OldIsPublic(ctx)
 	}
-	return nil, fmt.Errorf("unknown FsEvent field %s", name)
+	return nil, fmt.Errorf("unknown Metadata field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FsEventMutation) SetField(name string, value ent.Value) error {
+func (m *MetadataMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case fsevent.FieldCreatedAt:
+	case metadata.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case fsevent.FieldUpdatedAt:
+	case metadata.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case fsevent.FieldDeletedAt:
+	case metadata.FieldDeletedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDeletedAt(v)
 		return nil
-	case fsevent.FieldEvent:
+	case metadata.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEvent(v)
+		m.SetName(v)
 		return nil
-	case fsevent.FieldSubscriber:
-		v, ok := value.(uuid.UUID)
+	case metadata.FieldValue:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSubscriber(v)
+		m.SetValue(v)
 		return nil
-	case fsevent.FieldUserFsevent:
+	case metadata.FieldFileID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserFsevent(v)
+		m.SetFileID(v)
+		return nil
+	case metadata.FieldIsPublic:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsPublic(v)
 		return nil
 	}
-	return fmt.Errorf("unknown FsEvent field %s", name)
+	return fmt.Errorf("unknown Metadata field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *FsEventMutation) AddedFields() []string {
+func (m *MetadataMutation) AddedFields() []string {
 	var fields []string
 	return fields
 }
@@ -5161,7 +10806,7 @@ func (m *FsEventMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *FsEventMutation) AddedField(name string) (ent.Value, bool) {
+func (m *MetadataMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
 	}
 	return nil, false
@@ -5170,87 +10815,84 @@ func (m *FsEventMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FsEventMutation) AddField(name string, value ent.Value) error {
+func (m *MetadataMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown FsEvent numeric field %s", name)
+	return fmt.Errorf("unknown Metadata numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *FsEventMutation) ClearedFields() []string {
+func (m *MetadataMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(fsevent.FieldDeletedAt) {
-		fields = append(fields, fsevent.FieldDeletedAt)
-	}
-	if m.FieldCleared(fsevent.FieldUserFsevent) {
-		fields = append(fields, fsevent.FieldUserFsevent)
+	if m.FieldCleared(metadata.FieldDeletedAt) {
+		fields = append(fields, metadata.FieldDeletedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *FsEventMutation) FieldCleared(name string) bool {
+func (m *MetadataMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *FsEventMutation) ClearField(name string) error {
+func (m *MetadataMutation) ClearField(name string) error {
 	switch name {
-	case fsevent.FieldDeletedAt:
+	case metadata.FieldDeletedAt:
 		m.ClearDeletedAt()
 		return nil
-	case fsevent.FieldUserFsevent:
-		m.ClearUserFsevent()
-		return nil
 	}
-	return fmt.Errorf("unknown FsEvent nullable field %s", name)
+	return fmt.Errorf("unknown Metadata nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *FsEventMutation) ResetField(name string) error {
+func (m *MetadataMutation) ResetField(name string) error {
 	switch name {
-	case fsevent.FieldCreatedAt:
+	case metadata.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case fsevent.FieldUpdatedAt:
+	case metadata.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case fsevent.FieldDeletedAt:
+	case metadata.FieldDeletedAt:
 		m.ResetDeletedAt()
 		return nil
-	case fsevent.FieldEvent:
-		m.ResetEvent()
+	case metadata.FieldName:
+		m.ResetName()
 		return nil
-	case fsevent.FieldSubscriber:
-		m.ResetSubscriber()
+	case metadata.FieldValue:
+		m.ResetValue()
 		return nil
-	case fsevent.FieldUserFsevent:
-		m.ResetUserFsevent()
+	case metadata.FieldFileID:
+		m.ResetFileID()
+		return nil
+	case metadata.FieldIsPublic:
+		m.ResetIsPublic()
 		return nil
 	}
-	return fmt.Errorf("unknown FsEvent field %s", name)
+	return fmt.Errorf("unknown Metadata field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *FsEventMutation) AddedEdges() []string {
+func (m *MetadataMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.user != nil {
-		edges = append(edges, fsevent.EdgeUser)
+	if m.file != nil {
+		edges = append(edges, metadata.EdgeFile)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *FsEventMutation) AddedIDs(name string) []ent.Value {
+func (m *MetadataMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case fsevent.EdgeUser:
-		if id := m.user; id != nil {
+	case metadata.EdgeFile:
+		if id := m.file; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -5258,96 +10900,96 @@ func (m *FsEventMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *FsEventMutation) RemovedEdges() []string {
+func (m *MetadataMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *FsEventMutation) RemovedIDs(name string) []ent.Value {
+func (m *MetadataMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *FsEventMutation) ClearedEdges() []string {
+func (m *MetadataMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.cleareduser {
-		edges = append(edges, fsevent.EdgeUser)
+	if m.clearedfile {
+		edges = append(edges, metadata.EdgeFile)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *FsEventMutation) EdgeCleared(name string) bool {
+func (m *MetadataMutation) EdgeCleared(name string) bool {
 	switch name {
-	case fsevent.EdgeUser:
-		return m.cleareduser
+	case metadata.EdgeFile:
+		return m.clearedfile
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *FsEventMutation) ClearEdge(name string) error {
+func (m *MetadataMutation) ClearEdge(name string) error {
 	switch name {
-	case fsevent.EdgeUser:
-		m.ClearUser()
+	case metadata.EdgeFile:
+		m.ClearFile()
 		return nil
 	}
-	return fmt.Errorf("unknown FsEvent unique edge %s", name)
+	return fmt.Errorf("unknown Metadata unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *FsEventMutation) ResetEdge(name string) error {
+func (m *MetadataMutation) ResetEdge(name string) error {
 	switch name {
-	case fsevent.EdgeUser:
-		m.ResetUser()
+	case metadata.EdgeFile:
+		m.ResetFile()
 		return nil
 	}
-	return fmt.Errorf("unknown FsEvent edge %s", name)
+	return fmt.Errorf("unknown Metadata edge %s", name)
 }
 
-// GroupMutation represents an operation that mutates the Group nodes in the graph.
-type GroupMutation struct {
+// NodeMutation represents an operation that mutates the Node nodes in the graph.
+type NodeMutation struct {
 	config
-	op                      Op
-	typ                     string
-	id                      *int
-	created_at              *time.Time
-	updated_at              *time.Time
-	deleted_at              *time.Time
-	name                    *string
-	max_storage             *int64
-	addmax_storage          *int64
-	speed_limit             *int
-	addspeed_limit          *int
-	permissions             **boolset.BooleanSet
-	settings                **types.GroupSetting
-	clearedFields           map[string]struct{}
-	users                   map[int]struct{}
-	removedusers            map[int]struct{}
-	clearedusers            bool
-	storage_policies        *int
-	clearedstorage_policies bool
-	done                    bool
-	oldValue                func(context.Context) (*Group, error)
-	predicates              []predicate.Group
+	op                    Op
+	typ                   string
+	id                    *int
+	created_at            *time.Time
+	updated_at            *time.Time
+	deleted_at            *time.Time
+	status                *node.Status
+	name                  *string
+	_type                 *node.Type
+	server                *string
+	slave_key             *string
+	capabilities          **boolset.BooleanSet
+	settings              **types.NodeSetting
+	weight                *int
+	addweight             *int
+	clearedFields         map[string]struct{}
+	storage_policy        map[int]struct{}
+	removedstorage_policy map[int]struct{}
+	clearedstorage_policy bool
+	done                  bool
+	oldValue              func(context.Context) (*Node, error)
+	predicates            []predicate.Node
 }
 
-var _ ent.Mutation = (*GroupMutation)(nil)
+var _ ent.Mutation = (*NodeMutation)(nil)
 
-// groupOption allows management of the mutation configuration using functional options.
-type groupOption func(*GroupMutation)
+// nodeOption allows management of the mutation configuration using functional options.
+type nodeOption func(*NodeMutation)
 
-// newGroupMutation creates new mutation for the Group entity.
-func newGroupMutation(c config, op Op, opts ...groupOption) *GroupMutation {
-	m := &GroupMutation{
+// newNodeMutation creates new mutation for the Node entity.
+func newNodeMutation(c config, op Op, opts ...nodeOption) *NodeMutation {
+	m := &NodeMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeGroup,
+		typ:           TypeNode,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5356,20 +10998,20 @@ func newGroupMutation(c config, op Op, opts ...groupOption) *GroupMutation {
 	return m
 }
 
-// withGroupID sets the ID field of the mutation.
-func withGroupID(id int) groupOption {
-	return func(m *GroupMutation) {
+// withNodeID sets the ID field of the mutation.
+func withNodeID(id int) nodeOption {
+	return func(m *NodeMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Group
+			value *Node
 		)
-		m.oldValue = func(ctx context.Context) (*Group, error) {
+		m.oldValue = func(ctx context.Context) (*Node, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Group.Get(ctx, id)
+					value, err = m.Client().Node.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5378,10 +11020,10 @@ func withGroupID(id int) groupOption {
 	}
 }
 
-// withGroup sets the old Group of the mutation.
-func withGroup(node *Group) groupOption {
-	return func(m *GroupMutation) {
-		m.oldValue = func(context.Context) (*Group, error) {
+// withNode sets the old Node of the mutation.
+func withNode(node *Node) nodeOption {
+	return func(m *NodeMutation) {
+		m.oldValue = func(context.Context) (*Node, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5390,7 +11032,7 @@ func withGroup(node *Group) groupOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m GroupMutation) Client() *Client {
+func (m NodeMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5398,7 +11040,7 @@ func (m GroupMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m GroupMutation) Tx() (*Tx, error) {
+func (m NodeMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -5409,7 +11051,7 @@ func (m GroupMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *GroupMutation) ID() (id int, exists bool) {
+func (m *NodeMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -5420,7 +11062,7 @@ func (m *GroupMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *GroupMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *NodeMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -5429,19 +11071,19 @@ func (m *GroupMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Group.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Node.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *GroupMutation) SetCreatedAt(t time.Time) {
+func (m *NodeMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *GroupMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *NodeMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -5449,10 +11091,10 @@ func (m *GroupMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Group entity.
-// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *NodeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -5467,17 +11109,17 @@ func (m *GroupMutation) OldCreatedAt(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *GroupMutation) ResetCreatedAt() {
+func (m *NodeMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *GroupMutation) SetUpdatedAt(t time.Time) {
+func (m *NodeMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *GroupMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *NodeMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -5485,10 +11127,10 @@ func (m *GroupMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Group entity.
-// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *NodeMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -5503,17 +11145,17 @@ func (m *GroupMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *GroupMutation) ResetUpdatedAt() {
+func (m *NodeMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // SetDeletedAt sets the "deleted_at" field.
-func (m *GroupMutation) SetDeletedAt(t time.Time) {
+func (m *NodeMutation) SetDeletedAt(t time.Time) {
 	m.deleted_at = &t
 }
 
 // DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *GroupMutation) DeletedAt() (r time.Time, exists bool) {
+func (m *NodeMutation) DeletedAt() (r time.Time, exists bool) {
 	v := m.deleted_at
 	if v == nil {
 		return
@@ -5521,10 +11163,10 @@ func (m *GroupMutation) DeletedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the Group entity.
-// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedAt returns the old "deleted_at" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *NodeMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
@@ -5539,30 +11181,66 @@ func (m *GroupMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err err
 }
 
 // ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *GroupMutation) ClearDeletedAt() {
+func (m *NodeMutation) ClearDeletedAt() {
 	m.deleted_at = nil
-	m.clearedFields[group.FieldDeletedAt] = struct{}{}
+	m.clearedFields[node.FieldDeletedAt] = struct{}{}
 }
 
 // DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *GroupMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[group.FieldDeletedAt]
+func (m *NodeMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[node.FieldDeletedAt]
 	return ok
 }
 
 // ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *GroupMutation) ResetDeletedAt() {
+func (m *NodeMutation) ResetDeletedAt() {
 	m.deleted_at = nil
-	delete(m.clearedFields, group.FieldDeletedAt)
+	delete(m.clearedFields, node.FieldDeletedAt)
+}
+
+// SetStatus sets the "status" field.
+func (m *NodeMutation) SetStatus(n node.Status) {
+	m.status = &n
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *NodeMutation) Status() (r node.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldStatus(ctx context.Context) (v node.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *NodeMutation) ResetStatus() {
+	m.status = nil
 }
 
 // SetName sets the "name" field.
-func (m *GroupMutation) SetName(s string) {
+func (m *NodeMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *GroupMutation) Name() (r string, exists bool) {
+func (m *NodeMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -5570,10 +11248,10 @@ func (m *GroupMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Group entity.
-// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *NodeMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -5588,193 +11266,187 @@ func (m *GroupMutation) OldName(ctx context.Context) (v string, err error) {
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *GroupMutation) ResetName() {
+func (m *NodeMutation) ResetName() {
 	m.name = nil
 }
 
-// SetMaxStorage sets the "max_storage" field.
-func (m *GroupMutation) SetMaxStorage(i int64) {
-	m.max_storage = &i
-	m.addmax_storage = nil
+// SetType sets the "type" field.
+func (m *NodeMutation) SetType(n node.Type) {
+	m._type = &n
 }
 
-// MaxStorage returns the value of the "max_storage" field in the mutation.
-func (m *GroupMutation) MaxStorage() (r int64, exists bool) {
-	v := m.max_storage
+// GetType returns the value of the "type" field in the mutation.
+func (m *NodeMutation) GetType() (r node.Type, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldType(ctx context.Context) (v node.Type, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *NodeMutation) ResetType() {
+	m._type = nil
+}
+
+// SetServer sets the "server" field.
+func (m *NodeMutation) SetServer(s string) {
+	m.server = &s
+}
+
+// Server returns the value of the "server" field in the mutation.
+func (m *NodeMutation) Server() (r string, exists bool) {
+	v := m.server
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMaxStorage returns the old "max_storage" field's value of the Group entity.
-// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// OldServer returns the old "server" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMutation) OldMaxStorage(ctx context.Context) (v int64, err error) {
+func (m *NodeMutation) OldServer(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMaxStorage is only allowed on UpdateOne operations")
+		return v, errors.New("OldServer is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMaxStorage requires an ID field in the mutation")
+		return v, errors.New("OldServer requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMaxStorage: %w", err)
-	}
-	return oldValue.MaxStorage, nil
-}
-
-// AddMaxStorage adds i to the "max_storage" field.
-func (m *GroupMutation) AddMaxStorage(i int64) {
-	if m.addmax_storage != nil {
-		*m.addmax_storage += i
-	} else {
-		m.addmax_storage = &i
-	}
-}
-
-// AddedMaxStorage returns the value that was added to the "max_storage" field in this mutation.
-func (m *GroupMutation) AddedMaxStorage() (r int64, exists bool) {
-	v := m.addmax_storage
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldServer: %w", err)
 	}
-	return *v, true
+	return oldValue.Server, nil
 }
 
-// ClearMaxStorage clears the value of the "max_storage" field.
-func (m *GroupMutation) ClearMaxStorage() {
-	m.max_storage = nil
-	m.addmax_storage = nil
-	m.clearedFields[group.FieldMaxStorage] = struct{}{}
+// ClearServer clears the value of the "server" field.
+func (m *NodeMutation) ClearServer() {
+	m.server = nil
+	m.clearedFields[node.FieldServer] = struct{}{}
 }
 
-// MaxStorageCleared returns if the "max_storage" field was cleared in this mutation.
-func (m *GroupMutation) MaxStorageCleared() bool {
-	_, ok := m.clearedFields[group.FieldMaxStorage]
+// ServerCleared returns if the "server" field was cleared in this mutation.
+func (m *NodeMutation) ServerCleared() bool {
+	_, ok := m.clearedFields[node.FieldServer]
 	return ok
 }
 
-// ResetMaxStorage resets all changes to the "max_storage" field.
-func (m *GroupMutation) ResetMaxStorage() {
-	m.max_storage = nil
-	m.addmax_storage = nil
-	delete(m.clearedFields, group.FieldMaxStorage)
+// ResetServer resets all changes to the "server" field.
+func (m *NodeMutation) ResetServer() {
+	m.server = nil
+	delete(m.clearedFields, node.FieldServer)
 }
 
-// SetSpeedLimit sets the "speed_limit" field.
-func (m *GroupMutation) SetSpeedLimit(i int) {
-	m.speed_limit = &i
-	m.addspeed_limit = nil
+// SetSlaveKey sets the "slave_key" field.
+func (m *NodeMutation) SetSlaveKey(s string) {
+	m.slave_key = &s
 }
 
-// SpeedLimit returns the value of the "speed_limit" field in the mutation.
-func (m *GroupMutation) SpeedLimit() (r int, exists bool) {
-	v := m.speed_limit
+// SlaveKey returns the value of the "slave_key" field in the mutation.
+func (m *NodeMutation) SlaveKey() (r string, exists bool) {
+	v := m.slave_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSpeedLimit returns the old "speed_limit" field's value of the Group entity.
-// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// OldSlaveKey returns the old "slave_key" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMutation) OldSpeedLimit(ctx context.Context) (v int, err error) {
+func (m *NodeMutation) OldSlaveKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSpeedLimit is only allowed on UpdateOne operations")
+		return v, errors.New("OldSlaveKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSpeedLimit requires an ID field in the mutation")
+		return v, errors.New("OldSlaveKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSpeedLimit: %w", err)
-	}
-	return oldValue.SpeedLimit, nil
-}
-
-// AddSpeedLimit adds i to the "speed_limit" field.
-func (m *GroupMutation) AddSpeedLimit(i int) {
-	if m.addspeed_limit != nil {
-		*m.addspeed_limit += i
-	} else {
-		m.addspeed_limit = &i
-	}
-}
-
-// AddedSpeedLimit returns the value that was added to the "speed_limit" field in this mutation.
-func (m *GroupMutation) AddedSpeedLimit() (r int, exists bool) {
-	v := m.addspeed_limit
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldSlaveKey: %w", err)
 	}
-	return *v, true
+	return oldValue.SlaveKey, nil
 }
 
-// ClearSpeedLimit clears the value of the "speed_limit" field.
-func (m *GroupMutation) ClearSpeedLimit() {
-	m.speed_limit = nil
-	m.addspeed_limit = nil
-	m.clearedFields[group.FieldSpeedLimit] = struct{}{}
+// ClearSlaveKey clears the value of the "slave_key" field.
+func (m *NodeMutation) ClearSlaveKey() {
+	m.slave_key = nil
+	m.clearedFields[node.FieldSlaveKey] = struct{}{}
 }
 
-// SpeedLimitCleared returns if the "speed_limit" field was cleared in this mutation.
-func (m *GroupMutation) SpeedLimitCleared() bool {
-	_, ok := m.clearedFields[group.FieldSpeedLimit]
+// SlaveKeyCleared returns if the "slave_key" field was cleared in this mutation.
+func (m *NodeMutation) SlaveKeyCleared() bool {
+	_, ok := m.clearedFields[node.FieldSlaveKey]
 	return ok
 }
 
-// ResetSpeedLimit resets all changes to the "speed_limit" field.
-func (m *GroupMutation) ResetSpeedLimit() {
-	m.speed_limit = nil
-	m.addspeed_limit = nil
-	delete(m.clearedFields, group.FieldSpeedLimit)
+// ResetSlaveKey resets all changes to the "slave_key" field.
+func (m *NodeMutation) ResetSlaveKey() {
+	m.slave_key = nil
+	delete(m.clearedFields, node.FieldSlaveKey)
 }
 
-// SetPermissions sets the "permissions" field.
-func (m *GroupMutation) SetPermissions(bs *boolset.BooleanSet) {
-	m.permissions = &bs
+// SetCapabilities sets the "capabilities" field.
+func (m *NodeMutation) SetCapabilities(bs *boolset.BooleanSet) {
+	m.capabilities = &bs
 }
 
-// Permissions returns the value of the "permissions" field in the mutation.
-func (m *GroupMutation) Permissions() (r *boolset.BooleanSet, exists bool) {
-	v := m.permissions
+// Capabilities returns the value of the "capabilities" field in the mutation.
+func (m *NodeMutation) Capabilities() (r *boolset.BooleanSet, exists bool) {
+	v := m.capabilities
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPermissions returns the old "permissions" field's value of the Group entity.
-// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// OldCapabilities returns the old "capabilities" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMutation) OldPermissions(ctx context.Context) (v *boolset.BooleanSet, err error) {
+func (m *NodeMutation) OldCapabilities(ctx context.Context) (v *boolset.BooleanSet, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPermissions is only allowed on UpdateOne operations")
+		return v, errors.New("OldCapabilities is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPermissions requires an ID field in the mutation")
+		return v, errors.New("OldCapabilities requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPermissions: %w", err)
+		return v, fmt.Errorf("querying old value for OldCapabilities: %w", err)
 	}
-	return oldValue.Permissions, nil
+	return oldValue.Capabilities, nil
 }
 
-// ResetPermissions resets all changes to the "permissions" field.
-func (m *GroupMutation) ResetPermissions() {
-	m.permissions = nil
+// ResetCapabilities resets all changes to the "capabilities" field.
+func (m *NodeMutation) ResetCapabilities() {
+	m.capabilities = nil
 }
 
 // SetSettings sets the "settings" field.
-func (m *GroupMutation) SetSettings(ts *types.GroupSetting) {
+func (m *NodeMutation) SetSettings(ts *types.NodeSetting) {
 	m.settings = &ts
 }
 
 // Settings returns the value of the "settings" field in the mutation.
-func (m *GroupMutation) Settings() (r *types.GroupSetting, exists bool) {
+func (m *NodeMutation) Settings() (r *types.NodeSetting, exists bool) {
 	v := m.settings
 	if v == nil {
 		return
@@ -5782,10 +11454,10 @@ func (m *GroupMutation) Settings() (r *types.GroupSetting, exists bool) {
 	return *v, true
 }
 
-// OldSettings returns the old "settings" field's value of the Group entity.
-// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// OldSettings returns the old "settings" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMutation) OldSettings(ctx context.Context) (v *types.GroupSetting, err error) {
+func (m *NodeMutation) OldSettings(ctx context.Context) (v *types.NodeSetting, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldSettings is only allowed on UpdateOne operations")
 	}
@@ -5800,175 +11472,142 @@ func (m *GroupMutation) OldSettings(ctx context.Context) (v *types.GroupSetting,
 }
 
 // ClearSettings clears the value of the "settings" field.
-func (m *GroupMutation) ClearSettings() {
+func (m *NodeMutation) ClearSettings() {
 	m.settings = nil
-	m.clearedFields[group.FieldSettings] = struct{}{}
+	m.clearedFields[node.FieldSettings] = struct{}{}
 }
 
 // SettingsCleared returns if the "settings" field was cleared in this mutation.
-func (m *GroupMutation) SettingsCleared() bool {
-	_, ok := m.clearedFields[group.FieldSettings]
+func (m *NodeMutation) SettingsCleared() bool {
+	_, ok := m.clearedFields[node.FieldSettings]
 	return ok
 }
 
 // ResetSettings resets all changes to the "settings" field.
-func (m *GroupMutation) ResetSettings() {
+func (m *NodeMutation) ResetSettings() {
 	m.settings = nil
-	delete(m.clearedFields, group.FieldSettings)
+	delete(m.clearedFields, node.FieldSettings)
 }
 
-// SetStoragePolicyID sets the "storage_policy_id" field.
-func (m *GroupMutation) SetStoragePolicyID(i int) {
-	m.storage_policies = &i
+// SetWeight sets the "weight" field.
+func (m *NodeMutation) SetWeight(i int) {
+	m.weight = &i
+	m.addweight = nil
 }
 
-// StoragePolicyID returns the value of the "storage_policy_id" field in the mutation.
-func (m *GroupMutation) StoragePolicyID() (r int, exists bool) {
-	v := m.storage_policies
+// Weight returns the value of the "weight" field in the mutation.
+func (m *NodeMutation) Weight() (r int, exists bool) {
+	v := m.weight
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStoragePolicyID returns the old "storage_policy_id" field's value of the Group entity.
-// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// OldWeight returns the old "weight" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMutation) OldStoragePolicyID(ctx context.Context) (v int, err error) {
+func (m *NodeMutation) OldWeight(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStoragePolicyID is only allowed on UpdateOne operations")
+		return v, errors.New("OldWeight is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStoragePolicyID requires an ID field in the mutation")
+		return v, errors.New("OldWeight requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStoragePolicyID: %w", err)
-	}
-	return oldValue.StoragePolicyID, nil
-}
-
-// ClearStoragePolicyID clears the value of the "storage_policy_id" field.
-func (m *GroupMutation) ClearStoragePolicyID() {
-	m.storage_policies = nil
-	m.clearedFields[group.FieldStoragePolicyID] = struct{}{}
-}
-
-// StoragePolicyIDCleared returns if the "storage_policy_id" field was cleared in this mutation.
-func (m *GroupMutation) StoragePolicyIDCleared() bool {
-	_, ok := m.clearedFields[group.FieldStoragePolicyID]
-	return ok
-}
-
-// ResetStoragePolicyID resets all changes to the "storage_policy_id" field.
-func (m *GroupMutation) ResetStoragePolicyID() {
-	m.storage_policies = nil
-	delete(m.clearedFields, group.FieldStoragePolicyID)
-}
-
-// AddUserIDs adds the "users" edge to the User entity by ids.
-func (m *GroupMutation) AddUserIDs(ids ...int) {
-	if m.users == nil {
-		m.users = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.users[ids[i]] = struct{}{}
-	}
-}
-
-// ClearUsers clears the "users" edge to the User entity.
-func (m *GroupMutation) ClearUsers() {
-	m.clearedusers = true
-}
-
-// UsersCleared reports if the "users" edge to the User entity was cleared.
-func (m *GroupMutation) UsersCleared() bool {
-	return m.clearedusers
-}
-
-// RemoveUserIDs removes the "users" edge to the User entity by IDs.
-func (m *GroupMutation) RemoveUserIDs(ids ...int) {
-	if m.removedusers == nil {
-		m.removedusers = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.users, ids[i])
-		m.removedusers[ids[i]] = struct{}{}
+		return v, fmt.Errorf("querying old value for OldWeight: %w", err)
 	}
+	return oldValue.Weight, nil
 }
 
-// RemovedUsers returns the removed IDs of the "users" edge to the User entity.
-func (m *GroupMutation) RemovedUsersIDs() (ids []int) {
-	for id := range m.removedusers {
-		ids = append(ids, id)
+// AddWeight adds i to the "weight" field.
+func (m *NodeMutation) AddWeight(i int) {
+	if m.addweight != nil {
+		*m.addweight += i
+	} else {
+		m.addweight = &i
 	}
-	return
 }
 
-// UsersIDs returns the "users" edge IDs in the mutation.
-func (m *GroupMutation) UsersIDs() (ids []int) {
-	for id := range m.users {
-		ids = append(ids, id)
+// AddedWeight returns the value that was added to the "weight" field in this mutation.
+func (m *NodeMutation) AddedWeight() (r int, exists bool) {
+	v := m.addweight
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetUsers resets all changes to the "users" edge.
-func (m *GroupMutation) ResetUsers() {
-	m.users = nil
-	m.clearedusers = false
-	m.removedusers = nil
+// ResetWeight resets all changes to the "weight" field.
+func (m *NodeMutation) ResetWeight() {
+	m.weight = nil
+	m.addweight = nil
 }
 
-// SetStoragePoliciesID sets the "storage_policies" edge to the StoragePolicy entity by id.
-func (m *GroupMutation) SetStoragePoliciesID(id int) {
-	m.storage_policies = &id
+// AddStoragePolicyIDs adds the "storage_policy" edge to the StoragePolicy entity by ids.
+func (m *NodeMutation) AddStoragePolicyIDs(ids ...int) {
+	if m.storage_policy == nil {
+		m.storage_policy = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.storage_policy[ids[i]] = struct{}{}
+	}
 }
 
-// ClearStoragePolicies clears the "storage_policies" edge to the StoragePolicy entity.
-func (m *GroupMutation) ClearStoragePolicies() {
-	m.clearedstorage_policies = true
-	m.clearedFields[group.FieldStoragePolicyID] = struct{}{}
+// ClearStoragePolicy clears the "storage_policy" edge to the StoragePolicy entity.
+func (m *NodeMutation) ClearStoragePolicy() {
+	m.clearedstorage_policy = true
 }
 
-// StoragePoliciesCleared reports if the "storage_policies" edge to the StoragePolicy entity was cleared.
-func (m *GroupMutation) StoragePoliciesCleared() bool {
-	return m.StoragePolicyIDCleared() || m.clearedstorage_policies
+// StoragePolicyCleared reports if the "storage_policy" edge to the StoragePolicy entity was cleared.
+func (m *NodeMutation) StoragePolicyCleared() bool {
+	return m.clearedstorage_policy
 }
 
-// StoragePoliciesID returns the "storage_policies" edge ID in the mutation.
-func (m *GroupMutation) StoragePoliciesID() (id int, exists bool) {
-	if m.storage_policies != nil {
-		return *m.storage_policies, true
+// RemoveStoragePolicyIDs removes the "storage_policy" edge to the StoragePolicy entity by IDs.
+func (m *NodeMutation) RemoveStoragePolicyIDs(ids ...int) {
+	if m.removedstorage_policy == nil {
+		m.removedstorage_policy = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.storage_policy, ids[i])
+		m.removedstorage_policy[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedStoragePolicy returns the removed IDs of the "storage_policy" edge to the StoragePolicy entity.
+func (m *NodeMutation) RemovedStoragePolicyIDs() (ids []int) {
+	for id := range m.removedstorage_policy {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// StoragePoliciesIDs returns the "storage_policies" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// StoragePoliciesID instead. It exists only for internal usage by the builders.
-func (m *GroupMutation) StoragePoliciesIDs() (ids []int) {
-	if id := m.storage_policies; id != nil {
-		ids = append(ids, *id)
+// StoragePolicyIDs returns the "storage_policy" edge IDs in the mutation.
+func (m *NodeMutation) StoragePolicyIDs() (ids []int) {
+	for id := range m.storage_policy {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetStoragePolicies resets all changes to the "storage_policies" edge.
-func (m *GroupMutation) ResetStoragePolicies() {
-	m.storage_policies = nil
-	m.clearedstorage_policies = false
+// ResetStoragePolicy resets all changes to the "storage_policy" edge.
+func (m *NodeMutation) ResetStoragePolicy() {
+	m.storage_policy = nil
+	m.clearedstorage_policy = false
+	m.removedstorage_policy = nil
 }
 
-// Where appends a list predicates to the GroupMutation builder.
-func (m *GroupMutation) Where(ps ...predicate.Group) {
+// Where appends a list predicates to the NodeMutation builder.
+func (m *NodeMutation) Where(ps ...predicate.Node) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the GroupMutation builder. Using this method,
+// WhereP appends storage-level predicates to the NodeMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *GroupMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Group, len(ps))
+func (m *NodeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Node, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5976,51 +11615,57 @@ func (m *GroupMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *GroupMutation) Op() Op {
+func (m *NodeMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *GroupMutation) SetOp(op Op) {
+func (m *NodeMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Group).
-func (m *GroupMutation) Type() string {
+// Type returns the node type of this mutation (Node).
+func (m *NodeMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *GroupMutation) Fields() []string {
-	fields := make([]string, 0, 9)
+func (m *NodeMutation) Fields() []string {
+	fields := make([]string, 0, 11)
 	if m.created_at != nil {
-		fields = append(fields, group.FieldCreatedAt)
+		fields = append(fields, node.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, group.FieldUpdatedAt)
+		fields = append(fields, node.FieldUpdatedAt)
 	}
 	if m.deleted_at != nil {
-		fields = append(fields, group.FieldDeletedAt)
+		fields = append(fields, node.FieldDeletedAt)
+	}
+	if m.status != nil {
+		fields = append(fields, node.FieldStatus)
 	}
 	if m.name != nil {
-		fields = append(fields, group.FieldName)
+		fields = append(fields, node.FieldName)
 	}
-	if m.max_storage != nil {
-		fields = append(fields, group.FieldMaxStorage)
+	if m._type != nil {
+		fields = append(fields, node.FieldType)
 	}
-	if m.speed_limit != nil {
-		fields = append(fields, group.FieldSpeedLimit)
+	if m.server != nil {
+		fields = append(fields, node.FieldServer)
 	}
-	if m.permissions != nil {
-		fields = append(fields, group.FieldPermissions)
+	if m.slave_key != nil {
+		fields = append(fields, node.FieldSlaveKey)
+	}
+	if m.capabilities != nil {
+		fields = append(fields, node.FieldCapabilities)
 	}
 	if m.settings != nil {
-		fields = append(fields, group.FieldSettings)
+		fields = append(fields, node.FieldSettings)
 	}
-	if m.storage_policies != nil {
-		fields = append(fields, group.FieldStoragePolicyID)
+	if m.weight != nil {
+		fields = append(fields, node.FieldWeight)
 	}
 	return fields
 }
@@ -6028,26 +11673,30 @@ func (m *GroupMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *GroupMutation) Field(name string) (ent.Value, bool) {
+func (m *NodeMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case group.FieldCreatedAt:
+	case node.FieldCreatedAt:
 		return m.CreatedAt()
-	case group.FieldUpdatedAt:
+	case node.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case group.FieldDeletedAt:
+	case node.FieldDeletedAt:
 		return m.DeletedAt()
-	case group.FieldName:
+	case node.FieldStatus:
+		return m.Status()
+	case node.FieldName:
 		return m.Name()
-	case group.FieldMaxStorage:
-		return m.MaxStorage()
-	case group.FieldSpeedLimit:
-		return m.SpeedLimit()
-	case group.FieldPermissions:
-		return m.Permissions()
-	case group.FieldSettings:
+	case node.FieldType:
+		return m.GetType()
+	case node.FieldServer:
+		return m.Server()
+	case node.FieldSlaveKey:
+		return m.SlaveKey()
+	case node.FieldCapabilities:
+		return m.Capabilities()
+	case node.FieldSettings:
 		return m.Settings()
-	case group.FieldStoragePolicyID:
-		return m.StoragePolicyID()
+	case node.FieldWeight:
+		return m.Weight()
 	}
 	return nil, false
 }
@@ -6055,111 +11704,126 @@ func (m *GroupMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *GroupMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *NodeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case group.FieldCreatedAt:
+	case node.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case group.FieldUpdatedAt:
+	case node.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case group.FieldDeletedAt:
+	case node.FieldDeletedAt:
 		return m.OldDeletedAt(ctx)
-	case group.FieldName:
+	case node.FieldStatus:
+		return m.OldStatus(ctx)
+	case node.FieldName:
 		return m.OldName(ctx)
-	case group.FieldMaxStorage:
-		return m.OldMaxStorage(ctx)
-	case group.FieldSpeedLimit:
-		return m.OldSpeedLimit(ctx)
-	case group.FieldPermissions:
-		return m.OldPermissions(ctx)
-	case group.FieldSettings:
+	case node.FieldType:
+		return m.OldType(ctx)
+	case node.FieldServer:
+		return m.OldServer(ctx)
+	case node.FieldSlaveKey:
+		return m.OldSlaveKey(ctx)
+	case node.FieldCapabilities:
+		return m.OldCapabilities(ctx)
+	case node.FieldSettings:
 		return m.OldSettings(ctx)
-	case group.FieldStoragePolicyID:
-		return m.OldStoragePolicyID(ctx)
+	case node.FieldWeight:
+		return m.OldWeight(ctx)
 	}
-	return nil, fmt.Errorf("unknown Group field %s", name)
+	return nil, fmt.Errorf("unknown Node field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GroupMutation) SetField(name string, value ent.Value) error {
+func (m *NodeMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case group.FieldCreatedAt:
+	case node.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case group.FieldUpdatedAt:
+	case node.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case group.FieldDeletedAt:
+	case node.FieldDeletedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDeletedAt(v)
 		return nil
-	case group.FieldName:
+	case node.FieldStatus:
+		v, ok := value.(node.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case node.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case group.FieldMaxStorage:
-		v, ok := value.(int64)
+	case node.FieldType:
+		v, ok := value.(node.Type)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMaxStorage(v)
+		m.SetType(v)
 		return nil
-	case group.FieldSpeedLimit:
-		v, ok := value.(int)
+	case node.FieldServer:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSpeedLimit(v)
+		m.SetServer(v)
 		return nil
-	case group.FieldPermissions:
+	case node.FieldSlaveKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSlaveKey(v)
+		return nil
+	case node.FieldCapabilities:
 		v, ok := value.(*boolset.BooleanSet)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPermissions(v)
+		m.SetCapabilities(v)
 		return nil
-	case group.FieldSettings:
-		v, ok := value.(*types.GroupSetting)
+	case node.FieldSettings:
+		v, ok := value.(*types.NodeSetting)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetSettings(v)
 		return nil
-	case group.FieldStoragePolicyID:
+	case node.FieldWeight:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStoragePolicyID(v)
+		m.SetWeight(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Group field %s", name)
+	return fmt.Errorf("unknown Node field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *GroupMutation) AddedFields() []string {
+func (m *NodeMutation) AddedFields() []string {
 	var fields []string
-	if m.addmax_storage != nil {
-		fields = append(fields, group.FieldMaxStorage)
-	}
-	if m.addspeed_limit != nil {
-		fields = append(fields, group.FieldSpeedLimit)
+	if m.addweight != nil {
+		fields = append(fields, node.FieldWeight)
 	}
 	return fields
 }
@@ -6167,12 +11831,10 @@ func (m *GroupMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *GroupMutation) AddedField(name string) (ent.Value, bool) {
+func (m *NodeMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case group.FieldMaxStorage:
-		return m.AddedMaxStorage()
-	case group.FieldSpeedLimit:
-		return m.AddedSpeedLimit()
+	case node.FieldWeight:
+		return m.AddedWeight()
 	}
 	return nil, false
 }
@@ -6180,159 +11842,145 @@ func (m *GroupMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GroupMutation) AddField(name string, value ent.Value) error {
+func (m *NodeMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case group.FieldMaxStorage:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddMaxStorage(v)
-		return nil
-	case group.FieldSpeedLimit:
+	case node.FieldWeight:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddSpeedLimit(v)
+		m.AddWeight(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Group numeric field %s", name)
+	return fmt.Errorf("unknown Node numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *GroupMutation) ClearedFields() []string {
+func (m *NodeMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(group.FieldDeletedAt) {
-		fields = append(fields, group.FieldDeletedAt)
-	}
-	if m.FieldCleared(group.FieldMaxStorage) {
-		fields = append(fields, group.FieldMaxStorage)
+	if m.FieldCleared(node.FieldDeletedAt) {
+		fields = append(fields, node.FieldDeletedAt)
 	}
-	if m.FieldCleared(group.FieldSpeedLimit) {
-		fields = append(fields, group.FieldSpeedLimit)
+	if m.FieldCleared(node.FieldServer) {
+		fields = append(fields, node.FieldServer)
 	}
-	if m.FieldCleared(group.FieldSettings) {
-		fields = append(fields, group.FieldSettings)
+	if m.FieldCleared(node.FieldSlaveKey) {
+		fields = append(fields, node.FieldSlaveKey)
 	}
-	if m.FieldCleared(group.FieldStoragePolicyID) {
-		fields = append(fields, group.FieldStoragePolicyID)
+	if m.FieldCleared(node.FieldSettings) {
+		fields = append(fields, node.FieldSettings)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *GroupMutation) FieldCleared(name string) bool {
+func (m *NodeMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *GroupMutation) ClearField(name string) error {
+func (m *NodeMutation) ClearField(name string) error {
 	switch name {
-	case group.FieldDeletedAt:
+	case node.FieldDeletedAt:
 		m.ClearDeletedAt()
 		return nil
-	case group.FieldMaxStorage:
-		m.ClearMaxStorage()
+	case node.FieldServer:
+		m.ClearServer()
 		return nil
-	case group.FieldSpeedLimit:
-		m.ClearSpeedLimit()
+	case node.FieldSlaveKey:
+		m.ClearSlaveKey()
 		return nil
-	case group.FieldSettings:
+	case node.FieldSettings:
 		m.ClearSettings()
 		return nil
-	case group.FieldStoragePolicyID:
-		m.ClearStoragePolicyID()
-		return nil
 	}
-	return fmt.Errorf("unknown Group nullable field %s", name)
+	return fmt.Errorf("unknown Node nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *GroupMutation) ResetField(name string) error {
+func (m *NodeMutation) ResetField(name string) error {
 	switch name {
-	case group.FieldCreatedAt:
+	case node.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case group.FieldUpdatedAt:
+	case node.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case group.FieldDeletedAt:
+	case node.FieldDeletedAt:
 		m.ResetDeletedAt()
 		return nil
-	case group.FieldName:
+	case node.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case node.FieldName:
 		m.ResetName()
 		return nil
-	case group.FieldMaxStorage:
-		m.ResetMaxStorage()
+	case node.FieldType:
+		m.ResetType()
 		return nil
-	case group.FieldSpeedLimit:
-		m.ResetSpeedLimit()
+	case node.FieldServer:
+		m.ResetServer()
 		return nil
-	case group.FieldPermissions:
-		m.ResetPermissions()
+	case node.FieldSlaveKey:
+		m.ResetSlaveKey()
 		return nil
-	case group.FieldSettings:
+	case node.FieldCapabilities:
+		m.ResetCapabilities()
+		return nil
+	case node.FieldSettings:
 		m.ResetSettings()
 		return nil
-	case group.FieldStoragePolicyID:
-		m.ResetStoragePolicyID()
+	case node.FieldWeight:
+		m.ResetWeight()
 		return nil
 	}
-	return fmt.Errorf("unknown Group field %s", name)
+	return fmt.Errorf("unknown Node field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *GroupMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.users != nil {
-		edges = append(edges, group.EdgeUsers)
-	}
-	if m.storage_policies != nil {
-		edges = append(edges, group.EdgeStoragePolicies)
+func (m *NodeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.storage_policy != nil {
+		edges = append(edges, node.EdgeStoragePolicy)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *GroupMutation) AddedIDs(name string) []ent.Value {
+func (m *NodeMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case group.EdgeUsers:
-		ids := make([]ent.Value, 0, len(m.users))
-		for id := range m.users {
+	case node.EdgeStoragePolicy:
+		ids := make([]ent.Value, 0, len(m.storage_policy))
+		for id := range m.storage_policy {
 			ids = append(ids, id)
 		}
 		return ids
-	case group.EdgeStoragePolicies:
-		if id := m.storage_policies; id != nil {
-			return []ent.Value{*id}
-		}
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *GroupMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedusers != nil {
-		edges = append(edges, group.EdgeUsers)
+func (m *NodeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedstorage_policy != nil {
+		edges = append(edges, node.EdgeStoragePolicy)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *GroupMutation) RemovedIDs(name string) []ent.Value {
+func (m *NodeMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case group.EdgeUsers:
-		ids := make([]ent.Value, 0, len(m.removedusers))
-		for id := range m.removedusers {
+	case node.EdgeStoragePolicy:
+		ids := make([]ent.Value, 0, len(m.removedstorage_policy))
+		for id := range m.removedstorage_policy {
 			ids = append(ids, id)
 		}
 		return ids
@@ -6341,56 +11989,45 @@ func (m *GroupMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *GroupMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedusers {
-		edges = append(edges, group.EdgeUsers)
-	}
-	if m.clearedstorage_policies {
-		edges = append(edges, group.EdgeStoragePolicies)
+func (m *NodeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedstorage_policy {
+		edges = append(edges, node.EdgeStoragePolicy)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *GroupMutation) EdgeCleared(name string) bool {
+func (m *NodeMutation) EdgeCleared(name string) bool {
 	switch name {
-	case group.EdgeUsers:
-		return m.clearedusers
-	case group.EdgeStoragePolicies:
-		return m.clearedstorage_policies
+	case node.EdgeStoragePolicy:
+		return m.clearedstorage_policy
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *GroupMutation) ClearEdge(name string) error {
+func (m *NodeMutation) ClearEdge(name string) error {
 	switch name {
-	case group.EdgeStoragePolicies:
-		m.ClearStoragePolicies()
-		return nil
 	}
-	return fmt.Errorf("unknown Group unique edge %s", name)
+	return fmt.Errorf("unknown Node unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *GroupMutation) ResetEdge(name string) error {
+func (m *NodeMutation) ResetEdge(name string) error {
 	switch name {
-	case group.EdgeUsers:
-		m.ResetUsers()
-		return nil
-	case group.EdgeStoragePolicies:
-		m.ResetStoragePolicies()
+	case node.EdgeStoragePolicy:
+		m.ResetStoragePolicy()
 		return nil
 	}
-	return fmt.Errorf("unknown Group edge %s", name)
+	return fmt.Errorf("unknown Node edge %s", name)
 }
 
-// MetadataMutation represents an operation that mutates the Metadata nodes in the graph.
-type MetadataMutation struct {
+// PasskeyMutation represents an operation that mutates the Passkey nodes in the graph.
+type PasskeyMutation struct {
 	config
 	op            Op
 	typ           string
@@ -6398,28 +12035,29 @@ type MetadataMutation struct {
 	created_at    *time.Time
 	updated_at    *time.Time
 	deleted_at    *time.Time
+	credential_id *string
 	name          *string
-	value         *string
-	is_public     *bool
+	credential    **webauthn.Credential
+	used_at       *time.Time
 	clearedFields map[string]struct{}
-	file          *int
-	clearedfile   bool
+	user          *int
+	cleareduser   bool
 	done          bool
-	oldValue      func(context.Context) (*Metadata, error)
-	predicates    []predicate.Metadata
+	oldValue      func(context.Context) (*Passkey, error)
+	predicates    []predicate.Passkey
 }
 
-var _ ent.Mutation = (*MetadataMutation)(nil)
+var _ ent.Mutation = (*PasskeyMutation)(nil)
 
-// metadataOption allows management of the mutation configuration using functional options.
-type metadataOption func(*MetadataMutation)
+// passkeyOption allows management of the mutation configuration using functional options.
+type passkeyOption func(*PasskeyMutation)
 
-// newMetadataMutation creates new mutation for the Metadata entity.
-func newMetadataMutation(c config, op Op, opts ...metadataOption) *MetadataMutation {
-	m := &MetadataMutation{
+// newPasskeyMutation creates new mutation for the Passkey entity.
+func newPasskeyMutation(c config, op Op, opts ...passkeyOption) *PasskeyMutation {
+	m := &PasskeyMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMetadata,
+		typ:           TypePasskey,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -6428,20 +12066,20 @@ func newMetadataMutation(c config, op Op, opts ...metadataOption) *MetadataMutat
 	return m
 }
 
-// withMetadataID sets the ID field of the mutation.
-func withMetadataID(id int) metadataOption {
-	return func(m *MetadataMutation) {
+// withPasskeyID sets the ID field of the mutation.
+func withPasskeyID(id int) passkeyOption {
+	return func(m *PasskeyMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Metadata
+			value *Passkey
 		)
-		m.oldValue = func(ctx context.Context) (*Metadata, error) {
+		m.oldValue = func(ctx context.Context) (*Passkey, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Metadata.Get(ctx, id)
+					value, err = m.Client().Passkey.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -6450,10 +12088,10 @@ func withMetadataID(id int) metadataOption {
 	}
 }
 
-// withMetadata sets the old Metadata of the mutation.
-func withMetadata(node *Metadata) metadataOption {
-	return func(m *MetadataMutation) {
-		m.oldValue = func(context.Context) (*Metadata, error) {
+// withPasskey sets the old Passkey of the mutation.
+func withPasskey(node *Passkey) passkeyOption {
+	return func(m *PasskeyMutation) {
+		m.oldValue = func(context.Context) (*Passkey, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -6462,7 +12100,7 @@ func withMetadata(node *Metadata) metadataOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MetadataMutation) Client() *Client {
+func (m PasskeyMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -6470,7 +12108,7 @@ func (m MetadataMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MetadataMutation) Tx() (*Tx, error) {
+func (m PasskeyMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -6481,7 +12119,7 @@ func (m MetadataMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MetadataMutation) ID() (id int, exists bool) {
+func (m *PasskeyMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -6492,7 +12130,7 @@ func (m *MetadataMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MetadataMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *PasskeyMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -6501,19 +12139,19 @@ func (m *MetadataMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Metadata.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Passkey.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *MetadataMutation) SetCreatedAt(t time.Time) {
+func (m *PasskeyMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *MetadataMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *PasskeyMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -6521,10 +12159,10 @@ func (m *MetadataMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Metadata entity.
-// If the Metadata object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Passkey entity.
+// If the Passkey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MetadataMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *PasskeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -6539,17 +12177,17 @@ func (m *MetadataMutation) OldCreatedAt(ctx context.Context) (v time.Time, err e
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *MetadataMutation) ResetCreatedAt() {
+func (m *PasskeyMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *MetadataMutation) SetUpdatedAt(t time.Time) {
+func (m *PasskeyMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *MetadataMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *PasskeyMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -6557,10 +12195,10 @@ func (m *MetadataMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Metadata entity.
-// If the Metadata object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Passkey entity.
+// If the Passkey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MetadataMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *PasskeyMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -6575,17 +12213,17 @@ func (m *MetadataMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err e
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *MetadataMutation) ResetUpdatedAt() {
+func (m *PasskeyMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // SetDeletedAt sets the "deleted_at" field.
-func (m *MetadataMutation) SetDeletedAt(t time.Time) {
+func (m *PasskeyMutation) SetDeletedAt(t time.Time) {
 	m.deleted_at = &t
 }
 
 // DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *MetadataMutation) DeletedAt() (r time.Time, exists bool) {
+func (m *PasskeyMutation) DeletedAt() (r time.Time, exists bool) {
 	v := m.deleted_at
 	if v == nil {
 		return
@@ -6593,10 +12231,10 @@ func (m *MetadataMutation) DeletedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the Metadata entity.
-// If the Metadata object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedAt returns the old "deleted_at" field's value of the Passkey entity.
+// If the Passkey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MetadataMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *PasskeyMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
@@ -6611,203 +12249,252 @@ func (m *MetadataMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err
 }
 
 // ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *MetadataMutation) ClearDeletedAt() {
+func (m *PasskeyMutation) ClearDeletedAt() {
 	m.deleted_at = nil
-	m.clearedFields[metadata.FieldDeletedAt] = struct{}{}
+	m.clearedFields[passkey.FieldDeletedAt] = struct{}{}
 }
 
 // DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *MetadataMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[metadata.FieldDeletedAt]
+func (m *PasskeyMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[passkey.FieldDeletedAt]
 	return ok
 }
 
 // ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *MetadataMutation) ResetDeletedAt() {
+func (m *PasskeyMutation) ResetDeletedAt() {
 	m.deleted_at = nil
-	delete(m.clearedFields, metadata.FieldDeletedAt)
+	delete(m.clearedFields, passkey.FieldDeletedAt)
 }
 
-// SetName sets the "name" field.
-func (m *MetadataMutation) SetName(s string) {
-	m.name = &s
+// SetUserID sets the "user_id" field.
+func (m *PasskeyMutation) SetUserID(i int) {
+	m.user = &i
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *MetadataMutation) Name() (r string, exists bool) {
-	v := m.name
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *PasskeyMutation) UserID() (r int, exists bool) {
+	v := m.user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Metadata entity.
-// If the Metadata object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the Passkey entity.
+// If the Passkey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MetadataMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *PasskeyMutation) OldUserID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.UserID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *MetadataMutation) ResetName() {
-	m.name = nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *PasskeyMutation) ResetUserID() {
+	m.user = nil
 }
 
-// SetValue sets the "value" field.
-func (m *MetadataMutation) SetValue(s string) {
-	m.value = &s
+// SetCredentialID sets the "credential_id" field.
+func (m *PasskeyMutation) SetCredentialID(s string) {
+	m.credential_id = &s
 }
 
-// Value returns the value of the "value" field in the mutation.
-func (m *MetadataMutation) Value() (r string, exists bool) {
-	v := m.value
+// CredentialID returns the value of the "credential_id" field in the mutation.
+func (m *PasskeyMutation) CredentialID() (r string, exists bool) {
+	v := m.credential_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldValue returns the old "value" field's value of the Metadata entity.
-// If the Metadata object wasn't provided to the builder, the object is fetched from the database.
+// OldCredentialID returns the old "credential_id" field's value of the Passkey entity.
+// If the Passkey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MetadataMutation) OldValue(ctx context.Context) (v string, err error) {
+func (m *PasskeyMutation) OldCredentialID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+		return v, errors.New("OldCredentialID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldValue requires an ID field in the mutation")
+		return v, errors.New("OldCredentialID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+		return v, fmt.Errorf("querying old value for OldCredentialID: %w", err)
 	}
-	return oldValue.Value, nil
+	return oldValue.CredentialID, nil
 }
 
-// ResetValue resets all changes to the "value" field.
-func (m *MetadataMutation) ResetValue() {
-	m.value = nil
+// ResetCredentialID resets all changes to the "credential_id" field.
+func (m *PasskeyMutation) ResetCredentialID() {
+	m.credential_id = nil
 }
 
-// SetFileID sets the "file_id" field.
-func (m *MetadataMutation) SetFileID(i int) {
-	m.file = &i
+// SetName sets the "name" field.
+func (m *PasskeyMutation) SetName(s string) {
+	m.name = &s
 }
 
-// FileID returns the value of the "file_id" field in the mutation.
-func (m *MetadataMutation) FileID() (r int, exists bool) {
-	v := m.file
+// Name returns the value of the "name" field in the mutation.
+func (m *PasskeyMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFileID returns the old "file_id" field's value of the Metadata entity.
-// If the Metadata object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Passkey entity.
+// If the Passkey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MetadataMutation) OldFileID(ctx context.Context) (v int, err error) {
+func (m *PasskeyMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFileID is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFileID requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFileID: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.FileID, nil
+	return oldValue.Name, nil
 }
 
-// ResetFileID resets all changes to the "file_id" field.
-func (m *MetadataMutation) ResetFileID() {
-	m.file = nil
+// ResetName resets all changes to the "name" field.
+func (m *PasskeyMutation) ResetName() {
+	m.name = nil
 }
 
-// SetIsPublic sets the "is_public" field.
-func (m *MetadataMutation) SetIsPublic(b bool) {
-	m.is_public = &b
+// SetCredential sets the "credential" field.
+func (m *PasskeyMutation) SetCredential(w *webauthn.Credential) {
+	m.credential = &w
 }
 
-// IsPublic returns the value of the "is_public" field in the mutation.
-func (m *MetadataMutation) IsPublic() (r bool, exists bool) {
-	v := m.is_public
+// Credential returns the value of the "credential" field in the mutation.
+func (m *PasskeyMutation) Credential() (r *webauthn.Credential, exists bool) {
+	v := m.credential
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsPublic returns the old "is_public" field's value of the Metadata entity.
-// If the Metadata object wasn't provided to the builder, the object is fetched from the database.
+// OldCredential returns the old "credential" field's value of the Passkey entity.
+// If the Passkey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MetadataMutation) OldIsPublic(ctx context.Context) (v bool, err error) {
+func (m *PasskeyMutation) OldCredential(ctx context.Context) (v *webauthn.Credential, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsPublic is only allowed on UpdateOne operations")
+		return v, errors.New("OldCredential is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsPublic requires an ID field in the mutation")
+		return v, errors.New("OldCredential requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsPublic: %w", err)
+		return v, fmt.Errorf("querying old value for OldCredential: %w", err)
 	}
-	return oldValue.IsPublic, nil
+	return oldValue.Credential, nil
 }
 
-// ResetIsPublic resets all changes to the "is_public" field.
-func (m *MetadataMutation) ResetIsPublic() {
-	m.is_public = nil
+// ResetCredential resets all changes to the "credential" field.
+func (m *PasskeyMutation) ResetCredential() {
+	m.credential = nil
 }
 
-// ClearFile clears the "file" edge to the File entity.
-func (m *MetadataMutation) ClearFile() {
-	m.clearedfile = true
-	m.clearedFields[metadata.FieldFileID] = struct{}{}
+// SetUsedAt sets the "used_at" field.
+func (m *PasskeyMutation) SetUsedAt(t time.Time) {
+	m.used_at = &t
 }
 
-// FileCleared reports if the "file" edge to the File entity was cleared.
-func (m *MetadataMutation) FileCleared() bool {
-	return m.clearedfile
+// UsedAt returns the value of the "used_at" field in the mutation.
+func (m *PasskeyMutation) UsedAt() (r time.Time, exists bool) {
+	v := m.used_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// FileIDs returns the "file" edge IDs in the mutation.
+// OldUsedAt returns the old "used_at" field's value of the Passkey entity.
+// If the Passkey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasskeyMutation) OldUsedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsedAt: %w", err)
+	}
+	return oldValue.UsedAt, nil
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (m *PasskeyMutation) ClearUsedAt() {
+	m.used_at = nil
+	m.clearedFields[passkey.FieldUsedAt] = struct{}{}
+}
+
+// UsedAtCleared returns if the "used_at" field was cleared in this mutation.
+func (m *PasskeyMutation) UsedAtCleared() bool {
+	_, ok := m.clearedFields[passkey.FieldUsedAt]
+	return ok
+}
+
+// ResetUsedAt resets all changes to the "used_at" field.
+func (m *PasskeyMutation) ResetUsedAt() {
+	m.used_at = nil
+	delete(m.clearedFields, passkey.FieldUsedAt)
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *PasskeyMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[passkey.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *PasskeyMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// FileID instead. It exists only for internal usage by the builders.
-func (m *MetadataMutation) FileIDs() (ids []int) {
-	if id := m.file; id != nil {
+// UserID instead. It exists only for internal usage by the builders.
+func (m *PasskeyMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetFile resets all changes to the "file" edge.
-func (m *MetadataMutation) ResetFile() {
-	m.file = nil
-	m.clearedfile = false
+// ResetUser resets all changes to the "user" edge.
+func (m *PasskeyMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// Where appends a list predicates to the MetadataMutation builder.
-func (m *MetadataMutation) Where(ps ...predicate.Metadata) {
+// Where appends a list predicates to the PasskeyMutation builder.
+func (m *PasskeyMutation) Where(ps ...predicate.Passkey) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MetadataMutation builder. Using this method,
+// WhereP appends storage-level predicates to the PasskeyMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MetadataMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Metadata, len(ps))
+func (m *PasskeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Passkey, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -6815,45 +12502,48 @@ func (m *MetadataMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MetadataMutation) Op() Op {
+func (m *PasskeyMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MetadataMutation) SetOp(op Op) {
+func (m *PasskeyMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Metadata).
-func (m *MetadataMutation) Type() string {
+// Type returns the node type of this mutation (Passkey).
+func (m *PasskeyMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MetadataMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+func (m *PasskeyMutation) Fields() []string {
+	fields := make([]string, 0, 8)
 	if m.created_at != nil {
-		fields = append(fields, metadata.FieldCreatedAt)
+		fields = append(fields, passkey.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, metadata.FieldUpdatedAt)
+		fields = append(fields, passkey.FieldUpdatedAt)
 	}
 	if m.deleted_at != nil {
-		fields = append(fields, metadata.FieldDeletedAt)
+		fields = append(fields, passkey.FieldDeletedAt)
 	}
-	if m.name != nil {
-		fields = append(fields, metadata.FieldName)
+	if m.user != nil {
+		fields = append(fields, passkey.FieldUserID)
 	}
-	if m.value != nil {
-		fields = append(fields, metadata.FieldValue)
+	if m.credential_id != nil {
+		fields = append(fields, passkey.FieldCredentialID)
 	}
-	if m.file != nil {
-		fields = append(fields, metadata.FieldFileID)
+	if m.name != nil {
+		fields = append(fields, passkey.FieldName)
 	}
-	if m.is_public != nil {
-		fields = append(fields, metadata.FieldIsPublic)
+	if m.credential != nil {
+		fields = append(fields, passkey.FieldCredential)
+	}
+	if m.used_at != nil {
+		fields = append(fields, passkey.FieldUsedAt)
 	}
 	return fields
 }
@@ -6861,22 +12551,24 @@ func (m *MetadataMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MetadataMutation) Field(name string) (ent.Value, bool) {
+func (m *PasskeyMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case metadata.FieldCreatedAt:
+	case passkey.FieldCreatedAt:
 		return m.CreatedAt()
-	case metadata.FieldUpdatedAt:
+	case passkey.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case metadata.FieldDeletedAt:
+	case passkey.FieldDeletedAt:
 		return m.DeletedAt()
-	case metadata.FieldName:
+	case passkey.FieldUserID:
+		return m.UserID()
+	case passkey.FieldCredentialID:
+		return m.CredentialID()
+	case passkey.FieldName:
 		return m.Name()
-	case metadata.FieldValue:
-		return m.Value()
-	case metadata.FieldFileID:
-		return m.FileID()
-	case metadata.FieldIsPublic:
-		return m.IsPublic()
+	case passkey.FieldCredential:
+		return m.Credential()
+	case passkey.FieldUsedAt:
+		return m.UsedAt()
 	}
 	return nil, false
 }
@@ -6884,87 +12576,96 @@ func (m *MetadataMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MetadataMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *PasskeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case metadata.FieldCreatedAt:
+	case passkey.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case metadata.FieldUpdatedAt:
+	case passkey.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case metadata.FieldDeletedAt:
+	case passkey.FieldDeletedAt:
 		return m.OldDeletedAt(ctx)
-	case metadata.FieldName:
+	case passkey.FieldUserID:
+		return m.OldUserID(ctx)
+	case passkey.FieldCredentialID:
+		return m.OldCredentialID(ctx)
+	case passkey.FieldName:
 		return m.OldName(ctx)
-	case metadata.FieldValue:
-		return m.OldValue(ctx)
-	case metadata.FieldFileID:
-		return m.OldFileID(ctx)
-	case metadata.FieldIsPublic:
-		return m.OldIsPublic(ctx)
+	case passkey.FieldCredential:
+		return m.OldCredential(ctx)
+	case passkey.FieldUsedAt:
+		return m.OldUsedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Metadata field %s", name)
+	return nil, fmt.Errorf("unknown Passkey field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MetadataMutation) SetField(name string, value ent.Value) error {
+func (m *PasskeyMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case metadata.FieldCreatedAt:
+	case passkey.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case metadata.FieldUpdatedAt:
+	case passkey.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case metadata.FieldDeletedAt:
+	case passkey.FieldDeletedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDeletedAt(v)
 		return nil
-	case metadata.FieldName:
+	case passkey.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case passkey.FieldCredentialID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetCredentialID(v)
 		return nil
-	case metadata.FieldValue:
+	case passkey.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetValue(v)
+		m.SetName(v)
 		return nil
-	case metadata.FieldFileID:
-		v, ok := value.(int)
+	case passkey.FieldCredential:
+		v, ok := value.(*webauthn.Credential)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFileID(v)
+		m.SetCredential(v)
 		return nil
-	case metadata.FieldIsPublic:
-		v, ok := value.(bool)
+	case passkey.FieldUsedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsPublic(v)
+		m.SetUsedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Metadata field %s", name)
+	return fmt.Errorf("unknown Passkey field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MetadataMutation) AddedFields() []string {
+func (m *PasskeyMutation) AddedFields() []string {
 	var fields []string
 	return fields
 }
@@ -6972,7 +12673,7 @@ func (m *MetadataMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MetadataMutation) AddedField(name string) (ent.Value, bool) {
+func (m *PasskeyMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
 	}
 	return nil, false
@@ -6981,84 +12682,93 @@ func (m *MetadataMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MetadataMutation) AddField(name string, value ent.Value) error {
+func (m *PasskeyMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Metadata numeric field %s", name)
+	return fmt.Errorf("unknown Passkey numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MetadataMutation) ClearedFields() []string {
+func (m *PasskeyMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(metadata.FieldDeletedAt) {
-		fields = append(fields, metadata.FieldDeletedAt)
+	if m.FieldCleared(passkey.FieldDeletedAt) {
+		fields = append(fields, passkey.FieldDeletedAt)
+	}
+	if m.FieldCleared(passkey.FieldUsedAt) {
+		fields = append(fields, passkey.FieldUsedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MetadataMutation) FieldCleared(name string) bool {
+func (m *PasskeyMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MetadataMutation) ClearField(name string) error {
+func (m *PasskeyMutation) ClearField(name string) error {
 	switch name {
-	case metadata.FieldDeletedAt:
+	case passkey.FieldDeletedAt:
 		m.ClearDeletedAt()
 		return nil
+	case passkey.FieldUsedAt:
+		m.ClearUsedAt()
+		return nil
 	}
-	return fmt.Errorf("unknown Metadata nullable field %s", name)
+	return fmt.Errorf("unknown Passkey nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MetadataMutation) ResetField(name string) error {
+func (m *PasskeyMutation) ResetField(name string) error {
 	switch name {
-	case metadata.FieldCreatedAt:
+	case passkey.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case metadata.FieldUpdatedAt:
+	case passkey.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case metadata.FieldDeletedAt:
+	case passkey.FieldDeletedAt:
 		m.ResetDeletedAt()
 		return nil
-	case metadata.FieldName:
-		m.ResetName()
+	case passkey.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case metadata.FieldValue:
-		m.ResetValue()
+	case passkey.FieldCredentialID:
+		m.ResetCredentialID()
 		return nil
-	case metadata.FieldFileID:
-		m.ResetFileID()
+	case passkey.FieldName:
+		m.ResetName()
 		return nil
-	case metadata.FieldIsPublic:
-		m.ResetIsPublic()
+	case passkey.FieldCredential:
+		m.ResetCredential()
+		return nil
+	case passkey.FieldUsedAt:
+		m.ResetUsedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Metadata field %s", name)
+	return fmt.Errorf("unknown Passkey field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MetadataMutation) AddedEdges() []string {
+func (m *PasskeyMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.file != nil {
-		edges = append(edges, metadata.EdgeFile)
+	if m.user != nil {
+		edges = append(edges, passkey.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MetadataMutation) AddedIDs(name string) []ent.Value {
+func (m *PasskeyMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case metadata.EdgeFile:
-		if id := m.file; id != nil {
+	case passkey.EdgeUser:
+		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -7066,96 +12776,90 @@ func (m *MetadataMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MetadataMutation) RemovedEdges() []string {
+func (m *PasskeyMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MetadataMutation) RemovedIDs(name string) []ent.Value {
+func (m *PasskeyMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MetadataMutation) ClearedEdges() []string {
+func (m *PasskeyMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedfile {
-		edges = append(edges, metadata.EdgeFile)
+	if m.cleareduser {
+		edges = append(edges, passkey.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MetadataMutation) EdgeCleared(name string) bool {
+func (m *PasskeyMutation) EdgeCleared(name string) bool {
 	switch name {
-	case metadata.EdgeFile:
-		return m.clearedfile
+	case passkey.EdgeUser:
+		return m.cleareduser
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MetadataMutation) ClearEdge(name string) error {
+func (m *PasskeyMutation) ClearEdge(name string) error {
 	switch name {
-	case metadata.EdgeFile:
-		m.ClearFile()
+	case passkey.EdgeUser:
+		m.ClearUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Metadata unique edge %s", name)
+	return fmt.Errorf("unknown Passkey unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MetadataMutation) ResetEdge(name string) error {
-	switch name {
-	case metadata.EdgeFile:
-		m.ResetFile()
-		return nil
-	}
-	return fmt.Errorf("unknown Metadata edge %s", name)
-}
-
-// NodeMutation represents an operation that mutates the Node nodes in the graph.
-type NodeMutation struct {
-	config
-	op                    Op
-	typ                   string
-	id                    *int
-	created_at            *time.Time
-	updated_at            *time.Time
-	deleted_at            *time.Time
-	status                *node.Status
-	name                  *string
-	_type                 *node.Type
-	server                *string
-	slave_key             *string
-	capabilities          **boolset.BooleanSet
-	settings              **types.NodeSetting
-	weight                *int
-	addweight             *int
-	clearedFields         map[string]struct{}
-	storage_policy        map[int]struct{}
-	removedstorage_policy map[int]struct{}
-	clearedstorage_policy bool
-	done                  bool
-	oldValue              func(context.Context) (*Node, error)
-	predicates            []predicate.Node
+func (m *PasskeyMutation) ResetEdge(name string) error {
+	switch name {
+	case passkey.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Passkey edge %s", name)
 }
 
-var _ ent.Mutation = (*NodeMutation)(nil)
+// PlaylistMutation represents an operation that mutates the Playlist nodes in the graph.
+type PlaylistMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	created_at     *time.Time
+	updated_at     *time.Time
+	deleted_at     *time.Time
+	name           *string
+	comment        *string
+	file_ids       *[]int
+	appendfile_ids []int
+	clearedFields  map[string]struct{}
+	owner          *int
+	clearedowner   bool
+	done           bool
+	oldValue       func(context.Context) (*Playlist, error)
+	predicates     []predicate.Playlist
+}
 
-// nodeOption allows management of the mutation configuration using functional options.
-type nodeOption func(*NodeMutation)
+var _ ent.Mutation = (*PlaylistMutation)(nil)
 
-// newNodeMutation creates new mutation for the Node entity.
-func newNodeMutation(c config, op Op, opts ...nodeOption) *NodeMutation {
-	m := &NodeMutation{
+// playlistOption allows management of the mutation configuration using functional options.
+type playlistOption func(*PlaylistMutation)
+
+// newPlaylistMutation creates new mutation for the Playlist entity.
+func newPlaylistMutation(c config, op Op, opts ...playlistOption) *PlaylistMutation {
+	m := &PlaylistMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeNode,
+		typ:           TypePlaylist,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -7164,20 +12868,20 @@ func newNodeMutation(c config, op Op, opts ...nodeOption) *NodeMutation {
 	return m
 }
 
-// withNodeID sets the ID field of the mutation.
-func withNodeID(id int) nodeOption {
-	return func(m *NodeMutation) {
+// withPlaylistID sets the ID field of the mutation.
+func withPlaylistID(id int) playlistOption {
+	return func(m *PlaylistMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Node
+			value *Playlist
 		)
-		m.oldValue = func(ctx context.Context) (*Node, error) {
+		m.oldValue = func(ctx context.Context) (*Playlist, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Node.Get(ctx, id)
+					value, err = m.Client().Playlist.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -7186,10 +12890,10 @@ func withNodeID(id int) nodeOption {
 	}
 }
 
-// withNode sets the old Node of the mutation.
-func withNode(node *Node) nodeOption {
-	return func(m *NodeMutation) {
-		m.oldValue = func(context.Context) (*Node, error) {
+// withPlaylist sets the old Playlist of the mutation.
+func withPlaylist(node *Playlist) playlistOption {
+	return func(m *PlaylistMutation) {
+		m.oldValue = func(context.Context) (*Playlist, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -7198,7 +12902,7 @@ func withNode(node *Node) nodeOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m NodeMutation) Client() *Client {
+func (m PlaylistMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -7206,7 +12910,7 @@ func (m NodeMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m NodeMutation) Tx() (*Tx, error) {
+func (m PlaylistMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -7217,7 +12921,7 @@ func (m NodeMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *NodeMutation) ID() (id int, exists bool) {
+func (m *PlaylistMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -7228,7 +12932,7 @@ func (m *NodeMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *NodeMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *PlaylistMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -7237,19 +12941,19 @@ func (m *NodeMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Node.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Playlist.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *NodeMutation) SetCreatedAt(t time.Time) {
+func (m *PlaylistMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *NodeMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *PlaylistMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -7257,10 +12961,10 @@ func (m *NodeMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Node entity.
-// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Playlist entity.
+// If the Playlist object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NodeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *PlaylistMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -7275,17 +12979,17 @@ func (m *NodeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *NodeMutation) ResetCreatedAt() {
+func (m *PlaylistMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *NodeMutation) SetUpdatedAt(t time.Time) {
+func (m *PlaylistMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *NodeMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *PlaylistMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -7293,10 +12997,10 @@ func (m *NodeMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Node entity.
-// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Playlist entity.
+// If the Playlist object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NodeMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *PlaylistMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -7310,470 +13014,282 @@ func (m *NodeMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error
 	return oldValue.UpdatedAt, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *NodeMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// SetDeletedAt sets the "deleted_at" field.
-func (m *NodeMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
-}
-
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *NodeMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldDeletedAt returns the old "deleted_at" field's value of the Node entity.
-// If the Node object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NodeMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
-	}
-	return oldValue.DeletedAt, nil
-}
-
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *NodeMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[node.FieldDeletedAt] = struct{}{}
-}
-
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *NodeMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[node.FieldDeletedAt]
-	return ok
-}
-
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *NodeMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, node.FieldDeletedAt)
-}
-
-// SetStatus sets the "status" field.
-func (m *NodeMutation) SetStatus(n node.Status) {
-	m.status = &n
-}
-
-// Status returns the value of the "status" field in the mutation.
-func (m *NodeMutation) Status() (r node.Status, exists bool) {
-	v := m.status
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldStatus returns the old "status" field's value of the Node entity.
-// If the Node object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NodeMutation) OldStatus(ctx context.Context) (v node.Status, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
-	}
-	return oldValue.Status, nil
-}
-
-// ResetStatus resets all changes to the "status" field.
-func (m *NodeMutation) ResetStatus() {
-	m.status = nil
-}
-
-// SetName sets the "name" field.
-func (m *NodeMutation) SetName(s string) {
-	m.name = &s
-}
-
-// Name returns the value of the "name" field in the mutation.
-func (m *NodeMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldName returns the old "name" field's value of the Node entity.
-// If the Node object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NodeMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
-	}
-	return oldValue.Name, nil
-}
-
-// ResetName resets all changes to the "name" field.
-func (m *NodeMutation) ResetName() {
-	m.name = nil
-}
-
-// SetType sets the "type" field.
-func (m *NodeMutation) SetType(n node.Type) {
-	m._type = &n
-}
-
-// GetType returns the value of the "type" field in the mutation.
-func (m *NodeMutation) GetType() (r node.Type, exists bool) {
-	v := m._type
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldType returns the old "type" field's value of the Node entity.
-// If the Node object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NodeMutation) OldType(ctx context.Context) (v node.Type, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
-	}
-	return oldValue.Type, nil
-}
-
-// ResetType resets all changes to the "type" field.
-func (m *NodeMutation) ResetType() {
-	m._type = nil
-}
-
-// SetServer sets the "server" field.
-func (m *NodeMutation) SetServer(s string) {
-	m.server = &s
-}
-
-// Server returns the value of the "server" field in the mutation.
-func (m *NodeMutation) Server() (r string, exists bool) {
-	v := m.server
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldServer returns the old "server" field's value of the Node entity.
-// If the Node object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NodeMutation) OldServer(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldServer is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldServer requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldServer: %w", err)
-	}
-	return oldValue.Server, nil
-}
-
-// ClearServer clears the value of the "server" field.
-func (m *NodeMutation) ClearServer() {
-	m.server = nil
-	m.clearedFields[node.FieldServer] = struct{}{}
-}
-
-// ServerCleared returns if the "server" field was cleared in this mutation.
-func (m *NodeMutation) ServerCleared() bool {
-	_, ok := m.clearedFields[node.FieldServer]
-	return ok
-}
-
-// ResetServer resets all changes to the "server" field.
-func (m *NodeMutation) ResetServer() {
-	m.server = nil
-	delete(m.clearedFields, node.FieldServer)
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PlaylistMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetSlaveKey sets the "slave_key" field.
-func (m *NodeMutation) SetSlaveKey(s string) {
-	m.slave_key = &s
+// SetDeletedAt sets the "deleted_at" field.
+func (m *PlaylistMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
 }
 
-// SlaveKey returns the value of the "slave_key" field in the mutation.
-func (m *NodeMutation) SlaveKey() (r string, exists bool) {
-	v := m.slave_key
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *PlaylistMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSlaveKey returns the old "slave_key" field's value of the Node entity.
-// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedAt returns the old "deleted_at" field's value of the Playlist entity.
+// If the Playlist object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NodeMutation) OldSlaveKey(ctx context.Context) (v string, err error) {
+func (m *PlaylistMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSlaveKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSlaveKey requires an ID field in the mutation")
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSlaveKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
 	}
-	return oldValue.SlaveKey, nil
+	return oldValue.DeletedAt, nil
 }
 
-// ClearSlaveKey clears the value of the "slave_key" field.
-func (m *NodeMutation) ClearSlaveKey() {
-	m.slave_key = nil
-	m.clearedFields[node.FieldSlaveKey] = struct{}{}
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *PlaylistMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[playlist.FieldDeletedAt] = struct{}{}
 }
 
-// SlaveKeyCleared returns if the "slave_key" field was cleared in this mutation.
-func (m *NodeMutation) SlaveKeyCleared() bool {
-	_, ok := m.clearedFields[node.FieldSlaveKey]
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *PlaylistMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[playlist.FieldDeletedAt]
 	return ok
 }
 
-// ResetSlaveKey resets all changes to the "slave_key" field.
-func (m *NodeMutation) ResetSlaveKey() {
-	m.slave_key = nil
-	delete(m.clearedFields, node.FieldSlaveKey)
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *PlaylistMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, playlist.FieldDeletedAt)
 }
 
-// SetCapabilities sets the "capabilities" field.
-func (m *NodeMutation) SetCapabilities(bs *boolset.BooleanSet) {
-	m.capabilities = &bs
+// SetName sets the "name" field.
+func (m *PlaylistMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Capabilities returns the value of the "capabilities" field in the mutation.
-func (m *NodeMutation) Capabilities() (r *boolset.BooleanSet, exists bool) {
-	v := m.capabilities
+// Name returns the value of the "name" field in the mutation.
+func (m *PlaylistMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCapabilities returns the old "capabilities" field's value of the Node entity.
-// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Playlist entity.
+// If the Playlist object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NodeMutation) OldCapabilities(ctx context.Context) (v *boolset.BooleanSet, err error) {
+func (m *PlaylistMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCapabilities is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCapabilities requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCapabilities: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Capabilities, nil
+	return oldValue.Name, nil
 }
 
-// ResetCapabilities resets all changes to the "capabilities" field.
-func (m *NodeMutation) ResetCapabilities() {
-	m.capabilities = nil
+// ResetName resets all changes to the "name" field.
+func (m *PlaylistMutation) ResetName() {
+	m.name = nil
 }
 
-// SetSettings sets the "settings" field.
-func (m *NodeMutation) SetSettings(ts *types.NodeSetting) {
-	m.settings = &ts
+// SetComment sets the "comment" field.
+func (m *PlaylistMutation) SetComment(s string) {
+	m.comment = &s
 }
 
-// Settings returns the value of the "settings" field in the mutation.
-func (m *NodeMutation) Settings() (r *types.NodeSetting, exists bool) {
-	v := m.settings
+// Comment returns the value of the "comment" field in the mutation.
+func (m *PlaylistMutation) Comment() (r string, exists bool) {
+	v := m.comment
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSettings returns the old "settings" field's value of the Node entity.
-// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// OldComment returns the old "comment" field's value of the Playlist entity.
+// If the Playlist object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NodeMutation) OldSettings(ctx context.Context) (v *types.NodeSetting, err error) {
+func (m *PlaylistMutation) OldComment(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSettings is only allowed on UpdateOne operations")
+		return v, errors.New("OldComment is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSettings requires an ID field in the mutation")
+		return v, errors.New("OldComment requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSettings: %w", err)
+		return v, fmt.Errorf("querying old value for OldComment: %w", err)
 	}
-	return oldValue.Settings, nil
+	return oldValue.Comment, nil
 }
 
-// ClearSettings clears the value of the "settings" field.
-func (m *NodeMutation) ClearSettings() {
-	m.settings = nil
-	m.clearedFields[node.FieldSettings] = struct{}{}
+// ClearComment clears the value of the "comment" field.
+func (m *PlaylistMutation) ClearComment() {
+	m.comment = nil
+	m.clearedFields[playlist.FieldComment] = struct{}{}
 }
 
-// SettingsCleared returns if the "settings" field was cleared in this mutation.
-func (m *NodeMutation) SettingsCleared() bool {
-	_, ok := m.clearedFields[node.FieldSettings]
+// CommentCleared returns if the "comment" field was cleared in this mutation.
+func (m *PlaylistMutation) CommentCleared() bool {
+	_, ok := m.clearedFields[playlist.FieldComment]
 	return ok
 }
 
-// ResetSettings resets all changes to the "settings" field.
-func (m *NodeMutation) ResetSettings() {
-	m.settings = nil
-	delete(m.clearedFields, node.FieldSettings)
+// ResetComment resets all changes to the "comment" field.
+func (m *PlaylistMutation) ResetComment() {
+	m.comment = nil
+	delete(m.clearedFields, playlist.FieldComment)
 }
 
-// SetWeight sets the "weight" field.
-func (m *NodeMutation) SetWeight(i int) {
-	m.weight = &i
-	m.addweight = nil
+// SetFileIds sets the "file_ids" field.
+func (m *PlaylistMutation) SetFileIds(i []int) {
+	m.file_ids = &i
+	m.appendfile_ids = nil
 }
 
-// Weight returns the value of the "weight" field in the mutation.
-func (m *NodeMutation) Weight() (r int, exists bool) {
-	v := m.weight
+// FileIds returns the value of the "file_ids" field in the mutation.
+func (m *PlaylistMutation) FileIds() (r []int, exists bool) {
+	v := m.file_ids
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldWeight returns the old "weight" field's value of the Node entity.
-// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// OldFileIds returns the old "file_ids" field's value of the Playlist entity.
+// If the Playlist object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NodeMutation) OldWeight(ctx context.Context) (v int, err error) {
+func (m *PlaylistMutation) OldFileIds(ctx context.Context) (v []int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldWeight is only allowed on UpdateOne operations")
+		return v, errors.New("OldFileIds is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldWeight requires an ID field in the mutation")
+		return v, errors.New("OldFileIds requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWeight: %w", err)
+		return v, fmt.Errorf("querying old value for OldFileIds: %w", err)
 	}
-	return oldValue.Weight, nil
+	return oldValue.FileIds, nil
 }
 
-// AddWeight adds i to the "weight" field.
-func (m *NodeMutation) AddWeight(i int) {
-	if m.addweight != nil {
-		*m.addweight += i
-	} else {
-		m.addweight = &i
-	}
+// AppendFileIds adds i to the "file_ids" field.
+func (m *PlaylistMutation) AppendFileIds(i []int) {
+	m.appendfile_ids = append(m.appendfile_ids, i...)
 }
 
-// AddedWeight returns the value that was added to the "weight" field in this mutation.
-func (m *NodeMutation) AddedWeight() (r int, exists bool) {
-	v := m.addweight
-	if v == nil {
-		return
+// AppendedFileIds returns the list of values that were appended to the "file_ids" field in this mutation.
+func (m *PlaylistMutation) AppendedFileIds() ([]int, bool) {
+	if len(m.appendfile_ids) == 0 {
+		return nil, false
 	}
-	return *v, true
+	return m.appendfile_ids, true
 }
 
-// ResetWeight resets all changes to the "weight" field.
-func (m *NodeMutation) ResetWeight() {
-	m.weight = nil
-	m.addweight = nil
+// ClearFileIds clears the value of the "file_ids" field.
+func (m *PlaylistMutation) ClearFileIds() {
+	m.file_ids = nil
+	m.appendfile_ids = nil
+	m.clearedFields[playlist.FieldFileIds] = struct{}{}
 }
 
-// AddStoragePolicyIDs adds the "storage_policy" edge to the StoragePolicy entity by ids.
-func (m *NodeMutation) AddStoragePolicyIDs(ids ...int) {
-	if m.storage_policy == nil {
-		m.storage_policy = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.storage_policy[ids[i]] = struct{}{}
-	}
+// FileIdsCleared returns if the "file_ids" field was cleared in this mutation.
+func (m *PlaylistMutation) FileIdsCleared() bool {
+	_, ok := m.clearedFields[playlist.FieldFileIds]
+	return ok
 }
 
-// ClearStoragePolicy clears the "storage_policy" edge to the StoragePolicy entity.
-func (m *NodeMutation) ClearStoragePolicy() {
-	m.clearedstorage_policy = true
+// ResetFileIds resets all changes to the "file_ids" field.
+func (m *PlaylistMutation) ResetFileIds() {
+	m.file_ids = nil
+	m.appendfile_ids = nil
+	delete(m.clearedFields, playlist.FieldFileIds)
 }
 
-// StoragePolicyCleared reports if the "storage_policy" edge to the StoragePolicy entity was cleared.
-func (m *NodeMutation) StoragePolicyCleared() bool {
-	return m.clearedstorage_policy
+// SetOwnerID sets the "owner_id" field.
+func (m *PlaylistMutation) SetOwnerID(i int) {
+	m.owner = &i
 }
 
-// RemoveStoragePolicyIDs removes the "storage_policy" edge to the StoragePolicy entity by IDs.
-func (m *NodeMutation) RemoveStoragePolicyIDs(ids ...int) {
-	if m.removedstorage_policy == nil {
-		m.removedstorage_policy = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.storage_policy, ids[i])
-		m.removedstorage_policy[ids[i]] = struct{}{}
+// OwnerID returns the value of the "owner_id" field in the mutation.
+func (m *PlaylistMutation) OwnerID() (r int, exists bool) {
+	v := m.owner
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedStoragePolicy returns the removed IDs of the "storage_policy" edge to the StoragePolicy entity.
-func (m *NodeMutation) RemovedStoragePolicyIDs() (ids []int) {
-	for id := range m.removedstorage_policy {
-		ids = append(ids, id)
+// OldOwnerID returns the old "owner_id" field's value of the Playlist entity.
+// If the Playlist object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaylistMutation) OldOwnerID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
+	}
+	return oldValue.OwnerID, nil
 }
 
-// StoragePolicyIDs returns the "storage_policy" edge IDs in the mutation.
-func (m *NodeMutation) StoragePolicyIDs() (ids []int) {
-	for id := range m.storage_policy {
-		ids = append(ids, id)
+// ResetOwnerID resets all changes to the "owner_id" field.
+func (m *PlaylistMutation) ResetOwnerID() {
+	m.owner = nil
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *PlaylistMutation) ClearOwner() {
+	m.clearedowner = true
+	m.clearedFields[playlist.FieldOwnerID] = struct{}{}
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *PlaylistMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *PlaylistMutation) OwnerIDs() (ids []int) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetStoragePolicy resets all changes to the "storage_policy" edge.
-func (m *NodeMutation) ResetStoragePolicy() {
-	m.storage_policy = nil
-	m.clearedstorage_policy = false
-	m.removedstorage_policy = nil
+// ResetOwner resets all changes to the "owner" edge.
+func (m *PlaylistMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
 }
 
-// Where appends a list predicates to the NodeMutation builder.
-func (m *NodeMutation) Where(ps ...predicate.Node) {
+// Where appends a list predicates to the PlaylistMutation builder.
+func (m *PlaylistMutation) Where(ps ...predicate.Playlist) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the NodeMutation builder. Using this method,
+// WhereP appends storage-level predicates to the PlaylistMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *NodeMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Node, len(ps))
+func (m *PlaylistMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Playlist, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -7781,57 +13297,45 @@ func (m *NodeMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *NodeMutation) Op() Op {
+func (m *PlaylistMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *NodeMutation) SetOp(op Op) {
+func (m *PlaylistMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Node).
-func (m *NodeMutation) Type() string {
+// Type returns the node type of this mutation (Playlist).
+func (m *PlaylistMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *NodeMutation) Fields() []string {
-	fields := make([]string, 0, 11)
+func (m *PlaylistMutation) Fields() []string {
+	fields := make([]string, 0, 7)
 	if m.created_at != nil {
-		fields = append(fields, node.FieldCreatedAt)
+		fields = append(fields, playlist.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, node.FieldUpdatedAt)
+		fields = append(fields, playlist.FieldUpdatedAt)
 	}
 	if m.deleted_at != nil {
-		fields = append(fields, node.FieldDeletedAt)
-	}
-	if m.status != nil {
-		fields = append(fields, node.FieldStatus)
+		fields = append(fields, playlist.FieldDeletedAt)
 	}
 	if m.name != nil {
-		fields = append(fields, node.FieldName)
-	}
-	if m._type != nil {
-		fields = append(fields, node.FieldType)
-	}
-	if m.server != nil {
-		fields = append(fields, node.FieldServer)
+		fields = append(fields, playlist.FieldName)
 	}
-	if m.slave_key != nil {
-		fields = append(fields, node.FieldSlaveKey)
-	}
-	if m.capabilities != nil {
-		fields = append(fields, node.FieldCapabilities)
+	if m.comment != nil {
+		fields = append(fields, playlist.FieldComment)
 	}
-	if m.settings != nil {
-		fields = append(fields, node.FieldSettings)
+	if m.file_ids != nil {
+		fields = append(fields, playlist.FieldFileIds)
 	}
-	if m.weight != nil {
-		fields = append(fields, node.FieldWeight)
+	if m.owner != nil {
+		fields = append(fields, playlist.FieldOwnerID)
 	}
 	return fields
 }
@@ -7839,30 +13343,22 @@ func (m *NodeMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *NodeMutation) Field(name string) (ent.Value, bool) {
+func (m *PlaylistMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case node.FieldCreatedAt:
+	case playlist.FieldCreatedAt:
 		return m.CreatedAt()
-	case node.FieldUpdatedAt:
+	case playlist.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case node.FieldDeletedAt:
+	case playlist.FieldDeletedAt:
 		return m.DeletedAt()
-	case node.FieldStatus:
-		return m.Status()
-	case node.FieldName:
+	case playlist.FieldName:
 		return m.Name()
-	case node.FieldType:
-		return m.GetType()
-	case node.FieldServer:
-		return m.Server()
-	case node.FieldSlaveKey:
-		return m.SlaveKey()
-	case node.FieldCapabilities:
-		return m.Capabilities()
-	case node.FieldSettings:
-		return m.Settings()
-	case node.FieldWeight:
-		return m.Weight()
+	case playlist.FieldComment:
+		return m.Comment()
+	case playlist.FieldFileIds:
+		return m.FileIds()
+	case playlist.FieldOwnerID:
+		return m.OwnerID()
 	}
 	return nil, false
 }
@@ -7870,137 +13366,96 @@ func (m *NodeMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *NodeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *PlaylistMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case node.FieldCreatedAt:
+	case playlist.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case node.FieldUpdatedAt:
+	case playlist.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case node.FieldDeletedAt:
+	case playlist.FieldDeletedAt:
 		return m.OldDeletedAt(ctx)
-	case node.FieldStatus:
-		return m.OldStatus(ctx)
-	case node.FieldName:
+	case playlist.FieldName:
 		return m.OldName(ctx)
-	case node.FieldType:
-		return m.OldType(ctx)
-	case node.FieldServer:
-		return m.OldServer(ctx)
-	case node.FieldSlaveKey:
-		return m.OldSlaveKey(ctx)
-	case node.FieldCapabilities:
-		return m.OldCapabilities(ctx)
-	case node.FieldSettings:
-		return m.OldSettings(ctx)
-	case node.FieldWeight:
-		return m.OldWeight(ctx)
+	case playlist.FieldComment:
+		return m.OldComment(ctx)
+	case playlist.FieldFileIds:
+		return m.OldFileIds(ctx)
+	case playlist.FieldOwnerID:
+		return m.OldOwnerID(ctx)
 	}
-	return nil, fmt.Errorf("unknown Node field %s", name)
+	return nil, fmt.Errorf("unknown Playlist field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *NodeMutation) SetField(name string, value ent.Value) error {
+func (m *PlaylistMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case node.FieldCreatedAt:
+	case playlist.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case node.FieldUpdatedAt:
+	case playlist.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case node.FieldDeletedAt:
+	case playlist.FieldDeletedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDeletedAt(v)
 		return nil
-	case node.FieldStatus:
-		v, ok := value.(node.Status)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case node.FieldName:
+	case playlist.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case node.FieldType:
-		v, ok := value.(node.Type)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetType(v)
-		return nil
-	case node.FieldServer:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetServer(v)
-		return nil
-	case node.FieldSlaveKey:
+	case playlist.FieldComment:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSlaveKey(v)
-		return nil
-	case node.FieldCapabilities:
-		v, ok := value.(*boolset.BooleanSet)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCapabilities(v)
+		m.SetComment(v)
 		return nil
-	case node.FieldSettings:
-		v, ok := value.(*types.NodeSetting)
+	case playlist.FieldFileIds:
+		v, ok := value.([]int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSettings(v)
+		m.SetFileIds(v)
 		return nil
-	case node.FieldWeight:
+	case playlist.FieldOwnerID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetWeight(v)
+		m.SetOwnerID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Node field %s", name)
+	return fmt.Errorf("unknown Playlist field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *NodeMutation) AddedFields() []string {
+func (m *PlaylistMutation) AddedFields() []string {
 	var fields []string
-	if m.addweight != nil {
-		fields = append(fields, node.FieldWeight)
-	}
 	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *NodeMutation) AddedField(name string) (ent.Value, bool) {
+func (m *PlaylistMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case node.FieldWeight:
-		return m.AddedWeight()
 	}
 	return nil, false
 }
@@ -8008,192 +13463,157 @@ func (m *NodeMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *NodeMutation) AddField(name string, value ent.Value) error {
+func (m *PlaylistMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case node.FieldWeight:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddWeight(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Node numeric field %s", name)
+	return fmt.Errorf("unknown Playlist numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *NodeMutation) ClearedFields() []string {
+func (m *PlaylistMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(node.FieldDeletedAt) {
-		fields = append(fields, node.FieldDeletedAt)
+	if m.FieldCleared(playlist.FieldDeletedAt) {
+		fields = append(fields, playlist.FieldDeletedAt)
 	}
-	if m.FieldCleared(node.FieldServer) {
-		fields = append(fields, node.FieldServer)
-	}
-	if m.FieldCleared(node.FieldSlaveKey) {
-		fields = append(fields, node.FieldSlaveKey)
+	if m.FieldCleared(playlist.FieldComment) {
+		fields = append(fields, playlist.FieldComment)
 	}
-	if m.FieldCleared(node.FieldSettings) {
-		fields = append(fields, node.FieldSettings)
+	if m.FieldCleared(playlist.FieldFileIds) {
+		fields = append(fields, playlist.FieldFileIds)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *NodeMutation) FieldCleared(name string) bool {
+func (m *PlaylistMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *NodeMutation) ClearField(name string) error {
+func (m *PlaylistMutation) ClearField(name string) error {
 	switch name {
-	case node.FieldDeletedAt:
+	case playlist.FieldDeletedAt:
 		m.ClearDeletedAt()
 		return nil
-	case node.FieldServer:
-		m.ClearServer()
-		return nil
-	case node.FieldSlaveKey:
-		m.ClearSlaveKey()
+	case playlist.FieldComment:
+		m.ClearComment()
 		return nil
-	case node.FieldSettings:
-		m.ClearSettings()
+	case playlist.FieldFileIds:
+		m.ClearFileIds()
 		return nil
 	}
-	return fmt.Errorf("unknown Node nullable field %s", name)
+	return fmt.Errorf("unknown Playlist nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *NodeMutation) ResetField(name string) error {
+func (m *PlaylistMutation) ResetField(name string) error {
 	switch name {
-	case node.FieldCreatedAt:
+	case playlist.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case node.FieldUpdatedAt:
+	case playlist.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case node.FieldDeletedAt:
+	case playlist.FieldDeletedAt:
 		m.ResetDeletedAt()
 		return nil
-	case node.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case node.FieldName:
+	case playlist.FieldName:
 		m.ResetName()
 		return nil
-	case node.FieldType:
-		m.ResetType()
-		return nil
-	case node.FieldServer:
-		m.ResetServer()
-		return nil
-	case node.FieldSlaveKey:
-		m.ResetSlaveKey()
-		return nil
-	case node.FieldCapabilities:
-		m.ResetCapabilities()
+	case playlist.FieldComment:
+		m.ResetComment()
 		return nil
-	case node.FieldSettings:
-		m.ResetSettings()
+	case playlist.FieldFileIds:
+		m.ResetFileIds()
 		return nil
-	case node.FieldWeight:
-		m.ResetWeight()
+	case playlist.FieldOwnerID:
+		m.ResetOwnerID()
 		return nil
 	}
-	return fmt.Errorf("unknown Node field %s", name)
+	return fmt.Errorf("unknown Playlist field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *NodeMutation) AddedEdges() []string {
+func (m *PlaylistMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.storage_policy != nil {
-		edges = append(edges, node.EdgeStoragePolicy)
+	if m.owner != nil {
+		edges = append(edges, playlist.EdgeOwner)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *NodeMutation) AddedIDs(name string) []ent.Value {
+func (m *PlaylistMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case node.EdgeStoragePolicy:
-		ids := make([]ent.Value, 0, len(m.storage_policy))
-		for id := range m.storage_policy {
-			ids = append(ids, id)
+	case playlist.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *NodeMutation) RemovedEdges() []string {
+func (m *PlaylistMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.removedstorage_policy != nil {
-		edges = append(edges, node.EdgeStoragePolicy)
-	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *NodeMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case node.EdgeStoragePolicy:
-		ids := make([]ent.Value, 0, len(m.removedstorage_policy))
-		for id := range m.removedstorage_policy {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *PlaylistMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *NodeMutation) ClearedEdges() []string {
+func (m *PlaylistMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedstorage_policy {
-		edges = append(edges, node.EdgeStoragePolicy)
+	if m.clearedowner {
+		edges = append(edges, playlist.EdgeOwner)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *NodeMutation) EdgeCleared(name string) bool {
+func (m *PlaylistMutation) EdgeCleared(name string) bool {
 	switch name {
-	case node.EdgeStoragePolicy:
-		return m.clearedstorage_policy
+	case playlist.EdgeOwner:
+		return m.clearedowner
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *NodeMutation) ClearEdge(name string) error {
+func (m *PlaylistMutation) ClearEdge(name string) error {
 	switch name {
+	case playlist.EdgeOwner:
+		m.ClearOwner()
+		return nil
 	}
-	return fmt.Errorf("unknown Node unique edge %s", name)
+	return fmt.Errorf("unknown Playlist unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *NodeMutation) ResetEdge(name string) error {
+func (m *PlaylistMutation) ResetEdge(name string) error {
 	switch name {
-	case node.EdgeStoragePolicy:
-		m.ResetStoragePolicy()
+	case playlist.EdgeOwner:
+		m.ResetOwner()
 		return nil
 	}
-	return fmt.Errorf("unknown Node edge %s", name)
+	return fmt.Errorf("unknown Playlist edge %s", name)
 }
 
-// PasskeyMutation represents an operation that mutates the Passkey nodes in the graph.
-type PasskeyMutation struct {
+// SettingMutation represents an operation that mutates the Setting nodes in the graph.
+type SettingMutation struct {
 	config
 	op            Op
 	typ           string
@@ -8201,29 +13621,25 @@ type PasskeyMutation struct {
 	created_at    *time.Time
 	updated_at    *time.Time
 	deleted_at    *time.Time
-	credential_id *string
 	name          *string
-	credential    **webauthn.Credential
-	used_at       *time.Time
+	value         *string
 	clearedFields map[string]struct{}
-	user          *int
-	cleareduser   bool
 	done          bool
-	oldValue      func(context.Context) (*Passkey, error)
-	predicates    []predicate.Passkey
+	oldValue      func(context.Context) (*Setting, error)
+	predicates    []predicate.Setting
 }
 
-var _ ent.Mutation = (*PasskeyMutation)(nil)
+var _ ent.Mutation = (*SettingMutation)(nil)
 
-// passkeyOption allows management of the mutation configuration using functional options.
-type passkeyOption func(*PasskeyMutation)
+// settingOption allows management of the mutation configuration using functional options.
+type settingOption func(*SettingMutation)
 
-// newPasskeyMutation creates new mutation for the Passkey entity.
-func newPasskeyMutation(c config, op Op, opts ...passkeyOption) *PasskeyMutation {
-	m := &PasskeyMutation{
+// newSettingMutation creates new mutation for the Setting entity.
+func newSettingMutation(c config, op Op, opts ...settingOption) *SettingMutation {
+	m := &SettingMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePasskey,
+		typ:           TypeSetting,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -8232,20 +13648,20 @@ func newPasskeyMutation(c config, op Op, opts ...passkeyOption) *PasskeyMutation
 	return m
 }
 
-// withPasskeyID sets the ID field of the mutation.
-func withPasskeyID(id int) passkeyOption {
-	return func(m *PasskeyMutation) {
+// withSettingID sets the ID field of the mutation.
+func withSettingID(id int) settingOption {
+	return func(m *SettingMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Passkey
+			value *Setting
 		)
-		m.oldValue = func(ctx context.Context) (*Passkey, error) {
+		m.oldValue = func(ctx context.Context) (*Setting, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Passkey.Get(ctx, id)
+					value, err = m.Client().Setting.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -8254,10 +13670,10 @@ func withPasskeyID(id int) passkeyOption {
 	}
 }
 
-// withPasskey sets the old Passkey of the mutation.
-func withPasskey(node *Passkey) passkeyOption {
-	return func(m *PasskeyMutation) {
-		m.oldValue = func(context.Context) (*Passkey, error) {
+// withSetting sets the old Setting of the mutation.
+func withSetting(node *Setting) settingOption {
+	return func(m *SettingMutation) {
+		m.oldValue = func(context.Context) (*Setting, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -8266,7 +13682,7 @@ func withPasskey(node *Passkey) passkeyOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PasskeyMutation) Client() *Client {
+func (m SettingMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -8274,7 +13690,7 @@ func (m PasskeyMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PasskeyMutation) Tx() (*Tx, error) {
+func (m SettingMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -8285,7 +13701,7 @@ func (m PasskeyMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PasskeyMutation) ID() (id int, exists bool) {
+func (m *SettingMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -8296,7 +13712,7 @@ func (m *PasskeyMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PasskeyMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *SettingMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -8305,19 +13721,19 @@ func (m *PasskeyMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Passkey.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Setting.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *PasskeyMutation) SetCreatedAt(t time.Time) {
+func (m *SettingMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PasskeyMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *SettingMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -8325,10 +13741,10 @@ func (m *PasskeyMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Passkey entity.
-// If the Passkey object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Setting entity.
+// If the Setting object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PasskeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SettingMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -8343,17 +13759,17 @@ func (m *PasskeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err er
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PasskeyMutation) ResetCreatedAt() {
+func (m *SettingMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *PasskeyMutation) SetUpdatedAt(t time.Time) {
+func (m *SettingMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *PasskeyMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *SettingMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -8361,10 +13777,10 @@ func (m *PasskeyMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Passkey entity.
-// If the Passkey object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Setting entity.
+// If the Setting object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PasskeyMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SettingMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -8379,17 +13795,17 @@ func (m *PasskeyMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err er
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *PasskeyMutation) ResetUpdatedAt() {
+func (m *SettingMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // SetDeletedAt sets the "deleted_at" field.
-func (m *PasskeyMutation) SetDeletedAt(t time.Time) {
+func (m *SettingMutation) SetDeletedAt(t time.Time) {
 	m.deleted_at = &t
 }
 
 // DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *PasskeyMutation) DeletedAt() (r time.Time, exists bool) {
+func (m *SettingMutation) DeletedAt() (r time.Time, exists bool) {
 	v := m.deleted_at
 	if v == nil {
 		return
@@ -8397,10 +13813,10 @@ func (m *PasskeyMutation) DeletedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the Passkey entity.
-// If the Passkey object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedAt returns the old "deleted_at" field's value of the Setting entity.
+// If the Setting object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PasskeyMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *SettingMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
@@ -8415,915 +13831,1033 @@ func (m *PasskeyMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err e
 }
 
 // ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *PasskeyMutation) ClearDeletedAt() {
+func (m *SettingMutation) ClearDeletedAt() {
 	m.deleted_at = nil
-	m.clearedFields[passkey.FieldDeletedAt] = struct{}{}
+	m.clearedFields[setting.FieldDeletedAt] = struct{}{}
 }
 
 // DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *PasskeyMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[passkey.FieldDeletedAt]
+func (m *SettingMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[setting.FieldDeletedAt]
 	return ok
 }
 
 // ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *PasskeyMutation) ResetDeletedAt() {
+func (m *SettingMutation) ResetDeletedAt() {
 	m.deleted_at = nil
-	delete(m.clearedFields, passkey.FieldDeletedAt)
+	delete(m.clearedFields, setting.FieldDeletedAt)
 }
 
-// SetUserID sets the "user_id" field.
-func (m *PasskeyMutation) SetUserID(i int) {
-	m.user = &i
+// SetName sets the "name" field.
+func (m *SettingMutation) SetName(s string) {
+	m.name = &s
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *PasskeyMutation) UserID() (r int, exists bool) {
-	v := m.user
+// Name returns the value of the "name" field in the mutation.
+func (m *SettingMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the Passkey entity.
-// If the Passkey object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Setting entity.
+// If the Setting object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PasskeyMutation) OldUserID(ctx context.Context) (v int, err error) {
+func (m *SettingMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.Name, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *PasskeyMutation) ResetUserID() {
-	m.user = nil
+// ResetName resets all changes to the "name" field.
+func (m *SettingMutation) ResetName() {
+	m.name = nil
 }
 
-// SetCredentialID sets the "credential_id" field.
-func (m *PasskeyMutation) SetCredentialID(s string) {
-	m.credential_id = &s
+// SetValue sets the "value" field.
+func (m *SettingMutation) SetValue(s string) {
+	m.value = &s
 }
 
-// CredentialID returns the value of the "credential_id" field in the mutation.
-func (m *PasskeyMutation) CredentialID() (r string, exists bool) {
-	v := m.credential_id
+// Value returns the value of the "value" field in the mutation.
+func (m *SettingMutation) Value() (r string, exists bool) {
+	v := m.value
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCredentialID returns the old "credential_id" field's value of the Passkey entity.
-// If the Passkey object wasn't provided to the builder, the object is fetched from the database.
+// OldValue returns the old "value" field's value of the Setting entity.
+// If the Setting object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PasskeyMutation) OldCredentialID(ctx context.Context) (v string, err error) {
+func (m *SettingMutation) OldValue(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCredentialID is only allowed on UpdateOne operations")
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCredentialID requires an ID field in the mutation")
+		return v, errors.New("OldValue requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCredentialID: %w", err)
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
 	}
-	return oldValue.CredentialID, nil
+	return oldValue.Value, nil
 }
 
-// ResetCredentialID resets all changes to the "credential_id" field.
-func (m *PasskeyMutation) ResetCredentialID() {
-	m.credential_id = nil
+// ClearValue clears the value of the "value" field.
+func (m *SettingMutation) ClearValue() {
+	m.value = nil
+	m.clearedFields[setting.FieldValue] = struct{}{}
 }
 
-// SetName sets the "name" field.
-func (m *PasskeyMutation) SetName(s string) {
-	m.name = &s
+// ValueCleared returns if the "value" field was cleared in this mutation.
+func (m *SettingMutation) ValueCleared() bool {
+	_, ok := m.clearedFields[setting.FieldValue]
+	return ok
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *PasskeyMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
+// ResetValue resets all changes to the "value" field.
+func (m *SettingMutation) ResetValue() {
+	m.value = nil
+	delete(m.clearedFields, setting.FieldValue)
+}
+
+// Where appends a list predicates to the SettingMutation builder.
+func (m *SettingMutation) Where(ps ...predicate.Setting) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SettingMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SettingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Setting, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldName returns the old "name" field's value of the Passkey entity.
-// If the Passkey object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PasskeyMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+// Op returns the operation name.
+func (m *SettingMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SettingMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Setting).
+func (m *SettingMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SettingMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.created_at != nil {
+		fields = append(fields, setting.FieldCreatedAt)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+	if m.updated_at != nil {
+		fields = append(fields, setting.FieldUpdatedAt)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	if m.deleted_at != nil {
+		fields = append(fields, setting.FieldDeletedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, setting.FieldName)
+	}
+	if m.value != nil {
+		fields = append(fields, setting.FieldValue)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SettingMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case setting.FieldCreatedAt:
+		return m.CreatedAt()
+	case setting.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case setting.FieldDeletedAt:
+		return m.DeletedAt()
+	case setting.FieldName:
+		return m.Name()
+	case setting.FieldValue:
+		return m.Value()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SettingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case setting.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case setting.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case setting.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case setting.FieldName:
+		return m.OldName(ctx)
+	case setting.FieldValue:
+		return m.OldValue(ctx)
+	}
+	return nil, fmt.Errorf("unknown Setting field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SettingMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case setting.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case setting.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case setting.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case setting.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case setting.FieldValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Setting field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SettingMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SettingMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SettingMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return oldValue.Name, nil
+	return fmt.Errorf("unknown Setting numeric field %s", name)
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *PasskeyMutation) ResetName() {
-	m.name = nil
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SettingMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(setting.FieldDeletedAt) {
+		fields = append(fields, setting.FieldDeletedAt)
+	}
+	if m.FieldCleared(setting.FieldValue) {
+		fields = append(fields, setting.FieldValue)
+	}
+	return fields
 }
 
-// SetCredential sets the "credential" field.
-func (m *PasskeyMutation) SetCredential(w *webauthn.Credential) {
-	m.credential = &w
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SettingMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// Credential returns the value of the "credential" field in the mutation.
-func (m *PasskeyMutation) Credential() (r *webauthn.Credential, exists bool) {
-	v := m.credential
-	if v == nil {
-		return
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SettingMutation) ClearField(name string) error {
+	switch name {
+	case setting.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case setting.FieldValue:
+		m.ClearValue()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown Setting nullable field %s", name)
 }
 
-// OldCredential returns the old "credential" field's value of the Passkey entity.
-// If the Passkey object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PasskeyMutation) OldCredential(ctx context.Context) (v *webauthn.Credential, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCredential is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCredential requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCredential: %w", err)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SettingMutation) ResetField(name string) error {
+	switch name {
+	case setting.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case setting.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case setting.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case setting.FieldName:
+		m.ResetName()
+		return nil
+	case setting.FieldValue:
+		m.ResetValue()
+		return nil
 	}
-	return oldValue.Credential, nil
+	return fmt.Errorf("unknown Setting field %s", name)
 }
 
-// ResetCredential resets all changes to the "credential" field.
-func (m *PasskeyMutation) ResetCredential() {
-	m.credential = nil
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SettingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// SetUsedAt sets the "used_at" field.
-func (m *PasskeyMutation) SetUsedAt(t time.Time) {
-	m.used_at = &t
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SettingMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// UsedAt returns the value of the "used_at" field in the mutation.
-func (m *PasskeyMutation) UsedAt() (r time.Time, exists bool) {
-	v := m.used_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SettingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// OldUsedAt returns the old "used_at" field's value of the Passkey entity.
-// If the Passkey object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PasskeyMutation) OldUsedAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUsedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUsedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUsedAt: %w", err)
-	}
-	return oldValue.UsedAt, nil
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SettingMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ClearUsedAt clears the value of the "used_at" field.
-func (m *PasskeyMutation) ClearUsedAt() {
-	m.used_at = nil
-	m.clearedFields[passkey.FieldUsedAt] = struct{}{}
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SettingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// UsedAtCleared returns if the "used_at" field was cleared in this mutation.
-func (m *PasskeyMutation) UsedAtCleared() bool {
-	_, ok := m.clearedFields[passkey.FieldUsedAt]
-	return ok
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SettingMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// ResetUsedAt resets all changes to the "used_at" field.
-func (m *PasskeyMutation) ResetUsedAt() {
-	m.used_at = nil
-	delete(m.clearedFields, passkey.FieldUsedAt)
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SettingMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Setting unique edge %s", name)
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *PasskeyMutation) ClearUser() {
-	m.cleareduser = true
-	m.clearedFields[passkey.FieldUserID] = struct{}{}
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SettingMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Setting edge %s", name)
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *PasskeyMutation) UserCleared() bool {
-	return m.cleareduser
+// ShareMutation represents an operation that mutates the Share nodes in the graph.
+type ShareMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	created_at          *time.Time
+	updated_at          *time.Time
+	deleted_at          *time.Time
+	password            *string
+	views               *int
+	addviews            *int
+	downloads           *int
+	adddownloads        *int
+	expires             *time.Time
+	remain_downloads    *int
+	addremain_downloads *int
+	props               **types.ShareProps
+	clearedFields       map[string]struct{}
+	user                *int
+	cleareduser         bool
+	file                *int
+	clearedfile         bool
+	done                bool
+	oldValue            func(context.Context) (*Share, error)
+	predicates          []predicate.Share
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *PasskeyMutation) UserIDs() (ids []int) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+var _ ent.Mutation = (*ShareMutation)(nil)
+
+// shareOption allows management of the mutation configuration using functional options.
+type shareOption func(*ShareMutation)
+
+// newShareMutation creates new mutation for the Share entity.
+func newShareMutation(c config, op Op, opts ...shareOption) *ShareMutation {
+	m := &ShareMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeShare,
+		clearedFields: make(map[string]struct{}),
 	}
-	return
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *PasskeyMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// withShareID sets the ID field of the mutation.
+func withShareID(id int) shareOption {
+	return func(m *ShareMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Share
+		)
+		m.oldValue = func(ctx context.Context) (*Share, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Share.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// Where appends a list predicates to the PasskeyMutation builder.
-func (m *PasskeyMutation) Where(ps ...predicate.Passkey) {
-	m.predicates = append(m.predicates, ps...)
+// withShare sets the old Share of the mutation.
+func withShare(node *Share) shareOption {
+	return func(m *ShareMutation) {
+		m.oldValue = func(context.Context) (*Share, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// WhereP appends storage-level predicates to the PasskeyMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PasskeyMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Passkey, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ShareMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ShareMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	m.Where(p...)
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// Op returns the operation name.
-func (m *PasskeyMutation) Op() Op {
-	return m.op
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ShareMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// SetOp allows setting the mutation operation.
-func (m *PasskeyMutation) SetOp(op Op) {
-	m.op = op
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ShareMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Share.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// Type returns the node type of this mutation (Passkey).
-func (m *PasskeyMutation) Type() string {
-	return m.typ
+// SetCreatedAt sets the "created_at" field.
+func (m *ShareMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *PasskeyMutation) Fields() []string {
-	fields := make([]string, 0, 8)
-	if m.created_at != nil {
-		fields = append(fields, passkey.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, passkey.FieldUpdatedAt)
-	}
-	if m.deleted_at != nil {
-		fields = append(fields, passkey.FieldDeletedAt)
-	}
-	if m.user != nil {
-		fields = append(fields, passkey.FieldUserID)
-	}
-	if m.credential_id != nil {
-		fields = append(fields, passkey.FieldCredentialID)
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ShareMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	if m.name != nil {
-		fields = append(fields, passkey.FieldName)
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Share entity.
+// If the Share object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	if m.credential != nil {
-		fields = append(fields, passkey.FieldCredential)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
-	if m.used_at != nil {
-		fields = append(fields, passkey.FieldUsedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return fields
+	return oldValue.CreatedAt, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *PasskeyMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case passkey.FieldCreatedAt:
-		return m.CreatedAt()
-	case passkey.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case passkey.FieldDeletedAt:
-		return m.DeletedAt()
-	case passkey.FieldUserID:
-		return m.UserID()
-	case passkey.FieldCredentialID:
-		return m.CredentialID()
-	case passkey.FieldName:
-		return m.Name()
-	case passkey.FieldCredential:
-		return m.Credential()
-	case passkey.FieldUsedAt:
-		return m.UsedAt()
-	}
-	return nil, false
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ShareMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *PasskeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case passkey.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case passkey.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case passkey.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
-	case passkey.FieldUserID:
-		return m.OldUserID(ctx)
-	case passkey.FieldCredentialID:
-		return m.OldCredentialID(ctx)
-	case passkey.FieldName:
-		return m.OldName(ctx)
-	case passkey.FieldCredential:
-		return m.OldCredential(ctx)
-	case passkey.FieldUsedAt:
-		return m.OldUsedAt(ctx)
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ShareMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ShareMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown Passkey field %s", name)
+	return *v, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *PasskeyMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case passkey.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case passkey.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case passkey.FieldDeletedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDeletedAt(v)
-		return nil
-	case passkey.FieldUserID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUserID(v)
-		return nil
-	case passkey.FieldCredentialID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCredentialID(v)
-		return nil
-	case passkey.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case passkey.FieldCredential:
-		v, ok := value.(*webauthn.Credential)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCredential(v)
-		return nil
-	case passkey.FieldUsedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUsedAt(v)
-		return nil
+// OldUpdatedAt returns the old "updated_at" field's value of the Share entity.
+// If the Share object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Passkey field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *PasskeyMutation) AddedFields() []string {
-	var fields []string
-	return fields
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ShareMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *PasskeyMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
+// SetDeletedAt sets the "deleted_at" field.
+func (m *ShareMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *ShareMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
 	}
-	return nil, false
+	return *v, true
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *PasskeyMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// OldDeletedAt returns the old "deleted_at" field's value of the Share entity.
+// If the Share object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Passkey numeric field %s", name)
-}
-
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *PasskeyMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(passkey.FieldDeletedAt) {
-		fields = append(fields, passkey.FieldDeletedAt)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
 	}
-	if m.FieldCleared(passkey.FieldUsedAt) {
-		fields = append(fields, passkey.FieldUsedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
 	}
-	return fields
+	return oldValue.DeletedAt, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *PasskeyMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *ShareMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[share.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *ShareMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[share.FieldDeletedAt]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *PasskeyMutation) ClearField(name string) error {
-	switch name {
-	case passkey.FieldDeletedAt:
-		m.ClearDeletedAt()
-		return nil
-	case passkey.FieldUsedAt:
-		m.ClearUsedAt()
-		return nil
-	}
-	return fmt.Errorf("unknown Passkey nullable field %s", name)
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *ShareMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, share.FieldDeletedAt)
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *PasskeyMutation) ResetField(name string) error {
-	switch name {
-	case passkey.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case passkey.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case passkey.FieldDeletedAt:
-		m.ResetDeletedAt()
-		return nil
-	case passkey.FieldUserID:
-		m.ResetUserID()
-		return nil
-	case passkey.FieldCredentialID:
-		m.ResetCredentialID()
-		return nil
-	case passkey.FieldName:
-		m.ResetName()
-		return nil
-	case passkey.FieldCredential:
-		m.ResetCredential()
-		return nil
-	case passkey.FieldUsedAt:
-		m.ResetUsedAt()
-		return nil
-	}
-	return fmt.Errorf("unknown Passkey field %s", name)
+// SetPassword sets the "password" field.
+func (m *ShareMutation) SetPassword(s string) {
+	m.password = &s
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PasskeyMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.user != nil {
-		edges = append(edges, passkey.EdgeUser)
+// Password returns the value of the "password" field in the mutation.
+func (m *ShareMutation) Password() (r string, exists bool) {
+	v := m.password
+	if v == nil {
+		return
 	}
-	return edges
+	return *v, true
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *PasskeyMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case passkey.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
+// OldPassword returns the old "password" field's value of the Share entity.
+// If the Share object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareMutation) OldPassword(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
 	}
-	return nil
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPassword requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+	}
+	return oldValue.Password, nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PasskeyMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	return edges
+// ClearPassword clears the value of the "password" field.
+func (m *ShareMutation) ClearPassword() {
+	m.password = nil
+	m.clearedFields[share.FieldPassword] = struct{}{}
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *PasskeyMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// PasswordCleared returns if the "password" field was cleared in this mutation.
+func (m *ShareMutation) PasswordCleared() bool {
+	_, ok := m.clearedFields[share.FieldPassword]
+	return ok
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PasskeyMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.cleareduser {
-		edges = append(edges, passkey.EdgeUser)
-	}
-	return edges
+// ResetPassword resets all changes to the "password" field.
+func (m *ShareMutation) ResetPassword() {
+	m.password = nil
+	delete(m.clearedFields, share.FieldPassword)
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *PasskeyMutation) EdgeCleared(name string) bool {
-	switch name {
-	case passkey.EdgeUser:
-		return m.cleareduser
-	}
-	return false
+// SetViews sets the "views" field.
+func (m *ShareMutation) SetViews(i int) {
+	m.views = &i
+	m.addviews = nil
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *PasskeyMutation) ClearEdge(name string) error {
-	switch name {
-	case passkey.EdgeUser:
-		m.ClearUser()
-		return nil
+// Views returns the value of the "views" field in the mutation.
+func (m *ShareMutation) Views() (r int, exists bool) {
+	v := m.views
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Passkey unique edge %s", name)
+	return *v, true
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *PasskeyMutation) ResetEdge(name string) error {
-	switch name {
-	case passkey.EdgeUser:
-		m.ResetUser()
-		return nil
+// OldViews returns the old "views" field's value of the Share entity.
+// If the Share object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareMutation) OldViews(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldViews is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Passkey edge %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldViews requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldViews: %w", err)
+	}
+	return oldValue.Views, nil
 }
 
-// SettingMutation represents an operation that mutates the Setting nodes in the graph.
-type SettingMutation struct {
-	config
-	op            Op
-	typ           string
-	id            *int
-	created_at    *time.Time
-	updated_at    *time.Time
-	deleted_at    *time.Time
-	name          *string
-	value         *string
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*Setting, error)
-	predicates    []predicate.Setting
+// AddViews adds i to the "views" field.
+func (m *ShareMutation) AddViews(i int) {
+	if m.addviews != nil {
+		*m.addviews += i
+	} else {
+		m.addviews = &i
+	}
 }
 
-var _ ent.Mutation = (*SettingMutation)(nil)
-
-// settingOption allows management of the mutation configuration using functional options.
-type settingOption func(*SettingMutation)
-
-// newSettingMutation creates new mutation for the Setting entity.
-func newSettingMutation(c config, op Op, opts ...settingOption) *SettingMutation {
-	m := &SettingMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeSetting,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
+// AddedViews returns the value that was added to the "views" field in this mutation.
+func (m *ShareMutation) AddedViews() (r int, exists bool) {
+	v := m.addviews
+	if v == nil {
+		return
 	}
-	return m
+	return *v, true
 }
 
-// withSettingID sets the ID field of the mutation.
-func withSettingID(id int) settingOption {
-	return func(m *SettingMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Setting
-		)
-		m.oldValue = func(ctx context.Context) (*Setting, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Setting.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
-	}
+// ResetViews resets all changes to the "views" field.
+func (m *ShareMutation) ResetViews() {
+	m.views = nil
+	m.addviews = nil
 }
 
-// withSetting sets the old Setting of the mutation.
-func withSetting(node *Setting) settingOption {
-	return func(m *SettingMutation) {
-		m.oldValue = func(context.Context) (*Setting, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// SetDownloads sets the "downloads" field.
+func (m *ShareMutation) SetDownloads(i int) {
+	m.downloads = &i
+	m.adddownloads = nil
+}
+
+// Downloads returns the value of the "downloads" field in the mutation.
+func (m *ShareMutation) Downloads() (r int, exists bool) {
+	v := m.downloads
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SettingMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// OldDownloads returns the old "downloads" field's value of the Share entity.
+// If the Share object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareMutation) OldDownloads(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDownloads is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDownloads requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDownloads: %w", err)
+	}
+	return oldValue.Downloads, nil
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m SettingMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// AddDownloads adds i to the "downloads" field.
+func (m *ShareMutation) AddDownloads(i int) {
+	if m.adddownloads != nil {
+		*m.adddownloads += i
+	} else {
+		m.adddownloads = &i
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *SettingMutation) ID() (id int, exists bool) {
-	if m.id == nil {
+// AddedDownloads returns the value that was added to the "downloads" field in this mutation.
+func (m *ShareMutation) AddedDownloads() (r int, exists bool) {
+	v := m.adddownloads
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *SettingMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Setting.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ResetDownloads resets all changes to the "downloads" field.
+func (m *ShareMutation) ResetDownloads() {
+	m.downloads = nil
+	m.adddownloads = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *SettingMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetExpires sets the "expires" field.
+func (m *ShareMutation) SetExpires(t time.Time) {
+	m.expires = &t
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *SettingMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Expires returns the value of the "expires" field in the mutation.
+func (m *ShareMutation) Expires() (r time.Time, exists bool) {
+	v := m.expires
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Setting entity.
-// If the Setting object wasn't provided to the builder, the object is fetched from the database.
+// OldExpires returns the old "expires" field's value of the Share entity.
+// If the Share object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ShareMutation) OldExpires(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpires is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldExpires requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpires: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Expires, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *SettingMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearExpires clears the value of the "expires" field.
+func (m *ShareMutation) ClearExpires() {
+	m.expires = nil
+	m.clearedFields[share.FieldExpires] = struct{}{}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *SettingMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// ExpiresCleared returns if the "expires" field was cleared in this mutation.
+func (m *ShareMutation) ExpiresCleared() bool {
+	_, ok := m.clearedFields[share.FieldExpires]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *SettingMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetExpires resets all changes to the "expires" field.
+func (m *ShareMutation) ResetExpires() {
+	m.expires = nil
+	delete(m.clearedFields, share.FieldExpires)
+}
+
+// SetRemainDownloads sets the "remain_downloads" field.
+func (m *ShareMutation) SetRemainDownloads(i int) {
+	m.remain_downloads = &i
+	m.addremain_downloads = nil
+}
+
+// RemainDownloads returns the value of the "remain_downloads" field in the mutation.
+func (m *ShareMutation) RemainDownloads() (r int, exists bool) {
+	v := m.remain_downloads
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Setting entity.
-// If the Setting object wasn't provided to the builder, the object is fetched from the database.
+// OldRemainDownloads returns the old "remain_downloads" field's value of the Share entity.
+// If the Share object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ShareMutation) OldRemainDownloads(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldRemainDownloads is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldRemainDownloads requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldRemainDownloads: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.RemainDownloads, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *SettingMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// AddRemainDownloads adds i to the "remain_downloads" field.
+func (m *ShareMutation) AddRemainDownloads(i int) {
+	if m.addremain_downloads != nil {
+		*m.addremain_downloads += i
+	} else {
+		m.addremain_downloads = &i
+	}
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *SettingMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
+// AddedRemainDownloads returns the value that was added to the "remain_downloads" field in this mutation.
+func (m *ShareMutation) AddedRemainDownloads() (r int, exists bool) {
+	v := m.addremain_downloads
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *SettingMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
+// ClearRemainDownloads clears the value of the "remain_downloads" field.
+func (m *ShareMutation) ClearRemainDownloads() {
+	m.remain_downloads = nil
+	m.addremain_downloads = nil
+	m.clearedFields[share.FieldRemainDownloads] = struct{}{}
+}
+
+// RemainDownloadsCleared returns if the "remain_downloads" field was cleared in this mutation.
+func (m *ShareMutation) RemainDownloadsCleared() bool {
+	_, ok := m.clearedFields[share.FieldRemainDownloads]
+	return ok
+}
+
+// ResetRemainDownloads resets all changes to the "remain_downloads" field.
+func (m *ShareMutation) ResetRemainDownloads() {
+	m.remain_downloads = nil
+	m.addremain_downloads = nil
+	delete(m.clearedFields, share.FieldRemainDownloads)
+}
+
+// SetProps sets the "props" field.
+func (m *ShareMutation) SetProps(tp *types.ShareProps) {
+	m.props = &tp
+}
+
+// Props returns the value of the "props" field in the mutation.
+func (m *ShareMutation) Props() (r *types.ShareProps, exists bool) {
+	v := m.props
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the Setting entity.
-// If the Setting object wasn't provided to the builder, the object is fetched from the database.
+// OldProps returns the old "props" field's value of the Share entity.
+// If the Share object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *ShareMutation) OldProps(ctx context.Context) (v *types.ShareProps, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldProps is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+		return v, errors.New("OldProps requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldProps: %w", err)
 	}
-	return oldValue.DeletedAt, nil
+	return oldValue.Props, nil
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *SettingMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[setting.FieldDeletedAt] = struct{}{}
+// ClearProps clears the value of the "props" field.
+func (m *ShareMutation) ClearProps() {
+	m.props = nil
+	m.clearedFields[share.FieldProps] = struct{}{}
 }
 
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *SettingMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[setting.FieldDeletedAt]
+// PropsCleared returns if the "props" field was cleared in this mutation.
+func (m *ShareMutation) PropsCleared() bool {
+	_, ok := m.clearedFields[share.FieldProps]
 	return ok
 }
 
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *SettingMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, setting.FieldDeletedAt)
+// ResetProps resets all changes to the "props" field.
+func (m *ShareMutation) ResetProps() {
+	m.props = nil
+	delete(m.clearedFields, share.FieldProps)
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *ShareMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *ShareMutation) ClearUser() {
+	m.cleareduser = true
 }
 
-// SetName sets the "name" field.
-func (m *SettingMutation) SetName(s string) {
-	m.name = &s
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *ShareMutation) UserCleared() bool {
+	return m.cleareduser
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *SettingMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
+// UserID returns the "user" edge ID in the mutation.
+func (m *ShareMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
 	}
-	return *v, true
+	return
 }
 
-// OldName returns the old "name" field's value of the Setting entity.
-// If the Setting object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *ShareMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.Name, nil
+	return
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *SettingMutation) ResetName() {
-	m.name = nil
+// ResetUser resets all changes to the "user" edge.
+func (m *ShareMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// SetValue sets the "value" field.
-func (m *SettingMutation) SetValue(s string) {
-	m.value = &s
+// SetFileID sets the "file" edge to the File entity by id.
+func (m *ShareMutation) SetFileID(id int) {
+	m.file = &id
 }
 
-// Value returns the value of the "value" field in the mutation.
-func (m *SettingMutation) Value() (r string, exists bool) {
-	v := m.value
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearFile clears the "file" edge to the File entity.
+func (m *ShareMutation) ClearFile() {
+	m.clearedfile = true
 }
 
-// OldValue returns the old "value" field's value of the Setting entity.
-// If the Setting object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingMutation) OldValue(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldValue is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldValue requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldValue: %w", err)
-	}
-	return oldValue.Value, nil
+// FileCleared reports if the "file" edge to the File entity was cleared.
+func (m *ShareMutation) FileCleared() bool {
+	return m.clearedfile
 }
 
-// ClearValue clears the value of the "value" field.
-func (m *SettingMutation) ClearValue() {
-	m.value = nil
-	m.clearedFields[setting.FieldValue] = struct{}{}
+// FileID returns the "file" edge ID in the mutation.
+func (m *ShareMutation) FileID() (id int, exists bool) {
+	if m.file != nil {
+		return *m.file, true
+	}
+	return
 }
 
-// ValueCleared returns if the "value" field was cleared in this mutation.
-func (m *SettingMutation) ValueCleared() bool {
-	_, ok := m.clearedFields[setting.FieldValue]
-	return ok
+// FileIDs returns the "file" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FileID instead. It exists only for internal usage by the builders.
+func (m *ShareMutation) FileIDs() (ids []int) {
+	if id := m.file; id != nil {
+		ids = append(ids, *id)
+	}
+	return
 }
 
-// ResetValue resets all changes to the "value" field.
-func (m *SettingMutation) ResetValue() {
-	m.value = nil
-	delete(m.clearedFields, setting.FieldValue)
+// ResetFile resets all changes to the "file" edge.
+func (m *ShareMutation) ResetFile() {
+	m.file = nil
+	m.clearedfile = false
 }
 
-// Where appends a list predicates to the SettingMutation builder.
-func (m *SettingMutation) Where(ps ...predicate.Setting) {
+// Where appends a list predicates to the ShareMutation builder.
+func (m *ShareMutation) Where(ps ...predicate.Share) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SettingMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ShareMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SettingMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Setting, len(ps))
+func (m *ShareMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Share, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -9331,39 +14865,51 @@ func (m *SettingMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SettingMutation) Op() Op {
+func (m *ShareMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SettingMutation) SetOp(op Op) {
+func (m *ShareMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Setting).
-func (m *SettingMutation) Type() string {
+// Type returns the node type of this mutation (Share).
+func (m *ShareMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SettingMutation) Fields() []string {
-	fields := make([]string, 0, 5)
+func (m *ShareMutation) Fields() []string {
+	fields := make([]string, 0, 9)
 	if m.created_at != nil {
-		fields = append(fields, setting.FieldCreatedAt)
+		fields = append(fields, share.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, setting.FieldUpdatedAt)
+		fields = append(fields, share.FieldUpdatedAt)
 	}
 	if m.deleted_at != nil {
-		fields = append(fields, setting.FieldDeletedAt)
+		fields = append(fields, share.FieldDeletedAt)
 	}
-	if m.name != nil {
-		fields = append(fields, setting.FieldName)
+	if m.password != nil {
+		fields = append(fields, share.FieldPassword)
 	}
-	if m.value != nil {
-		fields = append(fields, setting.FieldValue)
+	if m.views != nil {
+		fields = append(fields, share.FieldViews)
+	}
+	if m.downloads != nil {
+		fields = append(fields, share.FieldDownloads)
+	}
+	if m.expires != nil {
+		fields = append(fields, share.FieldExpires)
+	}
+	if m.remain_downloads != nil {
+		fields = append(fields, share.FieldRemainDownloads)
+	}
+	if m.props != nil {
+		fields = append(fields, share.FieldProps)
 	}
 	return fields
 }
@@ -9371,18 +14917,26 @@ func (m *SettingMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SettingMutation) Field(name string) (ent.Value, bool) {
+func (m *ShareMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case setting.FieldCreatedAt:
+	case share.FieldCreatedAt:
 		return m.CreatedAt()
-	case setting.FieldUpdatedAt:
+	case share.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case setting.FieldDeletedAt:
+	case share.FieldDeletedAt:
 		return m.DeletedAt()
-	case setting.FieldName:
-		return m.Name()
-	case setting.FieldValue:
-		return m.Value()
+	case share.FieldPassword:
+		return m.Password()
+	case share.FieldViews:
+		return m.Views()
+	case share.FieldDownloads:
+		return m.Downloads()
+	case share.FieldExpires:
+		return m.Expires()
+	case share.FieldRemainDownloads:
+		return m.RemainDownloads()
+	case share.FieldProps:
+		return m.Props()
 	}
 	return nil, false
 }
@@ -9390,232 +14944,381 @@ func (m *SettingMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SettingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ShareMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case setting.FieldCreatedAt:
+	case share.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case setting.FieldUpdatedAt:
+	case share.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case setting.FieldDeletedAt:
+	case share.FieldDeletedAt:
 		return m.OldDeletedAt(ctx)
-	case setting.FieldName:
-		return m.OldName(ctx)
-	case setting.FieldValue:
-		return m.OldValue(ctx)
+	case share.FieldPassword:
+		return m.OldPassword(ctx)
+	case share.FieldViews:
+		return m.OldViews(ctx)
+	case share.FieldDownloads:
+		return m.OldDownloads(ctx)
+	case share.FieldExpires:
+		return m.OldExpires(ctx)
+	case share.FieldRemainDownloads:
+		return m.OldRemainDownloads(ctx)
+	case share.FieldProps:
+		return m.OldProps(ctx)
 	}
-	return nil, fmt.Errorf("unknown Setting field %s", name)
+	return nil, fmt.Errorf("unknown Share field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SettingMutation) SetField(name string, value ent.Value) error {
+func (m *ShareMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case setting.FieldCreatedAt:
+	case share.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case setting.FieldUpdatedAt:
+	case share.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case setting.FieldDeletedAt:
+	case share.FieldDeletedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDeletedAt(v)
 		return nil
-	case setting.FieldName:
+	case share.FieldPassword:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetPassword(v)
 		return nil
-	case setting.FieldValue:
-		v, ok := value.(string)
+	case share.FieldViews:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetValue(v)
+		m.SetViews(v)
+		return nil
+	case share.FieldDownloads:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDownloads(v)
+		return nil
+	case share.FieldExpires:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpires(v)
+		return nil
+	case share.FieldRemainDownloads:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRemainDownloads(v)
+		return nil
+	case share.FieldProps:
+		v, ok := value.(*types.ShareProps)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProps(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Setting field %s", name)
+	return fmt.Errorf("unknown Share field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SettingMutation) AddedFields() []string {
-	return nil
+func (m *ShareMutation) AddedFields() []string {
+	var fields []string
+	if m.addviews != nil {
+		fields = append(fields, share.FieldViews)
+	}
+	if m.adddownloads != nil {
+		fields = append(fields, share.FieldDownloads)
+	}
+	if m.addremain_downloads != nil {
+		fields = append(fields, share.FieldRemainDownloads)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SettingMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ShareMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case share.FieldViews:
+		return m.AddedViews()
+	case share.FieldDownloads:
+		return m.AddedDownloads()
+	case share.FieldRemainDownloads:
+		return m.AddedRemainDownloads()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SettingMutation) AddField(name string, value ent.Value) error {
+func (m *ShareMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case share.FieldViews:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddViews(v)
+		return nil
+	case share.FieldDownloads:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDownloads(v)
+		return nil
+	case share.FieldRemainDownloads:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRemainDownloads(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Setting numeric field %s", name)
+	return fmt.Errorf("unknown Share numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SettingMutation) ClearedFields() []string {
+func (m *ShareMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(setting.FieldDeletedAt) {
-		fields = append(fields, setting.FieldDeletedAt)
+	if m.FieldCleared(share.FieldDeletedAt) {
+		fields = append(fields, share.FieldDeletedAt)
 	}
-	if m.FieldCleared(setting.FieldValue) {
-		fields = append(fields, setting.FieldValue)
+	if m.FieldCleared(share.FieldPassword) {
+		fields = append(fields, share.FieldPassword)
+	}
+	if m.FieldCleared(share.FieldExpires) {
+		fields = append(fields, share.FieldExpires)
+	}
+	if m.FieldCleared(share.FieldRemainDownloads) {
+		fields = append(fields, share.FieldRemainDownloads)
+	}
+	if m.FieldCleared(share.FieldProps) {
+		fields = append(fields, share.FieldProps)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SettingMutation) FieldCleared(name string) bool {
+func (m *ShareMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SettingMutation) ClearField(name string) error {
+func (m *ShareMutation) ClearField(name string) error {
 	switch name {
-	case setting.FieldDeletedAt:
+	case share.FieldDeletedAt:
 		m.ClearDeletedAt()
 		return nil
-	case setting.FieldValue:
-		m.ClearValue()
+	case share.FieldPassword:
+		m.ClearPassword()
+		return nil
+	case share.FieldExpires:
+		m.ClearExpires()
+		return nil
+	case share.FieldRemainDownloads:
+		m.ClearRemainDownloads()
+		return nil
+	case share.FieldProps:
+		m.ClearProps()
 		return nil
 	}
-	return fmt.Errorf("unknown Setting nullable field %s", name)
+	return fmt.Errorf("unknown Share nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SettingMutation) ResetField(name string) error {
+func (m *ShareMutation) ResetField(name string) error {
 	switch name {
-	case setting.FieldCreatedAt:
+	case share.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case setting.FieldUpdatedAt:
+	case share.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case setting.FieldDeletedAt:
+	case share.FieldDeletedAt:
 		m.ResetDeletedAt()
 		return nil
-	case setting.FieldName:
-		m.ResetName()
+	case share.FieldPassword:
+		m.ResetPassword()
 		return nil
-	case setting.FieldValue:
-		m.ResetValue()
+	case share.FieldViews:
+		m.ResetViews()
+		return nil
+	case share.FieldDownloads:
+		m.ResetDownloads()
+		return nil
+	case share.FieldExpires:
+		m.ResetExpires()
+		return nil
+	case share.FieldRemainDownloads:
+		m.ResetRemainDownloads()
+		return nil
+	case share.FieldProps:
+		m.ResetProps()
 		return nil
 	}
-	return fmt.Errorf("unknown Setting field %s", name)
+	return fmt.Errorf("unknown Share field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SettingMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *ShareMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, share.EdgeUser)
+	}
+	if m.file != nil {
+		edges = append(edges, share.EdgeFile)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SettingMutation) AddedIDs(name string) []ent.Value {
+func (m *ShareMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case share.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case share.EdgeFile:
+		if id := m.file; id != nil {
+			return []ent.Value{*id}
+		}
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SettingMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *ShareMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SettingMutation) RemovedIDs(name string) []ent.Value {
+func (m *ShareMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SettingMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *ShareMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, share.EdgeUser)
+	}
+	if m.clearedfile {
+		edges = append(edges, share.EdgeFile)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SettingMutation) EdgeCleared(name string) bool {
+func (m *ShareMutation) EdgeCleared(name string) bool {
+	switch name {
+	case share.EdgeUser:
+		return m.cleareduser
+	case share.EdgeFile:
+		return m.clearedfile
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SettingMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Setting unique edge %s", name)
+func (m *ShareMutation) ClearEdge(name string) error {
+	switch name {
+	case share.EdgeUser:
+		m.ClearUser()
+		return nil
+	case share.EdgeFile:
+		m.ClearFile()
+		return nil
+	}
+	return fmt.Errorf("unknown Share unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SettingMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Setting edge %s", name)
+func (m *ShareMutation) ResetEdge(name string) error {
+	switch name {
+	case share.EdgeUser:
+		m.ResetUser()
+		return nil
+	case share.EdgeFile:
+		m.ResetFile()
+		return nil
+	}
+	return fmt.Errorf("unknown Share edge %s", name)
 }
 
-// ShareMutation represents an operation that mutates the Share nodes in the graph.
-type ShareMutation struct {
+// SnapshotMutation represents an operation that mutates the Snapshot nodes in the graph.
+type SnapshotMutation struct {
 	config
-	op                  Op
-	typ                 string
-	id                  *int
-	created_at          *time.Time
-	updated_at          *time.Time
-	deleted_at          *time.Time
-	password            *string
-	views               *int
-	addviews            *int
-	downloads           *int
-	adddownloads        *int
-	expires             *time.Time
-	remain_downloads    *int
-	addremain_downloads *int
-	props               **types.ShareProps
-	clearedFields       map[string]struct{}
-	user                *int
-	cleareduser         bool
-	file                *int
-	clearedfile         bool
-	done                bool
-	oldValue            func(context.Context) (*Share, error)
-	predicates          []predicate.Share
+	op             Op
+	typ            string
+	id             *int
+	created_at     *time.Time
+	updated_at     *time.Time
+	deleted_at     *time.Time
+	name           *string
+	file_id        *int
+	addfile_id     *int
+	root_uri       *string
+	files          *int
+	addfiles       *int
+	size           *int64
+	addsize        *int64
+	scheduled      *bool
+	manifest       *[]types.SnapshotNode
+	appendmanifest []types.SnapshotNode
+	clearedFields  map[string]struct{}
+	owner          *int
+	clearedowner   bool
+	done           bool
+	oldValue       func(context.Context) (*Snapshot, error)
+	predicates     []predicate.Snapshot
 }
 
-var _ ent.Mutation = (*ShareMutation)(nil)
+var _ ent.Mutation = (*SnapshotMutation)(nil)
 
-// shareOption allows management of the mutation configuration using functional options.
-type shareOption func(*ShareMutation)
+// snapshotOption allows management of the mutation configuration using functional options.
+type snapshotOption func(*SnapshotMutation)
 
-// newShareMutation creates new mutation for the Share entity.
-func newShareMutation(c config, op Op, opts ...shareOption) *ShareMutation {
-	m := &ShareMutation{
+// newSnapshotMutation creates new mutation for the Snapshot entity.
+func newSnapshotMutation(c config, op Op, opts ...snapshotOption) *SnapshotMutation {
+	m := &SnapshotMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeShare,
+		typ:           TypeSnapshot,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -9624,20 +15327,20 @@ func newShareMutation(c config, op Op, opts ...shareOption) *ShareMutation {
 	return m
 }
 
-// withShareID sets the ID field of the mutation.
-func withShareID(id int) shareOption {
-	return func(m *ShareMutation) {
+// withSnapshotID sets the ID field of the mutation.
+func withSnapshotID(id int) snapshotOption {
+	return func(m *SnapshotMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Share
+			value *Snapshot
 		)
-		m.oldValue = func(ctx context.Context) (*Share, error) {
+		m.oldValue = func(ctx context.Context) (*Snapshot, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Share.Get(ctx, id)
+					value, err = m.Client().Snapshot.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -9646,10 +15349,10 @@ func withShareID(id int) shareOption {
 	}
 }
 
-// withShare sets the old Share of the mutation.
-func withShare(node *Share) shareOption {
-	return func(m *ShareMutation) {
-		m.oldValue = func(context.Context) (*Share, error) {
+// withSnapshot sets the old Snapshot of the mutation.
+func withSnapshot(node *Snapshot) snapshotOption {
+	return func(m *SnapshotMutation) {
+		m.oldValue = func(context.Context) (*Snapshot, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -9658,7 +15361,7 @@ func withShare(node *Share) shareOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ShareMutation) Client() *Client {
+func (m SnapshotMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -9666,7 +15369,7 @@ func (m ShareMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ShareMutation) Tx() (*Tx, error) {
+func (m SnapshotMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -9677,7 +15380,7 @@ func (m ShareMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ShareMutation) ID() (id int, exists bool) {
+func (m *SnapshotMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -9688,7 +15391,7 @@ func (m *ShareMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ShareMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *SnapshotMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -9697,19 +15400,19 @@ func (m *ShareMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Share.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Snapshot.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *ShareMutation) SetCreatedAt(t time.Time) {
+func (m *SnapshotMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ShareMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *SnapshotMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -9717,10 +15420,10 @@ func (m *ShareMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Share entity.
-// If the Share object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ShareMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SnapshotMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -9735,28 +15438,28 @@ func (m *ShareMutation) OldCreatedAt(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ShareMutation) ResetCreatedAt() {
+func (m *SnapshotMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *ShareMutation) SetUpdatedAt(t time.Time) {
+func (m *SnapshotMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ShareMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *SnapshotMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
-
-// OldUpdatedAt returns the old "updated_at" field's value of the Share entity.
-// If the Share object wasn't provided to the builder, the object is fetched from the database.
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ShareMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SnapshotMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -9771,17 +15474,17 @@ func (m *ShareMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ShareMutation) ResetUpdatedAt() {
+func (m *SnapshotMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // SetDeletedAt sets the "deleted_at" field.
-func (m *ShareMutation) SetDeletedAt(t time.Time) {
+func (m *SnapshotMutation) SetDeletedAt(t time.Time) {
 	m.deleted_at = &t
 }
 
 // DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *ShareMutation) DeletedAt() (r time.Time, exists bool) {
+func (m *SnapshotMutation) DeletedAt() (r time.Time, exists bool) {
 	v := m.deleted_at
 	if v == nil {
 		return
@@ -9789,10 +15492,10 @@ func (m *ShareMutation) DeletedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the Share entity.
-// If the Share object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedAt returns the old "deleted_at" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ShareMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *SnapshotMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
@@ -9807,439 +15510,436 @@ func (m *ShareMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err err
 }
 
 // ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *ShareMutation) ClearDeletedAt() {
+func (m *SnapshotMutation) ClearDeletedAt() {
 	m.deleted_at = nil
-	m.clearedFields[share.FieldDeletedAt] = struct{}{}
+	m.clearedFields[snapshot.FieldDeletedAt] = struct{}{}
 }
 
 // DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *ShareMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[share.FieldDeletedAt]
+func (m *SnapshotMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[snapshot.FieldDeletedAt]
 	return ok
 }
 
 // ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *ShareMutation) ResetDeletedAt() {
+func (m *SnapshotMutation) ResetDeletedAt() {
 	m.deleted_at = nil
-	delete(m.clearedFields, share.FieldDeletedAt)
+	delete(m.clearedFields, snapshot.FieldDeletedAt)
 }
 
-// SetPassword sets the "password" field.
-func (m *ShareMutation) SetPassword(s string) {
-	m.password = &s
+// SetName sets the "name" field.
+func (m *SnapshotMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Password returns the value of the "password" field in the mutation.
-func (m *ShareMutation) Password() (r string, exists bool) {
-	v := m.password
+// Name returns the value of the "name" field in the mutation.
+func (m *SnapshotMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPassword returns the old "password" field's value of the Share entity.
-// If the Share object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ShareMutation) OldPassword(ctx context.Context) (v string, err error) {
+func (m *SnapshotMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPassword requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Password, nil
-}
-
-// ClearPassword clears the value of the "password" field.
-func (m *ShareMutation) ClearPassword() {
-	m.password = nil
-	m.clearedFields[share.FieldPassword] = struct{}{}
-}
-
-// PasswordCleared returns if the "password" field was cleared in this mutation.
-func (m *ShareMutation) PasswordCleared() bool {
-	_, ok := m.clearedFields[share.FieldPassword]
-	return ok
+	return oldValue.Name, nil
 }
 
-// ResetPassword resets all changes to the "password" field.
-func (m *ShareMutation) ResetPassword() {
-	m.password = nil
-	delete(m.clearedFields, share.FieldPassword)
+// ResetName resets all changes to the "name" field.
+func (m *SnapshotMutation) ResetName() {
+	m.name = nil
 }
 
-// SetViews sets the "views" field.
-func (m *ShareMutation) SetViews(i int) {
-	m.views = &i
-	m.addviews = nil
+// SetFileID sets the "file_id" field.
+func (m *SnapshotMutation) SetFileID(i int) {
+	m.file_id = &i
+	m.addfile_id = nil
 }
 
-// Views returns the value of the "views" field in the mutation.
-func (m *ShareMutation) Views() (r int, exists bool) {
-	v := m.views
+// FileID returns the value of the "file_id" field in the mutation.
+func (m *SnapshotMutation) FileID() (r int, exists bool) {
+	v := m.file_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldViews returns the old "views" field's value of the Share entity.
-// If the Share object wasn't provided to the builder, the object is fetched from the database.
+// OldFileID returns the old "file_id" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ShareMutation) OldViews(ctx context.Context) (v int, err error) {
+func (m *SnapshotMutation) OldFileID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldViews is only allowed on UpdateOne operations")
+		return v, errors.New("OldFileID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldViews requires an ID field in the mutation")
+		return v, errors.New("OldFileID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldViews: %w", err)
+		return v, fmt.Errorf("querying old value for OldFileID: %w", err)
 	}
-	return oldValue.Views, nil
+	return oldValue.FileID, nil
 }
 
-// AddViews adds i to the "views" field.
-func (m *ShareMutation) AddViews(i int) {
-	if m.addviews != nil {
-		*m.addviews += i
+// AddFileID adds i to the "file_id" field.
+func (m *SnapshotMutation) AddFileID(i int) {
+	if m.addfile_id != nil {
+		*m.addfile_id += i
 	} else {
-		m.addviews = &i
+		m.addfile_id = &i
 	}
 }
 
-// AddedViews returns the value that was added to the "views" field in this mutation.
-func (m *ShareMutation) AddedViews() (r int, exists bool) {
-	v := m.addviews
+// AddedFileID returns the value that was added to the "file_id" field in this mutation.
+func (m *SnapshotMutation) AddedFileID() (r int, exists bool) {
+	v := m.addfile_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetViews resets all changes to the "views" field.
-func (m *ShareMutation) ResetViews() {
-	m.views = nil
-	m.addviews = nil
+// ResetFileID resets all changes to the "file_id" field.
+func (m *SnapshotMutation) ResetFileID() {
+	m.file_id = nil
+	m.addfile_id = nil
 }
 
-// SetDownloads sets the "downloads" field.
-func (m *ShareMutation) SetDownloads(i int) {
-	m.downloads = &i
-	m.adddownloads = nil
+// SetRootURI sets the "root_uri" field.
+func (m *SnapshotMutation) SetRootURI(s string) {
+	m.root_uri = &s
 }
 
-// Downloads returns the value of the "downloads" field in the mutation.
-func (m *ShareMutation) Downloads() (r int, exists bool) {
-	v := m.downloads
+// RootURI returns the value of the "root_uri" field in the mutation.
+func (m *SnapshotMutation) RootURI() (r string, exists bool) {
+	v := m.root_uri
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDownloads returns the old "downloads" field's value of the Share entity.
-// If the Share object wasn't provided to the builder, the object is fetched from the database.
+// OldRootURI returns the old "root_uri" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ShareMutation) OldDownloads(ctx context.Context) (v int, err error) {
+func (m *SnapshotMutation) OldRootURI(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDownloads is only allowed on UpdateOne operations")
+		return v, errors.New("OldRootURI is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDownloads requires an ID field in the mutation")
+		return v, errors.New("OldRootURI requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDownloads: %w", err)
-	}
-	return oldValue.Downloads, nil
-}
-
-// AddDownloads adds i to the "downloads" field.
-func (m *ShareMutation) AddDownloads(i int) {
-	if m.adddownloads != nil {
-		*m.adddownloads += i
-	} else {
-		m.adddownloads = &i
-	}
-}
-
-// AddedDownloads returns the value that was added to the "downloads" field in this mutation.
-func (m *ShareMutation) AddedDownloads() (r int, exists bool) {
-	v := m.adddownloads
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldRootURI: %w", err)
 	}
-	return *v, true
+	return oldValue.RootURI, nil
 }
 
-// ResetDownloads resets all changes to the "downloads" field.
-func (m *ShareMutation) ResetDownloads() {
-	m.downloads = nil
-	m.adddownloads = nil
+// ResetRootURI resets all changes to the "root_uri" field.
+func (m *SnapshotMutation) ResetRootURI() {
+	m.root_uri = nil
 }
 
-// SetExpires sets the "expires" field.
-func (m *ShareMutation) SetExpires(t time.Time) {
-	m.expires = &t
+// SetFiles sets the "files" field.
+func (m *SnapshotMutation) SetFiles(i int) {
+	m.files = &i
+	m.addfiles = nil
 }
 
-// Expires returns the value of the "expires" field in the mutation.
-func (m *ShareMutation) Expires() (r time.Time, exists bool) {
-	v := m.expires
+// Files returns the value of the "files" field in the mutation.
+func (m *SnapshotMutation) Files() (r int, exists bool) {
+	v := m.files
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExpires returns the old "expires" field's value of the Share entity.
-// If the Share object wasn't provided to the builder, the object is fetched from the database.
+// OldFiles returns the old "files" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ShareMutation) OldExpires(ctx context.Context) (v *time.Time, err error) {
+func (m *SnapshotMutation) OldFiles(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpires is only allowed on UpdateOne operations")
+		return v, errors.New("OldFiles is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpires requires an ID field in the mutation")
+		return v, errors.New("OldFiles requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpires: %w", err)
+		return v, fmt.Errorf("querying old value for OldFiles: %w", err)
 	}
-	return oldValue.Expires, nil
+	return oldValue.Files, nil
 }
 
-// ClearExpires clears the value of the "expires" field.
-func (m *ShareMutation) ClearExpires() {
-	m.expires = nil
-	m.clearedFields[share.FieldExpires] = struct{}{}
+// AddFiles adds i to the "files" field.
+func (m *SnapshotMutation) AddFiles(i int) {
+	if m.addfiles != nil {
+		*m.addfiles += i
+	} else {
+		m.addfiles = &i
+	}
 }
 
-// ExpiresCleared returns if the "expires" field was cleared in this mutation.
-func (m *ShareMutation) ExpiresCleared() bool {
-	_, ok := m.clearedFields[share.FieldExpires]
-	return ok
+// AddedFiles returns the value that was added to the "files" field in this mutation.
+func (m *SnapshotMutation) AddedFiles() (r int, exists bool) {
+	v := m.addfiles
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetExpires resets all changes to the "expires" field.
-func (m *ShareMutation) ResetExpires() {
-	m.expires = nil
-	delete(m.clearedFields, share.FieldExpires)
+// ResetFiles resets all changes to the "files" field.
+func (m *SnapshotMutation) ResetFiles() {
+	m.files = nil
+	m.addfiles = nil
 }
 
-// SetRemainDownloads sets the "remain_downloads" field.
-func (m *ShareMutation) SetRemainDownloads(i int) {
-	m.remain_downloads = &i
-	m.addremain_downloads = nil
+// SetSize sets the "size" field.
+func (m *SnapshotMutation) SetSize(i int64) {
+	m.size = &i
+	m.addsize = nil
 }
 
-// RemainDownloads returns the value of the "remain_downloads" field in the mutation.
-func (m *ShareMutation) RemainDownloads() (r int, exists bool) {
-	v := m.remain_downloads
+// Size returns the value of the "size" field in the mutation.
+func (m *SnapshotMutation) Size() (r int64, exists bool) {
+	v := m.size
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRemainDownloads returns the old "remain_downloads" field's value of the Share entity.
-// If the Share object wasn't provided to the builder, the object is fetched from the database.
+// OldSize returns the old "size" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ShareMutation) OldRemainDownloads(ctx context.Context) (v *int, err error) {
+func (m *SnapshotMutation) OldSize(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRemainDownloads is only allowed on UpdateOne operations")
+		return v, errors.New("OldSize is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRemainDownloads requires an ID field in the mutation")
+		return v, errors.New("OldSize requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRemainDownloads: %w", err)
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
 	}
-	return oldValue.RemainDownloads, nil
+	return oldValue.Size, nil
 }
 
-// AddRemainDownloads adds i to the "remain_downloads" field.
-func (m *ShareMutation) AddRemainDownloads(i int) {
-	if m.addremain_downloads != nil {
-		*m.addremain_downloads += i
+// AddSize adds i to the "size" field.
+func (m *SnapshotMutation) AddSize(i int64) {
+	if m.addsize != nil {
+		*m.addsize += i
 	} else {
-		m.addremain_downloads = &i
+		m.addsize = &i
 	}
 }
 
-// AddedRemainDownloads returns the value that was added to the "remain_downloads" field in this mutation.
-func (m *ShareMutation) AddedRemainDownloads() (r int, exists bool) {
-	v := m.addremain_downloads
+// AddedSize returns the value that was added to the "size" field in this mutation.
+func (m *SnapshotMutation) AddedSize() (r int64, exists bool) {
+	v := m.addsize
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearRemainDownloads clears the value of the "remain_downloads" field.
-func (m *ShareMutation) ClearRemainDownloads() {
-	m.remain_downloads = nil
-	m.addremain_downloads = nil
-	m.clearedFields[share.FieldRemainDownloads] = struct{}{}
-}
-
-// RemainDownloadsCleared returns if the "remain_downloads" field was cleared in this mutation.
-func (m *ShareMutation) RemainDownloadsCleared() bool {
-	_, ok := m.clearedFields[share.FieldRemainDownloads]
-	return ok
-}
-
-// ResetRemainDownloads resets all changes to the "remain_downloads" field.
-func (m *ShareMutation) ResetRemainDownloads() {
-	m.remain_downloads = nil
-	m.addremain_downloads = nil
-	delete(m.clearedFields, share.FieldRemainDownloads)
+// ResetSize resets all changes to the "size" field.
+func (m *SnapshotMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
 }
 
-// SetProps sets the "props" field.
-func (m *ShareMutation) SetProps(tp *types.ShareProps) {
-	m.props = &tp
+// SetScheduled sets the "scheduled" field.
+func (m *SnapshotMutation) SetScheduled(b bool) {
+	m.scheduled = &b
 }
 
-// Props returns the value of the "props" field in the mutation.
-func (m *ShareMutation) Props() (r *types.ShareProps, exists bool) {
-	v := m.props
+// Scheduled returns the value of the "scheduled" field in the mutation.
+func (m *SnapshotMutation) Scheduled() (r bool, exists bool) {
+	v := m.scheduled
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProps returns the old "props" field's value of the Share entity.
-// If the Share object wasn't provided to the builder, the object is fetched from the database.
+// OldScheduled returns the old "scheduled" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ShareMutation) OldProps(ctx context.Context) (v *types.ShareProps, err error) {
+func (m *SnapshotMutation) OldScheduled(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProps is only allowed on UpdateOne operations")
+		return v, errors.New("OldScheduled is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProps requires an ID field in the mutation")
+		return v, errors.New("OldScheduled requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProps: %w", err)
+		return v, fmt.Errorf("querying old value for OldScheduled: %w", err)
 	}
-	return oldValue.Props, nil
-}
-
-// ClearProps clears the value of the "props" field.
-func (m *ShareMutation) ClearProps() {
-	m.props = nil
-	m.clearedFields[share.FieldProps] = struct{}{}
+	return oldValue.Scheduled, nil
 }
 
-// PropsCleared returns if the "props" field was cleared in this mutation.
-func (m *ShareMutation) PropsCleared() bool {
-	_, ok := m.clearedFields[share.FieldProps]
-	return ok
+// ResetScheduled resets all changes to the "scheduled" field.
+func (m *SnapshotMutation) ResetScheduled() {
+	m.scheduled = nil
 }
 
-// ResetProps resets all changes to the "props" field.
-func (m *ShareMutation) ResetProps() {
-	m.props = nil
-	delete(m.clearedFields, share.FieldProps)
+// SetManifest sets the "manifest" field.
+func (m *SnapshotMutation) SetManifest(tn []types.SnapshotNode) {
+	m.manifest = &tn
+	m.appendmanifest = nil
 }
 
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *ShareMutation) SetUserID(id int) {
-	m.user = &id
+// Manifest returns the value of the "manifest" field in the mutation.
+func (m *SnapshotMutation) Manifest() (r []types.SnapshotNode, exists bool) {
+	v := m.manifest
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *ShareMutation) ClearUser() {
-	m.cleareduser = true
+// OldManifest returns the old "manifest" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotMutation) OldManifest(ctx context.Context) (v []types.SnapshotNode, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldManifest is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldManifest requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldManifest: %w", err)
+	}
+	return oldValue.Manifest, nil
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *ShareMutation) UserCleared() bool {
-	return m.cleareduser
+// AppendManifest adds tn to the "manifest" field.
+func (m *SnapshotMutation) AppendManifest(tn []types.SnapshotNode) {
+	m.appendmanifest = append(m.appendmanifest, tn...)
 }
 
-// UserID returns the "user" edge ID in the mutation.
-func (m *ShareMutation) UserID() (id int, exists bool) {
-	if m.user != nil {
-		return *m.user, true
+// AppendedManifest returns the list of values that were appended to the "manifest" field in this mutation.
+func (m *SnapshotMutation) AppendedManifest() ([]types.SnapshotNode, bool) {
+	if len(m.appendmanifest) == 0 {
+		return nil, false
 	}
-	return
+	return m.appendmanifest, true
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *ShareMutation) UserIDs() (ids []int) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// ClearManifest clears the value of the "manifest" field.
+func (m *SnapshotMutation) ClearManifest() {
+	m.manifest = nil
+	m.appendmanifest = nil
+	m.clearedFields[snapshot.FieldManifest] = struct{}{}
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *ShareMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// ManifestCleared returns if the "manifest" field was cleared in this mutation.
+func (m *SnapshotMutation) ManifestCleared() bool {
+	_, ok := m.clearedFields[snapshot.FieldManifest]
+	return ok
 }
 
-// SetFileID sets the "file" edge to the File entity by id.
-func (m *ShareMutation) SetFileID(id int) {
-	m.file = &id
+// ResetManifest resets all changes to the "manifest" field.
+func (m *SnapshotMutation) ResetManifest() {
+	m.manifest = nil
+	m.appendmanifest = nil
+	delete(m.clearedFields, snapshot.FieldManifest)
 }
 
-// ClearFile clears the "file" edge to the File entity.
-func (m *ShareMutation) ClearFile() {
-	m.clearedfile = true
+// SetOwnerID sets the "owner_id" field.
+func (m *SnapshotMutation) SetOwnerID(i int) {
+	m.owner = &i
 }
 
-// FileCleared reports if the "file" edge to the File entity was cleared.
-func (m *ShareMutation) FileCleared() bool {
-	return m.clearedfile
+// OwnerID returns the value of the "owner_id" field in the mutation.
+func (m *SnapshotMutation) OwnerID() (r int, exists bool) {
+	v := m.owner
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// FileID returns the "file" edge ID in the mutation.
-func (m *ShareMutation) FileID() (id int, exists bool) {
-	if m.file != nil {
-		return *m.file, true
+// OldOwnerID returns the old "owner_id" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotMutation) OldOwnerID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
+	}
+	return oldValue.OwnerID, nil
 }
 
-// FileIDs returns the "file" edge IDs in the mutation.
+// ResetOwnerID resets all changes to the "owner_id" field.
+func (m *SnapshotMutation) ResetOwnerID() {
+	m.owner = nil
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *SnapshotMutation) ClearOwner() {
+	m.clearedowner = true
+	m.clearedFields[snapshot.FieldOwnerID] = struct{}{}
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *SnapshotMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// FileID instead. It exists only for internal usage by the builders.
-func (m *ShareMutation) FileIDs() (ids []int) {
-	if id := m.file; id != nil {
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *SnapshotMutation) OwnerIDs() (ids []int) {
+	if id := m.owner; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetFile resets all changes to the "file" edge.
-func (m *ShareMutation) ResetFile() {
-	m.file = nil
-	m.clearedfile = false
+// ResetOwner resets all changes to the "owner" edge.
+func (m *SnapshotMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
 }
 
-// Where appends a list predicates to the ShareMutation builder.
-func (m *ShareMutation) Where(ps ...predicate.Share) {
+// Where appends a list predicates to the SnapshotMutation builder.
+func (m *SnapshotMutation) Where(ps ...predicate.Snapshot) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ShareMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SnapshotMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ShareMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Share, len(ps))
+func (m *SnapshotMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Snapshot, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -10247,51 +15947,57 @@ func (m *ShareMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ShareMutation) Op() Op {
+func (m *SnapshotMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ShareMutation) SetOp(op Op) {
+func (m *SnapshotMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Share).
-func (m *ShareMutation) Type() string {
+// Type returns the node type of this mutation (Snapshot).
+func (m *SnapshotMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ShareMutation) Fields() []string {
-	fields := make([]string, 0, 9)
+func (m *SnapshotMutation) Fields() []string {
+	fields := make([]string, 0, 11)
 	if m.created_at != nil {
-		fields = append(fields, share.FieldCreatedAt)
+		fields = append(fields, snapshot.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, share.FieldUpdatedAt)
+		fields = append(fields, snapshot.FieldUpdatedAt)
 	}
 	if m.deleted_at != nil {
-		fields = append(fields, share.FieldDeletedAt)
+		fields = append(fields, snapshot.FieldDeletedAt)
 	}
-	if m.password != nil {
-		fields = append(fields, share.FieldPassword)
+	if m.name != nil {
+		fields = append(fields, snapshot.FieldName)
 	}
-	if m.views != nil {
-		fields = append(fields, share.FieldViews)
+	if m.file_id != nil {
+		fields = append(fields, snapshot.FieldFileID)
 	}
-	if m.downloads != nil {
-		fields = append(fields, share.FieldDownloads)
+	if m.root_uri != nil {
+		fields = append(fields, snapshot.FieldRootURI)
 	}
-	if m.expires != nil {
-		fields = append(fields, share.FieldExpires)
+	if m.files != nil {
+		fields = append(fields, snapshot.FieldFiles)
 	}
-	if m.remain_downloads != nil {
-		fields = append(fields, share.FieldRemainDownloads)
+	if m.size != nil {
+		fields = append(fields, snapshot.FieldSize)
 	}
-	if m.props != nil {
-		fields = append(fields, share.FieldProps)
+	if m.scheduled != nil {
+		fields = append(fields, snapshot.FieldScheduled)
+	}
+	if m.manifest != nil {
+		fields = append(fields, snapshot.FieldManifest)
+	}
+	if m.owner != nil {
+		fields = append(fields, snapshot.FieldOwnerID)
 	}
 	return fields
 }
@@ -10299,26 +16005,30 @@ func (m *ShareMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ShareMutation) Field(name string) (ent.Value, bool) {
+func (m *SnapshotMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case share.FieldCreatedAt:
+	case snapshot.FieldCreatedAt:
 		return m.CreatedAt()
-	case share.FieldUpdatedAt:
+	case snapshot.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case share.FieldDeletedAt:
+	case snapshot.FieldDeletedAt:
 		return m.DeletedAt()
-	case share.FieldPassword:
-		return m.Password()
-	case share.FieldViews:
-		return m.Views()
-	case share.FieldDownloads:
-		return m.Downloads()
-	case share.FieldExpires:
-		return m.Expires()
-	case share.FieldRemainDownloads:
-		return m.RemainDownloads()
-	case share.FieldProps:
-		return m.Props()
+	case snapshot.FieldName:
+		return m.Name()
+	case snapshot.FieldFileID:
+		return m.FileID()
+	case snapshot.FieldRootURI:
+		return m.RootURI()
+	case snapshot.FieldFiles:
+		return m.Files()
+	case snapshot.FieldSize:
+		return m.Size()
+	case snapshot.FieldScheduled:
+		return m.Scheduled()
+	case snapshot.FieldManifest:
+		return m.Manifest()
+	case snapshot.FieldOwnerID:
+		return m.OwnerID()
 	}
 	return nil, false
 }
@@ -10326,114 +16036,132 @@ func (m *ShareMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ShareMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SnapshotMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case share.FieldCreatedAt:
+	case snapshot.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case share.FieldUpdatedAt:
+	case snapshot.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case share.FieldDeletedAt:
+	case snapshot.FieldDeletedAt:
 		return m.OldDeletedAt(ctx)
-	case share.FieldPassword:
-		return m.OldPassword(ctx)
-	case share.FieldViews:
-		return m.OldViews(ctx)
-	case share.FieldDownloads:
-		return m.OldDownloads(ctx)
-	case share.FieldExpires:
-		return m.OldExpires(ctx)
-	case share.FieldRemainDownloads:
-		return m.OldRemainDownloads(ctx)
-	case share.FieldProps:
-		return m.OldProps(ctx)
+	case snapshot.FieldName:
+		return m.OldName(ctx)
+	case snapshot.FieldFileID:
+		return m.OldFileID(ctx)
+	case snapshot.FieldRootURI:
+		return m.OldRootURI(ctx)
+	case snapshot.FieldFiles:
+		return m.OldFiles(ctx)
+	case snapshot.FieldSize:
+		return m.OldSize(ctx)
+	case snapshot.FieldScheduled:
+		return m.OldScheduled(ctx)
+	case snapshot.FieldManifest:
+		return m.OldManifest(ctx)
+	case snapshot.FieldOwnerID:
+		return m.OldOwnerID(ctx)
 	}
-	return nil, fmt.Errorf("unknown Share field %s", name)
+	return nil, fmt.Errorf("unknown Snapshot field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ShareMutation) SetField(name string, value ent.Value) error {
+func (m *SnapshotMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case share.FieldCreatedAt:
+	case snapshot.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case share.FieldUpdatedAt:
+	case snapshot.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case share.FieldDeletedAt:
+	case snapshot.FieldDeletedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDeletedAt(v)
 		return nil
-	case share.FieldPassword:
+	case snapshot.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPassword(v)
+		m.SetName(v)
 		return nil
-	case share.FieldViews:
+	case snapshot.FieldFileID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetViews(v)
+		m.SetFileID(v)
 		return nil
-	case share.FieldDownloads:
+	case snapshot.FieldRootURI:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRootURI(v)
+		return nil
+	case snapshot.FieldFiles:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDownloads(v)
+		m.SetFiles(v)
 		return nil
-	case share.FieldExpires:
-		v, ok := value.(time.Time)
+	case snapshot.FieldSize:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExpires(v)
+		m.SetSize(v)
 		return nil
-	case share.FieldRemainDownloads:
-		v, ok := value.(int)
+	case snapshot.FieldScheduled:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRemainDownloads(v)
+		m.SetScheduled(v)
 		return nil
-	case share.FieldProps:
-		v, ok := value.(*types.ShareProps)
+	case snapshot.FieldManifest:
+		v, ok := value.([]types.SnapshotNode)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProps(v)
+		m.SetManifest(v)
+		return nil
+	case snapshot.FieldOwnerID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnerID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Share field %s", name)
+	return fmt.Errorf("unknown Snapshot field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ShareMutation) AddedFields() []string {
+func (m *SnapshotMutation) AddedFields() []string {
 	var fields []string
-	if m.addviews != nil {
-		fields = append(fields, share.FieldViews)
+	if m.addfile_id != nil {
+		fields = append(fields, snapshot.FieldFileID)
 	}
-	if m.adddownloads != nil {
-		fields = append(fields, share.FieldDownloads)
+	if m.addfiles != nil {
+		fields = append(fields, snapshot.FieldFiles)
 	}
-	if m.addremain_downloads != nil {
-		fields = append(fields, share.FieldRemainDownloads)
+	if m.addsize != nil {
+		fields = append(fields, snapshot.FieldSize)
 	}
 	return fields
 }
@@ -10441,14 +16169,14 @@ func (m *ShareMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ShareMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SnapshotMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case share.FieldViews:
-		return m.AddedViews()
-	case share.FieldDownloads:
-		return m.AddedDownloads()
-	case share.FieldRemainDownloads:
-		return m.AddedRemainDownloads()
+	case snapshot.FieldFileID:
+		return m.AddedFileID()
+	case snapshot.FieldFiles:
+		return m.AddedFiles()
+	case snapshot.FieldSize:
+		return m.AddedSize()
 	}
 	return nil, false
 }
@@ -10456,142 +16184,123 @@ func (m *ShareMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ShareMutation) AddField(name string, value ent.Value) error {
+func (m *SnapshotMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case share.FieldViews:
+	case snapshot.FieldFileID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddViews(v)
+		m.AddFileID(v)
 		return nil
-	case share.FieldDownloads:
+	case snapshot.FieldFiles:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddDownloads(v)
+		m.AddFiles(v)
 		return nil
-	case share.FieldRemainDownloads:
-		v, ok := value.(int)
+	case snapshot.FieldSize:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddRemainDownloads(v)
+		m.AddSize(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Share numeric field %s", name)
+	return fmt.Errorf("unknown Snapshot numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ShareMutation) ClearedFields() []string {
+func (m *SnapshotMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(share.FieldDeletedAt) {
-		fields = append(fields, share.FieldDeletedAt)
-	}
-	if m.FieldCleared(share.FieldPassword) {
-		fields = append(fields, share.FieldPassword)
+	if m.FieldCleared(snapshot.FieldDeletedAt) {
+		fields = append(fields, snapshot.FieldDeletedAt)
 	}
-	if m.FieldCleared(share.FieldExpires) {
-		fields = append(fields, share.FieldExpires)
-	}
-	if m.FieldCleared(share.FieldRemainDownloads) {
-		fields = append(fields, share.FieldRemainDownloads)
-	}
-	if m.FieldCleared(share.FieldProps) {
-		fields = append(fields, share.FieldProps)
+	if m.FieldCleared(snapshot.FieldManifest) {
+		fields = append(fields, snapshot.FieldManifest)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ShareMutation) FieldCleared(name string) bool {
+func (m *SnapshotMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ShareMutation) ClearField(name string) error {
+func (m *SnapshotMutation) ClearField(name string) error {
 	switch name {
-	case share.FieldDeletedAt:
+	case snapshot.FieldDeletedAt:
 		m.ClearDeletedAt()
 		return nil
-	case share.FieldPassword:
-		m.ClearPassword()
-		return nil
-	case share.FieldExpires:
-		m.ClearExpires()
-		return nil
-	case share.FieldRemainDownloads:
-		m.ClearRemainDownloads()
-		return nil
-	case share.FieldProps:
-		m.ClearProps()
+	case snapshot.FieldManifest:
+		m.ClearManifest()
 		return nil
 	}
-	return fmt.Errorf("unknown Share nullable field %s", name)
+	return fmt.Errorf("unknown Snapshot nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ShareMutation) ResetField(name string) error {
+func (m *SnapshotMutation) ResetField(name string) error {
 	switch name {
-	case share.FieldCreatedAt:
+	case snapshot.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case share.FieldUpdatedAt:
+	case snapshot.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case share.FieldDeletedAt:
+	case snapshot.FieldDeletedAt:
 		m.ResetDeletedAt()
 		return nil
-	case share.FieldPassword:
-		m.ResetPassword()
+	case snapshot.FieldName:
+		m.ResetName()
+		return nil
+	case snapshot.FieldFileID:
+		m.ResetFileID()
 		return nil
-	case share.FieldViews:
-		m.ResetViews()
+	case snapshot.FieldRootURI:
+		m.ResetRootURI()
 		return nil
-	case share.FieldDownloads:
-		m.ResetDownloads()
+	case snapshot.FieldFiles:
+		m.ResetFiles()
 		return nil
-	case share.FieldExpires:
-		m.ResetExpires()
+	case snapshot.FieldSize:
+		m.ResetSize()
 		return nil
-	case share.FieldRemainDownloads:
-		m.ResetRemainDownloads()
+	case snapshot.FieldScheduled:
+		m.ResetScheduled()
 		return nil
-	case share.FieldProps:
-		m.ResetProps()
+	case snapshot.FieldManifest:
+		m.ResetManifest()
+		return nil
+	case snapshot.FieldOwnerID:
+		m.ResetOwnerID()
 		return nil
 	}
-	return fmt.Errorf("unknown Share field %s", name)
+	return fmt.Errorf("unknown Snapshot field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ShareMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.user != nil {
-		edges = append(edges, share.EdgeUser)
-	}
-	if m.file != nil {
-		edges = append(edges, share.EdgeFile)
+func (m *SnapshotMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.owner != nil {
+		edges = append(edges, snapshot.EdgeOwner)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ShareMutation) AddedIDs(name string) []ent.Value {
+func (m *SnapshotMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case share.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
-	case share.EdgeFile:
-		if id := m.file; id != nil {
+	case snapshot.EdgeOwner:
+		if id := m.owner; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -10599,67 +16308,56 @@ func (m *ShareMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ShareMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *SnapshotMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ShareMutation) RemovedIDs(name string) []ent.Value {
+func (m *SnapshotMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ShareMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.cleareduser {
-		edges = append(edges, share.EdgeUser)
-	}
-	if m.clearedfile {
-		edges = append(edges, share.EdgeFile)
+func (m *SnapshotMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedowner {
+		edges = append(edges, snapshot.EdgeOwner)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ShareMutation) EdgeCleared(name string) bool {
+func (m *SnapshotMutation) EdgeCleared(name string) bool {
 	switch name {
-	case share.EdgeUser:
-		return m.cleareduser
-	case share.EdgeFile:
-		return m.clearedfile
+	case snapshot.EdgeOwner:
+		return m.clearedowner
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ShareMutation) ClearEdge(name string) error {
+func (m *SnapshotMutation) ClearEdge(name string) error {
 	switch name {
-	case share.EdgeUser:
-		m.ClearUser()
-		return nil
-	case share.EdgeFile:
-		m.ClearFile()
+	case snapshot.EdgeOwner:
+		m.ClearOwner()
 		return nil
 	}
-	return fmt.Errorf("unknown Share unique edge %s", name)
+	return fmt.Errorf("unknown Snapshot unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ShareMutation) ResetEdge(name string) error {
+func (m *SnapshotMutation) ResetEdge(name string) error {
 	switch name {
-	case share.EdgeUser:
-		m.ResetUser()
-		return nil
-	case share.EdgeFile:
-		m.ResetFile()
+	case snapshot.EdgeOwner:
+		m.ResetOwner()
 		return nil
 	}
-	return fmt.Errorf("unknown Share edge %s", name)
+	return fmt.Errorf("unknown Snapshot edge %s", name)
 }
 
 // StoragePolicyMutation represents an operation that mutates the StoragePolicy nodes in the graph.
@@ -13208,48 +18906,63 @@ func (m *TaskMutation) ResetEdge(name string) error {
 // UserMutation represents an operation that mutates the User nodes in the graph.
 type UserMutation struct {
 	config
-	op                  Op
-	typ                 string
-	id                  *int
-	created_at          *time.Time
-	updated_at          *time.Time
-	deleted_at          *time.Time
-	email               *string
-	nick                *string
-	password            *string
-	status              *user.Status
-	storage             *int64
-	addstorage          *int64
-	two_factor_secret   *string
-	avatar              *string
-	settings            **types.UserSetting
-	clearedFields       map[string]struct{}
-	group               *int
-	clearedgroup        bool
-	files               map[int]struct{}
-	removedfiles        map[int]struct{}
-	clearedfiles        bool
-	dav_accounts        map[int]struct{}
-	removeddav_accounts map[int]struct{}
-	cleareddav_accounts bool
-	shares              map[int]struct{}
-	removedshares       map[int]struct{}
-	clearedshares       bool
-	passkey             map[int]struct{}
-	removedpasskey      map[int]struct{}
-	clearedpasskey      bool
-	tasks               map[int]struct{}
-	removedtasks        map[int]struct{}
-	clearedtasks        bool
-	fsevents            map[int]struct{}
-	removedfsevents     map[int]struct{}
-	clearedfsevents     bool
-	entities            map[int]struct{}
-	removedentities     map[int]struct{}
-	clearedentities     bool
-	done                bool
-	oldValue            func(context.Context) (*User, error)
-	predicates          []predicate.User
+	op                        Op
+	typ                       string
+	id                        *int
+	created_at                *time.Time
+	updated_at                *time.Time
+	deleted_at                *time.Time
+	email                     *string
+	nick                      *string
+	password                  *string
+	status                    *user.Status
+	storage                   *int64
+	addstorage                *int64
+	two_factor_secret         *string
+	avatar                    *string
+	settings                  **types.UserSetting
+	clearedFields             map[string]struct{}
+	group                     *int
+	clearedgroup              bool
+	files                     map[int]struct{}
+	removedfiles              map[int]struct{}
+	clearedfiles              bool
+	dav_accounts              map[int]struct{}
+	removeddav_accounts       map[int]struct{}
+	cleareddav_accounts       bool
+	shares                    map[int]struct{}
+	removedshares             map[int]struct{}
+	clearedshares             bool
+	passkey                   map[int]struct{}
+	removedpasskey            map[int]struct{}
+	clearedpasskey            bool
+	tasks                     map[int]struct{}
+	removedtasks              map[int]struct{}
+	clearedtasks              bool
+	fsevents                  map[int]struct{}
+	removedfsevents           map[int]struct{}
+	clearedfsevents           bool
+	entities                  map[int]struct{}
+	removedentities           map[int]struct{}
+	clearedentities           bool
+	snapshots                 map[int]struct{}
+	removedsnapshots          map[int]struct{}
+	clearedsnapshots          bool
+	file_activities           map[int]struct{}
+	removedfile_activities    map[int]struct{}
+	clearedfile_activities    bool
+	feed_subscriptions        map[int]struct{}
+	removedfeed_subscriptions map[int]struct{}
+	clearedfeed_subscriptions bool
+	albums                    map[int]struct{}
+	removedalbums             map[int]struct{}
+	clearedalbums             bool
+	playlists                 map[int]struct{}
+	removedplaylists          map[int]struct{}
+	clearedplaylists          bool
+	done                      bool
+	oldValue                  func(context.Context) (*User, error)
+	predicates                []predicate.User
 }
 
 var _ ent.Mutation = (*UserMutation)(nil)
@@ -14285,6 +19998,276 @@ func (m *UserMutation) ResetEntities() {
 	m.removedentities = nil
 }
 
+// AddSnapshotIDs adds the "snapshots" edge to the Snapshot entity by ids.
+func (m *UserMutation) AddSnapshotIDs(ids ...int) {
+	if m.snapshots == nil {
+		m.snapshots = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.snapshots[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSnapshots clears the "snapshots" edge to the Snapshot entity.
+func (m *UserMutation) ClearSnapshots() {
+	m.clearedsnapshots = true
+}
+
+// SnapshotsCleared reports if the "snapshots" edge to the Snapshot entity was cleared.
+func (m *UserMutation) SnapshotsCleared() bool {
+	return m.clearedsnapshots
+}
+
+// RemoveSnapshotIDs removes the "snapshots" edge to the Snapshot entity by IDs.
+func (m *UserMutation) RemoveSnapshotIDs(ids ...int) {
+	if m.removedsnapshots == nil {
+		m.removedsnapshots = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.snapshots, ids[i])
+		m.removedsnapshots[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSnapshots returns the removed IDs of the "snapshots" edge to the Snapshot entity.
+func (m *UserMutation) RemovedSnapshotsIDs() (ids []int) {
+	for id := range m.removedsnapshots {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SnapshotsIDs returns the "snapshots" edge IDs in the mutation.
+func (m *UserMutation) SnapshotsIDs() (ids []int) {
+	for id := range m.snapshots {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSnapshots resets all changes to the "snapshots" edge.
+func (m *UserMutation) ResetSnapshots() {
+	m.snapshots = nil
+	m.clearedsnapshots = false
+	m.removedsnapshots = nil
+}
+
+// AddFileActivityIDs adds the "file_activities" edge to the FileActivity entity by ids.
+func (m *UserMutation) AddFileActivityIDs(ids ...int) {
+	if m.file_activities == nil {
+		m.file_activities = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.file_activities[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFileActivities clears the "file_activities" edge to the FileActivity entity.
+func (m *UserMutation) ClearFileActivities() {
+	m.clearedfile_activities = true
+}
+
+// FileActivitiesCleared reports if the "file_activities" edge to the FileActivity entity was cleared.
+func (m *UserMutation) FileActivitiesCleared() bool {
+	return m.clearedfile_activities
+}
+
+// RemoveFileActivityIDs removes the "file_activities" edge to the FileActivity entity by IDs.
+func (m *UserMutation) RemoveFileActivityIDs(ids ...int) {
+	if m.removedfile_activities == nil {
+		m.removedfile_activities = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.file_activities, ids[i])
+		m.removedfile_activities[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFileActivities returns the removed IDs of the "file_activities" edge to the FileActivity entity.
+func (m *UserMutation) RemovedFileActivitiesIDs() (ids []int) {
+	for id := range m.removedfile_activities {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FileActivitiesIDs returns the "file_activities" edge IDs in the mutation.
+func (m *UserMutation) FileActivitiesIDs() (ids []int) {
+	for id := range m.file_activities {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFileActivities resets all changes to the "file_activities" edge.
+func (m *UserMutation) ResetFileActivities() {
+	m.file_activities = nil
+	m.clearedfile_activities = false
+	m.removedfile_activities = nil
+}
+
+// AddFeedSubscriptionIDs adds the "feed_subscriptions" edge to the FeedSubscription entity by ids.
+func (m *UserMutation) AddFeedSubscriptionIDs(ids ...int) {
+	if m.feed_subscriptions == nil {
+		m.feed_subscriptions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.feed_subscriptions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFeedSubscriptions clears the "feed_subscriptions" edge to the FeedSubscription entity.
+func (m *UserMutation) ClearFeedSubscriptions() {
+	m.clearedfeed_subscriptions = true
+}
+
+// FeedSubscriptionsCleared reports if the "feed_subscriptions" edge to the FeedSubscription entity was cleared.
+func (m *UserMutation) FeedSubscriptionsCleared() bool {
+	return m.clearedfeed_subscriptions
+}
+
+// RemoveFeedSubscriptionIDs removes the "feed_subscriptions" edge to the FeedSubscription entity by IDs.
+func (m *UserMutation) RemoveFeedSubscriptionIDs(ids ...int) {
+	if m.removedfeed_subscriptions == nil {
+		m.removedfeed_subscriptions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.feed_subscriptions, ids[i])
+		m.removedfeed_subscriptions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFeedSubscriptions returns the removed IDs of the "feed_subscriptions" edge to the FeedSubscription entity.
+func (m *UserMutation) RemovedFeedSubscriptionsIDs() (ids []int) {
+	for id := range m.removedfeed_subscriptions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FeedSubscriptionsIDs returns the "feed_subscriptions" edge IDs in the mutation.
+func (m *UserMutation) FeedSubscriptionsIDs() (ids []int) {
+	for id := range m.feed_subscriptions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFeedSubscriptions resets all changes to the "feed_subscriptions" edge.
+func (m *UserMutation) ResetFeedSubscriptions() {
+	m.feed_subscriptions = nil
+	m.clearedfeed_subscriptions = false
+	m.removedfeed_subscriptions = nil
+}
+
+// AddAlbumIDs adds the "albums" edge to the Album entity by ids.
+func (m *UserMutation) AddAlbumIDs(ids ...int) {
+	if m.albums == nil {
+		m.albums = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.albums[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAlbums clears the "albums" edge to the Album entity.
+func (m *UserMutation) ClearAlbums() {
+	m.clearedalbums = true
+}
+
+// AlbumsCleared reports if the "albums" edge to the Album entity was cleared.
+func (m *UserMutation) AlbumsCleared() bool {
+	return m.clearedalbums
+}
+
+// RemoveAlbumIDs removes the "albums" edge to the Album entity by IDs.
+func (m *UserMutation) RemoveAlbumIDs(ids ...int) {
+	if m.removedalbums == nil {
+		m.removedalbums = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.albums, ids[i])
+		m.removedalbums[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAlbums returns the removed IDs of the "albums" edge to the Album entity.
+func (m *UserMutation) RemovedAlbumsIDs() (ids []int) {
+	for id := range m.removedalbums {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AlbumsIDs returns the "albums" edge IDs in the mutation.
+func (m *UserMutation) AlbumsIDs() (ids []int) {
+	for id := range m.albums {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAlbums resets all changes to the "albums" edge.
+func (m *UserMutation) ResetAlbums() {
+	m.albums = nil
+	m.clearedalbums = false
+	m.removedalbums = nil
+}
+
+// AddPlaylistIDs adds the "playlists" edge to the Playlist entity by ids.
+func (m *UserMutation) AddPlaylistIDs(ids ...int) {
+	if m.playlists == nil {
+		m.playlists = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.playlists[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPlaylists clears the "playlists" edge to the Playlist entity.
+func (m *UserMutation) ClearPlaylists() {
+	m.clearedplaylists = true
+}
+
+// PlaylistsCleared reports if the "playlists" edge to the Playlist entity was cleared.
+func (m *UserMutation) PlaylistsCleared() bool {
+	return m.clearedplaylists
+}
+
+// RemovePlaylistIDs removes the "playlists" edge to the Playlist entity by IDs.
+func (m *UserMutation) RemovePlaylistIDs(ids ...int) {
+	if m.removedplaylists == nil {
+		m.removedplaylists = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.playlists, ids[i])
+		m.removedplaylists[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPlaylists returns the removed IDs of the "playlists" edge to the Playlist entity.
+func (m *UserMutation) RemovedPlaylistsIDs() (ids []int) {
+	for id := range m.removedplaylists {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PlaylistsIDs returns the "playlists" edge IDs in the mutation.
+func (m *UserMutation) PlaylistsIDs() (ids []int) {
+	for id := range m.playlists {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPlaylists resets all changes to the "playlists" edge.
+func (m *UserMutation) ResetPlaylists() {
+	m.playlists = nil
+	m.clearedplaylists = false
+	m.removedplaylists = nil
+}
+
 // Where appends a list predicates to the UserMutation builder.
 func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
@@ -14653,7 +20636,7 @@ func (m *UserMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 8)
+	edges := make([]string, 0, 13)
 	if m.group != nil {
 		edges = append(edges, user.EdgeGroup)
 	}
@@ -14678,6 +20661,21 @@ func (m *UserMutation) AddedEdges() []string {
 	if m.entities != nil {
 		edges = append(edges, user.EdgeEntities)
 	}
+	if m.snapshots != nil {
+		edges = append(edges, user.EdgeSnapshots)
+	}
+	if m.file_activities != nil {
+		edges = append(edges, user.EdgeFileActivities)
+	}
+	if m.feed_subscriptions != nil {
+		edges = append(edges, user.EdgeFeedSubscriptions)
+	}
+	if m.albums != nil {
+		edges = append(edges, user.EdgeAlbums)
+	}
+	if m.playlists != nil {
+		edges = append(edges, user.EdgePlaylists)
+	}
 	return edges
 }
 
@@ -14731,13 +20729,43 @@ func (m *UserMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeSnapshots:
+		ids := make([]ent.Value, 0, len(m.snapshots))
+		for id := range m.snapshots {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeFileActivities:
+		ids := make([]ent.Value, 0, len(m.file_activities))
+		for id := range m.file_activities {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeFeedSubscriptions:
+		ids := make([]ent.Value, 0, len(m.feed_subscriptions))
+		for id := range m.feed_subscriptions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAlbums:
+		ids := make([]ent.Value, 0, len(m.albums))
+		for id := range m.albums {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgePlaylists:
+		ids := make([]ent.Value, 0, len(m.playlists))
+		for id := range m.playlists {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 8)
+	edges := make([]string, 0, 13)
 	if m.removedfiles != nil {
 		edges = append(edges, user.EdgeFiles)
 	}
@@ -14759,6 +20787,21 @@ func (m *UserMutation) RemovedEdges() []string {
 	if m.removedentities != nil {
 		edges = append(edges, user.EdgeEntities)
 	}
+	if m.removedsnapshots != nil {
+		edges = append(edges, user.EdgeSnapshots)
+	}
+	if m.removedfile_activities != nil {
+		edges = append(edges, user.EdgeFileActivities)
+	}
+	if m.removedfeed_subscriptions != nil {
+		edges = append(edges, user.EdgeFeedSubscriptions)
+	}
+	if m.removedalbums != nil {
+		edges = append(edges, user.EdgeAlbums)
+	}
+	if m.removedplaylists != nil {
+		edges = append(edges, user.EdgePlaylists)
+	}
 	return edges
 }
 
@@ -14808,13 +20851,43 @@ func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeSnapshots:
+		ids := make([]ent.Value, 0, len(m.removedsnapshots))
+		for id := range m.removedsnapshots {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeFileActivities:
+		ids := make([]ent.Value, 0, len(m.removedfile_activities))
+		for id := range m.removedfile_activities {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeFeedSubscriptions:
+		ids := make([]ent.Value, 0, len(m.removedfeed_subscriptions))
+		for id := range m.removedfeed_subscriptions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAlbums:
+		ids := make([]ent.Value, 0, len(m.removedalbums))
+		for id := range m.removedalbums {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgePlaylists:
+		ids := make([]ent.Value, 0, len(m.removedplaylists))
+		for id := range m.removedplaylists {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 8)
+	edges := make([]string, 0, 13)
 	if m.clearedgroup {
 		edges = append(edges, user.EdgeGroup)
 	}
@@ -14839,6 +20912,21 @@ func (m *UserMutation) ClearedEdges() []string {
 	if m.clearedentities {
 		edges = append(edges, user.EdgeEntities)
 	}
+	if m.clearedsnapshots {
+		edges = append(edges, user.EdgeSnapshots)
+	}
+	if m.clearedfile_activities {
+		edges = append(edges, user.EdgeFileActivities)
+	}
+	if m.clearedfeed_subscriptions {
+		edges = append(edges, user.EdgeFeedSubscriptions)
+	}
+	if m.clearedalbums {
+		edges = append(edges, user.EdgeAlbums)
+	}
+	if m.clearedplaylists {
+		edges = append(edges, user.EdgePlaylists)
+	}
 	return edges
 }
 
@@ -14862,6 +20950,16 @@ func (m *UserMutation) EdgeCleared(name string) bool {
 		return m.clearedfsevents
 	case user.EdgeEntities:
 		return m.clearedentities
+	case user.EdgeSnapshots:
+		return m.clearedsnapshots
+	case user.EdgeFileActivities:
+		return m.clearedfile_activities
+	case user.EdgeFeedSubscriptions:
+		return m.clearedfeed_subscriptions
+	case user.EdgeAlbums:
+		return m.clearedalbums
+	case user.EdgePlaylists:
+		return m.clearedplaylists
 	}
 	return false
 }
@@ -14905,6 +21003,21 @@ func (m *UserMutation) ResetEdge(name string) error {
 	case user.EdgeEntities:
 		m.ResetEntities()
 		return nil
+	case user.EdgeSnapshots:
+		m.ResetSnapshots()
+		return nil
+	case user.EdgeFileActivities:
+		m.ResetFileActivities()
+		return nil
+	case user.EdgeFeedSubscriptions:
+		m.ResetFeedSubscriptions()
+		return nil
+	case user.EdgeAlbums:
+		m.ResetAlbums()
+		return nil
+	case user.EdgePlaylists:
+		m.ResetPlaylists()
+		return nil
 	}
 	return fmt.Errorf("unknown User edge %s", name)
 }
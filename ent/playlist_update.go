@@ -0,0 +1,552 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/playlist"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+)
+
+// PlaylistUpdate is the builder for updating Playlist entities.
+type PlaylistUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PlaylistMutation
+}
+
+// Where appends a list predicates to the PlaylistUpdate builder.
+func (pu *PlaylistUpdate) Where(ps ...predicate.Playlist) *PlaylistUpdate {
+	pu.mutation.Where(ps...)
+	return pu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pu *PlaylistUpdate) SetUpdatedAt(t time.Time) *PlaylistUpdate {
+	pu.mutation.SetUpdatedAt(t)
+	return pu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (pu *PlaylistUpdate) SetDeletedAt(t time.Time) *PlaylistUpdate {
+	pu.mutation.SetDeletedAt(t)
+	return pu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (pu *PlaylistUpdate) SetNillableDeletedAt(t *time.Time) *PlaylistUpdate {
+	if t != nil {
+		pu.SetDeletedAt(*t)
+	}
+	return pu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (pu *PlaylistUpdate) ClearDeletedAt() *PlaylistUpdate {
+	pu.mutation.ClearDeletedAt()
+	return pu
+}
+
+// SetName sets the "name" field.
+func (pu *PlaylistUpdate) SetName(s string) *PlaylistUpdate {
+	pu.mutation.SetName(s)
+	return pu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (pu *PlaylistUpdate) SetNillableName(s *string) *PlaylistUpdate {
+	if s != nil {
+		pu.SetName(*s)
+	}
+	return pu
+}
+
+// SetComment sets the "comment" field.
+func (pu *PlaylistUpdate) SetComment(s string) *PlaylistUpdate {
+	pu.mutation.SetComment(s)
+	return pu
+}
+
+// SetNillableComment sets the "comment" field if the given value is not nil.
+func (pu *PlaylistUpdate) SetNillableComment(s *string) *PlaylistUpdate {
+	if s != nil {
+		pu.SetComment(*s)
+	}
+	return pu
+}
+
+// ClearComment clears the value of the "comment" field.
+func (pu *PlaylistUpdate) ClearComment() *PlaylistUpdate {
+	pu.mutation.ClearComment()
+	return pu
+}
+
+// SetFileIds sets the "file_ids" field.
+func (pu *PlaylistUpdate) SetFileIds(i []int) *PlaylistUpdate {
+	pu.mutation.SetFileIds(i)
+	return pu
+}
+
+// AppendFileIds appends i to the "file_ids" field.
+func (pu *PlaylistUpdate) AppendFileIds(i []int) *PlaylistUpdate {
+	pu.mutation.AppendFileIds(i)
+	return pu
+}
+
+// ClearFileIds clears the value of the "file_ids" field.
+func (pu *PlaylistUpdate) ClearFileIds() *PlaylistUpdate {
+	pu.mutation.ClearFileIds()
+	return pu
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (pu *PlaylistUpdate) SetOwnerID(i int) *PlaylistUpdate {
+	pu.mutation.SetOwnerID(i)
+	return pu
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (pu *PlaylistUpdate) SetNillableOwnerID(i *int) *PlaylistUpdate {
+	if i != nil {
+		pu.SetOwnerID(*i)
+	}
+	return pu
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (pu *PlaylistUpdate) SetOwner(u *User) *PlaylistUpdate {
+	return pu.SetOwnerID(u.ID)
+}
+
+// Mutation returns the PlaylistMutation object of the builder.
+func (pu *PlaylistUpdate) Mutation() *PlaylistMutation {
+	return pu.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (pu *PlaylistUpdate) ClearOwner() *PlaylistUpdate {
+	pu.mutation.ClearOwner()
+	return pu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pu *PlaylistUpdate) Save(ctx context.Context) (int, error) {
+	if err := pu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, pu.sqlSave, pu.mutation, pu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pu *PlaylistUpdate) SaveX(ctx context.Context) int {
+	affected, err := pu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pu *PlaylistUpdate) Exec(ctx context.Context) error {
+	_, err := pu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pu *PlaylistUpdate) ExecX(ctx context.Context) {
+	if err := pu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pu *PlaylistUpdate) defaults() error {
+	if _, ok := pu.mutation.UpdatedAt(); !ok {
+		if playlist.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized playlist.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := playlist.UpdateDefaultUpdatedAt()
+		pu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pu *PlaylistUpdate) check() error {
+	if _, ok := pu.mutation.OwnerID(); pu.mutation.OwnerCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Playlist.owner"`)
+	}
+	return nil
+}
+
+func (pu *PlaylistUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(playlist.Table, playlist.Columns, sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeInt))
+	if ps := pu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pu.mutation.UpdatedAt(); ok {
+		_spec.SetField(playlist.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := pu.mutation.DeletedAt(); ok {
+		_spec.SetField(playlist.FieldDeletedAt, field.TypeTime, value)
+	}
+	if pu.mutation.DeletedAtCleared() {
+		_spec.ClearField(playlist.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := pu.mutation.Name(); ok {
+		_spec.SetField(playlist.FieldName, field.TypeString, value)
+	}
+	if value, ok := pu.mutation.Comment(); ok {
+		_spec.SetField(playlist.FieldComment, field.TypeString, value)
+	}
+	if pu.mutation.CommentCleared() {
+		_spec.ClearField(playlist.FieldComment, field.TypeString)
+	}
+	if value, ok := pu.mutation.FileIds(); ok {
+		_spec.SetField(playlist.FieldFileIds, field.TypeJSON, value)
+	}
+	if value, ok := pu.mutation.AppendedFileIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, playlist.FieldFileIds, value)
+		})
+	}
+	if pu.mutation.FileIdsCleared() {
+		_spec.ClearField(playlist.FieldFileIds, field.TypeJSON)
+	}
+	if pu.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   playlist.OwnerTable,
+			Columns: []string{playlist.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   playlist.OwnerTable,
+			Columns: []string{playlist.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{playlist.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pu.mutation.done = true
+	return n, nil
+}
+
+// PlaylistUpdateOne is the builder for updating a single Playlist entity.
+type PlaylistUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PlaylistMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (puo *PlaylistUpdateOne) SetUpdatedAt(t time.Time) *PlaylistUpdateOne {
+	puo.mutation.SetUpdatedAt(t)
+	return puo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (puo *PlaylistUpdateOne) SetDeletedAt(t time.Time) *PlaylistUpdateOne {
+	puo.mutation.SetDeletedAt(t)
+	return puo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (puo *PlaylistUpdateOne) SetNillableDeletedAt(t *time.Time) *PlaylistUpdateOne {
+	if t != nil {
+		puo.SetDeletedAt(*t)
+	}
+	return puo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (puo *PlaylistUpdateOne) ClearDeletedAt() *PlaylistUpdateOne {
+	puo.mutation.ClearDeletedAt()
+	return puo
+}
+
+// SetName sets the "name" field.
+func (puo *PlaylistUpdateOne) SetName(s string) *PlaylistUpdateOne {
+	puo.mutation.SetName(s)
+	return puo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (puo *PlaylistUpdateOne) SetNillableName(s *string) *PlaylistUpdateOne {
+	if s != nil {
+		puo.SetName(*s)
+	}
+	return puo
+}
+
+// SetComment sets the "comment" field.
+func (puo *PlaylistUpdateOne) SetComment(s string) *PlaylistUpdateOne {
+	puo.mutation.SetComment(s)
+	return puo
+}
+
+// SetNillableComment sets the "comment" field if the given value is not nil.
+func (puo *PlaylistUpdateOne) SetNillableComment(s *string) *PlaylistUpdateOne {
+	if s != nil {
+		puo.SetComment(*s)
+	}
+	return puo
+}
+
+// ClearComment clears the value of the "comment" field.
+func (puo *PlaylistUpdateOne) ClearComment() *PlaylistUpdateOne {
+	puo.mutation.ClearComment()
+	return puo
+}
+
+// SetFileIds sets the "file_ids" field.
+func (puo *PlaylistUpdateOne) SetFileIds(i []int) *PlaylistUpdateOne {
+	puo.mutation.SetFileIds(i)
+	return puo
+}
+
+// AppendFileIds appends i to the "file_ids" field.
+func (puo *PlaylistUpdateOne) AppendFileIds(i []int) *PlaylistUpdateOne {
+	puo.mutation.AppendFileIds(i)
+	return puo
+}
+
+// ClearFileIds clears the value of the "file_ids" field.
+func (puo *PlaylistUpdateOne) ClearFileIds() *PlaylistUpdateOne {
+	puo.mutation.ClearFileIds()
+	return puo
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (puo *PlaylistUpdateOne) SetOwnerID(i int) *PlaylistUpdateOne {
+	puo.mutation.SetOwnerID(i)
+	return puo
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (puo *PlaylistUpdateOne) SetNillableOwnerID(i *int) *PlaylistUpdateOne {
+	if i != nil {
+		puo.SetOwnerID(*i)
+	}
+	return puo
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (puo *PlaylistUpdateOne) SetOwner(u *User) *PlaylistUpdateOne {
+	return puo.SetOwnerID(u.ID)
+}
+
+// Mutation returns the PlaylistMutation object of the builder.
+func (puo *PlaylistUpdateOne) Mutation() *PlaylistMutation {
+	return puo.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (puo *PlaylistUpdateOne) ClearOwner() *PlaylistUpdateOne {
+	puo.mutation.ClearOwner()
+	return puo
+}
+
+// Where appends a list predicates to the PlaylistUpdate builder.
+func (puo *PlaylistUpdateOne) Where(ps ...predicate.Playlist) *PlaylistUpdateOne {
+	puo.mutation.Where(ps...)
+	return puo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (puo *PlaylistUpdateOne) Select(field string, fields ...string) *PlaylistUpdateOne {
+	puo.fields = append([]string{field}, fields...)
+	return puo
+}
+
+// Save executes the query and returns the updated Playlist entity.
+func (puo *PlaylistUpdateOne) Save(ctx context.Context) (*Playlist, error) {
+	if err := puo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, puo.sqlSave, puo.mutation, puo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (puo *PlaylistUpdateOne) SaveX(ctx context.Context) *Playlist {
+	node, err := puo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (puo *PlaylistUpdateOne) Exec(ctx context.Context) error {
+	_, err := puo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (puo *PlaylistUpdateOne) ExecX(ctx context.Context) {
+	if err := puo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (puo *PlaylistUpdateOne) defaults() error {
+	if _, ok := puo.mutation.UpdatedAt(); !ok {
+		if playlist.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized playlist.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := playlist.UpdateDefaultUpdatedAt()
+		puo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (puo *PlaylistUpdateOne) check() error {
+	if _, ok := puo.mutation.OwnerID(); puo.mutation.OwnerCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Playlist.owner"`)
+	}
+	return nil
+}
+
+func (puo *PlaylistUpdateOne) sqlSave(ctx context.Context) (_node *Playlist, err error) {
+	if err := puo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(playlist.Table, playlist.Columns, sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeInt))
+	id, ok := puo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Playlist.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := puo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, playlist.FieldID)
+		for _, f := range fields {
+			if !playlist.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != playlist.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := puo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := puo.mutation.UpdatedAt(); ok {
+		_spec.SetField(playlist.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := puo.mutation.DeletedAt(); ok {
+		_spec.SetField(playlist.FieldDeletedAt, field.TypeTime, value)
+	}
+	if puo.mutation.DeletedAtCleared() {
+		_spec.ClearField(playlist.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := puo.mutation.Name(); ok {
+		_spec.SetField(playlist.FieldName, field.TypeString, value)
+	}
+	if value, ok := puo.mutation.Comment(); ok {
+		_spec.SetField(playlist.FieldComment, field.TypeString, value)
+	}
+	if puo.mutation.CommentCleared() {
+		_spec.ClearField(playlist.FieldComment, field.TypeString)
+	}
+	if value, ok := puo.mutation.FileIds(); ok {
+		_spec.SetField(playlist.FieldFileIds, field.TypeJSON, value)
+	}
+	if value, ok := puo.mutation.AppendedFileIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, playlist.FieldFileIds, value)
+		})
+	}
+	if puo.mutation.FileIdsCleared() {
+		_spec.ClearField(playlist.FieldFileIds, field.TypeJSON)
+	}
+	if puo.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   playlist.OwnerTable,
+			Columns: []string{playlist.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   playlist.OwnerTable,
+			Columns: []string{playlist.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Playlist{config: puo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, puo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{playlist.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	puo.mutation.done = true
+	return _node, nil
+}
@@ -6,6 +6,9 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Album is the predicate function for album builders.
+type Album func(*sql.Selector)
+
 // DavAccount is the predicate function for davaccount builders.
 type DavAccount func(*sql.Selector)
 
@@ -15,9 +18,18 @@ type DirectLink func(*sql.Selector)
 // Entity is the predicate function for entity builders.
 type Entity func(*sql.Selector)
 
+// FeedItem is the predicate function for feeditem builders.
+type FeedItem func(*sql.Selector)
+
+// FeedSubscription is the predicate function for feedsubscription builders.
+type FeedSubscription func(*sql.Selector)
+
 // File is the predicate function for file builders.
 type File func(*sql.Selector)
 
+// FileActivity is the predicate function for fileactivity builders.
+type FileActivity func(*sql.Selector)
+
 // FsEvent is the predicate function for fsevent builders.
 type FsEvent func(*sql.Selector)
 
@@ -33,12 +45,18 @@ type Node func(*sql.Selector)
 // Passkey is the predicate function for passkey builders.
 type Passkey func(*sql.Selector)
 
+// Playlist is the predicate function for playlist builders.
+type Playlist func(*sql.Selector)
+
 // Setting is the predicate function for setting builders.
 type Setting func(*sql.Selector)
 
 // Share is the predicate function for share builders.
 type Share func(*sql.Selector)
 
+// Snapshot is the predicate function for snapshot builders.
+type Snapshot func(*sql.Selector)
+
 // StoragePolicy is the predicate function for storagepolicy builders.
 type StoragePolicy func(*sql.Selector)
 
@@ -5,18 +5,24 @@ package runtime
 import (
 	"time"
 
+	"github.com/cloudreve/Cloudreve/v4/ent/album"
 	"github.com/cloudreve/Cloudreve/v4/ent/davaccount"
 	"github.com/cloudreve/Cloudreve/v4/ent/directlink"
 	"github.com/cloudreve/Cloudreve/v4/ent/entity"
+	"github.com/cloudreve/Cloudreve/v4/ent/feeditem"
+	"github.com/cloudreve/Cloudreve/v4/ent/feedsubscription"
 	"github.com/cloudreve/Cloudreve/v4/ent/file"
+	"github.com/cloudreve/Cloudreve/v4/ent/fileactivity"
 	"github.com/cloudreve/Cloudreve/v4/ent/fsevent"
 	"github.com/cloudreve/Cloudreve/v4/ent/group"
 	"github.com/cloudreve/Cloudreve/v4/ent/metadata"
 	"github.com/cloudreve/Cloudreve/v4/ent/node"
 	"github.com/cloudreve/Cloudreve/v4/ent/passkey"
+	"github.com/cloudreve/Cloudreve/v4/ent/playlist"
 	"github.com/cloudreve/Cloudreve/v4/ent/schema"
 	"github.com/cloudreve/Cloudreve/v4/ent/setting"
 	"github.com/cloudreve/Cloudreve/v4/ent/share"
+	"github.com/cloudreve/Cloudreve/v4/ent/snapshot"
 	"github.com/cloudreve/Cloudreve/v4/ent/storagepolicy"
 	"github.com/cloudreve/Cloudreve/v4/ent/task"
 	"github.com/cloudreve/Cloudreve/v4/ent/user"
@@ -27,6 +33,25 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	albumMixin := schema.Album{}.Mixin()
+	albumMixinHooks0 := albumMixin[0].Hooks()
+	album.Hooks[0] = albumMixinHooks0[0]
+	albumMixinInters0 := albumMixin[0].Interceptors()
+	album.Interceptors[0] = albumMixinInters0[0]
+	albumMixinFields0 := albumMixin[0].Fields()
+	_ = albumMixinFields0
+	albumFields := schema.Album{}.Fields()
+	_ = albumFields
+	// albumDescCreatedAt is the schema descriptor for created_at field.
+	albumDescCreatedAt := albumMixinFields0[0].Descriptor()
+	// album.DefaultCreatedAt holds the default value on creation for the created_at field.
+	album.DefaultCreatedAt = albumDescCreatedAt.Default.(func() time.Time)
+	// albumDescUpdatedAt is the schema descriptor for updated_at field.
+	albumDescUpdatedAt := albumMixinFields0[1].Descriptor()
+	// album.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	album.DefaultUpdatedAt = albumDescUpdatedAt.Default.(func() time.Time)
+	// album.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	album.UpdateDefaultUpdatedAt = albumDescUpdatedAt.UpdateDefault.(func() time.Time)
 	davaccountMixin := schema.DavAccount{}.Mixin()
 	davaccountMixinHooks0 := davaccountMixin[0].Hooks()
 	davaccount.Hooks[0] = davaccountMixinHooks0[0]
@@ -88,6 +113,39 @@ func init() {
 	entityDescReferenceCount := entityFields[3].Descriptor()
 	// entity.DefaultReferenceCount holds the default value on creation for the reference_count field.
 	entity.DefaultReferenceCount = entityDescReferenceCount.Default.(int)
+	feeditemFields := schema.FeedItem{}.Fields()
+	_ = feeditemFields
+	// feeditemDescMatched is the schema descriptor for matched field.
+	feeditemDescMatched := feeditemFields[4].Descriptor()
+	// feeditem.DefaultMatched holds the default value on creation for the matched field.
+	feeditem.DefaultMatched = feeditemDescMatched.Default.(bool)
+	// feeditemDescSeenAt is the schema descriptor for seen_at field.
+	feeditemDescSeenAt := feeditemFields[7].Descriptor()
+	// feeditem.DefaultSeenAt holds the default value on creation for the seen_at field.
+	feeditem.DefaultSeenAt = feeditemDescSeenAt.Default.(func() time.Time)
+	feedsubscriptionMixin := schema.FeedSubscription{}.Mixin()
+	feedsubscriptionMixinHooks0 := feedsubscriptionMixin[0].Hooks()
+	feedsubscription.Hooks[0] = feedsubscriptionMixinHooks0[0]
+	feedsubscriptionMixinInters0 := feedsubscriptionMixin[0].Interceptors()
+	feedsubscription.Interceptors[0] = feedsubscriptionMixinInters0[0]
+	feedsubscriptionMixinFields0 := feedsubscriptionMixin[0].Fields()
+	_ = feedsubscriptionMixinFields0
+	feedsubscriptionFields := schema.FeedSubscription{}.Fields()
+	_ = feedsubscriptionFields
+	// feedsubscriptionDescCreatedAt is the schema descriptor for created_at field.
+	feedsubscriptionDescCreatedAt := feedsubscriptionMixinFields0[0].Descriptor()
+	// feedsubscription.DefaultCreatedAt holds the default value on creation for the created_at field.
+	feedsubscription.DefaultCreatedAt = feedsubscriptionDescCreatedAt.Default.(func() time.Time)
+	// feedsubscriptionDescUpdatedAt is the schema descriptor for updated_at field.
+	feedsubscriptionDescUpdatedAt := feedsubscriptionMixinFields0[1].Descriptor()
+	// feedsubscription.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	feedsubscription.DefaultUpdatedAt = feedsubscriptionDescUpdatedAt.Default.(func() time.Time)
+	// feedsubscription.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	feedsubscription.UpdateDefaultUpdatedAt = feedsubscriptionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// feedsubscriptionDescEnabled is the schema descriptor for enabled field.
+	feedsubscriptionDescEnabled := feedsubscriptionFields[5].Descriptor()
+	// feedsubscription.DefaultEnabled holds the default value on creation for the enabled field.
+	feedsubscription.DefaultEnabled = feedsubscriptionDescEnabled.Default.(bool)
 	fileHooks := schema.File{}.Hooks()
 	file.Hooks[0] = fileHooks[0]
 	fileFields := schema.File{}.Fields()
@@ -108,6 +166,29 @@ func init() {
 	fileDescIsSymbolic := fileFields[8].Descriptor()
 	// file.DefaultIsSymbolic holds the default value on creation for the is_symbolic field.
 	file.DefaultIsSymbolic = fileDescIsSymbolic.Default.(bool)
+	fileactivityMixin := schema.FileActivity{}.Mixin()
+	fileactivityMixinHooks0 := fileactivityMixin[0].Hooks()
+	fileactivity.Hooks[0] = fileactivityMixinHooks0[0]
+	fileactivityMixinInters0 := fileactivityMixin[0].Interceptors()
+	fileactivity.Interceptors[0] = fileactivityMixinInters0[0]
+	fileactivityMixinFields0 := fileactivityMixin[0].Fields()
+	_ = fileactivityMixinFields0
+	fileactivityFields := schema.FileActivity{}.Fields()
+	_ = fileactivityFields
+	// fileactivityDescCreatedAt is the schema descriptor for created_at field.
+	fileactivityDescCreatedAt := fileactivityMixinFields0[0].Descriptor()
+	// fileactivity.DefaultCreatedAt holds the default value on creation for the created_at field.
+	fileactivity.DefaultCreatedAt = fileactivityDescCreatedAt.Default.(func() time.Time)
+	// fileactivityDescUpdatedAt is the schema descriptor for updated_at field.
+	fileactivityDescUpdatedAt := fileactivityMixinFields0[1].Descriptor()
+	// fileactivity.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	fileactivity.DefaultUpdatedAt = fileactivityDescUpdatedAt.Default.(func() time.Time)
+	// fileactivity.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	fileactivity.UpdateDefaultUpdatedAt = fileactivityDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// fileactivityDescAccessedAt is the schema descriptor for accessed_at field.
+	fileactivityDescAccessedAt := fileactivityFields[1].Descriptor()
+	// fileactivity.DefaultAccessedAt holds the default value on creation for the accessed_at field.
+	fileactivity.DefaultAccessedAt = fileactivityDescAccessedAt.Default.(func() time.Time)
 	fseventMixin := schema.FsEvent{}.Mixin()
 	fseventMixinHooks0 := fseventMixin[0].Hooks()
 	fsevent.Hooks[0] = fseventMixinHooks0[0]
@@ -219,6 +300,25 @@ func init() {
 	passkey.DefaultUpdatedAt = passkeyDescUpdatedAt.Default.(func() time.Time)
 	// passkey.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	passkey.UpdateDefaultUpdatedAt = passkeyDescUpdatedAt.UpdateDefault.(func() time.Time)
+	playlistMixin := schema.Playlist{}.Mixin()
+	playlistMixinHooks0 := playlistMixin[0].Hooks()
+	playlist.Hooks[0] = playlistMixinHooks0[0]
+	playlistMixinInters0 := playlistMixin[0].Interceptors()
+	playlist.Interceptors[0] = playlistMixinInters0[0]
+	playlistMixinFields0 := playlistMixin[0].Fields()
+	_ = playlistMixinFields0
+	playlistFields := schema.Playlist{}.Fields()
+	_ = playlistFields
+	// playlistDescCreatedAt is the schema descriptor for created_at field.
+	playlistDescCreatedAt := playlistMixinFields0[0].Descriptor()
+	// playlist.DefaultCreatedAt holds the default value on creation for the created_at field.
+	playlist.DefaultCreatedAt = playlistDescCreatedAt.Default.(func() time.Time)
+	// playlistDescUpdatedAt is the schema descriptor for updated_at field.
+	playlistDescUpdatedAt := playlistMixinFields0[1].Descriptor()
+	// playlist.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	playlist.DefaultUpdatedAt = playlistDescUpdatedAt.Default.(func() time.Time)
+	// playlist.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	playlist.UpdateDefaultUpdatedAt = playlistDescUpdatedAt.UpdateDefault.(func() time.Time)
 	settingMixin := schema.Setting{}.Mixin()
 	settingMixinHooks0 := settingMixin[0].Hooks()
 	setting.Hooks[0] = settingMixinHooks0[0]
@@ -265,6 +365,37 @@ func init() {
 	shareDescDownloads := shareFields[2].Descriptor()
 	// share.DefaultDownloads holds the default value on creation for the downloads field.
 	share.DefaultDownloads = shareDescDownloads.Default.(int)
+	snapshotMixin := schema.Snapshot{}.Mixin()
+	snapshotMixinHooks0 := snapshotMixin[0].Hooks()
+	snapshot.Hooks[0] = snapshotMixinHooks0[0]
+	snapshotMixinInters0 := snapshotMixin[0].Interceptors()
+	snapshot.Interceptors[0] = snapshotMixinInters0[0]
+	snapshotMixinFields0 := snapshotMixin[0].Fields()
+	_ = snapshotMixinFields0
+	snapshotFields := schema.Snapshot{}.Fields()
+	_ = snapshotFields
+	// snapshotDescCreatedAt is the schema descriptor for created_at field.
+	snapshotDescCreatedAt := snapshotMixinFields0[0].Descriptor()
+	// snapshot.DefaultCreatedAt holds the default value on creation for the created_at field.
+	snapshot.DefaultCreatedAt = snapshotDescCreatedAt.Default.(func() time.Time)
+	// snapshotDescUpdatedAt is the schema descriptor for updated_at field.
+	snapshotDescUpdatedAt := snapshotMixinFields0[1].Descriptor()
+	// snapshot.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	snapshot.DefaultUpdatedAt = snapshotDescUpdatedAt.Default.(func() time.Time)
+	// snapshot.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	snapshot.UpdateDefaultUpdatedAt = snapshotDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// snapshotDescFiles is the schema descriptor for files field.
+	snapshotDescFiles := snapshotFields[3].Descriptor()
+	// snapshot.DefaultFiles holds the default value on creation for the files field.
+	snapshot.DefaultFiles = snapshotDescFiles.Default.(int)
+	// snapshotDescSize is the schema descriptor for size field.
+	snapshotDescSize := snapshotFields[4].Descriptor()
+	// snapshot.DefaultSize holds the default value on creation for the size field.
+	snapshot.DefaultSize = snapshotDescSize.Default.(int64)
+	// snapshotDescScheduled is the schema descriptor for scheduled field.
+	snapshotDescScheduled := snapshotFields[5].Descriptor()
+	// snapshot.DefaultScheduled holds the default value on creation for the scheduled field.
+	snapshot.DefaultScheduled = snapshotDescScheduled.Default.(bool)
 	storagepolicyMixin := schema.StoragePolicy{}.Mixin()
 	storagepolicyMixinHooks0 := storagepolicyMixin[0].Hooks()
 	storagepolicy.Hooks[0] = storagepolicyMixinHooks0[0]
@@ -345,6 +476,5 @@ func init() {
 }
 
 const (
-	Version = "v0.13.0"                                         // Version of ent codegen.
-	Sum     = "h1:DclxWczaCpyiKn6ZWVcJjq1zIKtJ11iNKy+08lNYsJE=" // Sum of ent codegen.
+	Version = "v0.13.0" // Version of ent codegen.
 )
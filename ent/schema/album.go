@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Album holds the schema definition for the Album entity.
+type Album struct {
+	ent.Schema
+}
+
+// Fields of the Album.
+func (Album) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name"),
+		field.Int("owner_id"),
+	}
+}
+
+// Edges of the Album.
+func (Album) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).
+			Ref("albums").
+			Field("owner_id").
+			Unique().
+			Required(),
+		edge.To("files", File.Type),
+	}
+}
+
+// Indexes of the Album.
+func (Album) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("owner_id", "name"),
+	}
+}
+
+func (Album) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CommonMixin{},
+	}
+}
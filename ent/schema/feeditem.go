@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// FeedItem holds the schema definition for the FeedItem entity. Every item seen in
+// a subscribed feed is recorded for de-duplication, matched ones form the history.
+type FeedItem struct {
+	ent.Schema
+}
+
+// Fields of the FeedItem.
+func (FeedItem) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("guid"),
+		field.Text("title"),
+		field.Text("link"),
+		field.Time("published_at").
+			Optional().
+			Nillable(),
+		field.Bool("matched").
+			Default(false),
+		field.Int("task_id").
+			Optional().
+			Nillable(),
+		field.Text("error").
+			Optional(),
+		field.Time("seen_at").
+			Default(time.Now),
+		field.Int("subscription_id"),
+	}
+}
+
+// Edges of the FeedItem.
+func (FeedItem) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("subscription", FeedSubscription.Type).
+			Ref("items").
+			Field("subscription_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the FeedItem.
+func (FeedItem) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("subscription_id", "guid").
+			Unique(),
+		index.Fields("subscription_id", "matched", "seen_at"),
+	}
+}
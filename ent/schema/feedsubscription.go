@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// FeedSubscription holds the schema definition for the FeedSubscription entity.
+// New items of subscribed RSS/Atom feeds are downloaded by remote download tasks.
+type FeedSubscription struct {
+	ent.Schema
+}
+
+// Fields of the FeedSubscription.
+func (FeedSubscription) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name"),
+		field.Text("url"),
+		field.String("include").
+			Optional(),
+		field.String("exclude").
+			Optional(),
+		field.Text("dst"),
+		field.Bool("enabled").
+			Default(true),
+		field.Time("checked_at").
+			Optional().
+			Nillable(),
+		field.Text("error").
+			Optional(),
+		field.Int("owner_id"),
+	}
+}
+
+// Edges of the FeedSubscription.
+func (FeedSubscription) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).
+			Ref("feed_subscriptions").
+			Field("owner_id").
+			Unique().
+			Required(),
+		edge.To("items", FeedItem.Type),
+	}
+}
+
+// Indexes of the FeedSubscription.
+func (FeedSubscription) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("owner_id"),
+	}
+}
+
+func (FeedSubscription) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CommonMixin{},
+	}
+}
@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// FileActivity holds the schema definition for the FileActivity entity.
+// Only the latest activity of a user on a file is recorded.
+type FileActivity struct {
+	ent.Schema
+}
+
+// Fields of the FileActivity.
+func (FileActivity) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("action"),
+		field.Time("accessed_at").
+			Default(time.Now),
+		field.Time("opened_at").
+			Optional().
+			Nillable(),
+		field.Int("file_id"),
+		field.Int("user_id"),
+	}
+}
+
+// Edges of the FileActivity.
+func (FileActivity) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("file", File.Type).
+			Ref("activities").
+			Field("file_id").
+			Unique().
+			Required(),
+		edge.From("user", User.Type).
+			Ref("file_activities").
+			Field("user_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the FileActivity.
+func (FileActivity) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "file_id").
+			Unique(),
+		index.Fields("user_id", "accessed_at"),
+	}
+}
+
+func (FileActivity) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CommonMixin{},
+	}
+}
@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Playlist holds the schema definition for the Playlist entity.
+type Playlist struct {
+	ent.Schema
+}
+
+// Fields of the Playlist.
+func (Playlist) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name"),
+		field.Text("comment").
+			Optional(),
+		// Ordered IDs of files in playlist, a file may appear more than once.
+		field.Ints("file_ids").
+			Optional(),
+		field.Int("owner_id"),
+	}
+}
+
+// Edges of the Playlist.
+func (Playlist) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).
+			Ref("playlists").
+			Field("owner_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the Playlist.
+func (Playlist) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("owner_id", "name"),
+	}
+}
+
+func (Playlist) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CommonMixin{},
+	}
+}
@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+)
+
+// Snapshot holds the schema definition for the Snapshot entity.
+type Snapshot struct {
+	ent.Schema
+}
+
+// Fields of the Snapshot.
+func (Snapshot) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name"),
+		field.Int("file_id"),
+		field.Text("root_uri"),
+		field.Int("files").
+			Default(0),
+		field.Int64("size").
+			Default(0),
+		field.Bool("scheduled").
+			Default(false),
+		field.JSON("manifest", []types.SnapshotNode{}).
+			Optional(),
+		field.Int("owner_id"),
+	}
+}
+
+// Edges of the Snapshot.
+func (Snapshot) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).
+			Ref("snapshots").
+			Field("owner_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the Snapshot.
+func (Snapshot) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("owner_id", "file_id"),
+	}
+}
+
+func (Snapshot) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CommonMixin{},
+	}
+}
@@ -53,6 +53,11 @@ func (User) Edges() []ent.Edge {
 		edge.To("tasks", Task.Type),
 		edge.To("fsevents", FsEvent.Type),
 		edge.To("entities", Entity.Type),
+		edge.To("snapshots", Snapshot.Type),
+		edge.To("file_activities", FileActivity.Type),
+		edge.To("feed_subscriptions", FeedSubscription.Type),
+		edge.To("albums", Album.Type),
+		edge.To("playlists", Playlist.Type),
 	}
 }
 
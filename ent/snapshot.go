@@ -0,0 +1,264 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent/snapshot"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+)
+
+// Snapshot is the model entity for the Snapshot schema.
+type Snapshot struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// FileID holds the value of the "file_id" field.
+	FileID int `json:"file_id,omitempty"`
+	// RootURI holds the value of the "root_uri" field.
+	RootURI string `json:"root_uri,omitempty"`
+	// Files holds the value of the "files" field.
+	Files int `json:"files,omitempty"`
+	// Size holds the value of the "size" field.
+	Size int64 `json:"size,omitempty"`
+	// Scheduled holds the value of the "scheduled" field.
+	Scheduled bool `json:"scheduled,omitempty"`
+	// Manifest holds the value of the "manifest" field.
+	Manifest []types.SnapshotNode `json:"manifest,omitempty"`
+	// OwnerID holds the value of the "owner_id" field.
+	OwnerID int `json:"owner_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SnapshotQuery when eager-loading is set.
+	Edges        SnapshotEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SnapshotEdges holds the relations/edges for other nodes in the graph.
+type SnapshotEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *User `json:"owner,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SnapshotEdges) OwnerOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.Owner == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.Owner, nil
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Snapshot) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case snapshot.FieldManifest:
+			values[i] = new([]byte)
+		case snapshot.FieldScheduled:
+			values[i] = new(sql.NullBool)
+		case snapshot.FieldID, snapshot.FieldFileID, snapshot.FieldFiles, snapshot.FieldSize, snapshot.FieldOwnerID:
+			values[i] = new(sql.NullInt64)
+		case snapshot.FieldName, snapshot.FieldRootURI:
+			values[i] = new(sql.NullString)
+		case snapshot.FieldCreatedAt, snapshot.FieldUpdatedAt, snapshot.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Snapshot fields.
+func (s *Snapshot) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case snapshot.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			s.ID = int(value.Int64)
+		case snapshot.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				s.CreatedAt = value.Time
+			}
+		case snapshot.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				s.UpdatedAt = value.Time
+			}
+		case snapshot.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				s.DeletedAt = new(time.Time)
+				*s.DeletedAt = value.Time
+			}
+		case snapshot.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				s.Name = value.String
+			}
+		case snapshot.FieldFileID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field file_id", values[i])
+			} else if value.Valid {
+				s.FileID = int(value.Int64)
+			}
+		case snapshot.FieldRootURI:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field root_uri", values[i])
+			} else if value.Valid {
+				s.RootURI = value.String
+			}
+		case snapshot.FieldFiles:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field files", values[i])
+			} else if value.Valid {
+				s.Files = int(value.Int64)
+			}
+		case snapshot.FieldSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size", values[i])
+			} else if value.Valid {
+				s.Size = value.Int64
+			}
+		case snapshot.FieldScheduled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field scheduled", values[i])
+			} else if value.Valid {
+				s.Scheduled = value.Bool
+			}
+		case snapshot.FieldManifest:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field manifest", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &s.Manifest); err != nil {
+					return fmt.Errorf("unmarshal field manifest: %w", err)
+				}
+			}
+		case snapshot.FieldOwnerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_id", values[i])
+			} else if value.Valid {
+				s.OwnerID = int(value.Int64)
+			}
+		default:
+			s.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Snapshot.
+// This includes values selected through modifiers, order, etc.
+func (s *Snapshot) Value(name string) (ent.Value, error) {
+	return s.selectValues.Get(name)
+}
+
+// QueryOwner queries the "owner" edge of the Snapshot entity.
+func (s *Snapshot) QueryOwner() *UserQuery {
+	return NewSnapshotClient(s.config).QueryOwner(s)
+}
+
+// Update returns a builder for updating this Snapshot.
+// Note that you need to call Snapshot.Unwrap() before calling this method if this Snapshot
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (s *Snapshot) Update() *SnapshotUpdateOne {
+	return NewSnapshotClient(s.config).UpdateOne(s)
+}
+
+// Unwrap unwraps the Snapshot entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (s *Snapshot) Unwrap() *Snapshot {
+	_tx, ok := s.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Snapshot is not a transactional entity")
+	}
+	s.config.driver = _tx.drv
+	return s
+}
+
+// String implements the fmt.Stringer.
+func (s *Snapshot) String() string {
+	var builder strings.Builder
+	builder.WriteString("Snapshot(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", s.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(s.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(s.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := s.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(s.Name)
+	builder.WriteString(", ")
+	builder.WriteString("file_id=")
+	builder.WriteString(fmt.Sprintf("%v", s.FileID))
+	builder.WriteString(", ")
+	builder.WriteString("root_uri=")
+	builder.WriteString(s.RootURI)
+	builder.WriteString(", ")
+	builder.WriteString("files=")
+	builder.WriteString(fmt.Sprintf("%v", s.Files))
+	builder.WriteString(", ")
+	builder.WriteString("size=")
+	builder.WriteString(fmt.Sprintf("%v", s.Size))
+	builder.WriteString(", ")
+	builder.WriteString("scheduled=")
+	builder.WriteString(fmt.Sprintf("%v", s.Scheduled))
+	builder.WriteString(", ")
+	builder.WriteString("manifest=")
+	builder.WriteString(fmt.Sprintf("%v", s.Manifest))
+	builder.WriteString(", ")
+	builder.WriteString("owner_id=")
+	builder.WriteString(fmt.Sprintf("%v", s.OwnerID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SetOwner manually set the edge as loaded state.
+func (e *Snapshot) SetOwner(v *User) {
+	e.Edges.Owner = v
+	e.Edges.loadedTypes[0] = true
+}
+
+// Snapshots is a parsable slice of Snapshot.
+type Snapshots []*Snapshot
@@ -0,0 +1,1169 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/snapshot"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+)
+
+// SnapshotCreate is the builder for creating a Snapshot entity.
+type SnapshotCreate struct {
+	config
+	mutation *SnapshotMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sc *SnapshotCreate) SetCreatedAt(t time.Time) *SnapshotCreate {
+	sc.mutation.SetCreatedAt(t)
+	return sc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sc *SnapshotCreate) SetNillableCreatedAt(t *time.Time) *SnapshotCreate {
+	if t != nil {
+		sc.SetCreatedAt(*t)
+	}
+	return sc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sc *SnapshotCreate) SetUpdatedAt(t time.Time) *SnapshotCreate {
+	sc.mutation.SetUpdatedAt(t)
+	return sc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (sc *SnapshotCreate) SetNillableUpdatedAt(t *time.Time) *SnapshotCreate {
+	if t != nil {
+		sc.SetUpdatedAt(*t)
+	}
+	return sc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (sc *SnapshotCreate) SetDeletedAt(t time.Time) *SnapshotCreate {
+	sc.mutation.SetDeletedAt(t)
+	return sc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (sc *SnapshotCreate) SetNillableDeletedAt(t *time.Time) *SnapshotCreate {
+	if t != nil {
+		sc.SetDeletedAt(*t)
+	}
+	return sc
+}
+
+// SetName sets the "name" field.
+func (sc *SnapshotCreate) SetName(s string) *SnapshotCreate {
+	sc.mutation.SetName(s)
+	return sc
+}
+
+// SetFileID sets the "file_id" field.
+func (sc *SnapshotCreate) SetFileID(i int) *SnapshotCreate {
+	sc.mutation.SetFileID(i)
+	return sc
+}
+
+// SetRootURI sets the "root_uri" field.
+func (sc *SnapshotCreate) SetRootURI(s string) *SnapshotCreate {
+	sc.mutation.SetRootURI(s)
+	return sc
+}
+
+// SetFiles sets the "files" field.
+func (sc *SnapshotCreate) SetFiles(i int) *SnapshotCreate {
+	sc.mutation.SetFiles(i)
+	return sc
+}
+
+// SetNillableFiles sets the "files" field if the given value is not nil.
+func (sc *SnapshotCreate) SetNillableFiles(i *int) *SnapshotCreate {
+	if i != nil {
+		sc.SetFiles(*i)
+	}
+	return sc
+}
+
+// SetSize sets the "size" field.
+func (sc *SnapshotCreate) SetSize(i int64) *SnapshotCreate {
+	sc.mutation.SetSize(i)
+	return sc
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (sc *SnapshotCreate) SetNillableSize(i *int64) *SnapshotCreate {
+	if i != nil {
+		sc.SetSize(*i)
+	}
+	return sc
+}
+
+// SetScheduled sets the "scheduled" field.
+func (sc *SnapshotCreate) SetScheduled(b bool) *SnapshotCreate {
+	sc.mutation.SetScheduled(b)
+	return sc
+}
+
+// SetNillableScheduled sets the "scheduled" field if the given value is not nil.
+func (sc *SnapshotCreate) SetNillableScheduled(b *bool) *SnapshotCreate {
+	if b != nil {
+		sc.SetScheduled(*b)
+	}
+	return sc
+}
+
+// SetManifest sets the "manifest" field.
+func (sc *SnapshotCreate) SetManifest(tn []types.SnapshotNode) *SnapshotCreate {
+	sc.mutation.SetManifest(tn)
+	return sc
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (sc *SnapshotCreate) SetOwnerID(i int) *SnapshotCreate {
+	sc.mutation.SetOwnerID(i)
+	return sc
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (sc *SnapshotCreate) SetOwner(u *User) *SnapshotCreate {
+	return sc.SetOwnerID(u.ID)
+}
+
+// Mutation returns the SnapshotMutation object of the builder.
+func (sc *SnapshotCreate) Mutation() *SnapshotMutation {
+	return sc.mutation
+}
+
+// Save creates the Snapshot in the database.
+func (sc *SnapshotCreate) Save(ctx context.Context) (*Snapshot, error) {
+	if err := sc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, sc.sqlSave, sc.mutation, sc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sc *SnapshotCreate) SaveX(ctx context.Context) *Snapshot {
+	v, err := sc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sc *SnapshotCreate) Exec(ctx context.Context) error {
+	_, err := sc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sc *SnapshotCreate) ExecX(ctx context.Context) {
+	if err := sc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sc *SnapshotCreate) defaults() error {
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		if snapshot.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized snapshot.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := snapshot.DefaultCreatedAt()
+		sc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sc.mutation.UpdatedAt(); !ok {
+		if snapshot.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized snapshot.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := snapshot.DefaultUpdatedAt()
+		sc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := sc.mutation.Files(); !ok {
+		v := snapshot.DefaultFiles
+		sc.mutation.SetFiles(v)
+	}
+	if _, ok := sc.mutation.Size(); !ok {
+		v := snapshot.DefaultSize
+		sc.mutation.SetSize(v)
+	}
+	if _, ok := sc.mutation.Scheduled(); !ok {
+		v := snapshot.DefaultScheduled
+		sc.mutation.SetScheduled(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sc *SnapshotCreate) check() error {
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Snapshot.created_at"`)}
+	}
+	if _, ok := sc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Snapshot.updated_at"`)}
+	}
+	if _, ok := sc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Snapshot.name"`)}
+	}
+	if _, ok := sc.mutation.FileID(); !ok {
+		return &ValidationError{Name: "file_id", err: errors.New(`ent: missing required field "Snapshot.file_id"`)}
+	}
+	if _, ok := sc.mutation.RootURI(); !ok {
+		return &ValidationError{Name: "root_uri", err: errors.New(`ent: missing required field "Snapshot.root_uri"`)}
+	}
+	if _, ok := sc.mutation.Files(); !ok {
+		return &ValidationError{Name: "files", err: errors.New(`ent: missing required field "Snapshot.files"`)}
+	}
+	if _, ok := sc.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "Snapshot.size"`)}
+	}
+	if _, ok := sc.mutation.Scheduled(); !ok {
+		return &ValidationError{Name: "scheduled", err: errors.New(`ent: missing required field "Snapshot.scheduled"`)}
+	}
+	if _, ok := sc.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner_id", err: errors.New(`ent: missing required field "Snapshot.owner_id"`)}
+	}
+	if _, ok := sc.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required edge "Snapshot.owner"`)}
+	}
+	return nil
+}
+
+func (sc *SnapshotCreate) sqlSave(ctx context.Context) (*Snapshot, error) {
+	if err := sc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	sc.mutation.id = &_node.ID
+	sc.mutation.done = true
+	return _node, nil
+}
+
+func (sc *SnapshotCreate) createSpec() (*Snapshot, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Snapshot{config: sc.config}
+		_spec = sqlgraph.NewCreateSpec(snapshot.Table, sqlgraph.NewFieldSpec(snapshot.FieldID, field.TypeInt))
+	)
+
+	if id, ok := sc.mutation.ID(); ok {
+		_node.ID = id
+		id64 := int64(id)
+		_spec.ID.Value = id64
+	}
+
+	_spec.OnConflict = sc.conflict
+	if value, ok := sc.mutation.CreatedAt(); ok {
+		_spec.SetField(snapshot.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := sc.mutation.UpdatedAt(); ok {
+		_spec.SetField(snapshot.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := sc.mutation.DeletedAt(); ok {
+		_spec.SetField(snapshot.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := sc.mutation.Name(); ok {
+		_spec.SetField(snapshot.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := sc.mutation.FileID(); ok {
+		_spec.SetField(snapshot.FieldFileID, field.TypeInt, value)
+		_node.FileID = value
+	}
+	if value, ok := sc.mutation.RootURI(); ok {
+		_spec.SetField(snapshot.FieldRootURI, field.TypeString, value)
+		_node.RootURI = value
+	}
+	if value, ok := sc.mutation.Files(); ok {
+		_spec.SetField(snapshot.FieldFiles, field.TypeInt, value)
+		_node.Files = value
+	}
+	if value, ok := sc.mutation.Size(); ok {
+		_spec.SetField(snapshot.FieldSize, field.TypeInt64, value)
+		_node.Size = value
+	}
+	if value, ok := sc.mutation.Scheduled(); ok {
+		_spec.SetField(snapshot.FieldScheduled, field.TypeBool, value)
+		_node.Scheduled = value
+	}
+	if value, ok := sc.mutation.Manifest(); ok {
+		_spec.SetField(snapshot.FieldManifest, field.TypeJSON, value)
+		_node.Manifest = value
+	}
+	if nodes := sc.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   snapshot.OwnerTable,
+			Columns: []string{snapshot.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OwnerID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Snapshot.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SnapshotUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (sc *SnapshotCreate) OnConflict(opts ...sql.ConflictOption) *SnapshotUpsertOne {
+	sc.conflict = opts
+	return &SnapshotUpsertOne{
+		create: sc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Snapshot.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sc *SnapshotCreate) OnConflictColumns(columns ...string) *SnapshotUpsertOne {
+	sc.conflict = append(sc.conflict, sql.ConflictColumns(columns...))
+	return &SnapshotUpsertOne{
+		create: sc,
+	}
+}
+
+type (
+	// SnapshotUpsertOne is the builder for "upsert"-ing
+	//  one Snapshot node.
+	SnapshotUpsertOne struct {
+		create *SnapshotCreate
+	}
+
+	// SnapshotUpsert is the "OnConflict" setter.
+	SnapshotUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SnapshotUpsert) SetUpdatedAt(v time.Time) *SnapshotUpsert {
+	u.Set(snapshot.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SnapshotUpsert) UpdateUpdatedAt() *SnapshotUpsert {
+	u.SetExcluded(snapshot.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *SnapshotUpsert) SetDeletedAt(v time.Time) *SnapshotUpsert {
+	u.Set(snapshot.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *SnapshotUpsert) UpdateDeletedAt() *SnapshotUpsert {
+	u.SetExcluded(snapshot.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *SnapshotUpsert) ClearDeletedAt() *SnapshotUpsert {
+	u.SetNull(snapshot.FieldDeletedAt)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *SnapshotUpsert) SetName(v string) *SnapshotUpsert {
+	u.Set(snapshot.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *SnapshotUpsert) UpdateName() *SnapshotUpsert {
+	u.SetExcluded(snapshot.FieldName)
+	return u
+}
+
+// SetFileID sets the "file_id" field.
+func (u *SnapshotUpsert) SetFileID(v int) *SnapshotUpsert {
+	u.Set(snapshot.FieldFileID, v)
+	return u
+}
+
+// UpdateFileID sets the "file_id" field to the value that was provided on create.
+func (u *SnapshotUpsert) UpdateFileID() *SnapshotUpsert {
+	u.SetExcluded(snapshot.FieldFileID)
+	return u
+}
+
+// AddFileID adds v to the "file_id" field.
+func (u *SnapshotUpsert) AddFileID(v int) *SnapshotUpsert {
+	u.Add(snapshot.FieldFileID, v)
+	return u
+}
+
+// SetRootURI sets the "root_uri" field.
+func (u *SnapshotUpsert) SetRootURI(v string) *SnapshotUpsert {
+	u.Set(snapshot.FieldRootURI, v)
+	return u
+}
+
+// UpdateRootURI sets the "root_uri" field to the value that was provided on create.
+func (u *SnapshotUpsert) UpdateRootURI() *SnapshotUpsert {
+	u.SetExcluded(snapshot.FieldRootURI)
+	return u
+}
+
+// SetFiles sets the "files" field.
+func (u *SnapshotUpsert) SetFiles(v int) *SnapshotUpsert {
+	u.Set(snapshot.FieldFiles, v)
+	return u
+}
+
+// UpdateFiles sets the "files" field to the value that was provided on create.
+func (u *SnapshotUpsert) UpdateFiles() *SnapshotUpsert {
+	u.SetExcluded(snapshot.FieldFiles)
+	return u
+}
+
+// AddFiles adds v to the "files" field.
+func (u *SnapshotUpsert) AddFiles(v int) *SnapshotUpsert {
+	u.Add(snapshot.FieldFiles, v)
+	return u
+}
+
+// SetSize sets the "size" field.
+func (u *SnapshotUpsert) SetSize(v int64) *SnapshotUpsert {
+	u.Set(snapshot.FieldSize, v)
+	return u
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *SnapshotUpsert) UpdateSize() *SnapshotUpsert {
+	u.SetExcluded(snapshot.FieldSize)
+	return u
+}
+
+// AddSize adds v to the "size" field.
+func (u *SnapshotUpsert) AddSize(v int64) *SnapshotUpsert {
+	u.Add(snapshot.FieldSize, v)
+	return u
+}
+
+// SetScheduled sets the "scheduled" field.
+func (u *SnapshotUpsert) SetScheduled(v bool) *SnapshotUpsert {
+	u.Set(snapshot.FieldScheduled, v)
+	return u
+}
+
+// UpdateScheduled sets the "scheduled" field to the value that was provided on create.
+func (u *SnapshotUpsert) UpdateScheduled() *SnapshotUpsert {
+	u.SetExcluded(snapshot.FieldScheduled)
+	return u
+}
+
+// SetManifest sets the "manifest" field.
+func (u *SnapshotUpsert) SetManifest(v []types.SnapshotNode) *SnapshotUpsert {
+	u.Set(snapshot.FieldManifest, v)
+	return u
+}
+
+// UpdateManifest sets the "manifest" field to the value that was provided on create.
+func (u *SnapshotUpsert) UpdateManifest() *SnapshotUpsert {
+	u.SetExcluded(snapshot.FieldManifest)
+	return u
+}
+
+// ClearManifest clears the value of the "manifest" field.
+func (u *SnapshotUpsert) ClearManifest() *SnapshotUpsert {
+	u.SetNull(snapshot.FieldManifest)
+	return u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (u *SnapshotUpsert) SetOwnerID(v int) *SnapshotUpsert {
+	u.Set(snapshot.FieldOwnerID, v)
+	return u
+}
+
+// UpdateOwnerID sets the "owner_id" field to the value that was provided on create.
+func (u *SnapshotUpsert) UpdateOwnerID() *SnapshotUpsert {
+	u.SetExcluded(snapshot.FieldOwnerID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Snapshot.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *SnapshotUpsertOne) UpdateNewValues() *SnapshotUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(snapshot.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Snapshot.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SnapshotUpsertOne) Ignore() *SnapshotUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SnapshotUpsertOne) DoNothing() *SnapshotUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SnapshotCreate.OnConflict
+// documentation for more info.
+func (u *SnapshotUpsertOne) Update(set func(*SnapshotUpsert)) *SnapshotUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SnapshotUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SnapshotUpsertOne) SetUpdatedAt(v time.Time) *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SnapshotUpsertOne) UpdateUpdatedAt() *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *SnapshotUpsertOne) SetDeletedAt(v time.Time) *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *SnapshotUpsertOne) UpdateDeletedAt() *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *SnapshotUpsertOne) ClearDeletedAt() *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *SnapshotUpsertOne) SetName(v string) *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *SnapshotUpsertOne) UpdateName() *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetFileID sets the "file_id" field.
+func (u *SnapshotUpsertOne) SetFileID(v int) *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.SetFileID(v)
+	})
+}
+
+// AddFileID adds v to the "file_id" field.
+func (u *SnapshotUpsertOne) AddFileID(v int) *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.AddFileID(v)
+	})
+}
+
+// UpdateFileID sets the "file_id" field to the value that was provided on create.
+func (u *SnapshotUpsertOne) UpdateFileID() *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.UpdateFileID()
+	})
+}
+
+// SetRootURI sets the "root_uri" field.
+func (u *SnapshotUpsertOne) SetRootURI(v string) *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.SetRootURI(v)
+	})
+}
+
+// UpdateRootURI sets the "root_uri" field to the value that was provided on create.
+func (u *SnapshotUpsertOne) UpdateRootURI() *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.UpdateRootURI()
+	})
+}
+
+// SetFiles sets the "files" field.
+func (u *SnapshotUpsertOne) SetFiles(v int) *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.SetFiles(v)
+	})
+}
+
+// AddFiles adds v to the "files" field.
+func (u *SnapshotUpsertOne) AddFiles(v int) *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.AddFiles(v)
+	})
+}
+
+// UpdateFiles sets the "files" field to the value that was provided on create.
+func (u *SnapshotUpsertOne) UpdateFiles() *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.UpdateFiles()
+	})
+}
+
+// SetSize sets the "size" field.
+func (u *SnapshotUpsertOne) SetSize(v int64) *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.SetSize(v)
+	})
+}
+
+// AddSize adds v to the "size" field.
+func (u *SnapshotUpsertOne) AddSize(v int64) *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.AddSize(v)
+	})
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *SnapshotUpsertOne) UpdateSize() *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.UpdateSize()
+	})
+}
+
+// SetScheduled sets the "scheduled" field.
+func (u *SnapshotUpsertOne) SetScheduled(v bool) *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.SetScheduled(v)
+	})
+}
+
+// UpdateScheduled sets the "scheduled" field to the value that was provided on create.
+func (u *SnapshotUpsertOne) UpdateScheduled() *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.UpdateScheduled()
+	})
+}
+
+// SetManifest sets the "manifest" field.
+func (u *SnapshotUpsertOne) SetManifest(v []types.SnapshotNode) *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.SetManifest(v)
+	})
+}
+
+// UpdateManifest sets the "manifest" field to the value that was provided on create.
+func (u *SnapshotUpsertOne) UpdateManifest() *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.UpdateManifest()
+	})
+}
+
+// ClearManifest clears the value of the "manifest" field.
+func (u *SnapshotUpsertOne) ClearManifest() *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.ClearManifest()
+	})
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (u *SnapshotUpsertOne) SetOwnerID(v int) *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.SetOwnerID(v)
+	})
+}
+
+// UpdateOwnerID sets the "owner_id" field to the value that was provided on create.
+func (u *SnapshotUpsertOne) UpdateOwnerID() *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.UpdateOwnerID()
+	})
+}
+
+// Exec executes the query.
+func (u *SnapshotUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SnapshotCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SnapshotUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SnapshotUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SnapshotUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (m *SnapshotCreate) SetRawID(t int) *SnapshotCreate {
+	m.mutation.SetRawID(t)
+	return m
+}
+
+// SnapshotCreateBulk is the builder for creating many Snapshot entities in bulk.
+type SnapshotCreateBulk struct {
+	config
+	err      error
+	builders []*SnapshotCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Snapshot entities in the database.
+func (scb *SnapshotCreateBulk) Save(ctx context.Context) ([]*Snapshot, error) {
+	if scb.err != nil {
+		return nil, scb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(scb.builders))
+	nodes := make([]*Snapshot, len(scb.builders))
+	mutators := make([]Mutator, len(scb.builders))
+	for i := range scb.builders {
+		func(i int, root context.Context) {
+			builder := scb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SnapshotMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, scb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = scb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, scb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, scb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scb *SnapshotCreateBulk) SaveX(ctx context.Context) []*Snapshot {
+	v, err := scb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scb *SnapshotCreateBulk) Exec(ctx context.Context) error {
+	_, err := scb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scb *SnapshotCreateBulk) ExecX(ctx context.Context) {
+	if err := scb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Snapshot.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SnapshotUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (scb *SnapshotCreateBulk) OnConflict(opts ...sql.ConflictOption) *SnapshotUpsertBulk {
+	scb.conflict = opts
+	return &SnapshotUpsertBulk{
+		create: scb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Snapshot.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (scb *SnapshotCreateBulk) OnConflictColumns(columns ...string) *SnapshotUpsertBulk {
+	scb.conflict = append(scb.conflict, sql.ConflictColumns(columns...))
+	return &SnapshotUpsertBulk{
+		create: scb,
+	}
+}
+
+// SnapshotUpsertBulk is the builder for "upsert"-ing
+// a bulk of Snapshot nodes.
+type SnapshotUpsertBulk struct {
+	create *SnapshotCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Snapshot.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *SnapshotUpsertBulk) UpdateNewValues() *SnapshotUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(snapshot.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Snapshot.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SnapshotUpsertBulk) Ignore() *SnapshotUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SnapshotUpsertBulk) DoNothing() *SnapshotUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SnapshotCreateBulk.OnConflict
+// documentation for more info.
+func (u *SnapshotUpsertBulk) Update(set func(*SnapshotUpsert)) *SnapshotUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SnapshotUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SnapshotUpsertBulk) SetUpdatedAt(v time.Time) *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SnapshotUpsertBulk) UpdateUpdatedAt() *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *SnapshotUpsertBulk) SetDeletedAt(v time.Time) *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *SnapshotUpsertBulk) UpdateDeletedAt() *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *SnapshotUpsertBulk) ClearDeletedAt() *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *SnapshotUpsertBulk) SetName(v string) *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *SnapshotUpsertBulk) UpdateName() *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetFileID sets the "file_id" field.
+func (u *SnapshotUpsertBulk) SetFileID(v int) *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.SetFileID(v)
+	})
+}
+
+// AddFileID adds v to the "file_id" field.
+func (u *SnapshotUpsertBulk) AddFileID(v int) *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.AddFileID(v)
+	})
+}
+
+// UpdateFileID sets the "file_id" field to the value that was provided on create.
+func (u *SnapshotUpsertBulk) UpdateFileID() *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.UpdateFileID()
+	})
+}
+
+// SetRootURI sets the "root_uri" field.
+func (u *SnapshotUpsertBulk) SetRootURI(v string) *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.SetRootURI(v)
+	})
+}
+
+// UpdateRootURI sets the "root_uri" field to the value that was provided on create.
+func (u *SnapshotUpsertBulk) UpdateRootURI() *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.UpdateRootURI()
+	})
+}
+
+// SetFiles sets the "files" field.
+func (u *SnapshotUpsertBulk) SetFiles(v int) *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.SetFiles(v)
+	})
+}
+
+// AddFiles adds v to the "files" field.
+func (u *SnapshotUpsertBulk) AddFiles(v int) *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.AddFiles(v)
+	})
+}
+
+// UpdateFiles sets the "files" field to the value that was provided on create.
+func (u *SnapshotUpsertBulk) UpdateFiles() *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.UpdateFiles()
+	})
+}
+
+// SetSize sets the "size" field.
+func (u *SnapshotUpsertBulk) SetSize(v int64) *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.SetSize(v)
+	})
+}
+
+// AddSize adds v to the "size" field.
+func (u *SnapshotUpsertBulk) AddSize(v int64) *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.AddSize(v)
+	})
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *SnapshotUpsertBulk) UpdateSize() *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.UpdateSize()
+	})
+}
+
+// SetScheduled sets the "scheduled" field.
+func (u *SnapshotUpsertBulk) SetScheduled(v bool) *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.SetScheduled(v)
+	})
+}
+
+// UpdateScheduled sets the "scheduled" field to the value that was provided on create.
+func (u *SnapshotUpsertBulk) UpdateScheduled() *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.UpdateScheduled()
+	})
+}
+
+// SetManifest sets the "manifest" field.
+func (u *SnapshotUpsertBulk) SetManifest(v []types.SnapshotNode) *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.SetManifest(v)
+	})
+}
+
+// UpdateManifest sets the "manifest" field to the value that was provided on create.
+func (u *SnapshotUpsertBulk) UpdateManifest() *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.UpdateManifest()
+	})
+}
+
+// ClearManifest clears the value of the "manifest" field.
+func (u *SnapshotUpsertBulk) ClearManifest() *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.ClearManifest()
+	})
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (u *SnapshotUpsertBulk) SetOwnerID(v int) *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.SetOwnerID(v)
+	})
+}
+
+// UpdateOwnerID sets the "owner_id" field to the value that was provided on create.
+func (u *SnapshotUpsertBulk) UpdateOwnerID() *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.UpdateOwnerID()
+	})
+}
+
+// Exec executes the query.
+func (u *SnapshotUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SnapshotCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SnapshotCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SnapshotUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,731 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/ent/snapshot"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+)
+
+// SnapshotUpdate is the builder for updating Snapshot entities.
+type SnapshotUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SnapshotMutation
+}
+
+// Where appends a list predicates to the SnapshotUpdate builder.
+func (su *SnapshotUpdate) Where(ps ...predicate.Snapshot) *SnapshotUpdate {
+	su.mutation.Where(ps...)
+	return su
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (su *SnapshotUpdate) SetUpdatedAt(t time.Time) *SnapshotUpdate {
+	su.mutation.SetUpdatedAt(t)
+	return su
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (su *SnapshotUpdate) SetDeletedAt(t time.Time) *SnapshotUpdate {
+	su.mutation.SetDeletedAt(t)
+	return su
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (su *SnapshotUpdate) SetNillableDeletedAt(t *time.Time) *SnapshotUpdate {
+	if t != nil {
+		su.SetDeletedAt(*t)
+	}
+	return su
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (su *SnapshotUpdate) ClearDeletedAt() *SnapshotUpdate {
+	su.mutation.ClearDeletedAt()
+	return su
+}
+
+// SetName sets the "name" field.
+func (su *SnapshotUpdate) SetName(s string) *SnapshotUpdate {
+	su.mutation.SetName(s)
+	return su
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (su *SnapshotUpdate) SetNillableName(s *string) *SnapshotUpdate {
+	if s != nil {
+		su.SetName(*s)
+	}
+	return su
+}
+
+// SetFileID sets the "file_id" field.
+func (su *SnapshotUpdate) SetFileID(i int) *SnapshotUpdate {
+	su.mutation.ResetFileID()
+	su.mutation.SetFileID(i)
+	return su
+}
+
+// SetNillableFileID sets the "file_id" field if the given value is not nil.
+func (su *SnapshotUpdate) SetNillableFileID(i *int) *SnapshotUpdate {
+	if i != nil {
+		su.SetFileID(*i)
+	}
+	return su
+}
+
+// AddFileID adds i to the "file_id" field.
+func (su *SnapshotUpdate) AddFileID(i int) *SnapshotUpdate {
+	su.mutation.AddFileID(i)
+	return su
+}
+
+// SetRootURI sets the "root_uri" field.
+func (su *SnapshotUpdate) SetRootURI(s string) *SnapshotUpdate {
+	su.mutation.SetRootURI(s)
+	return su
+}
+
+// SetNillableRootURI sets the "root_uri" field if the given value is not nil.
+func (su *SnapshotUpdate) SetNillableRootURI(s *string) *SnapshotUpdate {
+	if s != nil {
+		su.SetRootURI(*s)
+	}
+	return su
+}
+
+// SetFiles sets the "files" field.
+func (su *SnapshotUpdate) SetFiles(i int) *SnapshotUpdate {
+	su.mutation.ResetFiles()
+	su.mutation.SetFiles(i)
+	return su
+}
+
+// SetNillableFiles sets the "files" field if the given value is not nil.
+func (su *SnapshotUpdate) SetNillableFiles(i *int) *SnapshotUpdate {
+	if i != nil {
+		su.SetFiles(*i)
+	}
+	return su
+}
+
+// AddFiles adds i to the "files" field.
+func (su *SnapshotUpdate) AddFiles(i int) *SnapshotUpdate {
+	su.mutation.AddFiles(i)
+	return su
+}
+
+// SetSize sets the "size" field.
+func (su *SnapshotUpdate) SetSize(i int64) *SnapshotUpdate {
+	su.mutation.ResetSize()
+	su.mutation.SetSize(i)
+	return su
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (su *SnapshotUpdate) SetNillableSize(i *int64) *SnapshotUpdate {
+	if i != nil {
+		su.SetSize(*i)
+	}
+	return su
+}
+
+// AddSize adds i to the "size" field.
+func (su *SnapshotUpdate) AddSize(i int64) *SnapshotUpdate {
+	su.mutation.AddSize(i)
+	return su
+}
+
+// SetScheduled sets the "scheduled" field.
+func (su *SnapshotUpdate) SetScheduled(b bool) *SnapshotUpdate {
+	su.mutation.SetScheduled(b)
+	return su
+}
+
+// SetNillableScheduled sets the "scheduled" field if the given value is not nil.
+func (su *SnapshotUpdate) SetNillableScheduled(b *bool) *SnapshotUpdate {
+	if b != nil {
+		su.SetScheduled(*b)
+	}
+	return su
+}
+
+// SetManifest sets the "manifest" field.
+func (su *SnapshotUpdate) SetManifest(tn []types.SnapshotNode) *SnapshotUpdate {
+	su.mutation.SetManifest(tn)
+	return su
+}
+
+// AppendManifest appends tn to the "manifest" field.
+func (su *SnapshotUpdate) AppendManifest(tn []types.SnapshotNode) *SnapshotUpdate {
+	su.mutation.AppendManifest(tn)
+	return su
+}
+
+// ClearManifest clears the value of the "manifest" field.
+func (su *SnapshotUpdate) ClearManifest() *SnapshotUpdate {
+	su.mutation.ClearManifest()
+	return su
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (su *SnapshotUpdate) SetOwnerID(i int) *SnapshotUpdate {
+	su.mutation.SetOwnerID(i)
+	return su
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (su *SnapshotUpdate) SetNillableOwnerID(i *int) *SnapshotUpdate {
+	if i != nil {
+		su.SetOwnerID(*i)
+	}
+	return su
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (su *SnapshotUpdate) SetOwner(u *User) *SnapshotUpdate {
+	return su.SetOwnerID(u.ID)
+}
+
+// Mutation returns the SnapshotMutation object of the builder.
+func (su *SnapshotUpdate) Mutation() *SnapshotMutation {
+	return su.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (su *SnapshotUpdate) ClearOwner() *SnapshotUpdate {
+	su.mutation.ClearOwner()
+	return su
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (su *SnapshotUpdate) Save(ctx context.Context) (int, error) {
+	if err := su.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, su.sqlSave, su.mutation, su.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (su *SnapshotUpdate) SaveX(ctx context.Context) int {
+	affected, err := su.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (su *SnapshotUpdate) Exec(ctx context.Context) error {
+	_, err := su.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (su *SnapshotUpdate) ExecX(ctx context.Context) {
+	if err := su.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (su *SnapshotUpdate) defaults() error {
+	if _, ok := su.mutation.UpdatedAt(); !ok {
+		if snapshot.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized snapshot.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := snapshot.UpdateDefaultUpdatedAt()
+		su.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (su *SnapshotUpdate) check() error {
+	if _, ok := su.mutation.OwnerID(); su.mutation.OwnerCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Snapshot.owner"`)
+	}
+	return nil
+}
+
+func (su *SnapshotUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := su.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(snapshot.Table, snapshot.Columns, sqlgraph.NewFieldSpec(snapshot.FieldID, field.TypeInt))
+	if ps := su.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := su.mutation.UpdatedAt(); ok {
+		_spec.SetField(snapshot.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := su.mutation.DeletedAt(); ok {
+		_spec.SetField(snapshot.FieldDeletedAt, field.TypeTime, value)
+	}
+	if su.mutation.DeletedAtCleared() {
+		_spec.ClearField(snapshot.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := su.mutation.Name(); ok {
+		_spec.SetField(snapshot.FieldName, field.TypeString, value)
+	}
+	if value, ok := su.mutation.FileID(); ok {
+		_spec.SetField(snapshot.FieldFileID, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AddedFileID(); ok {
+		_spec.AddField(snapshot.FieldFileID, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.RootURI(); ok {
+		_spec.SetField(snapshot.FieldRootURI, field.TypeString, value)
+	}
+	if value, ok := su.mutation.Files(); ok {
+		_spec.SetField(snapshot.FieldFiles, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AddedFiles(); ok {
+		_spec.AddField(snapshot.FieldFiles, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.Size(); ok {
+		_spec.SetField(snapshot.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := su.mutation.AddedSize(); ok {
+		_spec.AddField(snapshot.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := su.mutation.Scheduled(); ok {
+		_spec.SetField(snapshot.FieldScheduled, field.TypeBool, value)
+	}
+	if value, ok := su.mutation.Manifest(); ok {
+		_spec.SetField(snapshot.FieldManifest, field.TypeJSON, value)
+	}
+	if value, ok := su.mutation.AppendedManifest(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, snapshot.FieldManifest, value)
+		})
+	}
+	if su.mutation.ManifestCleared() {
+		_spec.ClearField(snapshot.FieldManifest, field.TypeJSON)
+	}
+	if su.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   snapshot.OwnerTable,
+			Columns: []string{snapshot.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   snapshot.OwnerTable,
+			Columns: []string{snapshot.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{snapshot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	su.mutation.done = true
+	return n, nil
+}
+
+// SnapshotUpdateOne is the builder for updating a single Snapshot entity.
+type SnapshotUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SnapshotMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (suo *SnapshotUpdateOne) SetUpdatedAt(t time.Time) *SnapshotUpdateOne {
+	suo.mutation.SetUpdatedAt(t)
+	return suo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (suo *SnapshotUpdateOne) SetDeletedAt(t time.Time) *SnapshotUpdateOne {
+	suo.mutation.SetDeletedAt(t)
+	return suo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (suo *SnapshotUpdateOne) SetNillableDeletedAt(t *time.Time) *SnapshotUpdateOne {
+	if t != nil {
+		suo.SetDeletedAt(*t)
+	}
+	return suo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (suo *SnapshotUpdateOne) ClearDeletedAt() *SnapshotUpdateOne {
+	suo.mutation.ClearDeletedAt()
+	return suo
+}
+
+// SetName sets the "name" field.
+func (suo *SnapshotUpdateOne) SetName(s string) *SnapshotUpdateOne {
+	suo.mutation.SetName(s)
+	return suo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (suo *SnapshotUpdateOne) SetNillableName(s *string) *SnapshotUpdateOne {
+	if s != nil {
+		suo.SetName(*s)
+	}
+	return suo
+}
+
+// SetFileID sets the "file_id" field.
+func (suo *SnapshotUpdateOne) SetFileID(i int) *SnapshotUpdateOne {
+	suo.mutation.ResetFileID()
+	suo.mutation.SetFileID(i)
+	return suo
+}
+
+// SetNillableFileID sets the "file_id" field if the given value is not nil.
+func (suo *SnapshotUpdateOne) SetNillableFileID(i *int) *SnapshotUpdateOne {
+	if i != nil {
+		suo.SetFileID(*i)
+	}
+	return suo
+}
+
+// AddFileID adds i to the "file_id" field.
+func (suo *SnapshotUpdateOne) AddFileID(i int) *SnapshotUpdateOne {
+	suo.mutation.AddFileID(i)
+	return suo
+}
+
+// SetRootURI sets the "root_uri" field.
+func (suo *SnapshotUpdateOne) SetRootURI(s string) *SnapshotUpdateOne {
+	suo.mutation.SetRootURI(s)
+	return suo
+}
+
+// SetNillableRootURI sets the "root_uri" field if the given value is not nil.
+func (suo *SnapshotUpdateOne) SetNillableRootURI(s *string) *SnapshotUpdateOne {
+	if s != nil {
+		suo.SetRootURI(*s)
+	}
+	return suo
+}
+
+// SetFiles sets the "files" field.
+func (suo *SnapshotUpdateOne) SetFiles(i int) *SnapshotUpdateOne {
+	suo.mutation.ResetFiles()
+	suo.mutation.SetFiles(i)
+	return suo
+}
+
+// SetNillableFiles sets the "files" field if the given value is not nil.
+func (suo *SnapshotUpdateOne) SetNillableFiles(i *int) *SnapshotUpdateOne {
+	if i != nil {
+		suo.SetFiles(*i)
+	}
+	return suo
+}
+
+// AddFiles adds i to the "files" field.
+func (suo *SnapshotUpdateOne) AddFiles(i int) *SnapshotUpdateOne {
+	suo.mutation.AddFiles(i)
+	return suo
+}
+
+// SetSize sets the "size" field.
+func (suo *SnapshotUpdateOne) SetSize(i int64) *SnapshotUpdateOne {
+	suo.mutation.ResetSize()
+	suo.mutation.SetSize(i)
+	return suo
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (suo *SnapshotUpdateOne) SetNillableSize(i *int64) *SnapshotUpdateOne {
+	if i != nil {
+		suo.SetSize(*i)
+	}
+	return suo
+}
+
+// AddSize adds i to the "size" field.
+func (suo *SnapshotUpdateOne) AddSize(i int64) *SnapshotUpdateOne {
+	suo.mutation.AddSize(i)
+	return suo
+}
+
+// SetScheduled sets the "scheduled" field.
+func (suo *SnapshotUpdateOne) SetScheduled(b bool) *SnapshotUpdateOne {
+	suo.mutation.SetScheduled(b)
+	return suo
+}
+
+// SetNillableScheduled sets the "scheduled" field if the given value is not nil.
+func (suo *SnapshotUpdateOne) SetNillableScheduled(b *bool) *SnapshotUpdateOne {
+	if b != nil {
+		suo.SetScheduled(*b)
+	}
+	return suo
+}
+
+// SetManifest sets the "manifest" field.
+func (suo *SnapshotUpdateOne) SetManifest(tn []types.SnapshotNode) *SnapshotUpdateOne {
+	suo.mutation.SetManifest(tn)
+	return suo
+}
+
+// AppendManifest appends tn to the "manifest" field.
+func (suo *SnapshotUpdateOne) AppendManifest(tn []types.SnapshotNode) *SnapshotUpdateOne {
+	suo.mutation.AppendManifest(tn)
+	return suo
+}
+
+// ClearManifest clears the value of the "manifest" field.
+func (suo *SnapshotUpdateOne) ClearManifest() *SnapshotUpdateOne {
+	suo.mutation.ClearManifest()
+	return suo
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (suo *SnapshotUpdateOne) SetOwnerID(i int) *SnapshotUpdateOne {
+	suo.mutation.SetOwnerID(i)
+	return suo
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (suo *SnapshotUpdateOne) SetNillableOwnerID(i *int) *SnapshotUpdateOne {
+	if i != nil {
+		suo.SetOwnerID(*i)
+	}
+	return suo
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (suo *SnapshotUpdateOne) SetOwner(u *User) *SnapshotUpdateOne {
+	return suo.SetOwnerID(u.ID)
+}
+
+// Mutation returns the SnapshotMutation object of the builder.
+func (suo *SnapshotUpdateOne) Mutation() *SnapshotMutation {
+	return suo.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (suo *SnapshotUpdateOne) ClearOwner() *SnapshotUpdateOne {
+	suo.mutation.ClearOwner()
+	return suo
+}
+
+// Where appends a list predicates to the SnapshotUpdate builder.
+func (suo *SnapshotUpdateOne) Where(ps ...predicate.Snapshot) *SnapshotUpdateOne {
+	suo.mutation.Where(ps...)
+	return suo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (suo *SnapshotUpdateOne) Select(field string, fields ...string) *SnapshotUpdateOne {
+	suo.fields = append([]string{field}, fields...)
+	return suo
+}
+
+// Save executes the query and returns the updated Snapshot entity.
+func (suo *SnapshotUpdateOne) Save(ctx context.Context) (*Snapshot, error) {
+	if err := suo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, suo.sqlSave, suo.mutation, suo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (suo *SnapshotUpdateOne) SaveX(ctx context.Context) *Snapshot {
+	node, err := suo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (suo *SnapshotUpdateOne) Exec(ctx context.Context) error {
+	_, err := suo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (suo *SnapshotUpdateOne) ExecX(ctx context.Context) {
+	if err := suo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (suo *SnapshotUpdateOne) defaults() error {
+	if _, ok := suo.mutation.UpdatedAt(); !ok {
+		if snapshot.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized snapshot.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := snapshot.UpdateDefaultUpdatedAt()
+		suo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (suo *SnapshotUpdateOne) check() error {
+	if _, ok := suo.mutation.OwnerID(); suo.mutation.OwnerCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Snapshot.owner"`)
+	}
+	return nil
+}
+
+func (suo *SnapshotUpdateOne) sqlSave(ctx context.Context) (_node *Snapshot, err error) {
+	if err := suo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(snapshot.Table, snapshot.Columns, sqlgraph.NewFieldSpec(snapshot.FieldID, field.TypeInt))
+	id, ok := suo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Snapshot.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := suo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, snapshot.FieldID)
+		for _, f := range fields {
+			if !snapshot.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != snapshot.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := suo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := suo.mutation.UpdatedAt(); ok {
+		_spec.SetField(snapshot.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := suo.mutation.DeletedAt(); ok {
+		_spec.SetField(snapshot.FieldDeletedAt, field.TypeTime, value)
+	}
+	if suo.mutation.DeletedAtCleared() {
+		_spec.ClearField(snapshot.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := suo.mutation.Name(); ok {
+		_spec.SetField(snapshot.FieldName, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.FileID(); ok {
+		_spec.SetField(snapshot.FieldFileID, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedFileID(); ok {
+		_spec.AddField(snapshot.FieldFileID, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.RootURI(); ok {
+		_spec.SetField(snapshot.FieldRootURI, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.Files(); ok {
+		_spec.SetField(snapshot.FieldFiles, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedFiles(); ok {
+		_spec.AddField(snapshot.FieldFiles, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.Size(); ok {
+		_spec.SetField(snapshot.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := suo.mutation.AddedSize(); ok {
+		_spec.AddField(snapshot.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := suo.mutation.Scheduled(); ok {
+		_spec.SetField(snapshot.FieldScheduled, field.TypeBool, value)
+	}
+	if value, ok := suo.mutation.Manifest(); ok {
+		_spec.SetField(snapshot.FieldManifest, field.TypeJSON, value)
+	}
+	if value, ok := suo.mutation.AppendedManifest(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, snapshot.FieldManifest, value)
+		})
+	}
+	if suo.mutation.ManifestCleared() {
+		_spec.ClearField(snapshot.FieldManifest, field.TypeJSON)
+	}
+	if suo.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   snapshot.OwnerTable,
+			Columns: []string{snapshot.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   snapshot.OwnerTable,
+			Columns: []string{snapshot.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Snapshot{config: suo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, suo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{snapshot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	suo.mutation.done = true
+	return _node, nil
+}
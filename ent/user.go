@@ -68,9 +68,19 @@ type UserEdges struct {
 	Fsevents []*FsEvent `json:"fsevents,omitempty"`
 	// Entities holds the value of the entities edge.
 	Entities []*Entity `json:"entities,omitempty"`
+	// Snapshots holds the value of the snapshots edge.
+	Snapshots []*Snapshot `json:"snapshots,omitempty"`
+	// FileActivities holds the value of the file_activities edge.
+	FileActivities []*FileActivity `json:"file_activities,omitempty"`
+	// FeedSubscriptions holds the value of the feed_subscriptions edge.
+	FeedSubscriptions []*FeedSubscription `json:"feed_subscriptions,omitempty"`
+	// Albums holds the value of the albums edge.
+	Albums []*Album `json:"albums,omitempty"`
+	// Playlists holds the value of the playlists edge.
+	Playlists []*Playlist `json:"playlists,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [8]bool
+	loadedTypes [13]bool
 }
 
 // GroupOrErr returns the Group value or an error if the edge
@@ -149,6 +159,51 @@ func (e UserEdges) EntitiesOrErr() ([]*Entity, error) {
 	return nil, &NotLoadedError{edge: "entities"}
 }
 
+// SnapshotsOrErr returns the Snapshots value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SnapshotsOrErr() ([]*Snapshot, error) {
+	if e.loadedTypes[8] {
+		return e.Snapshots, nil
+	}
+	return nil, &NotLoadedError{edge: "snapshots"}
+}
+
+// FileActivitiesOrErr returns the FileActivities value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) FileActivitiesOrErr() ([]*FileActivity, error) {
+	if e.loadedTypes[9] {
+		return e.FileActivities, nil
+	}
+	return nil, &NotLoadedError{edge: "file_activities"}
+}
+
+// FeedSubscriptionsOrErr returns the FeedSubscriptions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) FeedSubscriptionsOrErr() ([]*FeedSubscription, error) {
+	if e.loadedTypes[10] {
+		return e.FeedSubscriptions, nil
+	}
+	return nil, &NotLoadedError{edge: "feed_subscriptions"}
+}
+
+// AlbumsOrErr returns the Albums value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) AlbumsOrErr() ([]*Album, error) {
+	if e.loadedTypes[11] {
+		return e.Albums, nil
+	}
+	return nil, &NotLoadedError{edge: "albums"}
+}
+
+// PlaylistsOrErr returns the Playlists value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) PlaylistsOrErr() ([]*Playlist, error) {
+	if e.loadedTypes[12] {
+		return e.Playlists, nil
+	}
+	return nil, &NotLoadedError{edge: "playlists"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -311,6 +366,31 @@ func (u *User) QueryEntities() *EntityQuery {
 	return NewUserClient(u.config).QueryEntities(u)
 }
 
+// QuerySnapshots queries the "snapshots" edge of the User entity.
+func (u *User) QuerySnapshots() *SnapshotQuery {
+	return NewUserClient(u.config).QuerySnapshots(u)
+}
+
+// QueryFileActivities queries the "file_activities" edge of the User entity.
+func (u *User) QueryFileActivities() *FileActivityQuery {
+	return NewUserClient(u.config).QueryFileActivities(u)
+}
+
+// QueryFeedSubscriptions queries the "feed_subscriptions" edge of the User entity.
+func (u *User) QueryFeedSubscriptions() *FeedSubscriptionQuery {
+	return NewUserClient(u.config).QueryFeedSubscriptions(u)
+}
+
+// QueryAlbums queries the "albums" edge of the User entity.
+func (u *User) QueryAlbums() *AlbumQuery {
+	return NewUserClient(u.config).QueryAlbums(u)
+}
+
+// QueryPlaylists queries the "playlists" edge of the User entity.
+func (u *User) QueryPlaylists() *PlaylistQuery {
+	return NewUserClient(u.config).QueryPlaylists(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -421,5 +501,35 @@ func (e *User) SetEntities(v []*Entity) {
 	e.Edges.loadedTypes[7] = true
 }
 
+// SetSnapshots manually set the edge as loaded state.
+func (e *User) SetSnapshots(v []*Snapshot) {
+	e.Edges.Snapshots = v
+	e.Edges.loadedTypes[8] = true
+}
+
+// SetFileActivities manually set the edge as loaded state.
+func (e *User) SetFileActivities(v []*FileActivity) {
+	e.Edges.FileActivities = v
+	e.Edges.loadedTypes[9] = true
+}
+
+// SetFeedSubscriptions manually set the edge as loaded state.
+func (e *User) SetFeedSubscriptions(v []*FeedSubscription) {
+	e.Edges.FeedSubscriptions = v
+	e.Edges.loadedTypes[10] = true
+}
+
+// SetAlbums manually set the edge as loaded state.
+func (e *User) SetAlbums(v []*Album) {
+	e.Edges.Albums = v
+	e.Edges.loadedTypes[11] = true
+}
+
+// SetPlaylists manually set the edge as loaded state.
+func (e *User) SetPlaylists(v []*Playlist) {
+	e.Edges.Playlists = v
+	e.Edges.loadedTypes[12] = true
+}
+
 // Users is a parsable slice of User.
 type Users []*User
@@ -11,14 +11,19 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/album"
 	"github.com/cloudreve/Cloudreve/v4/ent/davaccount"
 	"github.com/cloudreve/Cloudreve/v4/ent/entity"
+	"github.com/cloudreve/Cloudreve/v4/ent/feedsubscription"
 	"github.com/cloudreve/Cloudreve/v4/ent/file"
+	"github.com/cloudreve/Cloudreve/v4/ent/fileactivity"
 	"github.com/cloudreve/Cloudreve/v4/ent/fsevent"
 	"github.com/cloudreve/Cloudreve/v4/ent/group"
 	"github.com/cloudreve/Cloudreve/v4/ent/passkey"
+	"github.com/cloudreve/Cloudreve/v4/ent/playlist"
 	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
 	"github.com/cloudreve/Cloudreve/v4/ent/share"
+	"github.com/cloudreve/Cloudreve/v4/ent/snapshot"
 	"github.com/cloudreve/Cloudreve/v4/ent/task"
 	"github.com/cloudreve/Cloudreve/v4/ent/user"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
@@ -328,6 +333,81 @@ func (uu *UserUpdate) AddEntities(e ...*Entity) *UserUpdate {
 	return uu.AddEntityIDs(ids...)
 }
 
+// AddSnapshotIDs adds the "snapshots" edge to the Snapshot entity by IDs.
+func (uu *UserUpdate) AddSnapshotIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddSnapshotIDs(ids...)
+	return uu
+}
+
+// AddSnapshots adds the "snapshots" edges to the Snapshot entity.
+func (uu *UserUpdate) AddSnapshots(s ...*Snapshot) *UserUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uu.AddSnapshotIDs(ids...)
+}
+
+// AddFileActivityIDs adds the "file_activities" edge to the FileActivity entity by IDs.
+func (uu *UserUpdate) AddFileActivityIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddFileActivityIDs(ids...)
+	return uu
+}
+
+// AddFileActivities adds the "file_activities" edges to the FileActivity entity.
+func (uu *UserUpdate) AddFileActivities(f ...*FileActivity) *UserUpdate {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uu.AddFileActivityIDs(ids...)
+}
+
+// AddFeedSubscriptionIDs adds the "feed_subscriptions" edge to the FeedSubscription entity by IDs.
+func (uu *UserUpdate) AddFeedSubscriptionIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddFeedSubscriptionIDs(ids...)
+	return uu
+}
+
+// AddFeedSubscriptions adds the "feed_subscriptions" edges to the FeedSubscription entity.
+func (uu *UserUpdate) AddFeedSubscriptions(f ...*FeedSubscription) *UserUpdate {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uu.AddFeedSubscriptionIDs(ids...)
+}
+
+// AddAlbumIDs adds the "albums" edge to the Album entity by IDs.
+func (uu *UserUpdate) AddAlbumIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddAlbumIDs(ids...)
+	return uu
+}
+
+// AddAlbums adds the "albums" edges to the Album entity.
+func (uu *UserUpdate) AddAlbums(a ...*Album) *UserUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uu.AddAlbumIDs(ids...)
+}
+
+// AddPlaylistIDs adds the "playlists" edge to the Playlist entity by IDs.
+func (uu *UserUpdate) AddPlaylistIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddPlaylistIDs(ids...)
+	return uu
+}
+
+// AddPlaylists adds the "playlists" edges to the Playlist entity.
+func (uu *UserUpdate) AddPlaylists(p ...*Playlist) *UserUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uu.AddPlaylistIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uu *UserUpdate) Mutation() *UserMutation {
 	return uu.mutation
@@ -486,6 +566,111 @@ func (uu *UserUpdate) RemoveEntities(e ...*Entity) *UserUpdate {
 	return uu.RemoveEntityIDs(ids...)
 }
 
+// ClearSnapshots clears all "snapshots" edges to the Snapshot entity.
+func (uu *UserUpdate) ClearSnapshots() *UserUpdate {
+	uu.mutation.ClearSnapshots()
+	return uu
+}
+
+// RemoveSnapshotIDs removes the "snapshots" edge to Snapshot entities by IDs.
+func (uu *UserUpdate) RemoveSnapshotIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveSnapshotIDs(ids...)
+	return uu
+}
+
+// RemoveSnapshots removes "snapshots" edges to Snapshot entities.
+func (uu *UserUpdate) RemoveSnapshots(s ...*Snapshot) *UserUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uu.RemoveSnapshotIDs(ids...)
+}
+
+// ClearFileActivities clears all "file_activities" edges to the FileActivity entity.
+func (uu *UserUpdate) ClearFileActivities() *UserUpdate {
+	uu.mutation.ClearFileActivities()
+	return uu
+}
+
+// RemoveFileActivityIDs removes the "file_activities" edge to FileActivity entities by IDs.
+func (uu *UserUpdate) RemoveFileActivityIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveFileActivityIDs(ids...)
+	return uu
+}
+
+// RemoveFileActivities removes "file_activities" edges to FileActivity entities.
+func (uu *UserUpdate) RemoveFileActivities(f ...*FileActivity) *UserUpdate {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uu.RemoveFileActivityIDs(ids...)
+}
+
+// ClearFeedSubscriptions clears all "feed_subscriptions" edges to the FeedSubscription entity.
+func (uu *UserUpdate) ClearFeedSubscriptions() *UserUpdate {
+	uu.mutation.ClearFeedSubscriptions()
+	return uu
+}
+
+// RemoveFeedSubscriptionIDs removes the "feed_subscriptions" edge to FeedSubscription entities by IDs.
+func (uu *UserUpdate) RemoveFeedSubscriptionIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveFeedSubscriptionIDs(ids...)
+	return uu
+}
+
+// RemoveFeedSubscriptions removes "feed_subscriptions" edges to FeedSubscription entities.
+func (uu *UserUpdate) RemoveFeedSubscriptions(f ...*FeedSubscription) *UserUpdate {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uu.RemoveFeedSubscriptionIDs(ids...)
+}
+
+// ClearAlbums clears all "albums" edges to the Album entity.
+func (uu *UserUpdate) ClearAlbums() *UserUpdate {
+	uu.mutation.ClearAlbums()
+	return uu
+}
+
+// RemoveAlbumIDs removes the "albums" edge to Album entities by IDs.
+func (uu *UserUpdate) RemoveAlbumIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveAlbumIDs(ids...)
+	return uu
+}
+
+// RemoveAlbums removes "albums" edges to Album entities.
+func (uu *UserUpdate) RemoveAlbums(a ...*Album) *UserUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uu.RemoveAlbumIDs(ids...)
+}
+
+// ClearPlaylists clears all "playlists" edges to the Playlist entity.
+func (uu *UserUpdate) ClearPlaylists() *UserUpdate {
+	uu.mutation.ClearPlaylists()
+	return uu
+}
+
+// RemovePlaylistIDs removes the "playlists" edge to Playlist entities by IDs.
+func (uu *UserUpdate) RemovePlaylistIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemovePlaylistIDs(ids...)
+	return uu
+}
+
+// RemovePlaylists removes "playlists" edges to Playlist entities.
+func (uu *UserUpdate) RemovePlaylists(p ...*Playlist) *UserUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uu.RemovePlaylistIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
 	if err := uu.defaults(); err != nil {
@@ -955,6 +1140,231 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if uu.mutation.SnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SnapshotsTable,
+			Columns: []string{user.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshot.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedSnapshotsIDs(); len(nodes) > 0 && !uu.mutation.SnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SnapshotsTable,
+			Columns: []string{user.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.SnapshotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SnapshotsTable,
+			Columns: []string{user.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.FileActivitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FileActivitiesTable,
+			Columns: []string{user.FileActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fileactivity.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedFileActivitiesIDs(); len(nodes) > 0 && !uu.mutation.FileActivitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FileActivitiesTable,
+			Columns: []string{user.FileActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fileactivity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.FileActivitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FileActivitiesTable,
+			Columns: []string{user.FileActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fileactivity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.FeedSubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FeedSubscriptionsTable,
+			Columns: []string{user.FeedSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(feedsubscription.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedFeedSubscriptionsIDs(); len(nodes) > 0 && !uu.mutation.FeedSubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FeedSubscriptionsTable,
+			Columns: []string{user.FeedSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(feedsubscription.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.FeedSubscriptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FeedSubscriptionsTable,
+			Columns: []string{user.FeedSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(feedsubscription.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.AlbumsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AlbumsTable,
+			Columns: []string{user.AlbumsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(album.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedAlbumsIDs(); len(nodes) > 0 && !uu.mutation.AlbumsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AlbumsTable,
+			Columns: []string{user.AlbumsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(album.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.AlbumsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AlbumsTable,
+			Columns: []string{user.AlbumsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(album.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.PlaylistsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PlaylistsTable,
+			Columns: []string{user.PlaylistsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedPlaylistsIDs(); len(nodes) > 0 && !uu.mutation.PlaylistsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PlaylistsTable,
+			Columns: []string{user.PlaylistsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.PlaylistsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PlaylistsTable,
+			Columns: []string{user.PlaylistsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
@@ -1227,43 +1637,118 @@ func (uuo *UserUpdateOne) AddTaskIDs(ids ...int) *UserUpdateOne {
 	return uuo
 }
 
-// AddTasks adds the "tasks" edges to the Task entity.
-func (uuo *UserUpdateOne) AddTasks(t ...*Task) *UserUpdateOne {
-	ids := make([]int, len(t))
-	for i := range t {
-		ids[i] = t[i].ID
+// AddTasks adds the "tasks" edges to the Task entity.
+func (uuo *UserUpdateOne) AddTasks(t ...*Task) *UserUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uuo.AddTaskIDs(ids...)
+}
+
+// AddFseventIDs adds the "fsevents" edge to the FsEvent entity by IDs.
+func (uuo *UserUpdateOne) AddFseventIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddFseventIDs(ids...)
+	return uuo
+}
+
+// AddFsevents adds the "fsevents" edges to the FsEvent entity.
+func (uuo *UserUpdateOne) AddFsevents(f ...*FsEvent) *UserUpdateOne {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uuo.AddFseventIDs(ids...)
+}
+
+// AddEntityIDs adds the "entities" edge to the Entity entity by IDs.
+func (uuo *UserUpdateOne) AddEntityIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddEntityIDs(ids...)
+	return uuo
+}
+
+// AddEntities adds the "entities" edges to the Entity entity.
+func (uuo *UserUpdateOne) AddEntities(e ...*Entity) *UserUpdateOne {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return uuo.AddEntityIDs(ids...)
+}
+
+// AddSnapshotIDs adds the "snapshots" edge to the Snapshot entity by IDs.
+func (uuo *UserUpdateOne) AddSnapshotIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddSnapshotIDs(ids...)
+	return uuo
+}
+
+// AddSnapshots adds the "snapshots" edges to the Snapshot entity.
+func (uuo *UserUpdateOne) AddSnapshots(s ...*Snapshot) *UserUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.AddSnapshotIDs(ids...)
+}
+
+// AddFileActivityIDs adds the "file_activities" edge to the FileActivity entity by IDs.
+func (uuo *UserUpdateOne) AddFileActivityIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddFileActivityIDs(ids...)
+	return uuo
+}
+
+// AddFileActivities adds the "file_activities" edges to the FileActivity entity.
+func (uuo *UserUpdateOne) AddFileActivities(f ...*FileActivity) *UserUpdateOne {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
 	}
-	return uuo.AddTaskIDs(ids...)
+	return uuo.AddFileActivityIDs(ids...)
 }
 
-// AddFseventIDs adds the "fsevents" edge to the FsEvent entity by IDs.
-func (uuo *UserUpdateOne) AddFseventIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.AddFseventIDs(ids...)
+// AddFeedSubscriptionIDs adds the "feed_subscriptions" edge to the FeedSubscription entity by IDs.
+func (uuo *UserUpdateOne) AddFeedSubscriptionIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddFeedSubscriptionIDs(ids...)
 	return uuo
 }
 
-// AddFsevents adds the "fsevents" edges to the FsEvent entity.
-func (uuo *UserUpdateOne) AddFsevents(f ...*FsEvent) *UserUpdateOne {
+// AddFeedSubscriptions adds the "feed_subscriptions" edges to the FeedSubscription entity.
+func (uuo *UserUpdateOne) AddFeedSubscriptions(f ...*FeedSubscription) *UserUpdateOne {
 	ids := make([]int, len(f))
 	for i := range f {
 		ids[i] = f[i].ID
 	}
-	return uuo.AddFseventIDs(ids...)
+	return uuo.AddFeedSubscriptionIDs(ids...)
 }
 
-// AddEntityIDs adds the "entities" edge to the Entity entity by IDs.
-func (uuo *UserUpdateOne) AddEntityIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.AddEntityIDs(ids...)
+// AddAlbumIDs adds the "albums" edge to the Album entity by IDs.
+func (uuo *UserUpdateOne) AddAlbumIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddAlbumIDs(ids...)
 	return uuo
 }
 
-// AddEntities adds the "entities" edges to the Entity entity.
-func (uuo *UserUpdateOne) AddEntities(e ...*Entity) *UserUpdateOne {
-	ids := make([]int, len(e))
-	for i := range e {
-		ids[i] = e[i].ID
+// AddAlbums adds the "albums" edges to the Album entity.
+func (uuo *UserUpdateOne) AddAlbums(a ...*Album) *UserUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
 	}
-	return uuo.AddEntityIDs(ids...)
+	return uuo.AddAlbumIDs(ids...)
+}
+
+// AddPlaylistIDs adds the "playlists" edge to the Playlist entity by IDs.
+func (uuo *UserUpdateOne) AddPlaylistIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddPlaylistIDs(ids...)
+	return uuo
+}
+
+// AddPlaylists adds the "playlists" edges to the Playlist entity.
+func (uuo *UserUpdateOne) AddPlaylists(p ...*Playlist) *UserUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uuo.AddPlaylistIDs(ids...)
 }
 
 // Mutation returns the UserMutation object of the builder.
@@ -1424,6 +1909,111 @@ func (uuo *UserUpdateOne) RemoveEntities(e ...*Entity) *UserUpdateOne {
 	return uuo.RemoveEntityIDs(ids...)
 }
 
+// ClearSnapshots clears all "snapshots" edges to the Snapshot entity.
+func (uuo *UserUpdateOne) ClearSnapshots() *UserUpdateOne {
+	uuo.mutation.ClearSnapshots()
+	return uuo
+}
+
+// RemoveSnapshotIDs removes the "snapshots" edge to Snapshot entities by IDs.
+func (uuo *UserUpdateOne) RemoveSnapshotIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveSnapshotIDs(ids...)
+	return uuo
+}
+
+// RemoveSnapshots removes "snapshots" edges to Snapshot entities.
+func (uuo *UserUpdateOne) RemoveSnapshots(s ...*Snapshot) *UserUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.RemoveSnapshotIDs(ids...)
+}
+
+// ClearFileActivities clears all "file_activities" edges to the FileActivity entity.
+func (uuo *UserUpdateOne) ClearFileActivities() *UserUpdateOne {
+	uuo.mutation.ClearFileActivities()
+	return uuo
+}
+
+// RemoveFileActivityIDs removes the "file_activities" edge to FileActivity entities by IDs.
+func (uuo *UserUpdateOne) RemoveFileActivityIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveFileActivityIDs(ids...)
+	return uuo
+}
+
+// RemoveFileActivities removes "file_activities" edges to FileActivity entities.
+func (uuo *UserUpdateOne) RemoveFileActivities(f ...*FileActivity) *UserUpdateOne {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uuo.RemoveFileActivityIDs(ids...)
+}
+
+// ClearFeedSubscriptions clears all "feed_subscriptions" edges to the FeedSubscription entity.
+func (uuo *UserUpdateOne) ClearFeedSubscriptions() *UserUpdateOne {
+	uuo.mutation.ClearFeedSubscriptions()
+	return uuo
+}
+
+// RemoveFeedSubscriptionIDs removes the "feed_subscriptions" edge to FeedSubscription entities by IDs.
+func (uuo *UserUpdateOne) RemoveFeedSubscriptionIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveFeedSubscriptionIDs(ids...)
+	return uuo
+}
+
+// RemoveFeedSubscriptions removes "feed_subscriptions" edges to FeedSubscription entities.
+func (uuo *UserUpdateOne) RemoveFeedSubscriptions(f ...*FeedSubscription) *UserUpdateOne {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uuo.RemoveFeedSubscriptionIDs(ids...)
+}
+
+// ClearAlbums clears all "albums" edges to the Album entity.
+func (uuo *UserUpdateOne) ClearAlbums() *UserUpdateOne {
+	uuo.mutation.ClearAlbums()
+	return uuo
+}
+
+// RemoveAlbumIDs removes the "albums" edge to Album entities by IDs.
+func (uuo *UserUpdateOne) RemoveAlbumIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveAlbumIDs(ids...)
+	return uuo
+}
+
+// RemoveAlbums removes "albums" edges to Album entities.
+func (uuo *UserUpdateOne) RemoveAlbums(a ...*Album) *UserUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uuo.RemoveAlbumIDs(ids...)
+}
+
+// ClearPlaylists clears all "playlists" edges to the Playlist entity.
+func (uuo *UserUpdateOne) ClearPlaylists() *UserUpdateOne {
+	uuo.mutation.ClearPlaylists()
+	return uuo
+}
+
+// RemovePlaylistIDs removes the "playlists" edge to Playlist entities by IDs.
+func (uuo *UserUpdateOne) RemovePlaylistIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemovePlaylistIDs(ids...)
+	return uuo
+}
+
+// RemovePlaylists removes "playlists" edges to Playlist entities.
+func (uuo *UserUpdateOne) RemovePlaylists(p ...*Playlist) *UserUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uuo.RemovePlaylistIDs(ids...)
+}
+
 // Where appends a list predicates to the UserUpdate builder.
 func (uuo *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
 	uuo.mutation.Where(ps...)
@@ -1923,6 +2513,231 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if uuo.mutation.SnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SnapshotsTable,
+			Columns: []string{user.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshot.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedSnapshotsIDs(); len(nodes) > 0 && !uuo.mutation.SnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SnapshotsTable,
+			Columns: []string{user.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.SnapshotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SnapshotsTable,
+			Columns: []string{user.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.FileActivitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FileActivitiesTable,
+			Columns: []string{user.FileActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fileactivity.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedFileActivitiesIDs(); len(nodes) > 0 && !uuo.mutation.FileActivitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FileActivitiesTable,
+			Columns: []string{user.FileActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fileactivity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.FileActivitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FileActivitiesTable,
+			Columns: []string{user.FileActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fileactivity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.FeedSubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FeedSubscriptionsTable,
+			Columns: []string{user.FeedSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(feedsubscription.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedFeedSubscriptionsIDs(); len(nodes) > 0 && !uuo.mutation.FeedSubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FeedSubscriptionsTable,
+			Columns: []string{user.FeedSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(feedsubscription.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.FeedSubscriptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FeedSubscriptionsTable,
+			Columns: []string{user.FeedSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(feedsubscription.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.AlbumsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AlbumsTable,
+			Columns: []string{user.AlbumsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(album.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedAlbumsIDs(); len(nodes) > 0 && !uuo.mutation.AlbumsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AlbumsTable,
+			Columns: []string{user.AlbumsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(album.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.AlbumsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AlbumsTable,
+			Columns: []string{user.AlbumsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(album.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.PlaylistsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PlaylistsTable,
+			Columns: []string{user.PlaylistsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedPlaylistsIDs(); len(nodes) > 0 && !uuo.mutation.PlaylistsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PlaylistsTable,
+			Columns: []string{user.PlaylistsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.PlaylistsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PlaylistsTable,
+			Columns: []string{user.PlaylistsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &User{config: uuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
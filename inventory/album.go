@@ -0,0 +1,177 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/album"
+	"github.com/cloudreve/Cloudreve/v4/ent/file"
+	"github.com/cloudreve/Cloudreve/v4/ent/schema"
+	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+	"github.com/samber/lo"
+)
+
+type (
+	AlbumClient interface {
+		TxOperator
+		// Create creates an empty album.
+		Create(ctx context.Context, ownerID int, name string) (*ent.Album, error)
+		// GetByID returns an album by its ID.
+		GetByID(ctx context.Context, id int) (*ent.Album, error)
+		// GetByName returns an album of given user by its name.
+		GetByName(ctx context.Context, ownerID int, name string) (*ent.Album, error)
+		// List returns all albums of given user, ordered by name.
+		List(ctx context.Context, ownerID int) ([]*ent.Album, error)
+		// Rename renames an album.
+		Rename(ctx context.Context, a *ent.Album, name string) (*ent.Album, error)
+		// Delete deletes an album, files referenced by it are not affected.
+		Delete(ctx context.Context, id int) error
+		// DeleteByUser deletes all albums of a given user.
+		DeleteByUser(ctx context.Context, uid int) error
+		// AddFiles adds files into album, files already in album are ignored.
+		AddFiles(ctx context.Context, a *ent.Album, fileIDs ...int) error
+		// RemoveFiles removes files from album.
+		RemoveFiles(ctx context.Context, a *ent.Album, fileIDs ...int) error
+		// GetFile returns the file in album with given ID.
+		GetFile(ctx context.Context, a *ent.Album, fileID int) (*ent.File, error)
+		// ListFiles lists files in album, ordered by ID with given pagination args.
+		ListFiles(ctx context.Context, a *ent.Album, args *PaginationArgs) (*ListAlbumFileResult, error)
+	}
+
+	ListAlbumFileResult struct {
+		*PaginationResults
+		Files []*ent.File
+	}
+)
+
+func NewAlbumClient(client *ent.Client, dbType conf.DBType) AlbumClient {
+	return &albumClient{client: client, maxSQlParam: sqlParamLimit(dbType)}
+}
+
+type albumClient struct {
+	maxSQlParam int
+	client      *ent.Client
+}
+
+func (c *albumClient) SetClient(newClient *ent.Client) TxOperator {
+	return &albumClient{client: newClient, maxSQlParam: c.maxSQlParam}
+}
+
+func (c *albumClient) GetClient() *ent.Client {
+	return c.client
+}
+
+func (c *albumClient) Create(ctx context.Context, ownerID int, name string) (*ent.Album, error) {
+	a, err := c.client.Album.Create().
+		SetOwnerID(ownerID).
+		SetName(name).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create album: %w", err)
+	}
+
+	return a, nil
+}
+
+func (c *albumClient) GetByID(ctx context.Context, id int) (*ent.Album, error) {
+	return c.client.Album.Query().Where(album.ID(id)).First(ctx)
+}
+
+func (c *albumClient) GetByName(ctx context.Context, ownerID int, name string) (*ent.Album, error) {
+	return c.client.Album.Query().Where(album.OwnerID(ownerID), album.Name(name)).First(ctx)
+}
+
+func (c *albumClient) List(ctx context.Context, ownerID int) ([]*ent.Album, error) {
+	return c.client.Album.Query().
+		Where(album.OwnerID(ownerID)).
+		Order(album.ByName()).
+		All(ctx)
+}
+
+func (c *albumClient) Rename(ctx context.Context, a *ent.Album, name string) (*ent.Album, error) {
+	return c.client.Album.UpdateOne(a).SetName(name).Save(ctx)
+}
+
+func (c *albumClient) Delete(ctx context.Context, id int) error {
+	if err := c.client.Album.UpdateOneID(id).ClearFiles().Exec(ctx); err != nil {
+		return fmt.Errorf("failed to clear album files: %w", err)
+	}
+
+	return c.client.Album.DeleteOneID(id).Exec(schema.SkipSoftDelete(ctx))
+}
+
+func (c *albumClient) DeleteByUser(ctx context.Context, uid int) error {
+	ids, err := c.client.Album.Query().Where(album.OwnerID(uid)).IDs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query albums: %w", err)
+	}
+
+	for _, id := range ids {
+		if err := c.Delete(ctx, id); err != nil {
+			return fmt.Errorf("failed to delete album %d: %w", id, err)
+		}
+	}
+
+	return nil
+}
+
+func (c *albumClient) AddFiles(ctx context.Context, a *ent.Album, fileIDs ...int) error {
+	for _, chunk := range lo.Chunk(lo.Uniq(fileIDs), capPageSize(c.maxSQlParam, 0, 10)) {
+		existed, err := c.client.Album.QueryFiles(a).Where(file.IDIn(chunk...)).IDs(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query album files: %w", err)
+		}
+
+		pending, _ := lo.Difference(chunk, existed)
+		if len(pending) == 0 {
+			continue
+		}
+
+		if err := c.client.Album.UpdateOne(a).AddFileIDs(pending...).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to add album files: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (c *albumClient) RemoveFiles(ctx context.Context, a *ent.Album, fileIDs ...int) error {
+	for _, chunk := range lo.Chunk(lo.Uniq(fileIDs), capPageSize(c.maxSQlParam, 0, 10)) {
+		if err := c.client.Album.UpdateOne(a).RemoveFileIDs(chunk...).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to remove album files: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (c *albumClient) GetFile(ctx context.Context, a *ent.Album, fileID int) (*ent.File, error) {
+	return withFileEagerLoading(ctx, c.client.Album.QueryFiles(a).Where(file.ID(fileID))).First(ctx)
+}
+
+func (c *albumClient) ListFiles(ctx context.Context, a *ent.Album, args *PaginationArgs) (*ListAlbumFileResult, error) {
+	query := c.client.Album.QueryFiles(a)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count album files: %w", err)
+	}
+
+	pageSize := capPageSize(c.maxSQlParam, args.PageSize, 10)
+	files, err := withFileEagerLoading(ctx, query.Order(file.ByID(getOrderTerm(args.Order)))).
+		Limit(pageSize).
+		Offset(args.Page * pageSize).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list album files: %w", err)
+	}
+
+	return &ListAlbumFileResult{
+		Files: files,
+		PaginationResults: &PaginationResults{
+			Page:       args.Page,
+			PageSize:   pageSize,
+			TotalItems: total,
+		},
+	}, nil
+}
@@ -0,0 +1,245 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/feeditem"
+	"github.com/cloudreve/Cloudreve/v4/ent/feedsubscription"
+	"github.com/cloudreve/Cloudreve/v4/ent/schema"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+	"github.com/samber/lo"
+)
+
+type (
+	FeedClient interface {
+		TxOperator
+		// Upsert creates a new subscription, or updates an existing one if ID is set.
+		Upsert(ctx context.Context, args *UpsertFeedParameters) (*ent.FeedSubscription, error)
+		// GetByID returns a subscription by its ID.
+		GetByID(ctx context.Context, id int) (*ent.FeedSubscription, error)
+		// ListByOwner returns all subscriptions of a user.
+		ListByOwner(ctx context.Context, uid int) ([]*ent.FeedSubscription, error)
+		// ListEnabled returns enabled subscriptions of active users with ID greater than afterID,
+		// owner and owner's group are loaded.
+		ListEnabled(ctx context.Context, afterID, limit int) ([]*ent.FeedSubscription, error)
+		// SetChecked updates the last polling time and error of a subscription.
+		SetChecked(ctx context.Context, id int, checkedAt time.Time, errMsg string) error
+		// Delete deletes a subscription and all its items.
+		Delete(ctx context.Context, id int) error
+		// DeleteByUser deletes all subscriptions of a given user.
+		DeleteByUser(ctx context.Context, uid int) error
+		// SeenGuids returns guids already recorded in given subscription among the given ones.
+		SeenGuids(ctx context.Context, subscriptionID int, guids []string) (map[string]bool, error)
+		// CreateItem records an item seen in a subscription.
+		CreateItem(ctx context.Context, args *CreateFeedItemParameters) (*ent.FeedItem, error)
+		// ListItems lists items of a subscription, latest first.
+		ListItems(ctx context.Context, args *ListFeedItemParameters) (*ListFeedItemResult, error)
+	}
+
+	UpsertFeedParameters struct {
+		ID      int
+		OwnerID int
+		Name    string
+		Url     string
+		Include string
+		Exclude string
+		Dst     string
+		Enabled bool
+	}
+
+	CreateFeedItemParameters struct {
+		SubscriptionID int
+		Guid           string
+		Title          string
+		Link           string
+		PublishedAt    *time.Time
+		Matched        bool
+		TaskID         *int
+		Error          string
+	}
+
+	ListFeedItemParameters struct {
+		*PaginationArgs
+		SubscriptionID int
+		// MatchedOnly filters out items not matching filters of the subscription.
+		MatchedOnly bool
+	}
+
+	ListFeedItemResult struct {
+		*PaginationResults
+		Items []*ent.FeedItem
+	}
+)
+
+func NewFeedClient(client *ent.Client, dbType conf.DBType) FeedClient {
+	return &feedClient{client: client, maxSQlParam: sqlParamLimit(dbType)}
+}
+
+type feedClient struct {
+	maxSQlParam int
+	client      *ent.Client
+}
+
+func (c *feedClient) SetClient(newClient *ent.Client) TxOperator {
+	return &feedClient{client: newClient, maxSQlParam: c.maxSQlParam}
+}
+
+func (c *feedClient) GetClient() *ent.Client {
+	return c.client
+}
+
+func (c *feedClient) Upsert(ctx context.Context, args *UpsertFeedParameters) (*ent.FeedSubscription, error) {
+	if args.ID == 0 {
+		return c.client.FeedSubscription.Create().
+			SetOwnerID(args.OwnerID).
+			SetName(args.Name).
+			SetURL(args.Url).
+			SetInclude(args.Include).
+			SetExclude(args.Exclude).
+			SetDst(args.Dst).
+			SetEnabled(args.Enabled).
+			Save(ctx)
+	}
+
+	return c.client.FeedSubscription.UpdateOneID(args.ID).
+		SetName(args.Name).
+		SetURL(args.Url).
+		SetInclude(args.Include).
+		SetExclude(args.Exclude).
+		SetDst(args.Dst).
+		SetEnabled(args.Enabled).
+		Save(ctx)
+}
+
+func (c *feedClient) GetByID(ctx context.Context, id int) (*ent.FeedSubscription, error) {
+	return c.client.FeedSubscription.Get(ctx, id)
+}
+
+func (c *feedClient) ListByOwner(ctx context.Context, uid int) ([]*ent.FeedSubscription, error) {
+	return c.client.FeedSubscription.Query().
+		Where(feedsubscription.OwnerID(uid)).
+		Order(feedsubscription.ByID()).
+		All(ctx)
+}
+
+func (c *feedClient) ListEnabled(ctx context.Context, afterID, limit int) ([]*ent.FeedSubscription, error) {
+	return c.client.FeedSubscription.Query().
+		Where(
+			feedsubscription.IDGT(afterID),
+			feedsubscription.Enabled(true),
+			feedsubscription.HasOwnerWith(user.StatusEQ(user.StatusActive)),
+		).
+		WithOwner(func(q *ent.UserQuery) {
+			q.WithGroup()
+		}).
+		Order(feedsubscription.ByID()).
+		Limit(limit).
+		All(ctx)
+}
+
+func (c *feedClient) SetChecked(ctx context.Context, id int, checkedAt time.Time, errMsg string) error {
+	return c.client.FeedSubscription.UpdateOneID(id).
+		SetCheckedAt(checkedAt).
+		SetError(errMsg).
+		Exec(ctx)
+}
+
+func (c *feedClient) Delete(ctx context.Context, id int) error {
+	ctx = schema.SkipSoftDelete(ctx)
+	if _, err := c.client.FeedItem.Delete().
+		Where(feeditem.SubscriptionID(id)).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete feed items: %w", err)
+	}
+
+	if err := c.client.FeedSubscription.DeleteOneID(id).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete feed subscription: %w", err)
+	}
+
+	return nil
+}
+
+func (c *feedClient) DeleteByUser(ctx context.Context, uid int) error {
+	ctx = schema.SkipSoftDelete(ctx)
+	if _, err := c.client.FeedItem.Delete().
+		Where(feeditem.HasSubscriptionWith(feedsubscription.OwnerID(uid))).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete feed items: %w", err)
+	}
+
+	if _, err := c.client.FeedSubscription.Delete().
+		Where(feedsubscription.OwnerID(uid)).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete feed subscriptions: %w", err)
+	}
+
+	return nil
+}
+
+func (c *feedClient) SeenGuids(ctx context.Context, subscriptionID int, guids []string) (map[string]bool, error) {
+	seen := make(map[string]bool, len(guids))
+	for _, chunk := range lo.Chunk(guids, c.maxSQlParam) {
+		existing, err := c.client.FeedItem.Query().
+			Where(feeditem.SubscriptionID(subscriptionID), feeditem.GUIDIn(chunk...)).
+			Select(feeditem.FieldGUID).
+			Strings(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query feed items: %w", err)
+		}
+
+		for _, guid := range existing {
+			seen[guid] = true
+		}
+	}
+
+	return seen, nil
+}
+
+func (c *feedClient) CreateItem(ctx context.Context, args *CreateFeedItemParameters) (*ent.FeedItem, error) {
+	return c.client.FeedItem.Create().
+		SetSubscriptionID(args.SubscriptionID).
+		SetGUID(args.Guid).
+		SetTitle(args.Title).
+		SetLink(args.Link).
+		SetNillablePublishedAt(args.PublishedAt).
+		SetMatched(args.Matched).
+		SetNillableTaskID(args.TaskID).
+		SetError(args.Error).
+		Save(ctx)
+}
+
+func (c *feedClient) ListItems(ctx context.Context, args *ListFeedItemParameters) (*ListFeedItemResult, error) {
+	query := c.client.FeedItem.Query().
+		Where(feeditem.SubscriptionID(args.SubscriptionID))
+	if args.MatchedOnly {
+		query.Where(feeditem.Matched(true))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count feed items: %w", err)
+	}
+
+	pageSize := capPageSize(c.maxSQlParam, args.PageSize, 10)
+	items, err := query.
+		Order(feeditem.BySeenAt(getOrderTerm(args.Order)), feeditem.ByID(getOrderTerm(args.Order))).
+		Limit(pageSize).
+		Offset(args.Page * pageSize).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list feed items: %w", err)
+	}
+
+	return &ListFeedItemResult{
+		Items: items,
+		PaginationResults: &PaginationResults{
+			Page:       args.Page,
+			PageSize:   pageSize,
+			TotalItems: total,
+		},
+	}, nil
+}
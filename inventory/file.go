@@ -3,6 +3,7 @@ package inventory
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -10,6 +11,7 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/ent/directlink"
 	"github.com/cloudreve/Cloudreve/v4/ent/entity"
 	"github.com/cloudreve/Cloudreve/v4/ent/file"
+	"github.com/cloudreve/Cloudreve/v4/ent/fileactivity"
 	"github.com/cloudreve/Cloudreve/v4/ent/metadata"
 	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
 	"github.com/cloudreve/Cloudreve/v4/ent/schema"
@@ -201,6 +203,10 @@ type FileClient interface {
 	SetPrimaryEntity(ctx context.Context, file *ent.File, entity *ent.Entity) error
 	// UnlinkEntity unlinks an entity from a file
 	UnlinkEntity(ctx context.Context, entity *ent.Entity, file *ent.File, owner *ent.User) (StorageDiff, error)
+	// UpdateEntityProps updates props of an entity
+	UpdateEntityProps(ctx context.Context, entity *ent.Entity, props *types.EntityProps) (*ent.Entity, error)
+	// LinkEntity links an existing entity to a file
+	LinkEntity(ctx context.Context, entity *ent.Entity, file *ent.File, owner *ent.User) (StorageDiff, error)
 	// CreateDirectLink creates a direct link for a file
 	CreateDirectLink(ctx context.Context, fileID int, name string, speed int, reuse bool) (*ent.DirectLink, error)
 	// CountByTimeRange counts files created in a given time range
@@ -223,6 +229,26 @@ type FileClient interface {
 	UpdateProps(ctx context.Context, file *ent.File, props *types.FileProps) (*ent.File, error)
 	// UpdateModifiedAt updates modified at of a file
 	UpdateModifiedAt(ctx context.Context, file *ent.File, modifiedAt time.Time) error
+	// ListVersionedFiles lists files with more than one version entity, ordered by ID and
+	// starting after given file ID.
+	ListVersionedFiles(ctx context.Context, afterID, limit int) ([]*ent.File, error)
+	// ListFoldersWithProps lists folders with props set, ordered by ID and starting after given file ID.
+	ListFoldersWithProps(ctx context.Context, afterID, limit int) ([]*ent.File, error)
+	// ListUserFiles lists all files and folders owned by given user, ordered by ID and starting after
+	// given file ID. Edges are loaded according to eager loading options in context.
+	ListUserFiles(ctx context.Context, uid, afterID, limit int) ([]*ent.File, error)
+	// ListUserChildFiles lists files and folders owned by given user directly under given parent folder,
+	// or those without parent if parent is 0, ordered by ID and starting after given file ID. Edges
+	// are loaded according to eager loading options in context.
+	ListUserChildFiles(ctx context.Context, uid, parent, afterID, limit int) ([]*ent.File, error)
+	// ListPolicyFiles lists non-symbolic files stored in given storage policy, ordered by ID and
+	// starting after given file ID.
+	ListPolicyFiles(ctx context.Context, policyID, afterID, limit int) ([]*ent.File, error)
+	// ListMediaFiles lists non-symbolic files owned by given user with one of given extensions, ordered
+	// by ID and starting after given file ID. Only metadata with given keys is loaded.
+	ListMediaFiles(ctx context.Context, uid int, exts, metaKeys []string, afterID, limit int) ([]*ent.File, error)
+	// ListFoldersByMetadata lists folders having metadata with given name, all their metadata is loaded.
+	ListFoldersByMetadata(ctx context.Context, name string) ([]*ent.File, error)
 }
 
 func NewFileClient(client *ent.Client, dbType conf.DBType, hasher hashid.Encoder) FileClient {
@@ -300,6 +326,119 @@ func (f *fileClient) UpdateProps(ctx context.Context, file *ent.File, props *typ
 	return file, nil
 }
 
+func (f *fileClient) ListVersionedFiles(ctx context.Context, afterID, limit int) ([]*ent.File, error) {
+	return f.client.File.Query().
+		Where(
+			file.IDGT(afterID),
+			file.Type(int(types.FileTypeFile)),
+			func(s *sql.Selector) {
+				t := sql.Table(file.EntitiesTable)
+				e := sql.Table(entity.Table)
+				versioned := sql.Select(t.C(file.EntitiesPrimaryKey[0])).
+					From(t).
+					Join(e).On(t.C(file.EntitiesPrimaryKey[1]), e.C(entity.FieldID)).
+					Where(sql.EQ(e.C(entity.FieldType), int(types.EntityTypeVersion))).
+					GroupBy(t.C(file.EntitiesPrimaryKey[0])).
+					Having(sql.GT(sql.Count(t.C(file.EntitiesPrimaryKey[1])), 1))
+				s.Where(sql.In(s.C(file.FieldID), versioned))
+			},
+		).
+		Order(ent.Asc(file.FieldID)).
+		Limit(limit).
+		All(ctx)
+}
+
+func (f *fileClient) ListFoldersWithProps(ctx context.Context, afterID, limit int) ([]*ent.File, error) {
+	return f.client.File.Query().
+		Where(
+			file.IDGT(afterID),
+			file.Type(int(types.FileTypeFolder)),
+			file.PropsNotNil(),
+		).
+		Order(ent.Asc(file.FieldID)).
+		Limit(limit).
+		All(ctx)
+}
+
+func (f *fileClient) ListUserFiles(ctx context.Context, uid, afterID, limit int) ([]*ent.File, error) {
+	query := f.client.File.Query().
+		Where(
+			file.IDGT(afterID),
+			file.OwnerID(uid),
+		).
+		Order(ent.Asc(file.FieldID)).
+		Limit(limit)
+
+	return withFileEagerLoading(ctx, query).All(ctx)
+}
+
+func (f *fileClient) ListUserChildFiles(ctx context.Context, uid, parent, afterID, limit int) ([]*ent.File, error) {
+	query := f.client.File.Query().
+		Where(
+			file.IDGT(afterID),
+			file.OwnerID(uid),
+		).
+		Order(ent.Asc(file.FieldID)).
+		Limit(limit)
+	if parent > 0 {
+		query.Where(file.FileChildren(parent))
+	} else {
+		query.Where(file.Not(file.HasParent()))
+	}
+
+	return withFileEagerLoading(ctx, query).All(ctx)
+}
+
+func (f *fileClient) ListPolicyFiles(ctx context.Context, policyID, afterID, limit int) ([]*ent.File, error) {
+	return f.client.File.Query().
+		Where(
+			file.IDGT(afterID),
+			file.Type(int(types.FileTypeFile)),
+			file.IsSymbolic(false),
+			file.StoragePolicyFiles(policyID),
+		).
+		Order(ent.Asc(file.FieldID)).
+		Limit(limit).
+		All(ctx)
+}
+
+func (f *fileClient) ListMediaFiles(ctx context.Context, uid int, exts, metaKeys []string, afterID, limit int) ([]*ent.File, error) {
+	if len(exts) == 0 {
+		return nil, nil
+	}
+
+	return f.client.File.Query().
+		Where(
+			file.IDGT(afterID),
+			file.OwnerID(uid),
+			file.Type(int(types.FileTypeFile)),
+			file.IsSymbolic(false),
+			func(s *sql.Selector) {
+				name := sql.Lower(s.C(file.FieldName))
+				s.Where(sql.Or(lo.Map(exts, func(ext string, index int) *sql.Predicate {
+					return sql.HasSuffix(name, "."+strings.ToLower(ext))
+				})...))
+			},
+		).
+		WithMetadata(func(m *ent.MetadataQuery) {
+			m.Where(metadata.NameIn(metaKeys...))
+		}).
+		Order(ent.Asc(file.FieldID)).
+		Limit(limit).
+		All(ctx)
+}
+
+func (f *fileClient) ListFoldersByMetadata(ctx context.Context, name string) ([]*ent.File, error) {
+	return f.client.File.Query().
+		Where(
+			file.Type(int(types.FileTypeFolder)),
+			file.HasMetadataWith(metadata.Name(name)),
+		).
+		WithMetadata().
+		Order(ent.Asc(file.FieldID)).
+		All(ctx)
+}
+
 func (f *fileClient) CountByTimeRange(ctx context.Context, start, end *time.Time) (int, error) {
 	if start == nil || end == nil {
 		return f.client.File.Query().Count(ctx)
@@ -476,6 +615,8 @@ func (f *fileClient) Delete(ctx context.Context, files []*ent.File, options *typ
 	entities := make(map[int]int)
 	// storageReduced stores the relation between owner ID and storage reduced.
 	storageReduced := make(map[int]int64)
+	// holders stores the relation between entity ID and owner of the file last linking it.
+	holders := make(map[int]int)
 	for _, fi := range files {
 		fileEntities, err := fi.Edges.EntitiesOrErr()
 		if err != nil {
@@ -484,6 +625,7 @@ func (f *fileClient) Delete(ctx context.Context, files []*ent.File, options *typ
 
 		for _, e := range fileEntities {
 			entities[e.ID]++
+			holders[e.ID] = fi.OwnerID
 			storageReduced[fi.OwnerID] -= e.Size
 		}
 	}
@@ -516,6 +658,16 @@ func (f *fileClient) Delete(ctx context.Context, files []*ent.File, options *typ
 		}
 
 		toBeRecycled = append(toBeRecycled, e...)
+
+		// Entities only pinned by snapshots now stay charged to their owners.
+		detached, err := f.client.Entity.Query().Where(group).Where(entityDetached()).All(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to query detached entities %v: %w", group, err)
+		}
+
+		for _, e := range detached {
+			storageReduced[holders[e.ID]] += e.Size
+		}
 	}
 
 	// 3. Update recycle options for above entities;
@@ -540,7 +692,7 @@ func (f *fileClient) Delete(ctx context.Context, files []*ent.File, options *typ
 	)
 
 	for i, group := range fileGroups {
-		// 4. Delete shares/metadata/directlinks if needed;
+		// 4. Delete shares/metadata/directlinks/activities if needed;
 		if _, err := f.client.Share.Delete().Where(share.HasFileWith(group)).Exec(ctx); err != nil {
 			return nil, nil, fmt.Errorf("failed to delete shares of files %v: %w", group, err)
 		}
@@ -553,6 +705,10 @@ func (f *fileClient) Delete(ctx context.Context, files []*ent.File, options *typ
 			return nil, nil, fmt.Errorf("failed to delete direct links of files %v: %w", group, err)
 		}
 
+		if _, err := f.client.FileActivity.Delete().Where(fileactivity.FileIDIn(chunks[i]...)).Exec(hardDeleteCtx); err != nil {
+			return nil, nil, fmt.Errorf("failed to delete activities of files %v: %w", group, err)
+		}
+
 		// 5. Delete files.
 		if _, err := f.client.File.Delete().Where(group).Exec(hardDeleteCtx); err != nil {
 			return nil, nil, fmt.Errorf("failed to delete files %v: %w", group, err)
@@ -834,12 +990,44 @@ func (f *fileClient) CapEntities(ctx context.Context, file *ent.File, owner *ent
 	return diff, nil
 }
 
-func (f *fileClient) UnlinkEntity(ctx context.Context, entity *ent.Entity, file *ent.File, owner *ent.User) (StorageDiff, error) {
-	if err := f.client.Entity.UpdateOne(entity).RemoveFile(file).AddReferenceCount(-1).Exec(ctx); err != nil {
+func (f *fileClient) UnlinkEntity(ctx context.Context, e *ent.Entity, file *ent.File, owner *ent.User) (StorageDiff, error) {
+	if err := f.client.Entity.UpdateOne(e).RemoveFile(file).AddReferenceCount(-1).Exec(ctx); err != nil {
 		return nil, fmt.Errorf("failed to unlink entity: %v", err)
 	}
 
-	return map[int]int64{owner.ID: entity.Size * int64(-1)}, nil
+	// Entity only pinned by snapshots now stays charged to the owner.
+	detached, err := f.client.Entity.Query().Where(entity.ID(e.ID), entityDetached()).Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check detached entity: %v", err)
+	}
+
+	if detached {
+		return map[int]int64{}, nil
+	}
+
+	return map[int]int64{owner.ID: e.Size * int64(-1)}, nil
+}
+
+func (f *fileClient) UpdateEntityProps(ctx context.Context, entity *ent.Entity, props *types.EntityProps) (*ent.Entity, error) {
+	return f.client.Entity.UpdateOne(entity).SetProps(props).Save(ctx)
+}
+
+func (f *fileClient) LinkEntity(ctx context.Context, e *ent.Entity, file *ent.File, owner *ent.User) (StorageDiff, error) {
+	// Entity only pinned by snapshots is already charged to the owner.
+	detached, err := f.client.Entity.Query().Where(entity.ID(e.ID), entityDetached()).Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check detached entity: %v", err)
+	}
+
+	if err := f.client.Entity.UpdateOne(e).AddFile(file).AddReferenceCount(1).Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to link entity: %v", err)
+	}
+
+	if detached {
+		return map[int]int64{}, nil
+	}
+
+	return map[int]int64{owner.ID: e.Size}, nil
 }
 
 func (f *fileClient) IsStoragePolicyUsedByEntities(ctx context.Context, policyID int) (bool, error) {
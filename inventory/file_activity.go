@@ -0,0 +1,191 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/file"
+	"github.com/cloudreve/Cloudreve/v4/ent/fileactivity"
+	"github.com/cloudreve/Cloudreve/v4/ent/schema"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+	"github.com/samber/lo"
+)
+
+type (
+	FileActivityClient interface {
+		TxOperator
+		// Record records an activity of user on a file. Activity of the same type within dedup
+		// duration since last record is ignored.
+		Record(ctx context.Context, uid, fileID int, action types.FileActivityType, dedup time.Duration) error
+		// List lists files recently accessed by a user, latest first, with file loaded.
+		List(ctx context.Context, args *ListFileActivityParameters) (*ListFileActivityResult, error)
+		// DeleteBefore deletes all activities accessed before given time.
+		DeleteBefore(ctx context.Context, before time.Time) (int, error)
+		// DeleteByUser deletes all activities of a given user.
+		DeleteByUser(ctx context.Context, uid int) error
+		// DeleteInTree deletes activities on given file and all its descendants.
+		DeleteInTree(ctx context.Context, root int) error
+	}
+
+	ListFileActivityParameters struct {
+		*PaginationArgs
+		UserID int
+		// Since filters out activities accessed before it.
+		Since time.Time
+	}
+
+	ListFileActivityResult struct {
+		*PaginationResults
+		Activities []*ent.FileActivity
+	}
+)
+
+func NewFileActivityClient(client *ent.Client, dbType conf.DBType) FileActivityClient {
+	return &fileActivityClient{client: client, maxSQlParam: sqlParamLimit(dbType)}
+}
+
+type fileActivityClient struct {
+	maxSQlParam int
+	client      *ent.Client
+}
+
+func (c *fileActivityClient) SetClient(newClient *ent.Client) TxOperator {
+	return &fileActivityClient{client: newClient, maxSQlParam: c.maxSQlParam}
+}
+
+func (c *fileActivityClient) GetClient() *ent.Client {
+	return c.client
+}
+
+func (c *fileActivityClient) Record(ctx context.Context, uid, fileID int, action types.FileActivityType, dedup time.Duration) error {
+	now := time.Now()
+	opened := action != types.FileActivityModify
+	existing, err := c.client.FileActivity.Query().
+		Where(fileactivity.UserID(uid), fileactivity.FileID(fileID)).
+		First(ctx)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			return fmt.Errorf("failed to query file activity: %w", err)
+		}
+
+		stm := c.client.FileActivity.Create().
+			SetUserID(uid).
+			SetFileID(fileID).
+			SetAction(int(action)).
+			SetAccessedAt(now)
+		if opened {
+			stm.SetOpenedAt(now)
+		}
+
+		if err := stm.Exec(ctx); err != nil && !ent.IsConstraintError(err) {
+			return fmt.Errorf("failed to create file activity: %w", err)
+		}
+
+		return nil
+	}
+
+	if existing.Action == int(action) && now.Sub(existing.AccessedAt) < dedup {
+		return nil
+	}
+
+	stm := existing.Update().
+		SetAction(int(action)).
+		SetAccessedAt(now)
+	if opened {
+		stm.SetOpenedAt(now)
+	}
+
+	if err := stm.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to update file activity: %w", err)
+	}
+
+	return nil
+}
+
+func (c *fileActivityClient) List(ctx context.Context, args *ListFileActivityParameters) (*ListFileActivityResult, error) {
+	// Files in trash bin have no parent.
+	query := c.client.FileActivity.Query().
+		Where(fileactivity.UserID(args.UserID), fileactivity.AccessedAtGTE(args.Since),
+			fileactivity.HasFileWith(file.HasParent()))
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count file activities: %w", err)
+	}
+
+	pageSize := capPageSize(c.maxSQlParam, args.PageSize, 10)
+	activities, err := query.
+		Order(fileactivity.ByAccessedAt(getOrderTerm(args.Order)), fileactivity.ByID(getOrderTerm(args.Order))).
+		WithFile(func(q *ent.FileQuery) {
+			withFileEagerLoading(ctx, q)
+		}).
+		Limit(pageSize).
+		Offset(args.Page * pageSize).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list file activities: %w", err)
+	}
+
+	return &ListFileActivityResult{
+		Activities: activities,
+		PaginationResults: &PaginationResults{
+			Page:       args.Page,
+			PageSize:   pageSize,
+			TotalItems: total,
+		},
+	}, nil
+}
+
+func (c *fileActivityClient) DeleteBefore(ctx context.Context, before time.Time) (int, error) {
+	return c.client.FileActivity.Delete().
+		Where(fileactivity.AccessedAtLT(before)).
+		Exec(schema.SkipSoftDelete(ctx))
+}
+
+func (c *fileActivityClient) DeleteByUser(ctx context.Context, uid int) error {
+	if _, err := c.client.FileActivity.Delete().
+		Where(fileactivity.UserID(uid)).
+		Exec(schema.SkipSoftDelete(ctx)); err != nil {
+		return fmt.Errorf("failed to delete file activities: %w", err)
+	}
+
+	return nil
+}
+
+func (c *fileActivityClient) DeleteInTree(ctx context.Context, root int) error {
+	ctx = schema.SkipSoftDelete(ctx)
+	if _, err := c.client.FileActivity.Delete().
+		Where(fileactivity.FileID(root)).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete file activities: %w", err)
+	}
+
+	// Activities are only recorded on files, descendants are visited level by level via folders.
+	folders := []int{root}
+	for len(folders) > 0 {
+		var next []int
+		for _, chunk := range lo.Chunk(folders, capPageSize(c.maxSQlParam, 0, 10)) {
+			if _, err := c.client.FileActivity.Delete().
+				Where(fileactivity.HasFileWith(file.FileChildrenIn(chunk...))).
+				Exec(ctx); err != nil {
+				return fmt.Errorf("failed to delete file activities: %w", err)
+			}
+
+			children, err := c.client.File.Query().
+				Where(file.FileChildrenIn(chunk...), file.Type(int(types.FileTypeFolder))).
+				IDs(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to list child folders: %w", err)
+			}
+
+			next = append(next, children...)
+		}
+
+		folders = next
+	}
+
+	return nil
+}
@@ -149,6 +149,7 @@ func migrateAdminGroup(l logging.Logger, client *ent.Client, ctx context.Context
 		types.GroupPermissionRedirectedSource:    true,
 		types.GroupPermissionAdvanceDelete:       true,
 		types.GroupPermissionIgnoreFileOwnership: true,
+		types.GroupPermissionTranscode:           true,
 		// TODO: review default permission
 	}, permissions)
 	if _, err := client.Group.Create().
@@ -239,6 +240,7 @@ func migrateMasterNode(l logging.Logger, client *ent.Client, ctx context.Context
 		types.NodeCapabilityCreateArchive:  true,
 		types.NodeCapabilityExtractArchive: true,
 		types.NodeCapabilityRemoteDownload: true,
+		types.NodeCapabilityTranscode:      true,
 	}, capabilities)
 
 	stm := client.Node.Create().
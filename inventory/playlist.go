@@ -0,0 +1,103 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/playlist"
+	"github.com/cloudreve/Cloudreve/v4/ent/schema"
+)
+
+type (
+	PlaylistClient interface {
+		TxOperator
+		// Upsert creates a new playlist, or updates an existing one if ID is set.
+		Upsert(ctx context.Context, args *UpsertPlaylistParameters) (*ent.Playlist, error)
+		// GetByID returns a playlist by its ID.
+		GetByID(ctx context.Context, id int) (*ent.Playlist, error)
+		// List returns all playlists of given user, ordered by name.
+		List(ctx context.Context, ownerID int) ([]*ent.Playlist, error)
+		// Delete deletes a playlist, files referenced by it are not affected.
+		Delete(ctx context.Context, id int) error
+		// DeleteByUser deletes all playlists of a given user.
+		DeleteByUser(ctx context.Context, uid int) error
+	}
+
+	UpsertPlaylistParameters struct {
+		ID      int
+		OwnerID int
+		Name    string
+		Comment string
+		// FileIDs is the ordered IDs of files in playlist.
+		FileIDs []int
+	}
+)
+
+func NewPlaylistClient(client *ent.Client) PlaylistClient {
+	return &playlistClient{client: client}
+}
+
+type playlistClient struct {
+	client *ent.Client
+}
+
+func (c *playlistClient) SetClient(newClient *ent.Client) TxOperator {
+	return &playlistClient{client: newClient}
+}
+
+func (c *playlistClient) GetClient() *ent.Client {
+	return c.client
+}
+
+func (c *playlistClient) Upsert(ctx context.Context, args *UpsertPlaylistParameters) (*ent.Playlist, error) {
+	if args.ID > 0 {
+		p, err := c.client.Playlist.UpdateOneID(args.ID).
+			SetName(args.Name).
+			SetComment(args.Comment).
+			SetFileIds(args.FileIDs).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update playlist: %w", err)
+		}
+
+		return p, nil
+	}
+
+	p, err := c.client.Playlist.Create().
+		SetOwnerID(args.OwnerID).
+		SetName(args.Name).
+		SetComment(args.Comment).
+		SetFileIds(args.FileIDs).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create playlist: %w", err)
+	}
+
+	return p, nil
+}
+
+func (c *playlistClient) GetByID(ctx context.Context, id int) (*ent.Playlist, error) {
+	return c.client.Playlist.Query().Where(playlist.ID(id)).First(ctx)
+}
+
+func (c *playlistClient) List(ctx context.Context, ownerID int) ([]*ent.Playlist, error) {
+	return c.client.Playlist.Query().
+		Where(playlist.OwnerID(ownerID)).
+		Order(playlist.ByName()).
+		All(ctx)
+}
+
+func (c *playlistClient) Delete(ctx context.Context, id int) error {
+	return c.client.Playlist.DeleteOneID(id).Exec(schema.SkipSoftDelete(ctx))
+}
+
+func (c *playlistClient) DeleteByUser(ctx context.Context, uid int) error {
+	if _, err := c.client.Playlist.Delete().
+		Where(playlist.OwnerID(uid)).
+		Exec(schema.SkipSoftDelete(ctx)); err != nil {
+		return fmt.Errorf("failed to delete playlists: %w", err)
+	}
+
+	return nil
+}
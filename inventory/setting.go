@@ -532,6 +532,11 @@ var DefaultSettings = map[string]string{
 	"cron_entity_collect":                        "@every 15m",
 	"cron_trash_bin_collect":                     "@every 33m",
 	"cron_oauth_cred_refresh":                    "@every 230h",
+	"cron_version_retention":                     "@every 6h",
+	"cron_folder_snapshot":                       "@every 1h",
+	"cron_recent_files":                          "@every 24h",
+	"cron_usage_report":                          "@every 1h",
+	"cron_feed_poll":                             "@every 30m",
 	"authn_enabled":                              "1",
 	"captcha_type":                               "normal",
 	"captcha_height":                             "60",
@@ -555,6 +560,10 @@ var DefaultSettings = map[string]string{
 	"captcha_cap_asset_server":                   "jsdelivr",
 	"thumb_width":                                "400",
 	"thumb_height":                               "300",
+	"thumb_small_width":                          "128",
+	"thumb_small_height":                         "128",
+	"thumb_large_width":                          "1600",
+	"thumb_large_height":                         "1600",
 	"thumb_entity_suffix":                        "{blob_path}/{blob_name}._thumb",
 	"thumb_slave_sidecar_suffix":                 "._thumb_sidecar",
 	"thumb_encode_method":                        "png",
@@ -562,6 +571,7 @@ var DefaultSettings = map[string]string{
 	"thumb_encode_quality":                       "95",
 	"thumb_builtin_enabled":                      "1",
 	"thumb_builtin_max_size":                     "78643200", // 75 MB
+	"thumb_builtin_text_exts":                    "txt,log,md,csv,json,yaml,yml,toml,ini,conf,xml,sql,sh,bat,ps1,go,py,js,ts,jsx,tsx,java,kt,c,h,cpp,hpp,cs,rs,rb,php,lua,swift,css,scss,vue",
 	"thumb_vips_max_size":                        "78643200", // 75 MB
 	"thumb_vips_enabled":                         "0",
 	"thumb_vips_exts":                            "3fr,ari,arw,bay,braw,crw,cr2,cr3,cap,data,dcs,dcr,dng,drf,eip,erf,fff,gpr,iiq,k25,kdc,mdc,mef,mos,mrw,nef,nrw,obm,orf,pef,ptx,pxn,r3d,raf,raw,rwl,rw2,rwz,sr2,srf,srw,tif,x3f,csv,mat,img,hdr,pbm,pgm,ppm,pfm,pnm,svg,svgz,j2k,jp2,jpt,j2c,jpc,gif,png,jpg,jpeg,jpe,webp,tif,tiff,fits,fit,fts,exr,jxl,pdf,heic,heif,avif,svs,vms,vmu,ndpi,scn,mrxs,svslide,bif,raw",
@@ -572,6 +582,22 @@ var DefaultSettings = map[string]string{
 	"thumb_ffmpeg_exts":                          "3g2,3gp,asf,asx,avi,divx,flv,m2ts,m2v,m4v,mkv,mov,mp4,mpeg,mpg,mts,mxf,ogv,rm,swf,webm,wmv",
 	"thumb_ffmpeg_seek":                          "00:00:01.00",
 	"thumb_ffmpeg_extra_args":                    "-hwaccel auto",
+	"thumb_ffmpeg_storyboard_enabled":            "0",
+	"thumb_ffmpeg_storyboard_on_upload":          "0",
+	"thumb_ffmpeg_storyboard_max_size":           "10737418240", // 10 GB
+	"thumb_ffmpeg_storyboard_frames":             "100",
+	"thumb_ffmpeg_storyboard_tile_width":         "160",
+	"thumb_ffmpeg_storyboard_columns":            "10",
+	"thumb_storyboard_entity_suffix":             "{blob_path}/{blob_name}._storyboard",
+	"transcode_enabled":                          "0",
+	"transcode_auto":                             "0",
+	"transcode_exts":                             "avi,flv,m2ts,mkv,mov,mts,mxf,rm,rmvb,ts,wmv",
+	"transcode_max_size":                         "53687091200", // 50 GB
+	"transcode_renditions":                       "1080:5000,720:2800,480:1200",
+	"transcode_segment_duration":                 "6",
+	"transcode_extra_args":                       "",
+	"transcode_entity_suffix":                    "{blob_path}/{blob_name}._hls",
+	"transcode_session_ttl":                      "21600",
 	"thumb_libreoffice_path":                     "soffice",
 	"thumb_libreoffice_max_size":                 "78643200", // 75 MB
 	"thumb_libreoffice_enabled":                  "0",
@@ -579,6 +605,9 @@ var DefaultSettings = map[string]string{
 	"thumb_music_cover_enabled":                  "1",
 	"thumb_music_cover_exts":                     "mp3,m4a,ogg,flac",
 	"thumb_music_cover_max_size":                 "1073741824", // 1 GB
+	"thumb_epub_cover_enabled":                   "1",
+	"thumb_epub_cover_exts":                      "epub",
+	"thumb_epub_cover_max_size":                  "536870912", // 512 MB
 	"thumb_libraw_enabled":                       "0",
 	"thumb_libraw_path":                          "simple_dcraw",
 	"thumb_libraw_max_size":                      "78643200", // 75 MB
@@ -594,6 +623,10 @@ var DefaultSettings = map[string]string{
 	"use_cursor_pagination":                      "1",
 	"max_page_size":                              "2000",
 	"max_recursive_searched_folder":              "65535",
+	"recent_files_window":                        "2592000",
+	"recent_files_dedup":                         "300",
+	"photos_exts":                                "jpg,jpeg,png,gif,bmp,webp,heic,heif,avif,tif,tiff,dng,cr2,cr3,nef,arw,raf,orf,rw2,mp4,mov,m4v,3gp,mkv,avi,webm,mts,m2ts",
+	"music_exts":                                 "mp3,m4a,ogg,oga,opus,flac,wav,aac,wma,ape,alac",
 	"max_batched_file":                           "3000",
 	"queue_media_meta_worker_num":                "30",
 	"queue_media_meta_max_execution":             "600",
@@ -625,6 +658,12 @@ var DefaultSettings = map[string]string{
 	"queue_remote_download_backoff_max_duration": "600",
 	"queue_remote_download_max_retry":            "5",
 	"queue_remote_download_retry_delay":          "0",
+	"queue_transcode_worker_num":                 "2",
+	"queue_transcode_max_execution":              "86400",
+	"queue_transcode_backoff_factor":             "2",
+	"queue_transcode_backoff_max_duration":       "600",
+	"queue_transcode_max_retry":                  "1",
+	"queue_transcode_retry_delay":                "0",
 	"entity_url_default_ttl":                     "3600",
 	"entity_url_cache_margin":                    "600",
 	"media_meta":                                 "1",
@@ -635,12 +674,17 @@ var DefaultSettings = map[string]string{
 	"media_meta_music":                           "1",
 	"media_meta_music_size_local":                "1073741824",
 	"media_exif_music_size_remote":               "1073741824",
+	"media_meta_document":                        "1",
+	"media_meta_document_size_local":             "104857600",
+	"media_meta_document_size_remote":            "52428800",
 	"media_meta_ffprobe":                         "0",
 	"media_meta_ffprobe_path":                    "ffprobe",
 	"media_meta_ffprobe_size_local":              "0",
 	"media_meta_ffprobe_size_remote":             "0",
 	"media_meta_geocoding":                       "0",
 	"media_meta_geocoding_mapbox_ak":             "",
+	"media_meta_geocoding_provider":              "mapbox",
+	"media_meta_geocoding_offline_dataset":       "",
 	"site_logo":                                  "/static/img/logo.svg",
 	"site_logo_light":                            "/static/img/logo_light.svg",
 	"tos_url":                                    "https://cloudreve.org/privacy-policy",
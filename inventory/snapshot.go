@@ -0,0 +1,252 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/entity"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/ent/schema"
+	"github.com/cloudreve/Cloudreve/v4/ent/snapshot"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+	"github.com/samber/lo"
+)
+
+type (
+	SnapshotClient interface {
+		TxOperator
+		// Create creates a snapshot and pins all entities referenced by its manifest. Pinned entities
+		// are still charged to files linking them, until a snapshot becomes their last reference.
+		Create(ctx context.Context, args *CreateSnapshotParameters) (*ent.Snapshot, error)
+		// GetByID returns a snapshot by its ID, with manifest loaded.
+		GetByID(ctx context.Context, id int) (*ent.Snapshot, error)
+		// List returns snapshots with given args, manifest is not loaded.
+		List(ctx context.Context, args *ListSnapshotParameters) (*ListSnapshotResult, error)
+		// Delete deletes snapshots and unpins their entities, returns IDs of entities no longer referenced
+		// and the storage released from owners of snapshots that were their last reference.
+		Delete(ctx context.Context, snapshots ...*ent.Snapshot) ([]int, StorageDiff, error)
+		// DeleteByUser deletes all snapshots of a given user.
+		DeleteByUser(ctx context.Context, uid int) error
+	}
+
+	CreateSnapshotParameters struct {
+		OwnerID   int
+		FileID    int
+		Name      string
+		RootUri   string
+		Scheduled bool
+		Manifest  []types.SnapshotNode
+	}
+
+	ListSnapshotParameters struct {
+		*PaginationArgs
+		OwnerID   int
+		FileID    int
+		Scheduled *bool
+	}
+
+	ListSnapshotResult struct {
+		*PaginationResults
+		Snapshots []*ent.Snapshot
+	}
+)
+
+func NewSnapshotClient(client *ent.Client, dbType conf.DBType) SnapshotClient {
+	return &snapshotClient{client: client, maxSQlParam: sqlParamLimit(dbType)}
+}
+
+type snapshotClient struct {
+	maxSQlParam int
+	client      *ent.Client
+}
+
+func (c *snapshotClient) SetClient(newClient *ent.Client) TxOperator {
+	return &snapshotClient{client: newClient, maxSQlParam: c.maxSQlParam}
+}
+
+func (c *snapshotClient) GetClient() *ent.Client {
+	return c.client
+}
+
+func (c *snapshotClient) Create(ctx context.Context, args *CreateSnapshotParameters) (*ent.Snapshot, error) {
+	files := lo.CountBy(args.Manifest, func(node types.SnapshotNode) bool {
+		return node.Type == types.FileTypeFile
+	})
+	size := lo.SumBy(args.Manifest, func(node types.SnapshotNode) int64 {
+		return node.Size
+	})
+
+	s, err := c.client.Snapshot.Create().
+		SetOwnerID(args.OwnerID).
+		SetFileID(args.FileID).
+		SetName(args.Name).
+		SetRootURI(args.RootUri).
+		SetScheduled(args.Scheduled).
+		SetFiles(files).
+		SetSize(size).
+		SetManifest(args.Manifest).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create snapshot: %w", err)
+	}
+
+	if err := c.addEntityReference(ctx, 1, snapshotEntities(s)); err != nil {
+		return nil, fmt.Errorf("failed to pin snapshot entities: %w", err)
+	}
+
+	return s, nil
+}
+
+func (c *snapshotClient) GetByID(ctx context.Context, id int) (*ent.Snapshot, error) {
+	return c.client.Snapshot.Query().Where(snapshot.ID(id)).First(ctx)
+}
+
+func (c *snapshotClient) List(ctx context.Context, args *ListSnapshotParameters) (*ListSnapshotResult, error) {
+	query := c.client.Snapshot.Query()
+	if args.OwnerID > 0 {
+		query.Where(snapshot.OwnerID(args.OwnerID))
+	}
+
+	if args.FileID > 0 {
+		query.Where(snapshot.FileID(args.FileID))
+	}
+
+	if args.Scheduled != nil {
+		query.Where(snapshot.Scheduled(*args.Scheduled))
+	}
+
+	pageSize := capPageSize(c.maxSQlParam, args.PageSize, 10)
+	query.Order(snapshot.ByID(getOrderTerm(args.Order)))
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count snapshots: %w", err)
+	}
+
+	snapshots, err := query.
+		Select(
+			snapshot.FieldID,
+			snapshot.FieldCreatedAt,
+			snapshot.FieldUpdatedAt,
+			snapshot.FieldName,
+			snapshot.FieldFileID,
+			snapshot.FieldRootURI,
+			snapshot.FieldFiles,
+			snapshot.FieldSize,
+			snapshot.FieldScheduled,
+			snapshot.FieldOwnerID,
+		).
+		Limit(pageSize).
+		Offset(args.Page * pageSize).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list snapshots: %w", err)
+	}
+
+	return &ListSnapshotResult{
+		Snapshots: snapshots,
+		PaginationResults: &PaginationResults{
+			Page:       args.Page,
+			PageSize:   pageSize,
+			TotalItems: total,
+		},
+	}, nil
+}
+
+func (c *snapshotClient) Delete(ctx context.Context, snapshots ...*ent.Snapshot) ([]int, StorageDiff, error) {
+	// holders stores the relation between entity ID and owner of the snapshot last unpinning it.
+	holders := make(map[int]int)
+	for _, s := range snapshots {
+		if s.Manifest == nil {
+			// Manifest is not selected in list result, reload it.
+			full, err := c.GetByID(ctx, s.ID)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get snapshot %d: %w", s.ID, err)
+			}
+			s = full
+		}
+
+		entities := snapshotEntities(s)
+		if err := c.addEntityReference(ctx, -1, entities); err != nil {
+			return nil, nil, fmt.Errorf("failed to unpin snapshot entities: %w", err)
+		}
+
+		if err := c.client.Snapshot.DeleteOneID(s.ID).Exec(schema.SkipSoftDelete(ctx)); err != nil {
+			return nil, nil, fmt.Errorf("failed to delete snapshot %d: %w", s.ID, err)
+		}
+
+		for _, id := range entities {
+			holders[id] = s.OwnerID
+		}
+	}
+
+	// Entities no longer referenced were only kept by snapshots, their sizes are released from
+	// snapshot owners.
+	stale := make([]int, 0)
+	diff := make(StorageDiff)
+	for _, chunk := range lo.Chunk(lo.Keys(holders), capPageSize(c.maxSQlParam, 0, 10)) {
+		entities, err := c.client.Entity.Query().
+			Where(entity.IDIn(chunk...), entity.ReferenceCountLTE(0)).
+			Select(entity.FieldSize).
+			All(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to query stale entities: %w", err)
+		}
+
+		for _, e := range entities {
+			stale = append(stale, e.ID)
+			diff[holders[e.ID]] -= e.Size
+		}
+	}
+
+	return stale, diff, nil
+}
+
+func (c *snapshotClient) DeleteByUser(ctx context.Context, uid int) error {
+	for {
+		snapshots, err := c.client.Snapshot.Query().
+			Where(snapshot.OwnerID(uid)).
+			Limit(capPageSize(c.maxSQlParam, 0, 10)).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query snapshots: %w", err)
+		}
+
+		if len(snapshots) == 0 {
+			return nil
+		}
+
+		// Unpinned entities are collected along with user files.
+		if _, _, err := c.Delete(ctx, snapshots...); err != nil {
+			return err
+		}
+	}
+}
+
+// addEntityReference adds delta to reference count of given entities.
+func (c *snapshotClient) addEntityReference(ctx context.Context, delta int, ids []int) error {
+	for _, chunk := range lo.Chunk(ids, capPageSize(c.maxSQlParam, 0, 10)) {
+		if err := c.client.Entity.Update().
+			Where(entity.IDIn(chunk...)).
+			AddReferenceCount(delta).
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// snapshotEntities returns distinct entity IDs pinned by given snapshot.
+func snapshotEntities(s *ent.Snapshot) []int {
+	return lo.Uniq(lo.FilterMap(s.Manifest, func(node types.SnapshotNode, index int) (int, bool) {
+		return node.Entity, node.Entity > 0
+	}))
+}
+
+// entityDetached matches entities only pinned by snapshots, whose sizes are charged to snapshot owners.
+func entityDetached() predicate.Entity {
+	return entity.And(entity.ReferenceCountGT(0), entity.Not(entity.HasFile()))
+}
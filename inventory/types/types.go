@@ -17,6 +17,10 @@ type (
 		DisableViewSync     bool                     `json:"disable_view_sync,omitempty"`
 		FsViewMap           map[string]ExplorerView  `json:"fs_view_map,omitempty"`
 		ShareLinksInProfile ShareLinksInProfileLevel `json:"share_links_in_profile,omitempty"`
+		// VersionRetentionSchedule thins out historical versions of user's files by age.
+		VersionRetentionSchedule *VersionRetentionSchedule `json:"version_retention_schedule,omitempty"`
+		// SavedSearches are search queries presented as smart folders under search file system.
+		SavedSearches []SavedSearch `json:"saved_searches,omitempty"`
 	}
 
 	ShareLinksInProfileLevel string
@@ -26,6 +30,13 @@ type (
 		Name string `json:"name,omitempty"`
 	}
 
+	SavedSearch struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+		// Uri is the search URI, including scope folder and search parameters in query.
+		Uri string `json:"uri"`
+	}
+
 	// GroupSetting 用户组其他配置
 	GroupSetting struct {
 		CompressSize          int64                  `json:"compress_size,omitempty"` // 可压缩大小
@@ -36,6 +47,21 @@ type (
 		MaxWalkedFiles        int                    `json:"max_walked_files,omitempty"`
 		TrashRetention        int                    `json:"trash_retention,omitempty"`
 		RedirectedSource      bool                   `json:"redirected_source,omitempty"`
+		// VersionRetentionSchedule default version retention schedule for users in this group.
+		VersionRetentionSchedule *VersionRetentionSchedule `json:"version_retention_schedule,omitempty"`
+	}
+
+	// VersionRetentionSchedule defines how historical versions are kept over time. Versions older than
+	// the widest rule window will be pruned.
+	VersionRetentionSchedule struct {
+		Rules []VersionRetentionRule `json:"rules,omitempty" binding:"max=32,dive"`
+	}
+
+	// VersionRetentionRule keeps at most one version per Interval for versions younger than Within.
+	// Both fields are in seconds, Interval of 0 keeps all versions within the window.
+	VersionRetentionRule struct {
+		Within   int64 `json:"within" binding:"min=1"`
+		Interval int64 `json:"interval" binding:"min=0"`
 	}
 
 	// PolicySetting 非公有的存储策略属性
@@ -109,15 +135,18 @@ type (
 
 	FileType         int
 	EntityType       int
+	FileActivityType int
 	GroupPermission  int
 	FilePermission   int
 	DavAccountOption int
 	NodeCapability   int
 
 	NodeSetting struct {
-		Provider            DownloaderProvider `json:"provider,omitempty"`
-		*QBittorrentSetting `json:"qbittorrent,omitempty"`
-		*Aria2Setting       `json:"aria2,omitempty"`
+		Provider                  DownloaderProvider `json:"provider,omitempty"`
+		*QBittorrentSetting       `json:"qbittorrent,omitempty"`
+		*Aria2Setting             `json:"aria2,omitempty"`
+		*BuiltinDownloaderSetting `json:"builtin,omitempty"`
+		*TransmissionSetting      `json:"transmission,omitempty"`
 		// 下载监控间隔
 		Interval       int  `json:"interval,omitempty"`
 		WaitForSeeding bool `json:"wait_for_seeding,omitempty"`
@@ -140,6 +169,21 @@ type (
 		TempPath string         `json:"temp_path,omitempty"`
 	}
 
+	TransmissionSetting struct {
+		Server   string         `json:"server,omitempty"`
+		User     string         `json:"user,omitempty"`
+		Password string         `json:"password,omitempty"`
+		Options  map[string]any `json:"options,omitempty"`
+		TempPath string         `json:"temp_path,omitempty"`
+	}
+
+	// BuiltinDownloaderSetting is the setting of built-in HTTP/FTP downloader.
+	BuiltinDownloaderSetting struct {
+		// Options are default task options, using the same names as aria2, e.g. split, header.
+		Options  map[string]any `json:"options,omitempty"`
+		TempPath string         `json:"temp_path,omitempty"`
+	}
+
 	TaskPublicState struct {
 		Error            string          `json:"error,omitempty"`
 		ErrorHistory     []string        `json:"error_history,omitempty"`
@@ -159,6 +203,48 @@ type (
 	EntityProps struct {
 		UnlinkOnly      bool             `json:"unlink_only,omitempty"`
 		EncryptMetadata *EncryptMetadata `json:"encrypt_metadata,omitempty"`
+		// Hash is the hex encoded SHA-256 of entity content, computed on demand.
+		Hash string `json:"hash,omitempty"`
+		// Transcode describes HLS renditions stored in a transcode entity.
+		Transcode *TranscodeProps `json:"transcode,omitempty"`
+		// Storyboard describes frame layout of a storyboard sprite sheet entity.
+		Storyboard *StoryboardProps `json:"storyboard,omitempty"`
+	}
+
+	// StoryboardProps describes evenly spaced video frames tiled in a storyboard sprite sheet.
+	StoryboardProps struct {
+		// SourceEntity is the ID of the version entity that frames are extracted from.
+		SourceEntity int `json:"source_entity"`
+		// Interval is the duration in seconds between two frames.
+		Interval   float64 `json:"interval"`
+		Count      int     `json:"count"`
+		Columns    int     `json:"columns"`
+		TileWidth  int     `json:"tile_width"`
+		TileHeight int     `json:"tile_height"`
+	}
+
+	// TranscodeProps describes all HLS renditions concatenated in one transcode entity.
+	TranscodeProps struct {
+		// SourceEntity is the ID of the version entity that renditions are transcoded from.
+		SourceEntity int                  `json:"source_entity"`
+		Renditions   []TranscodeRendition `json:"renditions"`
+	}
+
+	TranscodeRendition struct {
+		Name           string             `json:"name"`
+		Bandwidth      int                `json:"bandwidth"`
+		Width          int                `json:"width,omitempty"`
+		Height         int                `json:"height,omitempty"`
+		Codecs         string             `json:"codecs,omitempty"`
+		TargetDuration int                `json:"target_duration"`
+		Segments       []TranscodeSegment `json:"segments"`
+	}
+
+	// TranscodeSegment is a MPEG-TS segment located by byte range within the transcode entity.
+	TranscodeSegment struct {
+		Duration float64 `json:"duration"`
+		Offset   int64   `json:"offset"`
+		Length   int64   `json:"length"`
 	}
 
 	Cipher string
@@ -176,7 +262,31 @@ type (
 	PolicyType string
 
 	FileProps struct {
-		View *ExplorerView `json:"view,omitempty"`
+		View             *ExplorerView             `json:"view,omitempty"`
+		VersionRetention *VersionRetentionSchedule `json:"version_retention,omitempty"`
+		Snapshot         *SnapshotSchedule         `json:"snapshot,omitempty"`
+	}
+
+	// SnapshotSchedule defines how often a folder is snapshotted automatically.
+	SnapshotSchedule struct {
+		// Interval between two scheduled snapshots, in seconds.
+		Interval int64 `json:"interval" binding:"min=3600"`
+		// Keep is the max number of scheduled snapshots retained, oldest ones are deleted first.
+		Keep int `json:"keep" binding:"min=1,max=1000"`
+	}
+
+	// SnapshotNode is a file or folder recorded in a snapshot manifest.
+	SnapshotNode struct {
+		// FileID is the ID of the original file.
+		FileID int `json:"file_id"`
+		// Path is the path relative to the snapshot root, empty for the root itself.
+		Path      string    `json:"path"`
+		Type      FileType  `json:"type"`
+		Size      int64     `json:"size,omitempty"`
+		Entity    int       `json:"entity,omitempty"`
+		Policy    int       `json:"policy,omitempty"`
+		CreatedAt time.Time `json:"created_at"`
+		UpdatedAt time.Time `json:"updated_at"`
 	}
 
 	ExplorerView struct {
@@ -205,6 +315,10 @@ type (
 		ShareView bool `json:"share_view,omitempty"`
 		// Whether to automatically show readme file in share view
 		ShowReadMe bool `json:"show_read_me,omitempty"`
+		// AlbumID is the ID of shared photo album, the shared file is owner's root folder in this case.
+		AlbumID int `json:"album_id,omitempty"`
+		// AlbumName is the name of shared album when the share is created or updated.
+		AlbumName string `json:"album_name,omitempty"`
 	}
 
 	FileTypeIconSetting struct {
@@ -235,6 +349,7 @@ const (
 	GroupPermissionSetExplicitUser_placeholder
 	GroupPermissionIgnoreFileOwnership // not used
 	GroupPermissionUniqueRedirectDirectLink
+	GroupPermissionTranscode
 )
 
 const (
@@ -243,6 +358,7 @@ const (
 	NodeCapabilityExtractArchive
 	NodeCapabilityRemoteDownload
 	NodeCapability_CommunityPlaceholder
+	NodeCapabilityTranscode
 )
 
 const (
@@ -254,6 +370,21 @@ const (
 	EntityTypeVersion EntityType = iota
 	EntityTypeThumbnail
 	EntityTypeLivePhoto
+	EntityTypeTranscode
+	EntityTypeStoryboard
+	EntityTypeThumbnailSmall
+	EntityTypeThumbnailLarge
+)
+
+// IsThumbnail returns true if the entity is a thumbnail of any size.
+func (t EntityType) IsThumbnail() bool {
+	return t == EntityTypeThumbnail || t == EntityTypeThumbnailSmall || t == EntityTypeThumbnailLarge
+}
+
+const (
+	FileActivityModify FileActivityType = iota
+	FileActivityOpen
+	FileActivityDownload
 )
 
 func FileTypeFromString(s string) FileType {
@@ -286,8 +417,10 @@ const (
 )
 
 const (
-	DownloaderProviderAria2       = DownloaderProvider("aria2")
-	DownloaderProviderQBittorrent = DownloaderProvider("qbittorrent")
+	DownloaderProviderAria2        = DownloaderProvider("aria2")
+	DownloaderProviderQBittorrent  = DownloaderProvider("qbittorrent")
+	DownloaderProviderBuiltin      = DownloaderProvider("builtin")
+	DownloaderProviderTransmission = DownloaderProvider("transmission")
 )
 
 type (
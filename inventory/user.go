@@ -15,15 +15,18 @@ import (
 
 	"github.com/cloudreve/Cloudreve/v4/ent"
 	"github.com/cloudreve/Cloudreve/v4/ent/davaccount"
+	"github.com/cloudreve/Cloudreve/v4/ent/entity"
 	"github.com/cloudreve/Cloudreve/v4/ent/file"
 	"github.com/cloudreve/Cloudreve/v4/ent/passkey"
 	"github.com/cloudreve/Cloudreve/v4/ent/schema"
+	"github.com/cloudreve/Cloudreve/v4/ent/snapshot"
 	"github.com/cloudreve/Cloudreve/v4/ent/task"
 	"github.com/cloudreve/Cloudreve/v4/ent/user"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
 	"github.com/cloudreve/Cloudreve/v4/pkg/util"
 	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/samber/lo"
 )
 
 type (
@@ -62,6 +65,8 @@ type (
 		GetLoginUserByID(ctx context.Context, uid int) (*ent.User, error)
 		// GetLoginUserByEmail returns the login user by its WebDAV credentials.
 		GetActiveByDavAccount(ctx context.Context, email, pwd string) (*ent.User, error)
+		// GetActiveWithDavAccounts returns active user with given email, all dav accounts and group of user are loaded.
+		GetActiveWithDavAccounts(ctx context.Context, email string) (*ent.User, error)
 		// SaveSettings saves user settings.
 		SaveSettings(ctx context.Context, u *ent.User) error
 		// SearchActive search active users by Email or nickname.
@@ -279,6 +284,29 @@ func (c *userClient) CalculateStorage(ctx context.Context, uid int) (int64, erro
 		offset += batchSize
 	}
 
+	// Entities only pinned by snapshots are charged to snapshot owners.
+	snapshots, err := c.client.Snapshot.Query().Where(snapshot.OwnerID(uid)).All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list user snapshots: %w", err)
+	}
+
+	pinned := lo.Uniq(lo.FlatMap(snapshots, func(s *ent.Snapshot, index int) []int {
+		return snapshotEntities(s)
+	}))
+	for _, chunk := range lo.Chunk(pinned, batchSize) {
+		detached, err := c.client.Entity.Query().
+			Where(entity.IDIn(chunk...), entityDetached()).
+			Select(entity.FieldSize).
+			All(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("failed to list detached entities: %w", err)
+		}
+
+		for _, e := range detached {
+			sum += e.Size
+		}
+	}
+
 	if _, err := c.client.User.UpdateOneID(uid).SetStorage(sum).Save(ctx); err != nil {
 		return 0, err
 	}
@@ -371,6 +399,17 @@ func (c *userClient) GetActiveByDavAccount(ctx context.Context, email, pwd strin
 	).First(ctx)
 }
 
+func (c *userClient) GetActiveWithDavAccounts(ctx context.Context, email string) (*ent.User, error) {
+	ctx = context.WithValue(ctx, LoadUserGroup{}, true)
+	return withUserEagerLoading(
+		ctx,
+		c.client.User.Query().
+			Where(user.EmailEqualFold(email)).
+			Where(user.StatusEQ(user.StatusActive)).
+			WithDavAccounts(),
+	).First(ctx)
+}
+
 func (c *userClient) GetLoginUserByID(ctx context.Context, uid int) (*ent.User, error) {
 	ctx = context.WithValue(ctx, LoadUserGroup{}, true)
 	if uid > 0 {
@@ -1,7 +1,11 @@
 package middleware
 
 import (
+	"crypto/md5"
+	"crypto/subtle"
+	"encoding/hex"
 	"net/http"
+	"strings"
 
 	"github.com/cloudreve/Cloudreve/v4/application/dependency"
 	"github.com/cloudreve/Cloudreve/v4/ent"
@@ -12,6 +16,7 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
 	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
 	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/cloudreve/Cloudreve/v4/pkg/subsonic"
 	"github.com/cloudreve/Cloudreve/v4/pkg/util"
 
 	"github.com/cloudreve/Cloudreve/v4/pkg/auth"
@@ -174,6 +179,94 @@ func WebDAVAuth() gin.HandlerFunc {
 	}
 }
 
+// SubsonicAuth authenticates Subsonic clients with user email and password of one of user's WebDAV
+// accounts, either in plain text or as a salted token.
+func SubsonicAuth() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		username := c.Request.FormValue("u")
+		password, token, salt := c.Request.FormValue("p"), c.Request.FormValue("t"), c.Request.FormValue("s")
+		if c.Request.FormValue("apiKey") != "" {
+			subsonic.Abort(c, subsonic.ErrAuthNotSupported, "API key authentication is not supported")
+			return
+		}
+
+		if username == "" || (password == "" && (token == "" || salt == "")) {
+			subsonic.Abort(c, subsonic.ErrMissingParameter, "Required authentication parameters are missing")
+			return
+		}
+
+		if hexPassword, ok := strings.CutPrefix(password, "enc:"); ok {
+			decoded, err := hex.DecodeString(hexPassword)
+			if err != nil {
+				subsonic.Abort(c, subsonic.ErrWrongCredentials, "Wrong username or password")
+				return
+			}
+			password = string(decoded)
+		}
+
+		dep := dependency.FromContext(c)
+		l := dep.Logger()
+		expectedUser, err := dep.UserClient().GetActiveWithDavAccounts(c, username)
+		if err != nil {
+			l.Debug("SubsonicAuth: failed to get user %q: %s", username, err)
+			subsonic.Abort(c, subsonic.ErrWrongCredentials, "Wrong username or password")
+			return
+		}
+
+		var matched *ent.DavAccount
+		for _, account := range expectedUser.Edges.DavAccounts {
+			expected := account.Password
+			provided := password
+			if password == "" {
+				sum := md5.Sum([]byte(account.Password + salt))
+				expected, provided = hex.EncodeToString(sum[:]), strings.ToLower(token)
+			}
+
+			if subtle.ConstantTimeCompare([]byte(expected), []byte(provided)) == 1 {
+				matched = account
+				break
+			}
+		}
+
+		if matched == nil {
+			l.Debug("SubsonicAuth: no dav account of user %q matches provided credential", username)
+			subsonic.Abort(c, subsonic.ErrWrongCredentials, "Wrong username or password")
+			return
+		}
+
+		group, err := expectedUser.Edges.GroupOrErr()
+		if err != nil {
+			l.Debug("SubsonicAuth: user group not found: %s", err)
+			subsonic.Abort(c, subsonic.ErrGeneric, "User group not found")
+			return
+		}
+
+		if !group.Permissions.Enabled(int(types.GroupPermissionWebDAV)) {
+			l.Debug("SubsonicAuth: user %q does not have WebDAV permission.", expectedUser.Email)
+			subsonic.Abort(c, subsonic.ErrNotAuthorized, "User is not allowed to use WebDAV")
+			return
+		}
+
+		expectedUser.Edges.DavAccounts = []*ent.DavAccount{matched}
+		SetUserCtxByUser(c, expectedUser)
+		c.Next()
+	}
+}
+
+// SubsonicWritable rejects Subsonic requests authenticated by a read-only WebDAV account, must be
+// used after SubsonicAuth.
+func SubsonicWritable() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		accounts := inventory.UserFromContext(c).Edges.DavAccounts
+		if len(accounts) > 0 && accounts[0].Options.Enabled(int(types.DavAccountReadOnly)) {
+			subsonic.Abort(c, subsonic.ErrNotAuthorized, "WebDAV account is read-only")
+			return
+		}
+
+		c.Next()
+	}
+}
+
 // 对上传会话进行验证
 func UseUploadSession(policyType types.PolicyType) gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -43,6 +43,11 @@ func FrontendFileHandler(dep dependency.Dep) gin.HandlerFunc {
 		// Skipping routers handled by backend
 		if strings.HasPrefix(path, "/api") ||
 			strings.HasPrefix(path, "/dav") ||
+			strings.HasPrefix(path, "/rest/") ||
+			strings.HasPrefix(path, "/caldav") ||
+			strings.HasPrefix(path, "/carddav") ||
+			strings.HasPrefix(path, "/.well-known/caldav") ||
+			strings.HasPrefix(path, "/.well-known/carddav") ||
 			strings.HasPrefix(path, "/f/") ||
 			strings.HasPrefix(path, "/s/") ||
 			path == "/manifest.json" {
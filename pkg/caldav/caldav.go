@@ -0,0 +1,870 @@
+// Package caldav provides CalDAV (RFC 4791) and CardDAV (RFC 6352) servers. Calendar objects and vCards
+// are stored as files in a hidden folder of each user, so they are counted in user's storage quota.
+package caldav
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/webdav"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	syncTokenPrefix = "http://cloudreve.org/ns/sync/"
+)
+
+var (
+	errInvalidDepth    = errors.New("invalid depth")
+	errUnsupportedBody = errors.New("unsupported request body")
+
+	// writeMethods are methods rejected for read-only accounts.
+	writeMethods = map[string]bool{
+		http.MethodPut:    true,
+		http.MethodDelete: true,
+		"PROPPATCH":       true,
+		"MKCOL":           true,
+		"MKCALENDAR":      true,
+	}
+)
+
+type (
+	// request is a parsed CalDAV or CardDAV request. URL path is in form of
+	// "/<prefix>/[<collection>/[<item>]]", prefix root is both the principal and the collection home.
+	request struct {
+		kind       Kind
+		user       *ent.User
+		fm         manager.FileManager
+		collection string
+		item       string
+		readOnly   bool
+	}
+
+	propGetter func() (string, error)
+
+	// resource is a home, collection or item with its properties.
+	resource struct {
+		href string
+		live map[xml.Name]propGetter
+		// expensive are live properties only returned when explicitly requested.
+		expensive map[xml.Name]bool
+		dead      map[xml.Name]webdav.DeadPropsStore
+	}
+)
+
+// ServeHTTP returns the handler serving CalDAV or CardDAV requests of given kind.
+func ServeHTTP(kind Kind) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		dep := dependency.FromContext(c)
+		if c.Request.Method == http.MethodOptions {
+			handleOptions(c, kind)
+			return
+		}
+
+		u := inventory.UserFromContext(c)
+		r, ok := parseRequest(c.Request.URL.Path, kind)
+		if !ok {
+			c.Status(http.StatusNotFound)
+			return
+		}
+
+		r.user = u
+		r.fm = manager.NewFileManager(dep, u)
+		r.readOnly = u.Edges.DavAccounts[0].Options.Enabled(int(types.DavAccountReadOnly))
+		defer r.fm.Recycle()
+
+		status, err := http.StatusMethodNotAllowed, error(nil)
+		if r.readOnly && writeMethods[c.Request.Method] {
+			status = http.StatusForbidden
+		} else {
+			switch c.Request.Method {
+			case http.MethodGet, http.MethodHead:
+				status, err = handleGet(c, r)
+			case http.MethodPut:
+				status, err = handlePut(c, r)
+			case http.MethodDelete:
+				status, err = handleDelete(c, r)
+			case "PROPFIND":
+				status, err = handlePropfind(c, r)
+			case "PROPPATCH":
+				status, err = handleProppatch(c, r)
+			case "REPORT":
+				status, err = handleReport(c, r)
+			case "MKCOL", "MKCALENDAR":
+				status, err = handleMkcol(c, r)
+			}
+		}
+
+		if status != 0 {
+			c.Status(status)
+		}
+
+		if err != nil {
+			dep.Logger().Debug("CalDAV request failed with error: %s", err)
+		}
+	}
+}
+
+// WellKnown redirects service discovery requests (RFC 6764) to the home of given kind.
+func WellKnown(kind Kind) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Redirect(http.StatusMovedPermanently, kind.Prefix()+"/")
+	}
+}
+
+// parseRequest splits URL path into collection and item name.
+func parseRequest(p string, kind Kind) (*request, bool) {
+	rest, ok := strings.CutPrefix(p, kind.Prefix())
+	if !ok {
+		return nil, false
+	}
+
+	var segments []string
+	for _, s := range strings.Split(rest, "/") {
+		if s != "" {
+			segments = append(segments, s)
+		}
+	}
+
+	r := &request{kind: kind}
+	switch len(segments) {
+	case 0:
+	case 1:
+		r.collection = segments[0]
+	case 2:
+		r.collection, r.item = segments[0], segments[1]
+	default:
+		return nil, false
+	}
+
+	return r, true
+}
+
+func handleOptions(c *gin.Context, kind Kind) {
+	compliance := "1, 3, extended-mkcol, calendar-access"
+	allow := "OPTIONS, GET, HEAD, PUT, DELETE, PROPFIND, PROPPATCH, REPORT, MKCOL, MKCALENDAR"
+	if kind == KindAddressBook {
+		compliance = "1, 3, extended-mkcol, addressbook"
+		allow = "OPTIONS, GET, HEAD, PUT, DELETE, PROPFIND, PROPPATCH, REPORT, MKCOL"
+	}
+
+	c.Header("DAV", compliance)
+	c.Header("Allow", allow)
+	c.Status(http.StatusOK)
+}
+
+func handleGet(c *gin.Context, r *request) (int, error) {
+	if r.item == "" {
+		return http.StatusMethodNotAllowed, nil
+	}
+
+	col, err := openCollection(c, r.fm, r.user, r.kind, r.collection)
+	if err != nil {
+		return statusFromError(err), err
+	}
+	defer col.close()
+
+	f, err := col.item(c, r.item)
+	if err != nil {
+		return statusFromError(err), err
+	}
+
+	content, err := readItem(c, col, f)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	c.Header("ETag", etag(c, f))
+	c.Header("Last-Modified", f.UpdatedAt().UTC().Format(http.TimeFormat))
+	c.Data(http.StatusOK, r.kind.contentType(), content)
+	return 0, nil
+}
+
+func handlePut(c *gin.Context, r *request) (int, error) {
+	if r.item == "" {
+		return http.StatusMethodNotAllowed, nil
+	}
+
+	if strings.HasPrefix(r.item, ".") {
+		return http.StatusForbidden, nil
+	}
+
+	col, err := openCollection(c, r.fm, r.user, r.kind, r.collection)
+	if err != nil {
+		return statusFromError(err), err
+	}
+	defer col.close()
+
+	if !col.writable() {
+		return http.StatusForbidden, nil
+	}
+
+	data, err := io.ReadAll(io.LimitReader(c.Request.Body, maxItemSize+1))
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
+
+	ns := nsCalDAV
+	if r.kind == KindAddressBook {
+		ns = nsCardDAV
+	}
+
+	if len(data) > maxItemSize {
+		writeError(c.Writer, http.StatusForbidden, xml.Name{Space: ns, Local: "max-resource-size"})
+		return 0, nil
+	}
+
+	if precondition := validateItem(col, data); precondition != "" {
+		writeError(c.Writer, http.StatusForbidden, xml.Name{Space: ns, Local: precondition})
+		return 0, nil
+	}
+
+	existing, err := col.item(c, r.item)
+	if err != nil {
+		if statusFromError(err) != http.StatusNotFound {
+			return statusFromError(err), err
+		}
+		existing = nil
+	}
+
+	if status := checkPreconditions(c, existing); status != 0 {
+		return status, nil
+	}
+
+	res, err := col.fm.Update(c, &fs.UploadRequest{
+		Props: &fs.UploadProps{
+			Uri:  col.uri().Join(r.item),
+			Size: int64(len(data)),
+		},
+		File: io.NopCloser(bytes.NewReader(data)),
+		Mode: fs.ModeOverwrite,
+	})
+	if err != nil {
+		return statusFromError(err), err
+	}
+
+	if err := col.bump(c, r.item, false); err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	c.Header("ETag", etag(c, res))
+	if existing != nil {
+		return http.StatusNoContent, nil
+	}
+	return http.StatusCreated, nil
+}
+
+// validateItem checks content of an item to be stored in the collection, returns the name of failed
+// precondition if any.
+func validateItem(col *collection, data []byte) string {
+	invalid := "valid-calendar-data"
+	if col.kind == KindAddressBook {
+		invalid = "valid-address-data"
+	}
+
+	root, err := parseComponent(data)
+	if err != nil || root.name != col.kind.rootComponent() {
+		return invalid
+	}
+
+	if col.kind == KindCalendar {
+		supported := col.components()
+		for _, child := range root.children {
+			switch child.name {
+			case "VEVENT", "VTODO", "VJOURNAL", "VFREEBUSY":
+				found := false
+				for _, s := range supported {
+					found = found || s == child.name
+				}
+				if !found {
+					return "supported-calendar-component"
+				}
+			}
+		}
+	}
+
+	return ""
+}
+
+// checkPreconditions evaluates If-Match and If-None-Match headers against existing item.
+func checkPreconditions(c *gin.Context, existing fs.File) int {
+	if inm := c.GetHeader("If-None-Match"); inm != "" {
+		if existing != nil && (inm == "*" || etagMatches(inm, etag(c, existing))) {
+			return http.StatusPreconditionFailed
+		}
+	}
+
+	if im := c.GetHeader("If-Match"); im != "" {
+		if existing == nil || (im != "*" && !etagMatches(im, etag(c, existing))) {
+			return http.StatusPreconditionFailed
+		}
+	}
+
+	return 0
+}
+
+func etagMatches(header, tag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		if strings.TrimPrefix(strings.TrimSpace(candidate), "W/") == tag {
+			return true
+		}
+	}
+	return false
+}
+
+func handleDelete(c *gin.Context, r *request) (int, error) {
+	if r.collection == "" {
+		return http.StatusForbidden, nil
+	}
+
+	col, err := openCollection(c, r.fm, r.user, r.kind, r.collection)
+	if err != nil {
+		return statusFromError(err), err
+	}
+	defer col.close()
+
+	if r.item != "" {
+		if !col.writable() {
+			return http.StatusForbidden, nil
+		}
+
+		f, err := col.item(c, r.item)
+		if err != nil {
+			return statusFromError(err), err
+		}
+
+		if status := checkPreconditions(c, f); status != 0 {
+			return status, nil
+		}
+
+		if err := col.fm.Delete(c, []*fs.URI{f.Uri(false)}, fs.WithSkipSoftDelete(true)); err != nil {
+			return statusFromError(err), err
+		}
+
+		if err := col.bump(c, r.item, true); err != nil {
+			return http.StatusInternalServerError, err
+		}
+
+		return http.StatusNoContent, nil
+	}
+
+	if col.shared {
+		// Sharee removes the collection from its own list.
+		hasher := dependency.FromContext(c).HashIDEncoder()
+		if err := col.fm.PatchMedata(c, []*fs.URI{col.uri()}, fs.MetadataPatch{
+			Key:    shareMetadataPrefix + hashid.EncodeUserID(hasher, r.user.ID),
+			Remove: true,
+		}); err != nil {
+			return statusFromError(err), err
+		}
+
+		return http.StatusNoContent, nil
+	}
+
+	if err := col.fm.Delete(c, []*fs.URI{col.uri()}); err != nil {
+		return statusFromError(err), err
+	}
+
+	return http.StatusNoContent, nil
+}
+
+func handleMkcol(c *gin.Context, r *request) (int, error) {
+	if r.collection == "" || r.item != "" || (c.Request.Method == "MKCALENDAR" && r.kind != KindCalendar) {
+		return http.StatusMethodNotAllowed, nil
+	}
+
+	if !validCollectionName(r.collection) {
+		return http.StatusForbidden, nil
+	}
+
+	var body propertyUpdate
+	parsed, err := readXML(c.Request.Body, &body)
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
+
+	if parsed {
+		expected := xml.Name{Space: nsDAV, Local: "mkcol"}
+		if c.Request.Method == "MKCALENDAR" {
+			expected = xml.Name{Space: nsCalDAV, Local: "mkcalendar"}
+		}
+		if body.XMLName != expected {
+			return http.StatusUnsupportedMediaType, errUnsupportedBody
+		}
+	}
+
+	patches, _, forbidden := propPatches(&body, r.kind, true)
+	if len(forbidden) > 0 {
+		return http.StatusForbidden, nil
+	}
+
+	if err := createCollection(c, r.fm, r.kind, r.collection, patches); err != nil {
+		return statusFromError(err), err
+	}
+
+	return http.StatusCreated, nil
+}
+
+func handleProppatch(c *gin.Context, r *request) (int, error) {
+	if r.collection == "" || r.item != "" {
+		return http.StatusForbidden, nil
+	}
+
+	col, err := openCollection(c, r.fm, r.user, r.kind, r.collection)
+	if err != nil {
+		return statusFromError(err), err
+	}
+	defer col.close()
+
+	if col.shared {
+		return http.StatusForbidden, nil
+	}
+
+	var body propertyUpdate
+	parsed, err := readXML(c.Request.Body, &body)
+	if err != nil || !parsed || body.XMLName != (xml.Name{Space: nsDAV, Local: "propertyupdate"}) {
+		return http.StatusBadRequest, err
+	}
+
+	patches, names, forbidden := propPatches(&body, r.kind, false)
+	resp := &response{href: c.Request.URL.Path}
+	if len(forbidden) > 0 {
+		// PROPPATCH is atomic, none of the properties is changed.
+		resp.forbidden, resp.failed = forbidden, names
+	} else {
+		if err := col.fm.PatchMedata(c, []*fs.URI{col.uri()}, patches...); err != nil {
+			return statusFromError(err), err
+		}
+
+		for _, name := range names {
+			resp.props = append(resp.props, prop{name: name})
+		}
+	}
+
+	writeMultistatus(c.Writer, &multistatus{responses: []*response{resp}})
+	return 0, nil
+}
+
+// propPatches translates property updates into metadata patches of a collection. Protected properties are
+// returned as forbidden, properties that can be changed are returned as names.
+func propPatches(body *propertyUpdate, kind Kind, creating bool) ([]fs.MetadataPatch, []xml.Name, []xml.Name) {
+	var (
+		patches   []fs.MetadataPatch
+		names     []xml.Name
+		forbidden []xml.Name
+	)
+
+	for _, set := range body.Set {
+		for _, p := range set.Prop.Props {
+			switch {
+			case p.XMLName == xml.Name{Space: nsDAV, Local: "resourcetype"} && creating:
+				names = append(names, p.XMLName)
+			case p.XMLName == xml.Name{Space: nsCalDAV, Local: "supported-calendar-component-set"} &&
+				creating && kind == KindCalendar && len(p.Comps) > 0:
+				comps := make([]string, 0, len(p.Comps))
+				for _, comp := range p.Comps {
+					comps = append(comps, strings.ToUpper(comp.Name))
+				}
+				patches = append(patches, fs.MetadataPatch{Key: componentsMetadataKey, Value: strings.Join(comps, ",")})
+				names = append(names, p.XMLName)
+			case protectedProps[p.XMLName] || p.XMLName.Space == "" ||
+				strings.Contains(p.XMLName.Space, webdav.SpaceNameSeparator):
+				forbidden = append(forbidden, p.XMLName)
+			default:
+				val, _ := json.Marshal(&webdav.DeadPropsStore{Lang: p.Lang, InnerXML: p.InnerXML})
+				patches = append(patches, fs.MetadataPatch{Key: deadPropKey(p.XMLName), Value: string(val)})
+				names = append(names, p.XMLName)
+			}
+		}
+	}
+
+	for _, remove := range body.Remove {
+		for _, p := range remove.Prop.Props {
+			if protectedProps[p.XMLName] || p.XMLName.Space == "" {
+				forbidden = append(forbidden, p.XMLName)
+				continue
+			}
+
+			patches = append(patches, fs.MetadataPatch{Key: deadPropKey(p.XMLName), Remove: true})
+			names = append(names, p.XMLName)
+		}
+	}
+
+	return patches, names, forbidden
+}
+
+func deadPropKey(name xml.Name) string {
+	return webdav.DeadPropsMetadataPrefix + name.Space + webdav.SpaceNameSeparator + name.Local
+}
+
+func handlePropfind(c *gin.Context, r *request) (int, error) {
+	depth := 1
+	switch c.GetHeader("Depth") {
+	case "0":
+		depth = 0
+	case "1", "infinity", "":
+	default:
+		return http.StatusBadRequest, errInvalidDepth
+	}
+
+	var pf propfind
+	if _, err := readXML(c.Request.Body, &pf); err != nil {
+		return http.StatusBadRequest, err
+	}
+
+	names, allProp := pf.Prop.names(), pf.AllProp != nil || pf.Prop == nil
+	if pf.PropName != nil {
+		names, allProp = nil, false
+	}
+
+	ms := &multistatus{}
+	add := func(res *resource) error {
+		if pf.PropName != nil {
+			ms.add(res.propNames())
+			return nil
+		}
+
+		resp, err := res.response(names, allProp)
+		if err != nil {
+			return err
+		}
+		ms.add(resp)
+		return nil
+	}
+
+	switch {
+	case r.collection == "":
+		if err := add(homeResource(c, r)); err != nil {
+			return http.StatusInternalServerError, err
+		}
+
+		if depth > 0 {
+			cols, err := listCollections(c, r.fm, r.user, r.kind)
+			if err != nil {
+				return statusFromError(err), err
+			}
+
+			for _, col := range cols {
+				err := add(collectionResource(c, r, col))
+				col.close()
+				if err != nil {
+					return http.StatusInternalServerError, err
+				}
+			}
+		}
+	default:
+		col, err := openCollection(c, r.fm, r.user, r.kind, r.collection)
+		if err != nil {
+			return statusFromError(err), err
+		}
+		defer col.close()
+
+		if r.item != "" {
+			f, err := col.item(c, r.item)
+			if err != nil {
+				return statusFromError(err), err
+			}
+
+			if err := add(itemResource(c, col, f, nil)); err != nil {
+				return http.StatusInternalServerError, err
+			}
+			break
+		}
+
+		if err := add(collectionResource(c, r, col)); err != nil {
+			return http.StatusInternalServerError, err
+		}
+
+		if depth > 0 {
+			items, err := col.items(c)
+			if err != nil {
+				return statusFromError(err), err
+			}
+
+			for _, f := range items {
+				if err := add(itemResource(c, col, f, nil)); err != nil {
+					return http.StatusInternalServerError, err
+				}
+			}
+		}
+	}
+
+	writeMultistatus(c.Writer, ms)
+	return 0, nil
+}
+
+func handleReport(c *gin.Context, r *request) (int, error) {
+	var rep report
+	parsed, err := readXML(c.Request.Body, &rep)
+	if err != nil || !parsed {
+		return http.StatusBadRequest, err
+	}
+
+	names, allProp := rep.Prop.names(), rep.AllProp != nil || rep.Prop == nil
+	ms := &multistatus{}
+	switch rep.XMLName {
+	case xml.Name{Space: nsCalDAV, Local: "calendar-multiget"}, xml.Name{Space: nsCardDAV, Local: "addressbook-multiget"}:
+		if !reportSupported(r.kind, rep.XMLName) {
+			break
+		}
+
+		if err := multiget(c, r, &rep, names, allProp, ms); err != nil {
+			return http.StatusInternalServerError, err
+		}
+
+		writeMultistatus(c.Writer, ms)
+		return 0, nil
+	case xml.Name{Space: nsCalDAV, Local: "calendar-query"}, xml.Name{Space: nsCardDAV, Local: "addressbook-query"},
+		xml.Name{Space: nsDAV, Local: "sync-collection"}:
+		if !reportSupported(r.kind, rep.XMLName) || r.collection == "" || r.item != "" {
+			break
+		}
+
+		col, err := openCollection(c, r.fm, r.user, r.kind, r.collection)
+		if err != nil {
+			return statusFromError(err), err
+		}
+		defer col.close()
+
+		if rep.XMLName.Local == "sync-collection" {
+			return syncCollection(c, col, &rep, names, allProp, ms)
+		}
+
+		if err := query(c, col, &rep, names, allProp, ms); err != nil {
+			return statusFromError(err), err
+		}
+
+		writeMultistatus(c.Writer, ms)
+		return 0, nil
+	}
+
+	writeError(c.Writer, http.StatusForbidden, xml.Name{Space: nsDAV, Local: "supported-report"})
+	return 0, nil
+}
+
+func reportSupported(kind Kind, name xml.Name) bool {
+	if name.Space == nsDAV {
+		return true
+	}
+
+	return (kind == KindCalendar && name.Space == nsCalDAV) || (kind == KindAddressBook && name.Space == nsCardDAV)
+}
+
+// multiget responds properties of items with given hrefs, which may belong to different collections.
+func multiget(c *gin.Context, r *request, rep *report, names []xml.Name, allProp bool, ms *multistatus) error {
+	cols := make(map[string]*collection)
+	defer func() {
+		for _, col := range cols {
+			if col != nil {
+				col.close()
+			}
+		}
+	}()
+
+	for _, h := range rep.Hrefs {
+		h = strings.TrimSpace(h)
+		target, ok := parseRequest(unescapeHref(h), r.kind)
+		if !ok || target.item == "" {
+			ms.add(&response{href: h, status: http.StatusNotFound})
+			continue
+		}
+
+		col, ok := cols[target.collection]
+		if !ok {
+			col, _ = openCollection(c, r.fm, r.user, r.kind, target.collection)
+			cols[target.collection] = col
+		}
+
+		if col == nil {
+			ms.add(&response{href: h, status: http.StatusNotFound})
+			continue
+		}
+
+		f, err := col.item(c, target.item)
+		if err != nil {
+			ms.add(&response{href: h, status: http.StatusNotFound})
+			continue
+		}
+
+		resp, err := itemResource(c, col, f, nil).response(names, allProp)
+		if err != nil {
+			return err
+		}
+		ms.add(resp)
+	}
+
+	return nil
+}
+
+// query responds properties of items matching filter of calendar-query or addressbook-query report.
+func query(c *gin.Context, col *collection, rep *report, names []xml.Name, allProp bool, ms *multistatus) error {
+	items, err := col.items(c)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range items {
+		content, err := readItem(c, col, f)
+		if err != nil {
+			return err
+		}
+
+		root, err := parseComponent(content)
+		if err != nil {
+			// Skip items that are not valid, e.g. uploaded via WebDAV or web UI.
+			continue
+		}
+
+		if rep.CalendarFilter != nil && !rep.CalendarFilter.match(root) {
+			continue
+		}
+		if rep.AddressBookFilter != nil && !rep.AddressBookFilter.match(root) {
+			continue
+		}
+
+		resp, err := itemResource(c, col, f, content).response(names, allProp)
+		if err != nil {
+			return err
+		}
+		ms.add(resp)
+	}
+
+	return nil
+}
+
+// syncCollection responds items changed or deleted since given sync token (RFC 6578).
+func syncCollection(c *gin.Context, col *collection, rep *report, names []xml.Name, allProp bool, ms *multistatus) (int, error) {
+	rev := col.revision()
+	since := 0
+	if rep.SyncToken != "" {
+		var ok bool
+		since, ok = parseSyncToken(rep.SyncToken)
+		if !ok || since > rev || since < col.syncFloor() {
+			writeError(c.Writer, http.StatusForbidden, xml.Name{Space: nsDAV, Local: "valid-sync-token"})
+			return 0, nil
+		}
+	}
+
+	items, err := col.items(c)
+	if err != nil {
+		return statusFromError(err), err
+	}
+
+	present := make(map[string]bool, len(items))
+	for _, f := range items {
+		present[f.Name()] = true
+		if rep.SyncToken != "" && itemRevision(f) <= since {
+			continue
+		}
+
+		resp, err := itemResource(c, col, f, nil).response(names, allProp)
+		if err != nil {
+			return http.StatusInternalServerError, err
+		}
+		ms.add(resp)
+	}
+
+	if rep.SyncToken != "" {
+		for _, t := range col.tombstones() {
+			if t.Revision > since && !present[t.Name] {
+				present[t.Name] = true
+				ms.add(&response{href: col.href() + t.Name, status: http.StatusNotFound})
+			}
+		}
+	}
+
+	ms.syncToken = syncToken(rev)
+	writeMultistatus(c.Writer, ms)
+	return 0, nil
+}
+
+func syncToken(rev int) string {
+	return syncTokenPrefix + strconv.Itoa(rev)
+}
+
+func parseSyncToken(token string) (int, bool) {
+	rev, err := strconv.Atoi(strings.TrimPrefix(strings.TrimSpace(token), syncTokenPrefix))
+	return rev, err == nil && strings.HasPrefix(strings.TrimSpace(token), syncTokenPrefix)
+}
+
+func itemRevision(f fs.File) int {
+	rev, _ := strconv.Atoi(f.Metadata()[revisionMetadataKey])
+	return rev
+}
+
+// unescapeHref extracts the unescaped path of a href, which may be an absolute URL.
+func unescapeHref(h string) string {
+	if i := strings.Index(h, "://"); i >= 0 {
+		if j := strings.Index(h[i+3:], "/"); j >= 0 {
+			h = h[i+3+j:]
+		}
+	}
+
+	if p, err := url.PathUnescape(h); err == nil {
+		return p
+	}
+	return h
+}
+
+// readItem reads content of an item.
+func readItem(c *gin.Context, col *collection, f fs.File) ([]byte, error) {
+	es, err := col.fm.GetEntitySource(c, f.PrimaryEntityID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get entity source: %w", err)
+	}
+	defer es.Close()
+
+	return io.ReadAll(io.LimitReader(es, maxItemSize))
+}
+
+func etag(c *gin.Context, f fs.File) string {
+	hasher := dependency.FromContext(c).HashIDEncoder()
+	return fmt.Sprintf(`"%s"`, hashid.EncodeEntityID(hasher, f.PrimaryEntityID()))
+}
+
+func statusFromError(err error) int {
+	if ent.IsNotFound(err) {
+		return http.StatusNotFound
+	}
+
+	var ae *serializer.AggregateError
+	if errors.As(err, &ae) {
+		for _, e := range ae.Raw() {
+			return statusFromError(e)
+		}
+	}
+
+	var appErr serializer.AppError
+	if errors.As(err, &appErr) {
+		switch appErr.Code {
+		case serializer.CodeNotFound, serializer.CodeParentNotExist, serializer.CodeEntityNotExist:
+			return http.StatusNotFound
+		case serializer.CodeNoPermissionErr, serializer.CodeOwnerOnly:
+			return http.StatusForbidden
+		case serializer.CodeLockConflict:
+			return http.StatusLocked
+		case serializer.CodeObjectExist:
+			return http.StatusMethodNotAllowed
+		case serializer.CodeInsufficientCapacity:
+			return http.StatusInsufficientStorage
+		}
+	}
+
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,271 @@
+package caldav
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testEvent = "BEGIN:VCALENDAR\r\n" +
+	"VERSION:2.0\r\n" +
+	"PRODID:-//Test//EN\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:event-1\r\n" +
+	"DTSTART:20240110T090000Z\r\n" +
+	"DURATION:PT1H30M\r\n" +
+	"SUMMARY:Weekly sync with\r\n" +
+	"  the team\r\n" +
+	"ATTENDEE;CN=\"Doe; John\":mailto:john@example.com\r\n" +
+	"END:VEVENT\r\n" +
+	"END:VCALENDAR\r\n"
+
+const testCard = "BEGIN:VCARD\r\n" +
+	"VERSION:3.0\r\n" +
+	"FN:Alice Example\r\n" +
+	"EMAIL;TYPE=work:alice@example.com\r\n" +
+	"END:VCARD\r\n"
+
+func TestParseComponent(t *testing.T) {
+	root, err := parseComponent([]byte(testEvent))
+	if err != nil {
+		t.Fatalf("failed to parse: %s", err)
+	}
+
+	if root.name != "VCALENDAR" || len(root.children) != 1 {
+		t.Fatalf("unexpected root %q with %d children", root.name, len(root.children))
+	}
+
+	event := root.children[0]
+	if summary, ok := event.prop("SUMMARY"); !ok || summary.value != "Weekly sync with the team" {
+		t.Fatalf("unexpected summary %+v", summary)
+	}
+	if attendee, ok := event.prop("ATTENDEE"); !ok || attendee.params["CN"] != "Doe; John" ||
+		attendee.value != "mailto:john@example.com" {
+		t.Fatalf("unexpected attendee %+v", attendee)
+	}
+
+	start, end, recurring := event.timeSpan()
+	if !start.Equal(time.Date(2024, 1, 10, 9, 0, 0, 0, time.UTC)) ||
+		!end.Equal(time.Date(2024, 1, 10, 10, 30, 0, 0, time.UTC)) || recurring {
+		t.Fatalf("unexpected time span %s - %s, %v", start, end, recurring)
+	}
+
+	for _, invalid := range []string{
+		"",
+		"BEGIN:VCALENDAR\r\nEND:VEVENT\r\n",
+		"BEGIN:VCALENDAR\r\n",
+		"VERSION:2.0\r\n",
+		"BEGIN:VCARD\r\nEND:VCARD\r\nBEGIN:VCARD\r\nEND:VCARD\r\n",
+		"BEGIN:VCARD\r\ninvalid line\r\nEND:VCARD\r\n",
+	} {
+		if _, err := parseComponent([]byte(invalid)); err == nil {
+			t.Fatalf("expected error for %q", invalid)
+		}
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	for value, expected := range map[string]time.Duration{
+		"P1W":         7 * 24 * time.Hour,
+		"-P1DT2H3M4S": -(26*time.Hour + 3*time.Minute + 4*time.Second),
+		"PT15M":       15 * time.Minute,
+	} {
+		if d, err := parseDuration(value); err != nil || d != expected {
+			t.Fatalf("unexpected duration %s for %q: %v", d, value, err)
+		}
+	}
+
+	if _, err := parseDuration("1H"); err == nil {
+		t.Fatal("expected error for invalid duration")
+	}
+}
+
+func TestCalendarFilter(t *testing.T) {
+	root, err := parseComponent([]byte(testEvent))
+	if err != nil {
+		t.Fatalf("failed to parse: %s", err)
+	}
+
+	for body, expected := range map[string]bool{
+		`<C:comp-filter name="VCALENDAR"/>`:                                             true,
+		`<C:comp-filter name="VCALENDAR"><C:comp-filter name="VTODO"/></C:comp-filter>`: false,
+		`<C:comp-filter name="VCALENDAR"><C:comp-filter name="VEVENT">` +
+			`<C:time-range start="20240110T100000Z" end="20240111T000000Z"/></C:comp-filter></C:comp-filter>`: true,
+		`<C:comp-filter name="VCALENDAR"><C:comp-filter name="VEVENT">` +
+			`<C:time-range start="20240110T103000Z" end="20240111T000000Z"/></C:comp-filter></C:comp-filter>`: false,
+		`<C:comp-filter name="VCALENDAR"><C:comp-filter name="VEVENT">` +
+			`<C:prop-filter name="SUMMARY"><C:text-match>SYNC</C:text-match></C:prop-filter></C:comp-filter></C:comp-filter>`: true,
+		`<C:comp-filter name="VCALENDAR"><C:comp-filter name="VEVENT">` +
+			`<C:prop-filter name="LOCATION"><C:is-not-defined/></C:prop-filter></C:comp-filter></C:comp-filter>`: true,
+		`<C:comp-filter name="VCALENDAR"><C:comp-filter name="VEVENT">` +
+			`<C:prop-filter name="SUMMARY"><C:text-match negate-condition="yes">sync</C:text-match></C:prop-filter>` +
+			`</C:comp-filter></C:comp-filter>`: false,
+	} {
+		var f calendarFilter
+		doc := `<C:filter xmlns:C="urn:ietf:params:xml:ns:caldav">` + body + `</C:filter>`
+		if err := xml.Unmarshal([]byte(doc), &f); err != nil {
+			t.Fatalf("failed to unmarshal filter: %s", err)
+		}
+
+		if res := f.match(root); res != expected {
+			t.Fatalf("expected %v for filter %s", expected, body)
+		}
+	}
+}
+
+func TestAddressBookFilter(t *testing.T) {
+	root, err := parseComponent([]byte(testCard))
+	if err != nil {
+		t.Fatalf("failed to parse: %s", err)
+	}
+
+	for body, expected := range map[string]bool{
+		`<CR:filter/>`: true,
+		`<CR:filter><CR:prop-filter name="FN"><CR:text-match match-type="starts-with">alice</CR:text-match></CR:prop-filter></CR:filter>`: true,
+		`<CR:filter><CR:prop-filter name="FN"><CR:text-match match-type="equals">alice</CR:text-match></CR:prop-filter></CR:filter>`:      false,
+		`<CR:filter><CR:prop-filter name="TEL"/><CR:prop-filter name="EMAIL"/></CR:filter>`:                                               true,
+		`<CR:filter test="allof"><CR:prop-filter name="TEL"/><CR:prop-filter name="EMAIL"/></CR:filter>`:                                  false,
+		`<CR:filter><CR:prop-filter name="NICKNAME"><CR:is-not-defined/></CR:prop-filter></CR:filter>`:                                    true,
+	} {
+		var f addressBookFilter
+		doc := strings.Replace(body, "<CR:filter", `<CR:filter xmlns:CR="urn:ietf:params:xml:ns:carddav"`, 1)
+		if err := xml.Unmarshal([]byte(doc), &f); err != nil {
+			t.Fatalf("failed to unmarshal filter: %s", err)
+		}
+
+		if res := f.match(root); res != expected {
+			t.Fatalf("expected %v for filter %s", expected, body)
+		}
+	}
+}
+
+func TestReadReport(t *testing.T) {
+	var rep report
+	parsed, err := readXML(strings.NewReader(`<?xml version="1.0"?>
+<C:calendar-multiget xmlns:D="DAV:" xmlns:C="urn:ietf:params:xml:ns:caldav">
+  <D:prop><D:getetag/><C:calendar-data/></D:prop>
+  <D:href>/caldav/work/a.ics</D:href>
+  <D:href>/caldav/work/b.ics</D:href>
+</C:calendar-multiget>`), &rep)
+	if err != nil || !parsed {
+		t.Fatalf("failed to read report: %v", err)
+	}
+
+	if rep.XMLName != (xml.Name{Space: nsCalDAV, Local: "calendar-multiget"}) || len(rep.Hrefs) != 2 {
+		t.Fatalf("unexpected report %+v", rep)
+	}
+	if names := rep.Prop.names(); len(names) != 2 || names[1] != propCalendarData {
+		t.Fatalf("unexpected props %v", names)
+	}
+
+	rep = report{}
+	if _, err := readXML(strings.NewReader(`<D:sync-collection xmlns:D="DAV:">
+  <D:sync-token>http://cloudreve.org/ns/sync/12</D:sync-token><D:sync-level>1</D:sync-level>
+  <D:prop><D:getetag/></D:prop>
+</D:sync-collection>`), &rep); err != nil {
+		t.Fatalf("failed to read report: %s", err)
+	}
+	if rev, ok := parseSyncToken(rep.SyncToken); !ok || rev != 12 {
+		t.Fatalf("unexpected sync token %q", rep.SyncToken)
+	}
+	if _, ok := parseSyncToken("12"); ok {
+		t.Fatal("expected invalid sync token")
+	}
+
+	parsed, err = readXML(strings.NewReader(""), &rep)
+	if err != nil || parsed {
+		t.Fatalf("empty body should be allowed, got %v", err)
+	}
+}
+
+func TestPropPatches(t *testing.T) {
+	var body propertyUpdate
+	if _, err := readXML(strings.NewReader(`<C:mkcalendar xmlns:D="DAV:" xmlns:C="urn:ietf:params:xml:ns:caldav" xmlns:A="http://apple.com/ns/ical/">
+  <D:set><D:prop>
+    <D:displayname>Work</D:displayname>
+    <A:calendar-color>#FF0000</A:calendar-color>
+    <C:supported-calendar-component-set><C:comp name="VTODO"/></C:supported-calendar-component-set>
+  </D:prop></D:set>
+</C:mkcalendar>`), &body); err != nil {
+		t.Fatalf("failed to read body: %s", err)
+	}
+
+	patches, names, forbidden := propPatches(&body, KindCalendar, true)
+	if len(forbidden) != 0 || len(names) != 3 || len(patches) != 3 {
+		t.Fatalf("unexpected result %v %v %v", patches, names, forbidden)
+	}
+	if patches[0].Key != "dav:DAV:|displayname" || !strings.Contains(patches[0].Value, `"i"`) {
+		t.Fatalf("unexpected patch %+v", patches[0])
+	}
+	if patches[2].Key != componentsMetadataKey || patches[2].Value != "VTODO" {
+		t.Fatalf("unexpected patch %+v", patches[2])
+	}
+
+	// Component set is protected after creation.
+	_, _, forbidden = propPatches(&body, KindCalendar, false)
+	if len(forbidden) != 1 || forbidden[0].Local != "supported-calendar-component-set" {
+		t.Fatalf("unexpected forbidden %v", forbidden)
+	}
+}
+
+func TestMultistatus(t *testing.T) {
+	ms := &multistatus{syncToken: syncToken(3)}
+	ms.add(&response{
+		href: "/caldav/my calendar/",
+		props: []prop{
+			{name: propResourceType, inner: "<D:collection/><C:calendar/>"},
+			{name: xml.Name{Space: "http://example.com/ns", Local: "custom"}, inner: "v"},
+		},
+		notFound: []xml.Name{{Space: nsCalendarServer, Local: "unknown"}},
+	})
+	ms.add(&response{href: "/caldav/my calendar/gone.ics", status: 404})
+
+	res := string(ms.bytes())
+	for _, expected := range []string{
+		`xmlns:D="DAV:"`,
+		`<D:href>/caldav/my%20calendar/</D:href>`,
+		`<D:resourcetype><D:collection/><C:calendar/></D:resourcetype>`,
+		`<custom xmlns="http://example.com/ns">v</custom>`,
+		`<D:prop><CS:unknown/></D:prop><D:status>HTTP/1.1 404 Not Found</D:status>`,
+		`<D:href>/caldav/my%20calendar/gone.ics</D:href><D:status>HTTP/1.1 404 Not Found</D:status>`,
+		`<D:sync-token>http://cloudreve.org/ns/sync/3</D:sync-token>`,
+	} {
+		if !strings.Contains(res, expected) {
+			t.Fatalf("expected %q in %s", expected, res)
+		}
+	}
+
+	var v struct{}
+	if err := xml.Unmarshal([]byte(res), &v); err != nil {
+		t.Fatalf("multistatus is not well-formed: %s", err)
+	}
+}
+
+func TestParseRequest(t *testing.T) {
+	r, ok := parseRequest("/caldav/work/event.ics", KindCalendar)
+	if !ok || r.collection != "work" || r.item != "event.ics" {
+		t.Fatalf("unexpected request %+v", r)
+	}
+
+	r, ok = parseRequest("/carddav/", KindAddressBook)
+	if !ok || r.collection != "" || r.item != "" {
+		t.Fatalf("unexpected request %+v", r)
+	}
+
+	if _, ok := parseRequest("/caldav/a/b/c", KindCalendar); ok {
+		t.Fatal("expected too deep path to be rejected")
+	}
+
+	if p := unescapeHref("https://example.com/caldav/my%20calendar/a.ics"); p != "/caldav/my calendar/a.ics" {
+		t.Fatalf("unexpected href path %q", p)
+	}
+
+	if !etagMatches(`"a", W/"b"`, `"b"`) || etagMatches(`"a"`, `"b"`) {
+		t.Fatal("unexpected etag match result")
+	}
+
+	if validCollectionName("~abc") || validCollectionName(".hidden") || !validCollectionName("work") {
+		t.Fatal("unexpected collection name validation result")
+	}
+}
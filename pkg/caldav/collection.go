@@ -0,0 +1,541 @@
+package caldav
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/dbfs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/webdav"
+)
+
+type (
+	// Kind is the type of collection, either calendar or address book.
+	Kind string
+
+	// Permission is the access level granted to a sharee of a collection.
+	Permission string
+
+	// Collection describes a calendar or address book visible to a user.
+	Collection struct {
+		Kind        Kind              `json:"type"`
+		Name        string            `json:"name"`
+		DisplayName string            `json:"display_name"`
+		Href        string            `json:"href"`
+		Owner       string            `json:"owner"`
+		Permission  Permission        `json:"permission"`
+		SharedWith  map[string]string `json:"shared_with,omitempty"`
+	}
+
+	// collection is an opened calendar or address book backed by a folder of its owner.
+	collection struct {
+		kind       Kind
+		name       string
+		owner      *ent.User
+		folder     fs.File
+		fm         manager.FileManager
+		permission Permission
+		shared     bool
+	}
+
+	// tombstone records a deleted item for sync-collection report.
+	tombstone struct {
+		Name     string `json:"n"`
+		Revision int    `json:"r"`
+	}
+)
+
+const (
+	KindCalendar    Kind = "calendar"
+	KindAddressBook Kind = "addressbook"
+
+	PermissionRead  Permission = "read"
+	PermissionWrite Permission = "write"
+)
+
+const (
+	// homeFolder is the hidden folder in user's root holding all collections.
+	homeFolder = ".dav"
+	// sharedCollectionPrefix marks collection segments referring to collections shared by others.
+	sharedCollectionPrefix = "~"
+	// maxItemSize is the max size of a calendar object or vCard.
+	maxItemSize = 4 << 20
+	// maxTombstones is the max number of deleted items remembered by a collection.
+	maxTombstones = 1000
+
+	metadataPrefix        = "caldav:"
+	typeMetadataKey       = metadataPrefix + "type"
+	revisionMetadataKey   = metadataPrefix + "revision"
+	tombstonesMetadataKey = metadataPrefix + "tombstones"
+	syncFloorMetadataKey  = metadataPrefix + "sync_floor"
+	componentsMetadataKey = metadataPrefix + "components"
+	shareMetadataPrefix   = metadataPrefix + "share:"
+)
+
+var (
+	errCollectionNotFound = serializer.NewError(serializer.CodeNotFound, "Collection not found", nil)
+
+	defaultComponents = []string{"VEVENT", "VTODO"}
+
+	// revisionLocks serializes revision bumps of collections, striped by folder ID.
+	revisionLocks [64]sync.Mutex
+)
+
+// Prefix returns the route prefix of the kind.
+func (k Kind) Prefix() string {
+	if k == KindAddressBook {
+		return "/carddav"
+	}
+	return "/caldav"
+}
+
+func (k Kind) folder() string {
+	if k == KindAddressBook {
+		return "addressbooks"
+	}
+	return "calendars"
+}
+
+func (k Kind) contentType() string {
+	if k == KindAddressBook {
+		return "text/vcard; charset=utf-8"
+	}
+	return "text/calendar; charset=utf-8"
+}
+
+func (k Kind) rootComponent() string {
+	if k == KindAddressBook {
+		return "VCARD"
+	}
+	return "VCALENDAR"
+}
+
+// homeUri returns URI of the folder holding collections of given kind for the file system owner.
+func homeUri(kind Kind) (*fs.URI, error) {
+	base, err := fs.NewUriFromString(fs.NewMyUri(""))
+	if err != nil {
+		return nil, err
+	}
+
+	return base.Join(homeFolder, kind.folder()), nil
+}
+
+// validCollectionName reports whether name can be used for a collection owned by user.
+func validCollectionName(name string) bool {
+	return name != "" && !strings.HasPrefix(name, sharedCollectionPrefix) && !strings.HasPrefix(name, ".") &&
+		!strings.ContainsAny(name, "/\\")
+}
+
+// openCollection opens the collection with given URL segment visible to the user of fm. Caller must
+// call close after use.
+func openCollection(ctx context.Context, fm manager.FileManager, u *ent.User, kind Kind, name string) (*collection, error) {
+	if strings.HasPrefix(name, sharedCollectionPrefix) {
+		return openSharedCollection(ctx, u, kind, name)
+	}
+
+	if !validCollectionName(name) {
+		return nil, errCollectionNotFound
+	}
+
+	home, err := homeUri(kind)
+	if err != nil {
+		return nil, err
+	}
+
+	folder, err := fm.Get(ctx, home.Join(name), dbfs.WithFilePublicMetadata())
+	if err != nil {
+		return nil, err
+	}
+
+	if folder.Type() != types.FileTypeFolder || folder.Metadata()[typeMetadataKey] != string(kind) {
+		return nil, errCollectionNotFound
+	}
+
+	return &collection{kind: kind, name: name, owner: u, folder: folder, fm: fm, permission: PermissionWrite}, nil
+}
+
+// openSharedCollection opens a collection shared with u, name is in form of "~<folder hash ID>".
+func openSharedCollection(ctx context.Context, u *ent.User, kind Kind, name string) (*collection, error) {
+	dep := dependency.FromContext(ctx)
+	hasher := dep.HashIDEncoder()
+	folderID, err := hasher.Decode(strings.TrimPrefix(name, sharedCollectionPrefix), hashid.FileID)
+	if err != nil {
+		return nil, errCollectionNotFound
+	}
+
+	model, err := dep.FileClient().GetByID(context.WithValue(ctx, inventory.LoadFileMetadata{}, true), folderID)
+	if err != nil {
+		return nil, errCollectionNotFound
+	}
+
+	metadata := make(map[string]string, len(model.Edges.Metadata))
+	for _, m := range model.Edges.Metadata {
+		metadata[m.Name] = m.Value
+	}
+
+	permission := Permission(metadata[shareMetadataPrefix+hashid.EncodeUserID(hasher, u.ID)])
+	if (permission != PermissionRead && permission != PermissionWrite) || metadata[typeMetadataKey] != string(kind) ||
+		model.OwnerID == u.ID {
+		return nil, errCollectionNotFound
+	}
+
+	owner, err := dep.UserClient().GetByID(context.WithValue(ctx, inventory.LoadUserGroup{}, true), model.OwnerID)
+	if err != nil || owner.Status != user.StatusActive {
+		return nil, errCollectionNotFound
+	}
+
+	ownerFm := manager.NewFileManager(dep, owner)
+	folder, err := ownerFm.TraverseFile(ctx, folderID)
+	if err != nil {
+		ownerFm.Recycle()
+		return nil, errCollectionNotFound
+	}
+
+	// Collection must still be a direct child of the owner's home, not in trash bin or moved elsewhere.
+	home, err := homeUri(kind)
+	if err != nil {
+		ownerFm.Recycle()
+		return nil, err
+	}
+	if !folder.Uri(false).DirUri().IsSame(home, hashid.EncodeUserID(hasher, owner.ID)) {
+		ownerFm.Recycle()
+		return nil, errCollectionNotFound
+	}
+
+	folder, err = ownerFm.Get(ctx, folder.Uri(false), dbfs.WithFilePublicMetadata())
+	if err != nil {
+		ownerFm.Recycle()
+		return nil, err
+	}
+
+	return &collection{
+		kind:       kind,
+		name:       name,
+		owner:      owner,
+		folder:     folder,
+		fm:         ownerFm,
+		permission: permission,
+		shared:     true,
+	}, nil
+}
+
+// listCollections lists collections owned by or shared with u. Caller must close all of them after use.
+func listCollections(ctx context.Context, fm manager.FileManager, u *ent.User, kind Kind) ([]*collection, error) {
+	home, err := homeUri(kind)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []*collection
+	err = fm.Walk(ctx, home, 1, func(f fs.File, level int) error {
+		if level == 1 && f.Type() == types.FileTypeFolder && f.Metadata()[typeMetadataKey] == string(kind) &&
+			validCollectionName(f.Name()) {
+			res = append(res, &collection{kind: kind, name: f.Name(), owner: u, folder: f, fm: fm, permission: PermissionWrite})
+		}
+		return nil
+	}, dbfs.WithFilePublicMetadata())
+	if err != nil && statusFromError(err) != http.StatusNotFound {
+		return nil, err
+	}
+
+	dep := dependency.FromContext(ctx)
+	hasher := dep.HashIDEncoder()
+	folders, err := dep.FileClient().ListFoldersByMetadata(ctx, shareMetadataPrefix+hashid.EncodeUserID(hasher, u.ID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list shared collections: %w", err)
+	}
+
+	for _, folder := range folders {
+		col, err := openSharedCollection(ctx, u, kind, sharedCollectionPrefix+hashid.EncodeFileID(hasher, folder.ID))
+		if err != nil {
+			continue
+		}
+		res = append(res, col)
+	}
+
+	return res, nil
+}
+
+// close releases resources held by the collection.
+func (col *collection) close() {
+	if col.shared {
+		col.fm.Recycle()
+	}
+}
+
+// writable reports whether the requester can modify items in the collection.
+func (col *collection) writable() bool {
+	return col.permission == PermissionWrite
+}
+
+// href returns the URL path of the collection.
+func (col *collection) href() string {
+	return col.kind.Prefix() + "/" + col.name + "/"
+}
+
+// uri returns URI of the collection folder in its owner's file system.
+func (col *collection) uri() *fs.URI {
+	return col.folder.Uri(false)
+}
+
+// revision returns the current revision of the collection.
+func (col *collection) revision() int {
+	rev, _ := strconv.Atoi(col.folder.Metadata()[revisionMetadataKey])
+	return rev
+}
+
+// syncFloor returns the minimum revision that sync tokens can be based on.
+func (col *collection) syncFloor() int {
+	rev, _ := strconv.Atoi(col.folder.Metadata()[syncFloorMetadataKey])
+	return rev
+}
+
+// tombstones returns deleted items of the collection.
+func (col *collection) tombstones() []tombstone {
+	var res []tombstone
+	if raw, ok := col.folder.Metadata()[tombstonesMetadataKey]; ok {
+		_ = json.Unmarshal([]byte(raw), &res)
+	}
+	return res
+}
+
+// components returns component types supported by the calendar.
+func (col *collection) components() []string {
+	if raw := col.folder.Metadata()[componentsMetadataKey]; raw != "" {
+		return strings.Split(raw, ",")
+	}
+	return defaultComponents
+}
+
+// displayName returns the display name set by client, or the name of the collection.
+func (col *collection) displayName() string {
+	if prop, ok := col.deadProps()[davDisplayName]; ok {
+		if name := strings.TrimSpace(string(prop.InnerXML)); name != "" {
+			return name
+		}
+	}
+	return col.folder.Name()
+}
+
+// deadProps returns properties stored by clients, e.g. calendar color.
+func (col *collection) deadProps() map[xml.Name]webdav.DeadPropsStore {
+	res := make(map[xml.Name]webdav.DeadPropsStore)
+	for k, v := range col.folder.Metadata() {
+		if !strings.HasPrefix(k, webdav.DeadPropsMetadataPrefix) {
+			continue
+		}
+
+		space, local, ok := strings.Cut(strings.TrimPrefix(k, webdav.DeadPropsMetadataPrefix), webdav.SpaceNameSeparator)
+		if !ok {
+			continue
+		}
+
+		var store webdav.DeadPropsStore
+		if err := json.Unmarshal([]byte(v), &store); err != nil {
+			continue
+		}
+		res[xml.Name{Space: space, Local: local}] = store
+	}
+
+	return res
+}
+
+// items lists all items in the collection.
+func (col *collection) items(ctx context.Context) ([]fs.File, error) {
+	var res []fs.File
+	err := col.fm.Walk(ctx, col.uri(), 1, func(f fs.File, level int) error {
+		if level == 1 && f.Type() == types.FileTypeFile {
+			res = append(res, f)
+		}
+		return nil
+	}, dbfs.WithFilePublicMetadata())
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Name() < res[j].Name()
+	})
+	return res, nil
+}
+
+// item returns the item with given name.
+func (col *collection) item(ctx context.Context, name string) (fs.File, error) {
+	f, err := col.fm.Get(ctx, col.uri().Join(name), dbfs.WithFilePublicMetadata())
+	if err != nil {
+		return nil, err
+	}
+
+	if f.Type() != types.FileTypeFile {
+		return nil, fs.ErrPathNotExist
+	}
+
+	return f, nil
+}
+
+// bump increases revision of the collection, recording the item with given name as changed, or deleted
+// if deleted is true.
+func (col *collection) bump(ctx context.Context, name string, deleted bool) error {
+	mu := &revisionLocks[col.folder.ID()%len(revisionLocks)]
+	mu.Lock()
+	defer mu.Unlock()
+
+	// Reload collection to get the latest revision
+	folder, err := col.fm.Get(ctx, col.uri(), dbfs.WithFilePublicMetadata())
+	if err != nil {
+		return fmt.Errorf("failed to reload collection: %w", err)
+	}
+	col.folder = folder
+
+	next := col.revision() + 1
+	rev := strconv.Itoa(next)
+	patches := []fs.MetadataPatch{{Key: revisionMetadataKey, Value: rev}}
+	if deleted {
+		tombstones := append(col.tombstones(), tombstone{Name: name, Revision: next})
+		if len(tombstones) > maxTombstones {
+			// Tokens older than the dropped tombstones can no longer be synced incrementally.
+			patches = append(patches, fs.MetadataPatch{
+				Key:   syncFloorMetadataKey,
+				Value: strconv.Itoa(tombstones[len(tombstones)-maxTombstones-1].Revision),
+			})
+			tombstones = tombstones[len(tombstones)-maxTombstones:]
+		}
+
+		raw, err := json.Marshal(tombstones)
+		if err != nil {
+			return err
+		}
+		patches = append(patches, fs.MetadataPatch{Key: tombstonesMetadataKey, Value: string(raw)})
+	} else {
+		// Item must be marked before the collection, so that clients never get a token newer than the item.
+		if err := col.fm.PatchMedata(ctx, []*fs.URI{col.uri().Join(name)}, fs.MetadataPatch{
+			Key:   revisionMetadataKey,
+			Value: rev,
+		}); err != nil {
+			return fmt.Errorf("failed to update item revision: %w", err)
+		}
+	}
+
+	if err := col.fm.PatchMedata(ctx, []*fs.URI{col.uri()}, patches...); err != nil {
+		return fmt.Errorf("failed to update collection revision: %w", err)
+	}
+
+	return nil
+}
+
+// createCollection creates a collection owned by user of fm, with given metadata patches applied.
+func createCollection(ctx context.Context, fm manager.FileManager, kind Kind, name string, metadata []fs.MetadataPatch) error {
+	home, err := homeUri(kind)
+	if err != nil {
+		return err
+	}
+
+	if _, err := fm.Create(ctx, home, types.FileTypeFolder); err != nil {
+		return fmt.Errorf("failed to create home folder: %w", err)
+	}
+
+	uri := home.Join(name)
+	if _, err := fm.Create(ctx, uri, types.FileTypeFolder, dbfs.WithNoChainedCreation(), dbfs.WithErrorOnConflict()); err != nil {
+		return err
+	}
+
+	patches := append([]fs.MetadataPatch{
+		{Key: typeMetadataKey, Value: string(kind)},
+		{Key: revisionMetadataKey, Value: "0"},
+	}, metadata...)
+
+	return fm.PatchMedata(ctx, []*fs.URI{uri}, patches...)
+}
+
+// ListCollections lists collections of given kind owned by or shared with the user.
+func ListCollections(ctx context.Context, u *ent.User, kind Kind) ([]Collection, error) {
+	dep := dependency.FromContext(ctx)
+	hasher := dep.HashIDEncoder()
+	fm := manager.NewFileManager(dep, u)
+	defer fm.Recycle()
+
+	cols, err := listCollections(ctx, fm, u, kind)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]Collection, 0, len(cols))
+	for _, col := range cols {
+		item := Collection{
+			Kind:        kind,
+			Name:        col.name,
+			DisplayName: col.displayName(),
+			Href:        col.href(),
+			Owner:       hashid.EncodeUserID(hasher, col.owner.ID),
+			Permission:  col.permission,
+		}
+
+		if !col.shared {
+			item.SharedWith = make(map[string]string)
+			for k, v := range col.folder.Metadata() {
+				if strings.HasPrefix(k, shareMetadataPrefix) {
+					item.SharedWith[strings.TrimPrefix(k, shareMetadataPrefix)] = v
+				}
+			}
+		}
+
+		col.close()
+		res = append(res, item)
+	}
+
+	return res, nil
+}
+
+// ShareCollection grants target user given permission to the collection owned by owner. Empty permission
+// revokes the share.
+func ShareCollection(ctx context.Context, owner *ent.User, kind Kind, name string, target *ent.User, permission Permission) error {
+	if permission != "" && permission != PermissionRead && permission != PermissionWrite {
+		return serializer.NewError(serializer.CodeParamErr, "Invalid permission", nil)
+	}
+
+	if target.ID == owner.ID {
+		return serializer.NewError(serializer.CodeParamErr, "Cannot share collection with yourself", nil)
+	}
+
+	dep := dependency.FromContext(ctx)
+	fm := manager.NewFileManager(dep, owner)
+	defer fm.Recycle()
+
+	if strings.HasPrefix(name, sharedCollectionPrefix) {
+		return errCollectionNotFound
+	}
+
+	col, err := openCollection(ctx, fm, owner, kind, name)
+	if err != nil {
+		return err
+	}
+	defer col.close()
+
+	patch := fs.MetadataPatch{
+		Key:   shareMetadataPrefix + hashid.EncodeUserID(dep.HashIDEncoder(), target.ID),
+		Value: string(permission),
+	}
+	if permission == "" {
+		if _, ok := col.folder.Metadata()[patch.Key]; !ok {
+			return nil
+		}
+		patch.Remove = true
+	}
+
+	return fm.PatchMedata(ctx, []*fs.URI{col.uri()}, patch)
+}
@@ -0,0 +1,277 @@
+package caldav
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type (
+	// component is a parsed iCalendar or vCard component, e.g. VCALENDAR, VEVENT or VCARD.
+	component struct {
+		name     string
+		props    []property
+		children []*component
+	}
+
+	// property is a content line of a component.
+	property struct {
+		name   string
+		params map[string]string
+		value  string
+	}
+)
+
+// parseComponent parses an iCalendar (RFC 5545) or vCard (RFC 6350) object. Only one root
+// component is allowed.
+func parseComponent(data []byte) (*component, error) {
+	var (
+		root  *component
+		stack []*component
+	)
+
+	for _, line := range unfold(data) {
+		if line == "" {
+			continue
+		}
+
+		prop, err := parseProperty(line)
+		if err != nil {
+			return nil, err
+		}
+
+		switch prop.name {
+		case "BEGIN":
+			comp := &component{name: strings.ToUpper(prop.value)}
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, comp)
+			} else if root != nil {
+				return nil, fmt.Errorf("multiple root components")
+			} else {
+				root = comp
+			}
+			stack = append(stack, comp)
+		case "END":
+			if len(stack) == 0 || stack[len(stack)-1].name != strings.ToUpper(prop.value) {
+				return nil, fmt.Errorf("unexpected END:%s", prop.value)
+			}
+			stack = stack[:len(stack)-1]
+		default:
+			if len(stack) == 0 {
+				return nil, fmt.Errorf("property %q outside of component", prop.name)
+			}
+			current := stack[len(stack)-1]
+			current.props = append(current.props, *prop)
+		}
+	}
+
+	if root == nil {
+		return nil, fmt.Errorf("no component found")
+	}
+	if len(stack) > 0 {
+		return nil, fmt.Errorf("component %q is not closed", stack[len(stack)-1].name)
+	}
+
+	return root, nil
+}
+
+// unfold splits content into logical lines, joining folded continuation lines.
+func unfold(data []byte) []string {
+	var lines []string
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), maxItemSize)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if len(lines) > 0 && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+
+	return lines
+}
+
+// parseProperty parses a content line in form of "NAME;PARAM=VALUE:value".
+func parseProperty(line string) (*property, error) {
+	prop := &property{params: make(map[string]string)}
+	inQuote := false
+	start := 0
+	var key string
+	for i := 0; i < len(line); i++ {
+		switch line[i] {
+		case '"':
+			inQuote = !inQuote
+		case ';', ':':
+			if inQuote {
+				continue
+			}
+
+			token := line[start:i]
+			if prop.name == "" {
+				prop.name = strings.ToUpper(token)
+			} else if key != "" {
+				prop.params[key] = strings.Trim(token, `"`)
+			}
+
+			if line[i] == ':' {
+				prop.value = line[i+1:]
+				if prop.name == "" {
+					return nil, fmt.Errorf("invalid content line %q", line)
+				}
+				return prop, nil
+			}
+			start, key = i+1, ""
+		case '=':
+			if !inQuote && key == "" && prop.name != "" {
+				key = strings.ToUpper(line[start:i])
+				start = i + 1
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("invalid content line %q", line)
+}
+
+// prop returns the first property with given name.
+func (c *component) prop(name string) (*property, bool) {
+	for i := range c.props {
+		if c.props[i].name == name {
+			return &c.props[i], true
+		}
+	}
+
+	return nil, false
+}
+
+// propsByName returns all properties with given name.
+func (c *component) propsByName(name string) []property {
+	var res []property
+	for _, p := range c.props {
+		if p.name == name {
+			res = append(res, p)
+		}
+	}
+
+	return res
+}
+
+// childrenByName returns all sub-components with given name.
+func (c *component) childrenByName(name string) []*component {
+	var res []*component
+	for _, child := range c.children {
+		if child.name == name {
+			res = append(res, child)
+		}
+	}
+
+	return res
+}
+
+// timeSpan returns the time range occupied by an event or a to-do. Open ends are represented by zero
+// time. recurring is true if the component has recurrence rules, whose instances are not expanded.
+func (c *component) timeSpan() (start, end time.Time, recurring bool) {
+	_, hasRRule := c.prop("RRULE")
+	_, hasRDate := c.prop("RDATE")
+	recurring = hasRRule || hasRDate
+
+	startProp, hasStart := c.prop("DTSTART")
+	if hasStart {
+		start, _ = parseDateTime(startProp)
+	}
+
+	switch c.name {
+	case "VEVENT":
+		if endProp, ok := c.prop("DTEND"); ok {
+			end, _ = parseDateTime(endProp)
+		} else if durationProp, ok := c.prop("DURATION"); ok && hasStart {
+			d, _ := parseDuration(durationProp.value)
+			end = start.Add(d)
+		} else if hasStart && isDate(startProp) {
+			end = start.AddDate(0, 0, 1)
+		} else {
+			end = start
+		}
+	case "VTODO":
+		if dueProp, ok := c.prop("DUE"); ok {
+			end, _ = parseDateTime(dueProp)
+		} else if durationProp, ok := c.prop("DURATION"); ok && hasStart {
+			d, _ := parseDuration(durationProp.value)
+			end = start.Add(d)
+		}
+	default:
+		end = start
+	}
+
+	return start, end, recurring
+}
+
+func isDate(p *property) bool {
+	return p.params["VALUE"] == "DATE" || len(p.value) == len("20060102")
+}
+
+// parseDateTime parses DATE or DATE-TIME values. Local times are treated as UTC since time zone
+// definitions are not resolved.
+func parseDateTime(p *property) (time.Time, error) {
+	for _, layout := range []string{"20060102T150405Z", "20060102T150405", "20060102"} {
+		if t, err := time.ParseInLocation(layout, p.value, time.UTC); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid date time %q", p.value)
+}
+
+// parseDuration parses durations in form of "P1W", "-P1DT2H3M4S".
+func parseDuration(value string) (time.Duration, error) {
+	sign := time.Duration(1)
+	if strings.HasPrefix(value, "-") {
+		sign, value = -1, value[1:]
+	}
+	value = strings.TrimPrefix(value, "+")
+	if !strings.HasPrefix(value, "P") {
+		return 0, fmt.Errorf("invalid duration %q", value)
+	}
+
+	var (
+		res    time.Duration
+		number string
+	)
+	for _, ch := range value[1:] {
+		if ch >= '0' && ch <= '9' {
+			number += string(ch)
+			continue
+		}
+
+		if ch == 'T' {
+			continue
+		}
+
+		n, err := strconv.Atoi(number)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q", value)
+		}
+		number = ""
+
+		switch ch {
+		case 'W':
+			res += time.Duration(n) * 7 * 24 * time.Hour
+		case 'D':
+			res += time.Duration(n) * 24 * time.Hour
+		case 'H':
+			res += time.Duration(n) * time.Hour
+		case 'M':
+			res += time.Duration(n) * time.Minute
+		case 'S':
+			res += time.Duration(n) * time.Second
+		default:
+			return 0, fmt.Errorf("invalid duration %q", value)
+		}
+	}
+
+	return sign * res, nil
+}
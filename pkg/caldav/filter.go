@@ -0,0 +1,224 @@
+package caldav
+
+import (
+	"strings"
+	"time"
+)
+
+type (
+	// calendarFilter is the CALDAV:filter element of calendar-query report, see RFC 4791 section 9.7.
+	calendarFilter struct {
+		CompFilter compFilter `xml:"urn:ietf:params:xml:ns:caldav comp-filter"`
+	}
+
+	compFilter struct {
+		Name         string          `xml:"name,attr"`
+		IsNotDefined *struct{}       `xml:"urn:ietf:params:xml:ns:caldav is-not-defined"`
+		TimeRange    *timeRange      `xml:"urn:ietf:params:xml:ns:caldav time-range"`
+		PropFilters  []calPropFilter `xml:"urn:ietf:params:xml:ns:caldav prop-filter"`
+		CompFilters  []compFilter    `xml:"urn:ietf:params:xml:ns:caldav comp-filter"`
+	}
+
+	calPropFilter struct {
+		Name         string     `xml:"name,attr"`
+		IsNotDefined *struct{}  `xml:"urn:ietf:params:xml:ns:caldav is-not-defined"`
+		TextMatch    *textMatch `xml:"urn:ietf:params:xml:ns:caldav text-match"`
+	}
+
+	timeRange struct {
+		Start string `xml:"start,attr"`
+		End   string `xml:"end,attr"`
+	}
+
+	// addressBookFilter is the CARDDAV:filter element of addressbook-query report, see RFC 6352 section 10.5.
+	addressBookFilter struct {
+		Test        string           `xml:"test,attr"`
+		PropFilters []cardPropFilter `xml:"urn:ietf:params:xml:ns:carddav prop-filter"`
+	}
+
+	cardPropFilter struct {
+		Name         string      `xml:"name,attr"`
+		Test         string      `xml:"test,attr"`
+		IsNotDefined *struct{}   `xml:"urn:ietf:params:xml:ns:carddav is-not-defined"`
+		TextMatches  []textMatch `xml:"urn:ietf:params:xml:ns:carddav text-match"`
+	}
+
+	textMatch struct {
+		Value           string `xml:",chardata"`
+		MatchType       string `xml:"match-type,attr"`
+		NegateCondition string `xml:"negate-condition,attr"`
+	}
+)
+
+const (
+	testAllOf = "allof"
+)
+
+// match reports whether the calendar object matches the filter.
+func (f *calendarFilter) match(root *component) bool {
+	return root.name == strings.ToUpper(f.CompFilter.Name) && f.CompFilter.match(root)
+}
+
+func (f *compFilter) match(comp *component) bool {
+	if f.TimeRange != nil && !f.TimeRange.match(comp) {
+		return false
+	}
+
+	for _, pf := range f.PropFilters {
+		if !pf.match(comp) {
+			return false
+		}
+	}
+
+	for _, cf := range f.CompFilters {
+		children := comp.childrenByName(strings.ToUpper(cf.Name))
+		if cf.IsNotDefined != nil {
+			if len(children) > 0 {
+				return false
+			}
+			continue
+		}
+
+		matched := false
+		for _, child := range children {
+			if cf.match(child) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (f *calPropFilter) match(comp *component) bool {
+	props := comp.propsByName(strings.ToUpper(f.Name))
+	if f.IsNotDefined != nil {
+		return len(props) == 0
+	}
+
+	if len(props) == 0 {
+		return false
+	}
+
+	if f.TextMatch == nil {
+		return true
+	}
+
+	for _, p := range props {
+		if f.TextMatch.match(p.value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// match reports whether the component overlaps with the time range. Recurring components are
+// matched as long as their first instance starts before the range ends.
+func (r *timeRange) match(comp *component) bool {
+	rangeStart, _ := parseDateTime(&property{value: r.Start})
+	rangeEnd, _ := parseDateTime(&property{value: r.End})
+	start, end, recurring := comp.timeSpan()
+	if start.IsZero() && end.IsZero() {
+		// Components without time information, e.g. to-dos without due date, match any range.
+		return true
+	}
+
+	if recurring {
+		end = time.Time{}
+	} else if end.IsZero() {
+		end = start
+	}
+
+	if !rangeEnd.IsZero() && !start.IsZero() && !start.Before(rangeEnd) {
+		return false
+	}
+
+	if !rangeStart.IsZero() && !end.IsZero() {
+		if end.Equal(start) {
+			return !end.Before(rangeStart)
+		}
+		return end.After(rangeStart)
+	}
+
+	return true
+}
+
+// match reports whether the vCard matches the filter. Default test is "anyof".
+func (f *addressBookFilter) match(root *component) bool {
+	if len(f.PropFilters) == 0 {
+		return true
+	}
+
+	for _, pf := range f.PropFilters {
+		matched := pf.match(root)
+		if f.Test == testAllOf && !matched {
+			return false
+		}
+		if f.Test != testAllOf && matched {
+			return true
+		}
+	}
+
+	return f.Test == testAllOf
+}
+
+func (f *cardPropFilter) match(card *component) bool {
+	props := card.propsByName(strings.ToUpper(f.Name))
+	if f.IsNotDefined != nil {
+		return len(props) == 0
+	}
+
+	if len(props) == 0 {
+		return false
+	}
+
+	if len(f.TextMatches) == 0 {
+		return true
+	}
+
+	for _, tm := range f.TextMatches {
+		matched := false
+		for _, p := range props {
+			if tm.match(p.value) {
+				matched = true
+				break
+			}
+		}
+
+		if f.Test == testAllOf && !matched {
+			return false
+		}
+		if f.Test != testAllOf && matched {
+			return true
+		}
+	}
+
+	return f.Test == testAllOf
+}
+
+// match compares value with i;unicode-casemap collation, which is case-insensitive.
+func (m *textMatch) match(value string) bool {
+	value, expected := strings.ToLower(value), strings.ToLower(m.Value)
+	var res bool
+	switch m.MatchType {
+	case "equals":
+		res = value == expected
+	case "starts-with":
+		res = strings.HasPrefix(value, expected)
+	case "ends-with":
+		res = strings.HasSuffix(value, expected)
+	default:
+		res = strings.Contains(value, expected)
+	}
+
+	if m.NegateCondition == "yes" {
+		return !res
+	}
+
+	return res
+}
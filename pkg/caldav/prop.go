@@ -0,0 +1,269 @@
+package caldav
+
+import (
+	"encoding/xml"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	propResourceType   = xml.Name{Space: nsDAV, Local: "resourcetype"}
+	propCalendarData   = xml.Name{Space: nsCalDAV, Local: "calendar-data"}
+	propAddressData    = xml.Name{Space: nsCardDAV, Local: "address-data"}
+	propQuotaAvailable = xml.Name{Space: nsDAV, Local: "quota-available-bytes"}
+	propQuotaUsed      = xml.Name{Space: nsDAV, Local: "quota-used-bytes"}
+
+	// protectedProps are live properties of collections that cannot be changed by clients.
+	protectedProps = map[xml.Name]bool{
+		propResourceType:                                             true,
+		{Space: nsDAV, Local: "sync-token"}:                          true,
+		{Space: nsDAV, Local: "owner"}:                               true,
+		{Space: nsDAV, Local: "current-user-principal"}:              true,
+		{Space: nsDAV, Local: "current-user-privilege-set"}:          true,
+		{Space: nsDAV, Local: "supported-report-set"}:                true,
+		{Space: nsDAV, Local: "getetag"}:                             true,
+		{Space: nsDAV, Local: "getcontenttype"}:                      true,
+		{Space: nsDAV, Local: "getcontentlength"}:                    true,
+		{Space: nsDAV, Local: "getlastmodified"}:                     true,
+		propQuotaAvailable:                                           true,
+		propQuotaUsed:                                                true,
+		{Space: nsCalendarServer, Local: "getctag"}:                  true,
+		{Space: nsCalDAV, Local: "supported-calendar-component-set"}: true,
+		{Space: nsCalDAV, Local: "supported-calendar-data"}:          true,
+		{Space: nsCalDAV, Local: "max-resource-size"}:                true,
+		{Space: nsCardDAV, Local: "supported-address-data"}:          true,
+		{Space: nsCardDAV, Local: "max-resource-size"}:               true,
+	}
+)
+
+func constProp(v string) propGetter {
+	return func() (string, error) {
+		return v, nil
+	}
+}
+
+// response resolves given properties of the resource. All properties except expensive ones are
+// returned if allProp is true.
+func (res *resource) response(names []xml.Name, allProp bool) (*response, error) {
+	resp := &response{href: res.href}
+	if allProp {
+		names = nil
+		for name := range res.live {
+			if !res.expensive[name] {
+				names = append(names, name)
+			}
+		}
+		for name := range res.dead {
+			names = append(names, name)
+		}
+		sortNames(names)
+	}
+
+	for _, name := range names {
+		if getter, ok := res.live[name]; ok {
+			v, err := getter()
+			if err != nil {
+				return nil, err
+			}
+
+			resp.props = append(resp.props, prop{name: name, inner: v})
+			continue
+		}
+
+		if dead, ok := res.dead[name]; ok {
+			resp.props = append(resp.props, prop{name: name, inner: string(dead.InnerXML)})
+			continue
+		}
+
+		resp.notFound = append(resp.notFound, name)
+	}
+
+	return resp, nil
+}
+
+// propNames returns names of all properties of the resource.
+func (res *resource) propNames() *response {
+	resp := &response{href: res.href}
+	names := make([]xml.Name, 0, len(res.live)+len(res.dead))
+	for name := range res.live {
+		names = append(names, name)
+	}
+	for name := range res.dead {
+		names = append(names, name)
+	}
+	sortNames(names)
+
+	for _, name := range names {
+		resp.props = append(resp.props, prop{name: name})
+	}
+	return resp
+}
+
+func sortNames(names []xml.Name) {
+	sort.Slice(names, func(i, j int) bool {
+		if names[i].Space != names[j].Space {
+			return names[i].Space < names[j].Space
+		}
+		return names[i].Local < names[j].Local
+	})
+}
+
+// commonProps returns properties shared by all resources of the user.
+func commonProps(r *request) map[xml.Name]propGetter {
+	home := r.kind.Prefix() + "/"
+	return map[xml.Name]propGetter{
+		{Space: nsDAV, Local: "current-user-principal"}: constProp(href(home)),
+	}
+}
+
+// quotaProps returns quota properties (RFC 4331) of the requester.
+func quotaProps(c *gin.Context, r *request, props map[xml.Name]propGetter) {
+	var capacity *fs.Capacity
+	load := func() (*fs.Capacity, error) {
+		if capacity != nil {
+			return capacity, nil
+		}
+
+		var err error
+		capacity, err = r.fm.Capacity(c)
+		return capacity, err
+	}
+
+	props[propQuotaAvailable] = func() (string, error) {
+		capacity, err := load()
+		if err != nil {
+			return "", err
+		}
+		return strconv.FormatInt(max(capacity.Total-capacity.Used, 0), 10), nil
+	}
+	props[propQuotaUsed] = func() (string, error) {
+		capacity, err := load()
+		if err != nil {
+			return "", err
+		}
+		return strconv.FormatInt(capacity.Used, 10), nil
+	}
+}
+
+// privileges renders DAV:current-user-privilege-set (RFC 3744).
+func privileges(write, owner bool) string {
+	res := []string{"read", "read-current-user-privilege-set"}
+	if write {
+		res = append(res, "write-content", "bind", "unbind")
+		if owner {
+			res = append(res, "write", "write-properties")
+		}
+	}
+
+	var b strings.Builder
+	for _, p := range res {
+		b.WriteString("<D:privilege><D:" + p + "/></D:privilege>")
+	}
+	return b.String()
+}
+
+// homeResource returns the home of collections, which also serves as the principal of the user.
+func homeResource(c *gin.Context, r *request) *resource {
+	home := r.kind.Prefix() + "/"
+	props := commonProps(r)
+	props[propResourceType] = constProp("<D:collection/><D:principal/>")
+	props[davDisplayName] = constProp(escape(r.user.Nick))
+	props[xml.Name{Space: nsDAV, Local: "principal-URL"}] = constProp(href(home))
+	props[xml.Name{Space: nsDAV, Local: "current-user-privilege-set"}] = constProp(privileges(!r.readOnly, true))
+	props[xml.Name{Space: nsCalDAV, Local: "calendar-home-set"}] = constProp(href(KindCalendar.Prefix() + "/"))
+	props[xml.Name{Space: nsCardDAV, Local: "addressbook-home-set"}] = constProp(href(KindAddressBook.Prefix() + "/"))
+	props[xml.Name{Space: nsCalDAV, Local: "calendar-user-address-set"}] =
+		constProp("<D:href>" + escape("mailto:"+r.user.Email) + "</D:href>")
+	quotaProps(c, r, props)
+
+	return &resource{href: home, live: props}
+}
+
+// collectionResource returns properties of a calendar or address book.
+func collectionResource(c *gin.Context, r *request, col *collection) *resource {
+	props := commonProps(r)
+	rev := col.revision()
+	props[davDisplayName] = constProp(escape(col.displayName()))
+	props[xml.Name{Space: nsCalendarServer, Local: "getctag"}] = constProp(strconv.Itoa(rev))
+	props[xml.Name{Space: nsDAV, Local: "sync-token"}] = constProp(escape(syncToken(rev)))
+	props[xml.Name{Space: nsDAV, Local: "current-user-privilege-set"}] =
+		constProp(privileges(col.writable() && !r.readOnly, !col.shared))
+
+	reports := []xml.Name{{Space: nsDAV, Local: "sync-collection"}}
+	if col.kind == KindAddressBook {
+		props[propResourceType] = constProp("<D:collection/><CR:addressbook/>")
+		props[xml.Name{Space: nsCardDAV, Local: "supported-address-data"}] = constProp(
+			`<CR:address-data-type content-type="text/vcard" version="3.0"/>` +
+				`<CR:address-data-type content-type="text/vcard" version="4.0"/>`)
+		props[xml.Name{Space: nsCardDAV, Local: "max-resource-size"}] = constProp(strconv.Itoa(maxItemSize))
+		reports = append(reports,
+			xml.Name{Space: nsCardDAV, Local: "addressbook-query"},
+			xml.Name{Space: nsCardDAV, Local: "addressbook-multiget"})
+	} else {
+		props[propResourceType] = constProp("<D:collection/><C:calendar/>")
+		var comps strings.Builder
+		for _, comp := range col.components() {
+			comps.WriteString(`<C:comp name="` + escape(comp) + `"/>`)
+		}
+		props[xml.Name{Space: nsCalDAV, Local: "supported-calendar-component-set"}] = constProp(comps.String())
+		props[xml.Name{Space: nsCalDAV, Local: "supported-calendar-data"}] =
+			constProp(`<C:calendar-data content-type="text/calendar" version="2.0"/>`)
+		props[xml.Name{Space: nsCalDAV, Local: "max-resource-size"}] = constProp(strconv.Itoa(maxItemSize))
+		reports = append(reports,
+			xml.Name{Space: nsCalDAV, Local: "calendar-query"},
+			xml.Name{Space: nsCalDAV, Local: "calendar-multiget"})
+	}
+
+	var supported strings.Builder
+	for _, report := range reports {
+		supported.WriteString("<D:supported-report><D:report>")
+		supported.WriteString("<" + prefixes[report.Space] + ":" + report.Local + "/>")
+		supported.WriteString("</D:report></D:supported-report>")
+	}
+	props[xml.Name{Space: nsDAV, Local: "supported-report-set"}] = constProp(supported.String())
+
+	if !col.shared {
+		props[xml.Name{Space: nsDAV, Local: "owner"}] = constProp(href(r.kind.Prefix() + "/"))
+		quotaProps(c, r, props)
+	}
+
+	dead := col.deadProps()
+	delete(dead, davDisplayName)
+	for name := range props {
+		delete(dead, name)
+	}
+
+	return &resource{href: col.href(), live: props, dead: dead}
+}
+
+// itemResource returns properties of a calendar object or vCard. content is read on demand if not given.
+func itemResource(c *gin.Context, col *collection, f fs.File, content []byte) *resource {
+	props := map[xml.Name]propGetter{
+		propResourceType:                          constProp(""),
+		{Space: nsDAV, Local: "getetag"}:          constProp(escape(etag(c, f))),
+		{Space: nsDAV, Local: "getcontenttype"}:   constProp(escape(col.kind.contentType())),
+		{Space: nsDAV, Local: "getcontentlength"}: constProp(strconv.FormatInt(f.Size(), 10)),
+		{Space: nsDAV, Local: "getlastmodified"}:  constProp(f.UpdatedAt().UTC().Format(http.TimeFormat)),
+	}
+
+	data := propCalendarData
+	if col.kind == KindAddressBook {
+		data = propAddressData
+	}
+	props[data] = func() (string, error) {
+		if content == nil {
+			var err error
+			if content, err = readItem(c, col, f); err != nil {
+				return "", err
+			}
+		}
+		return escape(string(content)), nil
+	}
+
+	return &resource{href: col.href() + f.Name(), live: props, expensive: map[xml.Name]bool{data: true}}
+}
@@ -0,0 +1,262 @@
+package caldav
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+const (
+	nsDAV            = "DAV:"
+	nsCalDAV         = "urn:ietf:params:xml:ns:caldav"
+	nsCardDAV        = "urn:ietf:params:xml:ns:carddav"
+	nsCalendarServer = "http://calendarserver.org/ns/"
+	nsAppleICal      = "http://apple.com/ns/ical/"
+)
+
+var (
+	// prefixes are namespace prefixes declared in the root element of responses.
+	prefixes = map[string]string{
+		nsDAV:            "D",
+		nsCalDAV:         "C",
+		nsCardDAV:        "CR",
+		nsCalendarServer: "CS",
+		nsAppleICal:      "A",
+	}
+
+	davDisplayName = xml.Name{Space: nsDAV, Local: "displayname"}
+)
+
+type (
+	// rawProp is a property element with its raw content.
+	rawProp struct {
+		XMLName  xml.Name
+		Lang     string `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+		InnerXML []byte `xml:",innerxml"`
+		// Comps are children of CALDAV:supported-calendar-component-set.
+		Comps []struct {
+			Name string `xml:"name,attr"`
+		} `xml:"urn:ietf:params:xml:ns:caldav comp"`
+	}
+
+	// propList is the DAV:prop element.
+	propList struct {
+		Props []rawProp `xml:",any"`
+	}
+
+	// propfind is the DAV:propfind request body.
+	propfind struct {
+		XMLName  xml.Name  `xml:"DAV: propfind"`
+		AllProp  *struct{} `xml:"DAV: allprop"`
+		PropName *struct{} `xml:"DAV: propname"`
+		Prop     *propList `xml:"DAV: prop"`
+	}
+
+	// propertyUpdate is the body of PROPPATCH, MKCALENDAR and extended MKCOL requests, root element
+	// varies by method.
+	propertyUpdate struct {
+		XMLName xml.Name
+		Set     []struct {
+			Prop propList `xml:"DAV: prop"`
+		} `xml:"DAV: set"`
+		Remove []struct {
+			Prop propList `xml:"DAV: prop"`
+		} `xml:"DAV: remove"`
+	}
+
+	// report is the body of REPORT requests, root element indicates the report type.
+	report struct {
+		XMLName           xml.Name
+		AllProp           *struct{}          `xml:"DAV: allprop"`
+		Prop              *propList          `xml:"DAV: prop"`
+		Hrefs             []string           `xml:"DAV: href"`
+		CalendarFilter    *calendarFilter    `xml:"urn:ietf:params:xml:ns:caldav filter"`
+		AddressBookFilter *addressBookFilter `xml:"urn:ietf:params:xml:ns:carddav filter"`
+		SyncToken         string             `xml:"DAV: sync-token"`
+	}
+
+	// prop is a property in response.
+	prop struct {
+		name  xml.Name
+		inner string
+	}
+
+	// response is a DAV:response element of multistatus.
+	response struct {
+		href     string
+		status   int
+		props    []prop
+		notFound []xml.Name
+		// forbidden and failed are used for PROPPATCH responses.
+		forbidden []xml.Name
+		failed    []xml.Name
+	}
+
+	// multistatus builds a DAV:multistatus response body.
+	multistatus struct {
+		responses []*response
+		syncToken string
+	}
+)
+
+// names returns names of all properties in the list.
+func (l *propList) names() []xml.Name {
+	if l == nil {
+		return nil
+	}
+
+	res := make([]xml.Name, 0, len(l.Props))
+	for _, p := range l.Props {
+		res = append(res, p.XMLName)
+	}
+	return res
+}
+
+// readXML decodes request body into v. Empty body is allowed and leaves v untouched.
+func readXML(r io.Reader, v interface{}) (bool, error) {
+	err := xml.NewDecoder(io.LimitReader(r, maxItemSize)).Decode(v)
+	if err == io.EOF {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+// add appends a response.
+func (m *multistatus) add(r *response) {
+	m.responses = append(m.responses, r)
+}
+
+// bytes renders the multistatus document.
+func (m *multistatus) bytes() []byte {
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	buf.WriteString(`<D:multistatus`)
+	spaces := make([]string, 0, len(prefixes))
+	for space := range prefixes {
+		spaces = append(spaces, space)
+	}
+	sort.Strings(spaces)
+	for _, space := range spaces {
+		fmt.Fprintf(&buf, ` xmlns:%s="%s"`, prefixes[space], space)
+	}
+	buf.WriteString(`>`)
+
+	for _, r := range m.responses {
+		r.write(&buf)
+	}
+
+	if m.syncToken != "" {
+		buf.WriteString(`<D:sync-token>`)
+		_ = xml.EscapeText(&buf, []byte(m.syncToken))
+		buf.WriteString(`</D:sync-token>`)
+	}
+
+	buf.WriteString(`</D:multistatus>`)
+	return buf.Bytes()
+}
+
+func (r *response) write(buf *bytes.Buffer) {
+	buf.WriteString(`<D:response><D:href>`)
+	_ = xml.EscapeText(buf, []byte((&url.URL{Path: r.href}).EscapedPath()))
+	buf.WriteString(`</D:href>`)
+
+	if r.status != 0 {
+		writeStatus(buf, r.status)
+	}
+
+	if len(r.props) > 0 {
+		buf.WriteString(`<D:propstat><D:prop>`)
+		for _, p := range r.props {
+			writeElement(buf, p.name, p.inner)
+		}
+		buf.WriteString(`</D:prop>`)
+		writeStatus(buf, http.StatusOK)
+		buf.WriteString(`</D:propstat>`)
+	}
+
+	for _, group := range []struct {
+		names  []xml.Name
+		status int
+	}{
+		{r.notFound, http.StatusNotFound},
+		{r.forbidden, http.StatusForbidden},
+		{r.failed, http.StatusFailedDependency},
+	} {
+		if len(group.names) == 0 {
+			continue
+		}
+
+		buf.WriteString(`<D:propstat><D:prop>`)
+		for _, name := range group.names {
+			writeElement(buf, name, "")
+		}
+		buf.WriteString(`</D:prop>`)
+		writeStatus(buf, group.status)
+		buf.WriteString(`</D:propstat>`)
+	}
+
+	buf.WriteString(`</D:response>`)
+}
+
+func writeStatus(buf *bytes.Buffer, status int) {
+	fmt.Fprintf(buf, `<D:status>HTTP/1.1 %d %s</D:status>`, status, http.StatusText(status))
+}
+
+// writeElement writes an element with raw inner XML, using declared prefix if namespace is known.
+func writeElement(buf *bytes.Buffer, name xml.Name, inner string) {
+	tag := name.Local
+	open := tag
+	if prefix, ok := prefixes[name.Space]; ok {
+		tag = prefix + ":" + name.Local
+		open = tag
+	} else if name.Space != "" {
+		var space bytes.Buffer
+		_ = xml.EscapeText(&space, []byte(name.Space))
+		open = fmt.Sprintf(`%s xmlns="%s"`, tag, space.String())
+	}
+
+	if inner == "" {
+		fmt.Fprintf(buf, `<%s/>`, open)
+		return
+	}
+
+	fmt.Fprintf(buf, `<%s>%s</%s>`, open, inner, tag)
+}
+
+// escape escapes s for use as XML character data.
+func escape(s string) string {
+	var buf strings.Builder
+	_ = xml.EscapeText(&buf, []byte(s))
+	return buf.String()
+}
+
+// href renders a DAV:href element of given path.
+func href(p string) string {
+	return "<D:href>" + escape((&url.URL{Path: p}).EscapedPath()) + "</D:href>"
+}
+
+// writeMultistatus writes the multistatus response.
+func writeMultistatus(w http.ResponseWriter, m *multistatus) {
+	w.Header().Set("Content-Type", `application/xml; charset=utf-8`)
+	w.WriteHeader(http.StatusMultiStatus)
+	_, _ = w.Write(m.bytes())
+}
+
+// writeError writes a DAV:error response with given precondition element.
+func writeError(w http.ResponseWriter, status int, precondition xml.Name) {
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	fmt.Fprintf(&buf, `<D:error xmlns:D="%s" xmlns:C="%s" xmlns:CR="%s">`, nsDAV, nsCalDAV, nsCardDAV)
+	writeElement(&buf, precondition, "")
+	buf.WriteString(`</D:error>`)
+
+	w.Header().Set("Content-Type", `application/xml; charset=utf-8`)
+	w.WriteHeader(status)
+	_, _ = w.Write(buf.Bytes())
+}
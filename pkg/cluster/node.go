@@ -16,8 +16,10 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
 	"github.com/cloudreve/Cloudreve/v4/pkg/downloader"
 	"github.com/cloudreve/Cloudreve/v4/pkg/downloader/aria2"
+	"github.com/cloudreve/Cloudreve/v4/pkg/downloader/builtin"
 	"github.com/cloudreve/Cloudreve/v4/pkg/downloader/qbittorrent"
 	"github.com/cloudreve/Cloudreve/v4/pkg/downloader/slave"
+	"github.com/cloudreve/Cloudreve/v4/pkg/downloader/transmission"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
 	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
 	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
@@ -223,6 +225,10 @@ func NewDownloader(ctx context.Context, c request.Client, settings setting.Provi
 		return qbittorrent.NewClient(logging.FromContext(ctx), c, settings, options.QBittorrentSetting)
 	} else if options.Provider == types.DownloaderProviderAria2 {
 		return aria2.New(logging.FromContext(ctx), settings, options.Aria2Setting), nil
+	} else if options.Provider == types.DownloaderProviderBuiltin {
+		return builtin.New(logging.FromContext(ctx), settings, options.BuiltinDownloaderSetting), nil
+	} else if options.Provider == types.DownloaderProviderTransmission {
+		return transmission.NewClient(logging.FromContext(ctx), c, settings, options.TransmissionSetting)
 	} else if options.Provider == "" {
 		return nil, errors.New("downloader not configured for this node")
 	} else {
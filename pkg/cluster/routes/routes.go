@@ -95,6 +95,33 @@ func MasterArchiveDownloadUrl(base *url.URL, sessionID string) *url.URL {
 	return base.ResolveReference(routes)
 }
 
+func MasterArchiveMemberUrl(base *url.URL, sessionID, name string) *url.URL {
+	routes, err := url.Parse(path.Join(constants.APIPrefix, "file", "archive", "member", sessionID, url.PathEscape(name)))
+	if err != nil {
+		return nil
+	}
+
+	return base.ResolveReference(routes)
+}
+
+func MasterTranscodePlaylistUrl(base *url.URL, sessionID, playlist string) *url.URL {
+	routes, err := url.Parse(path.Join(constants.APIPrefix, "file", "transcode", sessionID, playlist))
+	if err != nil {
+		return nil
+	}
+
+	return base.ResolveReference(routes)
+}
+
+func MasterStoryboardVTTUrl(base *url.URL, sessionID string) *url.URL {
+	routes, err := url.Parse(path.Join(constants.APIPrefix, "file", "storyboard", sessionID, "storyboard.vtt"))
+	if err != nil {
+		return nil
+	}
+
+	return base.ResolveReference(routes)
+}
+
 func MasterPolicyOAuthCallback(base *url.URL) *url.URL {
 	if base.Scheme != "https" {
 		base.Scheme = "https"
@@ -193,10 +220,13 @@ func SlaveFileListRoute(srcPath string, recursive bool) string {
 	return fmt.Sprintf("%s?%s", base, query.Encode())
 }
 
-func SlaveThumbUrl(base *url.URL, srcPath, ext string) *url.URL {
+func SlaveThumbUrl(base *url.URL, srcPath, ext, size string) *url.URL {
 	srcPath = url.PathEscape(base64.URLEncoding.EncodeToString([]byte(srcPath)))
 	ext = url.PathEscape(ext)
 	route, _ := url.Parse(constants.APIPrefixSlave + fmt.Sprintf("/file/thumb/%s/%s", srcPath, ext))
+	if size != "" {
+		route.RawQuery = url.Values{"size": []string{size}}.Encode()
+	}
 	base = base.ResolveReference(route)
 	return base
 }
@@ -23,6 +23,7 @@ type ConfigProvider interface {
 	Slave() *Slave
 	Redis() *Redis
 	Cors() *Cors
+	DLNA() *DLNA
 	OptionOverwrite() map[string]any
 }
 
@@ -62,6 +63,7 @@ func NewIniConfigProvider(configPath string, l logging.Logger) (ConfigProvider,
 		slave:           *SlaveConfig,
 		redis:           *RedisConfig,
 		cors:            *CORSConfig,
+		dlna:            *DLNAConfig,
 		optionOverwrite: make(map[string]interface{}),
 	}
 
@@ -73,6 +75,7 @@ func NewIniConfigProvider(configPath string, l logging.Logger) (ConfigProvider,
 		"Redis":      &provider.redis,
 		"CORS":       &provider.cors,
 		"Slave":      &provider.slave,
+		"DLNA":       &provider.dlna,
 	}
 	for sectionName, sectionStruct := range sections {
 		err = mapSection(cfg, sectionName, sectionStruct)
@@ -97,6 +100,7 @@ type iniConfigProvider struct {
 	slave           Slave
 	redis           Redis
 	cors            Cors
+	dlna            DLNA
 	optionOverwrite map[string]any
 }
 
@@ -128,6 +132,10 @@ func (i *iniConfigProvider) Cors() *Cors {
 	return &i.cors
 }
 
+func (i *iniConfigProvider) DLNA() *DLNA {
+	return &i.dlna
+}
+
 func (i *iniConfigProvider) OptionOverwrite() map[string]any {
 	return i.optionOverwrite
 }
@@ -91,6 +91,22 @@ type Cors struct {
 	Secure           bool
 }
 
+// DLNA 局域网 UPnP 媒体服务器配置
+type DLNA struct {
+	Enabled bool
+	Listen  string `validate:"required"`
+	// Interface is the network interface used for SSDP discovery, e.g. "eth0".
+	// First multicast capable interface is used if empty.
+	Interface    string
+	FriendlyName string
+	// UUID of the media server, derived from host name and listen address if empty.
+	UUID string
+	// Folders exposed to LAN, each in form of "<user email>:<path under My files>".
+	Folders []string
+	// MaxAge in seconds of SSDP advertisements.
+	MaxAge int `validate:"gte=60"`
+}
+
 // RedisConfig Redis服务器配置
 var RedisConfig = &Redis{
 	Network:       "tcp",
@@ -146,4 +162,12 @@ var UnixConfig = &Unix{
 	Listen: "",
 }
 
+// DLNAConfig 局域网媒体服务器配置
+var DLNAConfig = &DLNA{
+	Enabled:      false,
+	Listen:       ":8200",
+	FriendlyName: "Cloudreve",
+	MaxAge:       1800,
+}
+
 var OptionOverwrite = map[string]interface{}{}
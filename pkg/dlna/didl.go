@@ -0,0 +1,109 @@
+package dlna
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+// UPnP classes of DIDL-Lite objects.
+const (
+	ClassStorageFolder = "object.container.storageFolder"
+	ClassMusicTrack    = "object.item.audioItem.musicTrack"
+	ClassVideoItem     = "object.item.videoItem"
+	ClassPhoto         = "object.item.imageItem.photo"
+)
+
+type (
+	// DIDLLite is the result of ContentDirectory Browse action.
+	DIDLLite struct {
+		XMLName    xml.Name    `xml:"DIDL-Lite"`
+		Xmlns      string      `xml:"xmlns,attr"`
+		XmlnsDC    string      `xml:"xmlns:dc,attr"`
+		XmlnsUPnP  string      `xml:"xmlns:upnp,attr"`
+		XmlnsDLNA  string      `xml:"xmlns:dlna,attr"`
+		Containers []Container `xml:"container"`
+		Items      []Item      `xml:"item"`
+	}
+
+	// Object holds properties shared by containers and items.
+	Object struct {
+		ID          string       `xml:"id,attr"`
+		ParentID    string       `xml:"parentID,attr"`
+		Restricted  int          `xml:"restricted,attr"`
+		Title       string       `xml:"dc:title"`
+		Class       string       `xml:"upnp:class"`
+		Date        string       `xml:"dc:date,omitempty"`
+		AlbumArtURI *AlbumArtURI `xml:"upnp:albumArtURI,omitempty"`
+	}
+
+	Container struct {
+		Object
+		ChildCount *int `xml:"childCount,attr,omitempty"`
+	}
+
+	Item struct {
+		Object
+		Artist string     `xml:"upnp:artist,omitempty"`
+		Album  string     `xml:"upnp:album,omitempty"`
+		Genre  string     `xml:"upnp:genre,omitempty"`
+		Track  int        `xml:"upnp:originalTrackNumber,omitempty"`
+		Res    []Resource `xml:"res"`
+	}
+
+	AlbumArtURI struct {
+		ProfileID string `xml:"dlna:profileID,attr,omitempty"`
+		URL       string `xml:",chardata"`
+	}
+
+	Resource struct {
+		ProtocolInfo string `xml:"protocolInfo,attr"`
+		Size         int64  `xml:"size,attr,omitempty"`
+		URL          string `xml:",chardata"`
+	}
+)
+
+// NewDIDLLite creates an empty DIDL-Lite document.
+func NewDIDLLite() *DIDLLite {
+	return &DIDLLite{
+		Xmlns:     "urn:schemas-upnp-org:metadata-1-0/DIDL-Lite/",
+		XmlnsDC:   "http://purl.org/dc/elements/1.1/",
+		XmlnsUPnP: "urn:schemas-upnp-org:metadata-1-0/upnp/",
+		XmlnsDLNA: "urn:schemas-dlna-org:metadata-1-0/",
+	}
+}
+
+// Len returns number of objects in the document.
+func (d *DIDLLite) Len() int {
+	return len(d.Containers) + len(d.Items)
+}
+
+// String marshals the document, which is embedded as escaped text in Browse response.
+func (d *DIDLLite) String() (string, error) {
+	res, err := xml.Marshal(d)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal DIDL-Lite: %w", err)
+	}
+
+	return string(res), nil
+}
+
+// ClassByMime returns the UPnP class of an item with given mime type, or empty string if
+// the mime type is not a playable media.
+func ClassByMime(mimeType string) string {
+	switch {
+	case strings.HasPrefix(mimeType, "audio/"):
+		return ClassMusicTrack
+	case strings.HasPrefix(mimeType, "video/"):
+		return ClassVideoItem
+	case strings.HasPrefix(mimeType, "image/"):
+		return ClassPhoto
+	default:
+		return ""
+	}
+}
+
+// ProtocolInfo returns the protocol info of a resource served over HTTP.
+func ProtocolInfo(mimeType string) string {
+	return fmt.Sprintf("http-get:*:%s:%s", mimeType, ContentFeatures)
+}
@@ -0,0 +1,524 @@
+// Package dlna provides a UPnP AV media server exposing configured folders to DLNA players in LAN,
+// with ContentDirectory and ConnectionManager services and SSDP discovery.
+package dlna
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/cloudreve/Cloudreve/v4/application/constants"
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/dbfs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager/entitysource"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/mediameta"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	// Prefix is the path prefix of all DLNA routes.
+	Prefix = "dlna"
+
+	ContentDirectory  = "ContentDirectory"
+	ConnectionManager = "ConnectionManager"
+
+	// ContentFeatures declares byte range seeking and streaming transfer mode for all resources.
+	ContentFeatures = "DLNA.ORG_OP=01;DLNA.ORG_CI=0;DLNA.ORG_FLAGS=01700000000000000000000000000000"
+
+	rootID           = "0"
+	rootParentID     = "-1"
+	objectIDSep      = "$"
+	browseMetadata   = "BrowseMetadata"
+	browseChildren   = "BrowseDirectChildren"
+	albumArtProfile  = "JPEG_TN"
+	subscribeTimeout = "Second-1800"
+)
+
+var errObjectNotFound = NewFault(ErrNoSuchObject, "No such object")
+
+// DeviceUUID returns the UUID of the media server. It is derived from host name and listen address
+// if not configured, so that it stays stable across restarts.
+func DeviceUUID(config *conf.DLNA) string {
+	if config.UUID != "" {
+		return config.UUID
+	}
+
+	host, _ := os.Hostname()
+	return uuid.NewSHA1(uuid.NameSpaceOID, []byte(host+config.Listen)).String()
+}
+
+// DeviceDescription serves the root device description.
+func DeviceDescription(c *gin.Context) {
+	config := dependency.FromContext(c).ConfigProvider().DLNA()
+	c.Data(http.StatusOK, `text/xml; charset="utf-8"`,
+		[]byte(deviceDescription(DeviceUUID(config), config.FriendlyName, constants.BackendVersion)))
+}
+
+// ServiceDescription serves the SCPD of service in path.
+func ServiceDescription(c *gin.Context) {
+	var scpd string
+	switch c.Param("service") {
+	case ContentDirectory:
+		scpd = contentDirectorySCPD
+	case ConnectionManager:
+		scpd = connectionManagerSCPD
+	default:
+		c.Status(http.StatusNotFound)
+		return
+	}
+
+	c.Data(http.StatusOK, `text/xml; charset="utf-8"`, []byte(scpd))
+}
+
+// Subscribe accepts event subscriptions. State variables never change, so no event is sent.
+func Subscribe(c *gin.Context) {
+	if c.Request.Method == "SUBSCRIBE" {
+		c.Header("SID", "uuid:"+uuid.NewString())
+		c.Header("TIMEOUT", subscribeTimeout)
+	}
+
+	c.Status(http.StatusOK)
+}
+
+// Control invokes a SOAP action of service in path.
+func Control(c *gin.Context) {
+	dep := dependency.FromContext(c)
+	action, err := ParseAction(c.Request.Header, c.Request.Body)
+	if err == nil {
+		var args []Arg
+		switch c.Param("service") {
+		case ContentDirectory:
+			args, err = contentDirectory(c, action)
+		case ConnectionManager:
+			args, err = connectionManager(action)
+		default:
+			c.Status(http.StatusNotFound)
+			return
+		}
+
+		if err == nil {
+			err = WriteResponse(c.Writer, action, args)
+			if err != nil {
+				dep.Logger().Debug("Failed to write DLNA action response: %s", err)
+			}
+			return
+		}
+	}
+
+	var fault *Fault
+	if !errors.As(err, &fault) {
+		dep.Logger().Warning("DLNA action failed: %s", err)
+		fault = NewFault(ErrActionFailed, "Action failed")
+	}
+
+	if err := WriteFault(c.Writer, fault); err != nil {
+		dep.Logger().Debug("Failed to write DLNA fault: %s", err)
+	}
+}
+
+// ServeMedia streams content of a media item.
+func ServeMedia(c *gin.Context) {
+	dep := dependency.FromContext(c)
+	lib, f, err := resolveObject(c, c.Param("id"))
+	if err != nil {
+		dep.Logger().Debug("Failed to resolve DLNA object %q: %s", c.Param("id"), err)
+		c.Status(http.StatusNotFound)
+		return
+	}
+	defer lib.fm.Recycle()
+
+	es, err := lib.fm.GetEntitySource(c, f.PrimaryEntityID())
+	if err != nil {
+		dep.Logger().Warning("Failed to get entity source of DLNA object %q: %s", c.Param("id"), err)
+		c.Status(http.StatusNotFound)
+		return
+	}
+	defer es.Close()
+
+	mimeType := dep.MimeDetector(c).TypeByName(f.DisplayName())
+	transferMode := "Streaming"
+	if ClassByMime(mimeType) == ClassPhoto {
+		transferMode = "Interactive"
+	}
+
+	c.Header("Content-Type", mimeType)
+	c.Header("transferMode.dlna.org", transferMode)
+	c.Header("contentFeatures.dlna.org", ContentFeatures)
+	es.Apply(entitysource.WithSpeedLimit(int64(lib.user.Edges.Group.SpeedLimit)), entitysource.WithDisplayName(f.DisplayName()))
+	es.Serve(c.Writer, c.Request)
+}
+
+// ServeAlbumArt serves thumbnail of a media item.
+func ServeAlbumArt(c *gin.Context) {
+	dep := dependency.FromContext(c)
+	lib, f, err := resolveObject(c, c.Param("id"))
+	if err != nil {
+		dep.Logger().Debug("Failed to resolve DLNA object %q: %s", c.Param("id"), err)
+		c.Status(http.StatusNotFound)
+		return
+	}
+	defer lib.fm.Recycle()
+
+	thumb, err := lib.fm.Thumbnail(c, f.Uri(false))
+	if err != nil {
+		c.Status(http.StatusNotFound)
+		return
+	}
+	defer thumb.Close()
+
+	c.Header("transferMode.dlna.org", "Interactive")
+	thumb.Serve(c.Writer, c.Request)
+}
+
+func connectionManager(action *Action) ([]Arg, error) {
+	switch action.Name {
+	case "GetProtocolInfo":
+		return []Arg{{"Source", "http-get:*:*:*"}, {"Sink", ""}}, nil
+	case "GetCurrentConnectionIDs":
+		return []Arg{{"ConnectionIDs", "0"}}, nil
+	case "GetCurrentConnectionInfo":
+		if action.Args["ConnectionID"] != "0" {
+			return nil, NewFault(706, "Invalid connection reference")
+		}
+		return []Arg{
+			{"RcsID", "-1"},
+			{"AVTransportID", "-1"},
+			{"ProtocolInfo", ""},
+			{"PeerConnectionManager", ""},
+			{"PeerConnectionID", "-1"},
+			{"Direction", "Output"},
+			{"Status", "OK"},
+		}, nil
+	default:
+		return nil, NewFault(ErrInvalidAction, "Invalid action")
+	}
+}
+
+func contentDirectory(c *gin.Context, action *Action) ([]Arg, error) {
+	switch action.Name {
+	case "Browse":
+		return browse(c, action)
+	case "GetSearchCapabilities":
+		return []Arg{{"SearchCaps", ""}}, nil
+	case "GetSortCapabilities":
+		return []Arg{{"SortCaps", ""}}, nil
+	case "GetSystemUpdateID":
+		return []Arg{{"Id", "1"}}, nil
+	default:
+		return nil, NewFault(ErrInvalidAction, "Invalid action")
+	}
+}
+
+func browse(c *gin.Context, action *Action) ([]Arg, error) {
+	start, err := strconv.Atoi(action.Args["StartingIndex"])
+	if err != nil || start < 0 {
+		return nil, NewFault(ErrInvalidArgs, "Invalid StartingIndex")
+	}
+	count, err := strconv.Atoi(action.Args["RequestedCount"])
+	if err != nil || count < 0 {
+		return nil, NewFault(ErrInvalidArgs, "Invalid RequestedCount")
+	}
+
+	b := newBrowser(c)
+	objectID := action.Args["ObjectID"]
+	didl := NewDIDLLite()
+	total := 1
+	switch action.Args["BrowseFlag"] {
+	case browseMetadata:
+		err = b.metadata(didl, objectID)
+	case browseChildren:
+		total, err = b.children(didl, objectID, start, count)
+	default:
+		return nil, NewFault(ErrInvalidArgs, "Invalid BrowseFlag")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := didl.String()
+	if err != nil {
+		return nil, err
+	}
+
+	return []Arg{
+		{"Result", result},
+		{"NumberReturned", strconv.Itoa(didl.Len())},
+		{"TotalMatches", strconv.Itoa(total)},
+		{"UpdateID", "1"},
+	}, nil
+}
+
+// library is an exposed folder of a user, object IDs under it are prefixed with its index.
+type library struct {
+	index int
+	user  *ent.User
+	root  fs.File
+	fm    manager.FileManager
+}
+
+// openLibrary opens the configured folder with given 1-based index. Caller must recycle the file manager.
+func openLibrary(c *gin.Context, index int) (*library, error) {
+	dep := dependency.FromContext(c)
+	folders := dep.ConfigProvider().DLNA().Folders
+	if index < 1 || index > len(folders) {
+		return nil, errObjectNotFound
+	}
+
+	email, p, ok := strings.Cut(folders[index-1], ":")
+	if !ok {
+		return nil, fmt.Errorf("invalid DLNA folder %q, expected <user email>:<path>", folders[index-1])
+	}
+
+	u, err := dep.UserClient().GetByEmail(context.WithValue(c, inventory.LoadUserGroup{}, true), strings.TrimSpace(email))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user %q: %w", email, err)
+	}
+	if u.Status != user.StatusActive {
+		return nil, fmt.Errorf("user %q is not active", email)
+	}
+
+	base, err := fs.NewUriFromString(fs.NewMyUri(""))
+	if err != nil {
+		return nil, err
+	}
+
+	fm := manager.NewFileManager(dep, u)
+	root, err := fm.Get(c, base.JoinRaw(strings.TrimSpace(p)))
+	if err != nil {
+		fm.Recycle()
+		return nil, fmt.Errorf("failed to get folder %q of user %q: %w", p, email, err)
+	}
+	if root.Type() != types.FileTypeFolder {
+		fm.Recycle()
+		return nil, fmt.Errorf("%q of user %q is not a folder", p, email)
+	}
+
+	return &library{index: index, user: u, root: root, fm: fm}, nil
+}
+
+// objectID returns the ID of given file under library.
+func (l *library) objectID(hasher hashid.Encoder, fileID int) string {
+	return fmt.Sprintf("%d%s%s", l.index, objectIDSep, hashid.EncodeFileID(hasher, fileID))
+}
+
+// resolveObject returns the library and file of given object ID, the file must be inside library folder.
+// Caller must recycle the file manager of library.
+func resolveObject(c *gin.Context, id string) (*library, fs.File, error) {
+	dep := dependency.FromContext(c)
+	indexStr, fileHash, ok := strings.Cut(id, objectIDSep)
+	if !ok {
+		return nil, nil, errObjectNotFound
+	}
+
+	index, err := strconv.Atoi(indexStr)
+	if err != nil {
+		return nil, nil, errObjectNotFound
+	}
+
+	fileID, err := dep.HashIDEncoder().Decode(fileHash, hashid.FileID)
+	if err != nil {
+		return nil, nil, errObjectNotFound
+	}
+
+	lib, err := openLibrary(c, index)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if fileID == lib.root.ID() {
+		return lib, lib.root, nil
+	}
+
+	f, err := lib.fm.TraverseFile(c, fileID)
+	if err != nil || f.OwnerID() != lib.user.ID ||
+		!f.Uri(false).EqualOrIsDescendantOf(lib.root.Uri(false), hashid.EncodeUserID(dep.HashIDEncoder(), lib.user.ID)) {
+		lib.fm.Recycle()
+		return nil, nil, errObjectNotFound
+	}
+
+	return lib, f, nil
+}
+
+// browser builds DIDL-Lite objects for a Browse request.
+type browser struct {
+	c    *gin.Context
+	dep  dependency.Dep
+	base string
+}
+
+func newBrowser(c *gin.Context) *browser {
+	return &browser{
+		c:    c,
+		dep:  dependency.FromContext(c),
+		base: fmt.Sprintf("http://%s/%s", c.Request.Host, Prefix),
+	}
+}
+
+func (b *browser) metadata(didl *DIDLLite, objectID string) error {
+	if objectID == rootID {
+		didl.Containers = append(didl.Containers, b.root())
+		return nil
+	}
+
+	lib, f, err := resolveObject(b.c, objectID)
+	if err != nil {
+		return errObjectNotFound
+	}
+	defer lib.fm.Recycle()
+
+	parentID := rootID
+	if f.ID() != lib.root.ID() {
+		parent, err := lib.fm.Get(b.c, f.Uri(false).DirUri())
+		if err != nil {
+			return fmt.Errorf("failed to get parent of %q: %w", f.Uri(false), err)
+		}
+		parentID = lib.objectID(b.dep.HashIDEncoder(), parent.ID())
+	}
+
+	b.append(didl, lib, f, parentID)
+	return nil
+}
+
+// children appends children of given object in range [start, start+count) and returns total number of children.
+// count of 0 means all remaining children.
+func (b *browser) children(didl *DIDLLite, objectID string, start, count int) (int, error) {
+	all := NewDIDLLite()
+	if objectID == rootID {
+		b.libraries(all)
+	} else {
+		lib, f, err := resolveObject(b.c, objectID)
+		if err != nil {
+			return 0, errObjectNotFound
+		}
+		defer lib.fm.Recycle()
+
+		if f.Type() != types.FileTypeFolder {
+			return 0, NewFault(ErrInvalidArgs, "Object is not a container")
+		}
+
+		err = lib.fm.Walk(b.c, f.Uri(false), 1, func(child fs.File, level int) error {
+			if level > 0 {
+				b.append(all, lib, child, objectID)
+			}
+			return nil
+		}, dbfs.WithFilePublicMetadata())
+		if err != nil {
+			return 0, fmt.Errorf("failed to list %q: %w", f.Uri(false), err)
+		}
+	}
+
+	// Containers are listed before items.
+	total := all.Len()
+	end := total
+	if count > 0 {
+		end = min(start+count, total)
+	}
+	for i := start; i < end; i++ {
+		if i < len(all.Containers) {
+			didl.Containers = append(didl.Containers, all.Containers[i])
+		} else {
+			didl.Items = append(didl.Items, all.Items[i-len(all.Containers)])
+		}
+	}
+
+	return total, nil
+}
+
+// root returns the root container, whose children are configured folders.
+func (b *browser) root() Container {
+	childCount := len(b.dep.ConfigProvider().DLNA().Folders)
+	return Container{
+		Object: Object{
+			ID:         rootID,
+			ParentID:   rootParentID,
+			Restricted: 1,
+			Title:      b.dep.ConfigProvider().DLNA().FriendlyName,
+			Class:      ClassStorageFolder,
+		},
+		ChildCount: &childCount,
+	}
+}
+
+// libraries appends all configured folders. Misconfigured ones are skipped.
+func (b *browser) libraries(didl *DIDLLite) {
+	for i := range b.dep.ConfigProvider().DLNA().Folders {
+		lib, err := openLibrary(b.c, i+1)
+		if err != nil {
+			b.dep.Logger().Warning("Failed to open DLNA folder #%d: %s", i+1, err)
+			continue
+		}
+
+		b.append(didl, lib, lib.root, rootID)
+		lib.fm.Recycle()
+	}
+}
+
+// append appends a folder as container or a media file as item. Other files are ignored.
+func (b *browser) append(didl *DIDLLite, lib *library, f fs.File, parentID string) {
+	id := lib.objectID(b.dep.HashIDEncoder(), f.ID())
+	object := Object{
+		ID:         id,
+		ParentID:   parentID,
+		Restricted: 1,
+		Title:      f.DisplayName(),
+		Date:       f.CreatedAt().Format("2006-01-02"),
+	}
+
+	if f.Type() == types.FileTypeFolder {
+		if f.ID() == lib.root.ID() && f.IsRootFolder() {
+			object.Title = lib.user.Nick
+		}
+
+		object.Class = ClassStorageFolder
+		didl.Containers = append(didl.Containers, Container{Object: object})
+		return
+	}
+
+	mimeType := b.dep.MimeDetector(b.c).TypeByName(f.DisplayName())
+	object.Class = ClassByMime(mimeType)
+	if object.Class == "" {
+		return
+	}
+
+	mediaUrl := fmt.Sprintf("%s/media/%s", b.base, url.PathEscape(id))
+	object.AlbumArtURI = &AlbumArtURI{
+		ProfileID: albumArtProfile,
+		URL:       fmt.Sprintf("%s/art/%s", b.base, url.PathEscape(id)),
+	}
+	item := Item{
+		Object: object,
+		Res:    []Resource{{ProtocolInfo: ProtocolInfo(mimeType), Size: f.Size(), URL: mediaUrl}},
+	}
+
+	if object.Class == ClassMusicTrack {
+		metadata := f.Metadata()
+		musicMeta := func(key string) string {
+			return metadata[fmt.Sprintf("%s:%s", driver.MediaTypeMusic, key)]
+		}
+
+		if title := musicMeta(mediameta.MusicTitle); title != "" {
+			item.Title = title
+		}
+		item.Artist = musicMeta(mediameta.MusicArtist)
+		item.Album = musicMeta(mediameta.MusicAlbum)
+		item.Genre = musicMeta(mediameta.MusicGenre)
+		item.Track, _ = strconv.Atoi(strings.Split(musicMeta(mediameta.MusicTrack), "/")[0])
+	}
+
+	didl.Items = append(didl.Items, item)
+}
@@ -0,0 +1,132 @@
+package dlna
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestParseAction(t *testing.T) {
+	header := http.Header{}
+	header.Set(soapActionHeader, `"`+ContentDirectoryType+`#Browse"`)
+	body := `<?xml version="1.0"?>
+<s:Envelope xmlns:s="http://schemas.xmlsoap.org/soap/envelope/" s:encodingStyle="http://schemas.xmlsoap.org/soap/encoding/">
+  <s:Body>
+    <u:Browse xmlns:u="urn:schemas-upnp-org:service:ContentDirectory:1">
+      <ObjectID>1$abc</ObjectID>
+      <BrowseFlag>BrowseDirectChildren</BrowseFlag>
+      <StartingIndex>0</StartingIndex>
+      <RequestedCount>10</RequestedCount>
+    </u:Browse>
+  </s:Body>
+</s:Envelope>`
+
+	action, err := ParseAction(header, strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("failed to parse action: %s", err)
+	}
+	if action.ServiceType != ContentDirectoryType || action.Name != "Browse" {
+		t.Fatalf("unexpected action %s#%s", action.ServiceType, action.Name)
+	}
+	if action.Args["ObjectID"] != "1$abc" || action.Args["BrowseFlag"] != browseChildren || action.Args["RequestedCount"] != "10" {
+		t.Fatalf("unexpected args: %v", action.Args)
+	}
+
+	header.Set(soapActionHeader, `"`+ContentDirectoryType+`#GetSystemUpdateID"`)
+	_, err = ParseAction(header, strings.NewReader(body))
+	var fault *Fault
+	if !errors.As(err, &fault) || fault.Code != ErrInvalidAction {
+		t.Fatalf("expected invalid action fault, got %v", err)
+	}
+}
+
+func TestWriteResponse(t *testing.T) {
+	w := httptest.NewRecorder()
+	action := &Action{ServiceType: ContentDirectoryType, Name: "Browse"}
+	if err := WriteResponse(w, action, []Arg{{"Result", "<DIDL-Lite/>"}, {"NumberReturned", "0"}}); err != nil {
+		t.Fatalf("failed to write response: %s", err)
+	}
+
+	body := w.Body.String()
+	if w.Code != http.StatusOK {
+		t.Fatalf("unexpected status %d", w.Code)
+	}
+	if !strings.Contains(body, `<u:BrowseResponse xmlns:u="`+ContentDirectoryType+`">`) ||
+		!strings.Contains(body, "<Result>&lt;DIDL-Lite/&gt;</Result><NumberReturned>0</NumberReturned>") {
+		t.Fatalf("unexpected response body: %s", body)
+	}
+
+	w = httptest.NewRecorder()
+	if err := WriteFault(w, NewFault(ErrNoSuchObject, "No such object")); err != nil {
+		t.Fatalf("failed to write fault: %s", err)
+	}
+	if w.Code != http.StatusInternalServerError || !strings.Contains(w.Body.String(), "<errorCode>701</errorCode>") {
+		t.Fatalf("unexpected fault: %d %s", w.Code, w.Body.String())
+	}
+}
+
+func TestDIDLLite(t *testing.T) {
+	didl := NewDIDLLite()
+	didl.Containers = append(didl.Containers, Container{Object: Object{ID: "1$a", ParentID: "0", Title: "Music", Class: ClassStorageFolder}})
+	didl.Items = append(didl.Items, Item{
+		Object: Object{ID: "1$b", ParentID: "1$a", Title: "Song & Dance", Class: ClassMusicTrack},
+		Artist: "Artist",
+		Res:    []Resource{{ProtocolInfo: ProtocolInfo("audio/mpeg"), Size: 42, URL: "http://host/dlna/media/1$b"}},
+	})
+
+	res, err := didl.String()
+	if err != nil {
+		t.Fatalf("failed to marshal: %s", err)
+	}
+
+	for _, expected := range []string{
+		`<container id="1$a" parentID="0" restricted="0"><dc:title>Music</dc:title><upnp:class>object.container.storageFolder</upnp:class></container>`,
+		`<dc:title>Song &amp; Dance</dc:title>`,
+		`<upnp:artist>Artist</upnp:artist>`,
+		`<res protocolInfo="http-get:*:audio/mpeg:` + ContentFeatures + `" size="42">http://host/dlna/media/1$b</res>`,
+		`xmlns:dc="http://purl.org/dc/elements/1.1/"`,
+	} {
+		if !strings.Contains(res, expected) {
+			t.Fatalf("expected %q in %s", expected, res)
+		}
+	}
+	if didl.Len() != 2 {
+		t.Fatalf("unexpected length %d", didl.Len())
+	}
+}
+
+func TestClassByMime(t *testing.T) {
+	for mimeType, class := range map[string]string{
+		"audio/flac":               ClassMusicTrack,
+		"video/mp4":                ClassVideoItem,
+		"image/jpeg":               ClassPhoto,
+		"application/octet-stream": "",
+	} {
+		if res := ClassByMime(mimeType); res != class {
+			t.Fatalf("expected class %q for %q, got %q", class, mimeType, res)
+		}
+	}
+}
+
+func TestAdvertiserMatch(t *testing.T) {
+	a := NewAdvertiser(nil, "uuid-1", "http://host/dlna/device.xml", 1800, nil)
+	if len(a.match(ssdpAll)) != len(a.targets()) {
+		t.Fatal("ssdp:all should match all targets")
+	}
+	if res := a.match(ContentDirectoryType); len(res) != 1 || a.usn(res[0]) != "uuid:uuid-1::"+ContentDirectoryType {
+		t.Fatalf("unexpected match %v", res)
+	}
+	if res := a.match("uuid:uuid-1"); len(res) != 1 || a.usn(res[0]) != "uuid:uuid-1" {
+		t.Fatalf("unexpected match %v", res)
+	}
+	if res := a.match("urn:schemas-upnp-org:service:AVTransport:1"); len(res) != 0 {
+		t.Fatalf("unexpected match %v", res)
+	}
+
+	msg := string(a.message("HTTP/1.1 200 OK", map[string]string{"ST": ssdpRootDevice, "USN": a.usn(ssdpRootDevice)}))
+	if msg != "HTTP/1.1 200 OK\r\nST: upnp:rootdevice\r\nUSN: uuid:uuid-1::upnp:rootdevice\r\n\r\n" {
+		t.Fatalf("unexpected message %q", msg)
+	}
+}
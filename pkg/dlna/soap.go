@@ -0,0 +1,138 @@
+package dlna
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const (
+	soapEnvelopeNs     = "http://schemas.xmlsoap.org/soap/envelope/"
+	soapEncodingStyle  = "http://schemas.xmlsoap.org/soap/encoding/"
+	soapActionHeader   = "SOAPACTION"
+	upnpControlErrorNs = "urn:schemas-upnp-org:control-1-0"
+)
+
+// UPnP error codes returned in SOAP faults.
+const (
+	ErrInvalidAction = 401
+	ErrInvalidArgs   = 402
+	ErrActionFailed  = 501
+	ErrNoSuchObject  = 701
+)
+
+type (
+	// soapRequest is an action invocation parsed from request body.
+	soapRequest struct {
+		Body struct {
+			Action struct {
+				XMLName xml.Name
+				Args    []struct {
+					XMLName xml.Name
+					Value   string `xml:",chardata"`
+				} `xml:",any"`
+			} `xml:",any"`
+		} `xml:"Body"`
+	}
+
+	// Action is a parsed SOAP action invocation.
+	Action struct {
+		ServiceType string
+		Name        string
+		Args        map[string]string
+	}
+
+	// Arg is an output argument of an action, written in order.
+	Arg struct {
+		Name  string
+		Value string
+	}
+
+	// Fault is an UPnP error returned by an action.
+	Fault struct {
+		Code        int
+		Description string
+	}
+)
+
+func (f *Fault) Error() string {
+	return fmt.Sprintf("upnp error %d: %s", f.Code, f.Description)
+}
+
+// NewFault creates an UPnP error with given code.
+func NewFault(code int, description string) *Fault {
+	return &Fault{Code: code, Description: description}
+}
+
+// ParseAction parses a SOAP action from request header and body.
+func ParseAction(header http.Header, body io.Reader) (*Action, error) {
+	// Header is in form of `"urn:schemas-upnp-org:service:ContentDirectory:1#Browse"`
+	serviceType, name, ok := strings.Cut(strings.Trim(header.Get(soapActionHeader), `" `), "#")
+	if !ok || name == "" {
+		return nil, NewFault(ErrInvalidAction, "Invalid SOAPACTION header")
+	}
+
+	var req soapRequest
+	if err := xml.NewDecoder(body).Decode(&req); err != nil {
+		return nil, NewFault(ErrInvalidArgs, fmt.Sprintf("Failed to parse SOAP envelope: %s", err))
+	}
+
+	if req.Body.Action.XMLName.Local != name {
+		return nil, NewFault(ErrInvalidAction, "Action in body does not match SOAPACTION header")
+	}
+
+	action := &Action{
+		ServiceType: serviceType,
+		Name:        name,
+		Args:        make(map[string]string, len(req.Body.Action.Args)),
+	}
+	for _, arg := range req.Body.Action.Args {
+		action.Args[arg.XMLName.Local] = arg.Value
+	}
+
+	return action, nil
+}
+
+// WriteResponse writes a SOAP envelope containing output arguments of given action.
+func WriteResponse(w http.ResponseWriter, action *Action, args []Arg) error {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, `<u:%sResponse xmlns:u="%s">`, action.Name, action.ServiceType)
+	for _, arg := range args {
+		fmt.Fprintf(&sb, "<%s>", arg.Name)
+		if err := xml.EscapeText(&sb, []byte(arg.Value)); err != nil {
+			return err
+		}
+		fmt.Fprintf(&sb, "</%s>", arg.Name)
+	}
+	fmt.Fprintf(&sb, `</u:%sResponse>`, action.Name)
+
+	return writeEnvelope(w, http.StatusOK, sb.String())
+}
+
+// WriteFault writes a SOAP fault with given UPnP error.
+func WriteFault(w http.ResponseWriter, fault *Fault) error {
+	var desc strings.Builder
+	if err := xml.EscapeText(&desc, []byte(fault.Description)); err != nil {
+		return err
+	}
+
+	return writeEnvelope(w, http.StatusInternalServerError, fmt.Sprintf(
+		`<s:Fault><faultcode>s:Client</faultcode><faultstring>UPnPError</faultstring><detail>`+
+			`<UPnPError xmlns="%s"><errorCode>%d</errorCode><errorDescription>%s</errorDescription></UPnPError>`+
+			`</detail></s:Fault>`,
+		upnpControlErrorNs, fault.Code, desc.String(),
+	))
+}
+
+func writeEnvelope(w http.ResponseWriter, status int, body string) error {
+	w.Header().Set("Content-Type", `text/xml; charset="utf-8"`)
+	w.Header().Set("Ext", "")
+	w.WriteHeader(status)
+	_, err := fmt.Fprintf(w,
+		`%s<s:Envelope xmlns:s="%s" s:encodingStyle="%s"><s:Body>%s</s:Body></s:Envelope>`,
+		xml.Header, soapEnvelopeNs, soapEncodingStyle, body,
+	)
+	return err
+}
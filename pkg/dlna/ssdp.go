@@ -0,0 +1,261 @@
+package dlna
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"net/http"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/constants"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+)
+
+const (
+	ssdpAddr       = "239.255.255.250:1900"
+	ssdpAll        = "ssdp:all"
+	ssdpRootDevice = "upnp:rootdevice"
+	ssdpDiscover   = `"ssdp:discover"`
+	ssdpAlive      = "ssdp:alive"
+	ssdpByeBye     = "ssdp:byebye"
+	// ssdpMaxDelay caps the random delay before answering M-SEARCH, regardless of MX in request.
+	ssdpMaxDelay = 3
+)
+
+// Advertiser announces the media server with SSDP, and answers M-SEARCH requests from control points.
+type Advertiser struct {
+	uuid     string
+	location string
+	maxAge   int
+	iface    *net.Interface
+	l        logging.Logger
+
+	conn   *net.UDPConn
+	group  *net.UDPAddr
+	closed chan struct{}
+	wg     sync.WaitGroup
+}
+
+// NewAdvertiser creates an advertiser on given network interface. location is the URL of device description.
+func NewAdvertiser(iface *net.Interface, uuid, location string, maxAge int, l logging.Logger) *Advertiser {
+	return &Advertiser{
+		uuid:     uuid,
+		location: location,
+		maxAge:   maxAge,
+		iface:    iface,
+		l:        l,
+		closed:   make(chan struct{}),
+	}
+}
+
+// Start joins the SSDP multicast group and starts announcing the device.
+func (a *Advertiser) Start() error {
+	group, err := net.ResolveUDPAddr("udp4", ssdpAddr)
+	if err != nil {
+		return err
+	}
+
+	conn, err := net.ListenMulticastUDP("udp4", a.iface, group)
+	if err != nil {
+		return fmt.Errorf("failed to join SSDP multicast group on %q: %w", a.iface.Name, err)
+	}
+
+	a.conn, a.group = conn, group
+	a.wg.Add(2)
+	go a.serve()
+	go a.announce()
+	return nil
+}
+
+// Close sends byebye notifications and stops the advertiser.
+func (a *Advertiser) Close() {
+	if a.conn == nil {
+		return
+	}
+
+	close(a.closed)
+	a.notify(ssdpByeBye)
+	_ = a.conn.Close()
+	a.wg.Wait()
+}
+
+// announce sends alive notifications periodically, before advertisements expire.
+func (a *Advertiser) announce() {
+	defer a.wg.Done()
+	ticker := time.NewTicker(time.Duration(a.maxAge) * time.Second / 2)
+	defer ticker.Stop()
+
+	a.notify(ssdpAlive)
+	for {
+		select {
+		case <-a.closed:
+			return
+		case <-ticker.C:
+			a.notify(ssdpAlive)
+		}
+	}
+}
+
+func (a *Advertiser) notify(nts string) {
+	for _, target := range a.targets() {
+		msg := a.message("NOTIFY * HTTP/1.1", map[string]string{
+			"HOST":          ssdpAddr,
+			"CACHE-CONTROL": fmt.Sprintf("max-age=%d", a.maxAge),
+			"LOCATION":      a.location,
+			"NT":            target,
+			"NTS":           nts,
+			"SERVER":        serverHeader(),
+			"USN":           a.usn(target),
+		})
+		if _, err := a.conn.WriteToUDP(msg, a.group); err != nil {
+			a.l.Debug("Failed to send SSDP %s notification: %s", nts, err)
+		}
+	}
+}
+
+// serve reads M-SEARCH requests until the connection is closed.
+func (a *Advertiser) serve() {
+	defer a.wg.Done()
+	buf := make([]byte, 2048)
+	for {
+		n, from, err := a.conn.ReadFromUDP(buf)
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return
+			}
+
+			a.l.Debug("Failed to read SSDP packet: %s", err)
+			continue
+		}
+
+		req, err := http.ReadRequest(bufio.NewReader(bytes.NewReader(buf[:n])))
+		if err != nil || req.Method != "M-SEARCH" || req.Header.Get("MAN") != ssdpDiscover {
+			continue
+		}
+
+		targets := a.match(req.Header.Get("ST"))
+		if len(targets) == 0 {
+			continue
+		}
+
+		mx, _ := strconv.Atoi(req.Header.Get("MX"))
+		go a.respond(from, targets, mx)
+	}
+}
+
+// respond answers a M-SEARCH request after a random delay of at most mx seconds.
+func (a *Advertiser) respond(to *net.UDPAddr, targets []string, mx int) {
+	if mx = min(mx, ssdpMaxDelay); mx > 0 {
+		select {
+		case <-a.closed:
+			return
+		case <-time.After(time.Duration(rand.Int63n(int64(mx) * int64(time.Second)))):
+		}
+	}
+
+	for _, target := range targets {
+		msg := a.message("HTTP/1.1 200 OK", map[string]string{
+			"CACHE-CONTROL": fmt.Sprintf("max-age=%d", a.maxAge),
+			"DATE":          time.Now().UTC().Format(http.TimeFormat),
+			"EXT":           "",
+			"LOCATION":      a.location,
+			"SERVER":        serverHeader(),
+			"ST":            target,
+			"USN":           a.usn(target),
+		})
+		if _, err := a.conn.WriteToUDP(msg, to); err != nil {
+			a.l.Debug("Failed to respond SSDP search from %q: %s", to, err)
+		}
+	}
+}
+
+// targets returns all notification types advertised by the device.
+func (a *Advertiser) targets() []string {
+	return []string{ssdpRootDevice, "uuid:" + a.uuid, DeviceType, ContentDirectoryType, ConnectionManagerType}
+}
+
+// match returns advertised targets matching the search target in M-SEARCH request.
+func (a *Advertiser) match(st string) []string {
+	if st == ssdpAll {
+		return a.targets()
+	}
+
+	for _, target := range a.targets() {
+		if target == st {
+			return []string{target}
+		}
+	}
+
+	return nil
+}
+
+func (a *Advertiser) usn(target string) string {
+	if target == "uuid:"+a.uuid {
+		return target
+	}
+
+	return fmt.Sprintf("uuid:%s::%s", a.uuid, target)
+}
+
+// message builds a SSDP message with headers in stable order.
+func (a *Advertiser) message(startLine string, headers map[string]string) []byte {
+	var sb strings.Builder
+	sb.WriteString(startLine + "\r\n")
+	for _, key := range []string{"HOST", "CACHE-CONTROL", "DATE", "EXT", "LOCATION", "NT", "NTS", "SERVER", "ST", "USN"} {
+		if value, ok := headers[key]; ok {
+			sb.WriteString(fmt.Sprintf("%s: %s\r\n", key, value))
+		}
+	}
+	sb.WriteString("\r\n")
+	return []byte(sb.String())
+}
+
+func serverHeader() string {
+	return fmt.Sprintf("%s/%s UPnP/1.0 Cloudreve/%s", runtime.GOOS, runtime.Version(), constants.BackendVersion)
+}
+
+// MulticastInterface returns the interface with given name and its IPv4 address. If name is empty,
+// the first up, non-loopback interface supporting multicast is used.
+func MulticastInterface(name string) (*net.Interface, net.IP, error) {
+	var candidates []net.Interface
+	if name != "" {
+		iface, err := net.InterfaceByName(name)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get interface %q: %w", name, err)
+		}
+		candidates = append(candidates, *iface)
+	} else {
+		ifaces, err := net.Interfaces()
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to list interfaces: %w", err)
+		}
+
+		for _, iface := range ifaces {
+			if iface.Flags&net.FlagUp != 0 && iface.Flags&net.FlagMulticast != 0 && iface.Flags&net.FlagLoopback == 0 {
+				candidates = append(candidates, iface)
+			}
+		}
+	}
+
+	for i := range candidates {
+		addrs, err := candidates[i].Addrs()
+		if err != nil {
+			continue
+		}
+
+		for _, addr := range addrs {
+			if ipNet, ok := addr.(*net.IPNet); ok && ipNet.IP.To4() != nil {
+				return &candidates[i], ipNet.IP.To4(), nil
+			}
+		}
+	}
+
+	return nil, nil, fmt.Errorf("no multicast interface with IPv4 address found")
+}
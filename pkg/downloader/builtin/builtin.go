@@ -0,0 +1,196 @@
+package builtin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/cloudreve/Cloudreve/v4/application/constants"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/downloader"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/gofrs/uuid"
+)
+
+const (
+	BuiltinTempFolder = "builtin"
+	stateFileSuffix   = ".json"
+)
+
+var (
+	// Downloader instances are created on demand for each call, running tasks
+	// are tracked globally so that they can be found by subsequent instances.
+	registryMu sync.Mutex
+	registry   = make(map[string]*task)
+)
+
+type builtinClient struct {
+	l        logging.Logger
+	settings setting.Provider
+	options  *types.BuiltinDownloaderSetting
+}
+
+// New creates a downloader that fetches HTTP(S) and FTP resources within the Cloudreve process.
+func New(l logging.Logger, settings setting.Provider, options *types.BuiltinDownloaderSetting) downloader.Downloader {
+	if options == nil {
+		options = &types.BuiltinDownloaderSetting{}
+	}
+
+	return &builtinClient{
+		l:        l,
+		settings: settings,
+		options:  options,
+	}
+}
+
+func (b *builtinClient) CreateTask(ctx context.Context, rawUrl string, options map[string]interface{}) (*downloader.TaskHandle, error) {
+	u, err := url.Parse(rawUrl)
+	if err != nil {
+		return nil, fmt.Errorf("invalid url: %w", err)
+	}
+
+	scheme := strings.ToLower(u.Scheme)
+	if scheme != "http" && scheme != "https" && scheme != "ftp" {
+		return nil, fmt.Errorf("unsupported protocol %q", u.Scheme)
+	}
+
+	// Task options overrides node default options
+	merged := make(map[string]interface{})
+	for k, v := range b.options.Options {
+		merged[k] = v
+	}
+	for k, v := range options {
+		merged[k] = v
+	}
+
+	opts, err := parseOptions(merged)
+	if err != nil {
+		return nil, err
+	}
+
+	guid, _ := uuid.NewV4()
+	t := newTask(b.l, b.tempPath(ctx), &taskState{
+		ID:      guid.String(),
+		Url:     rawUrl,
+		Options: opts,
+		Status:  downloader.StatusDownloading,
+	})
+
+	b.l.Info("Creating builtin download task with url %q saving to %q...", rawUrl, t.folder())
+
+	// Probe the resource before returning, so that file info is available for the first status query.
+	if err := t.prepare(ctx); err != nil {
+		return nil, err
+	}
+
+	registryMu.Lock()
+	registry[t.state.ID] = t
+	registryMu.Unlock()
+
+	t.start()
+	return &downloader.TaskHandle{
+		ID: t.state.ID,
+	}, nil
+}
+
+func (b *builtinClient) Info(ctx context.Context, handle *downloader.TaskHandle) (*downloader.TaskStatus, error) {
+	t, err := b.load(ctx, handle.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.status(), nil
+}
+
+func (b *builtinClient) Cancel(ctx context.Context, handle *downloader.TaskHandle) error {
+	t, err := b.load(ctx, handle.ID)
+	if err != nil {
+		return err
+	}
+
+	t.stop()
+
+	registryMu.Lock()
+	delete(registry, handle.ID)
+	registryMu.Unlock()
+
+	if err := os.RemoveAll(t.folder()); err != nil {
+		b.l.Warning("Failed to remove temp folder %q of builtin download task: %s", t.folder(), err)
+	}
+	if err := os.Remove(t.stateFile()); err != nil && !os.IsNotExist(err) {
+		b.l.Warning("Failed to remove state file %q of builtin download task: %s", t.stateFile(), err)
+	}
+
+	return nil
+}
+
+func (b *builtinClient) SetFilesToDownload(ctx context.Context, handle *downloader.TaskHandle, args ...*downloader.SetFileToDownloadArgs) error {
+	// Each task only contains one file, which is always selected.
+	return nil
+}
+
+func (b *builtinClient) Test(ctx context.Context) (string, error) {
+	base := b.tempPath(ctx)
+	if err := os.MkdirAll(base, 0700); err != nil {
+		return "", fmt.Errorf("cannot create temp folder %q: %w", base, err)
+	}
+
+	return constants.BackendVersion, nil
+}
+
+// load returns the running task with given ID, or restores it from the state file
+// persisted by a previous process and resumes it if it is not finished yet.
+func (b *builtinClient) load(ctx context.Context, id string) (*task, error) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	if t, ok := registry[id]; ok {
+		return t, nil
+	}
+
+	// Task ID is used as a file name, make sure it is a valid one
+	if _, err := uuid.FromString(id); err != nil {
+		return nil, downloader.ErrTaskNotFount
+	}
+
+	base := b.tempPath(ctx)
+	content, err := os.ReadFile(filepath.Join(base, id+stateFileSuffix))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, downloader.ErrTaskNotFount
+		}
+
+		return nil, fmt.Errorf("failed to read task state: %w", err)
+	}
+
+	state := &taskState{}
+	if err := json.Unmarshal(content, state); err != nil {
+		return nil, fmt.Errorf("failed to parse task state: %w", err)
+	}
+
+	t := newTask(b.l, base, state)
+	registry[id] = t
+	if state.Status == downloader.StatusDownloading {
+		b.l.Info("Resuming builtin download task %q from %d bytes.", id, state.downloaded())
+		t.start()
+	}
+
+	return t, nil
+}
+
+func (b *builtinClient) tempPath(ctx context.Context) string {
+	base := util.RelativePath(b.options.TempPath)
+	if b.options.TempPath == "" {
+		base = util.DataPath(b.settings.TempPath(ctx))
+	}
+
+	return filepath.Join(base, BuiltinTempFolder)
+}
@@ -0,0 +1,188 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/textproto"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	ftpDefaultPort = "21"
+	ftpDialTimeout = 30 * time.Second
+)
+
+// ftpSource reads file over FTP, each reader uses its own control connection in passive mode.
+type ftpSource struct {
+	url *url.URL
+}
+
+func (s *ftpSource) probe(ctx context.Context) (*sourceInfo, error) {
+	c, err := dialFtp(ctx, s.url)
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+
+	info := &sourceInfo{name: path.Base(s.url.Path), size: -1}
+	if _, msg, err := c.cmd(213, "SIZE %s", s.url.Path); err == nil {
+		if size, err := strconv.ParseInt(strings.TrimSpace(msg), 10, 64); err == nil {
+			info.size = size
+		}
+	}
+
+	if _, _, err := c.cmd(350, "REST 0"); err == nil {
+		info.ranged = true
+	}
+
+	return info, nil
+}
+
+func (s *ftpSource) open(ctx context.Context, offset, length int64) (io.ReadCloser, error) {
+	c, err := dialFtp(ctx, s.url)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.passive(ctx)
+	if err != nil {
+		c.Close()
+		return nil, err
+	}
+
+	if offset > 0 {
+		if _, _, err := c.cmd(350, "REST %d", offset); err != nil {
+			data.Close()
+			c.Close()
+			return nil, err
+		}
+	}
+
+	if _, _, err := c.cmd(1, "RETR %s", s.url.Path); err != nil {
+		data.Close()
+		c.Close()
+		return nil, err
+	}
+
+	return &ftpReader{Conn: data, control: c}, nil
+}
+
+type ftpConn struct {
+	*textproto.Conn
+	host string
+}
+
+func dialFtp(ctx context.Context, u *url.URL) (*ftpConn, error) {
+	host := u.Host
+	if u.Port() == "" {
+		host = net.JoinHostPort(u.Hostname(), ftpDefaultPort)
+	}
+
+	dialer := &net.Dialer{Timeout: ftpDialTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", host)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &ftpConn{Conn: textproto.NewConn(conn), host: u.Hostname()}
+	if _, _, err := c.ReadResponse(220); err != nil {
+		c.Close()
+		return nil, fmt.Errorf("unexpected FTP greeting: %w", err)
+	}
+
+	user, password := "anonymous", "anonymous@"
+	if u.User != nil {
+		user = u.User.Username()
+		password, _ = u.User.Password()
+	}
+
+	code, msg, err := c.cmd(0, "USER %s", user)
+	if err == nil && code == 331 {
+		code, msg, err = c.cmd(0, "PASS %s", password)
+	}
+	if err == nil && code != 230 && code != 202 {
+		err = fmt.Errorf("%d %s", code, msg)
+	}
+	if err != nil {
+		c.Close()
+		return nil, fmt.Errorf("FTP login failed: %w", err)
+	}
+
+	if _, _, err := c.cmd(200, "TYPE I"); err != nil {
+		c.Close()
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// cmd sends a command and reads the response, see textproto.Reader.ReadResponse for expectCode.
+func (c *ftpConn) cmd(expectCode int, format string, args ...any) (int, string, error) {
+	id, err := c.Cmd(format, args...)
+	if err != nil {
+		return 0, "", err
+	}
+
+	c.StartResponse(id)
+	defer c.EndResponse(id)
+	return c.ReadResponse(expectCode)
+}
+
+// passive opens a data connection, trying EPSV first and falling back to PASV. The host
+// in PASV response is ignored in favor of the control connection host, to get around NAT.
+func (c *ftpConn) passive(ctx context.Context) (net.Conn, error) {
+	var port int
+	if _, msg, err := c.cmd(229, "EPSV"); err == nil {
+		// 229 Entering Extended Passive Mode (|||port|)
+		start, end := strings.Index(msg, "(|||"), strings.LastIndex(msg, "|)")
+		if start < 0 || end < start+4 {
+			return nil, fmt.Errorf("invalid EPSV response %q", msg)
+		}
+		port, err = strconv.Atoi(msg[start+4 : end])
+		if err != nil {
+			return nil, fmt.Errorf("invalid EPSV response %q", msg)
+		}
+	} else {
+		_, msg, err := c.cmd(227, "PASV")
+		if err != nil {
+			return nil, err
+		}
+
+		// 227 Entering Passive Mode (h1,h2,h3,h4,p1,p2)
+		start, end := strings.Index(msg, "("), strings.LastIndex(msg, ")")
+		if start < 0 || end < start {
+			return nil, fmt.Errorf("invalid PASV response %q", msg)
+		}
+		parts := strings.Split(msg[start+1:end], ",")
+		if len(parts) != 6 {
+			return nil, fmt.Errorf("invalid PASV response %q", msg)
+		}
+		p1, err1 := strconv.Atoi(strings.TrimSpace(parts[4]))
+		p2, err2 := strconv.Atoi(strings.TrimSpace(parts[5]))
+		if err1 != nil || err2 != nil {
+			return nil, fmt.Errorf("invalid PASV response %q", msg)
+		}
+		port = p1<<8 | p2
+	}
+
+	dialer := &net.Dialer{Timeout: ftpDialTimeout}
+	return dialer.DialContext(ctx, "tcp", net.JoinHostPort(c.host, strconv.Itoa(port)))
+}
+
+// ftpReader reads from the data connection, and closes control connection along with it.
+type ftpReader struct {
+	net.Conn
+	control *ftpConn
+}
+
+func (r *ftpReader) Close() error {
+	err := r.Conn.Close()
+	r.control.Close()
+	return err
+}
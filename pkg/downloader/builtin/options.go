@@ -0,0 +1,120 @@
+package builtin
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	defaultSplit        = 5
+	defaultMinSplitSize = 20 << 20
+	defaultMaxTries     = 5
+	maxSplit            = 16
+)
+
+// taskOptions are parsed from option map, option names follows aria2 for consistency.
+type taskOptions struct {
+	Headers      []string `json:"headers,omitempty"`
+	Split        int      `json:"split"`
+	MinSplitSize int64    `json:"min_split_size"`
+	SpeedLimit   int64    `json:"speed_limit,omitempty"`
+	MaxTries     int      `json:"max_tries"`
+	Checksum     string   `json:"checksum,omitempty"`
+	Out          string   `json:"out,omitempty"`
+}
+
+func parseOptions(options map[string]interface{}) (*taskOptions, error) {
+	opts := &taskOptions{
+		Split:        defaultSplit,
+		MinSplitSize: defaultMinSplitSize,
+		MaxTries:     defaultMaxTries,
+	}
+
+	for k, v := range options {
+		var err error
+		switch k {
+		case "header":
+			opts.Headers = append(opts.Headers, stringList(v)...)
+		case "cookie":
+			opts.Headers = append(opts.Headers, "Cookie: "+fmt.Sprint(v))
+		case "user-agent":
+			opts.Headers = append(opts.Headers, "User-Agent: "+fmt.Sprint(v))
+		case "referer":
+			opts.Headers = append(opts.Headers, "Referer: "+fmt.Sprint(v))
+		case "split":
+			var split int64
+			split, err = parseSize(v)
+			opts.Split = min(max(int(split), 1), maxSplit)
+		case "min-split-size":
+			opts.MinSplitSize, err = parseSize(v)
+		case "max-download-limit":
+			opts.SpeedLimit, err = parseSize(v)
+		case "max-tries":
+			var tries int64
+			tries, err = parseSize(v)
+			opts.MaxTries = max(int(tries), 1)
+		case "checksum":
+			opts.Checksum = fmt.Sprint(v)
+			if !strings.Contains(opts.Checksum, "=") {
+				err = fmt.Errorf("expect format TYPE=DIGEST")
+			}
+		case "out":
+			opts.Out = fmt.Sprint(v)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid option %q: %w", k, err)
+		}
+	}
+
+	return opts, nil
+}
+
+func stringList(v interface{}) []string {
+	switch val := v.(type) {
+	case []string:
+		return val
+	case []interface{}:
+		res := make([]string, 0, len(val))
+		for _, item := range val {
+			res = append(res, fmt.Sprint(item))
+		}
+		return res
+	default:
+		return []string{fmt.Sprint(v)}
+	}
+}
+
+// parseSize parses numbers with optional K/M/G suffix, e.g. 1M, 512K.
+func parseSize(v interface{}) (int64, error) {
+	switch val := v.(type) {
+	case float64:
+		return int64(val), nil
+	case int:
+		return int64(val), nil
+	case int64:
+		return val, nil
+	}
+
+	s := strings.ToUpper(strings.TrimSpace(fmt.Sprint(v)))
+	multiplier := int64(1)
+	switch {
+	case strings.HasSuffix(s, "K"):
+		multiplier = 1 << 10
+	case strings.HasSuffix(s, "M"):
+		multiplier = 1 << 20
+	case strings.HasSuffix(s, "G"):
+		multiplier = 1 << 30
+	}
+	if multiplier > 1 {
+		s = s[:len(s)-1]
+	}
+
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	return n * multiplier, nil
+}
@@ -0,0 +1,103 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSize(t *testing.T) {
+	tests := []struct {
+		input    interface{}
+		expected int64
+		err      bool
+	}{
+		{input: float64(1024), expected: 1024},
+		{input: 3, expected: 3},
+		{input: int64(5), expected: 5},
+		{input: "100", expected: 100},
+		{input: " 512k ", expected: 512 << 10},
+		{input: "1M", expected: 1 << 20},
+		{input: "2G", expected: 2 << 30},
+		{input: "1.5M", err: true},
+		{input: "M", err: true},
+		{input: "abc", err: true},
+	}
+
+	for _, tt := range tests {
+		res, err := parseSize(tt.input)
+		if tt.err {
+			assert.Error(t, err, tt.input)
+			continue
+		}
+
+		assert.NoError(t, err, tt.input)
+		assert.Equal(t, tt.expected, res, tt.input)
+	}
+}
+
+func TestParseOptions(t *testing.T) {
+	a := assert.New(t)
+
+	t.Run("defaults", func(t *testing.T) {
+		opts, err := parseOptions(nil)
+		a.NoError(err)
+		a.Equal(&taskOptions{Split: defaultSplit, MinSplitSize: defaultMinSplitSize, MaxTries: defaultMaxTries}, opts)
+	})
+
+	t.Run("aria2 options", func(t *testing.T) {
+		opts, err := parseOptions(map[string]interface{}{
+			"header":             []interface{}{"X-A: 1", "X-B: 2"},
+			"split":              "8",
+			"min-split-size":     "1M",
+			"max-download-limit": float64(1024),
+			"max-tries":          "3",
+			"checksum":           "sha-256=abc",
+			"out":                "a.zip",
+			"unknown":            "ignored",
+		})
+		a.NoError(err)
+		a.Equal(&taskOptions{
+			Headers:      []string{"X-A: 1", "X-B: 2"},
+			Split:        8,
+			MinSplitSize: 1 << 20,
+			SpeedLimit:   1024,
+			MaxTries:     3,
+			Checksum:     "sha-256=abc",
+			Out:          "a.zip",
+		}, opts)
+	})
+
+	t.Run("header shortcuts", func(t *testing.T) {
+		opts, err := parseOptions(map[string]interface{}{"cookie": "a=b"})
+		a.NoError(err)
+		a.Equal([]string{"Cookie: a=b"}, opts.Headers)
+
+		opts, err = parseOptions(map[string]interface{}{"user-agent": "curl"})
+		a.NoError(err)
+		a.Equal([]string{"User-Agent: curl"}, opts.Headers)
+
+		opts, err = parseOptions(map[string]interface{}{"referer": "https://example.com"})
+		a.NoError(err)
+		a.Equal([]string{"Referer: https://example.com"}, opts.Headers)
+	})
+
+	t.Run("values are clamped", func(t *testing.T) {
+		opts, err := parseOptions(map[string]interface{}{"split": 100, "max-tries": 0})
+		a.NoError(err)
+		a.Equal(maxSplit, opts.Split)
+		a.Equal(1, opts.MaxTries)
+
+		opts, err = parseOptions(map[string]interface{}{"split": 0})
+		a.NoError(err)
+		a.Equal(1, opts.Split)
+	})
+
+	t.Run("invalid values", func(t *testing.T) {
+		_, err := parseOptions(map[string]interface{}{"split": "many"})
+		a.ErrorContains(err, `"split"`)
+
+		_, err = parseOptions(map[string]interface{}{"checksum": "abc"})
+		a.ErrorContains(err, "TYPE=DIGEST")
+	})
+}
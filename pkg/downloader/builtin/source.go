@@ -0,0 +1,145 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+)
+
+type (
+	// source is a remote resource that can be read from arbitrary offset.
+	source interface {
+		// probe returns basic info of the resource.
+		probe(ctx context.Context) (*sourceInfo, error)
+		// open returns a reader starting from offset, length is -1 if reading to the end.
+		open(ctx context.Context, offset, length int64) (io.ReadCloser, error)
+	}
+
+	sourceInfo struct {
+		name string
+		// size is -1 if unknown
+		size int64
+		// ranged indicates whether partial reading is supported
+		ranged bool
+	}
+)
+
+func newSource(rawUrl string, opts *taskOptions) (source, error) {
+	u, err := url.Parse(rawUrl)
+	if err != nil {
+		return nil, fmt.Errorf("invalid url: %w", err)
+	}
+
+	switch strings.ToLower(u.Scheme) {
+	case "http", "https":
+		header := http.Header{}
+		for _, h := range opts.Headers {
+			if k, v, ok := strings.Cut(h, ":"); ok {
+				header.Add(strings.TrimSpace(k), strings.TrimSpace(v))
+			}
+		}
+		return &httpSource{url: u.String(), header: header, client: http.DefaultClient}, nil
+	case "ftp":
+		return &ftpSource{url: u}, nil
+	default:
+		return nil, fmt.Errorf("unsupported protocol %q", u.Scheme)
+	}
+}
+
+type httpSource struct {
+	url    string
+	header http.Header
+	client *http.Client
+}
+
+func (s *httpSource) do(ctx context.Context, rangeHeader string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header = s.header.Clone()
+	if rangeHeader != "" {
+		req.Header.Set("Range", rangeHeader)
+	}
+
+	return s.client.Do(req)
+}
+
+func (s *httpSource) probe(ctx context.Context) (*sourceInfo, error) {
+	resp, err := s.do(ctx, "bytes=0-0")
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusRequestedRangeNotSatisfiable {
+		// Probably an empty file, try again without range.
+		resp.Body.Close()
+		resp, err = s.do(ctx, "")
+		if err != nil {
+			return nil, err
+		}
+	}
+	defer resp.Body.Close()
+
+	info := &sourceInfo{name: fileNameFromResponse(resp), size: -1}
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+		// Content-Range: bytes 0-0/1234
+		if _, total, ok := strings.Cut(resp.Header.Get("Content-Range"), "/"); ok {
+			if size, err := strconv.ParseInt(total, 10, 64); err == nil {
+				info.size = size
+				info.ranged = true
+			}
+		}
+	case http.StatusOK:
+		info.size = resp.ContentLength
+	default:
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return info, nil
+}
+
+func (s *httpSource) open(ctx context.Context, offset, length int64) (io.ReadCloser, error) {
+	rangeHeader := ""
+	if length >= 0 {
+		rangeHeader = fmt.Sprintf("bytes=%d-%d", offset, offset+length-1)
+	} else if offset > 0 {
+		rangeHeader = fmt.Sprintf("bytes=%d-", offset)
+	}
+
+	resp, err := s.do(ctx, rangeHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	expected := http.StatusOK
+	if rangeHeader != "" {
+		expected = http.StatusPartialContent
+	}
+
+	if resp.StatusCode != expected {
+		resp.Body.Close()
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return resp.Body, nil
+}
+
+// fileNameFromResponse infers file name from Content-Disposition header or URL path.
+func fileNameFromResponse(resp *http.Response) string {
+	if _, params, err := mime.ParseMediaType(resp.Header.Get("Content-Disposition")); err == nil {
+		if name := params["filename"]; name != "" {
+			return name
+		}
+	}
+
+	return path.Base(resp.Request.URL.Path)
+}
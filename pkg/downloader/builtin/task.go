@@ -0,0 +1,473 @@
+package builtin
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/downloader"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/juju/ratelimit"
+)
+
+const (
+	persistInterval = time.Second
+	retryBaseWait   = 2 * time.Second
+	bufferSize      = 256 * 1024
+	defaultFileName = "download"
+)
+
+type (
+	task struct {
+		l     logging.Logger
+		base  string
+		state *taskState
+
+		mu     sync.Mutex
+		speed  int64
+		cancel context.CancelFunc
+		done   chan struct{}
+	}
+
+	// taskState is persisted in the temp folder so that tasks can be resumed after restart.
+	taskState struct {
+		ID        string            `json:"id"`
+		Url       string            `json:"url"`
+		Options   *taskOptions      `json:"options"`
+		Name      string            `json:"name"`
+		Total     int64             `json:"total"`
+		Resumable bool              `json:"resumable"`
+		Segments  []*segment        `json:"segments"`
+		Status    downloader.Status `json:"status"`
+		Error     string            `json:"error,omitempty"`
+	}
+
+	// segment is a byte range of the file fetched by one connection. End is exclusive,
+	// or -1 if the size of the file is unknown.
+	segment struct {
+		Start int64 `json:"start"`
+		End   int64 `json:"end"`
+		Done  int64 `json:"done"`
+	}
+)
+
+func newTask(l logging.Logger, base string, state *taskState) *task {
+	return &task{
+		l:     l,
+		base:  base,
+		state: state,
+	}
+}
+
+func (t *task) folder() string {
+	return filepath.Join(t.base, t.state.ID)
+}
+
+func (t *task) stateFile() string {
+	return filepath.Join(t.base, t.state.ID+stateFileSuffix)
+}
+
+// prepare probes the remote resource and splits it into segments.
+func (t *task) prepare(ctx context.Context) error {
+	src, err := newSource(t.state.Url, t.state.Options)
+	if err != nil {
+		return err
+	}
+
+	info, err := src.probe(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to probe resource: %w", err)
+	}
+
+	name := t.state.Options.Out
+	if name == "" {
+		name = info.name
+	}
+
+	t.state.Name = sanitizeFileName(name)
+	t.state.Total = info.size
+	t.state.Resumable = info.ranged && info.size > 0
+	t.state.Segments = splitSegments(info.size, t.state.Resumable, t.state.Options.Split, t.state.Options.MinSplitSize)
+
+	if err := os.MkdirAll(t.folder(), 0700); err != nil {
+		return fmt.Errorf("failed to create temp folder: %w", err)
+	}
+
+	return t.persist()
+}
+
+func (t *task) start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.mu.Lock()
+	t.cancel = cancel
+	t.done = make(chan struct{})
+	t.mu.Unlock()
+
+	go t.run(ctx)
+}
+
+// stop aborts the running download and waits for all workers to exit.
+func (t *task) stop() {
+	t.mu.Lock()
+	cancel, done := t.cancel, t.done
+	t.mu.Unlock()
+
+	if cancel != nil {
+		cancel()
+		<-done
+	}
+}
+
+func (t *task) run(ctx context.Context) {
+	defer close(t.done)
+
+	err := t.download(ctx)
+	if ctx.Err() != nil {
+		// Task is canceled, state file is cleaned up by the caller.
+		return
+	}
+
+	t.mu.Lock()
+	t.speed = 0
+	if err != nil {
+		t.l.Warning("Builtin download task %q failed: %s", t.state.ID, err)
+		t.state.Status = downloader.StatusError
+		t.state.Error = err.Error()
+	} else {
+		t.l.Info("Builtin download task %q completed.", t.state.ID)
+		t.state.Status = downloader.StatusCompleted
+	}
+	t.mu.Unlock()
+
+	if err := t.persist(); err != nil {
+		t.l.Warning("Failed to persist state of builtin download task %q: %s", t.state.ID, err)
+	}
+}
+
+func (t *task) download(ctx context.Context) error {
+	src, err := newSource(t.state.Url, t.state.Options)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(t.folder(), 0700); err != nil {
+		return fmt.Errorf("failed to create temp folder: %w", err)
+	}
+
+	f, err := os.OpenFile(filepath.Join(t.folder(), t.state.Name), os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open temp file: %w", err)
+	}
+	defer f.Close()
+
+	if t.state.Total > 0 {
+		if err := f.Truncate(t.state.Total); err != nil {
+			return fmt.Errorf("failed to allocate temp file: %w", err)
+		}
+	}
+
+	var bucket *ratelimit.Bucket
+	if t.state.Options.SpeedLimit > 0 {
+		bucket = ratelimit.NewBucketWithRate(float64(t.state.Options.SpeedLimit), t.state.Options.SpeedLimit)
+	}
+
+	reportCtx, stopReport := context.WithCancel(ctx)
+	defer stopReport()
+	go t.report(reportCtx)
+
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+	workerCtx, cancelWorkers := context.WithCancel(ctx)
+	defer cancelWorkers()
+	for _, seg := range t.state.Segments {
+		wg.Add(1)
+		go func(seg *segment) {
+			defer wg.Done()
+			if err := t.fetch(workerCtx, src, f, seg, bucket); err != nil {
+				errOnce.Do(func() {
+					firstErr = err
+					cancelWorkers()
+				})
+			}
+		}(seg)
+	}
+	wg.Wait()
+
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	if firstErr != nil {
+		return firstErr
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to close temp file: %w", err)
+	}
+
+	t.mu.Lock()
+	if t.state.Total <= 0 {
+		t.state.Total = t.state.downloaded()
+	}
+	t.mu.Unlock()
+
+	return t.verify()
+}
+
+// fetch downloads one segment, retrying with backoff on failures.
+func (t *task) fetch(ctx context.Context, src source, f *os.File, seg *segment, bucket *ratelimit.Bucket) error {
+	for try := 1; ; try++ {
+		err := t.fetchOnce(ctx, src, f, seg, bucket)
+		if err == nil || ctx.Err() != nil {
+			return err
+		}
+
+		if try >= t.state.Options.MaxTries {
+			return err
+		}
+
+		t.l.Warning("Builtin download task %q segment at %d failed (%d/%d): %s", t.state.ID, seg.Start, try,
+			t.state.Options.MaxTries, err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(retryBaseWait * time.Duration(try)):
+		}
+	}
+}
+
+func (t *task) fetchOnce(ctx context.Context, src source, f *os.File, seg *segment, bucket *ratelimit.Bucket) error {
+	if !t.state.Resumable {
+		// Server does not support ranges, start over.
+		atomic.StoreInt64(&seg.Done, 0)
+	}
+
+	offset := seg.Start + atomic.LoadInt64(&seg.Done)
+	length := int64(-1)
+	if seg.End >= 0 {
+		length = seg.End - offset
+		if length <= 0 {
+			return nil
+		}
+	}
+
+	rc, err := src.open(ctx, offset, length)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	var r io.Reader = rc
+	if length >= 0 {
+		r = io.LimitReader(r, length)
+	}
+	if bucket != nil {
+		r = ratelimit.Reader(r, bucket)
+	}
+
+	buf := make([]byte, bufferSize)
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			if _, werr := f.WriteAt(buf[:n], offset); werr != nil {
+				return fmt.Errorf("failed to write temp file: %w", werr)
+			}
+
+			offset += int64(n)
+			atomic.AddInt64(&seg.Done, int64(n))
+		}
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if seg.End >= 0 && offset < seg.End {
+		return io.ErrUnexpectedEOF
+	}
+
+	return nil
+}
+
+// report samples download speed and persists the progress periodically.
+func (t *task) report(ctx context.Context) {
+	ticker := time.NewTicker(persistInterval)
+	defer ticker.Stop()
+
+	last := t.state.downloaded()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			current := t.state.downloaded()
+			t.mu.Lock()
+			t.speed = int64(float64(current-last) / persistInterval.Seconds())
+			t.mu.Unlock()
+			last = current
+
+			if err := t.persist(); err != nil {
+				t.l.Warning("Failed to persist state of builtin download task %q: %s", t.state.ID, err)
+			}
+		}
+	}
+}
+
+// verify checks the downloaded file against the checksum given in options.
+func (t *task) verify() error {
+	if t.state.Options.Checksum == "" {
+		return nil
+	}
+
+	algo, expected, _ := strings.Cut(t.state.Options.Checksum, "=")
+	var h hash.Hash
+	switch strings.ToLower(algo) {
+	case "md5":
+		h = md5.New()
+	case "sha-1", "sha1":
+		h = sha1.New()
+	case "sha-256", "sha256":
+		h = sha256.New()
+	case "sha-512", "sha512":
+		h = sha512.New()
+	default:
+		return fmt.Errorf("unsupported checksum algorithm %q", algo)
+	}
+
+	f, err := os.Open(filepath.Join(t.folder(), t.state.Name))
+	if err != nil {
+		return fmt.Errorf("failed to open downloaded file: %w", err)
+	}
+	defer f.Close()
+
+	if _, err := io.Copy(h, f); err != nil {
+		return fmt.Errorf("failed to calculate checksum: %w", err)
+	}
+
+	if actual := hex.EncodeToString(h.Sum(nil)); !strings.EqualFold(actual, expected) {
+		return fmt.Errorf("checksum mismatch, expected %s, got %s", expected, actual)
+	}
+
+	return nil
+}
+
+// persist writes task state into the state file atomically.
+func (t *task) persist() error {
+	t.mu.Lock()
+	snapshot := *t.state
+	snapshot.Segments = make([]*segment, len(t.state.Segments))
+	for i, seg := range t.state.Segments {
+		snapshot.Segments[i] = &segment{Start: seg.Start, End: seg.End, Done: atomic.LoadInt64(&seg.Done)}
+	}
+	t.mu.Unlock()
+
+	content, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+
+	tmp := t.stateFile() + ".tmp"
+	if err := os.WriteFile(tmp, content, 0600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, t.stateFile())
+}
+
+func (t *task) status() *downloader.TaskStatus {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	downloaded := t.state.downloaded()
+	progress := float64(0)
+	if t.state.Total > 0 {
+		progress = float64(downloaded) / float64(t.state.Total)
+	} else if t.state.Status == downloader.StatusCompleted {
+		progress = 1
+	}
+
+	return &downloader.TaskStatus{
+		SavePath:      t.folder(),
+		Name:          t.state.Name,
+		State:         t.state.Status,
+		Total:         t.state.Total,
+		Downloaded:    downloaded,
+		DownloadSpeed: t.speed,
+		ErrorMessage:  t.state.Error,
+		Files: []downloader.TaskFile{
+			{
+				Index:    0,
+				Name:     t.state.Name,
+				Size:     t.state.Total,
+				Progress: progress,
+				Selected: true,
+			},
+		},
+	}
+}
+
+func (s *taskState) downloaded() int64 {
+	var total int64
+	for _, seg := range s.Segments {
+		total += atomic.LoadInt64(&seg.Done)
+	}
+
+	return total
+}
+
+// splitSegments divides the file into at most split segments no smaller than minSize.
+func splitSegments(size int64, resumable bool, split int, minSize int64) []*segment {
+	if !resumable || size <= 0 {
+		return []*segment{{Start: 0, End: -1}}
+	}
+
+	count := int64(split)
+	if minSize > 0 && size/minSize < count {
+		count = size / minSize
+	}
+	if count < 1 {
+		count = 1
+	}
+
+	segments := make([]*segment, 0, count)
+	chunk := size / count
+	for i := int64(0); i < count; i++ {
+		end := (i + 1) * chunk
+		if i == count-1 {
+			end = size
+		}
+		segments = append(segments, &segment{Start: i * chunk, End: end})
+	}
+
+	return segments
+}
+
+func sanitizeFileName(name string) string {
+	name = strings.NewReplacer("/", "_", "\\", "_").Replace(strings.TrimSpace(name))
+	if name == "" || name == "." || name == ".." {
+		return defaultFileName
+	}
+
+	return name
+}
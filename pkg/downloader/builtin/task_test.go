@@ -0,0 +1,68 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitSegments(t *testing.T) {
+	tests := []struct {
+		name      string
+		size      int64
+		resumable bool
+		split     int
+		minSize   int64
+		expected  []*segment
+	}{
+		{
+			name:     "not resumable",
+			size:     100,
+			split:    4,
+			expected: []*segment{{Start: 0, End: -1}},
+		},
+		{
+			name:      "unknown size",
+			size:      -1,
+			resumable: true,
+			split:     4,
+			expected:  []*segment{{Start: 0, End: -1}},
+		},
+		{
+			name:      "last segment takes the remainder",
+			size:      10,
+			resumable: true,
+			split:     3,
+			expected:  []*segment{{Start: 0, End: 3}, {Start: 3, End: 6}, {Start: 6, End: 10}},
+		},
+		{
+			name:      "segments are no smaller than min size",
+			size:      100,
+			resumable: true,
+			split:     16,
+			minSize:   30,
+			expected:  []*segment{{Start: 0, End: 33}, {Start: 33, End: 66}, {Start: 66, End: 100}},
+		},
+		{
+			name:      "file smaller than min size",
+			size:      10,
+			resumable: true,
+			split:     4,
+			minSize:   20,
+			expected:  []*segment{{Start: 0, End: 10}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, splitSegments(tt.size, tt.resumable, tt.split, tt.minSize))
+		})
+	}
+}
+
+func TestSanitizeFileName(t *testing.T) {
+	a := assert.New(t)
+	a.Equal("a_b_c.txt", sanitizeFileName(" a/b\\c.txt "))
+	a.Equal(defaultFileName, sanitizeFileName(""))
+	a.Equal(defaultFileName, sanitizeFileName(".."))
+}
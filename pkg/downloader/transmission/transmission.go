@@ -0,0 +1,358 @@
+package transmission
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/downloader"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/gofrs/uuid"
+	"github.com/samber/lo"
+)
+
+const (
+	rpcPath         = "/transmission/rpc"
+	sessionIdHeader = "X-Transmission-Session-Id"
+	successResult   = "success"
+
+	// Torrent status defined in Transmission RPC spec.
+	statusStopped      = 0
+	statusCheckWait    = 1
+	statusCheck        = 2
+	statusDownloadWait = 3
+	statusDownload     = 4
+	statusSeedWait     = 5
+	statusSeed         = 6
+
+	// Local error, other errors are tracker warnings/errors which are not fatal.
+	errorLocal = 3
+)
+
+var (
+	// Options applied when adding torrents.
+	addOptions = map[string]struct{}{
+		"cookies":           {},
+		"bandwidthPriority": {},
+		"peer-limit":        {},
+	}
+	// Options applied via torrent-set after torrents are added.
+	setOptions = map[string]struct{}{
+		"downloadLimit":       {},
+		"downloadLimited":     {},
+		"uploadLimit":         {},
+		"uploadLimited":       {},
+		"seedRatioLimit":      {},
+		"seedRatioMode":       {},
+		"seedIdleLimit":       {},
+		"seedIdleMode":        {},
+		"honorsSessionLimits": {},
+	}
+
+	torrentFields = []string{
+		"id", "name", "hashString", "status", "downloadDir", "sizeWhenDone", "leftUntilDone", "rateDownload",
+		"rateUpload", "uploadedEver", "percentDone", "isFinished", "error", "errorString", "pieces", "pieceCount",
+		"files", "fileStats", "metadataPercentComplete",
+	}
+)
+
+type transmissionClient struct {
+	c        request.Client
+	settings setting.Provider
+	l        logging.Logger
+	options  *types.TransmissionSetting
+	server   string
+
+	mu        sync.Mutex
+	sessionId string
+}
+
+func NewClient(l logging.Logger, c request.Client, setting setting.Provider, options *types.TransmissionSetting) (downloader.Downloader, error) {
+	server, err := url.Parse(options.Server)
+	if err != nil {
+		return nil, fmt.Errorf("invalid transmission server URL: %w", err)
+	}
+
+	// add /transmission/rpc to the url if no path is given
+	if server.Path == "" || server.Path == "/" {
+		server.Path = rpcPath
+	}
+
+	c.Apply(request.WithLogger(l))
+	return &transmissionClient{c: c, options: options, l: l, settings: setting, server: server.String()}, nil
+}
+
+func (c *transmissionClient) CreateTask(ctx context.Context, url string, options map[string]interface{}) (*downloader.TaskHandle, error) {
+	guid, _ := uuid.NewV4()
+
+	// Generate a unique path for the task
+	base := util.RelativePath(c.options.TempPath)
+	if c.options.TempPath == "" {
+		base = util.DataPath(c.settings.TempPath(ctx))
+	}
+	path := filepath.Join(
+		base,
+		"transmission",
+		guid.String(),
+	)
+	c.l.Info("Creating Transmission task with url %q saving to %q...", url, path)
+
+	addArgs := map[string]any{
+		"filename":     url,
+		"download-dir": path,
+	}
+	setArgs := map[string]any{}
+
+	// Apply global options first, then group options
+	for _, opts := range []map[string]any{c.options.Options, options} {
+		for k, v := range opts {
+			if _, ok := addOptions[k]; ok {
+				addArgs[k] = v
+			} else if _, ok := setOptions[k]; ok {
+				setArgs[k] = v
+			}
+		}
+	}
+
+	res := &addResult{}
+	if err := c.call(ctx, "torrent-add", addArgs, res); err != nil {
+		return nil, fmt.Errorf("create task transmission failed: %w", err)
+	}
+
+	added := res.TorrentAdded
+	if added == nil {
+		added = res.TorrentDuplicate
+	}
+	if added == nil {
+		return nil, fmt.Errorf("create task transmission failed: no torrent returned")
+	}
+
+	if len(setArgs) > 0 {
+		setArgs["ids"] = []string{added.HashString}
+		if err := c.call(ctx, "torrent-set", setArgs, nil); err != nil {
+			c.l.Warning("Failed to apply options to transmission torrent %q: %s", added.HashString, err)
+		}
+	}
+
+	return &downloader.TaskHandle{
+		ID:   guid.String(),
+		Hash: added.HashString,
+	}, nil
+}
+
+func (c *transmissionClient) Info(ctx context.Context, handle *downloader.TaskHandle) (*downloader.TaskStatus, error) {
+	res := &getResult{}
+	if err := c.call(ctx, "torrent-get", map[string]any{
+		"ids":    []string{handle.Hash},
+		"fields": torrentFields,
+	}, res); err != nil {
+		return nil, fmt.Errorf("failed to get task info with hash %q: %w", handle.Hash, err)
+	}
+
+	if len(res.Torrents) == 0 {
+		return nil, fmt.Errorf("no torrent with hash %q: %w", handle.Hash, downloader.ErrTaskNotFount)
+	}
+
+	torrent := res.Torrents[0]
+	state := downloader.StatusUnknown
+	switch torrent.Status {
+	case statusStopped:
+		if torrent.PercentDone >= 1 || torrent.IsFinished {
+			state = downloader.StatusCompleted
+		} else {
+			state = downloader.StatusDownloading
+		}
+	case statusCheckWait, statusCheck, statusDownloadWait, statusDownload:
+		state = downloader.StatusDownloading
+	case statusSeedWait, statusSeed:
+		state = downloader.StatusSeeding
+	}
+
+	if torrent.Error == errorLocal {
+		state = downloader.StatusError
+	}
+
+	status := &downloader.TaskStatus{
+		Name:          torrent.Name,
+		Total:         torrent.SizeWhenDone,
+		Downloaded:    torrent.SizeWhenDone - torrent.LeftUntilDone,
+		DownloadSpeed: torrent.RateDownload,
+		Uploaded:      torrent.UploadedEver,
+		UploadSpeed:   torrent.RateUpload,
+		SavePath:      filepath.ToSlash(torrent.DownloadDir),
+		State:         state,
+		Hash:          torrent.HashString,
+		NumPieces:     torrent.PieceCount,
+		Files: lo.Map(torrent.Files, func(item File, index int) downloader.TaskFile {
+			progress := float64(0)
+			if item.Length > 0 {
+				progress = float64(item.BytesCompleted) / float64(item.Length)
+			}
+			return downloader.TaskFile{
+				Index:    index,
+				Name:     filepath.ToSlash(item.Name),
+				Size:     item.Length,
+				Progress: progress,
+				Selected: index < len(torrent.FileStats) && torrent.FileStats[index].Wanted,
+			}
+		}),
+	}
+
+	if state == downloader.StatusError {
+		status.ErrorMessage = torrent.ErrorString
+	}
+
+	// Transmission returns pieces as base64 encoded bitfield, the highest bit corresponds to the piece at index 0.
+	if torrent.Pieces != "" {
+		pieces, err := base64.StdEncoding.DecodeString(torrent.Pieces)
+		if err != nil {
+			c.l.Warning("Failed to decode pieces of transmission torrent %q: %s", torrent.HashString, err)
+		} else {
+			status.Pieces = pieces
+		}
+	}
+
+	return status, nil
+}
+
+func (c *transmissionClient) Cancel(ctx context.Context, handle *downloader.TaskHandle) error {
+	if err := c.call(ctx, "torrent-remove", map[string]any{
+		"ids":               []string{handle.Hash},
+		"delete-local-data": true,
+	}, nil); err != nil {
+		return fmt.Errorf("failed to cancel task with hash %q: %w", handle.Hash, err)
+	}
+
+	return nil
+}
+
+func (c *transmissionClient) SetFilesToDownload(ctx context.Context, handle *downloader.TaskHandle, args ...*downloader.SetFileToDownloadArgs) error {
+	wanted := make([]int, 0, len(args))
+	unwanted := make([]int, 0, len(args))
+	for _, arg := range args {
+		if arg.Download {
+			wanted = append(wanted, arg.Index)
+		} else {
+			unwanted = append(unwanted, arg.Index)
+		}
+	}
+
+	setArgs := map[string]any{
+		"ids": []string{handle.Hash},
+	}
+	if len(wanted) > 0 {
+		setArgs["files-wanted"] = wanted
+	}
+	if len(unwanted) > 0 {
+		setArgs["files-unwanted"] = unwanted
+	}
+
+	if err := c.call(ctx, "torrent-set", setArgs, nil); err != nil {
+		return fmt.Errorf("failed to set files to download: %w", err)
+	}
+
+	return nil
+}
+
+func (c *transmissionClient) Test(ctx context.Context) (string, error) {
+	res := &sessionResult{}
+	if err := c.call(ctx, "session-get", map[string]any{
+		"fields": []string{"version", "rpc-version"},
+	}, res); err != nil {
+		return "", fmt.Errorf("test transmission failed: %w", err)
+	}
+
+	return res.Version, nil
+}
+
+// call sends a RPC request and decodes the arguments of response into result if it is not nil.
+func (c *transmissionClient) call(ctx context.Context, method string, args map[string]any, result any) error {
+	body, err := json.Marshal(rpcRequest{Method: method, Arguments: args})
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := c.request(ctx, string(body), true)
+	if err != nil {
+		return err
+	}
+
+	res := &rpcResponse[json.RawMessage]{}
+	if err := json.Unmarshal([]byte(resp), res); err != nil {
+		return fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	if res.Result != successResult {
+		return fmt.Errorf("transmission returns error: %s", res.Result)
+	}
+
+	if result != nil && len(res.Arguments) > 0 {
+		if err := json.Unmarshal(res.Arguments, result); err != nil {
+			return fmt.Errorf("failed to unmarshal response arguments: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (c *transmissionClient) request(ctx context.Context, body string, retry bool) (string, error) {
+	c.mu.Lock()
+	headers := http.Header{
+		"Content-Type":  []string{"application/json"},
+		sessionIdHeader: []string{c.sessionId},
+	}
+	c.mu.Unlock()
+
+	if c.options.User != "" || c.options.Password != "" {
+		req := &http.Request{Header: http.Header{}}
+		req.SetBasicAuth(c.options.User, c.options.Password)
+		headers.Set("Authorization", req.Header.Get("Authorization"))
+	}
+
+	res := c.c.Request(http.MethodPost, c.server, strings.NewReader(body),
+		request.WithContext(ctx),
+		request.WithHeader(headers),
+	)
+	if res.Err != nil {
+		return "", fmt.Errorf("send request failed: %w", res.Err)
+	}
+
+	switch res.Response.StatusCode {
+	case http.StatusConflict:
+		// Session ID is missing or expired, Transmission returns the new one in header.
+		res.Response.Body.Close()
+		sessionId := res.Response.Header.Get(sessionIdHeader)
+		if !retry || sessionId == "" {
+			return "", fmt.Errorf("failed to obtain session ID")
+		}
+
+		c.l.Debug("Transmission session ID updated, retrying request...")
+		c.mu.Lock()
+		c.sessionId = sessionId
+		c.mu.Unlock()
+		return c.request(ctx, body, false)
+	case http.StatusUnauthorized:
+		res.Response.Body.Close()
+		return "", fmt.Errorf("unauthorized, possibly incorrect credential is provided")
+	case http.StatusOK:
+		respContent, err := res.GetResponse()
+		if err != nil {
+			return "", fmt.Errorf("failed reading response: %w", err)
+		}
+
+		return respContent, nil
+	default:
+		content, _ := res.GetResponse()
+		return "", fmt.Errorf("unexpected status code: %d, content: %s", res.Response.StatusCode, content)
+	}
+}
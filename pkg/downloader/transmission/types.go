@@ -0,0 +1,63 @@
+package transmission
+
+type rpcRequest struct {
+	Method    string         `json:"method"`
+	Arguments map[string]any `json:"arguments,omitempty"`
+}
+
+type rpcResponse[T any] struct {
+	Result    string `json:"result"`
+	Arguments T      `json:"arguments"`
+}
+
+type addedTorrent struct {
+	ID         int    `json:"id"`
+	Name       string `json:"name"`
+	HashString string `json:"hashString"`
+}
+
+type addResult struct {
+	TorrentAdded     *addedTorrent `json:"torrent-added"`
+	TorrentDuplicate *addedTorrent `json:"torrent-duplicate"`
+}
+
+type getResult struct {
+	Torrents []Torrent `json:"torrents"`
+}
+
+type sessionResult struct {
+	Version    string `json:"version"`
+	RpcVersion int    `json:"rpc-version"`
+}
+
+type Torrent struct {
+	ID             int         `json:"id"`
+	Name           string      `json:"name"`
+	HashString     string      `json:"hashString"`
+	Status         int         `json:"status"`
+	DownloadDir    string      `json:"downloadDir"`
+	SizeWhenDone   int64       `json:"sizeWhenDone"`
+	LeftUntilDone  int64       `json:"leftUntilDone"`
+	RateDownload   int64       `json:"rateDownload"`
+	RateUpload     int64       `json:"rateUpload"`
+	UploadedEver   int64       `json:"uploadedEver"`
+	PercentDone    float64     `json:"percentDone"`
+	IsFinished     bool        `json:"isFinished"`
+	Error          int         `json:"error"`
+	ErrorString    string      `json:"errorString"`
+	Pieces         string      `json:"pieces"`
+	PieceCount     int         `json:"pieceCount"`
+	Files          []File      `json:"files"`
+	FileStats      []FileStats `json:"fileStats"`
+	MetadataStatus float64     `json:"metadataPercentComplete"`
+}
+
+type File struct {
+	Name           string `json:"name"`
+	Length         int64  `json:"length"`
+	BytesCompleted int64  `json:"bytesCompleted"`
+}
+
+type FileStats struct {
+	Wanted bool `json:"wanted"`
+}
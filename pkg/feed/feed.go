@@ -0,0 +1,226 @@
+// Package feed parses RSS 2.0, RSS 1.0 (RDF) and Atom feeds into a flat list of items.
+package feed
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"golang.org/x/text/encoding/htmlindex"
+)
+
+var ErrUnknownFormat = errors.New("unknown feed format")
+
+// Item is an entry in a feed.
+type Item struct {
+	// Guid identifies the item within the feed, falls back to Link if not provided by feed.
+	Guid  string
+	Title string
+	// Link is the resource to download, enclosure is preferred over the item link.
+	Link        string
+	PublishedAt *time.Time
+}
+
+type (
+	rssItem struct {
+		Guid      string `xml:"guid"`
+		Title     string `xml:"title"`
+		Link      string `xml:"link"`
+		PubDate   string `xml:"pubDate"`
+		Date      string `xml:"http://purl.org/dc/elements/1.1/ date"`
+		Enclosure *struct {
+			Url string `xml:"url,attr"`
+		} `xml:"enclosure"`
+	}
+
+	rss struct {
+		Items []rssItem `xml:"channel>item"`
+	}
+
+	rdf struct {
+		Items []rssItem `xml:"item"`
+	}
+
+	atomLink struct {
+		Href string `xml:"href,attr"`
+		Rel  string `xml:"rel,attr"`
+	}
+
+	atomEntry struct {
+		ID        string     `xml:"id"`
+		Title     string     `xml:"title"`
+		Links     []atomLink `xml:"link"`
+		Published string     `xml:"published"`
+		Updated   string     `xml:"updated"`
+	}
+
+	atom struct {
+		Entries []atomEntry `xml:"entry"`
+	}
+)
+
+var timeLayouts = []string{
+	time.RFC1123Z,
+	time.RFC1123,
+	time.RFC3339,
+	"Mon, 2 Jan 2006 15:04:05 -0700",
+	"Mon, 2 Jan 2006 15:04:05 MST",
+	"2 Jan 2006 15:04:05 -0700",
+	"2006-01-02T15:04:05",
+}
+
+// Parse reads a feed document and returns its items in document order.
+func Parse(r io.Reader) ([]Item, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	root, err := rootElement(content)
+	if err != nil {
+		return nil, err
+	}
+
+	switch strings.ToLower(root.Local) {
+	case "rss":
+		doc := &rss{}
+		if err := unmarshal(content, doc); err != nil {
+			return nil, err
+		}
+		return fromRss(doc.Items), nil
+	case "rdf":
+		doc := &rdf{}
+		if err := unmarshal(content, doc); err != nil {
+			return nil, err
+		}
+		return fromRss(doc.Items), nil
+	case "feed":
+		doc := &atom{}
+		if err := unmarshal(content, doc); err != nil {
+			return nil, err
+		}
+		return fromAtom(doc.Entries), nil
+	default:
+		return nil, fmt.Errorf("%w: <%s>", ErrUnknownFormat, root.Local)
+	}
+}
+
+func fromRss(items []rssItem) []Item {
+	res := make([]Item, 0, len(items))
+	for _, item := range items {
+		link := strings.TrimSpace(item.Link)
+		if item.Enclosure != nil && item.Enclosure.Url != "" {
+			link = strings.TrimSpace(item.Enclosure.Url)
+		}
+
+		guid := strings.TrimSpace(item.Guid)
+		if guid == "" {
+			guid = link
+		}
+
+		date := item.PubDate
+		if date == "" {
+			date = item.Date
+		}
+
+		res = append(res, Item{
+			Guid:        guid,
+			Title:       strings.TrimSpace(item.Title),
+			Link:        link,
+			PublishedAt: parseTime(date),
+		})
+	}
+
+	return res
+}
+
+func fromAtom(entries []atomEntry) []Item {
+	res := make([]Item, 0, len(entries))
+	for _, entry := range entries {
+		link := ""
+		for _, l := range entry.Links {
+			switch l.Rel {
+			case "enclosure":
+				link = l.Href
+			case "", "alternate":
+				if link == "" {
+					link = l.Href
+				}
+			}
+		}
+
+		guid := strings.TrimSpace(entry.ID)
+		if guid == "" {
+			guid = link
+		}
+
+		date := entry.Published
+		if date == "" {
+			date = entry.Updated
+		}
+
+		res = append(res, Item{
+			Guid:        guid,
+			Title:       strings.TrimSpace(entry.Title),
+			Link:        strings.TrimSpace(link),
+			PublishedAt: parseTime(date),
+		})
+	}
+
+	return res
+}
+
+func rootElement(content []byte) (xml.Name, error) {
+	decoder := newDecoder(content)
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return xml.Name{}, fmt.Errorf("%w: %s", ErrUnknownFormat, err)
+		}
+
+		if start, ok := token.(xml.StartElement); ok {
+			return start.Name, nil
+		}
+	}
+}
+
+func unmarshal(content []byte, v any) error {
+	if err := newDecoder(content).Decode(v); err != nil {
+		return fmt.Errorf("failed to parse feed: %w", err)
+	}
+
+	return nil
+}
+
+func newDecoder(content []byte) *xml.Decoder {
+	decoder := xml.NewDecoder(bytes.NewReader(content))
+	decoder.Strict = false
+	decoder.CharsetReader = func(label string, input io.Reader) (io.Reader, error) {
+		enc, err := htmlindex.Get(label)
+		if err != nil {
+			return nil, err
+		}
+
+		return enc.NewDecoder().Reader(input), nil
+	}
+	return decoder
+}
+
+func parseTime(s string) *time.Time {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil
+	}
+
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return &t
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package feed
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseRss(t *testing.T) {
+	src := `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0">
+<channel>
+<title>Podcast</title>
+<item>
+<title> Episode 2 </title>
+<link>https://example.com/2</link>
+<guid>ep-2</guid>
+<pubDate>Tue, 10 Jun 2025 04:00:00 GMT</pubDate>
+<enclosure url="https://example.com/2.mp3" length="1" type="audio/mpeg"/>
+</item>
+<item>
+<title>Episode 1</title>
+<link>magnet:?xt=urn:btih:abc</link>
+</item>
+</channel>
+</rss>`
+
+	items, err := Parse(strings.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 2 {
+		t.Fatalf("expect 2 items, got %d", len(items))
+	}
+
+	if items[0].Guid != "ep-2" || items[0].Title != "Episode 2" || items[0].Link != "https://example.com/2.mp3" {
+		t.Errorf("unexpected item %+v", items[0])
+	}
+
+	if items[0].PublishedAt == nil || items[0].PublishedAt.Year() != 2025 {
+		t.Errorf("unexpected publish time %v", items[0].PublishedAt)
+	}
+
+	if items[1].Guid != "magnet:?xt=urn:btih:abc" || items[1].PublishedAt != nil {
+		t.Errorf("unexpected item %+v", items[1])
+	}
+}
+
+func TestParseAtom(t *testing.T) {
+	src := `<?xml version="1.0" encoding="utf-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom">
+<title>Releases</title>
+<entry>
+<id>tag:example.com,2025:1</id>
+<title>v1.0</title>
+<link rel="alternate" href="https://example.com/v1.0"/>
+<link rel="enclosure" href="https://example.com/v1.0.tar.gz"/>
+<updated>2025-06-10T04:00:00Z</updated>
+</entry>
+</feed>`
+
+	items, err := Parse(strings.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expect 1 item, got %d", len(items))
+	}
+
+	if items[0].Guid != "tag:example.com,2025:1" || items[0].Link != "https://example.com/v1.0.tar.gz" || items[0].PublishedAt == nil {
+		t.Errorf("unexpected item %+v", items[0])
+	}
+}
+
+func TestParseUnknown(t *testing.T) {
+	if _, err := Parse(strings.NewReader(`<html><body></body></html>`)); err == nil {
+		t.Error("expect error for non-feed document")
+	}
+}
@@ -118,6 +118,7 @@ const (
 	MediaTypeMusic      MetaType = "music"
 	MetaTypeStreamMedia MetaType = "stream"
 	MetaTypeGeocoding   MetaType = "geocoding"
+	MetaTypeDocument    MetaType = "document"
 )
 
 type ForceUsePublicEndpointCtx struct{}
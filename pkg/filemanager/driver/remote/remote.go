@@ -98,7 +98,12 @@ func (handler *Driver) Thumb(ctx context.Context, expire *time.Time, ext string,
 		return "", fmt.Errorf("parse server url failed: %w", err)
 	}
 
-	thumbURL := routes.SlaveThumbUrl(serverURL, e.Source(), ext)
+	size := ""
+	if variant := setting.ThumbVariantFromContext(ctx); variant != setting.ThumbVariantMedium {
+		size = string(variant)
+	}
+
+	thumbURL := routes.SlaveThumbUrl(serverURL, e.Source(), ext, size)
 	signedThumbURL, err := auth.SignURI(ctx, handler.AuthInstance, thumbURL.String(), expire)
 	if err != nil {
 		return "", err
@@ -23,6 +23,8 @@ const (
 var (
 	// ErrEventHubClosed is returned when operations are attempted on a closed EventHub.
 	ErrEventHubClosed = errors.New("event hub is closed")
+	// ErrSubscriberNotFound is returned when the requested subscriber does not exist or is not available.
+	ErrSubscriberNotFound = errors.New("subscriber not found")
 )
 
 // eventState tracks the accumulated state for each file
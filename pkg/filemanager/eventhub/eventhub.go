@@ -7,6 +7,7 @@ import (
 
 	"github.com/cloudreve/Cloudreve/v4/inventory"
 	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/samber/lo"
 )
 
 type (
@@ -21,6 +22,16 @@ type (
 		Unsubscribe(ctx context.Context, topic int, id string)
 		// Get subscribers of a topic.
 		GetSubscribers(ctx context.Context, topic int) []Subscriber
+		// Take returns and clears debounced events of an offline subscriber owned by the user in ctx,
+		// including those persisted in inventory. ErrSubscriberNotFound is returned if no such
+		// subscriber exists, e.g. it has expired or the hub was restarted.
+		Take(ctx context.Context, topic int, id string) ([]*Event, error)
+		// Remove permanently removes a subscriber along with its buffered events.
+		Remove(ctx context.Context, topic int, id string)
+		// Reserve creates an offline subscriber of a topic for the user in ctx, whose events are kept
+		// until taken. At most max reserved subscribers are kept for each user and topic, the oldest
+		// ones are removed once exceeded.
+		Reserve(ctx context.Context, topic int, id string, max int) error
 		// Close shuts down the event hub and disconnects all subscribers.
 		Close()
 	}
@@ -34,6 +45,7 @@ const (
 type eventHub struct {
 	mu            sync.RWMutex
 	topics        map[int]map[string]*subscriber
+	reserved      map[reservedKey][]string
 	userClient    inventory.UserClient
 	fsEventClient inventory.FsEventClient
 	closed        bool
@@ -41,9 +53,17 @@ type eventHub struct {
 	wg            sync.WaitGroup
 }
 
+// reservedKey groups reserved subscribers by topic and owner, IDs in each group are ordered by
+// creation time.
+type reservedKey struct {
+	topic int
+	uid   int
+}
+
 func NewEventHub(userClient inventory.UserClient, fsEventClient inventory.FsEventClient) EventHub {
 	e := &eventHub{
 		topics:        make(map[int]map[string]*subscriber),
+		reserved:      make(map[reservedKey][]string),
 		userClient:    userClient,
 		fsEventClient: fsEventClient,
 		closeCh:       make(chan struct{}),
@@ -88,13 +108,9 @@ func (e *eventHub) cleanupExpiredSubscribers() {
 	for topic, subs := range e.topics {
 		for id, sub := range subs {
 			if sub.shouldExpire() {
-				sub.close()
-				delete(subs, id)
+				e.removeLocked(topic, id)
 			}
 		}
-		if len(subs) == 0 {
-			delete(e.topics, topic)
-		}
 	}
 }
 
@@ -172,6 +188,92 @@ func (e *eventHub) Unsubscribe(ctx context.Context, topic int, id string) {
 	}
 }
 
+func (e *eventHub) Take(ctx context.Context, topic int, id string) ([]*Event, error) {
+	e.mu.RLock()
+	if e.closed {
+		e.mu.RUnlock()
+		return nil, ErrEventHubClosed
+	}
+
+	sub, ok := e.topics[topic][id]
+	e.mu.RUnlock()
+	if !ok {
+		return nil, ErrSubscriberNotFound
+	}
+
+	return sub.take(ctx)
+}
+
+func (e *eventHub) Remove(ctx context.Context, topic int, id string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.closed {
+		return
+	}
+
+	e.removeLocked(topic, id)
+}
+
+func (e *eventHub) Reserve(ctx context.Context, topic int, id string, max int) error {
+	sub, err := newSubscriber(ctx, id, e.userClient, e.fsEventClient)
+	if err != nil {
+		return err
+	}
+
+	sub.online = false
+	sub.offlineSince = time.Now()
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.closed {
+		return ErrEventHubClosed
+	}
+
+	if _, ok := e.topics[topic][id]; ok {
+		e.removeLocked(topic, id)
+	}
+
+	subs, ok := e.topics[topic]
+	if !ok {
+		subs = make(map[string]*subscriber)
+		e.topics[topic] = subs
+	}
+
+	subs[id] = sub
+	key := reservedKey{topic: topic, uid: sub.uid}
+	e.reserved[key] = append(e.reserved[key], id)
+	for len(e.reserved[key]) > max {
+		e.removeLocked(topic, e.reserved[key][0])
+	}
+
+	return nil
+}
+
+// removeLocked closes and removes a subscriber. Caller must hold e.mu.
+func (e *eventHub) removeLocked(topic int, id string) {
+	subs, ok := e.topics[topic]
+	if !ok {
+		return
+	}
+
+	if sub, ok := subs[id]; ok {
+		sub.close()
+		delete(subs, id)
+
+		key := reservedKey{topic: topic, uid: sub.uid}
+		if ids := lo.Without(e.reserved[key], id); len(ids) > 0 {
+			e.reserved[key] = ids
+		} else {
+			delete(e.reserved, key)
+		}
+	}
+	if len(subs) == 0 {
+		delete(e.topics, topic)
+	}
+}
+
 // Close shuts down the event hub and disconnects all subscribers.
 func (e *eventHub) Close() {
 	e.mu.Lock()
@@ -191,6 +293,7 @@ func (e *eventHub) Close() {
 		}
 	}
 	e.topics = nil
+	e.reserved = nil
 
 	e.mu.Unlock()
 
@@ -0,0 +1,152 @@
+package eventhub
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// memoryFsEventClient keeps persisted events in memory.
+type memoryFsEventClient struct {
+	mu     sync.Mutex
+	events []*ent.FsEvent
+}
+
+func (c *memoryFsEventClient) SetClient(newClient *ent.Client) inventory.TxOperator {
+	return c
+}
+
+func (c *memoryFsEventClient) GetClient() *ent.Client {
+	return nil
+}
+
+func (c *memoryFsEventClient) Create(ctx context.Context, uid int, subscriberId uuid.UUID, events ...string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, e := range events {
+		c.events = append(c.events, &ent.FsEvent{Event: e, Subscriber: subscriberId, UserFsevent: uid})
+	}
+	return nil
+}
+
+func (c *memoryFsEventClient) DeleteBySubscriber(ctx context.Context, subscriberId uuid.UUID) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	kept := c.events[:0]
+	for _, e := range c.events {
+		if e.Subscriber != subscriberId {
+			kept = append(kept, e)
+		}
+	}
+	c.events = kept
+	return nil
+}
+
+func (c *memoryFsEventClient) DeleteAll(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.events = nil
+	return nil
+}
+
+func (c *memoryFsEventClient) TakeBySubscriber(ctx context.Context, subscriberId uuid.UUID, userId int) ([]*ent.FsEvent, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var taken, kept []*ent.FsEvent
+	for _, e := range c.events {
+		if e.Subscriber == subscriberId && e.UserFsevent == userId {
+			taken = append(taken, e)
+		} else {
+			kept = append(kept, e)
+		}
+	}
+	c.events = kept
+	return taken, nil
+}
+
+func userContext(uid int) context.Context {
+	return context.WithValue(context.Background(), inventory.UserCtx{}, &ent.User{ID: uid})
+}
+
+func newID() string {
+	return uuid.Must(uuid.NewV4()).String()
+}
+
+func TestEventHub_Reserve(t *testing.T) {
+	a := assert.New(t)
+	hub := NewEventHub(nil, &memoryFsEventClient{})
+	defer hub.Close()
+
+	ctx := userContext(1)
+	ids := []string{newID(), newID(), newID()}
+	for _, id := range ids {
+		a.NoError(hub.Reserve(ctx, 1, id, 2))
+	}
+
+	// Oldest subscriber is evicted
+	_, err := hub.Take(ctx, 1, ids[0])
+	a.ErrorIs(err, ErrSubscriberNotFound)
+	for _, id := range ids[1:] {
+		events, err := hub.Take(ctx, 1, id)
+		a.NoError(err)
+		a.Empty(events)
+	}
+
+	// Reservations of other users and topics are counted separately
+	other := newID()
+	a.NoError(hub.Reserve(userContext(2), 1, other, 2))
+	a.NoError(hub.Reserve(ctx, 2, newID(), 2))
+	_, err = hub.Take(userContext(2), 1, other)
+	a.NoError(err)
+	_, err = hub.Take(ctx, 1, ids[1])
+	a.NoError(err)
+
+	// Removed subscribers do not count
+	hub.Remove(ctx, 1, ids[1])
+	a.NoError(hub.Reserve(ctx, 1, newID(), 2))
+	_, err = hub.Take(ctx, 1, ids[2])
+	a.NoError(err)
+
+	// Anonymous user cannot reserve
+	a.Error(hub.Reserve(context.Background(), 1, newID(), 2))
+}
+
+func TestEventHub_Take(t *testing.T) {
+	a := assert.New(t)
+	hub := NewEventHub(nil, &memoryFsEventClient{})
+	defer hub.Close()
+
+	ctx := userContext(1)
+	id := newID()
+	a.NoError(hub.Reserve(ctx, 1, id, 2))
+
+	subs := hub.GetSubscribers(ctx, 1)
+	a.Len(subs, 1)
+	subs[0].Publish(Event{Type: EventTypeCreate, FileID: "a", From: "/a"})
+	subs[0].Publish(Event{Type: EventTypeModify, FileID: "a", From: "/a"})
+
+	// Subscriber of another user is not visible
+	_, err := hub.Take(userContext(2), 1, id)
+	a.ErrorIs(err, ErrSubscriberNotFound)
+
+	events, err := hub.Take(ctx, 1, id)
+	a.NoError(err)
+	a.Equal([]*Event{{Type: EventTypeCreate, FileID: "a", From: "/a"}}, events)
+
+	events, err = hub.Take(ctx, 1, id)
+	a.NoError(err)
+	a.Empty(events)
+
+	hub.Remove(ctx, 1, id)
+	_, err = hub.Take(ctx, 1, id)
+	a.ErrorIs(err, ErrSubscriberNotFound)
+}
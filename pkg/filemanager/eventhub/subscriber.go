@@ -262,6 +262,45 @@ func (s *subscriber) setOffline() {
 	s.flushLocked(context.Background())
 }
 
+// take returns and clears all pending events of an offline subscriber, including those persisted
+// in inventory. Events are debounced before returned.
+func (s *subscriber) take(ctx context.Context) ([]*Event, error) {
+	l := logging.FromContext(ctx)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Events of online subscribers are delivered through the channel.
+	user := inventory.UserFromContext(ctx)
+	if s.closed || s.online || user == nil || user.ID != s.uid {
+		return nil, ErrSubscriberNotFound
+	}
+
+	if s.timer != nil {
+		s.timer.Stop()
+		s.timer = nil
+	}
+
+	// Persisted events are older than the buffered ones.
+	persisted, err := s.fsEventClient.TakeBySubscriber(ctx, uuid.FromStringOrNil(s.id), s.uid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get events from inventory: %w", err)
+	}
+
+	events := make([]*Event, 0, len(persisted)+len(s.buffer))
+	for _, event := range persisted {
+		var eventParsed Event
+		if err := json.Unmarshal([]byte(event.Event), &eventParsed); err != nil {
+			l.Error("Failed to unmarshal event: %s", err)
+			continue
+		}
+		events = append(events, &eventParsed)
+	}
+
+	events = append(events, s.buffer...)
+	s.buffer = nil
+	return DebounceEvents(events), nil
+}
+
 // close permanently closes the subscriber.
 func (s *subscriber) close() {
 	s.mu.Lock()
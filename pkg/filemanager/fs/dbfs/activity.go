@@ -0,0 +1,24 @@
+package dbfs
+
+import (
+	"context"
+
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+)
+
+func (f *DBFS) RecordActivity(ctx context.Context, file fs.File, action types.FileActivityType) {
+	// Only activities on files owned by current user are recorded.
+	if file == nil || file.IsNil() || inventory.IsAnonymousUser(f.user) || file.OwnerID() != f.user.ID ||
+		file.Type() != types.FileTypeFile {
+		return
+	}
+
+	// Join transaction in context if any, otherwise recording might be blocked by it.
+	fac, _ := inventory.InheritTx(ctx, f.fileActivityClient)
+	dedup := f.settingClient.DBFS(ctx).RecentFilesDedup
+	if err := fac.Record(ctx, f.user.ID, file.ID(), action, dedup); err != nil {
+		f.l.Warning("Failed to record activity on file %d: %s", file.ID(), err)
+	}
+}
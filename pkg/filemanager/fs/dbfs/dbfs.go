@@ -35,22 +35,39 @@ const (
 
 	folderSummaryCachePrefix = "folder_summary_"
 	defaultPageSize          = 100
+
+	// UsageChangedFoldersPrefix stores IDs of folders whose direct children are changed since the
+	// storage usage report of a user is updated, 0 stands for files without parent.
+	UsageChangedFoldersPrefix = "usage_changed_folders_"
+	// UsageRescanPendingPrefix marks that changes of a user cannot be applied to the storage usage
+	// report folder by folder, the report will be rebuilt by a full rescan.
+	UsageRescanPendingPrefix = "usage_rescan_pending_"
+	UsageRescanPendingTTL    = 7 * 24 * 3600 // 7 days
+	// usageMaxChangedFolders is the max number of changed folders kept for a user, a full rescan is
+	// requested once exceeded.
+	usageMaxChangedFolders = 1000
 )
 
 type (
 	ContextHintCtxKey      struct{}
 	ByPassOwnerCheckCtxKey struct{}
+	// SkipCapacityCheckCtxKey skips capacity check when restoring an entity, used when the
+	// restored entity replaces one of the same size.
+	SkipCapacityCheckCtxKey struct{}
 )
 
 func NewDatabaseFS(u *ent.User, fileClient inventory.FileClient, shareClient inventory.ShareClient,
-	l logging.Logger, ls lock.LockSystem, settingClient setting.Provider,
+	snapshotClient inventory.SnapshotClient, fileActivityClient inventory.FileActivityClient, l logging.Logger, ls lock.LockSystem, settingClient setting.Provider,
 	storagePolicyClient inventory.StoragePolicyClient, hasher hashid.Encoder, userClient inventory.UserClient,
-	cache, stateKv cache.Driver, directLinkClient inventory.DirectLinkClient, encryptorFactory encrypt.CryptorFactory, eventHub eventhub.EventHub) fs.FileSystem {
+	cache, stateKv cache.Driver, directLinkClient inventory.DirectLinkClient, encryptorFactory encrypt.CryptorFactory, eventHub eventhub.EventHub,
+	albumClient inventory.AlbumClient) fs.FileSystem {
 	return &DBFS{
 		user:                u,
 		navigators:          make(map[string]Navigator),
 		fileClient:          fileClient,
 		shareClient:         shareClient,
+		snapshotClient:      snapshotClient,
+		fileActivityClient:  fileActivityClient,
 		l:                   l,
 		ls:                  ls,
 		settingClient:       settingClient,
@@ -62,6 +79,7 @@ func NewDatabaseFS(u *ent.User, fileClient inventory.FileClient, shareClient inv
 		directLinkClient:    directLinkClient,
 		encryptorFactory:    encryptorFactory,
 		eventHub:            eventHub,
+		albumClient:         albumClient,
 	}
 }
 
@@ -72,7 +90,10 @@ type DBFS struct {
 	userClient          inventory.UserClient
 	storagePolicyClient inventory.StoragePolicyClient
 	shareClient         inventory.ShareClient
+	snapshotClient      inventory.SnapshotClient
+	fileActivityClient  inventory.FileActivityClient
 	directLinkClient    inventory.DirectLinkClient
+	albumClient         inventory.AlbumClient
 	l                   logging.Logger
 	ls                  lock.LockSystem
 	settingClient       setting.Provider
@@ -433,6 +454,8 @@ func (f *DBFS) Get(ctx context.Context, path *fs.URI, opts ...fs.Option) (fs.Fil
 			target.FileExtendedInfo.Shares = target.Model.Edges.Shares
 			if target.Model.Props != nil {
 				target.FileExtendedInfo.View = target.Model.Props.View
+				target.FileExtendedInfo.VersionRetention = target.Model.Props.VersionRetention
+				target.FileExtendedInfo.Snapshot = target.Model.Props.Snapshot
 			}
 		}
 
@@ -648,6 +671,7 @@ func (f *DBFS) createFile(ctx context.Context, parent *File, name string, fileTy
 	file.SetEntities([]*ent.Entity{entity})
 	newFile := newFile(parent, file)
 	f.emitFileCreated(ctx, newFile)
+	f.RecordActivity(ctx, newFile, types.FileActivityModify)
 	return newFile, nil
 }
 
@@ -732,11 +756,19 @@ func (f *DBFS) getNavigator(ctx context.Context, path *fs.URI, requiredCapabilit
 		case constants.FileSystemMy:
 			n = NewMyNavigator(f.user, f.fileClient, f.userClient, f.l, config, f.hasher)
 		case constants.FileSystemShare:
-			n = NewShareNavigator(f.user, f.fileClient, f.shareClient, f.l, config, f.hasher)
+			n = NewShareNavigator(f.user, f.fileClient, f.shareClient, f.albumClient, f.l, config, f.hasher)
 		case constants.FileSystemTrash:
 			n = NewTrashNavigator(f.user, f.fileClient, f.l, config, f.hasher)
 		case constants.FileSystemSharedWithMe:
 			n = NewSharedWithMeNavigator(f.user, f.fileClient, f.l, config, f.hasher)
+		case constants.FileSystemSnapshot:
+			n = NewSnapshotNavigator(f.user, f.fileClient, f.snapshotClient, f.l, config, f.hasher)
+		case constants.FileSystemSearch:
+			n = NewSearchNavigator(f.user, f.fileClient, f.userClient, f.l, config, f.hasher)
+		case constants.FileSystemRecent:
+			n = NewRecentNavigator(f.user, f.fileClient, f.fileActivityClient, f.l, config, f.hasher)
+		case constants.FileSystemPhotos:
+			n = NewPhotosNavigator(f.user, f.fileClient, f.albumClient, f.l, config, f.hasher, f.cache)
 		default:
 			return nil, fmt.Errorf("unknown file system %q", pathFs)
 		}
@@ -2,17 +2,30 @@ package dbfs
 
 import (
 	"context"
+	"encoding/gob"
+	"fmt"
 	"path"
+	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/cloudreve/Cloudreve/v4/pkg/auth/requestinfo"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cache"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/eventhub"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
 	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
 	"github.com/samber/lo"
 )
 
+// usageChangesMu guards read-modify-write of changed folders in KV.
+var usageChangesMu sync.Mutex
+
+func init() {
+	gob.Register(map[int]bool{})
+}
+
 func (f *DBFS) emitFileCreated(ctx context.Context, file *File) {
+	f.markUsageChanged(file.OwnerID(), file.Model.FileChildren)
 	subscribers := f.getEligibleSubscriber(ctx, file, true)
 	for _, subscriber := range subscribers {
 		subscriber.Publish(eventhub.Event{
@@ -24,6 +37,7 @@ func (f *DBFS) emitFileCreated(ctx context.Context, file *File) {
 }
 
 func (f *DBFS) emitFileModified(ctx context.Context, file *File) {
+	f.markUsageChanged(file.OwnerID(), file.Model.FileChildren)
 	subscribers := f.getEligibleSubscriber(ctx, file, true)
 	for _, subscriber := range subscribers {
 		subscriber.Publish(eventhub.Event{
@@ -35,6 +49,7 @@ func (f *DBFS) emitFileModified(ctx context.Context, file *File) {
 }
 
 func (f *DBFS) emitFileRenamed(ctx context.Context, file *File, newName string) {
+	f.markUsageChanged(file.OwnerID(), file.Model.FileChildren)
 	subscribers := f.getEligibleSubscriber(ctx, file, true)
 	for _, subscriber := range subscribers {
 		from := subscriber.relativePath(file)
@@ -50,6 +65,8 @@ func (f *DBFS) emitFileRenamed(ctx context.Context, file *File, newName string)
 
 func (f *DBFS) emitFileDeleted(ctx context.Context, files ...*File) {
 	for _, file := range files {
+		// Deleted files are either moved to trash bin without parent, or removed permanently.
+		f.markUsageChanged(file.OwnerID(), file.Model.FileChildren, 0)
 		subscribers := f.getEligibleSubscriber(ctx, file, true)
 		for _, subscriber := range subscribers {
 			subscriber.Publish(eventhub.Event{
@@ -62,6 +79,8 @@ func (f *DBFS) emitFileDeleted(ctx context.Context, files ...*File) {
 }
 
 func (f *DBFS) emitFileMoved(ctx context.Context, src, dst *File) {
+	f.markUsageChanged(src.OwnerID(), src.Model.FileChildren)
+	f.markUsageChanged(dst.OwnerID(), dst.Model.ID)
 	srcSubMap := lo.SliceToMap(f.getEligibleSubscriber(ctx, src, true), func(subscriber foundSubscriber) (string, *foundSubscriber) {
 		return subscriber.ID(), &subscriber
 	})
@@ -101,6 +120,49 @@ func (f *DBFS) emitFileMoved(ctx context.Context, src, dst *File) {
 
 }
 
+// markUsageChanged records folders whose direct children are changed, so that only these folders
+// are recounted when storage usage report of given user is updated.
+func (f *DBFS) markUsageChanged(uid int, folders ...int) {
+	usageChangesMu.Lock()
+	defer usageChangesMu.Unlock()
+
+	if _, ok := f.cache.Get(fmt.Sprintf("%s%d", UsageRescanPendingPrefix, uid)); ok {
+		return
+	}
+
+	key := fmt.Sprintf("%s%d", UsageChangedFoldersPrefix, uid)
+	changed := make(map[int]bool)
+	if v, ok := f.cache.Get(key); ok {
+		changed = lo.Assign(v.(map[int]bool))
+	}
+
+	for _, folder := range folders {
+		changed[folder] = true
+	}
+
+	if len(changed) > usageMaxChangedFolders {
+		_ = f.cache.Set(fmt.Sprintf("%s%d", UsageRescanPendingPrefix, uid), true, UsageRescanPendingTTL)
+		_ = f.cache.Delete(UsageChangedFoldersPrefix, strconv.Itoa(uid))
+		return
+	}
+
+	_ = f.cache.Set(key, changed, UsageRescanPendingTTL)
+}
+
+// TakeUsageChanges returns and clears IDs of folders changed since the last call for given user.
+func TakeUsageChanges(kv cache.Driver, uid int) []int {
+	usageChangesMu.Lock()
+	defer usageChangesMu.Unlock()
+
+	v, ok := kv.Get(fmt.Sprintf("%s%d", UsageChangedFoldersPrefix, uid))
+	if !ok {
+		return nil
+	}
+
+	_ = kv.Delete(UsageChangedFoldersPrefix, strconv.Itoa(uid))
+	return lo.Keys(v.(map[int]bool))
+}
+
 func (f *DBFS) getEligibleSubscriber(ctx context.Context, file *File, checkParentPerm bool) []foundSubscriber {
 	roots := file.Ancestors()
 	if !checkParentPerm {
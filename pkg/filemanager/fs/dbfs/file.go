@@ -11,6 +11,7 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
 	"github.com/cloudreve/Cloudreve/v4/pkg/boolset"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
 	"github.com/cloudreve/Cloudreve/v4/pkg/util"
 	"github.com/samber/lo"
 )
@@ -35,8 +36,19 @@ var (
 		View:      "grid",
 		Thumbnail: true,
 	}
+	// ThumbDisabledKeys are metadata keys marking thumbnails of all size variants as not available.
+	ThumbDisabledKeys = []string{
+		ThumbDisabledKey,
+		ThumbDisabledKeyOf(setting.ThumbVariantSmall),
+		ThumbDisabledKeyOf(setting.ThumbVariantLarge),
+	}
 )
 
+// ThumbDisabledKeyOf returns the metadata key marking thumbnail of given size variant as not available.
+func ThumbDisabledKeyOf(variant setting.ThumbVariant) string {
+	return ThumbDisabledKey + variant.Suffix()
+}
+
 type (
 	File struct {
 		Model             *ent.File
@@ -214,6 +226,28 @@ func (f *File) View() *types.ExplorerView {
 	return defaultView
 }
 
+// VersionRetention returns the version retention schedule of the file, can be inherited from parent.
+func (f *File) VersionRetention() *types.VersionRetentionSchedule {
+	current := f
+	for current != nil {
+		if current.Model.Props != nil && current.Model.Props.VersionRetention != nil {
+			return current.Model.Props.VersionRetention
+		}
+		current = current.Parent
+	}
+
+	return nil
+}
+
+// LastOpened returns when the file is last opened by the user, only available in recent files.
+func (f *File) LastOpened() *time.Time {
+	if len(f.Model.Edges.Activities) > 0 {
+		return f.Model.Edges.Activities[0].OpenedAt
+	}
+
+	return nil
+}
+
 // UserRoot return the root file from user's view.
 func (f *File) UserRoot() *File {
 	root := f
@@ -216,7 +216,7 @@ func (f *DBFS) Rename(ctx context.Context, path *fs.URI, newName string) (fs.Fil
 	}
 
 	if target.Type() == types.FileTypeFile && !strings.EqualFold(filepath.Ext(newName), filepath.Ext(oldName)) {
-		if err := fc.RemoveMetadata(ctx, target.Model, ThumbDisabledKey); err != nil {
+		if err := fc.RemoveMetadata(ctx, target.Model, ThumbDisabledKeys...); err != nil {
 			_ = inventory.Rollback(tx)
 			return nil, serializer.NewError(serializer.CodeDBError, "failed to remove disabled thumbnail mark", err)
 		}
@@ -227,6 +227,7 @@ func (f *DBFS) Rename(ctx context.Context, path *fs.URI, newName string) (fs.Fil
 	}
 
 	f.emitFileRenamed(ctx, target, newName)
+	f.RecordActivity(ctx, target, types.FileActivityModify)
 
 	return target.Replace(updated), nil
 }
@@ -281,6 +282,7 @@ func (f *DBFS) SoftDelete(ctx context.Context, path ...*fs.URI) error {
 	if err != nil {
 		return serializer.NewError(serializer.CodeDBError, "Failed to start transaction", err)
 	}
+	fac, _ := inventory.InheritTx(ctx, f.fileActivityClient)
 
 	for _, target := range targets {
 		// Perform soft-delete
@@ -299,6 +301,12 @@ func (f *DBFS) SoftDelete(ctx context.Context, path ...*fs.URI) error {
 			_ = inventory.Rollback(tx)
 			return serializer.NewError(serializer.CodeDBError, "failed to update metadata", err)
 		}
+
+		// Trashed files no longer appear in recent files.
+		if err := fac.DeleteInTree(ctx, target.ID()); err != nil {
+			_ = inventory.Rollback(tx)
+			return serializer.NewError(serializer.CodeDBError, "failed to delete file activities", err)
+		}
 	}
 
 	// Commit transaction
@@ -440,6 +448,109 @@ func (f *DBFS) VersionControl(ctx context.Context, path *fs.URI, versionId int,
 	}
 }
 
+func (f *DBFS) RestoreEntity(ctx context.Context, path *fs.URI, entityID int) (fs.File, error) {
+	// Get navigator
+	navigator, err := f.getNavigator(ctx, path, NavigatorCapabilityVersionControl)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create an empty file if target not exist.
+	if _, err := f.Create(ctx, path, types.FileTypeFile); err != nil {
+		return nil, err
+	}
+
+	// Get target file
+	ctx = context.WithValue(ctx, inventory.LoadFileEntity{}, true)
+	target, err := f.getFileByPath(ctx, navigator, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get target file: %w", err)
+	}
+
+	if _, ok := ctx.Value(ByPassOwnerCheckCtxKey{}).(bool); !ok && target.Owner().ID != f.user.ID {
+		return nil, fs.ErrOwnerOnly
+	}
+
+	if target.PrimaryEntityID() == entityID {
+		return target, nil
+	}
+
+	// Lock file
+	ls, err := f.acquireByPath(ctx, -1, f.user, true, fs.LockApp(fs.ApplicationVersionControl),
+		&LockByPath{target.Uri(true), target, target.Type(), ""})
+	defer func() { _ = f.Release(ctx, ls) }()
+	if err != nil {
+		return nil, err
+	}
+
+	// Entity is already a version of target file, switch to it.
+	if _, found := lo.Find(target.Entities(), func(item fs.Entity) bool {
+		return item.ID() == entityID
+	}); found {
+		return target, f.setCurrentVersion(ctx, target, entityID)
+	}
+
+	entity, err := f.fileClient.GetEntityByID(ctx, entityID)
+	if err != nil {
+		return nil, fs.ErrEntityNotExist.WithError(err)
+	}
+
+	if entity.Type != int(types.EntityTypeVersion) || entity.UploadSessionID != nil {
+		return nil, fs.ErrEntityNotExist.WithError(fmt.Errorf("entity %d is not a valid version", entityID))
+	}
+
+	if _, ok := ctx.Value(SkipCapacityCheckCtxKey{}).(bool); !ok {
+		if err := f.validateUserCapacity(ctx, entity.Size, target.Owner()); err != nil {
+			return nil, err
+		}
+	}
+
+	fc, tx, ctx, err := inventory.WithTx(ctx, f.fileClient)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to start transaction", err)
+	}
+
+	diff, err := fc.LinkEntity(ctx, entity, target.Model, target.Owner())
+	if err != nil {
+		_ = inventory.Rollback(tx)
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to link entity", err)
+	}
+
+	tx.AppendStorageDiff(diff)
+	if err := fc.SetPrimaryEntity(ctx, target.Model, entity); err != nil {
+		_ = inventory.Rollback(tx)
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to set primary entity", err)
+	}
+
+	// Cap thumbnail, transcode and storyboard entities
+	for _, t := range []types.EntityType{types.EntityTypeThumbnail, types.EntityTypeThumbnailSmall, types.EntityTypeThumbnailLarge} {
+		diff, err = fc.CapEntities(ctx, target.Model, target.Owner(), 0, t)
+		if err != nil {
+			_ = inventory.Rollback(tx)
+			return nil, serializer.NewError(serializer.CodeDBError, "Failed to cap thumbnail entities", err)
+		}
+
+		tx.AppendStorageDiff(diff)
+	}
+
+	for _, t := range []types.EntityType{types.EntityTypeTranscode, types.EntityTypeStoryboard} {
+		diff, err = fc.CapEntities(ctx, target.Model, target.Owner(), 0, t)
+		if err != nil {
+			_ = inventory.Rollback(tx)
+			return nil, serializer.NewError(serializer.CodeDBError, "Failed to cap derived entities", err)
+		}
+
+		tx.AppendStorageDiff(diff)
+	}
+	if err := inventory.CommitWithStorageDiff(ctx, tx, f.l, f.userClient); err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to commit restore entity", err)
+	}
+
+	f.emitFileModified(ctx, target)
+	f.RecordActivity(ctx, target, types.FileActivityModify)
+	return target, nil
+}
+
 func (f *DBFS) Restore(ctx context.Context, path ...*fs.URI) error {
 	ae := serializer.NewAggregateError()
 	targets := make([]*File, 0, len(path))
@@ -757,14 +868,26 @@ func (f *DBFS) setCurrentVersion(ctx context.Context, target *File, versionId in
 		return serializer.NewError(serializer.CodeDBError, "Failed to set primary entity", err)
 	}
 
-	// Cap thumbnail entities
-	diff, err := fc.CapEntities(ctx, target.Model, target.Owner(), 0, types.EntityTypeThumbnail)
-	if err != nil {
-		_ = inventory.Rollback(tx)
-		return serializer.NewError(serializer.CodeDBError, "Failed to cap thumbnail entities", err)
+	// Cap thumbnail, transcode and storyboard entities
+	for _, t := range []types.EntityType{types.EntityTypeThumbnail, types.EntityTypeThumbnailSmall, types.EntityTypeThumbnailLarge} {
+		diff, err := fc.CapEntities(ctx, target.Model, target.Owner(), 0, t)
+		if err != nil {
+			_ = inventory.Rollback(tx)
+			return serializer.NewError(serializer.CodeDBError, "Failed to cap thumbnail entities", err)
+		}
+
+		tx.AppendStorageDiff(diff)
 	}
 
-	tx.AppendStorageDiff(diff)
+	for _, t := range []types.EntityType{types.EntityTypeTranscode, types.EntityTypeStoryboard} {
+		diff, err := fc.CapEntities(ctx, target.Model, target.Owner(), 0, t)
+		if err != nil {
+			_ = inventory.Rollback(tx)
+			return serializer.NewError(serializer.CodeDBError, "Failed to cap derived entities", err)
+		}
+
+		tx.AppendStorageDiff(diff)
+	}
 	if err := inventory.CommitWithStorageDiff(ctx, tx, f.l, f.userClient); err != nil {
 		return serializer.NewError(serializer.CodeDBError, "Failed to commit set current version", err)
 	}
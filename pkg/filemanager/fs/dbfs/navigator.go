@@ -147,6 +147,24 @@ func init() {
 		NavigatorCapabilityDownloadFile: true,
 		NavigatorCapabilityEnterFolder:  true,
 	}, sharedWithMeNavigatorCapability)
+	boolset.Sets(map[NavigatorCapability]bool{
+		NavigatorCapabilityListChildren: true,
+		NavigatorCapabilityDownloadFile: true,
+		NavigatorCapabilityInfo:         true,
+		NavigatorCapabilityEnterFolder:  true,
+	}, snapshotNavigatorCapability)
+	boolset.Sets(map[NavigatorCapability]bool{
+		NavigatorCapabilityListChildren: true,
+		NavigatorCapabilityEnterFolder:  true,
+	}, searchNavigatorCapability)
+	boolset.Sets(map[NavigatorCapability]bool{
+		NavigatorCapabilityListChildren: true,
+	}, recentNavigatorCapability)
+	boolset.Sets(map[NavigatorCapability]bool{
+		NavigatorCapabilityListChildren: true,
+		NavigatorCapabilityEnterFolder:  true,
+		NavigatorCapabilityShare:        true,
+	}, photosNavigatorCapability)
 }
 
 // ==================== Base Navigator ====================
@@ -221,6 +239,42 @@ func (b *baseNavigator) findRoot(ctx context.Context, child *File) (*File, error
 	return root, nil
 }
 
+// MyFolderUri returns URI of the parent folder of given file in "my" file system, nil if
+// the file is in trash bin.
+func MyFolderUri(ctx context.Context, fileClient inventory.FileClient, child *ent.File, cached map[int]*fs.URI) (*fs.URI, error) {
+	if child.FileChildren == 0 {
+		return nil, nil
+	}
+
+	if uri, ok := cached[child.FileChildren]; ok {
+		return uri, nil
+	}
+
+	parent, err := fileClient.GetParentFile(ctx, child, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get parent of %q: %w", child.Name, err)
+	}
+
+	var uri *fs.URI
+	if parent.FileChildren == 0 {
+		if parent.Name == inventory.RootFolderName {
+			uri = newMyUri()
+		}
+	} else {
+		grandParentUri, err := MyFolderUri(ctx, fileClient, parent, cached)
+		if err != nil {
+			return nil, err
+		}
+
+		if grandParentUri != nil {
+			uri = grandParentUri.Join(parent.Name)
+		}
+	}
+
+	cached[parent.ID] = uri
+	return uri, nil
+}
+
 func (b *baseNavigator) walkUp(ctx context.Context, child *File) (*File, error) {
 	parent, err := b.fileClient.GetParentFile(ctx, child.Model, false)
 	if err != nil {
@@ -558,3 +612,23 @@ func newSharedWithMeUri(id string) *fs.URI {
 	res, _ := fs.NewUriFromString(fmt.Sprintf("%s://%s", constants.CloudreveScheme, constants.FileSystemSharedWithMe))
 	return res.Join(id)
 }
+
+func newSnapshotUri(id string) *fs.URI {
+	res, _ := fs.NewUriFromString(fmt.Sprintf("%s://%s", constants.CloudreveScheme, constants.FileSystemSnapshot))
+	return res.Join(id)
+}
+
+func newSearchUri(id string) *fs.URI {
+	res, _ := fs.NewUriFromString(fmt.Sprintf("%s://%s", constants.CloudreveScheme, constants.FileSystemSearch))
+	return res.Join(id)
+}
+
+func newRecentUri() *fs.URI {
+	res, _ := fs.NewUriFromString(fmt.Sprintf("%s://%s", constants.CloudreveScheme, constants.FileSystemRecent))
+	return res
+}
+
+func newPhotosUri() *fs.URI {
+	res, _ := fs.NewUriFromString(fmt.Sprintf("%s://%s", constants.CloudreveScheme, constants.FileSystemPhotos))
+	return res
+}
@@ -0,0 +1,534 @@
+package dbfs
+
+import (
+	"context"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/constants"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/boolset"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cache"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/samber/lo"
+)
+
+const (
+	PhotosTimeline = "timeline"
+	PhotosPlaces   = "places"
+	PhotosAlbums   = "albums"
+
+	// Metadata written by media meta extractors, used to group photos.
+	photosTakenAtKey = "exif:taken_at"
+	photosCountryKey = "geocoding:country"
+	photosPlaceKey   = "geocoding:place"
+
+	photosIndexPageSize    = 1000
+	photosIndexCachePrefix = "photos_index_"
+	photosIndexTTL         = 300
+)
+
+var photosNavigatorCapability = &boolset.BooleanSet{}
+
+func init() {
+	gob.Register(photosIndex{})
+}
+
+// NewPhotosNavigator creates a read-only navigator grouping user's image and video files by capture date,
+// by location and by albums. Grouped files are listed with their URIs in "my" file system.
+func NewPhotosNavigator(u *ent.User, fileClient inventory.FileClient, albumClient inventory.AlbumClient,
+	l logging.Logger, config *setting.DBFS, hasher hashid.Encoder, kv cache.Driver) Navigator {
+	return &photosNavigator{
+		user:        u,
+		l:           l,
+		fileClient:  fileClient,
+		albumClient: albumClient,
+		config:      config,
+		hasher:      hasher,
+		kv:          kv,
+		folderUris:  make(map[int]*fs.URI),
+	}
+}
+
+type (
+	photosNavigator struct {
+		l           logging.Logger
+		user        *ent.User
+		fileClient  inventory.FileClient
+		albumClient inventory.AlbumClient
+		config      *setting.DBFS
+		hasher      hashid.Encoder
+		kv          cache.Driver
+
+		root *File
+		// photos is the grouping index of media files of current user not in trash bin, newest first.
+		photos []photoItem
+		// folderUris caches URI of visited folders, nil for folders in trash bin.
+		folderUris map[int]*fs.URI
+	}
+
+	// photosIndex is cached for a short while, so that browsing photos does not go through all
+	// media files on every request. Only files listed in current page are loaded.
+	photosIndex struct {
+		Items []photoItem
+	}
+
+	photoItem struct {
+		FileID  int
+		TakenAt time.Time
+		Country string
+		Place   string
+	}
+)
+
+func (n *photosNavigator) Recycle() {
+
+}
+
+func (n *photosNavigator) PersistState(kv cache.Driver, key string) {
+}
+
+func (n *photosNavigator) RestoreState(s State) error {
+	return nil
+}
+
+func (n *photosNavigator) To(ctx context.Context, path *fs.URI) (*File, error) {
+	// Anonymous user does not have photos.
+	if inventory.IsAnonymousUser(n.user) {
+		return nil, ErrLoginRequired
+	}
+
+	if n.root == nil {
+		rootFile, err := n.fileClient.Root(ctx, n.user)
+		if err != nil {
+			n.l.Info("User's root folder not found: %s, will initialize it.", err)
+			return nil, ErrFsNotInitialized
+		}
+
+		n.root = newFile(nil, rootFile)
+		rootPath := newPhotosUri()
+		n.root.Path[pathIndexRoot], n.root.Path[pathIndexUser] = rootPath, rootPath
+		n.root.OwnerModel = n.user
+		n.root.IsUserRoot = true
+		n.root.CapabilitiesBs = n.Capabilities(false).Capability
+	}
+
+	current := n.root
+	elements := path.Elements()
+	for i := range elements {
+		exist, err := n.exist(ctx, elements[:i+1])
+		if err != nil {
+			return nil, err
+		}
+
+		if !exist {
+			return current, fs.ErrPathNotExist.WithError(fmt.Errorf("%q not found in photos", elements[i]))
+		}
+
+		current = n.virtualFolder(current, elements[i])
+	}
+
+	return current, nil
+}
+
+// exist checks if given virtual folder exists, all its ancestors are assumed to exist.
+func (n *photosNavigator) exist(ctx context.Context, elements []string) (bool, error) {
+	depth := len(elements)
+	switch elements[0] {
+	case PhotosTimeline:
+		if depth == 1 {
+			return true, nil
+		}
+
+		if depth > 4 {
+			return false, nil
+		}
+	case PhotosPlaces:
+		if depth == 1 {
+			return true, nil
+		}
+
+		if depth > 3 {
+			return false, nil
+		}
+	case PhotosAlbums:
+		if depth == 1 {
+			return true, nil
+		}
+
+		if depth > 2 {
+			return false, nil
+		}
+
+		_, err := n.album(ctx, elements[1])
+		if err != nil && errors.Is(err, fs.ErrPathNotExist) {
+			return false, nil
+		}
+
+		return err == nil, err
+	default:
+		return false, nil
+	}
+
+	photos, err := n.filter(ctx, elements)
+	return len(photos) > 0, err
+}
+
+func (n *photosNavigator) Children(ctx context.Context, parent *File, args *ListArgs) (*ListResult, error) {
+	if args.Search != nil {
+		return nil, fs.ErrNotSupportedAction.WithError(fmt.Errorf("search is not supported in photos"))
+	}
+
+	if parent.IsUserRoot {
+		return &ListResult{
+			Files: lo.Map([]string{PhotosTimeline, PhotosPlaces, PhotosAlbums}, func(name string, index int) *File {
+				return n.virtualFolder(parent, name)
+			}),
+			Pagination: &inventory.PaginationResults{TotalItems: 3},
+		}, nil
+	}
+
+	elements := parent.Uri(false).Elements()
+	if elements[0] == PhotosAlbums {
+		if len(elements) == 1 {
+			return n.listAlbums(ctx, parent, args)
+		}
+
+		return n.listAlbumFiles(ctx, elements[1], args)
+	}
+
+	photos, err := n.filter(ctx, elements)
+	if err != nil {
+		return nil, err
+	}
+
+	page := snapshotPageIndex(args.Page)
+	pageSize := args.Page.PageSize
+	if (elements[0] == PhotosTimeline && len(elements) == 4) || (elements[0] == PhotosPlaces && len(elements) == 3) {
+		start := min(page*pageSize, len(photos))
+		end := min(start+pageSize, len(photos))
+		files, err := n.photoFiles(ctx, photos[start:end])
+		if err != nil {
+			return nil, err
+		}
+
+		return &ListResult{
+			Files:      files,
+			Pagination: snapshotPageResult(args.Page, page, len(photos)),
+		}, nil
+	}
+
+	groups := lo.Uniq(lo.Map(photos, func(item photoItem, index int) string {
+		return photoGroup(item, elements[0], len(elements))
+	}))
+	if elements[0] == PhotosPlaces {
+		sort.Strings(groups)
+	}
+
+	start := min(page*pageSize, len(groups))
+	end := min(start+pageSize, len(groups))
+	return &ListResult{
+		Files: lo.Map(groups[start:end], func(name string, index int) *File {
+			return n.virtualFolder(parent, name)
+		}),
+		Pagination: snapshotPageResult(args.Page, page, len(groups)),
+	}, nil
+}
+
+// listAlbums lists all albums of current user as folders.
+func (n *photosNavigator) listAlbums(ctx context.Context, parent *File, args *ListArgs) (*ListResult, error) {
+	albums, err := n.albumClient.List(ctx, n.user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list albums: %w", err)
+	}
+
+	page := snapshotPageIndex(args.Page)
+	start := min(page*args.Page.PageSize, len(albums))
+	end := min(start+args.Page.PageSize, len(albums))
+	return &ListResult{
+		Files: lo.Map(albums[start:end], func(a *ent.Album, index int) *File {
+			f := n.virtualFolder(parent, a.Name)
+			f.Model.CreatedAt, f.Model.UpdatedAt = a.CreatedAt, a.UpdatedAt
+			return f
+		}),
+		Pagination: snapshotPageResult(args.Page, page, len(albums)),
+	}, nil
+}
+
+// listAlbumFiles lists files in given album, files in trash bin are skipped.
+func (n *photosNavigator) listAlbumFiles(ctx context.Context, name string, args *ListArgs) (*ListResult, error) {
+	a, err := n.album(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	page := snapshotPageIndex(args.Page)
+	order := args.Page.Order
+	if order == "" {
+		order = inventory.OrderDirectionDesc
+	}
+
+	res, err := n.albumClient.ListFiles(ctx, a, &inventory.PaginationArgs{
+		Page:     page,
+		PageSize: args.Page.PageSize,
+		Order:    order,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]*File, 0, len(res.Files))
+	for _, model := range res.Files {
+		folderUri, err := MyFolderUri(ctx, n.fileClient, model, n.folderUris)
+		if err != nil {
+			return nil, err
+		}
+
+		if folderUri != nil {
+			files = append(files, n.myFile(model, folderUri))
+		}
+	}
+
+	return &ListResult{
+		Files:      files,
+		Pagination: snapshotPageResult(args.Page, page, res.TotalItems),
+	}, nil
+}
+
+// album returns the album of current user with given name.
+func (n *photosNavigator) album(ctx context.Context, name string) (*ent.Album, error) {
+	a, err := n.albumClient.GetByName(ctx, n.user.ID, name)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fs.ErrPathNotExist.WithError(err)
+		}
+
+		return nil, fmt.Errorf("failed to get album: %w", err)
+	}
+
+	return a, nil
+}
+
+// photoFiles loads files of given photos, files moved into trash bin since indexed are skipped.
+func (n *photosNavigator) photoFiles(ctx context.Context, photos []photoItem) ([]*File, error) {
+	ids := lo.Map(photos, func(item photoItem, index int) int {
+		return item.FileID
+	})
+
+	models := make(map[int]*ent.File, len(ids))
+	for page := 0; page >= 0 && len(ids) > 0; {
+		res, next, err := n.fileClient.GetByIDs(ctx, ids, page)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get photos: %w", err)
+		}
+
+		for _, model := range res {
+			models[model.ID] = model
+		}
+		page = next
+	}
+
+	files := make([]*File, 0, len(ids))
+	for _, id := range ids {
+		model, ok := models[id]
+		if !ok {
+			continue
+		}
+
+		folderUri, err := MyFolderUri(ctx, n.fileClient, model, n.folderUris)
+		if err != nil {
+			return nil, err
+		}
+
+		if folderUri != nil {
+			files = append(files, n.myFile(model, folderUri))
+		}
+	}
+
+	return files, nil
+}
+
+// filter returns photos under given timeline or places folder.
+func (n *photosNavigator) filter(ctx context.Context, elements []string) ([]photoItem, error) {
+	if err := n.loadPhotos(ctx); err != nil {
+		return nil, err
+	}
+
+	return lo.Filter(n.photos, func(item photoItem, index int) bool {
+		for depth := 2; depth <= len(elements); depth++ {
+			if photoGroup(item, elements[0], depth-1) != elements[depth-1] {
+				return false
+			}
+		}
+
+		return elements[0] != PhotosPlaces || (item.Country != "" && item.Place != "")
+	}), nil
+}
+
+// loadPhotos indexes all media files of current user, files in trash bin are skipped. The index
+// only keeps grouping keys of files and is shared between requests for a short while.
+func (n *photosNavigator) loadPhotos(ctx context.Context) error {
+	if n.photos != nil {
+		return nil
+	}
+
+	cacheKey := fmt.Sprintf("%s%d", photosIndexCachePrefix, n.user.ID)
+	if cached, ok := n.kv.Get(cacheKey); ok {
+		n.photos = cached.(photosIndex).Items
+		return nil
+	}
+
+	photos := make([]photoItem, 0)
+	metaKeys := []string{photosTakenAtKey, photosCountryKey, photosPlaceKey}
+	for afterID := 0; ; {
+		files, err := n.fileClient.ListMediaFiles(ctx, n.user.ID, n.config.PhotosExts, metaKeys, afterID, photosIndexPageSize)
+		if err != nil {
+			return fmt.Errorf("failed to list media files: %w", err)
+		}
+
+		for _, model := range files {
+			folderUri, err := MyFolderUri(ctx, n.fileClient, model, n.folderUris)
+			if err != nil {
+				return err
+			}
+
+			if folderUri != nil {
+				photos = append(photos, newPhotoItem(model))
+			}
+		}
+
+		if len(files) < photosIndexPageSize {
+			break
+		}
+
+		afterID = files[len(files)-1].ID
+	}
+
+	sort.SliceStable(photos, func(i, j int) bool {
+		return photos[i].TakenAt.After(photos[j].TakenAt)
+	})
+	n.photos = photos
+	if err := n.kv.Set(cacheKey, photosIndex{Items: photos}, photosIndexTTL); err != nil {
+		n.l.Warning("Failed to cache photos index of user %d: %s", n.user.ID, err)
+	}
+
+	return nil
+}
+
+func newPhotoItem(model *ent.File) photoItem {
+	item := photoItem{FileID: model.ID, TakenAt: model.CreatedAt}
+	for _, m := range model.Edges.Metadata {
+		switch m.Name {
+		case photosTakenAtKey:
+			if t, err := time.Parse(time.RFC3339, m.Value); err == nil {
+				item.TakenAt = t
+			}
+		case photosCountryKey:
+			item.Country = m.Value
+		case photosPlaceKey:
+			item.Place = m.Value
+		}
+	}
+
+	return item
+}
+
+// photoGroup returns the name of folder containing given photo at given level under timeline or places.
+func photoGroup(item photoItem, category string, level int) string {
+	if category == PhotosPlaces {
+		if level == 1 {
+			return item.Country
+		}
+
+		return item.Place
+	}
+
+	switch level {
+	case 1:
+		return strconv.Itoa(item.TakenAt.Year())
+	case 2:
+		return fmt.Sprintf("%02d", item.TakenAt.Month())
+	default:
+		return fmt.Sprintf("%02d", item.TakenAt.Day())
+	}
+}
+
+// virtualFolder builds a virtual folder under given parent.
+func (n *photosNavigator) virtualFolder(parent *File, name string) *File {
+	f := newFile(parent, &ent.File{
+		ID:        n.root.Model.ID,
+		Name:      name,
+		Type:      int(types.FileTypeFolder),
+		OwnerID:   n.user.ID,
+		CreatedAt: n.root.Model.CreatedAt,
+		UpdatedAt: n.root.Model.UpdatedAt,
+	})
+	f.OwnerModel = n.user
+	f.CapabilitiesBs = n.Capabilities(false).Capability
+	return f
+}
+
+// myFile builds a file located in "my" file system.
+func (n *photosNavigator) myFile(model *ent.File, folderUri *fs.URI) *File {
+	f := newFile(nil, model)
+	f.Path[pathIndexRoot], f.Path[pathIndexUser] = folderUri.Join(model.Name), folderUri.Join(model.Name)
+	f.OwnerModel = n.user
+	f.CapabilitiesBs = myNavigatorCapability
+	return f
+}
+
+func (n *photosNavigator) Capabilities(isSearching bool) *fs.NavigatorProps {
+	return &fs.NavigatorProps{
+		Capability:  photosNavigatorCapability,
+		MaxPageSize: n.config.MaxPageSize,
+	}
+}
+
+func (n *photosNavigator) Walk(ctx context.Context, levelFiles []*File, limit, depth int, f WalkFunc) error {
+	return errors.New("not implemented")
+}
+
+func (n *photosNavigator) FollowTx(ctx context.Context) (func(), error) {
+	if _, ok := ctx.Value(inventory.TxCtx{}).(*inventory.Tx); !ok {
+		return nil, fmt.Errorf("navigator: no inherited transaction found in context")
+	}
+	newFileClient, _, _, err := inventory.WithTx(ctx, n.fileClient)
+	if err != nil {
+		return nil, err
+	}
+
+	newAlbumClient, _, _, err := inventory.WithTx(ctx, n.albumClient)
+	if err != nil {
+		return nil, err
+	}
+
+	oldFileClient, oldAlbumClient := n.fileClient, n.albumClient
+	revert := func() {
+		n.fileClient = oldFileClient
+		n.albumClient = oldAlbumClient
+	}
+
+	n.fileClient = newFileClient
+	n.albumClient = newAlbumClient
+	return revert, nil
+}
+
+func (n *photosNavigator) ExecuteHook(ctx context.Context, hookType fs.HookType, file *File) error {
+	return nil
+}
+
+func (n *photosNavigator) GetView(ctx context.Context, file *File) *types.ExplorerView {
+	if view, ok := n.user.Settings.FsViewMap[string(constants.FileSystemPhotos)]; ok {
+		return &view
+	}
+	return defaultView
+}
@@ -48,6 +48,22 @@ func (f *DBFS) PatchProps(ctx context.Context, uri *fs.URI, props *types.FilePro
 		}
 	}
 
+	if props.VersionRetention != nil {
+		if delete {
+			currentProps.VersionRetention = nil
+		} else {
+			currentProps.VersionRetention = props.VersionRetention
+		}
+	}
+
+	if props.Snapshot != nil {
+		if delete {
+			currentProps.Snapshot = nil
+		} else {
+			currentProps.Snapshot = props.Snapshot
+		}
+	}
+
 	if _, err := f.fileClient.UpdateProps(ctx, target.Model, currentProps); err != nil {
 		return serializer.NewError(serializer.CodeDBError, "failed to update file props", err)
 	}
@@ -0,0 +1,187 @@
+package dbfs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/constants"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/boolset"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cache"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+)
+
+var recentNavigatorCapability = &boolset.BooleanSet{}
+
+// NewRecentNavigator creates a navigator for files recently accessed by user.
+func NewRecentNavigator(u *ent.User, fileClient inventory.FileClient, fileActivityClient inventory.FileActivityClient,
+	l logging.Logger, config *setting.DBFS, hasher hashid.Encoder) Navigator {
+	return &recentNavigator{
+		user:               u,
+		l:                  l,
+		fileClient:         fileClient,
+		fileActivityClient: fileActivityClient,
+		config:             config,
+		hasher:             hasher,
+	}
+}
+
+type recentNavigator struct {
+	l                  logging.Logger
+	user               *ent.User
+	fileClient         inventory.FileClient
+	fileActivityClient inventory.FileActivityClient
+	config             *setting.DBFS
+	hasher             hashid.Encoder
+
+	root *File
+}
+
+func (n *recentNavigator) Recycle() {
+
+}
+
+func (n *recentNavigator) PersistState(kv cache.Driver, key string) {
+}
+
+func (n *recentNavigator) RestoreState(s State) error {
+	return nil
+}
+
+func (n *recentNavigator) To(ctx context.Context, path *fs.URI) (*File, error) {
+	// Anonymous user does not have recent files.
+	if inventory.IsAnonymousUser(n.user) {
+		return nil, ErrLoginRequired
+	}
+
+	if len(path.Elements()) > 0 {
+		// Recent files is a flatten list, files can only be accessed in "my" file system.
+		return nil, fs.ErrPathNotExist.WithError(fmt.Errorf("invalid Path %q", path))
+	}
+
+	if n.root == nil {
+		rootFile, err := n.fileClient.Root(ctx, n.user)
+		if err != nil {
+			n.l.Info("User's root folder not found: %s, will initialize it.", err)
+			return nil, ErrFsNotInitialized
+		}
+
+		n.root = newFile(nil, rootFile)
+		rootPath := newRecentUri()
+		n.root.Path[pathIndexRoot], n.root.Path[pathIndexUser] = rootPath, rootPath
+		n.root.OwnerModel = n.user
+		n.root.IsUserRoot = true
+		n.root.CapabilitiesBs = n.Capabilities(false).Capability
+	}
+
+	return n.root, nil
+}
+
+func (n *recentNavigator) Children(ctx context.Context, parent *File, args *ListArgs) (*ListResult, error) {
+	order := args.Page.Order
+	if order == "" {
+		order = inventory.OrderDirectionDesc
+	}
+
+	page := snapshotPageIndex(args.Page)
+	res, err := n.fileActivityClient.List(ctx, &inventory.ListFileActivityParameters{
+		PaginationArgs: &inventory.PaginationArgs{
+			Page:     page,
+			PageSize: args.Page.PageSize,
+			Order:    order,
+		},
+		UserID: n.user.ID,
+		Since:  time.Now().Add(-n.config.RecentFilesWindow),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list recent files: %w", err)
+	}
+
+	// folderUris caches URI of visited folders, nil for folders in trash bin.
+	folderUris := make(map[int]*fs.URI)
+	files := make([]*File, 0, len(res.Activities))
+	for _, activity := range res.Activities {
+		model := activity.Edges.File
+		if model == nil {
+			continue
+		}
+
+		parentUri, err := MyFolderUri(ctx, n.fileClient, model, folderUris)
+		if err != nil {
+			return nil, err
+		}
+
+		if parentUri == nil {
+			continue
+		}
+
+		// Activity is already loaded, attach it so that last opened time is available.
+		model.Edges.Activities = []*ent.FileActivity{activity}
+		f := newFile(nil, model)
+		f.Path[pathIndexRoot], f.Path[pathIndexUser] = parentUri.Join(model.Name), parentUri.Join(model.Name)
+		f.OwnerModel = n.user
+		f.CapabilitiesBs = myNavigatorCapability
+		files = append(files, f)
+	}
+
+	return &ListResult{
+		Files:      files,
+		MixedType:  true,
+		Pagination: snapshotPageResult(args.Page, page, res.TotalItems),
+	}, nil
+}
+
+func (n *recentNavigator) Capabilities(isSearching bool) *fs.NavigatorProps {
+	return &fs.NavigatorProps{
+		Capability:            recentNavigatorCapability,
+		OrderDirectionOptions: fullOrderDirectionOption,
+		MaxPageSize:           n.config.MaxPageSize,
+	}
+}
+
+func (n *recentNavigator) Walk(ctx context.Context, levelFiles []*File, limit, depth int, f WalkFunc) error {
+	return errors.New("not implemented")
+}
+
+func (n *recentNavigator) FollowTx(ctx context.Context) (func(), error) {
+	if _, ok := ctx.Value(inventory.TxCtx{}).(*inventory.Tx); !ok {
+		return nil, fmt.Errorf("navigator: no inherited transaction found in context")
+	}
+	newFileClient, _, _, err := inventory.WithTx(ctx, n.fileClient)
+	if err != nil {
+		return nil, err
+	}
+
+	newFileActivityClient, _, _, err := inventory.WithTx(ctx, n.fileActivityClient)
+	if err != nil {
+		return nil, err
+	}
+
+	oldFileClient, oldFileActivityClient := n.fileClient, n.fileActivityClient
+	revert := func() {
+		n.fileClient = oldFileClient
+		n.fileActivityClient = oldFileActivityClient
+	}
+
+	n.fileClient = newFileClient
+	n.fileActivityClient = newFileActivityClient
+	return revert, nil
+}
+
+func (n *recentNavigator) ExecuteHook(ctx context.Context, hookType fs.HookType, file *File) error {
+	return nil
+}
+
+func (n *recentNavigator) GetView(ctx context.Context, file *File) *types.ExplorerView {
+	if view, ok := n.user.Settings.FsViewMap[string(constants.FileSystemRecent)]; ok {
+		return &view
+	}
+	return defaultView
+}
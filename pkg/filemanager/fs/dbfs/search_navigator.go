@@ -0,0 +1,217 @@
+package dbfs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+
+	"github.com/cloudreve/Cloudreve/v4/application/constants"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/boolset"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cache"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/samber/lo"
+)
+
+var searchNavigatorCapability = &boolset.BooleanSet{}
+
+// NewSearchNavigator creates a navigator for user's saved searches. Each saved search is
+// presented as a folder named after it, whose children are evaluated from the search query on
+// every listing.
+func NewSearchNavigator(u *ent.User, fileClient inventory.FileClient, userClient inventory.UserClient, l logging.Logger,
+	config *setting.DBFS, hasher hashid.Encoder) Navigator {
+	return &searchNavigator{
+		user:       u,
+		l:          l,
+		fileClient: fileClient,
+		config:     config,
+		hasher:     hasher,
+		my:         NewMyNavigator(u, fileClient, userClient, l, config, hasher),
+	}
+}
+
+type searchNavigator struct {
+	l          logging.Logger
+	user       *ent.User
+	fileClient inventory.FileClient
+	config     *setting.DBFS
+	hasher     hashid.Encoder
+
+	// my navigator is used to evaluate search queries, results are files in "my" file system.
+	my   Navigator
+	root *File
+}
+
+func (n *searchNavigator) Recycle() {
+	n.my.Recycle()
+}
+
+func (n *searchNavigator) PersistState(kv cache.Driver, key string) {
+}
+
+func (n *searchNavigator) RestoreState(s State) error {
+	return nil
+}
+
+func (n *searchNavigator) To(ctx context.Context, path *fs.URI) (*File, error) {
+	// Anonymous user does not have saved searches.
+	if inventory.IsAnonymousUser(n.user) {
+		return nil, ErrLoginRequired
+	}
+
+	if n.root == nil {
+		rootFile, err := n.fileClient.Root(ctx, n.user)
+		if err != nil {
+			n.l.Info("User's root folder not found: %s, will initialize it.", err)
+			return nil, ErrFsNotInitialized
+		}
+
+		n.root = newFile(nil, rootFile)
+		rootPath := newSearchUri("")
+		n.root.Path[pathIndexRoot], n.root.Path[pathIndexUser] = rootPath, rootPath
+		n.root.OwnerModel = n.user
+		n.root.IsUserRoot = true
+		n.root.CapabilitiesBs = n.Capabilities(false).Capability
+	}
+
+	elements := path.Elements()
+	if len(elements) == 0 {
+		return n.root, nil
+	}
+
+	// Saved searches are a flatten list, search results can only be accessed in "my" file system.
+	if len(elements) > 1 {
+		return nil, fs.ErrPathNotExist.WithError(fmt.Errorf("invalid Path %q", path))
+	}
+
+	search, ok := lo.Find(n.user.Settings.SavedSearches, func(s types.SavedSearch) bool {
+		return s.Name == elements[0]
+	})
+	if !ok {
+		return nil, fs.ErrPathNotExist.WithError(fmt.Errorf("saved search %q not found", elements[0]))
+	}
+
+	return n.searchFolder(search), nil
+}
+
+func (n *searchNavigator) Children(ctx context.Context, parent *File, args *ListArgs) (*ListResult, error) {
+	if parent.IsUserRoot {
+		searches := n.user.Settings.SavedSearches
+		page := snapshotPageIndex(args.Page)
+		start := min(page*args.Page.PageSize, len(searches))
+		end := min(start+args.Page.PageSize, len(searches))
+		return &ListResult{
+			Files: lo.Map(searches[start:end], func(s types.SavedSearch, index int) *File {
+				return n.searchFolder(s)
+			}),
+			Pagination: snapshotPageResult(args.Page, page, len(searches)),
+		}, nil
+	}
+
+	search, ok := lo.Find(n.user.Settings.SavedSearches, func(s types.SavedSearch) bool {
+		return s.Name == parent.Name()
+	})
+	if !ok {
+		return nil, fs.ErrPathNotExist.WithError(fmt.Errorf("saved search %q not found", parent.Name()))
+	}
+
+	searchUri, err := fs.NewUriFromString(search.Uri)
+	if err != nil {
+		return nil, fmt.Errorf("invalid saved search uri: %w", err)
+	}
+
+	params := searchUri.SearchParameters()
+	if params == nil {
+		return nil, fmt.Errorf("saved search %q has no search parameters", search.ID)
+	}
+
+	scope, err := n.my.To(ctx, searchUri)
+	if err != nil {
+		return nil, fmt.Errorf("failed to navigate to search scope: %w", err)
+	}
+
+	// Recursive search only supports cursor pagination.
+	args.Page.UseCursorPagination = true
+	return n.my.Children(ctx, scope, &ListArgs{
+		Page:           args.Page,
+		Search:         params,
+		StreamCallback: args.StreamCallback,
+	})
+}
+
+// searchFolder builds a virtual folder for given saved search.
+func (n *searchNavigator) searchFolder(s types.SavedSearch) *File {
+	f := newFile(n.root, &ent.File{
+		ID:        searchFolderID(s),
+		Name:      s.Name,
+		Type:      int(types.FileTypeFolder),
+		OwnerID:   n.user.ID,
+		CreatedAt: n.root.Model.CreatedAt,
+		UpdatedAt: n.root.Model.UpdatedAt,
+	})
+	f.OwnerModel = n.user
+	f.CapabilitiesBs = n.Capabilities(false).Capability
+	return f
+}
+
+// searchFolderID derives a stable ID of saved search folder from its ID. IDs of saved search folders
+// are negative, so they never collide with real files.
+func searchFolderID(s types.SavedSearch) int {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(s.ID))
+	return -int(h.Sum32()&0x7fffffff) - 1
+}
+
+func (n *searchNavigator) Capabilities(isSearching bool) *fs.NavigatorProps {
+	return &fs.NavigatorProps{
+		Capability:            searchNavigatorCapability,
+		OrderDirectionOptions: fullOrderDirectionOption,
+		OrderByOptions:        searchLimitedOrderByOption,
+		MaxPageSize:           n.config.MaxPageSize,
+	}
+}
+
+func (n *searchNavigator) Walk(ctx context.Context, levelFiles []*File, limit, depth int, f WalkFunc) error {
+	return errors.New("not implemented")
+}
+
+func (n *searchNavigator) FollowTx(ctx context.Context) (func(), error) {
+	if _, ok := ctx.Value(inventory.TxCtx{}).(*inventory.Tx); !ok {
+		return nil, fmt.Errorf("navigator: no inherited transaction found in context")
+	}
+	newFileClient, _, _, err := inventory.WithTx(ctx, n.fileClient)
+	if err != nil {
+		return nil, err
+	}
+
+	revertMy, err := n.my.FollowTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	oldFileClient := n.fileClient
+	revert := func() {
+		n.fileClient = oldFileClient
+		revertMy()
+	}
+
+	n.fileClient = newFileClient
+	return revert, nil
+}
+
+func (n *searchNavigator) ExecuteHook(ctx context.Context, hookType fs.HookType, file *File) error {
+	return nil
+}
+
+func (n *searchNavigator) GetView(ctx context.Context, file *File) *types.ExplorerView {
+	if view, ok := n.user.Settings.FsViewMap[string(constants.FileSystemSearch)]; ok {
+		return &view
+	}
+	return defaultView
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
 	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/samber/lo"
 )
 
 var (
@@ -30,12 +31,13 @@ var shareNavigatorCapability = &boolset.BooleanSet{}
 
 // NewShareNavigator creates a navigator for user's "shared" file system.
 func NewShareNavigator(u *ent.User, fileClient inventory.FileClient, shareClient inventory.ShareClient,
-	l logging.Logger, config *setting.DBFS, hasher hashid.Encoder) Navigator {
+	albumClient inventory.AlbumClient, l logging.Logger, config *setting.DBFS, hasher hashid.Encoder) Navigator {
 	n := &shareNavigator{
 		user:        u,
 		l:           l,
 		fileClient:  fileClient,
 		shareClient: shareClient,
+		albumClient: albumClient,
 		config:      config,
 	}
 	n.baseNavigator = newBaseNavigator(fileClient, defaultFilter, u, hasher, config)
@@ -48,6 +50,7 @@ type (
 		user        *ent.User
 		fileClient  inventory.FileClient
 		shareClient inventory.ShareClient
+		albumClient inventory.AlbumClient
 		config      *setting.DBFS
 
 		*baseNavigator
@@ -56,8 +59,11 @@ type (
 		ownerRoot       *File
 		share           *ent.Share
 		owner           *ent.User
-		disableRecycle  bool
-		persist         func()
+		// album is the shared photo album, files in it are listed flatten under share root and
+		// addressed by their hashed ID, as names of album files are not unique.
+		album          *ent.Album
+		disableRecycle bool
+		persist        func()
 	}
 
 	shareNavigatorState struct {
@@ -66,6 +72,7 @@ type (
 		SingleFileShare bool
 		Share           *ent.Share
 		Owner           *ent.User
+		Album           *ent.Album
 	}
 )
 
@@ -78,6 +85,7 @@ func (n *shareNavigator) PersistState(kv cache.Driver, key string) {
 			SingleFileShare: n.singleFileShare,
 			Share:           n.share,
 			Owner:           n.owner,
+			Album:           n.album,
 		}, ContextHintTTL)
 	}
 }
@@ -90,6 +98,7 @@ func (n *shareNavigator) RestoreState(s State) error {
 		n.singleFileShare = state.SingleFileShare
 		n.share = state.Share
 		n.owner = state.Owner
+		n.album = state.Album
 		return nil
 	}
 
@@ -131,6 +140,16 @@ func (n *shareNavigator) Root(ctx context.Context, path *fs.URI) (*File, error)
 		return nil, ErrShareIncorrectPassword
 	}
 
+	// Album share references files in owner's album, the shared file is owner's root folder.
+	if share.Props != nil && share.Props.AlbumID > 0 {
+		album, err := n.albumClient.GetByID(ctx, share.Props.AlbumID)
+		if err != nil || album.OwnerID != n.owner.ID {
+			return nil, ErrShareNotFound.WithError(err)
+		}
+
+		n.album = album
+	}
+
 	// Share permission setting should overwrite root folder's permission
 	n.shareRoot = newFile(nil, share.Edges.File)
 
@@ -191,6 +210,14 @@ func (n *shareNavigator) To(ctx context.Context, path *fs.URI) (*File, error) {
 	current, lastAncestor := n.shareRoot, n.shareRoot
 	elements := path.Elements()
 
+	if n.album != nil && len(elements) > 0 {
+		if len(elements) > 1 {
+			return n.shareRoot, fs.ErrPathNotExist.WithError(fmt.Errorf("album does not contain folders"))
+		}
+
+		return n.albumFile(ctx, elements[0])
+	}
+
 	// If target is root of single file share, the root itself is the target.
 	if len(elements) == 1 && n.singleFileShare {
 		file, err := n.latestSharedSingleFile(ctx)
@@ -240,9 +267,88 @@ func (n *shareNavigator) Children(ctx context.Context, parent *File, args *ListA
 		}, nil
 	}
 
+	if n.album != nil {
+		return n.albumChildren(ctx, parent, args)
+	}
+
 	return n.baseNavigator.children(ctx, parent, args)
 }
 
+// albumFile returns the file in shared album with given hashed ID, files in trash bin are not accessible.
+func (n *shareNavigator) albumFile(ctx context.Context, hashID string) (*File, error) {
+	id, err := n.hasher.Decode(hashID, hashid.FileID)
+	if err != nil {
+		return n.shareRoot, fs.ErrPathNotExist.WithError(err)
+	}
+
+	model, err := n.albumClient.GetFile(ctx, n.album, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return n.shareRoot, fs.ErrPathNotExist.WithError(err)
+		}
+
+		return nil, fmt.Errorf("failed to get album file: %w", err)
+	}
+
+	folderUri, err := MyFolderUri(ctx, n.fileClient, model, make(map[int]*fs.URI))
+	if err != nil {
+		return nil, err
+	}
+
+	if folderUri == nil {
+		return n.shareRoot, fs.ErrPathNotExist.WithError(fmt.Errorf("file %q is in trash bin", hashID))
+	}
+
+	return n.newAlbumFile(n.shareRoot, model), nil
+}
+
+// newAlbumFile builds a file in shared album, whose path under share root is its hashed ID.
+func (n *shareNavigator) newAlbumFile(parent *File, model *ent.File) *File {
+	f := newFile(parent, model)
+	f.Path[pathIndexUser] = parent.Path[pathIndexUser].Join(hashid.EncodeFileID(n.hasher, model.ID))
+	f.OwnerModel = n.owner
+	return f
+}
+
+// albumChildren lists files in shared album, files in trash bin are skipped.
+func (n *shareNavigator) albumChildren(ctx context.Context, parent *File, args *ListArgs) (*ListResult, error) {
+	if !parent.IsUserRoot {
+		return nil, fs.ErrPathNotExist
+	}
+
+	if args.Search != nil {
+		return nil, fs.ErrNotSupportedAction.WithError(fmt.Errorf("search is not supported in album share"))
+	}
+
+	page := snapshotPageIndex(args.Page)
+	res, err := n.albumClient.ListFiles(ctx, n.album, &inventory.PaginationArgs{
+		Page:     page,
+		PageSize: args.Page.PageSize,
+		Order:    args.Page.Order,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	folderUris := make(map[int]*fs.URI)
+	files := make([]*File, 0, len(res.Files))
+	for _, model := range res.Files {
+		folderUri, err := MyFolderUri(ctx, n.fileClient, model, folderUris)
+		if err != nil {
+			return nil, err
+		}
+
+		if folderUri != nil {
+			files = append(files, n.newAlbumFile(parent, model))
+		}
+	}
+
+	return &ListResult{
+		Files:      files,
+		Pagination: snapshotPageResult(args.Page, page, res.TotalItems),
+	}, nil
+}
+
 func (n *shareNavigator) latestSharedSingleFile(ctx context.Context) (*File, error) {
 	if n.singleFileShare {
 		file, err := n.fileClient.GetByID(ctx, n.share.Edges.File.ID)
@@ -285,16 +391,26 @@ func (n *shareNavigator) FollowTx(ctx context.Context) (func(), error) {
 	}
 
 	newSharClient, _, _, err := inventory.WithTx(ctx, n.shareClient)
+	if err != nil {
+		return nil, err
+	}
 
-	oldFileClient, oldShareClient := n.fileClient, n.shareClient
+	newAlbumClient, _, _, err := inventory.WithTx(ctx, n.albumClient)
+	if err != nil {
+		return nil, err
+	}
+
+	oldFileClient, oldShareClient, oldAlbumClient := n.fileClient, n.shareClient, n.albumClient
 	revert := func() {
 		n.fileClient = oldFileClient
 		n.shareClient = oldShareClient
+		n.albumClient = oldAlbumClient
 		n.baseNavigator.fileClient = oldFileClient
 	}
 
 	n.fileClient = newFileClient
 	n.shareClient = newSharClient
+	n.albumClient = newAlbumClient
 	n.baseNavigator.fileClient = newFileClient
 	return revert, nil
 }
@@ -310,9 +426,50 @@ func (n *shareNavigator) ExecuteHook(ctx context.Context, hookType fs.HookType,
 }
 
 func (n *shareNavigator) Walk(ctx context.Context, levelFiles []*File, limit, depth int, f WalkFunc) error {
+	if n.album != nil {
+		return n.walkAlbum(ctx, levelFiles, limit, depth, f)
+	}
+
 	return n.baseNavigator.walk(ctx, levelFiles, limit, depth, f)
 }
 
+// walkAlbum walks files in shared album, which only has one level of files under share root.
+func (n *shareNavigator) walkAlbum(ctx context.Context, levelFiles []*File, limit, depth int, f WalkFunc) error {
+	if len(levelFiles) > limit {
+		return ErrFileCountLimitedReached
+	}
+
+	if err := f(levelFiles, 0); err != nil {
+		return err
+	}
+
+	root, ok := lo.Find(levelFiles, func(file *File) bool {
+		return file.IsUserRoot
+	})
+	if !ok || depth < 1 {
+		return nil
+	}
+
+	files := make([]*File, 0)
+	for page := 0; ; page++ {
+		res, err := n.albumChildren(ctx, root, &ListArgs{Page: &inventory.PaginationArgs{Page: page, PageSize: n.config.MaxPageSize}})
+		if err != nil {
+			return err
+		}
+
+		files = append(files, res.Files...)
+		if len(levelFiles)+len(files) > limit {
+			return ErrFileCountLimitedReached
+		}
+
+		if (page+1)*res.Pagination.PageSize >= res.Pagination.TotalItems {
+			break
+		}
+	}
+
+	return f(files, 1)
+}
+
 func (n *shareNavigator) GetView(ctx context.Context, file *File) *types.ExplorerView {
 	return file.View()
 }
@@ -0,0 +1,467 @@
+package dbfs
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cloudreve/Cloudreve/v4/application/constants"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/boolset"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cache"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/samber/lo"
+)
+
+var snapshotNavigatorCapability = &boolset.BooleanSet{}
+
+// NewSnapshotNavigator creates a read-only navigator for user's folder snapshots. Each snapshot is presented
+// as a folder under root, named by its hash ID, with the file tree recorded in its manifest.
+func NewSnapshotNavigator(u *ent.User, fileClient inventory.FileClient, snapshotClient inventory.SnapshotClient,
+	l logging.Logger, config *setting.DBFS, hasher hashid.Encoder) Navigator {
+	return &snapshotNavigator{
+		user:           u,
+		l:              l,
+		fileClient:     fileClient,
+		snapshotClient: snapshotClient,
+		config:         config,
+		hasher:         hasher,
+		trees:          make(map[int]*snapshotTree),
+	}
+}
+
+type (
+	snapshotNavigator struct {
+		l              logging.Logger
+		user           *ent.User
+		fileClient     inventory.FileClient
+		snapshotClient inventory.SnapshotClient
+		config         *setting.DBFS
+		hasher         hashid.Encoder
+
+		root  *File
+		trees map[int]*snapshotTree
+	}
+
+	// snapshotTree indexes manifest of a snapshot by relative path.
+	snapshotTree struct {
+		snapshot *ent.Snapshot
+		nodes    map[string]*types.SnapshotNode
+		children map[string][]*types.SnapshotNode
+	}
+)
+
+func newSnapshotTree(s *ent.Snapshot) *snapshotTree {
+	t := &snapshotTree{
+		snapshot: s,
+		nodes:    make(map[string]*types.SnapshotNode, len(s.Manifest)),
+		children: make(map[string][]*types.SnapshotNode),
+	}
+
+	for i := range s.Manifest {
+		node := &s.Manifest[i]
+		t.nodes[node.Path] = node
+		if node.Path == "" {
+			continue
+		}
+
+		parent := path.Dir(node.Path)
+		if parent == "." {
+			parent = ""
+		}
+		t.children[parent] = append(t.children[parent], node)
+	}
+
+	return t
+}
+
+func (n *snapshotNavigator) Recycle() {
+	if n.root != nil {
+		n.root.Recycle()
+	}
+}
+
+func (n *snapshotNavigator) PersistState(kv cache.Driver, key string) {
+}
+
+func (n *snapshotNavigator) RestoreState(s State) error {
+	return nil
+}
+
+func (n *snapshotNavigator) To(ctx context.Context, path *fs.URI) (*File, error) {
+	// Anonymous user does not have snapshots.
+	if inventory.IsAnonymousUser(n.user) {
+		return nil, ErrLoginRequired
+	}
+
+	if n.root == nil {
+		rootFile, err := n.fileClient.Root(ctx, n.user)
+		if err != nil {
+			n.l.Info("User's root folder not found: %s, will initialize it.", err)
+			return nil, ErrFsNotInitialized
+		}
+
+		n.root = newFile(nil, rootFile)
+		rootPath := newSnapshotUri("")
+		n.root.Path[pathIndexRoot], n.root.Path[pathIndexUser] = rootPath, rootPath
+		n.root.OwnerModel = n.user
+		n.root.IsUserRoot = true
+		n.root.CapabilitiesBs = n.Capabilities(false).Capability
+	}
+
+	elements := path.Elements()
+	if len(elements) == 0 {
+		return n.root, nil
+	}
+
+	tree, err := n.tree(ctx, elements[0])
+	if err != nil {
+		return nil, err
+	}
+
+	current := newFile(n.root, snapshotRootModel(tree.snapshot, elements[0]))
+	for i := 1; i < len(elements); i++ {
+		node, ok := tree.nodes[strings.Join(elements[1:i+1], fs.Separator)]
+		if !ok {
+			return current, fs.ErrPathNotExist.WithError(fmt.Errorf("%q not found in snapshot", elements[i]))
+		}
+
+		current = newFile(current, snapshotNodeModel(tree.snapshot, node))
+	}
+
+	if err := n.loadEntities(ctx, []*File{current}); err != nil {
+		return nil, err
+	}
+
+	return current, nil
+}
+
+func (n *snapshotNavigator) Children(ctx context.Context, parent *File, args *ListArgs) (*ListResult, error) {
+	if args.Search != nil {
+		return nil, fs.ErrNotSupportedAction.WithError(fmt.Errorf("search is not supported in snapshots"))
+	}
+
+	if parent.IsUserRoot {
+		return n.listSnapshots(ctx, parent, args)
+	}
+
+	if parent.Type() != types.FileTypeFolder {
+		return nil, fs.ErrPathNotExist
+	}
+
+	tree, rel, err := n.locate(ctx, parent)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make([]*types.SnapshotNode, len(tree.children[rel]))
+	copy(nodes, tree.children[rel])
+	sortSnapshotNodes(nodes, args.Page)
+
+	page := snapshotPageIndex(args.Page)
+	pageSize := args.Page.PageSize
+	start := min(page*pageSize, len(nodes))
+	end := min(start+pageSize, len(nodes))
+
+	files := lo.Map(nodes[start:end], func(node *types.SnapshotNode, index int) *File {
+		return newFile(parent, snapshotNodeModel(tree.snapshot, node))
+	})
+	if err := n.loadEntities(ctx, files); err != nil {
+		return nil, err
+	}
+
+	return &ListResult{
+		Files:      files,
+		Pagination: snapshotPageResult(args.Page, page, len(nodes)),
+	}, nil
+}
+
+// listSnapshots lists all snapshots of current user as folders, newest first.
+func (n *snapshotNavigator) listSnapshots(ctx context.Context, parent *File, args *ListArgs) (*ListResult, error) {
+	page := snapshotPageIndex(args.Page)
+	res, err := n.snapshotClient.List(ctx, &inventory.ListSnapshotParameters{
+		PaginationArgs: &inventory.PaginationArgs{
+			Page:     page,
+			PageSize: args.Page.PageSize,
+			Order:    inventory.OrderDirectionDesc,
+		},
+		OwnerID: n.user.ID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list snapshots: %w", err)
+	}
+
+	return &ListResult{
+		Files: lo.Map(res.Snapshots, func(s *ent.Snapshot, index int) *File {
+			return newFile(parent, snapshotRootModel(s, hashid.EncodeSnapshotID(n.hasher, s.ID)))
+		}),
+		Pagination: snapshotPageResult(args.Page, page, res.TotalItems),
+	}, nil
+}
+
+func (n *snapshotNavigator) Capabilities(isSearching bool) *fs.NavigatorProps {
+	return &fs.NavigatorProps{
+		Capability:            snapshotNavigatorCapability,
+		OrderDirectionOptions: fullOrderDirectionOption,
+		OrderByOptions:        fullOrderByOption,
+		MaxPageSize:           n.config.MaxPageSize,
+	}
+}
+
+func (n *snapshotNavigator) Walk(ctx context.Context, levelFiles []*File, limit, depth int, f WalkFunc) error {
+	walked := 0
+	level := 0
+	for walked <= limit && depth >= 0 && len(levelFiles) > 0 {
+		stop := false
+		depth--
+		if len(levelFiles) > limit-walked {
+			levelFiles = levelFiles[:limit-walked]
+			stop = true
+		}
+
+		if err := n.loadEntities(ctx, levelFiles); err != nil {
+			return err
+		}
+
+		if err := f(levelFiles, level); err != nil {
+			return err
+		}
+
+		if stop {
+			return ErrFileCountLimitedReached
+		}
+
+		walked += len(levelFiles)
+		next := make([]*File, 0)
+		for _, file := range levelFiles {
+			if file.Type() != types.FileTypeFolder {
+				continue
+			}
+
+			if file.IsUserRoot {
+				return fs.ErrNotSupportedAction.WithError(fmt.Errorf("cannot walk snapshot root"))
+			}
+
+			tree, rel, err := n.locate(ctx, file)
+			if err != nil {
+				return err
+			}
+
+			for _, node := range tree.children[rel] {
+				next = append(next, newFile(file, snapshotNodeModel(tree.snapshot, node)))
+			}
+		}
+
+		levelFiles = next
+		level++
+	}
+
+	if walked >= limit {
+		return ErrFileCountLimitedReached
+	}
+
+	return nil
+}
+
+func (n *snapshotNavigator) FollowTx(ctx context.Context) (func(), error) {
+	if _, ok := ctx.Value(inventory.TxCtx{}).(*inventory.Tx); !ok {
+		return nil, fmt.Errorf("navigator: no inherited transaction found in context")
+	}
+	newFileClient, _, _, err := inventory.WithTx(ctx, n.fileClient)
+	if err != nil {
+		return nil, err
+	}
+
+	oldFileClient := n.fileClient
+	revert := func() {
+		n.fileClient = oldFileClient
+	}
+
+	n.fileClient = newFileClient
+	return revert, nil
+}
+
+func (n *snapshotNavigator) ExecuteHook(ctx context.Context, hookType fs.HookType, file *File) error {
+	return nil
+}
+
+func (n *snapshotNavigator) GetView(ctx context.Context, file *File) *types.ExplorerView {
+	if view, ok := n.user.Settings.FsViewMap[string(constants.FileSystemSnapshot)]; ok {
+		return &view
+	}
+	return defaultView
+}
+
+// tree returns the indexed manifest of given snapshot, owned by current user.
+func (n *snapshotNavigator) tree(ctx context.Context, id string) (*snapshotTree, error) {
+	snapshotID, err := n.hasher.Decode(id, hashid.SnapshotID)
+	if err != nil {
+		return nil, fs.ErrPathNotExist.WithError(fmt.Errorf("invalid snapshot id %q: %w", id, err))
+	}
+
+	if t, ok := n.trees[snapshotID]; ok {
+		return t, nil
+	}
+
+	s, err := n.snapshotClient.GetByID(ctx, snapshotID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fs.ErrPathNotExist.WithError(err)
+		}
+
+		return nil, fmt.Errorf("failed to get snapshot: %w", err)
+	}
+
+	if s.OwnerID != n.user.ID {
+		return nil, fs.ErrPathNotExist.WithError(fmt.Errorf("snapshot %q not found", id))
+	}
+
+	t := newSnapshotTree(s)
+	n.trees[snapshotID] = t
+	return t, nil
+}
+
+// locate returns the snapshot tree of given file and its path relative to snapshot root.
+func (n *snapshotNavigator) locate(ctx context.Context, file *File) (*snapshotTree, string, error) {
+	elements := file.Uri(false).Elements()
+	if len(elements) == 0 {
+		return nil, "", fs.ErrPathNotExist
+	}
+
+	tree, err := n.tree(ctx, elements[0])
+	if err != nil {
+		return nil, "", err
+	}
+
+	return tree, strings.Join(elements[1:], fs.Separator), nil
+}
+
+// loadEntities loads pinned entities of given snapshot files.
+func (n *snapshotNavigator) loadEntities(ctx context.Context, files []*File) error {
+	pending := lo.Filter(files, func(f *File, index int) bool {
+		return f.Model.PrimaryEntity > 0 && f.Model.Edges.Entities == nil
+	})
+	if len(pending) == 0 {
+		return nil
+	}
+
+	ids := lo.Uniq(lo.Map(pending, func(f *File, index int) int {
+		return f.Model.PrimaryEntity
+	}))
+	entities := make(map[int]*ent.Entity, len(ids))
+	for page := 0; page >= 0; {
+		res, next, err := n.fileClient.GetEntitiesByIDs(ctx, ids, page)
+		if err != nil {
+			return fmt.Errorf("failed to load snapshot entities: %w", err)
+		}
+
+		for _, e := range res {
+			entities[e.ID] = e
+		}
+		page = next
+	}
+
+	for _, f := range pending {
+		f.Model.Edges.Entities = make([]*ent.Entity, 0, 1)
+		if e, ok := entities[f.Model.PrimaryEntity]; ok {
+			f.Model.Edges.Entities = append(f.Model.Edges.Entities, e)
+		}
+	}
+
+	return nil
+}
+
+// snapshotRootModel builds the folder model presenting a snapshot under navigator root.
+func snapshotRootModel(s *ent.Snapshot, name string) *ent.File {
+	return &ent.File{
+		ID:        s.FileID,
+		CreatedAt: s.CreatedAt,
+		UpdatedAt: s.CreatedAt,
+		Type:      int(types.FileTypeFolder),
+		Name:      name,
+		OwnerID:   s.OwnerID,
+		Size:      s.Size,
+	}
+}
+
+// snapshotNodeModel builds the file model of a manifest node.
+func snapshotNodeModel(s *ent.Snapshot, node *types.SnapshotNode) *ent.File {
+	return &ent.File{
+		ID:                 node.FileID,
+		CreatedAt:          node.CreatedAt,
+		UpdatedAt:          node.UpdatedAt,
+		Type:               int(node.Type),
+		Name:               path.Base(node.Path),
+		OwnerID:            s.OwnerID,
+		Size:               node.Size,
+		PrimaryEntity:      node.Entity,
+		StoragePolicyFiles: node.Policy,
+	}
+}
+
+// sortSnapshotNodes sorts nodes by given order, folders always come first.
+func sortSnapshotNodes(nodes []*types.SnapshotNode, args *inventory.PaginationArgs) {
+	less := func(a, b *types.SnapshotNode) bool {
+		switch args.OrderBy {
+		case "size":
+			return a.Size < b.Size
+		case "updated_at":
+			return a.UpdatedAt.Before(b.UpdatedAt)
+		case "created_at":
+			return a.CreatedAt.Before(b.CreatedAt)
+		default:
+			return path.Base(a.Path) < path.Base(b.Path)
+		}
+	}
+
+	sort.SliceStable(nodes, func(i, j int) bool {
+		if nodes[i].Type != nodes[j].Type {
+			return nodes[i].Type == types.FileTypeFolder
+		}
+
+		if args.Order == inventory.OrderDirectionDesc {
+			return less(nodes[j], nodes[i])
+		}
+		return less(nodes[i], nodes[j])
+	})
+}
+
+// snapshotPageIndex returns the requested page index. Cursor pagination is emulated by using
+// page index as page token.
+func snapshotPageIndex(args *inventory.PaginationArgs) int {
+	if !args.UseCursorPagination {
+		return args.Page
+	}
+
+	page, err := strconv.Atoi(args.PageToken)
+	if err != nil || page < 0 {
+		return 0
+	}
+
+	return page
+}
+
+func snapshotPageResult(args *inventory.PaginationArgs, page, total int) *inventory.PaginationResults {
+	res := &inventory.PaginationResults{
+		Page:       page,
+		PageSize:   args.PageSize,
+		TotalItems: total,
+	}
+
+	if args.UseCursorPagination {
+		res.IsCursor = true
+		if (page+1)*args.PageSize < total {
+			res.NextPageToken = strconv.Itoa(page + 1)
+		}
+	}
+
+	return res
+}
@@ -155,7 +155,7 @@ func (f *DBFS) PrepareUpload(ctx context.Context, req *fs.UploadRequest, opts ..
 
 	// Generate save path by storage policy
 	isThumbnailAndPolicyNotAvailable := policy.ID != ancestor.Model.StoragePolicyFiles &&
-		(req.Props.EntityType != nil && *req.Props.EntityType == types.EntityTypeThumbnail) &&
+		(req.Props.EntityType != nil && req.Props.EntityType.IsThumbnail()) &&
 		req.ImportFrom == nil
 	if req.Props.SavePath == "" || isThumbnailAndPolicyNotAvailable {
 		req.Props.SavePath = generateSavePath(policy, req, f.user)
@@ -313,7 +313,7 @@ func (f *DBFS) CompleteUpload(ctx context.Context, session *fs.UploadSession) (f
 	}
 
 	// Remove metadata that are defined in upload session
-	err = fc.RemoveMetadata(ctx, filePrivate.Model, MetadataUploadSessionID, ThumbDisabledKey)
+	err = fc.RemoveMetadata(ctx, filePrivate.Model, append([]string{MetadataUploadSessionID}, ThumbDisabledKeys...)...)
 	if err != nil {
 		_ = inventory.Rollback(tx)
 		return nil, serializer.NewError(serializer.CodeDBError, "Failed to update placeholder metadata", err)
@@ -335,13 +335,26 @@ func (f *DBFS) CompleteUpload(ctx context.Context, session *fs.UploadSession) (f
 
 	if entityType == types.EntityTypeVersion {
 		// If updating version entity, we need to cap all existing thumbnail entity to let it re-generate.
-		diff, err = fc.CapEntities(ctx, filePrivate.Model, owner, 0, types.EntityTypeThumbnail)
-		if err != nil {
-			_ = inventory.Rollback(tx)
-			return nil, serializer.NewError(serializer.CodeDBError, "Failed to cap thumbnail entities", err)
+		for _, t := range []types.EntityType{types.EntityTypeThumbnail, types.EntityTypeThumbnailSmall, types.EntityTypeThumbnailLarge} {
+			diff, err = fc.CapEntities(ctx, filePrivate.Model, owner, 0, t)
+			if err != nil {
+				_ = inventory.Rollback(tx)
+				return nil, serializer.NewError(serializer.CodeDBError, "Failed to cap thumbnail entities", err)
+			}
+
+			tx.AppendStorageDiff(diff)
 		}
 
-		tx.AppendStorageDiff(diff)
+		// Transcoded renditions and storyboards are also outdated.
+		for _, t := range []types.EntityType{types.EntityTypeTranscode, types.EntityTypeStoryboard} {
+			diff, err = fc.CapEntities(ctx, filePrivate.Model, owner, 0, t)
+			if err != nil {
+				_ = inventory.Rollback(tx)
+				return nil, serializer.NewError(serializer.CodeDBError, "Failed to cap derived entities", err)
+			}
+
+			tx.AppendStorageDiff(diff)
+		}
 	}
 
 	if err := inventory.CommitWithStorageDiff(ctx, tx, f.l, f.userClient); err != nil {
@@ -356,6 +369,7 @@ func (f *DBFS) CompleteUpload(ctx context.Context, session *fs.UploadSession) (f
 	}
 
 	f.emitFileModified(ctx, filePrivate)
+	f.RecordActivity(ctx, filePrivate, types.FileActivityModify)
 
 	file, err = f.Get(ctx, session.Props.Uri, WithFileEntities(), WithNotRoot())
 	if err != nil {
@@ -415,7 +429,7 @@ func (f *DBFS) CancelUploadSession(ctx context.Context, path *fs.URI, sessionID
 	}
 
 	// Remove upload session metadata
-	if err := f.fileClient.RemoveMetadata(ctx, filePrivate.Model, MetadataUploadSessionID, ThumbDisabledKey); err != nil {
+	if err := f.fileClient.RemoveMetadata(ctx, filePrivate.Model, append([]string{MetadataUploadSessionID}, ThumbDisabledKeys...)...); err != nil {
 		return nil, serializer.NewError(serializer.CodeDBError, "Failed to remove upload session metadata", err)
 	}
 
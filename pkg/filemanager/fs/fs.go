@@ -93,12 +93,17 @@ type (
 		//  - `delete` is false: set version as current version;
 		//  - `delete` is true: delete version.
 		VersionControl(ctx context.Context, path *URI, versionId int, delete bool) error
+		// RestoreEntity links given version entity to the file at given path and sets it as current version.
+		// An empty file is created if target does not exist.
+		RestoreEntity(ctx context.Context, path *URI, entityID int) (File, error)
 		// GetFileFromDirectLink gets a file from a direct link.
 		GetFileFromDirectLink(ctx context.Context, dl *ent.DirectLink) (File, error)
 		// TraverseFile traverses a file to its root file, return the file with linked root.
 		TraverseFile(ctx context.Context, fileID int) (File, error)
 		// PatchProps patches the props of a file.
 		PatchProps(ctx context.Context, uri *URI, props *types.FileProps, delete bool) error
+		// RecordActivity records an activity of current user on given file, failures are only logged.
+		RecordActivity(ctx context.Context, file File, action types.FileActivityType)
 	}
 
 	UploadManager interface {
@@ -168,6 +173,9 @@ type (
 		Capabilities() *boolset.BooleanSet
 		IsRootFolder() bool
 		View() *types.ExplorerView
+		VersionRetention() *types.VersionRetentionSchedule
+		// LastOpened returns when the file is last opened by current user, only available in recent files.
+		LastOpened() *time.Time
 	}
 
 	Entities []Entity
@@ -193,6 +201,8 @@ type (
 		Shares                []*ent.Share
 		EntityStoragePolicies map[int]*ent.StoragePolicy
 		View                  *types.ExplorerView
+		VersionRetention      *types.VersionRetentionSchedule
+		Snapshot              *types.SnapshotSchedule
 		DirectLinks           []*ent.DirectLink
 	}
 
@@ -318,6 +328,8 @@ type (
 		SysSkipSoftDelete  bool
 		Metadata           map[string]string
 		ArchiveCompression bool
+		ArchiveFormat      string
+		ArchivePassword    string
 		ProgressFunc
 		MaxArchiveSize  int64
 		DryRun          CreateArchiveDryRunFunc
@@ -577,6 +589,20 @@ func WithArchiveCompression(b bool) Option {
 	})
 }
 
+// WithArchiveFormat sets container format of created archive, empty for zip.
+func WithArchiveFormat(f string) Option {
+	return OptionFunc(func(o *FsOption) {
+		o.ArchiveFormat = f
+	})
+}
+
+// WithArchivePassword sets password used to encrypt created archive.
+func WithArchivePassword(p string) Option {
+	return OptionFunc(func(o *FsOption) {
+		o.ArchivePassword = p
+	})
+}
+
 // WithMaxArchiveSize sets maximum size of to be archived file or to-be decompressed
 // size, 0 for unlimited.
 func WithMaxArchiveSize(s int64) Option {
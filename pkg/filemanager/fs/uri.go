@@ -368,6 +368,16 @@ func NewMyUri(id string) string {
 	return fmt.Sprintf("%s://%s@%s", constants.CloudreveScheme, id, constants.FileSystemMy)
 }
 
+// NewSnapshotUri returns the URI of given snapshot under snapshot file system.
+func NewSnapshotUri(id string) string {
+	return fmt.Sprintf("%s://%s/%s", constants.CloudreveScheme, constants.FileSystemSnapshot, id)
+}
+
+// NewAlbumUri returns the URI of given album under photos file system.
+func NewAlbumUri(name string) string {
+	return fmt.Sprintf("%s://%s/albums/%s", constants.CloudreveScheme, constants.FileSystemPhotos, PathEscape(name))
+}
+
 // PathEscape is same as url.PathEscape, with modifications to incoporate with JS encodeURIComponent:
 // encodeURI() escapes all characters except:
 //
@@ -85,34 +85,14 @@ var ZipEncodings = map[string]encoding.Encoding{
 }
 
 func (m *manager) ListArchiveFiles(ctx context.Context, uri *fs.URI, entity, zipEncoding string) ([]ArchivedFile, error) {
-	file, err := m.fs.Get(ctx, uri, dbfs.WithFileEntities(), dbfs.WithRequiredCapabilities(dbfs.NavigatorCapabilityDownloadFile))
+	file, targetEntity, err := m.getArchiveEntity(ctx, uri, entity)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get file: %w", err)
-	}
-
-	if file.Type() != types.FileTypeFile {
-		return nil, fs.ErrNotSupportedAction.WithError(fmt.Errorf("path %s is not a file", uri))
-	}
-
-	// Validate file size
-	if m.user.Edges.Group.Settings.DecompressSize > 0 && file.Size() > m.user.Edges.Group.Settings.DecompressSize {
-		return nil, fs.ErrFileSizeTooBig.WithError(fmt.Errorf("file size %d exceeds the limit %d", file.Size(), m.user.Edges.Group.Settings.DecompressSize))
+		return nil, err
 	}
 
-	found, targetEntity := fs.FindDesiredEntity(file, entity, m.hasher, nil)
-	if !found {
-		return nil, fs.ErrEntityNotExist
-	}
-
-	var (
-		enc encoding.Encoding
-		ok  bool
-	)
-	if zipEncoding != "" {
-		enc, ok = ZipEncodings[strings.ToLower(zipEncoding)]
-		if !ok {
-			return nil, fs.ErrNotSupportedAction.WithError(fmt.Errorf("not supported zip encoding: %s", zipEncoding))
-		}
+	enc, err := zipEncodingByName(zipEncoding)
+	if err != nil {
+		return nil, err
 	}
 
 	cacheKey := getArchiveListCacheKey(targetEntity.ID(), zipEncoding)
@@ -137,7 +117,15 @@ func (m *manager) ListArchiveFiles(ctx context.Context, uri *fs.URI, entity, zip
 	case "7z":
 		readerFunc = get7zFileList
 	default:
-		return nil, fs.ErrNotSupportedAction.WithError(fmt.Errorf("not supported archive format: %s", file.Ext()))
+		if !isStreamArchive(file.DisplayName()) {
+			return nil, fs.ErrNotSupportedAction.WithError(fmt.Errorf("not supported archive format: %s", file.Ext()))
+		}
+
+		// Stream archives can only be read sequentially, use entity source as a reader directly
+		// to avoid issuing range requests for each read.
+		readerFunc = func(ctx context.Context, _ io.ReaderAt, _ int64, _ encoding.Encoding) ([]ArchivedFile, error) {
+			return getStreamArchiveFileList(ctx, file.DisplayName(), es)
+		}
 	}
 
 	sr := io.NewSectionReader(es, 0, targetEntity.Size())
@@ -150,6 +138,43 @@ func (m *manager) ListArchiveFiles(ctx context.Context, uri *fs.URI, entity, zip
 	return fileList, nil
 }
 
+// getArchiveEntity finds the archive file and its desired entity, validating size limit of archive files.
+func (m *manager) getArchiveEntity(ctx context.Context, uri *fs.URI, entity string) (fs.File, fs.Entity, error) {
+	file, err := m.fs.Get(ctx, uri, dbfs.WithFileEntities(), dbfs.WithRequiredCapabilities(dbfs.NavigatorCapabilityDownloadFile))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get file: %w", err)
+	}
+
+	if file.Type() != types.FileTypeFile {
+		return nil, nil, fs.ErrNotSupportedAction.WithError(fmt.Errorf("path %s is not a file", uri))
+	}
+
+	// Validate file size
+	if m.user.Edges.Group.Settings.DecompressSize > 0 && file.Size() > m.user.Edges.Group.Settings.DecompressSize {
+		return nil, nil, fs.ErrFileSizeTooBig.WithError(fmt.Errorf("file size %d exceeds the limit %d", file.Size(), m.user.Edges.Group.Settings.DecompressSize))
+	}
+
+	found, targetEntity := fs.FindDesiredEntity(file, entity, m.hasher, nil)
+	if !found {
+		return nil, nil, fs.ErrEntityNotExist
+	}
+
+	return file, targetEntity, nil
+}
+
+func zipEncodingByName(name string) (encoding.Encoding, error) {
+	if name == "" {
+		return nil, nil
+	}
+
+	enc, ok := ZipEncodings[strings.ToLower(name)]
+	if !ok {
+		return nil, fs.ErrNotSupportedAction.WithError(fmt.Errorf("not supported zip encoding: %s", name))
+	}
+
+	return enc, nil
+}
+
 func (m *manager) CreateArchive(ctx context.Context, uris []*fs.URI, writer io.Writer, opts ...fs.Option) (int, error) {
 	o := newOption()
 	for _, opt := range opts {
@@ -169,13 +194,16 @@ func (m *manager) CreateArchive(ctx context.Context, uris []*fs.URI, writer io.W
 		files = append(files, file)
 	}
 
-	zipWriter := zip.NewWriter(writer)
-	defer zipWriter.Close()
+	archiveWriter, err := NewArchiveWriter(writer, ArchiveFormat(o.ArchiveFormat), o.ArchiveCompression, o.ArchivePassword)
+	if err != nil {
+		return 0, fs.ErrNotSupportedAction.WithError(err)
+	}
+	defer archiveWriter.Close()
 
 	var compressed int64
 	for _, file := range files {
 		if file.Type() == types.FileTypeFile {
-			if err := m.compressFileToArchive(ctx, "/", file, zipWriter, o.ArchiveCompression, o.DryRun); err != nil {
+			if err := m.compressFileToArchive(ctx, "/", file, archiveWriter, o.DryRun); err != nil {
 				failed++
 				m.l.Warning("Failed to compress file %s: %s, skipping it...", file.Uri(false), err)
 			}
@@ -195,7 +223,7 @@ func (m *manager) CreateArchive(ctx context.Context, uris []*fs.URI, writer io.W
 					return nil
 				}
 				if err := m.compressFileToArchive(ctx, strings.TrimPrefix(f.Uri(false).Dir(),
-					file.Uri(false).Dir()), f, zipWriter, o.ArchiveCompression, o.DryRun); err != nil {
+					file.Uri(false).Dir()), f, archiveWriter, o.DryRun); err != nil {
 					failed++
 					m.l.Warning("Failed to compress file %s: %s, skipping it...", f.Uri(false), err)
 				}
@@ -220,8 +248,8 @@ func (m *manager) CreateArchive(ctx context.Context, uris []*fs.URI, writer io.W
 	return failed, nil
 }
 
-func (m *manager) compressFileToArchive(ctx context.Context, parent string, file fs.File, zipWriter *zip.Writer,
-	compression bool, dryrun fs.CreateArchiveDryRunFunc) error {
+func (m *manager) compressFileToArchive(ctx context.Context, parent string, file fs.File, archiveWriter ArchiveWriter,
+	dryrun fs.CreateArchiveDryRunFunc) error {
 	es, err := m.GetEntitySource(ctx, file.PrimaryEntityID())
 	if err != nil {
 		return fmt.Errorf("failed to get entity source for file %s: %w", file.Uri(false), err)
@@ -234,21 +262,9 @@ func (m *manager) compressFileToArchive(ctx context.Context, parent string, file
 	}
 
 	m.l.Debug("Compressing %s to archive...", file.Uri(false))
-	header := &zip.FileHeader{
-		Name:               zipName,
-		Modified:           file.UpdatedAt(),
-		UncompressedSize64: uint64(file.Size()),
-	}
-
-	if !compression {
-		header.Method = zip.Store
-	} else {
-		header.Method = zip.Deflate
-	}
-
-	writer, err := zipWriter.CreateHeader(header)
+	writer, err := archiveWriter.Create(zipName, file.UpdatedAt(), es.Entity().Size())
 	if err != nil {
-		return fmt.Errorf("failed to create zip header for %s: %w", file.Uri(false), err)
+		return fmt.Errorf("failed to create archive header for %s: %w", file.Uri(false), err)
 	}
 
 	es.Apply(entitysource.WithContext(ctx))
@@ -265,25 +281,10 @@ func getZipFileList(ctx context.Context, file io.ReaderAt, size int64, textEncod
 
 	fileList := make([]ArchivedFile, 0, len(zr.File))
 	for _, f := range zr.File {
-		hdr := f.FileHeader
-		if hdr.NonUTF8 && textEncoding != nil {
-			dec := textEncoding.NewDecoder()
-			filename, err := dec.String(hdr.Name)
-			if err == nil {
-				hdr.Name = filename
-			}
-			if hdr.Comment != "" {
-				comment, err := dec.String(hdr.Comment)
-				if err == nil {
-					hdr.Comment = comment
-				}
-			}
-		}
-
 		info := f.FileInfo()
 		modTime := info.ModTime()
 		fileList = append(fileList, ArchivedFile{
-			Name:        util.FormSlash(hdr.Name),
+			Name:        util.FormSlash(decodeZipFileName(&f.FileHeader, textEncoding)),
 			Size:        info.Size(),
 			UpdatedAt:   &modTime,
 			IsDirectory: info.IsDir(),
@@ -292,6 +293,18 @@ func getZipFileList(ctx context.Context, file io.ReaderAt, size int64, textEncod
 	return fileList, nil
 }
 
+// decodeZipFileName returns name of the zip file entry, decoded with textEncoding if it's not UTF-8.
+func decodeZipFileName(hdr *zip.FileHeader, textEncoding encoding.Encoding) string {
+	if hdr.NonUTF8 && textEncoding != nil {
+		filename, err := textEncoding.NewDecoder().String(hdr.Name)
+		if err == nil {
+			return filename
+		}
+	}
+
+	return hdr.Name
+}
+
 func get7zFileList(ctx context.Context, file io.ReaderAt, size int64, extEncoding encoding.Encoding) ([]ArchivedFile, error) {
 	zr, err := sevenzip.NewReader(file, size)
 	if err != nil {
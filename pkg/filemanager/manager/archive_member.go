@@ -0,0 +1,364 @@
+package manager
+
+import (
+	"archive/zip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	iofs "io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bodgit/sevenzip"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager/entitysource"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/gofrs/uuid"
+	"github.com/mholt/archives"
+	"github.com/samber/lo"
+	"golang.org/x/text/encoding"
+)
+
+type (
+	// ArchiveMember is a single file read out of an archive. Reader also implements io.ReadSeeker
+	// if random access is supported for this member.
+	ArchiveMember struct {
+		io.Reader
+		Name      string
+		Size      int64
+		UpdatedAt time.Time
+
+		closeFuncs []func() error
+	}
+)
+
+const (
+	archiveMemberTempFolder = "archive_member"
+)
+
+// streamArchiveExts are extensions of archives that can only be read sequentially.
+var streamArchiveExts = []string{"rar", "tar", "tgz", "tzst", "gz", "zst", "bz2", "xz"}
+
+// Close releases all resources held by the member.
+func (a *ArchiveMember) Close() error {
+	var errs []error
+	for i := len(a.closeFuncs) - 1; i >= 0; i-- {
+		if err := a.closeFuncs[i](); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (a *ArchiveMember) onClose(f func() error) {
+	a.closeFuncs = append(a.closeFuncs, f)
+}
+
+func (m *manager) OpenArchiveMember(ctx context.Context, uri *fs.URI, entity, member, zipEncoding string) (*ArchiveMember, error) {
+	res, _, err := m.openArchiveMember(ctx, uri, entity, member, zipEncoding)
+	return res, err
+}
+
+// openArchiveMember opens the archive member, also returns entity source of the archive, which
+// is closed together with returned member.
+func (m *manager) openArchiveMember(ctx context.Context, uri *fs.URI, entity, member, zipEncoding string) (*ArchiveMember, entitysource.EntitySource, error) {
+	file, targetEntity, err := m.getArchiveEntity(ctx, uri, entity)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	enc, err := zipEncodingByName(zipEncoding)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	member = normalizeArchiveMemberName(member)
+	if member == "" || member == "." {
+		return nil, nil, fs.ErrPathNotExist.WithError(fmt.Errorf("empty archive member name"))
+	}
+
+	es, err := m.GetEntitySource(ctx, 0, fs.WithEntity(targetEntity))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get entity source: %w", err)
+	}
+
+	es.Apply(entitysource.WithContext(ctx))
+
+	var res *ArchiveMember
+	sr := io.NewSectionReader(es, 0, targetEntity.Size())
+	switch file.Ext() {
+	case "zip":
+		res, err = openZipMember(sr, targetEntity.Size(), member, enc)
+	case "7z":
+		res, err = open7zMember(sr, targetEntity.Size(), member)
+	default:
+		if !isStreamArchive(file.DisplayName()) {
+			err = fs.ErrNotSupportedAction.WithError(fmt.Errorf("not supported archive format: %s", file.Ext()))
+			break
+		}
+
+		res, err = openStreamArchiveMember(ctx, file.DisplayName(), es, member)
+	}
+
+	if err != nil {
+		es.Close()
+		return nil, nil, err
+	}
+
+	res.onClose(es.Close)
+	return res, es, nil
+}
+
+func (m *manager) ArchiveMemberThumbnail(ctx context.Context, uri *fs.URI, entity, member, zipEncoding string) (*ArchiveMember, error) {
+	src, archiveSrc, err := m.openArchiveMember(ctx, uri, entity, member, zipEncoding)
+	if err != nil {
+		return nil, err
+	}
+	defer src.Close()
+
+	// Thumb generators requires a seekable source, save member into a temp file first.
+	tempPath := filepath.Join(
+		util.DataPath(m.settings.TempPath(ctx)),
+		archiveMemberTempFolder,
+		fmt.Sprintf("%s_%s", uuid.Must(uuid.NewV4()), filepath.Base(src.Name)),
+	)
+	tempFile, err := util.CreatNestedFile(tempPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temp file: %w", err)
+	}
+
+	_, err = io.Copy(tempFile, src)
+	tempFile.Close()
+	defer os.Remove(tempPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read archive member: %w", err)
+	}
+
+	memberSrc, err := archiveSrc.CloneToLocalSrc(types.EntityTypeVersion, tempPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create member entity source: %w", err)
+	}
+	defer memberSrc.Close()
+
+	m.l.Debug("Generating thumb for member %q in archive %q...", src.Name, uri)
+	res, err := m.dep.ThumbPipeline().Generate(ctx, memberSrc, util.Ext(src.Name), nil)
+	if err != nil {
+		if res != nil && res.Path != "" {
+			_ = os.Remove(res.Path)
+		}
+
+		return nil, fmt.Errorf("failed to generate thumb: %w", err)
+	}
+
+	thumbFile, err := os.Open(res.Path)
+	if err != nil {
+		_ = os.Remove(res.Path)
+		return nil, fmt.Errorf("failed to open temp thumb %q: %w", res.Path, err)
+	}
+
+	stat, err := thumbFile.Stat()
+	if err != nil {
+		thumbFile.Close()
+		_ = os.Remove(res.Path)
+		return nil, fmt.Errorf("failed to stat temp thumb %q: %w", res.Path, err)
+	}
+
+	thumb := &ArchiveMember{
+		Reader:    thumbFile,
+		Name:      src.Name + ".jpg",
+		Size:      stat.Size(),
+		UpdatedAt: src.UpdatedAt,
+	}
+	thumb.onClose(func() error { return os.Remove(res.Path) })
+	thumb.onClose(thumbFile.Close)
+	return thumb, nil
+}
+
+func openZipMember(file io.ReaderAt, size int64, member string, textEncoding encoding.Encoding) (*ArchiveMember, error) {
+	zr, err := zip.NewReader(file, size)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create zip reader: %w", err)
+	}
+
+	for _, f := range zr.File {
+		name := decodeZipFileName(&f.FileHeader, textEncoding)
+		if f.FileInfo().IsDir() || normalizeArchiveMemberName(name) != member {
+			continue
+		}
+
+		res := &ArchiveMember{
+			Name:      path.Base(util.FormSlash(name)),
+			Size:      int64(f.UncompressedSize64),
+			UpdatedAt: f.Modified,
+		}
+
+		// Stored and not encrypted member can be read with random access.
+		if f.Method == zip.Store && f.Flags&zipFlagEncrypted == 0 {
+			offset, err := f.DataOffset()
+			if err != nil {
+				return nil, fmt.Errorf("failed to get data offset of %q: %w", name, err)
+			}
+
+			res.Reader = io.NewSectionReader(file, offset, int64(f.UncompressedSize64))
+			return res, nil
+		}
+
+		rc, err := f.Open()
+		if err != nil {
+			return nil, fmt.Errorf("failed to open %q: %w", name, err)
+		}
+
+		res.Reader = rc
+		res.onClose(rc.Close)
+		return res, nil
+	}
+
+	return nil, fs.ErrPathNotExist.WithError(fmt.Errorf("member %q not found in archive", member))
+}
+
+func open7zMember(file io.ReaderAt, size int64, member string) (*ArchiveMember, error) {
+	zr, err := sevenzip.NewReader(file, size)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create 7z reader: %w", err)
+	}
+
+	for _, f := range zr.File {
+		info := f.FileInfo()
+		if info.IsDir() || normalizeArchiveMemberName(f.Name) != member {
+			continue
+		}
+
+		rc, err := f.Open()
+		if err != nil {
+			return nil, fmt.Errorf("failed to open %q: %w", f.Name, err)
+		}
+
+		res := &ArchiveMember{
+			Reader:    rc,
+			Name:      path.Base(util.FormSlash(f.Name)),
+			Size:      info.Size(),
+			UpdatedAt: info.ModTime(),
+		}
+		res.onClose(rc.Close)
+		return res, nil
+	}
+
+	return nil, fs.ErrPathNotExist.WithError(fmt.Errorf("member %q not found in archive", member))
+}
+
+// openStreamArchiveMember scans the archive sequentially until member is found, its content is then
+// piped to the returned reader. Scanning stops once returned member is closed.
+func openStreamArchiveMember(ctx context.Context, name string, r io.Reader, member string) (*ArchiveMember, error) {
+	format, stream, err := archives.Identify(ctx, name, r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to identify archive format: %w", err)
+	}
+
+	extractor, ok := format.(archives.Extractor)
+	if !ok {
+		return nil, fs.ErrNotSupportedAction.WithError(fmt.Errorf("format not an extractor %s", format.Extension()))
+	}
+
+	var (
+		pr, pw     = io.Pipe()
+		found      = make(chan *ArchiveMember, 1)
+		done       = make(chan struct{})
+		extractErr error
+	)
+
+	go func() {
+		defer close(done)
+		defer close(found)
+
+		extractErr = extractor.Extract(ctx, stream, func(ctx context.Context, f archives.FileInfo) error {
+			if f.IsDir() || normalizeArchiveMemberName(f.NameInArchive) != member {
+				return nil
+			}
+
+			rc, err := f.Open()
+			if err != nil {
+				return fmt.Errorf("failed to open %q: %w", f.NameInArchive, err)
+			}
+			defer rc.Close()
+
+			found <- &ArchiveMember{
+				Reader:    pr,
+				Name:      path.Base(util.FormSlash(f.NameInArchive)),
+				Size:      f.Size(),
+				UpdatedAt: f.ModTime(),
+			}
+
+			if _, err := io.Copy(pw, rc); err != nil {
+				pw.CloseWithError(err)
+				return err
+			}
+
+			pw.Close()
+			return iofs.SkipAll
+		})
+	}()
+
+	res, ok := <-found
+	if !ok {
+		<-done
+		if extractErr != nil {
+			return nil, fmt.Errorf("failed to read archive: %w", extractErr)
+		}
+
+		return nil, fs.ErrPathNotExist.WithError(fmt.Errorf("member %q not found in archive", member))
+	}
+
+	var closeOnce sync.Once
+	res.onClose(func() error {
+		closeOnce.Do(func() {
+			pr.Close()
+			<-done
+		})
+		return nil
+	})
+	return res, nil
+}
+
+func getStreamArchiveFileList(ctx context.Context, name string, r io.Reader) ([]ArchivedFile, error) {
+	format, stream, err := archives.Identify(ctx, name, r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to identify archive format: %w", err)
+	}
+
+	extractor, ok := format.(archives.Extractor)
+	if !ok {
+		return nil, fs.ErrNotSupportedAction.WithError(fmt.Errorf("format not an extractor %s", format.Extension()))
+	}
+
+	fileList := make([]ArchivedFile, 0)
+	err = extractor.Extract(ctx, stream, func(ctx context.Context, f archives.FileInfo) error {
+		modTime := f.ModTime()
+		fileList = append(fileList, ArchivedFile{
+			Name:        util.FormSlash(f.NameInArchive),
+			Size:        f.Size(),
+			UpdatedAt:   &modTime,
+			IsDirectory: f.IsDir(),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return fileList, nil
+}
+
+func isStreamArchive(name string) bool {
+	return lo.Contains(streamArchiveExts, util.Ext(name))
+}
+
+func normalizeArchiveMemberName(name string) string {
+	return strings.TrimPrefix(util.FormSlash(name), "/")
+}
@@ -0,0 +1,128 @@
+package manager
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/stretchr/testify/assert"
+)
+
+func assertPathNotExist(t *testing.T, err error) {
+	var appErr serializer.AppError
+	if assert.True(t, errors.As(err, &appErr)) {
+		assert.Equal(t, serializer.CodeParentNotExist, appErr.ErrCode())
+	}
+}
+
+func TestNormalizeArchiveMemberName(t *testing.T) {
+	tests := map[string]string{
+		"a.txt":           "a.txt",
+		"/a.txt":          "a.txt",
+		"dir\\sub\\a.txt": "dir/sub/a.txt",
+		"./dir//a.txt":    "dir/a.txt",
+		"/dir/../a.txt":   "a.txt",
+	}
+
+	for name, expected := range tests {
+		assert.Equal(t, expected, normalizeArchiveMemberName(name), name)
+	}
+}
+
+func TestOpenZipMember(t *testing.T) {
+	a := assert.New(t)
+	modified := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	buf := &bytes.Buffer{}
+	zw := zip.NewWriter(buf)
+	for _, f := range []struct {
+		name   string
+		method uint16
+	}{
+		{"dir/", zip.Store},
+		{"dir/stored.txt", zip.Store},
+		{"dir\\deflated.txt", zip.Deflate},
+	} {
+		w, err := zw.CreateHeader(&zip.FileHeader{Name: f.name, Method: f.method, Modified: modified})
+		a.NoError(err)
+		if f.name != "dir/" {
+			_, err = w.Write([]byte("content of " + f.name))
+			a.NoError(err)
+		}
+	}
+	a.NoError(zw.Close())
+	file := bytes.NewReader(buf.Bytes())
+
+	// Stored member supports random access
+	res, err := openZipMember(file, file.Size(), "dir/stored.txt", nil)
+	a.NoError(err)
+	a.Equal("stored.txt", res.Name)
+	a.EqualValues(len("content of dir/stored.txt"), res.Size)
+	a.True(modified.Equal(res.UpdatedAt))
+	seeker, ok := res.Reader.(io.ReadSeeker)
+	a.True(ok)
+	_, err = seeker.Seek(11, io.SeekStart)
+	a.NoError(err)
+	content, err := io.ReadAll(res)
+	a.NoError(err)
+	a.Equal("dir/stored.txt", string(content))
+	a.NoError(res.Close())
+
+	// Deflated member is matched by normalized name
+	res, err = openZipMember(file, file.Size(), "dir/deflated.txt", nil)
+	a.NoError(err)
+	a.Equal("deflated.txt", res.Name)
+	_, ok = res.Reader.(io.Seeker)
+	a.False(ok)
+	content, err = io.ReadAll(res)
+	a.NoError(err)
+	a.Equal("content of dir\\deflated.txt", string(content))
+	a.NoError(res.Close())
+
+	// Directories and missing members are not found
+	for _, member := range []string{"dir", "missing.txt"} {
+		_, err = openZipMember(file, file.Size(), member, nil)
+		assertPathNotExist(t, err)
+	}
+}
+
+func TestOpenStreamArchiveMember(t *testing.T) {
+	a := assert.New(t)
+	modified := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	buf := &bytes.Buffer{}
+	tw := tar.NewWriter(buf)
+	a.NoError(tw.WriteHeader(&tar.Header{Typeflag: tar.TypeDir, Name: "dir/", Mode: 0755, ModTime: modified}))
+	for _, name := range []string{"dir/a.txt", "dir/b.txt"} {
+		content := []byte("content of " + name)
+		a.NoError(tw.WriteHeader(&tar.Header{Typeflag: tar.TypeReg, Name: name, Size: int64(len(content)), Mode: 0644, ModTime: modified}))
+		_, err := tw.Write(content)
+		a.NoError(err)
+	}
+	a.NoError(tw.Close())
+	ctx := context.Background()
+
+	res, err := openStreamArchiveMember(ctx, "archive.tar", bytes.NewReader(buf.Bytes()), "dir/b.txt")
+	a.NoError(err)
+	a.Equal("b.txt", res.Name)
+	a.EqualValues(len("content of dir/b.txt"), res.Size)
+	a.True(modified.Equal(res.UpdatedAt))
+	content, err := io.ReadAll(res)
+	a.NoError(err)
+	a.Equal("content of dir/b.txt", string(content))
+	a.NoError(res.Close())
+
+	// Closing member before reading it stops scanning
+	res, err = openStreamArchiveMember(ctx, "archive.tar", bytes.NewReader(buf.Bytes()), "dir/a.txt")
+	a.NoError(err)
+	a.NoError(res.Close())
+
+	for _, member := range []string{"dir", "missing.txt"} {
+		_, err = openStreamArchiveMember(ctx, "archive.tar", bytes.NewReader(buf.Bytes()), member)
+		assertPathNotExist(t, err)
+	}
+}
@@ -0,0 +1,221 @@
+package manager
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+type (
+	// ArchiveFormat is the container format used when creating archives.
+	ArchiveFormat string
+
+	// ArchiveWriter writes entries into an archive stream sequentially.
+	ArchiveWriter interface {
+		// Create adds a new file entry and returns a writer for its content. Content of
+		// previous entry must be fully written before calling Create again.
+		Create(name string, modified time.Time, size int64) (io.Writer, error)
+		// Close flushes and finalizes the archive, underlying writer is not closed.
+		Close() error
+	}
+)
+
+const (
+	ArchiveFormatZip    ArchiveFormat = "zip"
+	ArchiveFormatTar    ArchiveFormat = "tar"
+	ArchiveFormatTarGz  ArchiveFormat = "tar.gz"
+	ArchiveFormatTarZst ArchiveFormat = "tar.zst"
+)
+
+// Ext returns the file extension of the format, without leading dot.
+func (f ArchiveFormat) Ext() string {
+	if f == "" {
+		return string(ArchiveFormatZip)
+	}
+	return string(f)
+}
+
+// ContentType returns the MIME type of the format.
+func (f ArchiveFormat) ContentType() string {
+	switch f {
+	case ArchiveFormatTar:
+		return "application/x-tar"
+	case ArchiveFormatTarGz:
+		return "application/gzip"
+	case ArchiveFormatTarZst:
+		return "application/zstd"
+	default:
+		return "application/zip"
+	}
+}
+
+// Valid returns whether the format is supported. Empty format is treated as zip.
+func (f ArchiveFormat) Valid() bool {
+	switch f {
+	case "", ArchiveFormatZip, ArchiveFormatTar, ArchiveFormatTarGz, ArchiveFormatTarZst:
+		return true
+	}
+	return false
+}
+
+// SupportEncryption returns whether the format supports password protection.
+func (f ArchiveFormat) SupportEncryption() bool {
+	return f == "" || f == ArchiveFormatZip
+}
+
+// ArchiveFormatFromName infers archive format from file name, falls back to zip.
+func ArchiveFormatFromName(name string) ArchiveFormat {
+	name = strings.ToLower(name)
+	switch {
+	case strings.HasSuffix(name, ".tar.gz"), strings.HasSuffix(name, ".tgz"):
+		return ArchiveFormatTarGz
+	case strings.HasSuffix(name, ".tar.zst"), strings.HasSuffix(name, ".tzst"):
+		return ArchiveFormatTarZst
+	case strings.HasSuffix(name, ".tar"):
+		return ArchiveFormatTar
+	default:
+		return ArchiveFormatZip
+	}
+}
+
+// NewArchiveWriter creates an ArchiveWriter of given format on top of w. compression only
+// affects zip format, tar.gz and tar.zst are always compressed, tar is never compressed.
+// A non-empty password encrypts entries with AES-256, only zip format supports it.
+func NewArchiveWriter(w io.Writer, format ArchiveFormat, compression bool, password string) (ArchiveWriter, error) {
+	if password != "" && !format.SupportEncryption() {
+		return nil, fmt.Errorf("archive format %s does not support encryption", format)
+	}
+
+	switch format {
+	case "", ArchiveFormatZip:
+		zw := zip.NewWriter(w)
+		if password != "" {
+			zw.RegisterCompressor(zipMethodWinZipAES, newZipAESCompressor(password, compression))
+		}
+		return &zipArchiveWriter{w: zw, compression: compression, encrypted: password != ""}, nil
+	case ArchiveFormatTar:
+		return &tarArchiveWriter{w: tar.NewWriter(w)}, nil
+	case ArchiveFormatTarGz:
+		gw := gzip.NewWriter(w)
+		return &tarArchiveWriter{w: tar.NewWriter(gw), compressor: gw}, nil
+	case ArchiveFormatTarZst:
+		zw, err := zstd.NewWriter(w)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create zstd writer: %w", err)
+		}
+		return &tarArchiveWriter{w: tar.NewWriter(zw), compressor: zw}, nil
+	default:
+		return nil, fmt.Errorf("unsupported archive format: %s", format)
+	}
+}
+
+type zipArchiveWriter struct {
+	w           *zip.Writer
+	compression bool
+	encrypted   bool
+}
+
+func (z *zipArchiveWriter) Create(name string, modified time.Time, size int64) (io.Writer, error) {
+	header := &zip.FileHeader{
+		Name:               name,
+		Modified:           modified,
+		UncompressedSize64: uint64(size),
+		Method:             zip.Store,
+	}
+
+	if z.compression {
+		header.Method = zip.Deflate
+	}
+
+	if z.encrypted {
+		header.Extra = zipAESExtra(header.Method)
+		header.Method = zipMethodWinZipAES
+		header.Flags |= zipFlagEncrypted
+	}
+
+	return z.w.CreateHeader(header)
+}
+
+func (z *zipArchiveWriter) Close() error {
+	return z.w.Close()
+}
+
+type tarArchiveWriter struct {
+	w          *tar.Writer
+	compressor io.WriteCloser
+	current    *tarEntryWriter
+}
+
+func (t *tarArchiveWriter) Create(name string, modified time.Time, size int64) (io.Writer, error) {
+	if err := t.finishEntry(); err != nil {
+		return nil, err
+	}
+
+	header := &tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     strings.TrimPrefix(filepath.ToSlash(name), "/"),
+		Size:     size,
+		Mode:     0644,
+		ModTime:  modified,
+	}
+
+	if err := t.w.WriteHeader(header); err != nil {
+		return nil, err
+	}
+
+	t.current = &tarEntryWriter{w: t.w, remaining: size}
+	return t.current, nil
+}
+
+// finishEntry pads previous entry with zeros if its content was not fully written,
+// tar entries have fixed size, a short entry would otherwise corrupt the whole stream.
+func (t *tarArchiveWriter) finishEntry() error {
+	if t.current == nil || t.current.remaining <= 0 {
+		return nil
+	}
+
+	_, err := io.CopyN(t.w, zeroReader{}, t.current.remaining)
+	t.current = nil
+	return err
+}
+
+func (t *tarArchiveWriter) Close() error {
+	if err := t.finishEntry(); err != nil {
+		return err
+	}
+
+	if err := t.w.Close(); err != nil {
+		return err
+	}
+
+	if t.compressor != nil {
+		return t.compressor.Close()
+	}
+
+	return nil
+}
+
+type tarEntryWriter struct {
+	w         io.Writer
+	remaining int64
+}
+
+func (e *tarEntryWriter) Write(p []byte) (int, error) {
+	n, err := e.w.Write(p)
+	e.remaining -= int64(n)
+	return n, err
+}
+
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	clear(p)
+	return len(p), nil
+}
@@ -0,0 +1,113 @@
+package manager
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArchiveFormatFromName(t *testing.T) {
+	tests := map[string]ArchiveFormat{
+		"a.zip":     ArchiveFormatZip,
+		"a.tar":     ArchiveFormatTar,
+		"a.TAR.GZ":  ArchiveFormatTarGz,
+		"a.tgz":     ArchiveFormatTarGz,
+		"a.tar.zst": ArchiveFormatTarZst,
+		"a.tzst":    ArchiveFormatTarZst,
+		"a.gz":      ArchiveFormatZip,
+		"a":         ArchiveFormatZip,
+	}
+
+	for name, expected := range tests {
+		assert.Equal(t, expected, ArchiveFormatFromName(name), name)
+	}
+}
+
+func TestTarArchiveWriter_ShortEntry(t *testing.T) {
+	a := assert.New(t)
+	buf := &bytes.Buffer{}
+	w, err := NewArchiveWriter(buf, ArchiveFormatTar, false, "")
+	a.NoError(err)
+
+	// First entry is written short and padded with zeros
+	modified := time.Unix(1700000000, 0)
+	ew, err := w.Create("/a.txt", modified, 5)
+	a.NoError(err)
+	_, err = ew.Write([]byte("ab"))
+	a.NoError(err)
+
+	ew, err = w.Create("b.txt", modified, 3)
+	a.NoError(err)
+	_, err = ew.Write([]byte("xyz"))
+	a.NoError(err)
+	a.NoError(w.Close())
+
+	r := tar.NewReader(buf)
+	expected := []struct {
+		name    string
+		content []byte
+	}{
+		{"a.txt", []byte{'a', 'b', 0, 0, 0}},
+		{"b.txt", []byte("xyz")},
+	}
+	for _, e := range expected {
+		header, err := r.Next()
+		a.NoError(err)
+		a.Equal(e.name, header.Name)
+		a.True(modified.Equal(header.ModTime))
+		content, err := io.ReadAll(r)
+		a.NoError(err)
+		a.Equal(e.content, content)
+	}
+	_, err = r.Next()
+	a.Equal(io.EOF, err)
+}
+
+func TestTarArchiveWriter_Compressed(t *testing.T) {
+	decompressors := map[ArchiveFormat]func(r io.Reader) (io.Reader, error){
+		ArchiveFormatTarGz: func(r io.Reader) (io.Reader, error) {
+			return gzip.NewReader(r)
+		},
+		ArchiveFormatTarZst: func(r io.Reader) (io.Reader, error) {
+			return zstd.NewReader(r)
+		},
+	}
+
+	for format, decompress := range decompressors {
+		t.Run(string(format), func(t *testing.T) {
+			a := assert.New(t)
+			buf := &bytes.Buffer{}
+			w, err := NewArchiveWriter(buf, format, false, "")
+			a.NoError(err)
+			ew, err := w.Create("a.txt", time.Now(), 5)
+			a.NoError(err)
+			_, err = ew.Write([]byte("hello"))
+			a.NoError(err)
+
+			// Compressed stream is only complete once the compressor is closed
+			a.NoError(w.Close())
+			dr, err := decompress(buf)
+			a.NoError(err)
+			r := tar.NewReader(dr)
+			header, err := r.Next()
+			a.NoError(err)
+			a.Equal("a.txt", header.Name)
+			content, err := io.ReadAll(r)
+			a.NoError(err)
+			a.Equal("hello", string(content))
+			_, err = r.Next()
+			a.Equal(io.EOF, err)
+		})
+	}
+}
+
+func TestNewArchiveWriter_Encryption(t *testing.T) {
+	_, err := NewArchiveWriter(io.Discard, ArchiveFormatTarGz, true, "secret")
+	assert.Error(t, err)
+}
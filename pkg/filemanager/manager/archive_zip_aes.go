@@ -0,0 +1,163 @@
+package manager
+
+import (
+	"archive/zip"
+	"compress/flate"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"io"
+)
+
+// WinZip AES encryption (AE-1) with 256 bits key, see https://www.winzip.com/en/support/aes-encryption/
+const (
+	zipMethodWinZipAES = 99
+	zipAESExtraID      = 0x9901
+	zipAESVersion      = 1
+	zipAESStrength256  = 3
+	zipAESKeySize      = 32
+	zipAESSaltSize     = 16
+	zipAESVerifierSize = 2
+	zipAESAuthCodeSize = 10
+	zipAESIterations   = 1000
+	zipFlagEncrypted   = 0x1
+)
+
+// zipAESExtra builds the AES extra data field which records the actual compression method.
+func zipAESExtra(method uint16) []byte {
+	extra := make([]byte, 11)
+	binary.LittleEndian.PutUint16(extra[0:], zipAESExtraID)
+	binary.LittleEndian.PutUint16(extra[2:], 7)
+	binary.LittleEndian.PutUint16(extra[4:], zipAESVersion)
+	copy(extra[6:], "AE")
+	extra[8] = zipAESStrength256
+	binary.LittleEndian.PutUint16(extra[9:], method)
+	return extra
+}
+
+// newZipAESCompressor returns a zip.Compressor that optionally deflates file content and
+// then encrypts it with a key derived from password and a per-file random salt.
+func newZipAESCompressor(password string, compression bool) zip.Compressor {
+	return func(w io.Writer) (io.WriteCloser, error) {
+		salt := make([]byte, zipAESSaltSize)
+		if _, err := rand.Read(salt); err != nil {
+			return nil, fmt.Errorf("failed to generate salt: %w", err)
+		}
+
+		keys, err := pbkdf2.Key(sha1.New, password, salt, zipAESIterations, 2*zipAESKeySize+zipAESVerifierSize)
+		if err != nil {
+			return nil, fmt.Errorf("failed to derive key: %w", err)
+		}
+
+		block, err := aes.NewCipher(keys[:zipAESKeySize])
+		if err != nil {
+			return nil, fmt.Errorf("failed to create cipher: %w", err)
+		}
+
+		enc := &zipAESEncryptWriter{
+			w:      w,
+			header: append(salt, keys[2*zipAESKeySize:]...),
+			ctr:    &zipAESCtr{block: block, pos: aes.BlockSize},
+			mac:    hmac.New(sha1.New, keys[zipAESKeySize:2*zipAESKeySize]),
+		}
+
+		if !compression {
+			return enc, nil
+		}
+
+		fw, err := flate.NewWriter(enc, flate.DefaultCompression)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create deflate writer: %w", err)
+		}
+
+		return &zipAESDeflateWriter{Writer: fw, enc: enc}, nil
+	}
+}
+
+// zipAESEncryptWriter encrypts data and appends the authentication code on Close.
+// Salt and password verifier are written lazily since zip.Writer creates compressor
+// before writing the local file header.
+type zipAESEncryptWriter struct {
+	w      io.Writer
+	header []byte
+	ctr    *zipAESCtr
+	mac    hash.Hash
+	buf    []byte
+}
+
+func (e *zipAESEncryptWriter) writeHeader() error {
+	if e.header == nil {
+		return nil
+	}
+
+	_, err := e.w.Write(e.header)
+	e.header = nil
+	return err
+}
+
+func (e *zipAESEncryptWriter) Write(p []byte) (int, error) {
+	if err := e.writeHeader(); err != nil {
+		return 0, err
+	}
+
+	if cap(e.buf) < len(p) {
+		e.buf = make([]byte, len(p))
+	}
+	buf := e.buf[:len(p)]
+	e.ctr.XORKeyStream(buf, p)
+	e.mac.Write(buf)
+	return e.w.Write(buf)
+}
+
+func (e *zipAESEncryptWriter) Close() error {
+	if err := e.writeHeader(); err != nil {
+		return err
+	}
+
+	_, err := e.w.Write(e.mac.Sum(nil)[:zipAESAuthCodeSize])
+	return err
+}
+
+type zipAESDeflateWriter struct {
+	*flate.Writer
+	enc *zipAESEncryptWriter
+}
+
+func (d *zipAESDeflateWriter) Close() error {
+	if err := d.Writer.Close(); err != nil {
+		return err
+	}
+	return d.enc.Close()
+}
+
+// zipAESCtr is AES in CTR mode with a little-endian counter starting from 1, as WinZip
+// defines it. It differs from cipher.NewCTR which uses a big-endian counter.
+type zipAESCtr struct {
+	block   cipher.Block
+	counter [aes.BlockSize]byte
+	stream  [aes.BlockSize]byte
+	pos     int
+}
+
+func (c *zipAESCtr) XORKeyStream(dst, src []byte) {
+	for i := range src {
+		if c.pos == aes.BlockSize {
+			for j := range c.counter {
+				c.counter[j]++
+				if c.counter[j] != 0 {
+					break
+				}
+			}
+			c.block.Encrypt(c.stream[:], c.counter[:])
+			c.pos = 0
+		}
+		dst[i] = src[i] ^ c.stream[c.pos]
+		c.pos++
+	}
+}
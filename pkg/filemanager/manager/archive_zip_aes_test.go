@@ -0,0 +1,129 @@
+package manager
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/flate"
+	"crypto/aes"
+	"crypto/hmac"
+	"crypto/pbkdf2"
+	"crypto/sha1"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// zipAESDecompressor decrypts AE-1 entries written by newZipAESCompressor, the actual compression
+// method is given by caller since zip.Decompressor has no access to the extra field.
+func zipAESDecompressor(password string, compression bool) zip.Decompressor {
+	return func(r io.Reader) io.ReadCloser {
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return io.NopCloser(&errorReader{err})
+		}
+
+		header := zipAESSaltSize + zipAESVerifierSize
+		if len(data) < header+zipAESAuthCodeSize {
+			return io.NopCloser(&errorReader{errors.New("entry too short")})
+		}
+
+		keys, err := pbkdf2.Key(sha1.New, password, data[:zipAESSaltSize], zipAESIterations, 2*zipAESKeySize+zipAESVerifierSize)
+		if err != nil {
+			return io.NopCloser(&errorReader{err})
+		}
+
+		if !bytes.Equal(keys[2*zipAESKeySize:], data[zipAESSaltSize:header]) {
+			return io.NopCloser(&errorReader{errors.New("incorrect password")})
+		}
+
+		content, authCode := data[header:len(data)-zipAESAuthCodeSize], data[len(data)-zipAESAuthCodeSize:]
+		mac := hmac.New(sha1.New, keys[zipAESKeySize:2*zipAESKeySize])
+		mac.Write(content)
+		if !bytes.Equal(mac.Sum(nil)[:zipAESAuthCodeSize], authCode) {
+			return io.NopCloser(&errorReader{errors.New("authentication failed")})
+		}
+
+		block, err := aes.NewCipher(keys[:zipAESKeySize])
+		if err != nil {
+			return io.NopCloser(&errorReader{err})
+		}
+
+		plain := make([]byte, len(content))
+		(&zipAESCtr{block: block, pos: aes.BlockSize}).XORKeyStream(plain, content)
+		if compression {
+			return flate.NewReader(bytes.NewReader(plain))
+		}
+		return io.NopCloser(bytes.NewReader(plain))
+	}
+}
+
+type errorReader struct {
+	err error
+}
+
+func (e *errorReader) Read(p []byte) (int, error) {
+	return 0, e.err
+}
+
+func TestZipAES_RoundTrip(t *testing.T) {
+	files := map[string][]byte{
+		"a.txt":     []byte("hello world"),
+		"dir/b.txt": bytes.Repeat([]byte("cloudreve"), 1000),
+		"empty.txt": {},
+	}
+
+	for _, compression := range []bool{false, true} {
+		name := "stored"
+		if compression {
+			name = "deflate"
+		}
+
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+			buf := &bytes.Buffer{}
+			w, err := NewArchiveWriter(buf, ArchiveFormatZip, compression, "secret")
+			a.NoError(err)
+			for name, content := range files {
+				ew, err := w.Create(name, time.Now(), int64(len(content)))
+				a.NoError(err)
+				_, err = ew.Write(content)
+				a.NoError(err)
+			}
+			a.NoError(w.Close())
+
+			expectedMethod := zip.Store
+			if compression {
+				expectedMethod = zip.Deflate
+			}
+
+			open := func(password string) map[string][]byte {
+				r, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+				a.NoError(err)
+				r.RegisterDecompressor(zipMethodWinZipAES, zipAESDecompressor(password, compression))
+
+				res := make(map[string][]byte)
+				for _, f := range r.File {
+					a.EqualValues(zipMethodWinZipAES, f.Method)
+					a.NotZero(f.Flags & zipFlagEncrypted)
+					a.True(bytes.HasPrefix(f.Extra, zipAESExtra(expectedMethod)))
+
+					rc, err := f.Open()
+					a.NoError(err)
+					content, err := io.ReadAll(rc)
+					rc.Close()
+					if err != nil {
+						return nil
+					}
+					res[f.Name] = content
+				}
+				return res
+			}
+
+			a.Equal(files, open("secret"))
+			a.Nil(open("wrong"))
+		})
+	}
+}
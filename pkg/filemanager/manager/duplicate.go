@@ -0,0 +1,246 @@
+package manager
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/dbfs"
+	"github.com/samber/lo"
+	"golang.org/x/tools/container/intsets"
+)
+
+type (
+	DuplicateManagement interface {
+		// DuplicateCandidates walks given folder and returns all non-empty files in it.
+		DuplicateCandidates(ctx context.Context, uri *fs.URI) ([]DuplicateCandidate, error)
+		// FindDuplicates groups candidates by size and content hash, hashes are computed and saved to
+		// entity if not yet known. hashed is called after each entity is hashed.
+		FindDuplicates(ctx context.Context, candidates []DuplicateCandidate, hashed func(size int64)) ([]DuplicateGroup, error)
+		// ResolveDuplicates keeps the first file of each group, other files owned by current user are
+		// either linked to the entity of the kept file, or moved to trash bin. Returns number of
+		// resolved and failed files, and IDs of entities no longer referenced.
+		ResolveDuplicates(ctx context.Context, groups []DuplicateGroup, action DuplicateAction) (int, int, []int)
+	}
+
+	DuplicateCandidate struct {
+		File   DuplicateFile
+		Entity fs.Entity
+	}
+
+	DuplicateGroup struct {
+		Hash  string          `json:"hash"`
+		Size  int64           `json:"size"`
+		Files []DuplicateFile `json:"files"`
+	}
+
+	DuplicateFile struct {
+		FileID   int    `json:"file_id"`
+		EntityID int    `json:"entity_id"`
+		OwnerID  int    `json:"owner_id"`
+		Uri      string `json:"uri"`
+	}
+
+	DuplicateAction string
+)
+
+const (
+	// DuplicateActionLink links duplicated files to the same entity, which reclaims storage space
+	// but not user's capacity, since capacity is charged per file.
+	DuplicateActionLink = DuplicateAction("link")
+	// DuplicateActionDelete moves duplicated files to trash bin.
+	DuplicateActionDelete = DuplicateAction("delete")
+)
+
+// Reclaimable returns bytes that can be reclaimed by keeping only one entity in the group, files
+// already sharing the same entity do not take extra space.
+func (g *DuplicateGroup) Reclaimable() int64 {
+	entities := lo.UniqBy(g.Files, func(f DuplicateFile) int {
+		return f.EntityID
+	})
+	return g.Size * int64(max(len(entities)-1, 0))
+}
+
+func (m *manager) DuplicateCandidates(ctx context.Context, uri *fs.URI) ([]DuplicateCandidate, error) {
+	candidates := make([]DuplicateCandidate, 0)
+	err := m.Walk(ctx, uri, intsets.MaxInt, func(f fs.File, level int) error {
+		if f.Type() != types.FileTypeFile || f.IsSymbolic() || f.Size() == 0 {
+			return nil
+		}
+
+		entity := f.PrimaryEntity()
+		if entity == nil {
+			return nil
+		}
+
+		candidates = append(candidates, DuplicateCandidate{
+			File: DuplicateFile{
+				FileID:   f.ID(),
+				EntityID: entity.ID(),
+				OwnerID:  f.OwnerID(),
+				Uri:      f.Uri(false).String(),
+			},
+			Entity: entity,
+		})
+		return nil
+	}, dbfs.WithFileEntities())
+	if err != nil {
+		return nil, err
+	}
+
+	return candidates, nil
+}
+
+func (m *manager) FindDuplicates(ctx context.Context, candidates []DuplicateCandidate, hashed func(size int64)) ([]DuplicateGroup, error) {
+	bySize := lo.GroupBy(candidates, func(c DuplicateCandidate) int64 {
+		return c.Entity.Size()
+	})
+
+	hashes := make(map[int]string)
+	groups := make([]DuplicateGroup, 0)
+	for size, sameSize := range bySize {
+		if len(sameSize) < 2 {
+			continue
+		}
+
+		byHash := make(map[string][]DuplicateFile)
+		for _, c := range sameSize {
+			hash, ok := hashes[c.Entity.ID()]
+			if !ok {
+				var err error
+				hash, err = m.entityHash(ctx, c.Entity)
+				if err != nil {
+					m.l.Warning("Failed to hash entity %d of file %q: %s", c.Entity.ID(), c.File.Uri, err)
+					continue
+				}
+
+				hashes[c.Entity.ID()] = hash
+				if hashed != nil {
+					hashed(size)
+				}
+			}
+
+			byHash[hash] = append(byHash[hash], c.File)
+		}
+
+		for hash, files := range byHash {
+			if len(files) < 2 {
+				continue
+			}
+
+			// Oldest file comes first and is kept when resolving.
+			sort.Slice(files, func(i, j int) bool {
+				return files[i].FileID < files[j].FileID
+			})
+			groups = append(groups, DuplicateGroup{Hash: hash, Size: size, Files: files})
+		}
+	}
+
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].Reclaimable() > groups[j].Reclaimable()
+	})
+
+	return groups, nil
+}
+
+// entityHash returns SHA-256 of entity content, computed hash is saved into entity props.
+func (m *manager) entityHash(ctx context.Context, e fs.Entity) (string, error) {
+	if props := e.Props(); props != nil && props.Hash != "" {
+		return props.Hash, nil
+	}
+
+	es, err := m.GetEntitySource(ctx, 0, fs.WithEntity(e))
+	if err != nil {
+		return "", fmt.Errorf("failed to get entity source: %w", err)
+	}
+	defer es.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, es); err != nil {
+		return "", fmt.Errorf("failed to read entity: %w", err)
+	}
+
+	hash := hex.EncodeToString(h.Sum(nil))
+	props := &types.EntityProps{}
+	if e.Props() != nil {
+		*props = *e.Props()
+	}
+	props.Hash = hash
+	if _, err := m.dep.FileClient().UpdateEntityProps(ctx, e.Model(), props); err != nil {
+		m.l.Warning("Failed to save hash of entity %d: %s", e.ID(), err)
+	}
+
+	return hash, nil
+}
+
+func (m *manager) ResolveDuplicates(ctx context.Context, groups []DuplicateGroup, action DuplicateAction) (int, int, []int) {
+	resolved, failed := 0, 0
+	stale := make([]int, 0)
+	for _, group := range groups {
+		keep := group.Files[0]
+		for _, dup := range group.Files[1:] {
+			if dup.OwnerID != m.user.ID {
+				continue
+			}
+
+			uri, err := fs.NewUriFromString(dup.Uri)
+			if err != nil {
+				failed++
+				continue
+			}
+
+			switch action {
+			case DuplicateActionDelete:
+				err = m.Delete(ctx, []*fs.URI{uri})
+			case DuplicateActionLink:
+				if dup.EntityID == keep.EntityID {
+					// Already sharing the same entity.
+					continue
+				}
+
+				var unlinked bool
+				unlinked, err = m.linkDuplicate(ctx, uri, dup.EntityID, keep.EntityID)
+				if unlinked {
+					stale = append(stale, dup.EntityID)
+				}
+			default:
+				return resolved, failed, stale
+			}
+
+			if err != nil {
+				m.l.Warning("Failed to resolve duplicate %q: %s", dup.Uri, err)
+				failed++
+				continue
+			}
+
+			resolved++
+		}
+	}
+
+	return resolved, failed, stale
+}
+
+// linkDuplicate sets target entity as current version of the file at given uri and deletes its
+// original version. Returns whether the original entity is no longer referenced.
+func (m *manager) linkDuplicate(ctx context.Context, uri *fs.URI, original, target int) (bool, error) {
+	// Original version is deleted right after, linking a duplicate never increases used storage.
+	if _, err := m.RestoreEntity(context.WithValue(ctx, dbfs.SkipCapacityCheckCtxKey{}, true), uri, target); err != nil {
+		return false, fmt.Errorf("failed to link entity: %w", err)
+	}
+
+	if err := m.fs.VersionControl(ctx, uri, original, true); err != nil {
+		return false, fmt.Errorf("failed to delete original version: %w", err)
+	}
+
+	entity, err := m.dep.FileClient().GetEntityByID(ctx, original)
+	if err != nil {
+		return false, nil
+	}
+
+	return entity.ReferenceCount <= 0, nil
+}
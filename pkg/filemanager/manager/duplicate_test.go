@@ -0,0 +1,30 @@
+package manager
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDuplicateGroup_Reclaimable(t *testing.T) {
+	tests := []struct {
+		name     string
+		entities []int
+		expected int64
+	}{
+		{name: "distinct entities", entities: []int{1, 2, 3}, expected: 200},
+		{name: "files sharing entities", entities: []int{1, 1, 2, 2}, expected: 100},
+		{name: "all files share one entity", entities: []int{1, 1}, expected: 0},
+		{name: "empty group", entities: []int{}, expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &DuplicateGroup{Size: 100}
+			for i, e := range tt.entities {
+				g.Files = append(g.Files, DuplicateFile{FileID: i + 1, EntityID: e})
+			}
+			assert.Equal(t, tt.expected, g.Reclaimable())
+		})
+	}
+}
@@ -38,8 +38,12 @@ type (
 		SetCurrentVersion(ctx context.Context, path *fs.URI, version int) error
 		// DeleteVersion deletes a version of given file
 		DeleteVersion(ctx context.Context, path *fs.URI, version int) error
+		// PatchVersionRetention sets or removes (schedule is nil) version retention schedule of given file or folder
+		PatchVersionRetention(ctx context.Context, path *fs.URI, schedule *types.VersionRetentionSchedule) error
 		// ExtractAndSaveMediaMeta extracts and saves media meta into file metadata of given file.
 		ExtractAndSaveMediaMeta(ctx context.Context, uri *fs.URI, entityID int) error
+		// RegenerateMedia re-queues media meta extraction and regenerates thumbnails of given file.
+		RegenerateMedia(ctx context.Context, uri *fs.URI, args *MediaRegenerateArgs) (bool, error)
 		// RecycleEntities recycles a group of entities
 		RecycleEntities(ctx context.Context, force bool, entityIDs ...int) error
 		// ListPhysical lists physical files in a path
@@ -258,6 +262,12 @@ func (m *manager) GetEntityUrls(ctx context.Context, args []GetEntityUrlArgs, op
 			m.l.Warning("Failed to execute navigator hooks: %s", err)
 		}
 
+		activity := types.FileActivityOpen
+		if o.IsDownload {
+			activity = types.FileActivityDownload
+		}
+		m.fs.RecordActivity(ctx, file, activity)
+
 		policy, d, err := m.getEntityPolicyDriver(ctx, target, nil)
 		if err != nil {
 			ae.Add(arg.URI.String(), err)
@@ -89,6 +89,10 @@ type (
 		CreateArchive(ctx context.Context, uris []*fs.URI, writer io.Writer, opts ...fs.Option) (int, error)
 		// ListArchiveFiles lists files in an archive
 		ListArchiveFiles(ctx context.Context, uri *fs.URI, entity, zipEncoding string) ([]ArchivedFile, error)
+		// OpenArchiveMember opens a single file in an archive for reading, without extracting the archive.
+		OpenArchiveMember(ctx context.Context, uri *fs.URI, entity, member, zipEncoding string) (*ArchiveMember, error)
+		// ArchiveMemberThumbnail generates thumbnail for a single file in an archive.
+		ArchiveMemberThumbnail(ctx context.Context, uri *fs.URI, entity, member, zipEncoding string) (*ArchiveMember, error)
 	}
 
 	FileManager interface {
@@ -98,6 +102,12 @@ type (
 		UploadManagement
 		FsManagement
 		ShareManagement
+		SnapshotManagement
+		DuplicateManagement
+		UsageManagement
+		MusicManagement
+		TranscodeManagement
+		StoryboardManagement
 		Archiver
 
 		// Recycle reset current FileManager object and put back to resource pool
@@ -146,9 +156,9 @@ func NewFileManager(dep dependency.Dep, u *ent.User) FileManager {
 		l:        dep.Logger(),
 		user:     u,
 		settings: dep.SettingProvider(),
-		fs: dbfs.NewDatabaseFS(u, dep.FileClient(), dep.ShareClient(), dep.Logger(), dep.LockSystem(),
+		fs: dbfs.NewDatabaseFS(u, dep.FileClient(), dep.ShareClient(), dep.SnapshotClient(), dep.FileActivityClient(), dep.Logger(), dep.LockSystem(),
 			dep.SettingProvider(), dep.StoragePolicyClient(), dep.HashIDEncoder(), dep.UserClient(), dep.KV(), dep.NavigatorStateKV(),
-			dep.DirectLinkClient(), dep.EncryptorFactory(context.TODO()), dep.EventHub()),
+			dep.DirectLinkClient(), dep.EncryptorFactory(context.TODO()), dep.EventHub(), dep.AlbumClient()),
 		kv:           dep.KV(),
 		config:       config,
 		auth:         dep.GeneralAuth(),
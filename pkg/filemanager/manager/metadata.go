@@ -15,6 +15,7 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/dbfs"
 	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
 	"github.com/go-playground/validator/v10"
 	"github.com/samber/lo"
 )
@@ -96,15 +97,14 @@ var (
 				return fmt.Errorf("unsupported system metadata key: %s", patch.Key)
 			},
 		},
-		"dav": {},
+		"dav":    {},
+		"caldav": {},
 		// Allow manipulating thumbnail metadata via public PatchMetadata API
 		"thumb": {
-			// Only supported thumb metadata currently is thumb:disabled
-			dbfs.ThumbDisabledKey: func(ctx context.Context, m *manager, patch *fs.MetadataPatch) error {
-				// Presence of this key disables thumbnails; value is ignored.
-				// We allow both setting and removing this key.
-				return nil
-			},
+			// Only supported thumb metadata currently are thumb:disabled and its size variants
+			dbfs.ThumbDisabledKey:                              allowThumbDisabled,
+			dbfs.ThumbDisabledKeyOf(setting.ThumbVariantSmall): allowThumbDisabled,
+			dbfs.ThumbDisabledKeyOf(setting.ThumbVariantLarge): allowThumbDisabled,
 		},
 		customizeMetadataSuffix: {
 			iconColorMetadataKey: validateColor(false),
@@ -278,6 +278,12 @@ var (
 	}
 )
 
+// allowThumbDisabled allows setting and removing thumbnail disabled marks. Presence of the key disables
+// thumbnails of corresponding size; value is ignored.
+func allowThumbDisabled(ctx context.Context, m *manager, patch *fs.MetadataPatch) error {
+	return nil
+}
+
 func (m *manager) PatchMedata(ctx context.Context, path []*fs.URI, data ...fs.MetadataPatch) error {
 	data, err := m.validateMetadata(ctx, data...)
 	if err != nil {
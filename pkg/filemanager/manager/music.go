@@ -0,0 +1,368 @@
+package manager
+
+import (
+	"context"
+	"encoding/gob"
+	"fmt"
+	"hash/fnv"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/dbfs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/mediameta"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/samber/lo"
+)
+
+type (
+	MusicManagement interface {
+		// MusicLibrary returns the music library of current user, built from tags of audio files not
+		// in trash bin. If user is authenticated by a WebDAV account, only files under the root
+		// folder of the account are included. The library is cached for a short while.
+		MusicLibrary(ctx context.Context) (*MusicLibrary, error)
+	}
+
+	// MusicLibrary groups audio files of a user by album artist and album.
+	MusicLibrary struct {
+		// Artists is sorted by name.
+		Artists []*MusicArtist
+		// Albums is sorted by artist, year and name.
+		Albums []*MusicAlbum
+		// Tracks is sorted by disc and track number.
+		Tracks    []*MusicTrack
+		IndexedAt time.Time
+
+		// Lookup tables built on first use, not cached.
+		artists map[string]*MusicArtist
+		albums  map[string]*MusicAlbum
+		tracks  map[int]*MusicTrack
+	}
+
+	MusicArtist struct {
+		ID       string
+		Name     string
+		AlbumIDs []string
+	}
+
+	MusicAlbum struct {
+		ID       string
+		Name     string
+		ArtistID string
+		Artist   string
+		Genre    string
+		Year     int
+		Size     int64
+		TrackIDs []int
+		// CreatedAt is the creation time of the newest track in album.
+		CreatedAt time.Time
+	}
+
+	MusicTrack struct {
+		FileID   int
+		Name     string
+		Uri      string
+		Size     int64
+		Title    string
+		Artist   string
+		AlbumID  string
+		Album    string
+		ArtistID string
+		// AlbumArtist is the artist of album, which falls back to artist of the track.
+		AlbumArtist string
+		Genre       string
+		Year        int
+		Track       int
+		Disc        int
+		CreatedAt   time.Time
+	}
+)
+
+const (
+	MusicLibraryCachePrefix = "music_library_"
+	MusicUnknownArtist      = "Unknown Artist"
+	MusicUnknownAlbum       = "Unknown Album"
+
+	musicLibraryTTL     = 300
+	musicIndexPageSize  = 1000
+	musicArtistIDPrefix = "ar-"
+	musicAlbumIDPrefix  = "al-"
+)
+
+func init() {
+	gob.Register(MusicLibrary{})
+}
+
+func (m *manager) MusicLibrary(ctx context.Context) (*MusicLibrary, error) {
+	var (
+		cacheKey = fmt.Sprintf("%s%d", MusicLibraryCachePrefix, m.user.ID)
+		scope    *fs.URI
+	)
+	if len(m.user.Edges.DavAccounts) > 0 {
+		account := m.user.Edges.DavAccounts[0]
+		uri, err := fs.NewUriFromString(account.URI)
+		if err != nil {
+			return nil, serializer.NewError(serializer.CodeParamErr, "Invalid root folder of WebDAV account", err)
+		}
+
+		cacheKey = fmt.Sprintf("%s%d_%d", MusicLibraryCachePrefix, m.user.ID, account.ID)
+		scope = uri
+	}
+
+	if cached, ok := m.kv.Get(cacheKey); ok {
+		library := cached.(MusicLibrary)
+		return &library, nil
+	}
+
+	library, err := m.indexMusic(ctx, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.kv.Set(cacheKey, *library, musicLibraryTTL); err != nil {
+		m.l.Warning("Failed to cache music library of user %d: %s", m.user.ID, err)
+	}
+
+	return library, nil
+}
+
+// indexMusic goes through all audio files of current user and groups them by tags. If scope is
+// not nil, only files under it are included.
+func (m *manager) indexMusic(ctx context.Context, scope *fs.URI) (*MusicLibrary, error) {
+	fc := m.dep.FileClient()
+	exts := m.settings.DBFS(ctx).MusicExts
+	metaKeys := lo.Map([]string{
+		mediameta.MusicTitle, mediameta.MusicAlbum, mediameta.MusicArtist, mediameta.MusicAlbumArtists,
+		mediameta.MusicGenre, mediameta.MusicYear, mediameta.MusicTrack, mediameta.MusicDisc,
+	}, func(key string, index int) string {
+		return musicMetaKey(key)
+	})
+
+	var (
+		library = &MusicLibrary{IndexedAt: time.Now()}
+		uid     = hashid.EncodeUserID(m.hasher, m.user.ID)
+		// folderUris caches URI of visited folders, nil for folders in trash bin.
+		folderUris = make(map[int]*fs.URI)
+	)
+	for afterID := 0; ; {
+		files, err := fc.ListMediaFiles(ctx, m.user.ID, exts, metaKeys, afterID, musicIndexPageSize)
+		if err != nil {
+			return nil, serializer.NewError(serializer.CodeDBError, "Failed to list audio files", err)
+		}
+
+		for _, file := range files {
+			folderUri, err := dbfs.MyFolderUri(ctx, fc, file, folderUris)
+			if err != nil {
+				return nil, serializer.NewError(serializer.CodeDBError, "Failed to get folder of audio file", err)
+			}
+
+			if folderUri != nil && (scope == nil || folderUri.EqualOrIsDescendantOf(scope, uid)) {
+				library.Tracks = append(library.Tracks, newMusicTrack(file, folderUri))
+			}
+		}
+
+		if len(files) < musicIndexPageSize {
+			break
+		}
+
+		afterID = files[len(files)-1].ID
+	}
+
+	library.group()
+	return library, nil
+}
+
+// group builds artists and albums from tracks, and sorts all of them.
+func (l *MusicLibrary) group() {
+	sort.SliceStable(l.Tracks, func(i, j int) bool {
+		a, b := l.Tracks[i], l.Tracks[j]
+		if a.Disc != b.Disc {
+			return a.Disc < b.Disc
+		}
+		if a.Track != b.Track {
+			return a.Track < b.Track
+		}
+		return compareMusicName(a.Name, b.Name) < 0
+	})
+
+	artists := make(map[string]*MusicArtist)
+	albums := make(map[string]*MusicAlbum)
+	for _, track := range l.Tracks {
+		if _, ok := artists[track.ArtistID]; !ok {
+			artists[track.ArtistID] = &MusicArtist{ID: track.ArtistID, Name: track.AlbumArtist}
+		}
+
+		album, ok := albums[track.AlbumID]
+		if !ok {
+			album = &MusicAlbum{
+				ID:       track.AlbumID,
+				Name:     track.Album,
+				ArtistID: track.ArtistID,
+				Artist:   track.AlbumArtist,
+			}
+			albums[album.ID] = album
+		}
+
+		album.TrackIDs = append(album.TrackIDs, track.FileID)
+		album.Size += track.Size
+		album.Year = max(album.Year, track.Year)
+		if album.Genre == "" {
+			album.Genre = track.Genre
+		}
+		if track.CreatedAt.After(album.CreatedAt) {
+			album.CreatedAt = track.CreatedAt
+		}
+	}
+
+	l.Artists = lo.Values(artists)
+	sort.Slice(l.Artists, func(i, j int) bool {
+		return compareMusicName(l.Artists[i].Name, l.Artists[j].Name) < 0
+	})
+
+	l.Albums = lo.Values(albums)
+	sort.Slice(l.Albums, func(i, j int) bool {
+		a, b := l.Albums[i], l.Albums[j]
+		if c := compareMusicName(a.Artist, b.Artist); c != 0 {
+			return c < 0
+		}
+		if a.Year != b.Year {
+			return a.Year < b.Year
+		}
+		return compareMusicName(a.Name, b.Name) < 0
+	})
+	for _, album := range l.Albums {
+		artist := artists[album.ArtistID]
+		artist.AlbumIDs = append(artist.AlbumIDs, album.ID)
+	}
+}
+
+// Artist returns the artist with given ID.
+func (l *MusicLibrary) Artist(id string) (*MusicArtist, bool) {
+	if l.artists == nil {
+		l.artists = lo.KeyBy(l.Artists, func(item *MusicArtist) string {
+			return item.ID
+		})
+	}
+
+	artist, ok := l.artists[id]
+	return artist, ok
+}
+
+// Album returns the album with given ID.
+func (l *MusicLibrary) Album(id string) (*MusicAlbum, bool) {
+	if l.albums == nil {
+		l.albums = lo.KeyBy(l.Albums, func(item *MusicAlbum) string {
+			return item.ID
+		})
+	}
+
+	album, ok := l.albums[id]
+	return album, ok
+}
+
+// Track returns the track of given file ID.
+func (l *MusicLibrary) Track(fileID int) (*MusicTrack, bool) {
+	if l.tracks == nil {
+		l.tracks = lo.KeyBy(l.Tracks, func(item *MusicTrack) int {
+			return item.FileID
+		})
+	}
+
+	track, ok := l.tracks[fileID]
+	return track, ok
+}
+
+// AlbumTracks returns tracks of given album in order.
+func (l *MusicLibrary) AlbumTracks(album *MusicAlbum) []*MusicTrack {
+	return lo.FilterMap(album.TrackIDs, func(id int, index int) (*MusicTrack, bool) {
+		return l.Track(id)
+	})
+}
+
+// ArtistAlbums returns albums of given artist in order.
+func (l *MusicLibrary) ArtistAlbums(artist *MusicArtist) []*MusicAlbum {
+	return lo.FilterMap(artist.AlbumIDs, func(id string, index int) (*MusicAlbum, bool) {
+		return l.Album(id)
+	})
+}
+
+func newMusicTrack(file *ent.File, folderUri *fs.URI) *MusicTrack {
+	track := &MusicTrack{
+		FileID:    file.ID,
+		Name:      file.Name,
+		Uri:       folderUri.Join(file.Name).String(),
+		Size:      file.Size,
+		CreatedAt: file.CreatedAt,
+	}
+
+	for _, meta := range file.Edges.Metadata {
+		switch meta.Name {
+		case musicMetaKey(mediameta.MusicTitle):
+			track.Title = meta.Value
+		case musicMetaKey(mediameta.MusicAlbum):
+			track.Album = meta.Value
+		case musicMetaKey(mediameta.MusicArtist):
+			track.Artist = meta.Value
+		case musicMetaKey(mediameta.MusicAlbumArtists):
+			track.AlbumArtist = meta.Value
+		case musicMetaKey(mediameta.MusicGenre):
+			track.Genre = meta.Value
+		case musicMetaKey(mediameta.MusicYear):
+			track.Year, _ = strconv.Atoi(meta.Value)
+		case musicMetaKey(mediameta.MusicTrack):
+			track.Track = musicPosition(meta.Value)
+		case musicMetaKey(mediameta.MusicDisc):
+			track.Disc = musicPosition(meta.Value)
+		}
+	}
+
+	if track.Title == "" {
+		track.Title = strings.TrimSuffix(file.Name, path.Ext(file.Name))
+	}
+	if track.Artist == "" {
+		track.Artist = MusicUnknownArtist
+	}
+	if track.AlbumArtist == "" {
+		track.AlbumArtist = track.Artist
+	}
+	if track.Album == "" {
+		track.Album = MusicUnknownAlbum
+	}
+
+	track.ArtistID = musicArtistIDPrefix + musicHash(strings.ToLower(track.AlbumArtist))
+	track.AlbumID = musicAlbumIDPrefix + musicHash(strings.ToLower(track.AlbumArtist), strings.ToLower(track.Album))
+	return track
+}
+
+// musicMetaKey returns the name of metadata written by music extractor.
+func musicMetaKey(key string) string {
+	return fmt.Sprintf("%s:%s", driver.MediaTypeMusic, key)
+}
+
+// musicPosition parses track or disc number formatted as "number/total".
+func musicPosition(value string) int {
+	number, _, _ := strings.Cut(value, "/")
+	res, _ := strconv.Atoi(number)
+	return res
+}
+
+// musicHash returns a short stable hash of given names.
+func musicHash(names ...string) string {
+	h := fnv.New64a()
+	for _, name := range names {
+		h.Write([]byte(name))
+		h.Write([]byte{0})
+	}
+
+	return strconv.FormatUint(h.Sum64(), 36)
+}
+
+func compareMusicName(a, b string) int {
+	return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+}
@@ -245,6 +245,20 @@ func (l *manager) CreateOrUpdateShare(ctx context.Context, path *fs.URI, args *C
 		return nil, serializer.NewError(serializer.CodeNoPermissionErr, "cannot share symbolic file", nil)
 	}
 
+	// Only albums can be shared in photos file system.
+	var album *ent.Album
+	if path.FileSystem() == constants.FileSystemPhotos {
+		elements := path.Elements()
+		if len(elements) != 2 || elements[0] != dbfs.PhotosAlbums {
+			return nil, serializer.NewError(serializer.CodeNoPermissionErr, "only albums can be shared in photos", nil)
+		}
+
+		album, err = l.dep.AlbumClient().GetByName(ctx, l.user.ID, elements[1])
+		if err != nil {
+			return nil, serializer.NewError(serializer.CodeNotFound, "album not found", err)
+		}
+	}
+
 	var existed *ent.Share
 	shareClient := l.dep.ShareClient()
 	if args.ExistedShareID != 0 {
@@ -254,7 +268,13 @@ func (l *manager) CreateOrUpdateShare(ctx context.Context, path *fs.URI, args *C
 			return nil, serializer.NewError(serializer.CodeNotFound, "failed to get existed share", err)
 		}
 
-		if existed.Edges.File.ID != file.ID() {
+		existedAlbum := 0
+		if existed.Props != nil {
+			existedAlbum = existed.Props.AlbumID
+		}
+
+		// Album shares can only be edited through the album, otherwise album props would be lost.
+		if existed.Edges.File.ID != file.ID() || (album != nil && existedAlbum != album.ID) || (album == nil && existedAlbum > 0) {
 			return nil, serializer.NewError(serializer.CodeNotFound, "share link not found", nil)
 		}
 	}
@@ -271,6 +291,10 @@ func (l *manager) CreateOrUpdateShare(ctx context.Context, path *fs.URI, args *C
 		ShareView:  args.ShareView,
 		ShowReadMe: args.ShowReadMe,
 	}
+	if album != nil {
+		props.AlbumID = album.ID
+		props.AlbumName = album.Name
+	}
 
 	share, err := shareClient.Upsert(ctx, &inventory.CreateShareParams{
 		OwnerID:         file.OwnerID(),
@@ -330,8 +354,16 @@ func getEntityDisplayName(f fs.File, e fs.Entity) string {
 	switch e.Type() {
 	case types.EntityTypeThumbnail:
 		return fmt.Sprintf("%s_thumbnail", f.DisplayName())
+	case types.EntityTypeThumbnailSmall:
+		return fmt.Sprintf("%s_thumbnail_small", f.DisplayName())
+	case types.EntityTypeThumbnailLarge:
+		return fmt.Sprintf("%s_thumbnail_large", f.DisplayName())
 	case types.EntityTypeLivePhoto:
 		return fmt.Sprintf("%s_live_photo.mov", f.DisplayName())
+	case types.EntityTypeTranscode:
+		return fmt.Sprintf("%s_hls.ts", f.DisplayName())
+	case types.EntityTypeStoryboard:
+		return fmt.Sprintf("%s_storyboard.jpg", f.DisplayName())
 	default:
 		return f.Name()
 	}
@@ -0,0 +1,29 @@
+package manager
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/pkg/crontab"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+)
+
+func init() {
+	crontab.Register(setting.CronTypeRecentFiles, CronPruneFileActivities)
+}
+
+// CronPruneFileActivities deletes file activities older than recent files window.
+func CronPruneFileActivities(ctx context.Context) {
+	dep := dependency.FromContext(ctx)
+	l := dep.Logger()
+	window := dep.SettingProvider().DBFS(ctx).RecentFilesWindow
+
+	deleted, err := dep.FileActivityClient().DeleteBefore(ctx, time.Now().Add(-window))
+	if err != nil {
+		l.Error("Failed to prune file activities: %s", err)
+		return
+	}
+
+	l.Info("Pruned %d expired file activities.", deleted)
+}
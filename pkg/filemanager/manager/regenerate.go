@@ -0,0 +1,149 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/dbfs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/samber/lo"
+)
+
+type (
+	MediaRegenerateArgs struct {
+		// MediaMeta re-queues media meta extraction.
+		MediaMeta bool `json:"media_meta,omitempty"`
+		// Thumbnail regenerates thumbnails.
+		Thumbnail bool `json:"thumbnail,omitempty"`
+		// Force regenerates even if media meta or thumbnails are already present.
+		Force bool `json:"force,omitempty"`
+	}
+)
+
+var mediaMetaTypes = []driver.MetaType{
+	driver.MetaTypeExif,
+	driver.MediaTypeMusic,
+	driver.MetaTypeStreamMedia,
+	driver.MetaTypeGeocoding,
+	driver.MetaTypeDocument,
+}
+
+// RegenerateMedia queues media meta extraction and generates thumbnails of given file according to
+// args. Returns false if nothing is done for the file.
+func (m *manager) RegenerateMedia(ctx context.Context, uri *fs.URI, args *MediaRegenerateArgs) (bool, error) {
+	file, err := m.fs.Get(ctx, uri, dbfs.WithFileEntities(), dbfs.WithFilePublicMetadata())
+	if err != nil {
+		return false, fmt.Errorf("failed to get file: %w", err)
+	}
+
+	latest := file.PrimaryEntity()
+	if file.Type() != types.FileTypeFile || file.IsSymbolic() || latest == nil || latest.ID() == 0 {
+		return false, nil
+	}
+
+	_, d, err := m.getEntityPolicyDriver(ctx, latest, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to get storage driver: %w", err)
+	}
+
+	done := false
+	if args.MediaMeta && m.shouldGenerateMediaMeta(ctx, d, file.Name()) && (args.Force || !hasMediaMeta(file.Metadata())) {
+		t, err := NewMediaMetaTask(ctx, uri, latest.ID(), m.user)
+		if err != nil {
+			return false, fmt.Errorf("failed to create media meta task: %w", err)
+		}
+
+		if err := m.dep.MediaMetaQueue(ctx).QueueTask(ctx, t); err != nil {
+			return false, fmt.Errorf("failed to queue media meta task: %w", err)
+		}
+
+		done = true
+	}
+
+	if args.Thumbnail {
+		generated, err := m.regenerateThumb(ctx, uri, file, latest, d, args.Force)
+		if err != nil {
+			return done, err
+		}
+
+		done = done || generated
+	}
+
+	return done, nil
+}
+
+// regenerateThumb generates thumbnails of given file with proxy generator. Existing thumbnail variants
+// are regenerated only if force is set, while files previously marked as thumbnail not available are
+// always retried.
+func (m *manager) regenerateThumb(ctx context.Context, uri *fs.URI, file fs.File, latest fs.Entity, d driver.Handler, force bool) (bool, error) {
+	variants := []setting.ThumbVariant{setting.ThumbVariantMedium}
+	existing := lo.Filter(file.Entities(), func(e fs.Entity, index int) bool {
+		return e.Type().IsThumbnail()
+	})
+	// Medium thumbnail is the one always generated, files with only small or large ones still need it.
+	if !force && lo.ContainsBy(existing, func(e fs.Entity) bool {
+		return e.Type() == types.EntityTypeThumbnail
+	}) {
+		return false, nil
+	}
+
+	// Other sizes are only regenerated if they were generated before, the rest are left to lazy generation.
+	for _, e := range existing {
+		switch e.Type() {
+		case types.EntityTypeThumbnailSmall:
+			variants = append(variants, setting.ThumbVariantSmall)
+		case types.EntityTypeThumbnailLarge:
+			variants = append(variants, setting.ThumbVariantLarge)
+		}
+	}
+
+	// Thumbnails generated natively by storage provider do not need to be regenerated.
+	capabilities := d.Capabilities()
+	if capabilities.ThumbSupportAllExts || util.IsInExtensionList(capabilities.ThumbSupportedExts, file.DisplayName()) &&
+		(capabilities.ThumbMaxSize == 0 || latest.Size() <= capabilities.ThumbMaxSize) &&
+		!latest.Encrypted() {
+		return false, nil
+	}
+
+	if !capabilities.ThumbProxy {
+		return false, nil
+	}
+
+	for _, key := range dbfs.ThumbDisabledKeys {
+		if _, ok := file.Metadata()[key]; !ok {
+			continue
+		}
+
+		if err := m.fs.PatchMetadata(dbfs.WithBypassOwnerCheck(ctx), []*fs.URI{uri}, fs.MetadataPatch{
+			Key:    key,
+			Remove: true,
+		}); err != nil {
+			return false, fmt.Errorf("failed to reset thumb disabled mark: %w", err)
+		}
+	}
+
+	for _, variant := range lo.Uniq(variants) {
+		if _, err := m.SubmitAndAwaitThumbnailTask(setting.WithThumbVariant(ctx, variant), uri, file.Ext(), latest); err != nil {
+			return false, fmt.Errorf("failed to generate %s thumbnail: %w", variant, err)
+		}
+	}
+
+	return true, nil
+}
+
+func hasMediaMeta(metadata map[string]string) bool {
+	for k := range metadata {
+		for _, t := range mediaMetaTypes {
+			if strings.HasPrefix(k, string(t)+":") {
+				return true
+			}
+		}
+	}
+
+	return false
+}
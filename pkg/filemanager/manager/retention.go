@@ -0,0 +1,217 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/constants"
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/crontab"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/samber/lo"
+)
+
+func init() {
+	crontab.Register(setting.CronTypeVersionRetention, CronPruneVersions)
+}
+
+func (m *manager) PatchVersionRetention(ctx context.Context, uri *fs.URI, schedule *types.VersionRetentionSchedule) error {
+	patch := &types.FileProps{
+		VersionRetention: schedule,
+	}
+	isDelete := schedule == nil
+	if isDelete {
+		patch.VersionRetention = &types.VersionRetentionSchedule{}
+	}
+
+	return m.fs.PatchProps(ctx, uri, patch, isDelete)
+}
+
+// CronPruneVersions prunes historical versions of all files according to their version retention
+// schedules. Entities no longer referenced are queued for recycling immediately.
+func CronPruneVersions(ctx context.Context) {
+	dep := dependency.FromContext(ctx)
+	l := dep.Logger()
+	fc := dep.FileClient()
+	uc := dep.UserClient()
+	pageSize := dep.SettingProvider().DBFS(ctx).MaxPageSize
+	now := time.Now()
+
+	managers := make(map[int]*manager)
+	defer func() {
+		for _, fm := range managers {
+			fm.Recycle()
+		}
+	}()
+
+	staleEntities := make(map[int][]int)
+	afterID, batch := 0, 0
+	for {
+		files, err := fc.ListVersionedFiles(ctx, afterID, pageSize)
+		if err != nil {
+			l.Error("Failed to list versioned files: %s", err)
+			break
+		}
+
+		l.Info("Found %d files with multiple versions in batch #%d", len(files), batch)
+		for _, file := range files {
+			afterID = file.ID
+			fm, ok := managers[file.OwnerID]
+			if !ok {
+				user, err := uc.GetByID(context.WithValue(ctx, inventory.LoadUserGroup{}, true), file.OwnerID)
+				if err != nil {
+					l.Error("Failed to get user %d: %s", file.OwnerID, err)
+					continue
+				}
+
+				fm = NewFileManager(dep, user).(*manager)
+				managers[file.OwnerID] = fm
+			}
+
+			stale, err := fm.pruneVersions(context.WithValue(ctx, inventory.UserCtx{}, fm.user), file.ID, now)
+			if err != nil {
+				l.Warning("Failed to prune versions of file %d: %s", file.ID, err)
+			}
+
+			staleEntities[file.OwnerID] = append(staleEntities[file.OwnerID], stale...)
+		}
+
+		if len(files) < pageSize {
+			break
+		}
+
+		batch++
+	}
+
+	for uid, entities := range staleEntities {
+		if len(entities) == 0 {
+			continue
+		}
+
+		userCtx := context.WithValue(ctx, inventory.UserCtx{}, managers[uid].user)
+		t, err := newExplicitEntityRecycleTask(userCtx, entities)
+		if err != nil {
+			l.Error("Failed to create explicit entity recycle task for user %d: %s", uid, err)
+			continue
+		}
+
+		if err := dep.EntityRecycleQueue(userCtx).QueueTask(userCtx, t); err != nil {
+			l.Error("Failed to queue explicit entity recycle task for user %d: %s", uid, err)
+		}
+	}
+}
+
+// pruneVersions deletes versions of given file not covered by its retention schedule, returns IDs of
+// entities that are no longer referenced by any file.
+func (m *manager) pruneVersions(ctx context.Context, fileID int, now time.Time) ([]int, error) {
+	ctx = context.WithValue(ctx, inventory.LoadFileEntity{}, true)
+	file, err := m.fs.TraverseFile(ctx, fileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to traverse file: %w", err)
+	}
+
+	// Files in trash bin are left as is until they are collected.
+	uri := file.Uri(false)
+	if uri == nil || uri.FileSystem() != constants.FileSystemMy {
+		return nil, nil
+	}
+
+	schedule := m.versionRetentionSchedule(file)
+	if schedule == nil || len(schedule.Rules) == 0 {
+		return nil, nil
+	}
+
+	stale := make([]int, 0)
+	for _, version := range versionsToPrune(file.Entities(), file.PrimaryEntityID(), schedule, now) {
+		if err := m.fs.VersionControl(ctx, uri, version.ID(), true); err != nil {
+			return stale, fmt.Errorf("failed to delete version %d: %w", version.ID(), err)
+		}
+
+		if version.ReferenceCount() <= 1 {
+			stale = append(stale, version.ID())
+		}
+	}
+
+	return stale, nil
+}
+
+// versionRetentionSchedule resolves the effective schedule of given file. Schedule set on the file
+// or its nearest ancestor takes precedence over user setting, then group setting.
+func (m *manager) versionRetentionSchedule(file fs.File) *types.VersionRetentionSchedule {
+	if schedule := file.VersionRetention(); schedule != nil {
+		return schedule
+	}
+
+	if m.user.Settings != nil && m.user.Settings.VersionRetentionSchedule != nil {
+		return m.user.Settings.VersionRetentionSchedule
+	}
+
+	if m.user.Edges.Group != nil && m.user.Edges.Group.Settings != nil {
+		return m.user.Edges.Group.Settings.VersionRetentionSchedule
+	}
+
+	return nil
+}
+
+// versionsToPrune returns historical versions that fall out of given schedule. Within a rule window,
+// only the newest version of each Interval slot is kept; versions older than all windows are pruned.
+// Current version is never pruned, but it occupies the slot it falls in.
+func versionsToPrune(versions []fs.Entity, primaryID int, schedule *types.VersionRetentionSchedule, now time.Time) []fs.Entity {
+	rules := make([]types.VersionRetentionRule, len(schedule.Rules))
+	copy(rules, schedule.Rules)
+	sort.Slice(rules, func(i, j int) bool {
+		return rules[i].Within < rules[j].Within
+	})
+
+	type slot struct {
+		rule  int
+		index int64
+	}
+	slotOf := func(e fs.Entity) (slot, bool) {
+		age := int64(now.Sub(e.CreatedAt()).Seconds())
+		for i, rule := range rules {
+			if age < rule.Within {
+				if rule.Interval <= 0 {
+					return slot{rule: i, index: int64(e.ID())}, true
+				}
+				return slot{rule: i, index: e.CreatedAt().Unix() / rule.Interval}, true
+			}
+		}
+
+		return slot{}, false
+	}
+
+	occupied := make(map[slot]bool)
+	if primary, found := lo.Find(versions, func(e fs.Entity) bool {
+		return e.ID() == primaryID
+	}); found {
+		if s, ok := slotOf(primary); ok {
+			occupied[s] = true
+		}
+	}
+
+	candidates := lo.Filter(versions, func(e fs.Entity, index int) bool {
+		return e.Type() == types.EntityTypeVersion && e.ID() != primaryID && e.UploadSessionID() == nil
+	})
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].CreatedAt().After(candidates[j].CreatedAt())
+	})
+
+	res := make([]fs.Entity, 0)
+	for _, candidate := range candidates {
+		s, ok := slotOf(candidate)
+		if !ok || occupied[s] {
+			res = append(res, candidate)
+			continue
+		}
+
+		occupied[s] = true
+	}
+
+	return res
+}
@@ -0,0 +1,84 @@
+package manager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/gofrs/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersionsToPrune(t *testing.T) {
+	now := time.Date(2024, 1, 10, 12, 30, 0, 0, time.UTC)
+	hour, day := int64(3600), int64(86400)
+	version := func(id int, age time.Duration) fs.Entity {
+		return fs.NewEntity(&ent.Entity{ID: id, Type: int(types.EntityTypeVersion), CreatedAt: now.Add(-age)})
+	}
+
+	tests := []struct {
+		name     string
+		versions []fs.Entity
+		primary  int
+		rules    []types.VersionRetentionRule
+		expected []int
+	}{
+		{
+			name:     "keep all versions within window without interval",
+			versions: []fs.Entity{version(1, time.Minute), version(2, 2*time.Minute), version(3, 3*time.Minute), version(4, 2*time.Hour)},
+			primary:  1,
+			rules:    []types.VersionRetentionRule{{Within: hour}},
+			expected: []int{4},
+		},
+		{
+			name: "keep newest version of each slot in age tiers",
+			versions: []fs.Entity{
+				version(1, 0),
+				// Hourly tier within a day
+				version(2, 40*time.Minute), version(3, 50*time.Minute), version(4, 3*time.Hour),
+				// Daily tier within a week
+				version(5, 50*time.Hour), version(6, 51*time.Hour),
+				// Older than all tiers
+				version(7, 30*24*time.Hour),
+			},
+			primary: 1,
+			rules: []types.VersionRetentionRule{
+				// Unordered rules are sorted by window
+				{Within: 7 * day, Interval: day},
+				{Within: day, Interval: hour},
+			},
+			expected: []int{3, 6, 7},
+		},
+		{
+			name:     "primary entity occupies its slot and is never pruned",
+			versions: []fs.Entity{version(1, 30*time.Minute), version(2, 10*time.Minute), version(3, 40*24*time.Hour)},
+			primary:  3,
+			rules:    []types.VersionRetentionRule{{Within: day, Interval: day}},
+			expected: []int{1},
+		},
+		{
+			name: "other entities and ongoing uploads are ignored",
+			versions: []fs.Entity{
+				version(1, 0),
+				fs.NewEntity(&ent.Entity{ID: 2, Type: int(types.EntityTypeThumbnail), CreatedAt: now.Add(-40 * 24 * time.Hour)}),
+				fs.NewEntity(&ent.Entity{ID: 3, Type: int(types.EntityTypeVersion), CreatedAt: now.Add(-40 * 24 * time.Hour),
+					UploadSessionID: lo.ToPtr(uuid.Must(uuid.NewV4()))}),
+			},
+			primary:  1,
+			rules:    []types.VersionRetentionRule{{Within: day}},
+			expected: []int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := versionsToPrune(tt.versions, tt.primary, &types.VersionRetentionSchedule{Rules: tt.rules}, now)
+			assert.ElementsMatch(t, tt.expected, lo.Map(res, func(e fs.Entity, index int) int {
+				return e.ID()
+			}))
+		})
+	}
+}
@@ -0,0 +1,287 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/constants"
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/crontab"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"golang.org/x/tools/container/intsets"
+)
+
+type (
+	SnapshotManagement interface {
+		// CreateSnapshot records current state of given folder as a snapshot. File versions pinned by
+		// the snapshot are kept after files are deleted, and stay counted in user's storage until no
+		// snapshot pins them.
+		CreateSnapshot(ctx context.Context, uri *fs.URI, name string, scheduled bool) (*ent.Snapshot, error)
+		// DeleteSnapshots deletes given snapshots, entities no longer referenced are recycled.
+		DeleteSnapshots(ctx context.Context, snapshots ...*ent.Snapshot) error
+		// PatchSnapshotSchedule sets or removes (if schedule is nil) snapshot schedule of given folder.
+		PatchSnapshotSchedule(ctx context.Context, uri *fs.URI, schedule *types.SnapshotSchedule) error
+		// RestoreEntity sets given version entity as current version of the file at given path.
+		RestoreEntity(ctx context.Context, uri *fs.URI, entityID int) (fs.File, error)
+	}
+)
+
+func init() {
+	crontab.Register(setting.CronTypeFolderSnapshot, CronFolderSnapshot)
+}
+
+func (m *manager) CreateSnapshot(ctx context.Context, uri *fs.URI, name string, scheduled bool) (*ent.Snapshot, error) {
+	if uri.FileSystem() != constants.FileSystemMy {
+		return nil, fs.ErrNotSupportedAction.WithError(fmt.Errorf("only folders in my files can be snapshotted"))
+	}
+
+	var (
+		root      fs.File
+		rootDepth int
+		nodes     []types.SnapshotNode
+	)
+	if err := m.Walk(ctx, uri, intsets.MaxInt, func(f fs.File, level int) error {
+		if level == 0 {
+			if f.Type() != types.FileTypeFolder {
+				return fs.ErrNotSupportedAction.WithError(fmt.Errorf("only folders can be snapshotted"))
+			}
+
+			root = f
+			rootDepth = len(f.Uri(false).Elements())
+		}
+
+		if f.IsSymbolic() {
+			return nil
+		}
+
+		node := types.SnapshotNode{
+			FileID:    f.ID(),
+			Path:      strings.Join(f.Uri(false).Elements()[rootDepth:], fs.Separator),
+			Type:      f.Type(),
+			Size:      f.Size(),
+			Policy:    f.PolicyID(),
+			CreatedAt: f.CreatedAt(),
+			UpdatedAt: f.UpdatedAt(),
+		}
+		if f.Type() == types.FileTypeFile {
+			node.Entity = f.PrimaryEntityID()
+		}
+
+		nodes = append(nodes, node)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if name == "" {
+		name = time.Now().Format(time.DateTime)
+	}
+
+	sc, tx, ctx, err := inventory.WithTx(ctx, m.dep.SnapshotClient())
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to start transaction", err)
+	}
+
+	s, err := sc.Create(ctx, &inventory.CreateSnapshotParameters{
+		OwnerID:   m.user.ID,
+		FileID:    root.ID(),
+		Name:      name,
+		RootUri:   root.Uri(false).String(),
+		Scheduled: scheduled,
+		Manifest:  nodes,
+	})
+	if err != nil {
+		_ = inventory.Rollback(tx)
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to create snapshot", err)
+	}
+
+	if err := inventory.Commit(tx); err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to commit snapshot", err)
+	}
+
+	return s, nil
+}
+
+func (m *manager) DeleteSnapshots(ctx context.Context, snapshots ...*ent.Snapshot) error {
+	sc, tx, ctx, err := inventory.WithTx(ctx, m.dep.SnapshotClient())
+	if err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to start transaction", err)
+	}
+
+	stale, diff, err := sc.Delete(ctx, snapshots...)
+	if err != nil {
+		_ = inventory.Rollback(tx)
+		return serializer.NewError(serializer.CodeDBError, "Failed to delete snapshots", err)
+	}
+
+	tx.AppendStorageDiff(diff)
+	if err := inventory.CommitWithStorageDiff(ctx, tx, m.l, m.dep.UserClient()); err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to commit snapshot deletion", err)
+	}
+
+	if len(stale) == 0 {
+		return nil
+	}
+
+	t, err := newExplicitEntityRecycleTask(ctx, stale)
+	if err != nil {
+		m.l.Warning("Failed to create explicit entity recycle task: %s", err)
+		return nil
+	}
+
+	if err := m.dep.EntityRecycleQueue(ctx).QueueTask(ctx, t); err != nil {
+		m.l.Warning("Failed to queue explicit entity recycle task: %s", err)
+	}
+
+	return nil
+}
+
+func (m *manager) PatchSnapshotSchedule(ctx context.Context, uri *fs.URI, schedule *types.SnapshotSchedule) error {
+	target, err := m.fs.Get(ctx, uri)
+	if err != nil {
+		return err
+	}
+
+	if target.Type() != types.FileTypeFolder {
+		return fs.ErrNotSupportedAction.WithError(fmt.Errorf("snapshot schedule can only be set on folders"))
+	}
+
+	patch := &types.FileProps{
+		Snapshot: schedule,
+	}
+	isDelete := schedule == nil
+	if isDelete {
+		patch.Snapshot = &types.SnapshotSchedule{}
+	}
+
+	return m.fs.PatchProps(ctx, uri, patch, isDelete)
+}
+
+func (m *manager) RestoreEntity(ctx context.Context, uri *fs.URI, entityID int) (fs.File, error) {
+	return m.fs.RestoreEntity(ctx, uri, entityID)
+}
+
+// CronFolderSnapshot takes snapshots of folders whose snapshot schedule is due, then deletes
+// scheduled snapshots exceeding the retention limit.
+func CronFolderSnapshot(ctx context.Context) {
+	dep := dependency.FromContext(ctx)
+	l := dep.Logger()
+	fc := dep.FileClient()
+	uc := dep.UserClient()
+	pageSize := dep.SettingProvider().DBFS(ctx).MaxPageSize
+	now := time.Now()
+
+	managers := make(map[int]*manager)
+	defer func() {
+		for _, fm := range managers {
+			fm.Recycle()
+		}
+	}()
+
+	afterID := 0
+	for {
+		folders, err := fc.ListFoldersWithProps(ctx, afterID, pageSize)
+		if err != nil {
+			l.Error("Failed to list folders with props: %s", err)
+			break
+		}
+
+		for _, folder := range folders {
+			afterID = folder.ID
+			if folder.Props == nil || folder.Props.Snapshot == nil {
+				continue
+			}
+
+			fm, ok := managers[folder.OwnerID]
+			if !ok {
+				user, err := uc.GetByID(context.WithValue(ctx, inventory.LoadUserGroup{}, true), folder.OwnerID)
+				if err != nil {
+					l.Error("Failed to get user %d: %s", folder.OwnerID, err)
+					continue
+				}
+
+				fm = NewFileManager(dep, user).(*manager)
+				managers[folder.OwnerID] = fm
+			}
+
+			userCtx := context.WithValue(ctx, inventory.UserCtx{}, fm.user)
+			if err := fm.scheduledSnapshot(userCtx, folder.ID, folder.Props.Snapshot, now); err != nil {
+				l.Warning("Failed to take scheduled snapshot of folder %d: %s", folder.ID, err)
+			}
+		}
+
+		if len(folders) < pageSize {
+			break
+		}
+	}
+}
+
+func (m *manager) scheduledSnapshot(ctx context.Context, folderID int, schedule *types.SnapshotSchedule, now time.Time) error {
+	sc := m.dep.SnapshotClient()
+	scheduled := true
+	latest, err := sc.List(ctx, &inventory.ListSnapshotParameters{
+		PaginationArgs: &inventory.PaginationArgs{
+			PageSize: 1,
+			Order:    inventory.OrderDirectionDesc,
+		},
+		FileID:    folderID,
+		Scheduled: &scheduled,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get latest snapshot: %w", err)
+	}
+
+	if len(latest.Snapshots) > 0 && now.Sub(latest.Snapshots[0].CreatedAt) < time.Duration(schedule.Interval)*time.Second {
+		return nil
+	}
+
+	folder, err := m.fs.TraverseFile(ctx, folderID)
+	if err != nil {
+		return fmt.Errorf("failed to traverse folder: %w", err)
+	}
+
+	// Folders in trash bin are not snapshotted.
+	uri := folder.Uri(false)
+	if uri == nil || uri.FileSystem() != constants.FileSystemMy {
+		return nil
+	}
+
+	if _, err := m.CreateSnapshot(ctx, uri, now.Format(time.DateTime), true); err != nil {
+		return fmt.Errorf("failed to create snapshot: %w", err)
+	}
+
+	if schedule.Keep <= 0 {
+		return nil
+	}
+
+	// Delete oldest scheduled snapshots beyond the retention limit.
+	for {
+		expired, err := sc.List(ctx, &inventory.ListSnapshotParameters{
+			PaginationArgs: &inventory.PaginationArgs{
+				Page:     1,
+				PageSize: schedule.Keep,
+				Order:    inventory.OrderDirectionDesc,
+			},
+			FileID:    folderID,
+			Scheduled: &scheduled,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list expired snapshots: %w", err)
+		}
+
+		if len(expired.Snapshots) == 0 {
+			return nil
+		}
+
+		if err := m.DeleteSnapshots(ctx, expired.Snapshots...); err != nil {
+			return err
+		}
+	}
+}
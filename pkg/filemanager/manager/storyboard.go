@@ -0,0 +1,274 @@
+package manager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/task"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/dbfs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager/entitysource"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
+	"github.com/cloudreve/Cloudreve/v4/pkg/thumb"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/samber/lo"
+)
+
+type (
+	StoryboardManagement interface {
+		// Storyboard returns the storyboard sprite sheet of the video together with its frame layout.
+		// The storyboard is generated and awaited if not exist.
+		Storyboard(ctx context.Context, uri *fs.URI) (entitysource.EntitySource, *types.StoryboardProps, error)
+	}
+)
+
+func (m *manager) Storyboard(ctx context.Context, uri *fs.URI) (entitysource.EntitySource, *types.StoryboardProps, error) {
+	if m.stateless || !m.settings.FFMpegStoryboardEnabled(ctx) {
+		return nil, nil, fs.ErrNotSupportedAction.WithError(fmt.Errorf("storyboard is disabled"))
+	}
+
+	file, err := m.fs.Get(ctx, uri, dbfs.WithFileEntities())
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get file: %w", err)
+	}
+
+	latest := file.PrimaryEntity()
+	if file.Type() != types.FileTypeFile || latest == nil || latest.ID() == 0 {
+		return nil, nil, fs.ErrEntityNotExist
+	}
+
+	// 1. If storyboard of current version exist, use it.
+	storyboard, found := lo.Find(file.Entities(), func(e fs.Entity) bool {
+		return e.Type() == types.EntityTypeStoryboard && e.Props() != nil && e.Props().Storyboard != nil &&
+			e.Props().Storyboard.SourceEntity == latest.ID()
+	})
+	if found {
+		es, err := m.GetEntitySource(ctx, 0, fs.WithEntity(storyboard))
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get entity source: %w", err)
+		}
+
+		es.Apply(entitysource.WithDisplayName(getEntityDisplayName(file, storyboard)))
+		return es, storyboard.Props().Storyboard, nil
+	}
+
+	// 2. Generate a new one.
+	if !m.shouldGenerateStoryboard(ctx, file.DisplayName(), latest.Size()) {
+		return nil, nil, fs.ErrEntityNotExist
+	}
+
+	if err := m.fs.CheckCapability(ctx, uri,
+		dbfs.WithRequiredCapabilities(dbfs.NavigatorCapabilityGenerateThumb)); err != nil {
+		// Current FS does not support generating new storyboard.
+		return nil, nil, fs.ErrEntityNotExist
+	}
+
+	storyboard, props, err := m.SubmitAndAwaitStoryboardTask(ctx, uri, file.Ext(), latest)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to execute storyboard task: %w", err)
+	}
+
+	es, err := m.GetEntitySource(ctx, 0, fs.WithEntity(storyboard))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get entity source: %w", err)
+	}
+
+	es.Apply(entitysource.WithDisplayName(getEntityDisplayName(file, storyboard)))
+	return es, props, nil
+}
+
+func (m *manager) SubmitAndAwaitStoryboardTask(ctx context.Context, uri *fs.URI, ext string, entity fs.Entity) (fs.Entity, *types.StoryboardProps, error) {
+	t, err := m.submitStoryboardTask(ctx, uri, ext, entity)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Wait for task to finish
+	select {
+	case <-ctx.Done():
+		return nil, nil, ctx.Err()
+	case res := <-t.sig:
+		if res.err != nil {
+			return nil, nil, fmt.Errorf("failed to generate storyboard: %w", res.err)
+		}
+
+		return res.entity, res.props, nil
+	}
+}
+
+func (m *manager) submitStoryboardTask(ctx context.Context, uri *fs.URI, ext string, entity fs.Entity) (*GenerateStoryboardTask, error) {
+	es, err := m.GetEntitySource(ctx, 0, fs.WithEntity(entity))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get entity source: %w", err)
+	}
+
+	t := newGenerateStoryboardTask(ctx, m, uri, ext, es)
+	if err := m.dep.ThumbQueue(ctx).QueueTask(ctx, t); err != nil {
+		es.Close()
+		return nil, fmt.Errorf("failed to queue task: %w", err)
+	}
+
+	return t, nil
+}
+
+func (m *manager) shouldGenerateStoryboard(ctx context.Context, fileName string, size int64) bool {
+	return m.settings.FFMpegStoryboardEnabled(ctx) &&
+		util.IsInExtensionList(m.settings.FFMpegThumbExts(ctx), fileName) &&
+		size <= m.settings.FFMpegStoryboardMaxSize(ctx)
+}
+
+func (m *manager) generateStoryboard(ctx context.Context, uri *fs.URI, ext string, es entitysource.EntitySource) (fs.Entity, *types.StoryboardProps, error) {
+	res, err := thumb.NewStoryboardGenerator(m.l, m.settings).Generate(ctx, es, ext)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate storyboard: %w", err)
+	}
+
+	defer os.Remove(res.Path)
+
+	sprite, err := os.Open(res.Path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open temp storyboard %q: %w", res.Path, err)
+	}
+
+	defer sprite.Close()
+	fileInfo, err := sprite.Stat()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to stat temp storyboard %q: %w", res.Path, err)
+	}
+
+	entityType := types.EntityTypeStoryboard
+	req := &fs.UploadRequest{
+		Props: &fs.UploadProps{
+			Uri:        uri,
+			Size:       fileInfo.Size(),
+			SavePath:   path.Clean(util.ReplaceMagicVar(m.settings.StoryboardEntitySuffix(ctx), fs.Separator, true, true, time.Now(), m.user.ID, uri.Name(), uri.Path(), es.Entity().Source())),
+			MimeType:   thumb.StoryboardContentType,
+			EntityType: &entityType,
+		},
+		File:   sprite,
+		Seeker: sprite,
+	}
+
+	// Like thumbnails, storyboards can be requested by users with read-only permission.
+	ctx = dbfs.WithBypassOwnerCheck(ctx)
+	file, err := m.Update(ctx, req, fs.WithEntityType(types.EntityTypeStoryboard))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to upload storyboard entity: %w", err)
+	}
+
+	storyboard, found := lo.Find(file.Entities(), func(e fs.Entity) bool {
+		return e.Type() == types.EntityTypeStoryboard
+	})
+	if !found {
+		return nil, nil, fmt.Errorf("failed to find storyboard entity")
+	}
+
+	storyboardProps := res.Props
+	storyboardProps.SourceEntity = es.Entity().ID()
+	props := &types.EntityProps{}
+	if storyboard.Props() != nil {
+		*props = *storyboard.Props()
+	}
+	props.Storyboard = &storyboardProps
+	if _, err := m.dep.FileClient().UpdateEntityProps(ctx, storyboard.Model(), props); err != nil {
+		return nil, nil, fmt.Errorf("failed to save storyboard props: %w", err)
+	}
+
+	return storyboard, &storyboardProps, nil
+}
+
+func (m *manager) storyboardForNewEntity(ctx context.Context, session *fs.UploadSession) {
+	if session.Props.EntityType != nil && *session.Props.EntityType != types.EntityTypeVersion {
+		return
+	}
+
+	if !m.settings.FFMpegStoryboardOnUpload(ctx) ||
+		!m.shouldGenerateStoryboard(ctx, session.Props.Uri.Name(), session.Props.Size) {
+		return
+	}
+
+	entity, err := m.fs.GetEntity(ctx, session.EntityID)
+	if err != nil {
+		m.l.Warning("Failed to get new entity for storyboard: %s", err)
+		return
+	}
+
+	if _, err := m.submitStoryboardTask(ctx, session.Props.Uri, util.Ext(session.Props.Uri.Name()), entity); err != nil {
+		m.l.Warning("Failed to queue storyboard task: %s", err)
+	}
+}
+
+type (
+	GenerateStoryboardTask struct {
+		*queue.InMemoryTask
+		es  entitysource.EntitySource
+		ext string
+		m   *manager
+		uri *fs.URI
+		sig chan *generateStoryboardRes
+	}
+	generateStoryboardRes struct {
+		entity fs.Entity
+		props  *types.StoryboardProps
+		err    error
+	}
+)
+
+func newGenerateStoryboardTask(ctx context.Context, m *manager, uri *fs.URI, ext string, es entitysource.EntitySource) *GenerateStoryboardTask {
+	t := &GenerateStoryboardTask{
+		InMemoryTask: &queue.InMemoryTask{
+			DBTask: &queue.DBTask{
+				Task: &ent.Task{
+					CorrelationID: logging.CorrelationID(ctx),
+					PublicState:   &types.TaskPublicState{},
+				},
+			},
+		},
+		es:  es,
+		ext: ext,
+		m:   m,
+		uri: uri,
+		sig: make(chan *generateStoryboardRes, 2),
+	}
+
+	t.InMemoryTask.DBTask.Task.SetUser(m.user)
+	return t
+}
+
+func (m *GenerateStoryboardTask) Do(ctx context.Context) (task.Status, error) {
+	// Entity source is owned by the task, since submitter might not wait for the result.
+	defer m.es.Close()
+
+	entity, props, err := m.m.generateStoryboard(ctx, m.uri, m.ext, m.es)
+	if err != nil {
+		if errors.Is(err, thumb.ErrNotAvailable) {
+			m.notify(&generateStoryboardRes{err: err})
+			return task.StatusCompleted, nil
+		}
+
+		return task.StatusError, err
+	}
+
+	m.notify(&generateStoryboardRes{entity: entity, props: props})
+	return task.StatusCompleted, nil
+}
+
+func (m *GenerateStoryboardTask) OnError(err error, d time.Duration) {
+	m.InMemoryTask.OnError(err, d)
+	m.notify(&generateStoryboardRes{err: err})
+}
+
+// notify sends result to the submitter without blocking, since it might not be waiting at all.
+func (m *GenerateStoryboardTask) notify(res *generateStoryboardRes) {
+	select {
+	case m.sig <- res:
+	default:
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager/entitysource"
 	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
 	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
 	"github.com/cloudreve/Cloudreve/v4/pkg/thumb"
 	"github.com/cloudreve/Cloudreve/v4/pkg/util"
 	"github.com/samber/lo"
@@ -31,15 +32,16 @@ func (m *manager) Thumbnail(ctx context.Context, uri *fs.URI) (entitysource.Enti
 		return nil, fmt.Errorf("failed to get file: %w", err)
 	}
 
-	// 0. Check if thumb is disabled in this file.
-	if _, ok := file.Metadata()[dbfs.ThumbDisabledKey]; ok || file.Type() != types.FileTypeFile {
+	// 0. Check if thumb of requested size is disabled in this file.
+	if _, ok := file.Metadata()[thumbDisabledKey(ctx)]; ok || file.Type() != types.FileTypeFile {
 		return nil, fs.ErrEntityNotExist
 	}
 
-	// 1. If thumbnail entity exist, use it.
+	// 1. If thumbnail entity of requested size exist, use it.
+	entityType := thumbEntityType(ctx)
 	entities := file.Entities()
 	thumbEntity, found := lo.Find(entities, func(e fs.Entity) bool {
-		return e.Type() == types.EntityTypeThumbnail
+		return e.Type() == entityType
 	})
 	if found {
 		thumbSource, err := m.GetEntitySource(ctx, 0, fs.WithEntity(thumbEntity))
@@ -176,12 +178,12 @@ func (m *manager) generateThumb(ctx context.Context, uri *fs.URI, ext string, es
 			return nil, fmt.Errorf("failed to save thumb sidecar: %w", err)
 		}
 
-		thumbEntity, err = local.NewLocalFileEntity(types.EntityTypeThumbnail, savePath)
+		thumbEntity, err = local.NewLocalFileEntity(thumbEntityType(ctx), savePath)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create local thumb entity: %w", err)
 		}
 	} else {
-		entityType := types.EntityTypeThumbnail
+		entityType := thumbEntityType(ctx)
 		req := &fs.UploadRequest{
 			Props: &fs.UploadProps{
 				Uri:        uri,
@@ -197,7 +199,7 @@ func (m *manager) generateThumb(ctx context.Context, uri *fs.URI, ext string, es
 		// Generating thumb can be triggered by users with read-only permission. We can bypass update permission check.
 		ctx = dbfs.WithBypassOwnerCheck(ctx)
 
-		file, err := m.Update(ctx, req, fs.WithEntityType(types.EntityTypeThumbnail))
+		file, err := m.Update(ctx, req, fs.WithEntityType(entityType))
 		if err != nil {
 			return nil, fmt.Errorf("failed to upload thumb entity: %w", err)
 		}
@@ -205,7 +207,7 @@ func (m *manager) generateThumb(ctx context.Context, uri *fs.URI, ext string, es
 		entities := file.Entities()
 		found := false
 		thumbEntity, found = lo.Find(entities, func(e fs.Entity) bool {
-			return e.Type() == types.EntityTypeThumbnail
+			return e.Type() == entityType
 		})
 		if !found {
 			return nil, fmt.Errorf("failed to find thumb entity")
@@ -229,6 +231,8 @@ type (
 		m   *manager
 		uri *fs.URI
 		sig chan *generateRes
+		// variant is the requested thumbnail size, restored in task context.
+		variant setting.ThumbVariant
 	}
 	generateRes struct {
 		thumbEntity fs.Entity
@@ -246,11 +250,12 @@ func newGenerateThumbTask(ctx context.Context, m *manager, uri *fs.URI, ext stri
 				},
 			},
 		},
-		es:  es,
-		ext: ext,
-		m:   m,
-		uri: uri,
-		sig: make(chan *generateRes, 2),
+		es:      es,
+		ext:     ext,
+		m:       m,
+		uri:     uri,
+		sig:     make(chan *generateRes, 2),
+		variant: setting.ThumbVariantFromContext(ctx),
 	}
 
 	t.InMemoryTask.DBTask.Task.SetUser(m.user)
@@ -266,7 +271,7 @@ func (m *GenerateThumbTask) Do(ctx context.Context) (task.Status, error) {
 	default:
 	}
 
-	res, err := m.m.generateThumb(ctx, m.uri, m.ext, m.es)
+	res, err := m.m.generateThumb(setting.WithThumbVariant(ctx, m.variant), m.uri, m.ext, m.es)
 	if err != nil {
 		if errors.Is(err, thumb.ErrNotAvailable) {
 			m.sig <- &generateRes{nil, err}
@@ -285,11 +290,30 @@ func (m *GenerateThumbTask) OnError(err error, d time.Duration) {
 	m.sig <- &generateRes{nil, err}
 }
 
+// thumbEntityType returns the entity type of thumbnail variant specified in context.
+func thumbEntityType(ctx context.Context) types.EntityType {
+	switch setting.ThumbVariantFromContext(ctx) {
+	case setting.ThumbVariantSmall:
+		return types.EntityTypeThumbnailSmall
+	case setting.ThumbVariantLarge:
+		return types.EntityTypeThumbnailLarge
+	default:
+		return types.EntityTypeThumbnail
+	}
+}
+
+// thumbDisabledKey returns the metadata key marking thumbnail variant specified in context as not available.
+func thumbDisabledKey(ctx context.Context) string {
+	return dbfs.ThumbDisabledKeyOf(setting.ThumbVariantFromContext(ctx))
+}
+
+// disableThumb marks thumbnail variant specified in context as not available, other variants are
+// not affected.
 func disableThumb(ctx context.Context, m *manager, uri *fs.URI) error {
 	return m.fs.PatchMetadata(
 		dbfs.WithBypassOwnerCheck(ctx),
 		[]*fs.URI{uri}, fs.MetadataPatch{
-			Key:     dbfs.ThumbDisabledKey,
+			Key:     thumbDisabledKey(ctx),
 			Value:   "",
 			Private: false,
 		})
@@ -0,0 +1,208 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/dbfs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager/entitysource"
+	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/cloudreve/Cloudreve/v4/pkg/transcode"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/samber/lo"
+)
+
+type (
+	TranscodeManagement interface {
+		// TranscodeSource returns the file and its primary entity to be transcoded, error is returned
+		// if transcoding is disabled or not applicable for the file.
+		TranscodeSource(ctx context.Context, uri *fs.URI) (fs.File, fs.Entity, error)
+		// SaveTranscode uploads transcoded renditions as the transcode entity of the file.
+		SaveTranscode(ctx context.Context, uri *fs.URI, sourceEntity int, res *transcode.Result, progress fs.ProgressFunc) error
+		// SetTranscodeProps saves renditions into existing transcode entity of the file, used after
+		// the entity is uploaded by a slave node.
+		SetTranscodeProps(ctx context.Context, uri *fs.URI, props *types.TranscodeProps) error
+		// TranscodeUrl returns renditions of the file together with the URL of its transcode entity.
+		TranscodeUrl(ctx context.Context, uri *fs.URI, expire *time.Time) (string, *types.TranscodeProps, error)
+	}
+
+	// TranscodeTaskFactory creates a task to transcode given entity of the file.
+	TranscodeTaskFactory func(ctx context.Context, uri *fs.URI, entityID int, creator *ent.User) (queue.Task, error)
+)
+
+var transcodeTaskFactory TranscodeTaskFactory
+
+// RegisterTranscodeTaskFactory registers the factory used to queue transcoding tasks for new uploads.
+func RegisterTranscodeTaskFactory(factory TranscodeTaskFactory) {
+	transcodeTaskFactory = factory
+}
+
+// TranscodeSavePath returns the save path of transcode entity for given source entity.
+func TranscodeSavePath(ctx context.Context, settings setting.Provider, uid int, uri *fs.URI, source fs.Entity) string {
+	return path.Clean(util.ReplaceMagicVar(settings.Transcode(ctx).EntitySuffix, fs.Separator, true, true, time.Now(),
+		uid, uri.Name(), uri.Path(), source.Source()))
+}
+
+func (m *manager) TranscodeSource(ctx context.Context, uri *fs.URI) (fs.File, fs.Entity, error) {
+	transcodeSetting := m.settings.Transcode(ctx)
+	if !transcodeSetting.Enabled {
+		return nil, nil, fs.ErrNotSupportedAction.WithError(fmt.Errorf("video transcoding is disabled"))
+	}
+
+	file, err := m.fs.Get(ctx, uri, dbfs.WithFileEntities(), dbfs.WithRequiredCapabilities(dbfs.NavigatorCapabilityDownloadFile))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get file: %w", err)
+	}
+
+	if file.Type() != types.FileTypeFile {
+		return nil, nil, fs.ErrNotSupportedAction.WithError(fmt.Errorf("not a file"))
+	}
+
+	if !util.IsInExtensionList(transcodeSetting.Exts, file.DisplayName()) {
+		return nil, nil, fs.ErrNotSupportedAction.WithError(fmt.Errorf("unsupported video format: %s", file.Ext()))
+	}
+
+	source := file.PrimaryEntity()
+	if source == nil || source.ID() == 0 {
+		return nil, nil, fs.ErrEntityNotExist
+	}
+
+	if source.Size() > transcodeSetting.MaxSize {
+		return nil, nil, fs.ErrNotSupportedAction.WithError(fmt.Errorf("file is too big to transcode"))
+	}
+
+	return file, source, nil
+}
+
+func (m *manager) SaveTranscode(ctx context.Context, uri *fs.URI, sourceEntity int, res *transcode.Result, progress fs.ProgressFunc) error {
+	file, err := m.fs.Get(ctx, uri, dbfs.WithFileEntities())
+	if err != nil {
+		return fmt.Errorf("failed to get file: %w", err)
+	}
+
+	source, found := lo.Find(file.Entities(), func(e fs.Entity) bool {
+		return e.ID() == sourceEntity
+	})
+	if !found {
+		return fs.ErrEntityNotExist.WithError(fmt.Errorf("source entity %d not found", sourceEntity))
+	}
+
+	output, err := os.Open(res.Path)
+	if err != nil {
+		return fmt.Errorf("failed to open transcoded file %q: %w", res.Path, err)
+	}
+	defer output.Close()
+
+	entityType := types.EntityTypeTranscode
+	req := &fs.UploadRequest{
+		Props: &fs.UploadProps{
+			Uri:        uri,
+			Size:       res.Size,
+			SavePath:   TranscodeSavePath(ctx, m.settings, m.user.ID, uri, source),
+			MimeType:   transcode.ContentType,
+			EntityType: &entityType,
+		},
+		ProgressFunc: progress,
+		File:         output,
+		Seeker:       output,
+	}
+
+	if _, err := m.Update(ctx, req, fs.WithEntityType(types.EntityTypeTranscode)); err != nil {
+		return fmt.Errorf("failed to upload transcode entity: %w", err)
+	}
+
+	return m.SetTranscodeProps(ctx, uri, &types.TranscodeProps{
+		SourceEntity: sourceEntity,
+		Renditions:   res.Renditions,
+	})
+}
+
+func (m *manager) SetTranscodeProps(ctx context.Context, uri *fs.URI, transcodeProps *types.TranscodeProps) error {
+	file, err := m.fs.Get(ctx, uri, dbfs.WithFileEntities())
+	if err != nil {
+		return fmt.Errorf("failed to get file: %w", err)
+	}
+
+	e, found := lo.Find(file.Entities(), func(e fs.Entity) bool {
+		return e.Type() == types.EntityTypeTranscode
+	})
+	if !found {
+		return fs.ErrEntityNotExist.WithError(fmt.Errorf("transcode entity not found"))
+	}
+
+	props := &types.EntityProps{}
+	if e.Props() != nil {
+		*props = *e.Props()
+	}
+	props.Transcode = transcodeProps
+	if _, err := m.dep.FileClient().UpdateEntityProps(ctx, e.Model(), props); err != nil {
+		return fmt.Errorf("failed to save transcode props: %w", err)
+	}
+
+	return nil
+}
+
+func (m *manager) TranscodeUrl(ctx context.Context, uri *fs.URI, expire *time.Time) (string, *types.TranscodeProps, error) {
+	file, err := m.fs.Get(ctx, uri, dbfs.WithFileEntities(), dbfs.WithRequiredCapabilities(dbfs.NavigatorCapabilityDownloadFile))
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to get file: %w", err)
+	}
+
+	e, found := lo.Find(file.Entities(), func(e fs.Entity) bool {
+		return e.Type() == types.EntityTypeTranscode
+	})
+	primary := file.PrimaryEntity()
+	// Renditions are outdated if they are not transcoded from current version.
+	if !found || e.Props() == nil || e.Props().Transcode == nil || primary == nil ||
+		e.Props().Transcode.SourceEntity != primary.ID() {
+		return "", nil, fs.ErrEntityNotExist
+	}
+
+	es, err := m.GetEntitySource(ctx, 0, fs.WithEntity(e))
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to get entity source: %w", err)
+	}
+	defer es.Close()
+
+	src, err := es.Url(ctx,
+		entitysource.WithExpire(expire),
+		entitysource.WithDisplayName(getEntityDisplayName(file, e)),
+	)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to get transcode entity url: %w", err)
+	}
+
+	return src.Url, e.Props().Transcode, nil
+}
+
+func (m *manager) transcodeForNewEntity(ctx context.Context, session *fs.UploadSession) {
+	if transcodeTaskFactory == nil ||
+		(session.Props.EntityType != nil && *session.Props.EntityType != types.EntityTypeVersion) {
+		return
+	}
+
+	transcodeSetting := m.settings.Transcode(ctx)
+	if !transcodeSetting.Enabled || !transcodeSetting.Auto ||
+		!util.IsInExtensionList(transcodeSetting.Exts, session.Props.Uri.Name()) ||
+		session.Props.Size > transcodeSetting.MaxSize ||
+		m.user.Edges.Group == nil || !m.user.Edges.Group.Permissions.Enabled(int(types.GroupPermissionTranscode)) {
+		return
+	}
+
+	t, err := transcodeTaskFactory(ctx, session.Props.Uri, session.EntityID, m.user)
+	if err != nil {
+		m.l.Warning("Failed to create transcode task: %s", err)
+		return
+	}
+
+	if err := m.dep.TranscodeQueue(ctx).QueueTask(ctx, t); err != nil {
+		m.l.Warning("Failed to queue transcode task: %s", err)
+	}
+}
@@ -430,6 +430,10 @@ func (m *manager) onNewEntityUploaded(ctx context.Context, session *fs.UploadSes
 	if !m.stateless {
 		// Submit media meta task for new entity
 		m.mediaMetaForNewEntity(ctx, session, d)
+		// Submit transcode task for new video
+		m.transcodeForNewEntity(ctx, session)
+		// Submit storyboard task for new video
+		m.storyboardForNewEntity(ctx, session)
 	}
 }
 
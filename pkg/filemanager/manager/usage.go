@@ -0,0 +1,771 @@
+package manager
+
+import (
+	"context"
+	"encoding/gob"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/task"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/crontab"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/dbfs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/samber/lo"
+)
+
+type (
+	UsageManagement interface {
+		// UsageReport returns the storage usage report of current user. Folders changed by file
+		// events since the last call are recounted and applied to the cached report. A full rescan
+		// is queued in background if no report is available yet, or changes cannot be applied
+		// folder by folder, the pending or stale report is returned meanwhile.
+		UsageReport(ctx context.Context) (*UsageReport, error)
+		// RefreshUsageReport computes and caches the storage usage report of current user by a full
+		// rescan, which loads all files of user with their entities page by page.
+		RefreshUsageReport(ctx context.Context) (*UsageReport, error)
+		// QueueUsageRescan queues a full rescan of storage usage of current user in background.
+		QueueUsageRescan(ctx context.Context) error
+	}
+
+	// UsageReport is a breakdown of storage used by a user.
+	UsageReport struct {
+		// Current is the size of current version of files not in trash bin.
+		Current int64 `json:"current"`
+		// Versions is the size of history versions of files not in trash bin.
+		Versions int64 `json:"versions"`
+		// Derived is the size of thumbnails and live photos of files not in trash bin.
+		Derived int64 `json:"derived"`
+		// Trash is the size of all entities of files in trash bin.
+		Trash      int64         `json:"trash"`
+		Files      int           `json:"files"`
+		Folders    int           `json:"folders"`
+		Tree       *UsageNode    `json:"tree"`
+		Categories []UsageBucket `json:"categories"`
+		Extensions []UsageBucket `json:"extensions"`
+		Policies   []UsageBucket `json:"policies"`
+		Ages       []UsageBucket `json:"ages"`
+		// Pending indicates the report is not yet calculated, a rescan is running in background.
+		Pending bool `json:"pending,omitempty"`
+		// Stale indicates files are changed after the report is calculated.
+		Stale        bool      `json:"stale"`
+		CalculatedAt time.Time `json:"calculated_at"`
+	}
+
+	// UsageNode is a folder in usage tree, Size and Files include all descendants.
+	UsageNode struct {
+		Name     string       `json:"name"`
+		Uri      string       `json:"uri,omitempty"`
+		Size     int64        `json:"size"`
+		Files    int          `json:"files"`
+		Children []*UsageNode `json:"children,omitempty"`
+		// Others indicates this node merges sibling folders beyond the display limit.
+		Others bool `json:"others,omitempty"`
+	}
+
+	// UsageBucket is the size and file count of a group of files. A file with entities in
+	// multiple storage policies is counted once in each of them.
+	UsageBucket struct {
+		Key   string `json:"key"`
+		Name  string `json:"name,omitempty"`
+		Size  int64  `json:"size"`
+		Files int    `json:"files"`
+	}
+
+	// usageCache is the cached report along with per-folder accounting it is built from, so that
+	// changed folders can be recounted without rescanning all files.
+	usageCache struct {
+		Report UsageReport
+		// Folders of user, files without parent are accounted in folder 0.
+		Folders map[int]*usageFolder
+		Root    int
+	}
+
+	usageFolder struct {
+		Name   string
+		Parent int
+		// Stat is the accounting of direct child files.
+		Stat     usageStat
+		children []int
+	}
+
+	usageStat struct {
+		Files      int
+		Current    int64
+		Versions   int64
+		Derived    int64
+		Categories map[string]*UsageBucket
+		Extensions map[string]*UsageBucket
+		Policies   map[int]*UsageBucket
+		Ages       []UsageBucket
+	}
+
+	UsageScanTask struct {
+		*queue.DBTask
+	}
+)
+
+const (
+	UsageReportCachePrefix = "usage_report_"
+	// Reports are evicted after a while, so that reports of inactive users are not kept forever.
+	usageReportTTL = 7 * 24 * 3600 // 7 days
+	// usageScanQueuedPrefix marks that a full rescan of a user is queued.
+	usageScanQueuedPrefix = "usage_scan_queued_"
+	usageScanQueuedTTL    = 3600 // 1 hour
+
+	usageTreeMaxDepth    = 4
+	usageTreeMaxChildren = 32
+	usageMaxExtensions   = 20
+	usageUnknownCategory = "other"
+)
+
+var usageAgeBuckets = []struct {
+	key    string
+	within time.Duration
+}{
+	{"week", 7 * 24 * time.Hour},
+	{"month", 30 * 24 * time.Hour},
+	{"quarter", 90 * 24 * time.Hour},
+	{"year", 365 * 24 * time.Hour},
+	{"older", 0},
+}
+
+// usageLocks stores a mutex for each user, so that the cached report of a user is updated by one
+// caller at a time.
+var usageLocks sync.Map
+
+func usageLock(uid int) *sync.Mutex {
+	l, _ := usageLocks.LoadOrStore(uid, &sync.Mutex{})
+	return l.(*sync.Mutex)
+}
+
+func init() {
+	gob.Register(usageCache{})
+	crontab.Register(setting.CronTypeUsageReport, CronRefreshUsageReports)
+	queue.RegisterResumableTaskFactory(queue.UsageScanTaskType, NewUsageScanTaskFromModel)
+}
+
+func (m *manager) UsageReport(ctx context.Context) (*UsageReport, error) {
+	lock := usageLock(m.user.ID)
+	if !lock.TryLock() {
+		// Report is being updated by another caller.
+		c, ok := m.cachedUsage()
+		if !ok {
+			return &UsageReport{Pending: true}, nil
+		}
+
+		c.Report.Stale = true
+		return &c.Report, nil
+	}
+	defer lock.Unlock()
+
+	c, ok := m.cachedUsage()
+	if !ok {
+		if err := m.QueueUsageRescan(ctx); err != nil {
+			return nil, err
+		}
+
+		return &UsageReport{Pending: true}, nil
+	}
+
+	if _, ok := m.kv.Get(fmt.Sprintf("%s%d", dbfs.UsageRescanPendingPrefix, m.user.ID)); ok {
+		if err := m.QueueUsageRescan(ctx); err != nil {
+			m.l.Warning("Failed to queue usage rescan of user %d: %s", m.user.ID, err)
+		}
+
+		c.Report.Stale = true
+		return &c.Report, nil
+	}
+
+	changed := dbfs.TakeUsageChanges(m.kv, m.user.ID)
+	if len(changed) == 0 {
+		return &c.Report, nil
+	}
+
+	if err := m.applyUsageChanges(ctx, c, changed); err != nil {
+		// Taken changes are lost, only a full rescan can bring the report up to date.
+		m.l.Warning("Failed to apply usage changes of user %d: %s", m.user.ID, err)
+		_ = m.kv.Set(fmt.Sprintf("%s%d", dbfs.UsageRescanPendingPrefix, m.user.ID), true, dbfs.UsageRescanPendingTTL)
+		if err := m.QueueUsageRescan(ctx); err != nil {
+			m.l.Warning("Failed to queue usage rescan of user %d: %s", m.user.ID, err)
+		}
+
+		c.Report.Stale = true
+		return &c.Report, nil
+	}
+
+	m.cacheUsage(c)
+	return &c.Report, nil
+}
+
+func (m *manager) RefreshUsageReport(ctx context.Context) (*UsageReport, error) {
+	lock := usageLock(m.user.ID)
+	lock.Lock()
+	defer lock.Unlock()
+
+	// Clear changes before calculation, so that changes made during calculation are recorded again.
+	_ = m.kv.Delete(dbfs.UsageRescanPendingPrefix, strconv.Itoa(m.user.ID))
+	_ = dbfs.TakeUsageChanges(m.kv, m.user.ID)
+
+	c, err := m.scanUsage(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	m.cacheUsage(c)
+	return &c.Report, nil
+}
+
+func (m *manager) QueueUsageRescan(ctx context.Context) error {
+	queuedKey := fmt.Sprintf("%s%d", usageScanQueuedPrefix, m.user.ID)
+	if _, ok := m.kv.Get(queuedKey); ok {
+		return nil
+	}
+
+	t, err := NewUsageScanTask(ctx, m.user)
+	if err != nil {
+		return serializer.NewError(serializer.CodeCreateTaskError, "Failed to create usage scan task", err)
+	}
+
+	if err := m.dep.IoIntenseQueue(ctx).QueueTask(ctx, t); err != nil {
+		return serializer.NewError(serializer.CodeCreateTaskError, "Failed to queue usage scan task", err)
+	}
+
+	_ = m.kv.Set(queuedKey, true, usageScanQueuedTTL)
+	return nil
+}
+
+func (m *manager) cachedUsage() (*usageCache, bool) {
+	cached, ok := m.kv.Get(fmt.Sprintf("%s%d", UsageReportCachePrefix, m.user.ID))
+	if !ok {
+		return nil, false
+	}
+
+	c, ok := cached.(usageCache)
+	if !ok || c.Folders == nil {
+		return nil, false
+	}
+
+	return &c, true
+}
+
+func (m *manager) cacheUsage(c *usageCache) {
+	if err := m.kv.Set(fmt.Sprintf("%s%d", UsageReportCachePrefix, m.user.ID), *c, usageReportTTL); err != nil {
+		m.l.Warning("Failed to cache usage report of user %d: %s", m.user.ID, err)
+	}
+}
+
+// scanUsage goes through all files owned by current user and aggregates the storage used.
+func (m *manager) scanUsage(ctx context.Context) (*usageCache, error) {
+	fc := m.dep.FileClient()
+	pageSize := m.settings.DBFS(ctx).MaxPageSize
+	ctx = context.WithValue(ctx, inventory.LoadFileEntity{}, true)
+
+	now := time.Now()
+	categoryMap := m.extensionCategories(ctx)
+	c := &usageCache{Folders: make(map[int]*usageFolder)}
+
+	afterID := 0
+	for {
+		page, err := fc.ListUserFiles(ctx, m.user.ID, afterID, pageSize)
+		if err != nil {
+			return nil, serializer.NewError(serializer.CodeDBError, "Failed to list files", err)
+		}
+
+		for _, file := range page {
+			afterID = file.ID
+			c.count(file, categoryMap, now)
+		}
+
+		if len(page) < pageSize {
+			break
+		}
+	}
+
+	report, err := m.buildUsageReport(ctx, c, now)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Report = *report
+	return c, nil
+}
+
+// applyUsageChanges recounts direct children of changed folders. Folders no longer found in their
+// recounted parent are removed along with their descendants, new folders are counted recursively.
+func (m *manager) applyUsageChanges(ctx context.Context, c *usageCache, changed []int) error {
+	fc := m.dep.FileClient()
+	pageSize := m.settings.DBFS(ctx).MaxPageSize
+	ctx = context.WithValue(ctx, inventory.LoadFileEntity{}, true)
+
+	now := time.Now()
+	categoryMap := m.extensionCategories(ctx)
+	recounted := make(map[int]bool)
+	listed := make(map[int]bool)
+	pending := lo.Filter(lo.Uniq(changed), func(id int, index int) bool {
+		_, ok := c.Folders[id]
+		return ok || id == 0
+	})
+
+	for len(pending) > 0 {
+		id := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+		recounted[id] = true
+		c.folder(id).Stat = usageStat{}
+
+		afterID := 0
+		for {
+			page, err := fc.ListUserChildFiles(ctx, m.user.ID, id, afterID, pageSize)
+			if err != nil {
+				return fmt.Errorf("failed to list files of folder %d: %w", id, err)
+			}
+
+			for _, file := range page {
+				afterID = file.ID
+				if file.Type == int(types.FileTypeFolder) {
+					listed[file.ID] = true
+					if _, ok := c.Folders[file.ID]; !ok {
+						pending = append(pending, file.ID)
+					}
+				}
+
+				c.count(file, categoryMap, now)
+			}
+
+			if len(page) < pageSize {
+				break
+			}
+		}
+	}
+
+	c.prune(recounted, listed)
+	report, err := m.buildUsageReport(ctx, c, now)
+	if err != nil {
+		return err
+	}
+
+	c.Report = *report
+	return nil
+}
+
+// buildUsageReport aggregates folder accountings into a report, files in folders not reachable
+// from root are in trash bin.
+func (m *manager) buildUsageReport(ctx context.Context, c *usageCache, now time.Time) (*UsageReport, error) {
+	report := &UsageReport{CalculatedAt: now}
+	for _, folder := range c.Folders {
+		folder.children = nil
+	}
+	for id, folder := range c.Folders {
+		if parent, ok := c.Folders[folder.Parent]; ok && id != 0 && folder.Parent != 0 {
+			parent.children = append(parent.children, id)
+		}
+	}
+
+	current := make(map[int]bool, len(c.Folders))
+	var markCurrent func(id int)
+	markCurrent = func(id int) {
+		current[id] = true
+		for _, child := range c.Folders[id].children {
+			markCurrent(child)
+		}
+	}
+	if _, ok := c.Folders[c.Root]; ok && c.Root != 0 {
+		markCurrent(c.Root)
+	}
+
+	categories := make(map[string]*UsageBucket)
+	extensions := make(map[string]*UsageBucket)
+	policies := make(map[int]*UsageBucket)
+	ages := make([]UsageBucket, len(usageAgeBuckets))
+	for i, bucket := range usageAgeBuckets {
+		ages[i].Key = bucket.key
+	}
+
+	for id, folder := range c.Folders {
+		stat := &folder.Stat
+		// Policies include files in trash bin, as their entities still take up space.
+		for policyID, bucket := range stat.Policies {
+			policy, ok := policies[policyID]
+			if !ok {
+				policy = &UsageBucket{Key: hashid.EncodePolicyID(m.hasher, policyID)}
+				policies[policyID] = policy
+			}
+			policy.Size += bucket.Size
+			policy.Files += bucket.Files
+		}
+
+		if !current[id] {
+			report.Trash += stat.size()
+			continue
+		}
+
+		report.Files += stat.Files
+		report.Current += stat.Current
+		report.Versions += stat.Versions
+		report.Derived += stat.Derived
+		mergeBuckets(categories, stat.Categories)
+		mergeBuckets(extensions, stat.Extensions)
+		for i := range stat.Ages {
+			ages[i].Size += stat.Ages[i].Size
+			ages[i].Files += stat.Ages[i].Files
+		}
+	}
+
+	if len(current) > 0 {
+		// Root folder itself is not counted.
+		report.Folders = len(current) - 1
+		rootUri, err := fs.NewUriFromString(fs.NewMyUri(""))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse root uri: %w", err)
+		}
+
+		report.Tree = buildUsageTree(c.Folders, c.Root, rootUri, 0)
+	}
+
+	report.Categories = sortedBuckets(categories, 0)
+	report.Extensions = sortedBuckets(extensions, usageMaxExtensions)
+	report.Ages = ages
+	report.Policies = make([]UsageBucket, 0, len(policies))
+	for id, policy := range policies {
+		if p, err := m.policyClient.GetPolicyByID(ctx, id); err == nil {
+			policy.Name = p.Name
+		}
+		report.Policies = append(report.Policies, *policy)
+	}
+	sort.Slice(report.Policies, func(i, j int) bool {
+		return report.Policies[i].Size > report.Policies[j].Size
+	})
+
+	return report, nil
+}
+
+// folder returns accounting of given folder, creating it if not exist.
+func (c *usageCache) folder(id int) *usageFolder {
+	folder, ok := c.Folders[id]
+	if !ok {
+		folder = &usageFolder{}
+		c.Folders[id] = folder
+	}
+
+	return folder
+}
+
+// prune removes folders no longer found in their recounted parent along with their descendants.
+func (c *usageCache) prune(recounted, listed map[int]bool) {
+	removed := make([]int, 0)
+	for id, folder := range c.Folders {
+		if id != 0 && recounted[folder.Parent] && !listed[id] {
+			removed = append(removed, id)
+		}
+	}
+
+	children := lo.GroupBy(lo.Keys(c.Folders), func(id int) int {
+		return c.Folders[id].Parent
+	})
+	for len(removed) > 0 {
+		id := removed[len(removed)-1]
+		removed = removed[:len(removed)-1]
+		delete(c.Folders, id)
+		removed = append(removed, children[id]...)
+	}
+}
+
+// count records given file or folder into accounting of its parent.
+func (c *usageCache) count(file *ent.File, categoryMap map[string]string, now time.Time) {
+	if file.Type == int(types.FileTypeFolder) {
+		folder := c.folder(file.ID)
+		folder.Name, folder.Parent = file.Name, file.FileChildren
+		if file.FileChildren == 0 && file.Name == inventory.RootFolderName {
+			c.Root = file.ID
+		}
+		return
+	}
+
+	if file.IsSymbolic {
+		return
+	}
+
+	c.folder(file.FileChildren).Stat.add(file, categoryMap, now)
+}
+
+func (s *usageStat) add(file *ent.File, categoryMap map[string]string, now time.Time) {
+	if s.Policies == nil {
+		s.Categories = make(map[string]*UsageBucket)
+		s.Extensions = make(map[string]*UsageBucket)
+		s.Policies = make(map[int]*UsageBucket)
+		s.Ages = make([]UsageBucket, len(usageAgeBuckets))
+	}
+
+	var current int64
+	policies := make(map[int]int64)
+	for _, entity := range file.Edges.Entities {
+		switch {
+		case entity.ID == file.PrimaryEntity:
+			current += entity.Size
+			s.Current += entity.Size
+		case entity.Type == int(types.EntityTypeVersion):
+			s.Versions += entity.Size
+		default:
+			s.Derived += entity.Size
+		}
+
+		policies[entity.StoragePolicyEntities] += entity.Size
+	}
+
+	for id, size := range policies {
+		policy, ok := s.Policies[id]
+		if !ok {
+			policy = &UsageBucket{}
+			s.Policies[id] = policy
+		}
+		policy.Size += size
+		policy.Files++
+	}
+
+	s.Files++
+	ext := util.Ext(file.Name)
+	category, ok := categoryMap[ext]
+	if !ok {
+		category = usageUnknownCategory
+	}
+	addToBucket(s.Categories, category, current)
+	addToBucket(s.Extensions, ext, current)
+
+	for i, bucket := range usageAgeBuckets {
+		if bucket.within == 0 || now.Sub(file.UpdatedAt) < bucket.within {
+			s.Ages[i].Size += current
+			s.Ages[i].Files++
+			break
+		}
+	}
+}
+
+func (s *usageStat) size() int64 {
+	return s.Current + s.Versions + s.Derived
+}
+
+// extensionCategories returns a map from file extension to search category, parsed from
+// category search queries.
+func (m *manager) extensionCategories(ctx context.Context) map[string]string {
+	res := make(map[string]string)
+	for _, category := range []setting.SearchCategory{setting.CategoryImage, setting.CategoryVideo,
+		setting.CategoryAudio, setting.CategoryDocument} {
+		query, err := url.ParseQuery(m.settings.SearchCategoryQuery(ctx, category))
+		if err != nil {
+			m.l.Warning("Failed to parse search query of category %q: %s", category, err)
+			continue
+		}
+
+		for _, name := range query["name"] {
+			if ext, ok := strings.CutPrefix(name, "*."); ok {
+				res[strings.ToLower(ext)] = string(category)
+			}
+		}
+	}
+
+	return res
+}
+
+// buildUsageTree builds usage tree of given folder, sizes of descendants are accumulated into their
+// ancestors. Only the largest sub folders within max depth are kept, the rest are merged.
+func buildUsageTree(folders map[int]*usageFolder, id int, uri *fs.URI, depth int) *UsageNode {
+	folder := folders[id]
+	node := &UsageNode{
+		Name:  folder.Name,
+		Uri:   uri.String(),
+		Size:  folder.Stat.size(),
+		Files: folder.Stat.Files,
+	}
+
+	children := make([]*UsageNode, 0, len(folder.children))
+	for _, childID := range folder.children {
+		child := buildUsageTree(folders, childID, uri.Join(folders[childID].Name), depth+1)
+		node.Size += child.Size
+		node.Files += child.Files
+		children = append(children, child)
+	}
+
+	if depth >= usageTreeMaxDepth {
+		return node
+	}
+
+	sort.Slice(children, func(i, j int) bool {
+		return children[i].Size > children[j].Size
+	})
+	if len(children) > usageTreeMaxChildren {
+		others := &UsageNode{Others: true}
+		for _, child := range children[usageTreeMaxChildren:] {
+			others.Size += child.Size
+			others.Files += child.Files
+		}
+		children = append(children[:usageTreeMaxChildren], others)
+	}
+
+	node.Children = children
+	return node
+}
+
+// mergeBuckets adds sizes and file counts of src buckets into dst.
+func mergeBuckets(dst, src map[string]*UsageBucket) {
+	for key, bucket := range src {
+		merged, ok := dst[key]
+		if !ok {
+			merged = &UsageBucket{Key: key}
+			dst[key] = merged
+		}
+		merged.Size += bucket.Size
+		merged.Files += bucket.Files
+	}
+}
+
+func addToBucket(buckets map[string]*UsageBucket, key string, size int64) {
+	bucket, ok := buckets[key]
+	if !ok {
+		bucket = &UsageBucket{Key: key}
+		buckets[key] = bucket
+	}
+	bucket.Size += size
+	bucket.Files++
+}
+
+// sortedBuckets returns buckets sorted by size in descending order, at most limit buckets are
+// returned if limit is positive.
+func sortedBuckets(buckets map[string]*UsageBucket, limit int) []UsageBucket {
+	res := make([]UsageBucket, 0, len(buckets))
+	for _, bucket := range buckets {
+		res = append(res, *bucket)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Size > res[j].Size
+	})
+	if limit > 0 && len(res) > limit {
+		res = res[:limit]
+	}
+
+	return res
+}
+
+// CronRefreshUsageReports applies changes of users made since their last usage report, users whose
+// changes cannot be applied folder by folder are fully rescanned. Reports never requested by users
+// are not computed.
+func CronRefreshUsageReports(ctx context.Context) {
+	dep := dependency.FromContext(ctx)
+	l := dep.Logger()
+	kv := dep.KV()
+	uc := dep.UserClient()
+	args := &inventory.ListUserParameters{
+		PaginationArgs: &inventory.PaginationArgs{
+			PageSize: 100,
+		},
+		Status: user.StatusActive,
+	}
+
+	refreshed := 0
+	for {
+		res, err := uc.ListUsers(context.WithValue(ctx, inventory.LoadUserGroup{}, true), args)
+		if err != nil {
+			l.Error("Failed to list users: %s", err)
+			return
+		}
+
+		for _, u := range res.Users {
+			uid := strconv.Itoa(u.ID)
+			_, rescan := kv.Get(dbfs.UsageRescanPendingPrefix + uid)
+			_, changed := kv.Get(dbfs.UsageChangedFoldersPrefix + uid)
+			if !rescan && !changed {
+				continue
+			}
+
+			if _, ok := kv.Get(UsageReportCachePrefix + uid); !ok {
+				_ = kv.Delete(dbfs.UsageRescanPendingPrefix, uid)
+				_ = kv.Delete(dbfs.UsageChangedFoldersPrefix, uid)
+				continue
+			}
+
+			if err := refreshUsageReport(ctx, dep, u, rescan); err != nil {
+				l.Warning("Failed to refresh usage report of user %d: %s", u.ID, err)
+				continue
+			}
+
+			refreshed++
+		}
+
+		if len(res.Users) < args.PageSize {
+			break
+		}
+
+		args.Page++
+	}
+
+	l.Info("Refreshed %d stale usage reports.", refreshed)
+}
+
+// refreshUsageReport applies changes of given user to the usage report, or rebuilds it by a full
+// rescan if rescan is true.
+func refreshUsageReport(ctx context.Context, dep dependency.Dep, u *ent.User, rescan bool) error {
+	fm := NewFileManager(dep, u)
+	defer fm.Recycle()
+
+	ctx = context.WithValue(ctx, inventory.UserCtx{}, u)
+	if rescan {
+		_, err := fm.RefreshUsageReport(ctx)
+		return err
+	}
+
+	_, err := fm.UsageReport(ctx)
+	return err
+}
+
+// NewUsageScanTask creates a task to rebuild storage usage report of given user by a full rescan.
+func NewUsageScanTask(ctx context.Context, u *ent.User) (*UsageScanTask, error) {
+	return &UsageScanTask{
+		DBTask: &queue.DBTask{
+			DirectOwner: u,
+			Task: &ent.Task{
+				Type:          queue.UsageScanTaskType,
+				CorrelationID: logging.CorrelationID(ctx),
+				PrivateState:  "{}",
+				PublicState:   &types.TaskPublicState{},
+			},
+		},
+	}, nil
+}
+
+func NewUsageScanTaskFromModel(task *ent.Task) queue.Task {
+	return &UsageScanTask{
+		DBTask: &queue.DBTask{
+			Task: task,
+		},
+	}
+}
+
+func (t *UsageScanTask) Do(ctx context.Context) (task.Status, error) {
+	dep := dependency.FromContext(ctx)
+	u := inventory.UserFromContext(ctx)
+	defer func() { _ = dep.KV().Delete(usageScanQueuedPrefix, strconv.Itoa(u.ID)) }()
+
+	fm := NewFileManager(dep, u)
+	defer fm.Recycle()
+
+	if _, err := fm.RefreshUsageReport(ctx); err != nil {
+		return task.StatusError, err
+	}
+
+	return task.StatusCompleted, nil
+}
@@ -0,0 +1,65 @@
+package manager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUsageCache_Count(t *testing.T) {
+	a := assert.New(t)
+	now := time.Now()
+	c := &usageCache{Folders: make(map[int]*usageFolder)}
+	files := []*ent.File{
+		{ID: 1, Type: int(types.FileTypeFolder), Name: inventory.RootFolderName},
+		{ID: 2, Type: int(types.FileTypeFile), Name: "a.jpg", FileChildren: 3, PrimaryEntity: 10, UpdatedAt: now,
+			Edges: ent.FileEdges{Entities: []*ent.Entity{
+				{ID: 10, Type: int(types.EntityTypeVersion), Size: 100, StoragePolicyEntities: 1},
+				{ID: 11, Type: int(types.EntityTypeVersion), Size: 50, StoragePolicyEntities: 2},
+				{ID: 12, Type: int(types.EntityTypeThumbnail), Size: 5, StoragePolicyEntities: 1},
+			}}},
+		// Child file comes before its parent folder
+		{ID: 3, Type: int(types.FileTypeFolder), Name: "photos", FileChildren: 1},
+		{ID: 4, Type: int(types.FileTypeFile), Name: "trashed.txt", PrimaryEntity: 13, UpdatedAt: now,
+			Edges: ent.FileEdges{Entities: []*ent.Entity{{ID: 13, Type: int(types.EntityTypeVersion), Size: 7}}}},
+		{ID: 5, Type: int(types.FileTypeFile), Name: "link", FileChildren: 3, IsSymbolic: true},
+	}
+	for _, f := range files {
+		c.count(f, map[string]string{"jpg": "image"}, now)
+	}
+
+	a.Equal(1, c.Root)
+	a.Equal(&usageFolder{Name: "photos", Parent: 1, Stat: usageStat{
+		Files:      1,
+		Current:    100,
+		Versions:   50,
+		Derived:    5,
+		Categories: map[string]*UsageBucket{"image": {Key: "image", Size: 100, Files: 1}},
+		Extensions: map[string]*UsageBucket{"jpg": {Key: "jpg", Size: 100, Files: 1}},
+		Policies:   map[int]*UsageBucket{1: {Size: 105, Files: 1}, 2: {Size: 50, Files: 1}},
+		Ages:       []UsageBucket{{Size: 100, Files: 1}, {}, {}, {}, {}},
+	}}, c.Folders[3])
+	a.EqualValues(7, c.Folders[0].Stat.size())
+	a.Equal(1, c.Folders[0].Stat.Files)
+}
+
+func TestUsageCache_Prune(t *testing.T) {
+	c := &usageCache{Folders: map[int]*usageFolder{
+		0: {},
+		1: {Name: inventory.RootFolderName},
+		2: {Name: "a", Parent: 1},
+		3: {Name: "b", Parent: 2},
+		4: {Name: "c", Parent: 3},
+		5: {Name: "d", Parent: 1},
+		6: {Name: "e", Parent: 5},
+	}}
+
+	// Folder 2 is no longer found in recounted root, removed along with its descendants.
+	c.prune(map[int]bool{0: true, 1: true}, map[int]bool{1: true, 5: true})
+	assert.ElementsMatch(t, []int{0, 1, 5, 6}, lo.Keys(c.Folders))
+}
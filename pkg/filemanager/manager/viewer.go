@@ -75,6 +75,8 @@ func (m *manager) CreateViewerSession(ctx context.Context, uri *fs.URI, version
 		return nil, err
 	}
 
+	m.fs.RecordActivity(ctx, file, types.FileActivityOpen)
+
 	return &ViewerSession{
 		File:        file,
 		ID:          sessionID,
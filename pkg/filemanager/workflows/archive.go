@@ -1,7 +1,6 @@
 package workflows
 
 import (
-	"archive/zip"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -44,6 +43,8 @@ type (
 	CreateArchiveTaskState struct {
 		Uris               []string                     `json:"uris,omitempty"`
 		Dst                string                       `json:"dst,omitempty"`
+		Format             manager.ArchiveFormat        `json:"format,omitempty"`
+		EncryptedPassword  []byte                       `json:"encrypted_password,omitempty"`
 		TempPath           string                       `json:"temp_path,omitempty"`
 		ArchiveFile        string                       `json:"archive_file,omitempty"`
 		Phase              CreateArchiveTaskPhase       `json:"phase,omitempty"`
@@ -75,12 +76,25 @@ func init() {
 }
 
 // NewCreateArchiveTask creates a new CreateArchiveTask
-func NewCreateArchiveTask(ctx context.Context, src []string, dst string) (queue.Task, error) {
+func NewCreateArchiveTask(ctx context.Context, src []string, dst string, format manager.ArchiveFormat, password string) (queue.Task, error) {
 	state := &CreateArchiveTaskState{
 		Uris:      src,
 		Dst:       dst,
+		Format:    format,
 		NodeState: NodeState{},
 	}
+	if password != "" {
+		masterKey, err := dependency.FromContext(ctx).MasterEncryptKeyVault(ctx).GetMasterKey(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get master key: %w", err)
+		}
+
+		state.EncryptedPassword, err = encrypt.EncryptWithMasterKey(masterKey, []byte(password))
+		if err != nil {
+			return nil, fmt.Errorf("failed to encrypt password: %w", err)
+		}
+	}
+
 	stateBytes, err := json.Marshal(state)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal state: %w", err)
@@ -210,17 +224,25 @@ func (m *CreateArchiveTask) listEntitiesAndSendToSlave(ctx context.Context, dep
 		return task.StatusError, fmt.Errorf("failed to create uri from strings: %s (%w)", err, queue.CriticalErr)
 	}
 
-	payload := &SlaveCreateArchiveTaskState{
-		Entities: make([]SlaveCreateArchiveEntity, 0, len(uris)),
-		Policies: make(map[int]*ent.StoragePolicy),
-	}
-
 	user := inventory.UserFromContext(ctx)
 	fm := manager.NewFileManager(dep, user)
 	storagePolicyClient := dep.StoragePolicyClient()
 	masterKey, _ := dep.MasterEncryptKeyVault(ctx).GetMasterKey(ctx)
 
+	password, err := m.password(ctx, dep)
+	if err != nil {
+		return task.StatusError, err
+	}
+
+	payload := &SlaveCreateArchiveTaskState{
+		Entities: make([]SlaveCreateArchiveEntity, 0, len(uris)),
+		Policies: make(map[int]*ent.StoragePolicy),
+		Format:   m.state.Format,
+		Password: password,
+	}
+
 	failed, err := fm.CreateArchive(ctx, uris, io.Discard,
+		fs.WithArchiveFormat(string(m.state.Format)),
 		fs.WithDryRun(func(name string, e fs.Entity) {
 			entityModel, err := decryptEntityKeyIfNeeded(masterKey, e.Model())
 			if err != nil {
@@ -258,6 +280,7 @@ func (m *CreateArchiveTask) listEntitiesAndSendToSlave(ctx context.Context, dep
 		return task.StatusError, fmt.Errorf("failed to create slave task: %w", err)
 	}
 
+	m.state.EncryptedPassword = nil
 	m.state.Phase = CreateArchiveTaskPhaseAwaitSlaveCompressing
 	m.state.SlaveArchiveTaskID = taskId
 	m.ResumeAfter((10 * time.Second))
@@ -378,8 +401,16 @@ func (m *CreateArchiveTask) createArchiveFile(ctx context.Context, dep dependenc
 	user := inventory.UserFromContext(ctx)
 	fm := manager.NewFileManager(dep, user)
 
-	// Create temp zip file
-	fileName := fmt.Sprintf("%s.zip", uuid.Must(uuid.NewV4()))
+	password, err := m.password(ctx, dep)
+	if err != nil {
+		return task.StatusError, err
+	}
+
+	// Archive is staged in a temp file instead of being streamed into the destination upload:
+	// upload sessions, capacity check, encryption and chunked storage drivers all require the
+	// final size up front, which is unknown until compression finishes. Keeping it on disk
+	// also lets the upload phase be retried without compressing all sources again.
+	fileName := fmt.Sprintf("%s.%s", uuid.Must(uuid.NewV4()), m.state.Format.Ext())
 	zipFilePath := filepath.Join(
 		m.state.TempPath,
 		fileName,
@@ -398,6 +429,8 @@ func (m *CreateArchiveTask) createArchiveFile(ctx context.Context, dep dependenc
 	m.Unlock()
 	failed, err := fm.CreateArchive(ctx, uris, zipFile,
 		fs.WithArchiveCompression(true),
+		fs.WithArchiveFormat(string(m.state.Format)),
+		fs.WithArchivePassword(password),
 		fs.WithMaxArchiveSize(user.Edges.Group.Settings.CompressSize),
 		fs.WithProgressFunc(func(current, diff int64, total int64) {
 			atomic.AddInt64(&m.progress[ProgressTypeArchiveSize].Current, diff)
@@ -416,6 +449,7 @@ func (m *CreateArchiveTask) createArchiveFile(ctx context.Context, dep dependenc
 	delete(m.progress, ProgressTypeArchiveCount)
 	m.Unlock()
 
+	m.state.EncryptedPassword = nil
 	m.state.Phase = CreateArchiveTaskPhaseUploadArchive
 	m.state.ArchiveFile = fileName
 	m.ResumeAfter(0)
@@ -527,6 +561,8 @@ type (
 	SlaveCreateArchiveTaskState struct {
 		Entities       []SlaveCreateArchiveEntity `json:"entities"`
 		Policies       map[int]*ent.StoragePolicy `json:"policies"`
+		Format         manager.ArchiveFormat      `json:"format,omitempty"`
+		Password       string                     `json:"password,omitempty"`
 		CompressedSize int64                      `json:"compressed_size"`
 		TempPath       string                     `json:"temp_path"`
 		ZipFilePath    string                     `json:"zip_file_path"`
@@ -576,6 +612,21 @@ func (t *SlaveCreateArchiveTask) Do(ctx context.Context) (task.Status, error) {
 
 	t.state = state
 
+	// Password is only needed to create the archive writer, drop it from task state right away
+	// so that it is not kept in the persisted state whether or not compression succeeds.
+	password := t.state.Password
+	if password != "" {
+		t.state.Password = ""
+		strippedState, err := json.Marshal(t.state)
+		if err != nil {
+			return task.StatusError, fmt.Errorf("failed to marshal state: %w", err)
+		}
+
+		t.Lock()
+		t.Task.PrivateState = string(strippedState)
+		t.Unlock()
+	}
+
 	totalFiles := int64(0)
 	totalFileSize := int64(0)
 	for _, e := range t.state.Entities {
@@ -596,7 +647,7 @@ func (t *SlaveCreateArchiveTask) Do(ctx context.Context) (task.Status, error) {
 	t.state.TempPath = tempPath
 
 	// 2. Create archive file
-	fileName := fmt.Sprintf("%s.zip", uuid.Must(uuid.NewV4()))
+	fileName := fmt.Sprintf("%s.%s", uuid.Must(uuid.NewV4()), t.state.Format.Ext())
 	zipFilePath := filepath.Join(
 		t.state.TempPath,
 		fileName,
@@ -608,8 +659,11 @@ func (t *SlaveCreateArchiveTask) Do(ctx context.Context) (task.Status, error) {
 
 	defer zipFile.Close()
 
-	zipWriter := zip.NewWriter(zipFile)
-	defer zipWriter.Close()
+	archiveWriter, err := manager.NewArchiveWriter(zipFile, t.state.Format, true, password)
+	if err != nil {
+		return task.StatusError, fmt.Errorf("failed to create archive writer: %w", err)
+	}
+	defer archiveWriter.Close()
 
 	// 3. Download each entity and write into zip file
 	for _, e := range t.state.Entities {
@@ -631,19 +685,12 @@ func (t *SlaveCreateArchiveTask) Do(ctx context.Context) (task.Status, error) {
 			continue
 		}
 
-		// Write to zip file
-		header := &zip.FileHeader{
-			Name:               e.Path,
-			Modified:           entity.UpdatedAt(),
-			UncompressedSize64: uint64(entity.Size()),
-			Method:             zip.Deflate,
-		}
-
-		writer, err := zipWriter.CreateHeader(header)
+		// Write to archive file
+		writer, err := archiveWriter.Create(e.Path, entity.UpdatedAt(), entity.Size())
 		if err != nil {
 			es.Close()
 			state.Failed++
-			t.l.Warning("Failed to create zip header for %s: %s, skipping...", e.Path, err)
+			t.l.Warning("Failed to create archive header for %s: %s, skipping...", e.Path, err)
 			continue
 		}
 
@@ -652,14 +699,16 @@ func (t *SlaveCreateArchiveTask) Do(ctx context.Context) (task.Status, error) {
 		es.Close()
 		if err != nil {
 			state.Failed++
-			t.l.Warning("Failed to write entity %d to zip file: %s, skipping...", e.Entity.ID, err)
+			t.l.Warning("Failed to write entity %d to archive file: %s, skipping...", e.Entity.ID, err)
 		}
 
 		atomic.AddInt64(&t.progress[ProgressTypeArchiveSize].Current, entity.Size())
 		atomic.AddInt64(&t.progress[ProgressTypeArchiveCount].Current, 1)
 	}
 
-	zipWriter.Close()
+	if err := archiveWriter.Close(); err != nil {
+		return task.StatusError, fmt.Errorf("failed to finalize archive file: %w", err)
+	}
 	stat, err := zipFile.Stat()
 	if err != nil {
 		return task.StatusError, fmt.Errorf("failed to get compressed file info: %w", err)
@@ -689,6 +738,26 @@ func (m *SlaveCreateArchiveTask) Progress(ctx context.Context) queue.Progresses
 	return m.progress
 }
 
+// password returns the archive password in plain text. The password is kept in task state encrypted
+// with master key, and cleared once files are compressed or sent to slave node.
+func (m *CreateArchiveTask) password(ctx context.Context, dep dependency.Dep) (string, error) {
+	if len(m.state.EncryptedPassword) == 0 {
+		return "", nil
+	}
+
+	masterKey, err := dep.MasterEncryptKeyVault(ctx).GetMasterKey(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get master key: %w", err)
+	}
+
+	password, err := encrypt.DecryptWithMasterKey(masterKey, m.state.EncryptedPassword)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt password: %s (%w)", err, queue.CriticalErr)
+	}
+
+	return string(password), nil
+}
+
 func decryptEntityKeyIfNeeded(masterKey []byte, entity *ent.Entity) (*ent.Entity, error) {
 	if entity.Props == nil || entity.Props.EncryptMetadata == nil || entity.Props.EncryptMetadata.KeyPlainText != nil {
 		return entity, nil
@@ -0,0 +1,290 @@
+package workflows
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/task"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
+	"github.com/samber/lo"
+)
+
+type (
+	DuplicateFinderTask struct {
+		*queue.DBTask
+
+		l        logging.Logger
+		state    *DuplicateFinderTaskState
+		progress queue.Progresses
+	}
+	DuplicateFinderTaskState struct {
+		// Uri is the folder to scan, ignored if AllUsers is set.
+		Uri string `json:"uri,omitempty"`
+		// AllUsers indicates whether to scan files of all users, only available for reporting.
+		AllUsers bool `json:"all_users,omitempty"`
+		// Action to perform on duplicates, empty for report only.
+		Action      manager.DuplicateAction  `json:"action,omitempty"`
+		Groups      []manager.DuplicateGroup `json:"groups,omitempty"`
+		Total       int                      `json:"total,omitempty"`
+		Reclaimable int64                    `json:"reclaimable,omitempty"`
+		Resolved    int                      `json:"resolved,omitempty"`
+		Failed      int                      `json:"failed,omitempty"`
+	}
+
+	DuplicateFileSummary struct {
+		ID    string `json:"id"`
+		Owner string `json:"owner"`
+		Uri   string `json:"uri"`
+	}
+	DuplicateGroupSummary struct {
+		Hash  string                 `json:"hash"`
+		Size  int64                  `json:"size"`
+		Files []DuplicateFileSummary `json:"files"`
+	}
+)
+
+const (
+	ProgressTypeHashed = "hashed"
+
+	SummaryKeyDuplicateGroups = "groups"
+	SummaryKeyReclaimable     = "reclaimable"
+	SummaryKeyResolved        = "resolved"
+
+	// maxDuplicateGroupsInReport limits groups kept in task state, groups with most reclaimable
+	// bytes are kept.
+	maxDuplicateGroupsInReport = 1000
+)
+
+func init() {
+	queue.RegisterResumableTaskFactory(queue.DuplicateFinderTaskType, NewDuplicateFinderTaskFromModel)
+}
+
+// NewDuplicateFinderTask creates a task finding duplicated files under uri, or in files of all users
+// if allUsers is true.
+func NewDuplicateFinderTask(ctx context.Context, u *ent.User, uri string, allUsers bool, action manager.DuplicateAction) (queue.Task, error) {
+	state := &DuplicateFinderTaskState{
+		Uri:      uri,
+		AllUsers: allUsers,
+		Action:   action,
+	}
+	stateBytes, err := json.Marshal(state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal state: %w", err)
+	}
+
+	t := &DuplicateFinderTask{
+		DBTask: &queue.DBTask{
+			Task: &ent.Task{
+				Type:          queue.DuplicateFinderTaskType,
+				CorrelationID: logging.CorrelationID(ctx),
+				PrivateState:  string(stateBytes),
+				PublicState:   &types.TaskPublicState{},
+			},
+			DirectOwner: u,
+		},
+	}
+
+	return t, nil
+}
+
+func NewDuplicateFinderTaskFromModel(task *ent.Task) queue.Task {
+	return &DuplicateFinderTask{
+		DBTask: &queue.DBTask{
+			Task: task,
+		},
+	}
+}
+
+func (m *DuplicateFinderTask) Do(ctx context.Context) (task.Status, error) {
+	dep := dependency.FromContext(ctx)
+	m.l = dep.Logger()
+
+	m.Lock()
+	if m.progress == nil {
+		m.progress = make(queue.Progresses)
+	}
+	m.Unlock()
+
+	// unmarshal state
+	state := &DuplicateFinderTaskState{}
+	if err := json.Unmarshal([]byte(m.State()), state); err != nil {
+		return task.StatusError, fmt.Errorf("failed to unmarshal state: %w", err)
+	}
+	m.state = state
+
+	next, err := m.findDuplicates(ctx, dep)
+
+	newStateStr, marshalErr := json.Marshal(m.state)
+	if marshalErr != nil {
+		return task.StatusError, fmt.Errorf("failed to marshal state: %w", marshalErr)
+	}
+
+	m.Lock()
+	m.Task.PrivateState = string(newStateStr)
+	m.Unlock()
+	return next, err
+}
+
+func (m *DuplicateFinderTask) findDuplicates(ctx context.Context, dep dependency.Dep) (task.Status, error) {
+	fm := manager.NewFileManager(dep, inventory.UserFromContext(ctx))
+	defer fm.Recycle()
+
+	var (
+		candidates []manager.DuplicateCandidate
+		err        error
+	)
+	if m.state.AllUsers {
+		candidates, err = m.allUsersCandidates(ctx, dep)
+	} else {
+		uri, parseErr := fs.NewUriFromString(m.state.Uri)
+		if parseErr != nil {
+			return task.StatusError, fmt.Errorf("failed to parse uri: %s (%w)", parseErr, queue.CriticalErr)
+		}
+
+		candidates, err = fm.DuplicateCandidates(ctx, uri)
+	}
+	if err != nil {
+		return task.StatusError, fmt.Errorf("failed to collect files: %w", err)
+	}
+
+	// Only files sharing size with others need to be hashed.
+	sizeCount := lo.CountValuesBy(candidates, func(c manager.DuplicateCandidate) int64 {
+		return c.Entity.Size()
+	})
+	total := int64(0)
+	for size, count := range sizeCount {
+		if count > 1 {
+			total += size * int64(count)
+		}
+	}
+
+	m.Lock()
+	m.progress[ProgressTypeHashed] = &queue.Progress{Total: total}
+	m.Unlock()
+
+	groups, err := fm.FindDuplicates(ctx, candidates, func(size int64) {
+		atomic.AddInt64(&m.progress[ProgressTypeHashed].Current, size)
+	})
+	if err != nil {
+		return task.StatusError, fmt.Errorf("failed to find duplicates: %w", err)
+	}
+
+	m.state.Total = len(groups)
+	m.state.Reclaimable = lo.SumBy(groups, func(g manager.DuplicateGroup) int64 {
+		return g.Reclaimable()
+	})
+	m.state.Groups = groups
+	if len(groups) > maxDuplicateGroupsInReport {
+		m.state.Groups = groups[:maxDuplicateGroupsInReport]
+	}
+
+	if m.state.Action == "" || m.state.AllUsers {
+		return task.StatusCompleted, nil
+	}
+
+	// All groups are resolved, not only the ones kept in report.
+	resolved, failed, stale := fm.ResolveDuplicates(ctx, groups, m.state.Action)
+	m.state.Resolved, m.state.Failed = resolved, failed
+	if len(stale) > 0 {
+		if err := fm.RecycleEntities(ctx, false, stale...); err != nil {
+			m.l.Warning("Failed to recycle unlinked entities: %s", err)
+		}
+	}
+
+	return task.StatusCompleted, nil
+}
+
+// allUsersCandidates collects duplicate candidates from files of all active users.
+func (m *DuplicateFinderTask) allUsersCandidates(ctx context.Context, dep dependency.Dep) ([]manager.DuplicateCandidate, error) {
+	uc := dep.UserClient()
+	candidates := make([]manager.DuplicateCandidate, 0)
+	args := &inventory.ListUserParameters{
+		PaginationArgs: &inventory.PaginationArgs{
+			PageSize: 100,
+		},
+		Status: user.StatusActive,
+	}
+
+	for {
+		res, err := uc.ListUsers(context.WithValue(ctx, inventory.LoadUserGroup{}, true), args)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list users: %w", err)
+		}
+
+		for _, u := range res.Users {
+			if inventory.IsAnonymousUser(u) {
+				continue
+			}
+
+			root, err := fs.NewUriFromString(fs.NewMyUri(hashid.EncodeUserID(dep.HashIDEncoder(), u.ID)))
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse root uri: %w", err)
+			}
+
+			userCtx := context.WithValue(ctx, inventory.UserCtx{}, u)
+			fm := manager.NewFileManager(dep, u)
+			userCandidates, err := fm.DuplicateCandidates(userCtx, root)
+			fm.Recycle()
+			if err != nil {
+				m.l.Warning("Failed to collect files of user %d: %s", u.ID, err)
+				continue
+			}
+
+			candidates = append(candidates, userCandidates...)
+		}
+
+		if len(res.Users) < args.PageSize {
+			return candidates, nil
+		}
+
+		args.Page++
+	}
+}
+
+func (m *DuplicateFinderTask) Progress(ctx context.Context) queue.Progresses {
+	m.Lock()
+	defer m.Unlock()
+	return m.progress
+}
+
+func (m *DuplicateFinderTask) Summarize(hasher hashid.Encoder) *queue.Summary {
+	// unmarshal state
+	if m.state == nil {
+		if err := json.Unmarshal([]byte(m.State()), &m.state); err != nil {
+			return nil
+		}
+	}
+
+	return &queue.Summary{
+		Props: map[string]any{
+			SummaryKeySrc: m.state.Uri,
+			SummaryKeyDuplicateGroups: lo.Map(m.state.Groups, func(g manager.DuplicateGroup, index int) DuplicateGroupSummary {
+				return DuplicateGroupSummary{
+					Hash: g.Hash,
+					Size: g.Size,
+					Files: lo.Map(g.Files, func(f manager.DuplicateFile, index int) DuplicateFileSummary {
+						return DuplicateFileSummary{
+							ID:    hashid.EncodeFileID(hasher, f.FileID),
+							Owner: hashid.EncodeUserID(hasher, f.OwnerID),
+							Uri:   f.Uri,
+						}
+					}),
+				}
+			}),
+			SummaryKeyReclaimable: m.state.Reclaimable,
+			SummaryKeyResolved:    m.state.Resolved,
+			SummaryKeyFailed:      m.state.Failed,
+		},
+	}
+}
@@ -0,0 +1,238 @@
+package workflows
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"regexp"
+	"syscall"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/crontab"
+	"github.com/cloudreve/Cloudreve/v4/pkg/feed"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+)
+
+const (
+	feedPollPageSize = 100
+	feedMaxSize      = 10 << 20
+	feedFetchTimeout = 30 * time.Second
+)
+
+var (
+	ErrFeedAddressNotAllowed = errors.New("feed address is not allowed")
+
+	// feedTransport refuses to connect to loopback, private and link-local addresses. Addresses are
+	// checked after DNS resolution and on each redirect, so that feed URL supplied by users cannot
+	// be used to probe internal network.
+	feedTransport = &http.Transport{
+		DialContext: (&net.Dialer{
+			Timeout: feedFetchTimeout,
+			Control: feedDialControl,
+		}).DialContext,
+		TLSHandshakeTimeout: 10 * time.Second,
+	}
+)
+
+func init() {
+	crontab.Register(setting.CronTypeFeedPoll, CronPollFeeds)
+}
+
+// CronPollFeeds polls all enabled feed subscriptions and creates remote download tasks for new matched items.
+func CronPollFeeds(ctx context.Context) {
+	dep := dependency.FromContext(ctx)
+	l := dep.Logger()
+	fc := dep.FeedClient()
+
+	afterID, created := 0, 0
+	for {
+		subscriptions, err := fc.ListEnabled(ctx, afterID, feedPollPageSize)
+		if err != nil {
+			l.Error("Failed to list feed subscriptions: %s", err)
+			return
+		}
+
+		for _, sub := range subscriptions {
+			afterID = sub.ID
+			n, err := PollFeed(ctx, dep, sub)
+			errMsg := ""
+			if err != nil {
+				l.Warning("Failed to poll feed subscription %d: %s", sub.ID, err)
+				errMsg = err.Error()
+			}
+
+			if err := fc.SetChecked(ctx, sub.ID, time.Now(), errMsg); err != nil {
+				l.Warning("Failed to update feed subscription %d: %s", sub.ID, err)
+			}
+
+			created += n
+		}
+
+		if len(subscriptions) < feedPollPageSize {
+			break
+		}
+	}
+
+	l.Info("Feed subscriptions polled, %d remote download tasks created.", created)
+}
+
+// PollFeed fetches the feed of given subscription, records new items and creates remote download tasks
+// for the matched ones. Owner and its group must be loaded in the subscription. Returns number of created tasks.
+func PollFeed(ctx context.Context, dep dependency.Dep, sub *ent.FeedSubscription) (int, error) {
+	owner := sub.Edges.Owner
+	if owner == nil || owner.Edges.Group == nil {
+		return 0, errors.New("owner of subscription is not loaded")
+	}
+
+	group := owner.Edges.Group
+	if !group.Permissions.Enabled(int(types.GroupPermissionRemoteDownload)) {
+		return 0, errors.New("remote download is not allowed for user group")
+	}
+
+	include, exclude, err := CompileFeedFilters(sub.Include, sub.Exclude)
+	if err != nil {
+		return 0, err
+	}
+
+	items, err := fetchFeed(ctx, dep, sub.URL)
+	if err != nil {
+		return 0, err
+	}
+
+	fc := dep.FeedClient()
+	guids := make([]string, 0, len(items))
+	for _, item := range items {
+		guids = append(guids, item.Guid)
+	}
+
+	seen, err := fc.SeenGuids(ctx, sub.ID, guids)
+	if err != nil {
+		return 0, err
+	}
+
+	userCtx := context.WithValue(ctx, inventory.UserCtx{}, owner)
+	l := dep.Logger()
+	limit := group.Settings.Aria2BatchSize
+	created := 0
+
+	// Feeds are usually listed latest first, walk through them reversely so that history follows publish order.
+	for i := len(items) - 1; i >= 0; i-- {
+		item := items[i]
+		if item.Guid == "" || seen[item.Guid] {
+			continue
+		}
+
+		args := &inventory.CreateFeedItemParameters{
+			SubscriptionID: sub.ID,
+			Guid:           item.Guid,
+			Title:          item.Title,
+			Link:           item.Link,
+			PublishedAt:    item.PublishedAt,
+			Matched:        MatchFeedItem(item.Title, include, exclude),
+		}
+
+		if args.Matched {
+			if limit > 0 && created >= limit {
+				// Exceeds batch size limit of user group, leave it for next round.
+				continue
+			}
+
+			if item.Link == "" {
+				args.Error = "no download link found in item"
+			} else if t, err := NewRemoteDownloadTask(userCtx, item.Link, "", sub.Dst); err != nil {
+				args.Error = err.Error()
+			} else if err := dep.RemoteDownloadQueue(ctx).QueueTask(userCtx, t); err != nil {
+				args.Error = err.Error()
+			} else {
+				taskID := t.ID()
+				args.TaskID = &taskID
+				created++
+			}
+		}
+
+		seen[item.Guid] = true
+		if _, err := fc.CreateItem(ctx, args); err != nil && !ent.IsConstraintError(err) {
+			l.Warning("Failed to record item %q of feed subscription %d: %s", item.Guid, sub.ID, err)
+		}
+	}
+
+	return created, nil
+}
+
+// CompileFeedFilters compiles include and exclude regular expressions, empty ones are returned as nil.
+func CompileFeedFilters(include, exclude string) (*regexp.Regexp, *regexp.Regexp, error) {
+	var (
+		includeRe, excludeRe *regexp.Regexp
+		err                  error
+	)
+	if include != "" {
+		if includeRe, err = regexp.Compile(include); err != nil {
+			return nil, nil, fmt.Errorf("invalid include filter: %w", err)
+		}
+	}
+
+	if exclude != "" {
+		if excludeRe, err = regexp.Compile(exclude); err != nil {
+			return nil, nil, fmt.Errorf("invalid exclude filter: %w", err)
+		}
+	}
+
+	return includeRe, excludeRe, nil
+}
+
+// MatchFeedItem reports whether an item title passes the include and exclude filters.
+func MatchFeedItem(title string, include, exclude *regexp.Regexp) bool {
+	if include != nil && !include.MatchString(title) {
+		return false
+	}
+
+	return exclude == nil || !exclude.MatchString(title)
+}
+
+func fetchFeed(ctx context.Context, dep dependency.Dep, url string) ([]feed.Item, error) {
+	resp := dep.RequestClient(
+		request.WithContext(ctx),
+		request.WithTimeout(feedFetchTimeout),
+		request.WithLogger(dep.Logger()),
+		request.WithTransport(feedTransport),
+	).Request(http.MethodGet, url, nil).CheckHTTPResponse(http.StatusOK)
+	if resp.Err != nil {
+		if errors.Is(resp.Err, ErrFeedAddressNotAllowed) {
+			return nil, ErrFeedAddressNotAllowed
+		}
+
+		return nil, fmt.Errorf("failed to fetch feed: %w", resp.Err)
+	}
+	defer resp.Response.Body.Close()
+
+	items, err := feed.Parse(io.LimitReader(resp.Response.Body, feedMaxSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse feed: %w", err)
+	}
+
+	return items, nil
+}
+
+// feedDialControl rejects connections to addresses not reachable from public network.
+func feedDialControl(network, address string, c syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return ErrFeedAddressNotAllowed
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil || ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsMulticast() {
+		return ErrFeedAddressNotAllowed
+	}
+
+	return nil
+}
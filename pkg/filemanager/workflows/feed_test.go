@@ -0,0 +1,187 @@
+package workflows
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/boolset"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompileFeedFilters(t *testing.T) {
+	a := assert.New(t)
+
+	include, exclude, err := CompileFeedFilters("", "")
+	a.NoError(err)
+	a.Nil(include)
+	a.Nil(exclude)
+
+	include, exclude, err = CompileFeedFilters("(?i)1080p", "CAM")
+	a.NoError(err)
+	a.NotNil(include)
+	a.NotNil(exclude)
+
+	_, _, err = CompileFeedFilters("(", "")
+	a.ErrorContains(err, "include")
+
+	_, _, err = CompileFeedFilters("", "[")
+	a.ErrorContains(err, "exclude")
+}
+
+func TestMatchFeedItem(t *testing.T) {
+	include, exclude, err := CompileFeedFilters("(?i)1080p", "CAM")
+	assert.NoError(t, err)
+
+	tests := []struct {
+		title    string
+		include  bool
+		exclude  bool
+		expected bool
+	}{
+		{title: "Show S01E01 1080P", include: true, exclude: true, expected: true},
+		{title: "Show S01E01 720p", include: true, exclude: true, expected: false},
+		{title: "Show S01E01 1080p CAM", include: true, exclude: true, expected: false},
+		{title: "Show S01E01 1080p CAM", include: true, expected: true},
+		{title: "Show S01E01 CAM", exclude: true, expected: false},
+		{title: "anything", expected: true},
+	}
+
+	for _, tt := range tests {
+		in, ex := include, exclude
+		if !tt.include {
+			in = nil
+		}
+		if !tt.exclude {
+			ex = nil
+		}
+
+		assert.Equal(t, tt.expected, MatchFeedItem(tt.title, in, ex), tt.title)
+	}
+}
+
+func TestFeedTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	_, err := (&http.Client{Transport: feedTransport}).Get(server.URL)
+	assert.ErrorIs(t, err, ErrFeedAddressNotAllowed)
+
+	for _, addr := range []string{"127.0.0.1:80", "10.0.0.1:80", "192.168.1.1:443", "169.254.169.254:80",
+		"[::1]:80", "[fe80::1]:80", "0.0.0.0:80", "localhost:80"} {
+		assert.ErrorIs(t, feedDialControl("tcp", addr, nil), ErrFeedAddressNotAllowed, addr)
+	}
+
+	for _, addr := range []string{"1.1.1.1:443", "[2606:4700:4700::1111]:443"} {
+		assert.NoError(t, feedDialControl("tcp", addr, nil), addr)
+	}
+}
+
+type (
+	// feedTestDep serves a fixed feed and records created items.
+	feedTestDep struct {
+		dependency.Dep
+		feedClient *feedTestClient
+		feed       string
+	}
+
+	feedTestClient struct {
+		inventory.FeedClient
+		seen    map[string]bool
+		created []*inventory.CreateFeedItemParameters
+	}
+
+	feedTestRequestClient struct {
+		request.Client
+		feed string
+	}
+)
+
+func (d *feedTestDep) FeedClient() inventory.FeedClient {
+	return d.feedClient
+}
+
+func (d *feedTestDep) RequestClient(opts ...request.Option) request.Client {
+	return &feedTestRequestClient{feed: d.feed}
+}
+
+func (d *feedTestDep) Logger() logging.Logger {
+	return logging.NewConsoleLogger(logging.LevelError)
+}
+
+func (c *feedTestRequestClient) Request(method, target string, body io.Reader, opts ...request.Option) *request.Response {
+	return &request.Response{Response: &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(c.feed)),
+	}}
+}
+
+func (c *feedTestClient) SeenGuids(ctx context.Context, subscriptionID int, guids []string) (map[string]bool, error) {
+	res := make(map[string]bool)
+	for _, guid := range guids {
+		if c.seen[guid] {
+			res[guid] = true
+		}
+	}
+	return res, nil
+}
+
+func (c *feedTestClient) CreateItem(ctx context.Context, args *inventory.CreateFeedItemParameters) (*ent.FeedItem, error) {
+	c.created = append(c.created, args)
+	c.seen[args.Guid] = true
+	return &ent.FeedItem{}, nil
+}
+
+func TestPollFeed(t *testing.T) {
+	a := assert.New(t)
+	dep := &feedTestDep{
+		feedClient: &feedTestClient{seen: map[string]bool{"ep-2": true}},
+		feed: `<rss version="2.0"><channel>
+<item><title>Episode 4</title><link>https://example.com/4</link><guid>ep-4</guid></item>
+<item><title>Episode 3</title><link>https://example.com/3</link><guid>ep-3</guid></item>
+<item><title>Episode 3 again</title><link>https://example.com/3</link><guid>ep-3</guid></item>
+<item><title>Episode 2</title><link>https://example.com/2</link><guid>ep-2</guid></item>
+<item><title>Episode 1</title><link>https://example.com/1</link></item>
+</channel></rss>`,
+	}
+
+	permissions := &boolset.BooleanSet{}
+	boolset.Set(types.GroupPermissionRemoteDownload, true, permissions)
+	sub := &ent.FeedSubscription{
+		ID: 1,
+		// Items not matched are recorded without creating tasks.
+		Include: "^never$",
+		Edges: ent.FeedSubscriptionEdges{Owner: &ent.User{
+			ID:    1,
+			Edges: ent.UserEdges{Group: &ent.Group{Permissions: permissions, Settings: &types.GroupSetting{}}},
+		}},
+	}
+
+	created, err := PollFeed(context.Background(), dep, sub)
+	a.NoError(err)
+	a.Equal(0, created)
+
+	// Seen items are skipped, duplicated ones are recorded once in publish order. Link is used as guid
+	// if missing.
+	titles := make([]string, 0)
+	for _, item := range dep.feedClient.created {
+		a.False(item.Matched)
+		titles = append(titles, item.Title)
+	}
+	a.Equal([]string{"Episode 1", "Episode 3 again", "Episode 4"}, titles)
+
+	// Polling again records nothing new.
+	dep.feedClient.created = nil
+	_, err = PollFeed(context.Background(), dep, sub)
+	a.NoError(err)
+	a.Empty(dep.feedClient.created)
+}
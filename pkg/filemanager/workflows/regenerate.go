@@ -0,0 +1,416 @@
+package workflows
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/cloudreve/Cloudreve/v4/application/constants"
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/task"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
+	"github.com/samber/lo"
+	"golang.org/x/time/rate"
+	"golang.org/x/tools/container/intsets"
+)
+
+type (
+	MediaRegenerateTask struct {
+		*queue.DBTask
+
+		l        logging.Logger
+		state    *MediaRegenerateTaskState
+		progress queue.Progresses
+		managers map[int]manager.FileManager
+		owners   map[int]*ent.User
+		limiter  *rate.Limiter
+	}
+	MediaRegenerateTaskState struct {
+		// Users whose files are scanned.
+		Users []int `json:"users,omitempty"`
+		// Policies whose files are scanned.
+		Policies []int `json:"policies,omitempty"`
+		// Folders to scan recursively.
+		Folders []string `json:"folders,omitempty"`
+		// RateLimit is the maximum files processed per second, 0 for unlimited.
+		RateLimit float64 `json:"rate_limit,omitempty"`
+
+		manager.MediaRegenerateArgs
+
+		// Target and AfterID is the cursor of current scan, saved after each page of users and
+		// policies, or each folder. Folders are always scanned from beginning when task is resumed.
+		Target  int `json:"target,omitempty"`
+		AfterID int `json:"after_id,omitempty"`
+		// Walked is the number of files found in scanned folders.
+		Walked int `json:"walked,omitempty"`
+
+		Processed int `json:"processed,omitempty"`
+		Queued    int `json:"queued,omitempty"`
+		Skipped   int `json:"skipped,omitempty"`
+		Failed    int `json:"failed,omitempty"`
+	}
+)
+
+const (
+	ProgressTypeRegenerated = "regenerated"
+
+	SummaryKeyProcessed = "processed"
+	SummaryKeyQueued    = "queued"
+	SummaryKeySkipped   = "skipped"
+	SummaryKeyUsers     = "users"
+	SummaryKeyPolicies  = "policies"
+	SummaryKeyFolders   = "folders"
+
+	regenerateListPageSize = 100
+)
+
+func init() {
+	queue.RegisterResumableTaskFactory(queue.MediaRegenerateTaskType, NewMediaRegenerateTaskFromModel)
+}
+
+// NewMediaRegenerateTask creates a task that walks files of given users, policies and folders, then
+// re-queues media meta extraction and/or thumbnail generation for them.
+func NewMediaRegenerateTask(ctx context.Context, u *ent.User, users, policies []int, folders []string,
+	args manager.MediaRegenerateArgs, rateLimit float64) (queue.Task, error) {
+	state := &MediaRegenerateTaskState{
+		Users:               users,
+		Policies:            policies,
+		Folders:             folders,
+		RateLimit:           rateLimit,
+		MediaRegenerateArgs: args,
+	}
+	stateBytes, err := json.Marshal(state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal state: %w", err)
+	}
+
+	t := &MediaRegenerateTask{
+		DBTask: &queue.DBTask{
+			Task: &ent.Task{
+				Type:          queue.MediaRegenerateTaskType,
+				CorrelationID: logging.CorrelationID(ctx),
+				PrivateState:  string(stateBytes),
+				PublicState:   &types.TaskPublicState{},
+			},
+			DirectOwner: u,
+		},
+	}
+
+	return t, nil
+}
+
+func NewMediaRegenerateTaskFromModel(task *ent.Task) queue.Task {
+	return &MediaRegenerateTask{
+		DBTask: &queue.DBTask{
+			Task: task,
+		},
+	}
+}
+
+func (m *MediaRegenerateTask) Do(ctx context.Context) (task.Status, error) {
+	dep := dependency.FromContext(ctx)
+	m.l = dep.Logger()
+
+	m.Lock()
+	if m.progress == nil {
+		m.progress = make(queue.Progresses)
+	}
+	m.Unlock()
+
+	// unmarshal state
+	state := &MediaRegenerateTaskState{}
+	if err := json.Unmarshal([]byte(m.State()), state); err != nil {
+		return task.StatusError, fmt.Errorf("failed to unmarshal state: %w", err)
+	}
+	m.Lock()
+	m.state = state
+	m.Unlock()
+
+	m.managers = make(map[int]manager.FileManager)
+	m.owners = make(map[int]*ent.User)
+	defer func() {
+		for _, fm := range m.managers {
+			fm.Recycle()
+		}
+	}()
+
+	next, err := m.regenerate(ctx, dep)
+
+	newStateStr, marshalErr := json.Marshal(m.state)
+	if marshalErr != nil {
+		return task.StatusError, fmt.Errorf("failed to marshal state: %w", marshalErr)
+	}
+
+	m.Lock()
+	m.Task.PrivateState = string(newStateStr)
+	m.Unlock()
+	return next, err
+}
+
+// regenerate processes one page of current user or policy target, or a whole folder target, then
+// suspends the task so that the cursor is persisted.
+func (m *MediaRegenerateTask) regenerate(ctx context.Context, dep dependency.Dep) (task.Status, error) {
+	m.limiter = rate.NewLimiter(rate.Inf, 1)
+	if m.state.RateLimit > 0 {
+		m.limiter = rate.NewLimiter(rate.Limit(m.state.RateLimit), 1)
+	}
+
+	m.Lock()
+	_, counted := m.progress[ProgressTypeRegenerated]
+	m.Unlock()
+	if !counted {
+		total, err := m.countFiles(ctx, dep)
+		if err != nil {
+			return task.StatusError, err
+		}
+
+		m.Lock()
+		m.progress[ProgressTypeRegenerated] = &queue.Progress{Total: int64(total + m.state.Walked), Current: int64(m.state.Processed)}
+		m.Unlock()
+	}
+
+	fc := dep.FileClient()
+	users, policies := len(m.state.Users), len(m.state.Policies)
+	targets := users + policies + len(m.state.Folders)
+	if m.state.Target >= targets {
+		return task.StatusCompleted, nil
+	}
+
+	var (
+		exhausted bool
+		err       error
+	)
+	switch target := m.state.Target; {
+	case target < users:
+		uid := m.state.Users[target]
+		exhausted, err = m.regeneratePage(ctx, dep, func(afterID int) ([]*ent.File, error) {
+			return fc.ListUserFiles(ctx, uid, afterID, regenerateListPageSize)
+		})
+	case target < users+policies:
+		policyID := m.state.Policies[target-users]
+		exhausted, err = m.regeneratePage(ctx, dep, func(afterID int) ([]*ent.File, error) {
+			return fc.ListPolicyFiles(ctx, policyID, afterID, regenerateListPageSize)
+		})
+	default:
+		exhausted, err = true, m.regenerateFolder(ctx, dep, m.state.Folders[target-users-policies])
+	}
+
+	if err != nil {
+		return task.StatusError, err
+	}
+
+	if exhausted {
+		m.Lock()
+		m.state.Target, m.state.AfterID = m.state.Target+1, 0
+		m.Unlock()
+		if m.state.Target >= targets {
+			return task.StatusCompleted, nil
+		}
+	}
+
+	m.ResumeAfter(0)
+	return task.StatusSuspending, nil
+}
+
+// countFiles counts files in user and policy targets, folders are counted when walked.
+func (m *MediaRegenerateTask) countFiles(ctx context.Context, dep dependency.Dep) (int, error) {
+	fc := dep.FileClient()
+	total := 0
+	args := make([]*inventory.FlattenListFileParameters, 0, len(m.state.Users)+len(m.state.Policies))
+	for _, uid := range m.state.Users {
+		args = append(args, &inventory.FlattenListFileParameters{UserID: uid})
+	}
+	for _, policyID := range m.state.Policies {
+		args = append(args, &inventory.FlattenListFileParameters{StoragePolicyID: policyID})
+	}
+
+	for _, arg := range args {
+		arg.PaginationArgs = &inventory.PaginationArgs{PageSize: 1}
+		res, err := fc.FlattenListFiles(ctx, arg)
+		if err != nil {
+			return 0, fmt.Errorf("failed to count files: %w", err)
+		}
+
+		total += res.TotalItems
+	}
+
+	return total, nil
+}
+
+// regeneratePage processes next page of listed files and moves the cursor forward. Returns whether
+// all files are listed.
+func (m *MediaRegenerateTask) regeneratePage(ctx context.Context, dep dependency.Dep, list func(afterID int) ([]*ent.File, error)) (bool, error) {
+	files, err := list(m.state.AfterID)
+	if err != nil {
+		return false, fmt.Errorf("failed to list files: %w", err)
+	}
+
+	for _, file := range files {
+		if file.Type == int(types.FileTypeFile) && !file.IsSymbolic {
+			if err := m.processFile(ctx, dep, file.OwnerID, func(ctx context.Context, fm manager.FileManager) (*fs.URI, error) {
+				f, err := fm.TraverseFile(ctx, file.ID)
+				if err != nil {
+					return nil, err
+				}
+
+				return f.Uri(false), nil
+			}); err != nil {
+				return false, err
+			}
+		}
+
+		m.Lock()
+		m.state.AfterID = file.ID
+		m.Unlock()
+	}
+
+	return len(files) < regenerateListPageSize, nil
+}
+
+// regenerateFolder walks given folder with permission of task owner, then processes files in it.
+func (m *MediaRegenerateTask) regenerateFolder(ctx context.Context, dep dependency.Dep, folder string) error {
+	uri, err := fs.NewUriFromString(folder)
+	if err != nil {
+		return fmt.Errorf("failed to parse folder uri: %s (%w)", err, queue.CriticalErr)
+	}
+
+	type walked struct {
+		owner int
+		uri   *fs.URI
+	}
+	files := make([]walked, 0)
+	fm := manager.NewFileManager(dep, inventory.UserFromContext(ctx))
+	defer fm.Recycle()
+	if err := fm.Walk(ctx, uri, intsets.MaxInt, func(f fs.File, level int) error {
+		if f.Type() == types.FileTypeFile && !f.IsSymbolic() {
+			files = append(files, walked{owner: f.OwnerID(), uri: f.Uri(false)})
+		}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed to walk folder %q: %w", folder, err)
+	}
+
+	m.Lock()
+	atomic.AddInt64(&m.progress[ProgressTypeRegenerated].Total, int64(len(files)))
+	m.Unlock()
+
+	for _, f := range files {
+		if err := m.processFile(ctx, dep, f.owner, func(ctx context.Context, fm manager.FileManager) (*fs.URI, error) {
+			return f.uri, nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	m.Lock()
+	m.state.Walked += len(files)
+	m.Unlock()
+	return nil
+}
+
+// processFile regenerates media of a single file using file manager of its owner. Only rate limiter
+// errors are returned, failures of a single file are counted and logged.
+func (m *MediaRegenerateTask) processFile(ctx context.Context, dep dependency.Dep, ownerID int,
+	resolve func(ctx context.Context, fm manager.FileManager) (*fs.URI, error)) error {
+	if err := m.limiter.Wait(ctx); err != nil {
+		return err
+	}
+
+	defer func() {
+		m.Lock()
+		m.state.Processed++
+		atomic.AddInt64(&m.progress[ProgressTypeRegenerated].Current, 1)
+		m.Unlock()
+	}()
+
+	fm, ok := m.managers[ownerID]
+	if !ok {
+		owner, err := dep.UserClient().GetByID(context.WithValue(ctx, inventory.LoadUserGroup{}, true), ownerID)
+		if err != nil {
+			m.l.Warning("Failed to get user %d: %s", ownerID, err)
+			m.count(&m.state.Failed)
+			return nil
+		}
+
+		fm = manager.NewFileManager(dep, owner)
+		m.managers[ownerID] = fm
+		m.owners[ownerID] = owner
+	}
+
+	userCtx := context.WithValue(ctx, inventory.UserCtx{}, m.owners[ownerID])
+	uri, err := resolve(userCtx, fm)
+	if err != nil {
+		m.l.Warning("Failed to resolve file uri: %s", err)
+		m.count(&m.state.Failed)
+		return nil
+	}
+
+	// Files in trash bin are skipped.
+	if uri == nil || uri.FileSystem() != constants.FileSystemMy {
+		m.count(&m.state.Skipped)
+		return nil
+	}
+
+	done, err := fm.RegenerateMedia(userCtx, uri, &m.state.MediaRegenerateArgs)
+	if err != nil {
+		m.l.Warning("Failed to regenerate media of %q: %s", uri, err)
+		m.count(&m.state.Failed)
+		return nil
+	}
+
+	if done {
+		m.count(&m.state.Queued)
+	} else {
+		m.count(&m.state.Skipped)
+	}
+
+	return nil
+}
+
+// count increases given counter of task state.
+func (m *MediaRegenerateTask) count(counter *int) {
+	m.Lock()
+	defer m.Unlock()
+	*counter++
+}
+
+func (m *MediaRegenerateTask) Progress(ctx context.Context) queue.Progresses {
+	m.Lock()
+	defer m.Unlock()
+	return m.progress
+}
+
+func (m *MediaRegenerateTask) Summarize(hasher hashid.Encoder) *queue.Summary {
+	// unmarshal state
+	if m.state == nil {
+		if err := json.Unmarshal([]byte(m.State()), &m.state); err != nil {
+			return nil
+		}
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	return &queue.Summary{
+		Props: map[string]any{
+			SummaryKeyProcessed: m.state.Processed,
+			SummaryKeyQueued:    m.state.Queued,
+			SummaryKeySkipped:   m.state.Skipped,
+			SummaryKeyFailed:    m.state.Failed,
+			SummaryKeyUsers: lo.Map(m.state.Users, func(uid int, index int) string {
+				return hashid.EncodeUserID(hasher, uid)
+			}),
+			SummaryKeyPolicies: lo.Map(m.state.Policies, func(id int, index int) string {
+				return hashid.EncodePolicyID(hasher, id)
+			}),
+			SummaryKeyFolders: m.state.Folders,
+		},
+	}
+}
@@ -0,0 +1,272 @@
+package workflows
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync/atomic"
+
+	"github.com/cloudreve/Cloudreve/v4/application/constants"
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/task"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
+	"golang.org/x/tools/container/intsets"
+)
+
+type (
+	SnapshotRestoreTask struct {
+		*queue.DBTask
+
+		l        logging.Logger
+		state    *SnapshotRestoreTaskState
+		progress queue.Progresses
+	}
+	SnapshotRestoreTaskState struct {
+		// Src is the URI of the snapshot file or folder to restore.
+		Src string `json:"src"`
+		// Dst is the URI in my files to restore to.
+		Dst string `json:"dst"`
+		// RemoveExtra indicates whether files under Dst not recorded in snapshot are moved to trash bin.
+		RemoveExtra bool `json:"remove_extra,omitempty"`
+		Restored    int  `json:"restored,omitempty"`
+		Removed     int  `json:"removed,omitempty"`
+		Failed      int  `json:"failed,omitempty"`
+	}
+)
+
+const (
+	ProgressTypeRestored = "restored"
+
+	SummaryKeyRemoved = "removed"
+)
+
+func init() {
+	queue.RegisterResumableTaskFactory(queue.SnapshotRestoreTaskType, NewSnapshotRestoreTaskFromModel)
+}
+
+// NewSnapshotRestoreTask creates a task restoring src in a snapshot to dst.
+func NewSnapshotRestoreTask(ctx context.Context, u *ent.User, src, dst string, removeExtra bool) (queue.Task, error) {
+	state := &SnapshotRestoreTaskState{
+		Src:         src,
+		Dst:         dst,
+		RemoveExtra: removeExtra,
+	}
+	stateBytes, err := json.Marshal(state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal state: %w", err)
+	}
+
+	t := &SnapshotRestoreTask{
+		DBTask: &queue.DBTask{
+			Task: &ent.Task{
+				Type:          queue.SnapshotRestoreTaskType,
+				CorrelationID: logging.CorrelationID(ctx),
+				PrivateState:  string(stateBytes),
+				PublicState:   &types.TaskPublicState{},
+			},
+			DirectOwner: u,
+		},
+	}
+
+	return t, nil
+}
+
+func NewSnapshotRestoreTaskFromModel(task *ent.Task) queue.Task {
+	return &SnapshotRestoreTask{
+		DBTask: &queue.DBTask{
+			Task: task,
+		},
+	}
+}
+
+func (m *SnapshotRestoreTask) Do(ctx context.Context) (task.Status, error) {
+	dep := dependency.FromContext(ctx)
+	m.l = dep.Logger()
+
+	m.Lock()
+	if m.progress == nil {
+		m.progress = make(queue.Progresses)
+	}
+	m.Unlock()
+
+	// unmarshal state
+	state := &SnapshotRestoreTaskState{}
+	if err := json.Unmarshal([]byte(m.State()), state); err != nil {
+		return task.StatusError, fmt.Errorf("failed to unmarshal state: %w", err)
+	}
+	m.state = state
+
+	next, err := m.processRestore(ctx, dep)
+
+	newStateStr, marshalErr := json.Marshal(m.state)
+	if marshalErr != nil {
+		return task.StatusError, fmt.Errorf("failed to marshal state: %w", marshalErr)
+	}
+
+	m.Lock()
+	m.Task.PrivateState = string(newStateStr)
+	m.Unlock()
+	return next, err
+}
+
+func (m *SnapshotRestoreTask) processRestore(ctx context.Context, dep dependency.Dep) (task.Status, error) {
+	user := inventory.UserFromContext(ctx)
+
+	src, err := fs.NewUriFromString(m.state.Src)
+	if err != nil || src.FileSystem() != constants.FileSystemSnapshot || len(src.Elements()) == 0 {
+		return task.StatusError, fmt.Errorf("invalid src %q (%w)", m.state.Src, queue.CriticalErr)
+	}
+
+	dst, err := fs.NewUriFromString(m.state.Dst)
+	if err != nil {
+		return task.StatusError, fmt.Errorf("failed to parse dst: %s (%w)", err, queue.CriticalErr)
+	}
+
+	snapshotID, err := dep.HashIDEncoder().Decode(src.Elements()[0], hashid.SnapshotID)
+	if err != nil {
+		return task.StatusError, fmt.Errorf("invalid snapshot id: %s (%w)", err, queue.CriticalErr)
+	}
+
+	snapshot, err := dep.SnapshotClient().GetByID(ctx, snapshotID)
+	if err != nil || snapshot.OwnerID != user.ID {
+		return task.StatusError, fmt.Errorf("snapshot %d not found (%w)", snapshotID, queue.CriticalErr)
+	}
+
+	// Collect nodes under src, parents come before children.
+	srcPath := strings.Join(src.Elements()[1:], fs.Separator)
+	nodes := make([]types.SnapshotNode, 0)
+	for _, node := range snapshot.Manifest {
+		if srcPath == "" || node.Path == srcPath || strings.HasPrefix(node.Path, srcPath+fs.Separator) {
+			nodes = append(nodes, node)
+		}
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].Path < nodes[j].Path
+	})
+
+	if len(nodes) == 0 {
+		return task.StatusError, fmt.Errorf("%q not found in snapshot (%w)", srcPath, queue.CriticalErr)
+	}
+
+	m.Lock()
+	m.progress[ProgressTypeRestored] = &queue.Progress{
+		Total: int64(len(nodes)),
+	}
+	m.Unlock()
+
+	fm := manager.NewFileManager(dep, user)
+	defer fm.Recycle()
+
+	// recorded is relative paths of all nodes in snapshot, failing to restore a node does not
+	// make the existing file at its path an extra one.
+	recorded := make(map[string]bool, len(nodes))
+	for _, node := range nodes {
+		rel := strings.TrimPrefix(strings.TrimPrefix(node.Path, srcPath), fs.Separator)
+		recorded[rel] = true
+		target := dst
+		if rel != "" {
+			target = dst.JoinRaw(rel)
+		}
+
+		switch {
+		case node.Type == types.FileTypeFolder:
+			_, err = fm.Create(ctx, target, types.FileTypeFolder)
+		case node.Entity == 0:
+			_, err = fm.Create(ctx, target, types.FileTypeFile)
+		default:
+			_, err = fm.RestoreEntity(ctx, target, node.Entity)
+		}
+
+		atomic.AddInt64(&m.progress[ProgressTypeRestored].Current, 1)
+		if err != nil {
+			m.l.Warning("Failed to restore %q: %s", target, err)
+			m.state.Failed++
+			continue
+		}
+
+		m.state.Restored++
+	}
+
+	if m.state.RemoveExtra && nodes[0].Type == types.FileTypeFolder {
+		if err := m.removeExtra(ctx, fm, dst, recorded); err != nil {
+			return task.StatusError, fmt.Errorf("failed to remove extra files: %w", err)
+		}
+	}
+
+	return task.StatusCompleted, nil
+}
+
+// removeExtra moves files under dst that are not recorded in snapshot to trash bin.
+func (m *SnapshotRestoreTask) removeExtra(ctx context.Context, fm manager.FileManager, dst *fs.URI, recorded map[string]bool) error {
+	dstDepth := len(dst.Elements())
+	extras := make([]*fs.URI, 0)
+	extra := make(map[string]bool)
+	if err := fm.Walk(ctx, dst, intsets.MaxInt, func(f fs.File, level int) error {
+		if level == 0 {
+			return nil
+		}
+
+		elements := f.Uri(false).Elements()[dstDepth:]
+		rel := strings.Join(elements, fs.Separator)
+		parent := strings.Join(elements[:len(elements)-1], fs.Separator)
+		if extra[parent] {
+			// Ancestor is already moved to trash bin.
+			extra[rel] = true
+			return nil
+		}
+
+		if recorded[rel] {
+			return nil
+		}
+
+		extra[rel] = true
+		extras = append(extras, f.Uri(false))
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if len(extras) == 0 {
+		return nil
+	}
+
+	if err := fm.Delete(ctx, extras); err != nil {
+		return err
+	}
+
+	m.state.Removed = len(extras)
+	return nil
+}
+
+func (m *SnapshotRestoreTask) Progress(ctx context.Context) queue.Progresses {
+	m.Lock()
+	defer m.Unlock()
+	return m.progress
+}
+
+func (m *SnapshotRestoreTask) Summarize(hasher hashid.Encoder) *queue.Summary {
+	// unmarshal state
+	if m.state == nil {
+		if err := json.Unmarshal([]byte(m.State()), &m.state); err != nil {
+			return nil
+		}
+	}
+
+	return &queue.Summary{
+		Props: map[string]any{
+			SummaryKeySrc:     m.state.Src,
+			SummaryKeyDst:     m.state.Dst,
+			SummaryKeyFailed:  m.state.Failed,
+			SummaryKeyRemoved: m.state.Removed,
+		},
+	}
+}
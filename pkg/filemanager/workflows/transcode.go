@@ -0,0 +1,625 @@
+package workflows
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/task"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cluster"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager/entitysource"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/cloudreve/Cloudreve/v4/pkg/transcode"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+)
+
+type (
+	TranscodeTask struct {
+		*queue.DBTask
+
+		l        logging.Logger
+		state    *TranscodeTaskState
+		progress queue.Progresses
+		node     cluster.Node
+	}
+
+	TranscodeTaskPhase string
+
+	TranscodeTaskState struct {
+		Uri          string                   `json:"uri"`
+		SourceEntity int                      `json:"source_entity"`
+		TempPath     string                   `json:"temp_path,omitempty"`
+		Result       *transcode.Result        `json:"result,omitempty"`
+		Phase        TranscodeTaskPhase       `json:"phase,omitempty"`
+		SlaveTaskID  int                      `json:"slave_task_id,omitempty"`
+		SlaveState   *SlaveTranscodeTaskState `json:"slave_state,omitempty"`
+		NodeState    `json:",inline"`
+	}
+)
+
+const (
+	TranscodeTaskPhaseNotStarted TranscodeTaskPhase = "not_started"
+	TranscodeTaskPhaseTranscode  TranscodeTaskPhase = "transcode"
+	TranscodeTaskPhaseUpload     TranscodeTaskPhase = "upload"
+
+	TranscodeTaskPhaseAwaitSlave TranscodeTaskPhase = "await_slave"
+
+	ProgressTypeTranscode = "transcode"
+
+	// transcodeInputUrlTTL is the TTL of entity URL used as ffmpeg input, it should cover the whole transcoding.
+	transcodeInputUrlTTL = 24 * time.Hour
+)
+
+func init() {
+	queue.RegisterResumableTaskFactory(queue.TranscodeTaskType, NewTranscodeTaskFromModel)
+	manager.RegisterTranscodeTaskFactory(func(ctx context.Context, uri *fs.URI, entityID int, creator *ent.User) (queue.Task, error) {
+		return NewTranscodeTask(ctx, uri.String(), entityID, creator)
+	})
+}
+
+// NewTranscodeTask creates a new TranscodeTask for given version entity of the file.
+func NewTranscodeTask(ctx context.Context, uri string, entityID int, creator *ent.User) (queue.Task, error) {
+	state := &TranscodeTaskState{
+		Uri:          uri,
+		SourceEntity: entityID,
+		NodeState:    NodeState{},
+	}
+	stateBytes, err := json.Marshal(state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal state: %w", err)
+	}
+
+	t := &TranscodeTask{
+		DBTask: &queue.DBTask{
+			Task: &ent.Task{
+				Type:          queue.TranscodeTaskType,
+				CorrelationID: logging.CorrelationID(ctx),
+				PrivateState:  string(stateBytes),
+				PublicState:   &types.TaskPublicState{},
+			},
+			DirectOwner: creator,
+		},
+	}
+	return t, nil
+}
+
+func NewTranscodeTaskFromModel(task *ent.Task) queue.Task {
+	return &TranscodeTask{
+		DBTask: &queue.DBTask{
+			Task: task,
+		},
+	}
+}
+
+func (m *TranscodeTask) Do(ctx context.Context) (task.Status, error) {
+	dep := dependency.FromContext(ctx)
+	m.l = dep.Logger()
+
+	m.Lock()
+	if m.progress == nil {
+		m.progress = make(queue.Progresses)
+	}
+	m.Unlock()
+
+	// unmarshal state
+	state := &TranscodeTaskState{}
+	if err := json.Unmarshal([]byte(m.State()), state); err != nil {
+		return task.StatusError, fmt.Errorf("failed to unmarshal state: %w", err)
+	}
+	m.state = state
+
+	// select node
+	node, err := allocateNode(ctx, dep, &m.state.NodeState, types.NodeCapabilityTranscode)
+	if err != nil {
+		return task.StatusError, fmt.Errorf("failed to allocate node: %w", err)
+	}
+	m.node = node
+
+	next := task.StatusCompleted
+
+	if m.node.IsMaster() {
+		// Transcode source entity into renditions
+		// Upload renditions as transcode entity
+		switch m.state.Phase {
+		case TranscodeTaskPhaseNotStarted, "":
+			next, err = m.initializeTempFolder(ctx, dep)
+		case TranscodeTaskPhaseTranscode:
+			next, err = m.transcode(ctx, dep)
+		case TranscodeTaskPhaseUpload:
+			next, err = m.upload(ctx, dep)
+		default:
+			next, err = task.StatusError, fmt.Errorf("unknown phase %q: %w", m.state.Phase, queue.CriticalErr)
+		}
+	} else {
+		// Send source entity to slave node, it transcodes and uploads the transcode entity
+		// Await slave and save renditions
+		switch m.state.Phase {
+		case TranscodeTaskPhaseNotStarted, "":
+			next, err = m.createSlaveTask(ctx, dep)
+		case TranscodeTaskPhaseAwaitSlave:
+			next, err = m.awaitSlave(ctx, dep)
+		default:
+			next, err = task.StatusError, fmt.Errorf("unknown phase %q: %w", m.state.Phase, queue.CriticalErr)
+		}
+	}
+
+	newStateStr, marshalErr := json.Marshal(m.state)
+	if marshalErr != nil {
+		return task.StatusError, fmt.Errorf("failed to marshal state: %w", marshalErr)
+	}
+
+	m.Lock()
+	m.Task.PrivateState = string(newStateStr)
+	m.Unlock()
+	return next, err
+}
+
+func (m *TranscodeTask) Cleanup(ctx context.Context) error {
+	if m.state.SlaveState != nil && m.state.SlaveState.TempPath != "" && m.node != nil {
+		if err := m.node.CleanupFolders(context.Background(), m.state.SlaveState.TempPath); err != nil {
+			m.l.Warning("Failed to cleanup slave temp folder %s: %s", m.state.SlaveState.TempPath, err)
+		}
+	}
+
+	if m.state.TempPath != "" {
+		time.Sleep(time.Duration(1) * time.Second)
+		return os.RemoveAll(m.state.TempPath)
+	}
+
+	return nil
+}
+
+func (m *TranscodeTask) initializeTempFolder(ctx context.Context, dep dependency.Dep) (task.Status, error) {
+	tempPath, err := prepareTempFolder(ctx, dep, m)
+	if err != nil {
+		return task.StatusError, fmt.Errorf("failed to prepare temp folder: %w", err)
+	}
+
+	m.state.TempPath = tempPath
+	m.state.Phase = TranscodeTaskPhaseTranscode
+	m.ResumeAfter(0)
+	return task.StatusSuspending, nil
+}
+
+// source returns the file URI and the source entity to transcode. Task fails if source entity
+// is no longer the current version.
+func (m *TranscodeTask) source(ctx context.Context, fm manager.FileManager) (*fs.URI, fs.Entity, error) {
+	uri, err := fs.NewUriFromString(m.state.Uri)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse uri %q: %s (%w)", m.state.Uri, err, queue.CriticalErr)
+	}
+
+	_, source, err := fm.TranscodeSource(ctx, uri)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get transcode source: %s (%w)", err, queue.CriticalErr)
+	}
+
+	if m.state.SourceEntity == 0 {
+		m.state.SourceEntity = source.ID()
+	}
+
+	if source.ID() != m.state.SourceEntity {
+		return nil, nil, fmt.Errorf("file is updated since task is created (%w)", queue.CriticalErr)
+	}
+
+	return uri, source, nil
+}
+
+func (m *TranscodeTask) transcode(ctx context.Context, dep dependency.Dep) (task.Status, error) {
+	user := inventory.UserFromContext(ctx)
+	fm := manager.NewFileManager(dep, user)
+	defer fm.Recycle()
+
+	_, source, err := m.source(ctx, fm)
+	if err != nil {
+		return task.StatusError, err
+	}
+
+	es, err := fm.GetEntitySource(ctx, 0, fs.WithEntity(source))
+	if err != nil {
+		return task.StatusError, fmt.Errorf("failed to get entity source: %w", err)
+	}
+	defer es.Close()
+
+	settings := dep.SettingProvider()
+	opts := transcodeOptions(ctx, settings)
+	m.Lock()
+	m.progress[ProgressTypeTranscode] = &queue.Progress{Total: int64(len(opts.Renditions))}
+	m.Unlock()
+
+	input, err := transcodeInput(ctx, es, m.state.TempPath)
+	if err != nil {
+		return task.StatusError, err
+	}
+
+	res, err := transcode.Transcode(ctx, m.l, input, filepath.Join(m.state.TempPath, "hls"), opts, func(done int) {
+		atomic.StoreInt64(&m.progress[ProgressTypeTranscode].Current, int64(done))
+	})
+	if err != nil {
+		return task.StatusError, fmt.Errorf("failed to transcode: %w", err)
+	}
+
+	m.state.Result = res
+	m.state.Phase = TranscodeTaskPhaseUpload
+	m.ResumeAfter(0)
+	return task.StatusSuspending, nil
+}
+
+func (m *TranscodeTask) upload(ctx context.Context, dep dependency.Dep) (task.Status, error) {
+	user := inventory.UserFromContext(ctx)
+	fm := manager.NewFileManager(dep, user)
+	defer fm.Recycle()
+
+	uri, _, err := m.source(ctx, fm)
+	if err != nil {
+		return task.StatusError, err
+	}
+
+	m.Lock()
+	m.progress[ProgressTypeUpload] = &queue.Progress{}
+	m.Unlock()
+	err = fm.SaveTranscode(ctx, uri, m.state.SourceEntity, m.state.Result, func(current, diff int64, total int64) {
+		atomic.StoreInt64(&m.progress[ProgressTypeUpload].Current, current)
+		atomic.StoreInt64(&m.progress[ProgressTypeUpload].Total, total)
+	})
+	if err != nil {
+		return task.StatusError, fmt.Errorf("failed to save transcoded renditions: %w", err)
+	}
+
+	return task.StatusCompleted, nil
+}
+
+func (m *TranscodeTask) createSlaveTask(ctx context.Context, dep dependency.Dep) (task.Status, error) {
+	user := inventory.UserFromContext(ctx)
+	fm := manager.NewFileManager(dep, user)
+	defer fm.Recycle()
+
+	uri, source, err := m.source(ctx, fm)
+	if err != nil {
+		return task.StatusError, err
+	}
+
+	policy, err := dep.StoragePolicyClient().GetPolicyByID(ctx, source.PolicyID())
+	if err != nil {
+		return task.StatusError, fmt.Errorf("failed to get policy: %w", err)
+	}
+
+	masterKey, _ := dep.MasterEncryptKeyVault(ctx).GetMasterKey(ctx)
+	entityModel, err := decryptEntityKeyIfNeeded(masterKey, source.Model())
+	if err != nil {
+		return task.StatusError, fmt.Errorf("failed to decrypt entity key: %w", err)
+	}
+
+	settings := dep.SettingProvider()
+	opts := transcodeOptions(ctx, settings)
+	payload := &SlaveTranscodeTaskState{
+		Uri:             m.state.Uri,
+		Entity:          entityModel,
+		Policy:          policy,
+		SavePath:        manager.TranscodeSavePath(ctx, settings, user.ID, uri, source),
+		UserID:          user.ID,
+		Renditions:      opts.Renditions,
+		SegmentDuration: opts.SegmentDuration,
+		ExtraArgs:       opts.ExtraArgs,
+	}
+
+	payloadStr, err := json.Marshal(payload)
+	if err != nil {
+		return task.StatusError, fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	taskId, err := m.node.CreateTask(ctx, queue.SlaveTranscodeTaskType, string(payloadStr))
+	if err != nil {
+		return task.StatusError, fmt.Errorf("failed to create slave task: %w", err)
+	}
+
+	m.state.Phase = TranscodeTaskPhaseAwaitSlave
+	m.state.SlaveTaskID = taskId
+	m.ResumeAfter(10 * time.Second)
+	return task.StatusSuspending, nil
+}
+
+func (m *TranscodeTask) awaitSlave(ctx context.Context, dep dependency.Dep) (task.Status, error) {
+	t, err := m.node.GetTask(ctx, m.state.SlaveTaskID, true)
+	if err != nil {
+		return task.StatusError, fmt.Errorf("failed to get slave task: %w", err)
+	}
+
+	m.Lock()
+	m.state.NodeState.progress = t.Progress
+	m.Unlock()
+
+	m.state.SlaveState = &SlaveTranscodeTaskState{}
+	if err := json.Unmarshal([]byte(t.PrivateState), m.state.SlaveState); err != nil {
+		return task.StatusError, fmt.Errorf("failed to unmarshal slave state: %s (%w)", err, queue.CriticalErr)
+	}
+
+	if t.Status == task.StatusError {
+		return task.StatusError, fmt.Errorf("slave task failed: %s (%w)", t.Error, queue.CriticalErr)
+	}
+
+	if t.Status == task.StatusCanceled {
+		return task.StatusError, fmt.Errorf("slave task canceled (%w)", queue.CriticalErr)
+	}
+
+	if t.Status == task.StatusCompleted {
+		user := inventory.UserFromContext(ctx)
+		fm := manager.NewFileManager(dep, user)
+		defer fm.Recycle()
+
+		uri, _, err := m.source(ctx, fm)
+		if err != nil {
+			return task.StatusError, err
+		}
+
+		if err := fm.SetTranscodeProps(ctx, uri, &types.TranscodeProps{
+			SourceEntity: m.state.SourceEntity,
+			Renditions:   m.state.SlaveState.Result,
+		}); err != nil {
+			return task.StatusError, fmt.Errorf("failed to save transcoded renditions: %w", err)
+		}
+
+		return task.StatusCompleted, nil
+	}
+
+	m.l.Info("Slave task %d is still transcoding, resume after 30s.", m.state.SlaveTaskID)
+	m.ResumeAfter(time.Second * 30)
+	return task.StatusSuspending, nil
+}
+
+func (m *TranscodeTask) Progress(ctx context.Context) queue.Progresses {
+	m.Lock()
+	defer m.Unlock()
+
+	if m.state != nil && m.state.NodeState.progress != nil {
+		merged := make(queue.Progresses)
+		for k, v := range m.progress {
+			merged[k] = v
+		}
+
+		for k, v := range m.state.NodeState.progress {
+			merged[k] = v
+		}
+
+		return merged
+	}
+	return m.progress
+}
+
+func (m *TranscodeTask) Summarize(hasher hashid.Encoder) *queue.Summary {
+	// unmarshal state
+	if m.state == nil {
+		if err := json.Unmarshal([]byte(m.State()), &m.state); err != nil {
+			return nil
+		}
+	}
+
+	return &queue.Summary{
+		NodeID: m.state.NodeID,
+		Phase:  string(m.state.Phase),
+		Props: map[string]any{
+			SummaryKeySrc: m.state.Uri,
+		},
+	}
+}
+
+type (
+	SlaveTranscodeTaskState struct {
+		Uri             string                       `json:"uri"`
+		Entity          *ent.Entity                  `json:"entity,omitempty"`
+		Policy          *ent.StoragePolicy           `json:"policy,omitempty"`
+		SavePath        string                       `json:"save_path,omitempty"`
+		UserID          int                          `json:"user_id"`
+		Renditions      []setting.TranscodeRendition `json:"renditions,omitempty"`
+		SegmentDuration int                          `json:"segment_duration,omitempty"`
+		ExtraArgs       string                       `json:"extra_args,omitempty"`
+		TempPath        string                       `json:"temp_path,omitempty"`
+		// Result is the transcoded renditions reported back to master
+		Result []types.TranscodeRendition `json:"result,omitempty"`
+	}
+	SlaveTranscodeTask struct {
+		*queue.InMemoryTask
+
+		progress queue.Progresses
+		l        logging.Logger
+		state    *SlaveTranscodeTaskState
+		node     cluster.Node
+	}
+)
+
+// NewSlaveTranscodeTask creates a new SlaveTranscodeTask from raw private state
+func NewSlaveTranscodeTask(ctx context.Context, props *types.SlaveTaskProps, id int, state string) queue.Task {
+	return &SlaveTranscodeTask{
+		InMemoryTask: &queue.InMemoryTask{
+			DBTask: &queue.DBTask{
+				Task: &ent.Task{
+					ID:            id,
+					CorrelationID: logging.CorrelationID(ctx),
+					PublicState: &types.TaskPublicState{
+						SlaveTaskProps: props,
+					},
+					PrivateState: state,
+				},
+			},
+		},
+
+		progress: make(queue.Progresses),
+	}
+}
+
+func (t *SlaveTranscodeTask) Do(ctx context.Context) (task.Status, error) {
+	ctx = prepareSlaveTaskCtx(ctx, t.Model().PublicState.SlaveTaskProps)
+	dep := dependency.FromContext(ctx)
+	t.l = dep.Logger()
+
+	np, err := dep.NodePool(ctx)
+	if err != nil {
+		return task.StatusError, fmt.Errorf("failed to get node pool: %w", err)
+	}
+
+	t.node, err = np.Get(ctx, types.NodeCapabilityNone, 0)
+	if err != nil || !t.node.IsMaster() {
+		return task.StatusError, fmt.Errorf("failed to get master node: %w", err)
+	}
+
+	fm := manager.NewFileManager(dep, nil)
+
+	// unmarshal state
+	state := &SlaveTranscodeTaskState{}
+	if err := json.Unmarshal([]byte(t.State()), state); err != nil {
+		return task.StatusError, fmt.Errorf("failed to unmarshal state: %w", err)
+	}
+	t.state = state
+
+	uri, err := fs.NewUriFromString(t.state.Uri)
+	if err != nil {
+		return task.StatusError, fmt.Errorf("failed to parse uri: %s (%w)", err, queue.CriticalErr)
+	}
+
+	tempPath, err := prepareTempFolder(ctx, dep, t)
+	if err != nil {
+		return task.StatusError, fmt.Errorf("failed to prepare temp folder: %w", err)
+	}
+	t.state.TempPath = tempPath
+
+	// 1. Transcode source entity
+	entity := fs.NewEntity(t.state.Entity)
+	es, err := fm.GetEntitySource(ctx, 0, fs.WithEntity(entity), fs.WithPolicy(fm.CastStoragePolicyOnSlave(ctx, t.state.Policy)))
+	if err != nil {
+		return task.StatusError, fmt.Errorf("failed to get entity source: %w", err)
+	}
+	defer es.Close()
+
+	t.Lock()
+	t.progress[ProgressTypeTranscode] = &queue.Progress{Total: int64(len(t.state.Renditions))}
+	t.Unlock()
+
+	input, err := transcodeInput(ctx, es, t.state.TempPath)
+	if err != nil {
+		return task.StatusError, err
+	}
+
+	opts := &transcode.Options{
+		FFMpegPath:      dep.SettingProvider().FFMpegPath(ctx),
+		ExtraArgs:       t.state.ExtraArgs,
+		SegmentDuration: t.state.SegmentDuration,
+		Renditions:      t.state.Renditions,
+	}
+	res, err := transcode.Transcode(ctx, t.l, input, filepath.Join(t.state.TempPath, "hls"), opts, func(done int) {
+		atomic.StoreInt64(&t.progress[ProgressTypeTranscode].Current, int64(done))
+	})
+	if err != nil {
+		return task.StatusError, fmt.Errorf("failed to transcode: %w", err)
+	}
+
+	// 2. Upload transcode entity
+	output, err := os.Open(res.Path)
+	if err != nil {
+		return task.StatusError, fmt.Errorf("failed to open transcoded file: %w", err)
+	}
+	defer output.Close()
+
+	t.Lock()
+	t.progress[ProgressTypeUpload] = &queue.Progress{Total: res.Size}
+	t.Unlock()
+	req := &fs.UploadRequest{
+		Props: &fs.UploadProps{
+			Uri:      uri,
+			Size:     res.Size,
+			SavePath: t.state.SavePath,
+			MimeType: transcode.ContentType,
+		},
+		ProgressFunc: func(current, diff int64, total int64) {
+			atomic.StoreInt64(&t.progress[ProgressTypeUpload].Current, current)
+		},
+		File:   output,
+		Seeker: output,
+	}
+
+	if _, err := fm.Update(ctx, req, fs.WithNode(t.node), fs.WithStatelessUserID(t.state.UserID),
+		fs.WithEntityType(types.EntityTypeTranscode)); err != nil {
+		return task.StatusError, fmt.Errorf("failed to upload transcode entity: %w", err)
+	}
+
+	// Clear unused fields to save space
+	t.state.Result = res.Renditions
+	t.state.Entity = nil
+	t.state.Policy = nil
+
+	newStateStr, marshalErr := json.Marshal(t.state)
+	if marshalErr != nil {
+		return task.StatusError, fmt.Errorf("failed to marshal state: %w", marshalErr)
+	}
+
+	t.Lock()
+	t.Task.PrivateState = string(newStateStr)
+	t.Unlock()
+	return task.StatusCompleted, nil
+}
+
+func (t *SlaveTranscodeTask) Progress(ctx context.Context) queue.Progresses {
+	t.Lock()
+	defer t.Unlock()
+
+	res := make(queue.Progresses)
+	for k, v := range t.progress {
+		res[k] = v
+	}
+	return res
+}
+
+func transcodeOptions(ctx context.Context, settings setting.Provider) *transcode.Options {
+	transcodeSetting := settings.Transcode(ctx)
+	return &transcode.Options{
+		FFMpegPath:      settings.FFMpegPath(ctx),
+		ExtraArgs:       transcodeSetting.ExtraArgs,
+		SegmentDuration: transcodeSetting.SegmentDuration,
+		Renditions:      transcodeSetting.Renditions,
+	}
+}
+
+// transcodeInput returns ffmpeg input of the entity source. Unencrypted entities are read in place
+// by local path or URL, encrypted ones are decrypted into a temp file first.
+func transcodeInput(ctx context.Context, es entitysource.EntitySource, tempPath string) (string, error) {
+	if !es.Entity().Encrypted() {
+		if es.IsLocal() {
+			return es.LocalPath(ctx), nil
+		}
+
+		expire := time.Now().Add(transcodeInputUrlTTL)
+		src, err := es.Url(ctx, entitysource.WithContext(ctx), entitysource.WithExpire(&expire), entitysource.WithNoInternalProxy())
+		if err != nil {
+			return "", fmt.Errorf("failed to get entity url: %w", err)
+		}
+
+		return src.Url, nil
+	}
+
+	inputPath := filepath.Join(tempPath, "input")
+	input, err := util.CreatNestedFile(inputPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to create temp input file: %w", err)
+	}
+	defer input.Close()
+
+	es.Apply(entitysource.WithContext(ctx))
+	if _, err := io.Copy(input, es); err != nil {
+		return "", fmt.Errorf("failed to decrypt entity into temp file: %w", err)
+	}
+
+	return inputPath, nil
+}
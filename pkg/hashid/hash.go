@@ -22,6 +22,10 @@ const (
 	TaskID
 	DavAccountID
 	PaymentID
+	SnapshotID
+	FeedSubscriptionID
+	AlbumID
+	PlaylistID
 )
 
 var (
@@ -93,8 +97,14 @@ func EncodePaymentID(encoder Encoder, uid int) string {
 	return res
 }
 
-// EncodeFileID encode file id to hash id
+// EncodeFileID encode file id to hash id. Negative IDs are reserved for virtual files, they are
+// encoded with an extra element so that they never decode to a real file ID.
 func EncodeFileID(encoder Encoder, uid int) string {
+	if uid < 0 {
+		res, _ := encoder.Encode([]int{-uid, FileID, 0})
+		return res
+	}
+
 	res, _ := encoder.Encode([]int{uid, FileID})
 	return res
 }
@@ -147,6 +157,30 @@ func EncodeSourceLinkID(encoder Encoder, id int) string {
 	return res
 }
 
+// EncodeSnapshotID encode snapshot id to hash id
+func EncodeSnapshotID(encoder Encoder, id int) string {
+	res, _ := encoder.Encode([]int{id, SnapshotID})
+	return res
+}
+
+// EncodeFeedSubscriptionID encode feed subscription id to hash id
+func EncodeFeedSubscriptionID(encoder Encoder, id int) string {
+	res, _ := encoder.Encode([]int{id, FeedSubscriptionID})
+	return res
+}
+
+// EncodeAlbumID encode photo album id to hash id
+func EncodeAlbumID(encoder Encoder, id int) string {
+	res, _ := encoder.Encode([]int{id, AlbumID})
+	return res
+}
+
+// EncodePlaylistID encode music playlist id to hash id
+func EncodePlaylistID(encoder Encoder, id int) string {
+	res, _ := encoder.Encode([]int{id, PlaylistID})
+	return res
+}
+
 func FromContext(c context.Context) int {
 	return c.Value(ObjectIDCtx{}).(int)
 }
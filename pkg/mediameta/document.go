@@ -0,0 +1,389 @@
+package mediameta
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager/entitysource"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/samber/lo"
+)
+
+var (
+	ooxmlExts = []string{
+		"docx", "docm", "dotx", "xlsx", "xlsm", "xltx", "pptx", "pptm", "potx", "ppsx",
+	}
+	odfExts = []string{
+		"odt", "ott", "ods", "ots", "odp", "otp", "odg",
+	}
+	documentExts = append(append([]string{"pdf", "epub"}, ooxmlExts...), odfExts...)
+
+	ErrEpubCoverNotFound = errors.New("epub cover not found")
+)
+
+const (
+	DocumentTitle          = "title"
+	DocumentAuthor         = "author"
+	DocumentProducer       = "producer"
+	DocumentPageCount      = "page_count"
+	DocumentWordCount      = "word_count"
+	DocumentLastModifiedBy = "last_modified_by"
+
+	// maxDocumentXmlSize is the maximum size of a single XML part read from an OOXML/ODF/EPUB package.
+	maxDocumentXmlSize = 8 << 20
+	// maxEpubCoverSize is the maximum size of an EPUB cover image.
+	maxEpubCoverSize = 32 << 20
+)
+
+func newDocumentExtractor(settings setting.Provider, l logging.Logger) *documentExtractor {
+	return &documentExtractor{
+		l:        l,
+		settings: settings,
+	}
+}
+
+type documentExtractor struct {
+	l        logging.Logger
+	settings setting.Provider
+}
+
+// documentInfo is the common set of properties extracted from all supported document formats.
+type documentInfo struct {
+	title          string
+	author         string
+	producer       string
+	lastModifiedBy string
+	pages          int64
+	words          int64
+}
+
+func (d *documentExtractor) Exts() []string {
+	return documentExts
+}
+
+func (d *documentExtractor) Extract(ctx context.Context, ext string, source entitysource.EntitySource, opts ...optionFunc) ([]driver.MediaMeta, error) {
+	localLimit, remoteLimit := d.settings.MediaMetaDocumentSizeLimit(ctx)
+	if err := checkFileSize(localLimit, remoteLimit, source); err != nil {
+		return nil, err
+	}
+
+	var (
+		info *documentInfo
+		err  error
+	)
+	size := source.Entity().Size()
+	switch {
+	case ext == "pdf":
+		info, err = readPdfInfo(source, size)
+	case ext == "epub":
+		info, err = readEpubInfo(source, size)
+	case lo.Contains(odfExts, ext):
+		info, err = readOdfInfo(source, size)
+	default:
+		info, err = readOoxmlInfo(source, size)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to read document properties: %w", err)
+	}
+
+	metas := info.metas()
+	for i := 0; i < len(metas); i++ {
+		metas[i].Type = driver.MetaTypeDocument
+	}
+
+	return metas, nil
+}
+
+func (i *documentInfo) metas() []driver.MediaMeta {
+	metas := make([]driver.MediaMeta, 0, 6)
+	appendString := func(key, value string) {
+		if value = strings.TrimSpace(strings.ReplaceAll(value, "\x00", "")); value != "" {
+			metas = append(metas, driver.MediaMeta{Key: key, Value: value})
+		}
+	}
+	appendInt := func(key string, value int64) {
+		if value > 0 {
+			metas = append(metas, driver.MediaMeta{Key: key, Value: strconv.FormatInt(value, 10)})
+		}
+	}
+
+	appendString(DocumentTitle, i.title)
+	appendString(DocumentAuthor, i.author)
+	appendString(DocumentProducer, i.producer)
+	appendString(DocumentLastModifiedBy, i.lastModifiedBy)
+	appendInt(DocumentPageCount, i.pages)
+	appendInt(DocumentWordCount, i.words)
+	return metas
+}
+
+type (
+	ooxmlCoreProperties struct {
+		Title          string `xml:"title"`
+		Creator        string `xml:"creator"`
+		LastModifiedBy string `xml:"lastModifiedBy"`
+	}
+
+	ooxmlAppProperties struct {
+		Application string `xml:"Application"`
+		Pages       int64  `xml:"Pages"`
+		Slides      int64  `xml:"Slides"`
+		Words       int64  `xml:"Words"`
+	}
+)
+
+// readOoxmlInfo reads document properties from docProps/core.xml and docProps/app.xml of an OOXML package.
+func readOoxmlInfo(r io.ReaderAt, size int64) (*documentInfo, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open OOXML package: %w", err)
+	}
+
+	info := &documentInfo{}
+	core := &ooxmlCoreProperties{}
+	if err := decodeZipXml(zr, "docProps/core.xml", core); err == nil {
+		info.title = core.Title
+		info.author = core.Creator
+		info.lastModifiedBy = core.LastModifiedBy
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	app := &ooxmlAppProperties{}
+	if err := decodeZipXml(zr, "docProps/app.xml", app); err == nil {
+		info.producer = app.Application
+		info.pages = app.Pages
+		if app.Slides > 0 {
+			info.pages = app.Slides
+		}
+		info.words = app.Words
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+type odfMeta struct {
+	Meta struct {
+		Generator      string `xml:"generator"`
+		Title          string `xml:"title"`
+		InitialCreator string `xml:"initial-creator"`
+		Creator        string `xml:"creator"`
+		Statistic      struct {
+			PageCount int64 `xml:"page-count,attr"`
+			WordCount int64 `xml:"word-count,attr"`
+		} `xml:"document-statistic"`
+	} `xml:"meta"`
+}
+
+// readOdfInfo reads document properties from meta.xml of an OpenDocument package.
+func readOdfInfo(r io.ReaderAt, size int64) (*documentInfo, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open ODF package: %w", err)
+	}
+
+	meta := &odfMeta{}
+	if err := decodeZipXml(zr, "meta.xml", meta); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return &documentInfo{}, nil
+		}
+		return nil, err
+	}
+
+	info := &documentInfo{
+		title:          meta.Meta.Title,
+		author:         meta.Meta.InitialCreator,
+		producer:       meta.Meta.Generator,
+		lastModifiedBy: meta.Meta.Creator,
+		pages:          meta.Meta.Statistic.PageCount,
+		words:          meta.Meta.Statistic.WordCount,
+	}
+	if info.author == "" {
+		info.author = meta.Meta.Creator
+	}
+
+	return info, nil
+}
+
+type (
+	epubContainer struct {
+		RootFiles []struct {
+			FullPath  string `xml:"full-path,attr"`
+			MediaType string `xml:"media-type,attr"`
+		} `xml:"rootfiles>rootfile"`
+	}
+
+	epubPackage struct {
+		Metadata struct {
+			Titles   []string `xml:"title"`
+			Creators []string `xml:"creator"`
+			Metas    []struct {
+				Name    string `xml:"name,attr"`
+				Content string `xml:"content,attr"`
+			} `xml:"meta"`
+		} `xml:"metadata"`
+		Items []epubItem `xml:"manifest>item"`
+	}
+
+	epubItem struct {
+		ID         string `xml:"id,attr"`
+		Href       string `xml:"href,attr"`
+		MediaType  string `xml:"media-type,attr"`
+		Properties string `xml:"properties,attr"`
+	}
+)
+
+// readEpubInfo reads title and authors from the package document of an EPUB file.
+func readEpubInfo(r io.ReaderAt, size int64) (*documentInfo, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open EPUB package: %w", err)
+	}
+
+	pkg, _, err := readEpubPackage(zr)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &documentInfo{}
+	if len(pkg.Metadata.Titles) > 0 {
+		info.title = pkg.Metadata.Titles[0]
+	}
+	creators := make([]string, 0, len(pkg.Metadata.Creators))
+	for _, c := range pkg.Metadata.Creators {
+		if c = strings.TrimSpace(c); c != "" {
+			creators = append(creators, c)
+		}
+	}
+	info.author = strings.Join(creators, ", ")
+
+	return info, nil
+}
+
+// ReadEpubCover returns the cover image of given EPUB file, along with the file extension of the image
+// without leading dot.
+func ReadEpubCover(r io.ReaderAt, size int64) ([]byte, string, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to open EPUB package: %w", err)
+	}
+
+	pkg, opfPath, err := readEpubPackage(zr)
+	if err != nil {
+		return nil, "", err
+	}
+
+	cover := pkg.coverItem()
+	if cover == nil {
+		return nil, "", ErrEpubCoverNotFound
+	}
+
+	href, err := url.PathUnescape(cover.Href)
+	if err != nil {
+		href = cover.Href
+	}
+	coverPath := path.Join(path.Dir(opfPath), href)
+	f, err := zr.Open(coverPath)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to open cover %q: %w", coverPath, err)
+	}
+	defer f.Close()
+
+	data, err := io.ReadAll(io.LimitReader(f, maxEpubCoverSize))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read cover %q: %w", coverPath, err)
+	}
+
+	ext := strings.TrimPrefix(strings.ToLower(path.Ext(coverPath)), ".")
+	if exts, _ := mime.ExtensionsByType(cover.MediaType); ext == "" && len(exts) > 0 {
+		ext = strings.TrimPrefix(exts[0], ".")
+	}
+	if ext == "jpeg" {
+		ext = "jpg"
+	}
+
+	return data, ext, nil
+}
+
+func readEpubPackage(zr *zip.Reader) (*epubPackage, string, error) {
+	container := &epubContainer{}
+	if err := decodeZipXml(zr, "META-INF/container.xml", container); err != nil {
+		return nil, "", fmt.Errorf("failed to read EPUB container: %w", err)
+	}
+
+	opfPath := ""
+	for _, rf := range container.RootFiles {
+		if rf.MediaType == "" || rf.MediaType == "application/oebps-package+xml" {
+			opfPath = rf.FullPath
+			break
+		}
+	}
+	if opfPath == "" {
+		return nil, "", fmt.Errorf("no package document found in EPUB container")
+	}
+
+	pkg := &epubPackage{}
+	if err := decodeZipXml(zr, opfPath, pkg); err != nil {
+		return nil, "", fmt.Errorf("failed to read EPUB package document: %w", err)
+	}
+
+	return pkg, opfPath, nil
+}
+
+// coverItem finds the manifest item of cover image. EPUB 3 marks it with "cover-image" property, while
+// EPUB 2 references it from a <meta name="cover"> element.
+func (p *epubPackage) coverItem() *epubItem {
+	for i, item := range p.Items {
+		if lo.Contains(strings.Fields(item.Properties), "cover-image") {
+			return &p.Items[i]
+		}
+	}
+
+	for _, m := range p.Metadata.Metas {
+		if m.Name != "cover" {
+			continue
+		}
+		for i, item := range p.Items {
+			if item.ID == m.Content {
+				return &p.Items[i]
+			}
+		}
+	}
+
+	for i, item := range p.Items {
+		if (item.ID == "cover" || item.ID == "cover-image") && strings.HasPrefix(item.MediaType, "image/") {
+			return &p.Items[i]
+		}
+	}
+
+	return nil
+}
+
+// decodeZipXml decodes XML file with given name in zip archive into v.
+func decodeZipXml(zr *zip.Reader, name string, v any) error {
+	f, err := zr.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := xml.NewDecoder(io.LimitReader(f, maxDocumentXmlSize)).Decode(v); err != nil {
+		return fmt.Errorf("failed to decode %q: %w", name, err)
+	}
+
+	return nil
+}
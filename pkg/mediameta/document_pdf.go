@@ -0,0 +1,1057 @@
+package mediameta
+
+import (
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+// A minimal PDF object reader, only capable of locating the document information dictionary and page tree
+// through cross-reference tables and streams. Objects are read on demand with io.ReaderAt so that remote
+// sources do not have to be downloaded entirely.
+
+const (
+	pdfMaxDepth      = 64
+	pdfMinWindow     = 64 << 10
+	pdfMaxWindow     = 32 << 20
+	pdfMaxStreamSize = 64 << 20
+	pdfTailSize      = 2048
+)
+
+var (
+	errPdfUnexpectedEOF = errors.New("unexpected end of pdf data")
+	errPdfMalformed     = errors.New("malformed pdf")
+	errPdfCycle         = errors.New("cyclic pdf object reference")
+)
+
+type (
+	pdfName    string
+	pdfString  string
+	pdfKeyword string
+	pdfDict    map[pdfName]any
+	pdfArray   []any
+	pdfRef     struct {
+		num int
+		gen int
+	}
+	pdfStream struct {
+		dict pdfDict
+		// offset is the absolute offset of stream data in file.
+		offset int64
+	}
+
+	pdfXrefEntry struct {
+		offset     int64
+		compressed bool
+		stream     int
+		index      int
+	}
+
+	pdfObjStm struct {
+		data    []byte
+		first   int
+		offsets map[int]int
+	}
+
+	pdfReader struct {
+		r       io.ReaderAt
+		size    int64
+		xref    map[int]pdfXrefEntry
+		trailer pdfDict
+		objStms map[int]*pdfObjStm
+		// loading holds object streams being loaded, used to detect streams whose length
+		// refers to an object stored inside themselves.
+		loading map[int]bool
+		// depth is the current nesting of object lookups.
+		depth int
+	}
+)
+
+// readPdfInfo reads title, author, producer and page count from a PDF file.
+func readPdfInfo(r io.ReaderAt, size int64) (*documentInfo, error) {
+	d := &pdfReader{
+		r:       r,
+		size:    size,
+		xref:    make(map[int]pdfXrefEntry),
+		objStms: make(map[int]*pdfObjStm),
+		loading: make(map[int]bool),
+	}
+	if err := d.loadXref(); err != nil {
+		return nil, err
+	}
+
+	info := &documentInfo{}
+	if root, err := d.resolveDict(d.trailer["Root"]); err == nil {
+		if pages, err := d.resolveDict(root["Pages"]); err == nil {
+			if count, err := d.resolve(pages["Count"]); err == nil {
+				info.pages, _ = pdfInt(count)
+			}
+		}
+	}
+
+	// Strings in encrypted documents cannot be read without decrypting them first.
+	if _, encrypted := d.trailer["Encrypt"]; encrypted {
+		return info, nil
+	}
+
+	infoDict, err := d.resolveDict(d.trailer["Info"])
+	if err != nil {
+		return info, nil
+	}
+
+	text := func(key pdfName) string {
+		v, err := d.resolve(infoDict[key])
+		if err != nil {
+			return ""
+		}
+		s, _ := v.(pdfString)
+		return pdfTextString(s)
+	}
+	info.title = text("Title")
+	info.author = text("Author")
+	info.producer = text("Producer")
+	if info.producer == "" {
+		info.producer = text("Creator")
+	}
+
+	return info, nil
+}
+
+// loadXref loads all cross-reference sections starting from the one referenced by "startxref".
+func (d *pdfReader) loadXref() error {
+	tailSize := min(d.size, int64(pdfTailSize))
+	tail := make([]byte, tailSize)
+	if _, err := d.r.ReadAt(tail, d.size-tailSize); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("failed to read pdf trailer: %w", err)
+	}
+
+	idx := bytes.LastIndex(tail, []byte("startxref"))
+	if idx < 0 {
+		return fmt.Errorf("startxref not found: %w", errPdfMalformed)
+	}
+	p := &pdfParser{buf: tail[idx+len("startxref"):], eof: true}
+	start, err := p.readObject(0)
+	if err != nil {
+		return fmt.Errorf("failed to read startxref: %w", err)
+	}
+	offset, ok := pdfInt(start)
+	if !ok {
+		return fmt.Errorf("invalid startxref: %w", errPdfMalformed)
+	}
+
+	visited := make(map[int64]bool)
+	for offset > 0 && !visited[offset] {
+		visited[offset] = true
+		trailer, err := d.loadXrefSection(offset)
+		if err != nil {
+			return err
+		}
+
+		if d.trailer == nil {
+			d.trailer = trailer
+		}
+		offset, _ = pdfInt(trailer["Prev"])
+	}
+
+	if d.trailer == nil {
+		return fmt.Errorf("trailer not found: %w", errPdfMalformed)
+	}
+
+	return nil
+}
+
+// loadXrefSection loads a classic cross-reference table or a cross-reference stream at given offset,
+// entries already loaded from newer sections are kept.
+func (d *pdfReader) loadXrefSection(offset int64) (pdfDict, error) {
+	var (
+		trailer pdfDict
+		stream  *pdfStream
+	)
+	err := d.parseAt(offset, func(p *pdfParser) error {
+		p.skipSpace()
+		if !bytes.HasPrefix(p.buf[p.pos:], []byte("xref")) {
+			_, obj, err := p.readIndirect(offset)
+			if err != nil {
+				return err
+			}
+
+			s, ok := obj.(*pdfStream)
+			if !ok || s.dict["Type"] != pdfName("XRef") {
+				return fmt.Errorf("invalid xref stream: %w", errPdfMalformed)
+			}
+
+			stream = s
+			return nil
+		}
+
+		p.pos += len("xref")
+		entries := make(map[int]pdfXrefEntry)
+		for {
+			obj, err := p.readObject(0)
+			if err != nil {
+				return err
+			}
+
+			if obj == pdfKeyword("trailer") {
+				dict, err := p.readObject(0)
+				if err != nil {
+					return err
+				}
+
+				var ok bool
+				if trailer, ok = dict.(pdfDict); !ok {
+					return fmt.Errorf("invalid trailer: %w", errPdfMalformed)
+				}
+				break
+			}
+
+			start, ok := pdfInt(obj)
+			if !ok {
+				return fmt.Errorf("invalid xref subsection: %w", errPdfMalformed)
+			}
+			countObj, err := p.readObject(0)
+			if err != nil {
+				return err
+			}
+			count, ok := pdfInt(countObj)
+			if !ok {
+				return fmt.Errorf("invalid xref subsection: %w", errPdfMalformed)
+			}
+
+			for i := int64(0); i < count; i++ {
+				var fields [3]any
+				for j := range fields {
+					if fields[j], err = p.readObject(0); err != nil {
+						return err
+					}
+				}
+
+				off, _ := pdfInt(fields[0])
+				if fields[2] == pdfKeyword("n") {
+					entries[int(start+i)] = pdfXrefEntry{offset: off}
+				}
+			}
+		}
+
+		for num, entry := range entries {
+			if _, ok := d.xref[num]; !ok {
+				d.xref[num] = entry
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read xref at %d: %w", offset, err)
+	}
+
+	if stream != nil {
+		if err := d.loadXrefStream(stream); err != nil {
+			return nil, fmt.Errorf("failed to read xref stream at %d: %w", offset, err)
+		}
+		return stream.dict, nil
+	}
+
+	// Hybrid files keep entries of compressed objects in an additional xref stream.
+	if xrefStm, ok := pdfInt(trailer["XRefStm"]); ok && xrefStm > 0 {
+		if _, err := d.loadXrefSection(xrefStm); err != nil {
+			return nil, err
+		}
+	}
+
+	return trailer, nil
+}
+
+func (d *pdfReader) loadXrefStream(s *pdfStream) error {
+	data, err := d.streamData(s)
+	if err != nil {
+		return err
+	}
+
+	wArr, _ := s.dict["W"].(pdfArray)
+	if len(wArr) != 3 {
+		return fmt.Errorf("invalid /W: %w", errPdfMalformed)
+	}
+	var w [3]int
+	rowLen := 0
+	for i := range w {
+		v, ok := pdfInt(wArr[i])
+		if !ok || v < 0 || v > 8 {
+			return fmt.Errorf("invalid /W: %w", errPdfMalformed)
+		}
+		w[i] = int(v)
+		rowLen += w[i]
+	}
+	if rowLen == 0 {
+		return fmt.Errorf("invalid /W: %w", errPdfMalformed)
+	}
+
+	index, _ := s.dict["Index"].(pdfArray)
+	if index == nil {
+		size, _ := pdfInt(s.dict["Size"])
+		index = pdfArray{int64(0), size}
+	}
+
+	field := func(row []byte, i int, def int64) int64 {
+		if w[i] == 0 {
+			return def
+		}
+		offset := 0
+		for j := 0; j < i; j++ {
+			offset += w[j]
+		}
+		var v int64
+		for _, b := range row[offset : offset+w[i]] {
+			v = v<<8 | int64(b)
+		}
+		return v
+	}
+
+	for i := 0; i+1 < len(index); i += 2 {
+		start, _ := pdfInt(index[i])
+		count, _ := pdfInt(index[i+1])
+		for j := int64(0); j < count; j++ {
+			if len(data) < rowLen {
+				return nil
+			}
+			row := data[:rowLen]
+			data = data[rowLen:]
+
+			num := int(start + j)
+			if _, ok := d.xref[num]; ok {
+				continue
+			}
+
+			switch field(row, 0, 1) {
+			case 1:
+				d.xref[num] = pdfXrefEntry{offset: field(row, 1, 0)}
+			case 2:
+				d.xref[num] = pdfXrefEntry{compressed: true, stream: int(field(row, 1, 0)), index: int(field(row, 2, 0))}
+			}
+		}
+	}
+
+	return nil
+}
+
+// parseAt reads a window of data starting at offset and parses it with fn. The window grows
+// until fn no longer runs out of data.
+func (d *pdfReader) parseAt(offset int64, fn func(p *pdfParser) error) error {
+	if offset < 0 || offset >= d.size {
+		return fmt.Errorf("offset %d out of range: %w", offset, errPdfMalformed)
+	}
+
+	for window := int64(pdfMinWindow); ; window *= 4 {
+		n := min(window, d.size-offset)
+		buf := make([]byte, n)
+		if _, err := d.r.ReadAt(buf, offset); err != nil && !errors.Is(err, io.EOF) {
+			return err
+		}
+
+		err := fn(&pdfParser{buf: buf, eof: offset+n >= d.size})
+		if errors.Is(err, errPdfUnexpectedEOF) && offset+n < d.size && window < pdfMaxWindow {
+			continue
+		}
+
+		return err
+	}
+}
+
+// object returns the indirect object with given number.
+func (d *pdfReader) object(num int) (any, error) {
+	entry, ok := d.xref[num]
+	if !ok {
+		return nil, nil
+	}
+
+	d.depth++
+	defer func() { d.depth-- }()
+	if d.depth > pdfMaxDepth {
+		return nil, fmt.Errorf("object %d nested too deep: %w", num, errPdfCycle)
+	}
+
+	if entry.compressed {
+		stm, err := d.objStm(entry.stream)
+		if err != nil {
+			return nil, err
+		}
+
+		offset, ok := stm.offsets[num]
+		if !ok || stm.first+offset >= len(stm.data) {
+			return nil, nil
+		}
+
+		p := &pdfParser{buf: stm.data[stm.first+offset:], eof: true}
+		return p.readObject(0)
+	}
+
+	var obj any
+	err := d.parseAt(entry.offset, func(p *pdfParser) error {
+		var err error
+		_, obj, err = p.readIndirect(entry.offset)
+		return err
+	})
+	return obj, err
+}
+
+// objStm loads and caches the object stream with given number.
+func (d *pdfReader) objStm(num int) (*pdfObjStm, error) {
+	if stm, ok := d.objStms[num]; ok {
+		return stm, nil
+	}
+
+	// Object streams are never nested, make sure we do not recurse into another one.
+	if entry, ok := d.xref[num]; !ok || entry.compressed {
+		return nil, fmt.Errorf("invalid object stream %d: %w", num, errPdfMalformed)
+	}
+
+	if d.loading[num] {
+		return nil, fmt.Errorf("object stream %d depends on itself: %w", num, errPdfCycle)
+	}
+	d.loading[num] = true
+	defer delete(d.loading, num)
+
+	obj, err := d.object(num)
+	if err != nil {
+		return nil, err
+	}
+
+	s, ok := obj.(*pdfStream)
+	if !ok {
+		return nil, fmt.Errorf("invalid object stream %d: %w", num, errPdfMalformed)
+	}
+
+	data, err := d.streamData(s)
+	if err != nil {
+		return nil, err
+	}
+
+	n, _ := pdfInt(s.dict["N"])
+	first, _ := pdfInt(s.dict["First"])
+	if first < 0 || first > int64(len(data)) {
+		return nil, fmt.Errorf("invalid object stream %d: %w", num, errPdfMalformed)
+	}
+
+	stm := &pdfObjStm{data: data, first: int(first), offsets: make(map[int]int)}
+	p := &pdfParser{buf: data[:first], eof: true}
+	for i := int64(0); i < n; i++ {
+		objNum, err := p.readObject(0)
+		if err != nil {
+			break
+		}
+		offset, err := p.readObject(0)
+		if err != nil {
+			break
+		}
+
+		o, _ := pdfInt(objNum)
+		off, _ := pdfInt(offset)
+		stm.offsets[int(o)] = int(off)
+	}
+
+	d.objStms[num] = stm
+	return stm, nil
+}
+
+// resolve follows indirect references until a direct object is reached.
+func (d *pdfReader) resolve(obj any) (any, error) {
+	for i := 0; i < pdfMaxDepth; i++ {
+		ref, ok := obj.(pdfRef)
+		if !ok {
+			return obj, nil
+		}
+
+		var err error
+		if obj, err = d.object(ref.num); err != nil {
+			return nil, err
+		}
+	}
+
+	return nil, fmt.Errorf("too many indirections: %w", errPdfMalformed)
+}
+
+func (d *pdfReader) resolveDict(obj any) (pdfDict, error) {
+	obj, err := d.resolve(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	switch v := obj.(type) {
+	case pdfDict:
+		return v, nil
+	case *pdfStream:
+		return v.dict, nil
+	default:
+		return nil, fmt.Errorf("expect dictionary: %w", errPdfMalformed)
+	}
+}
+
+// streamData reads and decodes data of given stream. Only FlateDecode with optional PNG predictors
+// is supported, which is what cross-reference and object streams use in practice.
+func (d *pdfReader) streamData(s *pdfStream) ([]byte, error) {
+	lengthObj, err := d.resolve(s.dict["Length"])
+	if err != nil {
+		return nil, err
+	}
+
+	length, ok := pdfInt(lengthObj)
+	if !ok || length < 0 || length > pdfMaxStreamSize || s.offset+length > d.size {
+		return nil, fmt.Errorf("invalid stream length: %w", errPdfMalformed)
+	}
+
+	data := make([]byte, length)
+	if _, err := d.r.ReadAt(data, s.offset); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to read stream: %w", err)
+	}
+
+	var filters pdfArray
+	switch f := s.dict["Filter"].(type) {
+	case pdfName:
+		filters = pdfArray{f}
+	case pdfArray:
+		filters = f
+	}
+
+	var params pdfArray
+	switch p := s.dict["DecodeParms"].(type) {
+	case pdfDict:
+		params = pdfArray{p}
+	case pdfArray:
+		params = p
+	}
+
+	for i, f := range filters {
+		if f != pdfName("FlateDecode") {
+			return nil, fmt.Errorf("unsupported stream filter %v", f)
+		}
+
+		zr, err := zlib.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress stream: %w", err)
+		}
+
+		// Many writers leave a truncated zlib checksum behind, data read so far is still usable.
+		data, err = io.ReadAll(io.LimitReader(zr, pdfMaxStreamSize))
+		if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, fmt.Errorf("failed to decompress stream: %w", err)
+		}
+
+		if i < len(params) {
+			if p, ok := params[i].(pdfDict); ok {
+				if data, err = pdfUnpredict(data, p); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	return data, nil
+}
+
+// pdfUnpredict reverses PNG predictors applied before compression.
+func pdfUnpredict(data []byte, params pdfDict) ([]byte, error) {
+	predictor, _ := pdfInt(params["Predictor"])
+	if predictor <= 1 {
+		return data, nil
+	}
+	if predictor < 10 {
+		return nil, fmt.Errorf("unsupported predictor %d", predictor)
+	}
+
+	intParam := func(key pdfName, def int64) int {
+		if v, ok := pdfInt(params[key]); ok && v > 0 {
+			return int(v)
+		}
+		return int(def)
+	}
+	colors := intParam("Colors", 1)
+	bpc := intParam("BitsPerComponent", 8)
+	columns := intParam("Columns", 1)
+
+	bpp := max(colors*bpc/8, 1)
+	rowLen := (colors*bpc*columns + 7) / 8
+	out := make([]byte, 0, len(data))
+	prev := make([]byte, rowLen)
+	for len(data) >= rowLen+1 {
+		filter, row := data[0], data[1:rowLen+1]
+		for i := range row {
+			var left, upLeft byte
+			if i >= bpp {
+				left, upLeft = row[i-bpp], prev[i-bpp]
+			}
+			up := prev[i]
+
+			switch filter {
+			case 1:
+				row[i] += left
+			case 2:
+				row[i] += up
+			case 3:
+				row[i] += byte((int(left) + int(up)) / 2)
+			case 4:
+				row[i] += paeth(left, up, upLeft)
+			}
+		}
+
+		out = append(out, row...)
+		prev = row
+		data = data[rowLen+1:]
+	}
+
+	return out, nil
+}
+
+func paeth(a, b, c byte) byte {
+	p := int(a) + int(b) - int(c)
+	pa, pb, pc := abs(p-int(a)), abs(p-int(b)), abs(p-int(c))
+	switch {
+	case pa <= pb && pa <= pc:
+		return a
+	case pb <= pc:
+		return b
+	default:
+		return c
+	}
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+// pdfInt converts numeric object into int64.
+func pdfInt(obj any) (int64, bool) {
+	switch v := obj.(type) {
+	case int64:
+		return v, true
+	case float64:
+		return int64(v), true
+	default:
+		return 0, false
+	}
+}
+
+// pdfTextString decodes a PDF text string, which is either UTF-16BE or UTF-8 with BOM,
+// or PDFDocEncoding that is approximated by Latin-1.
+func pdfTextString(s pdfString) string {
+	b := []byte(s)
+	switch {
+	case len(b) >= 2 && b[0] == 0xfe && b[1] == 0xff:
+		b = b[2:]
+		u := make([]uint16, 0, len(b)/2)
+		for i := 0; i+1 < len(b); i += 2 {
+			u = append(u, uint16(b[i])<<8|uint16(b[i+1]))
+		}
+		return string(utf16.Decode(u))
+	case len(b) >= 3 && b[0] == 0xef && b[1] == 0xbb && b[2] == 0xbf:
+		return strings.ToValidUTF8(string(b[3:]), "")
+	default:
+		r := make([]rune, len(b))
+		for i, c := range b {
+			r[i] = rune(c)
+		}
+		return string(r)
+	}
+}
+
+// pdfParser parses PDF objects from a buffer. If eof is false, the buffer is only a window
+// of the underlying data, running out of it results in errPdfUnexpectedEOF.
+type pdfParser struct {
+	buf []byte
+	pos int
+	eof bool
+}
+
+func isPdfWhitespace(c byte) bool {
+	return c == 0 || c == '\t' || c == '\n' || c == '\f' || c == '\r' || c == ' '
+}
+
+func isPdfDelimiter(c byte) bool {
+	return strings.IndexByte("()<>[]{}/%", c) >= 0
+}
+
+func (p *pdfParser) skipSpace() {
+	for p.pos < len(p.buf) {
+		c := p.buf[p.pos]
+		if isPdfWhitespace(c) {
+			p.pos++
+			continue
+		}
+
+		if c == '%' {
+			for p.pos < len(p.buf) && p.buf[p.pos] != '\n' && p.buf[p.pos] != '\r' {
+				p.pos++
+			}
+			continue
+		}
+
+		return
+	}
+}
+
+// regular reads a run of regular characters.
+func (p *pdfParser) regular() (string, error) {
+	start := p.pos
+	for p.pos < len(p.buf) && !isPdfWhitespace(p.buf[p.pos]) && !isPdfDelimiter(p.buf[p.pos]) {
+		p.pos++
+	}
+
+	if p.pos >= len(p.buf) && !p.eof {
+		return "", errPdfUnexpectedEOF
+	}
+
+	return string(p.buf[start:p.pos]), nil
+}
+
+func (p *pdfParser) readObject(depth int) (any, error) {
+	if depth > pdfMaxDepth {
+		return nil, fmt.Errorf("object nested too deep: %w", errPdfMalformed)
+	}
+
+	p.skipSpace()
+	if p.pos >= len(p.buf) {
+		return nil, errPdfUnexpectedEOF
+	}
+
+	switch c := p.buf[p.pos]; {
+	case c == '/':
+		return p.readName()
+	case c == '(':
+		return p.readLiteralString()
+	case c == '<':
+		if p.pos+1 >= len(p.buf) {
+			return nil, errPdfUnexpectedEOF
+		}
+		if p.buf[p.pos+1] == '<' {
+			return p.readDict(depth)
+		}
+		return p.readHexString()
+	case c == '[':
+		return p.readArray(depth)
+	case c == '+' || c == '-' || c == '.' || (c >= '0' && c <= '9'):
+		return p.readNumberOrRef()
+	case isPdfDelimiter(c):
+		return nil, fmt.Errorf("unexpected %q at %d: %w", c, p.pos, errPdfMalformed)
+	default:
+		kw, err := p.regular()
+		if err != nil {
+			return nil, err
+		}
+
+		switch kw {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		case "null":
+			return nil, nil
+		default:
+			return pdfKeyword(kw), nil
+		}
+	}
+}
+
+func (p *pdfParser) readName() (pdfName, error) {
+	p.pos++
+	raw, err := p.regular()
+	if err != nil {
+		return "", err
+	}
+
+	if !strings.Contains(raw, "#") {
+		return pdfName(raw), nil
+	}
+
+	var sb strings.Builder
+	for i := 0; i < len(raw); i++ {
+		if raw[i] == '#' && i+2 < len(raw) {
+			if v, err := strconv.ParseUint(raw[i+1:i+3], 16, 8); err == nil {
+				sb.WriteByte(byte(v))
+				i += 2
+				continue
+			}
+		}
+		sb.WriteByte(raw[i])
+	}
+
+	return pdfName(sb.String()), nil
+}
+
+func (p *pdfParser) readLiteralString() (pdfString, error) {
+	p.pos++
+	var (
+		sb    strings.Builder
+		depth = 1
+	)
+	for p.pos < len(p.buf) {
+		c := p.buf[p.pos]
+		p.pos++
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return pdfString(sb.String()), nil
+			}
+		case '\\':
+			if p.pos >= len(p.buf) {
+				return "", errPdfUnexpectedEOF
+			}
+
+			e := p.buf[p.pos]
+			p.pos++
+			switch e {
+			case 'n':
+				sb.WriteByte('\n')
+			case 'r':
+				sb.WriteByte('\r')
+			case 't':
+				sb.WriteByte('\t')
+			case 'b':
+				sb.WriteByte('\b')
+			case 'f':
+				sb.WriteByte('\f')
+			case '\r':
+				// Line continuation
+				if p.pos < len(p.buf) && p.buf[p.pos] == '\n' {
+					p.pos++
+				}
+			case '\n':
+			default:
+				if e >= '0' && e <= '7' {
+					v := int(e - '0')
+					for i := 0; i < 2 && p.pos < len(p.buf) && p.buf[p.pos] >= '0' && p.buf[p.pos] <= '7'; i++ {
+						v = v*8 + int(p.buf[p.pos]-'0')
+						p.pos++
+					}
+					sb.WriteByte(byte(v))
+				} else {
+					sb.WriteByte(e)
+				}
+			}
+			continue
+		}
+
+		sb.WriteByte(c)
+	}
+
+	return "", errPdfUnexpectedEOF
+}
+
+func (p *pdfParser) readHexString() (pdfString, error) {
+	p.pos++
+	end := bytes.IndexByte(p.buf[p.pos:], '>')
+	if end < 0 {
+		return "", errPdfUnexpectedEOF
+	}
+
+	var digits []byte
+	for _, c := range p.buf[p.pos : p.pos+end] {
+		if !isPdfWhitespace(c) {
+			digits = append(digits, c)
+		}
+	}
+	p.pos += end + 1
+
+	if len(digits)%2 == 1 {
+		digits = append(digits, '0')
+	}
+
+	out := make([]byte, len(digits)/2)
+	for i := range out {
+		v, err := strconv.ParseUint(string(digits[i*2:i*2+2]), 16, 8)
+		if err != nil {
+			return "", fmt.Errorf("invalid hex string: %w", errPdfMalformed)
+		}
+		out[i] = byte(v)
+	}
+
+	return pdfString(out), nil
+}
+
+func (p *pdfParser) readDict(depth int) (pdfDict, error) {
+	p.pos += 2
+	dict := make(pdfDict)
+	for {
+		p.skipSpace()
+		if p.pos+1 >= len(p.buf) {
+			return nil, errPdfUnexpectedEOF
+		}
+
+		if p.buf[p.pos] == '>' && p.buf[p.pos+1] == '>' {
+			p.pos += 2
+			return dict, nil
+		}
+
+		key, err := p.readObject(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+
+		name, ok := key.(pdfName)
+		if !ok {
+			return nil, fmt.Errorf("invalid dictionary key %v: %w", key, errPdfMalformed)
+		}
+
+		value, err := p.readObject(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+
+		dict[name] = value
+	}
+}
+
+func (p *pdfParser) readArray(depth int) (pdfArray, error) {
+	p.pos++
+	arr := pdfArray{}
+	for {
+		p.skipSpace()
+		if p.pos >= len(p.buf) {
+			return nil, errPdfUnexpectedEOF
+		}
+
+		if p.buf[p.pos] == ']' {
+			p.pos++
+			return arr, nil
+		}
+
+		v, err := p.readObject(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+
+		arr = append(arr, v)
+	}
+}
+
+// readNumberOrRef reads a number, or an indirect reference in form of "num gen R".
+func (p *pdfParser) readNumberOrRef() (any, error) {
+	tok, err := p.regular()
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.ContainsAny(tok, ".") {
+		v, err := strconv.ParseFloat(tok, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q: %w", tok, errPdfMalformed)
+		}
+		return v, nil
+	}
+
+	num, err := strconv.ParseInt(tok, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid number %q: %w", tok, errPdfMalformed)
+	}
+
+	save := p.pos
+	gen, ok, err := p.lookaheadRef()
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		p.pos = save
+		return num, nil
+	}
+
+	return pdfRef{num: int(num), gen: gen}, nil
+}
+
+// lookaheadRef checks whether the following tokens are "gen R".
+func (p *pdfParser) lookaheadRef() (int, bool, error) {
+	p.skipSpace()
+	if p.pos >= len(p.buf) {
+		if p.eof {
+			return 0, false, nil
+		}
+		return 0, false, errPdfUnexpectedEOF
+	}
+
+	if c := p.buf[p.pos]; c < '0' || c > '9' {
+		return 0, false, nil
+	}
+
+	tok, err := p.regular()
+	if err != nil {
+		return 0, false, err
+	}
+
+	gen, err := strconv.Atoi(tok)
+	if err != nil {
+		return 0, false, nil
+	}
+
+	p.skipSpace()
+	if p.pos >= len(p.buf) {
+		if p.eof {
+			return 0, false, nil
+		}
+		return 0, false, errPdfUnexpectedEOF
+	}
+
+	if p.buf[p.pos] != 'R' || (p.pos+1 < len(p.buf) && !isPdfWhitespace(p.buf[p.pos+1]) && !isPdfDelimiter(p.buf[p.pos+1])) {
+		return 0, false, nil
+	}
+
+	p.pos++
+	return gen, true, nil
+}
+
+// readIndirect reads an indirect object definition "num gen obj ... endobj". base is the absolute
+// offset of the buffer, used to locate stream data.
+func (p *pdfParser) readIndirect(base int64) (int, any, error) {
+	numObj, err := p.readObject(0)
+	if err != nil {
+		return 0, nil, err
+	}
+	if _, err := p.readObject(0); err != nil {
+		return 0, nil, err
+	}
+	kw, err := p.readObject(0)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	num, ok := pdfInt(numObj)
+	if !ok || kw != pdfKeyword("obj") {
+		return 0, nil, fmt.Errorf("invalid indirect object: %w", errPdfMalformed)
+	}
+
+	obj, err := p.readObject(0)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	dict, ok := obj.(pdfDict)
+	if !ok {
+		return int(num), obj, nil
+	}
+
+	p.skipSpace()
+	if len(p.buf)-p.pos < len("stream\r\n") && !p.eof {
+		return 0, nil, errPdfUnexpectedEOF
+	}
+
+	if !bytes.HasPrefix(p.buf[p.pos:], []byte("stream")) {
+		return int(num), dict, nil
+	}
+
+	p.pos += len("stream")
+	if p.pos < len(p.buf) && p.buf[p.pos] == '\r' {
+		p.pos++
+	}
+	if p.pos < len(p.buf) && p.buf[p.pos] == '\n' {
+		p.pos++
+	}
+
+	return int(num), &pdfStream{dict: dict, offset: base + int64(p.pos)}, nil
+}
@@ -0,0 +1,280 @@
+package mediameta
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func buildZip(t *testing.T, files map[string]string) *bytes.Reader {
+	buf := &bytes.Buffer{}
+	zw := zip.NewWriter(buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	return bytes.NewReader(buf.Bytes())
+}
+
+// buildPdf writes given objects with a classic xref table, or with an xref stream that also
+// holds objects listed in compressed.
+func buildPdf(objects map[int]string, compressed []int, trailer string, xrefStream bool) []byte {
+	buf := &bytes.Buffer{}
+	buf.WriteString("%PDF-1.7\n%\xe2\xe3\xcf\xd3\n")
+	offsets := map[int]int{}
+	maxNum := 0
+	inStm := map[int]int{}
+	for i, num := range compressed {
+		inStm[num] = i
+	}
+
+	for num := 1; num <= len(objects); num++ {
+		maxNum = num
+		if _, ok := inStm[num]; ok {
+			continue
+		}
+		offsets[num] = buf.Len()
+		fmt.Fprintf(buf, "%d 0 obj\n%s\nendobj\n", num, objects[num])
+	}
+
+	if !xrefStream {
+		xrefOffset := buf.Len()
+		fmt.Fprintf(buf, "xref\n0 %d\n0000000000 65535 f\r\n", maxNum+1)
+		for num := 1; num <= maxNum; num++ {
+			fmt.Fprintf(buf, "%010d 00000 n\r\n", offsets[num])
+		}
+		fmt.Fprintf(buf, "trailer\n<< /Size %d %s >>\nstartxref\n%d\n%%%%EOF\n", maxNum+1, trailer, xrefOffset)
+		return buf.Bytes()
+	}
+
+	// Object stream
+	stmNum := maxNum + 1
+	header, body := &bytes.Buffer{}, &bytes.Buffer{}
+	for _, num := range compressed {
+		fmt.Fprintf(header, "%d %d ", num, body.Len())
+		body.WriteString(objects[num] + "\n")
+	}
+	stmData := deflate(append(header.Bytes(), body.Bytes()...))
+	offsets[stmNum] = buf.Len()
+	fmt.Fprintf(buf, "%d 0 obj\n<< /Type /ObjStm /N %d /First %d /Filter /FlateDecode /Length %d >>\nstream\n", stmNum, len(compressed), header.Len(), len(stmData))
+	buf.Write(stmData)
+	buf.WriteString("\nendstream\nendobj\n")
+
+	// Xref stream with PNG up predictor
+	xrefNum := stmNum + 1
+	xrefOffset := buf.Len()
+	offsets[xrefNum] = xrefOffset
+	var rows []byte
+	prev := make([]byte, 4)
+	for num := 0; num <= xrefNum; num++ {
+		row := make([]byte, 4)
+		if idx, ok := inStm[num]; ok {
+			row[0], row[2], row[3] = 2, byte(stmNum), byte(idx)
+		} else if off, ok := offsets[num]; ok {
+			row[0], row[1], row[2] = 1, byte(off>>8), byte(off)
+		}
+		rows = append(rows, 2)
+		for i := range row {
+			rows = append(rows, row[i]-prev[i])
+		}
+		prev = row
+	}
+	xrefData := deflate(rows)
+	fmt.Fprintf(buf, "%d 0 obj\n<< /Type /XRef /Size %d /W [1 2 1] /Filter /FlateDecode /DecodeParms << /Columns 4 /Predictor 12 >> /Length %d %s >>\nstream\r\n", xrefNum, xrefNum+1, len(xrefData), trailer)
+	buf.Write(xrefData)
+	fmt.Fprintf(buf, "\nendstream\nendobj\nstartxref\n%d\n%%%%EOF\n", xrefOffset)
+	return buf.Bytes()
+}
+
+func deflate(data []byte) []byte {
+	buf := &bytes.Buffer{}
+	zw := zlib.NewWriter(buf)
+	_, _ = zw.Write(data)
+	_ = zw.Close()
+	return buf.Bytes()
+}
+
+func TestReadPdfInfo(t *testing.T) {
+	objects := map[int]string{
+		1: "<< /Type /Catalog /Pages 2 0 R >>",
+		2: "<< /Type /Pages /Kids [3 0 R] /Count 3 0 R >>",
+		3: "12",
+		4: `<< /Title (Annual \(draft\) Report) /Author <FEFF004A00F60072006700200057> /Creator (Writer) /Producer (Cloud\\PDF\0551.0) >>`,
+	}
+
+	for _, xrefStream := range []bool{false, true} {
+		var compressed []int
+		if xrefStream {
+			compressed = []int{2, 3, 4}
+		}
+
+		data := buildPdf(objects, compressed, "/Root 1 0 R /Info 4 0 R", xrefStream)
+		info, err := readPdfInfo(bytes.NewReader(data), int64(len(data)))
+		if err != nil {
+			t.Fatalf("xrefStream=%v: %s", xrefStream, err)
+		}
+
+		if info.title != "Annual (draft) Report" || info.author != "Jörg W" || info.producer != `Cloud\PDF-1.0` || info.pages != 12 {
+			t.Errorf("xrefStream=%v: unexpected info %+v", xrefStream, info)
+		}
+	}
+}
+
+func TestReadPdfInfoEncrypted(t *testing.T) {
+	objects := map[int]string{
+		1: "<< /Type /Catalog /Pages 2 0 R >>",
+		2: "<< /Type /Pages /Kids [] /Count 5 >>",
+		3: "<< /Title <8a9b> >>",
+		4: "<< /Filter /Standard >>",
+	}
+	data := buildPdf(objects, nil, "/Root 1 0 R /Info 3 0 R /Encrypt 4 0 R", false)
+	info, err := readPdfInfo(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if info.title != "" || info.pages != 5 {
+		t.Errorf("unexpected info %+v", info)
+	}
+}
+
+// cyclicObjStmPdf builds a PDF whose object stream takes its /Length from an object stored
+// inside the same stream.
+func cyclicObjStmPdf() []byte {
+	buf := &bytes.Buffer{}
+	buf.WriteString("%PDF-1.7\n")
+	stmOffset := buf.Len()
+	buf.WriteString("3 0 obj\n<< /Type /ObjStm /N 2 /First 8 /Length 4 0 R >>\nstream\n2 0 4 1 \n<<>>\n9\nendstream\nendobj\n")
+
+	xrefOffset := buf.Len()
+	rows := []byte{
+		0, 0, 0,
+		0, 0, 0,
+		2, 3, 0,
+		1, byte(stmOffset), 0,
+		2, 3, 1,
+		1, byte(xrefOffset), 0,
+	}
+	fmt.Fprintf(buf, "5 0 obj\n<< /Type /XRef /Size 6 /W [1 1 1] /Root 2 0 R /Info 4 0 R /Length %d >>\nstream\n", len(rows))
+	buf.Write(rows)
+	fmt.Fprintf(buf, "\nendstream\nendobj\nstartxref\n%d\n%%%%EOF\n", xrefOffset)
+	return buf.Bytes()
+}
+
+func TestReadPdfInfoCyclicObjStm(t *testing.T) {
+	data := cyclicObjStmPdf()
+	info, err := readPdfInfo(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if info.title != "" || info.pages != 0 {
+		t.Errorf("unexpected info %+v", info)
+	}
+
+	d := &pdfReader{r: bytes.NewReader(data), size: int64(len(data)), xref: map[int]pdfXrefEntry{},
+		objStms: map[int]*pdfObjStm{}, loading: map[int]bool{}}
+	if err := d.loadXref(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.object(2); !errors.Is(err, errPdfCycle) {
+		t.Errorf("expected cycle error, got %v", err)
+	}
+}
+
+func TestReadOoxmlInfo(t *testing.T) {
+	r := buildZip(t, map[string]string{
+		"docProps/core.xml": `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<cp:coreProperties xmlns:cp="http://schemas.openxmlformats.org/package/2006/metadata/core-properties" xmlns:dc="http://purl.org/dc/elements/1.1/">
+<dc:title>Quarterly Plan</dc:title><dc:creator>Alice</dc:creator><cp:lastModifiedBy>Bob</cp:lastModifiedBy>
+</cp:coreProperties>`,
+		"docProps/app.xml": `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Properties xmlns="http://schemas.openxmlformats.org/officeDocument/2006/extended-properties">
+<Application>Microsoft Office Word</Application><Pages>3</Pages><Words>1024</Words>
+</Properties>`,
+	})
+
+	info, err := readOoxmlInfo(r, r.Size())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := documentInfo{title: "Quarterly Plan", author: "Alice", lastModifiedBy: "Bob", producer: "Microsoft Office Word", pages: 3, words: 1024}
+	if *info != want {
+		t.Errorf("got %+v, want %+v", *info, want)
+	}
+}
+
+func TestReadOdfInfo(t *testing.T) {
+	r := buildZip(t, map[string]string{
+		"meta.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<office:document-meta xmlns:office="urn:oasis:names:tc:opendocument:xmlns:office:1.0" xmlns:meta="urn:oasis:names:tc:opendocument:xmlns:meta:1.0" xmlns:dc="http://purl.org/dc/elements/1.1/">
+<office:meta><meta:generator>LibreOffice/7.6</meta:generator><dc:title>Notes</dc:title>
+<meta:initial-creator>Carol</meta:initial-creator><dc:creator>Dave</dc:creator>
+<meta:document-statistic meta:page-count="2" meta:word-count="321"/></office:meta>
+</office:document-meta>`,
+	})
+
+	info, err := readOdfInfo(r, r.Size())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := documentInfo{title: "Notes", author: "Carol", lastModifiedBy: "Dave", producer: "LibreOffice/7.6", pages: 2, words: 321}
+	if *info != want {
+		t.Errorf("got %+v, want %+v", *info, want)
+	}
+}
+
+func TestReadEpub(t *testing.T) {
+	container := `<?xml version="1.0"?>
+<container version="1.0" xmlns="urn:oasis:names:tc:opendocument:xmlns:container">
+<rootfiles><rootfile full-path="OEBPS/content.opf" media-type="application/oebps-package+xml"/></rootfiles>
+</container>`
+
+	cases := map[string]string{
+		"epub3": `<package xmlns="http://www.idpf.org/2007/opf" version="3.0"><metadata xmlns:dc="http://purl.org/dc/elements/1.1/">
+<dc:title>A Tale</dc:title><dc:creator>Erin</dc:creator><dc:creator>Frank</dc:creator></metadata>
+<manifest><item id="img" href="images/cover%20art.png" media-type="image/png" properties="cover-image"/></manifest></package>`,
+		"epub2": `<package xmlns="http://www.idpf.org/2007/opf" version="2.0"><metadata xmlns:dc="http://purl.org/dc/elements/1.1/">
+<dc:title>A Tale</dc:title><dc:creator>Erin</dc:creator><dc:creator>Frank</dc:creator><meta name="cover" content="img"/></metadata>
+<manifest><item id="img" href="images/cover art.png" media-type="image/png"/></manifest></package>`,
+	}
+
+	for name, opf := range cases {
+		r := buildZip(t, map[string]string{
+			"META-INF/container.xml":      container,
+			"OEBPS/content.opf":           opf,
+			"OEBPS/images/cover art.png":  "cover",
+			"OEBPS/images/unrelated.jpeg": "other",
+		})
+
+		info, err := readEpubInfo(r, r.Size())
+		if err != nil {
+			t.Fatalf("%s: %s", name, err)
+		}
+		if info.title != "A Tale" || info.author != "Erin, Frank" {
+			t.Errorf("%s: unexpected info %+v", name, info)
+		}
+
+		cover, ext, err := ReadEpubCover(r, r.Size())
+		if err != nil {
+			t.Fatalf("%s: %s", name, err)
+		}
+		if string(cover) != "cover" || ext != "png" {
+			t.Errorf("%s: unexpected cover %q, %q", name, cover, ext)
+		}
+	}
+}
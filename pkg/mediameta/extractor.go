@@ -49,13 +49,18 @@ func NewExtractorManager(ctx context.Context, settings setting.Provider, l loggi
 		extractors = append(extractors, musicE)
 	}
 
+	if e.settings.MediaMetaDocumentEnabled(ctx) {
+		documentE := newDocumentExtractor(settings, l)
+		extractors = append(extractors, documentE)
+	}
+
 	if e.settings.MediaMetaFFProbeEnabled(ctx) {
 		ffprobeE := newFFProbeExtractor(settings, l)
 		extractors = append(extractors, ffprobeE)
 	}
 
 	if e.settings.MediaMetaGeocodingEnabled(ctx) {
-		geocodingE := newGeocodingExtractor(settings, l, client)
+		geocodingE := newGeocodingExtractor(ctx, settings, l, client)
 		extractors = append(extractors, geocodingE)
 	}
 
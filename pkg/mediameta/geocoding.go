@@ -7,12 +7,14 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"time"
 
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager/entitysource"
 	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
 	"github.com/cloudreve/Cloudreve/v4/pkg/request"
 	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
 )
 
 const mapBoxURL = "https://api.mapbox.com/search/geocode/v6/reverse"
@@ -30,14 +32,35 @@ type geocodingExtractor struct {
 	settings setting.Provider
 	l        logging.Logger
 	client   request.Client
+	offline  *offlineGeocoder
 }
 
-func newGeocodingExtractor(settings setting.Provider, l logging.Logger, client request.Client) *geocodingExtractor {
-	return &geocodingExtractor{
+func newGeocodingExtractor(ctx context.Context, settings setting.Provider, l logging.Logger, client request.Client) *geocodingExtractor {
+	e := &geocodingExtractor{
 		settings: settings,
 		l:        l,
 		client:   client,
 	}
+
+	if settings.MediaMetaGeocodingProvider(ctx) == setting.GeocodingProviderOffline {
+		dataset := settings.MediaMetaGeocodingOfflineDataset(ctx)
+		if dataset == "" {
+			l.Warning("Offline geocoding is enabled but no dataset is configured.")
+			return e
+		}
+
+		start := time.Now()
+		offline, err := loadOfflineGeocoder(util.DataPath(dataset))
+		if err != nil {
+			l.Warning("Failed to load offline geocoding dataset %q: %s", dataset, err)
+			return e
+		}
+
+		l.Info("Offline geocoding dataset %q loaded with %d places in %s.", dataset, len(offline.places), time.Since(start))
+		e.offline = offline
+	}
+
+	return e
 }
 
 func (e *geocodingExtractor) Exts() []string {
@@ -75,9 +98,18 @@ func (e *geocodingExtractor) Extract(ctx context.Context, ext string, source ent
 		return nil, fmt.Errorf("geocoding: failed to parse longitude: %w", err)
 	}
 
-	metas, err := e.getGeocoding(ctx, lat, lng, option.language)
-	if err != nil {
-		return nil, fmt.Errorf("geocoding: failed to get geocoding: %w", err)
+	var metas []driver.MediaMeta
+	if e.settings.MediaMetaGeocodingProvider(ctx) == setting.GeocodingProviderOffline {
+		if e.offline == nil {
+			return nil, nil
+		}
+
+		metas = e.offline.getGeocoding(lat, lng)
+	} else {
+		metas, err = e.getGeocoding(ctx, lat, lng, option.language)
+		if err != nil {
+			return nil, fmt.Errorf("geocoding: failed to get geocoding: %w", err)
+		}
 	}
 
 	for i, _ := range metas {
@@ -0,0 +1,328 @@
+package mediameta
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver"
+)
+
+const (
+	earthRadiusKm = 6371.0
+	// offlineGeocodingMaxDistance is the max distance in km between photo and the nearest known place,
+	// photos further away from any place (e.g. in the ocean) are not geocoded.
+	offlineGeocodingMaxDistance = 100.0
+
+	// Columns of GeoNames cities dump, see https://download.geonames.org/export/dump/readme.txt
+	geoNamesColumns        = 19
+	geoNamesColName        = 1
+	geoNamesColLat         = 4
+	geoNamesColLng         = 5
+	geoNamesColCountryCode = 8
+	geoNamesColAdmin1Code  = 10
+
+	// GeoNames name tables looked up in the same folder of the cities dump.
+	geoNamesAdmin1File  = "admin1CodesASCII.txt"
+	geoNamesCountryFile = "countryInfo.txt"
+)
+
+type (
+	// offlineGeocoder looks up the nearest known place with a k-d tree built on places' coordinates
+	// projected onto unit sphere, so that euclidean nearest neighbor is also the great-circle one.
+	offlineGeocoder struct {
+		places []geoPlace
+	}
+
+	geoPlace struct {
+		pos     [3]float64
+		city    string
+		region  string
+		country string
+	}
+)
+
+var (
+	// Loaded dataset is shared by extractors, it is only reloaded if the dataset file is changed.
+	offlineGeocoderCache struct {
+		sync.Mutex
+		path     string
+		modTime  time.Time
+		size     int64
+		geocoder *offlineGeocoder
+	}
+)
+
+// loadOfflineGeocoder loads and indexes places in dataset file. Two formats are supported:
+//   - GeoNames cities dump (e.g. cities500.txt), region and country names are read from
+//     admin1CodesASCII.txt and countryInfo.txt in the same folder if present;
+//   - CSV with columns latitude, longitude, city, region, country, with an optional header row.
+func loadOfflineGeocoder(path string) (*offlineGeocoder, error) {
+	stat, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat dataset: %w", err)
+	}
+
+	offlineGeocoderCache.Lock()
+	defer offlineGeocoderCache.Unlock()
+	if offlineGeocoderCache.geocoder != nil && offlineGeocoderCache.path == path &&
+		offlineGeocoderCache.modTime.Equal(stat.ModTime()) && offlineGeocoderCache.size == stat.Size() {
+		return offlineGeocoderCache.geocoder, nil
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open dataset: %w", err)
+	}
+	defer f.Close()
+
+	reader := bufio.NewReader(f)
+	firstLine, err := reader.Peek(4096)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, bufio.ErrBufferFull) {
+		return nil, fmt.Errorf("failed to read dataset: %w", err)
+	}
+
+	if i := strings.IndexByte(string(firstLine), '\n'); i >= 0 {
+		firstLine = firstLine[:i]
+	}
+
+	var places []geoPlace
+	if len(strings.Split(string(firstLine), "\t")) >= geoNamesColumns {
+		places, err = parseGeoNames(reader, filepath.Dir(path))
+	} else {
+		places, err = parseGeoCSV(reader)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(places) == 0 {
+		return nil, fmt.Errorf("no place found in dataset")
+	}
+
+	geocoder := newOfflineGeocoder(places)
+	offlineGeocoderCache.path = path
+	offlineGeocoderCache.modTime = stat.ModTime()
+	offlineGeocoderCache.size = stat.Size()
+	offlineGeocoderCache.geocoder = geocoder
+	return geocoder, nil
+}
+
+func parseGeoNames(r io.Reader, dir string) ([]geoPlace, error) {
+	countries := readGeoNamesTable(filepath.Join(dir, geoNamesCountryFile), 0, 4)
+	regions := readGeoNamesTable(filepath.Join(dir, geoNamesAdmin1File), 0, 1)
+	names := make(map[string]string)
+
+	places := make([]geoPlace, 0)
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		cols := strings.Split(scanner.Text(), "\t")
+		if len(cols) < geoNamesColumns {
+			continue
+		}
+
+		lat, errLat := strconv.ParseFloat(cols[geoNamesColLat], 64)
+		lng, errLng := strconv.ParseFloat(cols[geoNamesColLng], 64)
+		if errLat != nil || errLng != nil {
+			continue
+		}
+
+		countryCode := cols[geoNamesColCountryCode]
+		country, ok := countries[countryCode]
+		if !ok {
+			country = countryCode
+		}
+
+		region := regions[countryCode+"."+cols[geoNamesColAdmin1Code]]
+		places = append(places, newGeoPlace(lat, lng, cols[geoNamesColName], intern(names, region), intern(names, country)))
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read GeoNames dataset: %w", err)
+	}
+
+	return places, nil
+}
+
+// readGeoNamesTable reads a tab separated GeoNames table into a map, missing table is ignored.
+func readGeoNamesTable(path string, keyCol, valueCol int) map[string]string {
+	res := make(map[string]string)
+	f, err := os.Open(path)
+	if err != nil {
+		return res
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		cols := strings.Split(line, "\t")
+		if len(cols) > keyCol && len(cols) > valueCol {
+			res[cols[keyCol]] = cols[valueCol]
+		}
+	}
+
+	return res
+}
+
+func parseGeoCSV(r io.Reader) ([]geoPlace, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	names := make(map[string]string)
+
+	places := make([]geoPlace, 0)
+	for line := 0; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CSV dataset: %w", err)
+		}
+
+		if len(record) < 5 {
+			return nil, fmt.Errorf("invalid CSV dataset at line %d: expect 5 columns", line+1)
+		}
+
+		lat, errLat := strconv.ParseFloat(record[0], 64)
+		lng, errLng := strconv.ParseFloat(record[1], 64)
+		if errLat != nil || errLng != nil {
+			if line == 0 {
+				// Header row
+				continue
+			}
+
+			return nil, fmt.Errorf("invalid coordinate in CSV dataset at line %d", line+1)
+		}
+
+		places = append(places, newGeoPlace(lat, lng, record[2], intern(names, record[3]), intern(names, record[4])))
+	}
+
+	return places, nil
+}
+
+func newGeoPlace(lat, lng float64, city, region, country string) geoPlace {
+	return geoPlace{
+		pos:     sphericalToCartesian(lat, lng),
+		city:    city,
+		region:  region,
+		country: country,
+	}
+}
+
+func newOfflineGeocoder(places []geoPlace) *offlineGeocoder {
+	buildGeoTree(places, 0)
+	return &offlineGeocoder{places: places}
+}
+
+// buildGeoTree sorts places in place into an implicit k-d tree, whose root of each range is the median.
+func buildGeoTree(places []geoPlace, depth int) {
+	if len(places) <= 1 {
+		return
+	}
+
+	axis := depth % 3
+	sort.Slice(places, func(i, j int) bool {
+		return places[i].pos[axis] < places[j].pos[axis]
+	})
+
+	mid := len(places) / 2
+	buildGeoTree(places[:mid], depth+1)
+	buildGeoTree(places[mid+1:], depth+1)
+}
+
+// Reverse returns the nearest place of given coordinate, or nil if no place is close enough.
+func (g *offlineGeocoder) Reverse(lat, lng float64) *geoPlace {
+	target := sphericalToCartesian(lat, lng)
+	maxChord := 2 * math.Sin(offlineGeocodingMaxDistance/earthRadiusKm/2)
+
+	var best *geoPlace
+	bestDist := maxChord * maxChord
+	var search func(places []geoPlace, depth int)
+	search = func(places []geoPlace, depth int) {
+		if len(places) == 0 {
+			return
+		}
+
+		mid := len(places) / 2
+		node := &places[mid]
+		if d := squaredDistance(node.pos, target); d <= bestDist {
+			best, bestDist = node, d
+		}
+
+		axis := depth % 3
+		diff := target[axis] - node.pos[axis]
+		near, far := places[:mid], places[mid+1:]
+		if diff > 0 {
+			near, far = far, near
+		}
+
+		search(near, depth+1)
+		if diff*diff <= bestDist {
+			search(far, depth+1)
+		}
+	}
+
+	search(g.places, 0)
+	return best
+}
+
+func (g *offlineGeocoder) getGeocoding(lat, lng float64) []driver.MediaMeta {
+	place := g.Reverse(lat, lng)
+	if place == nil {
+		return nil
+	}
+
+	metas := make([]driver.MediaMeta, 0, 3)
+	if place.city != "" {
+		metas = append(metas, driver.MediaMeta{Key: Place, Value: place.city})
+	}
+	if place.region != "" {
+		metas = append(metas, driver.MediaMeta{Key: Region, Value: place.region})
+	}
+	if place.country != "" {
+		metas = append(metas, driver.MediaMeta{Key: Country, Value: place.country})
+	}
+
+	return metas
+}
+
+func sphericalToCartesian(lat, lng float64) [3]float64 {
+	latRad, lngRad := lat*math.Pi/180, lng*math.Pi/180
+	return [3]float64{
+		math.Cos(latRad) * math.Cos(lngRad),
+		math.Cos(latRad) * math.Sin(lngRad),
+		math.Sin(latRad),
+	}
+}
+
+func squaredDistance(a, b [3]float64) float64 {
+	dx, dy, dz := a[0]-b[0], a[1]-b[1], a[2]-b[2]
+	return dx*dx + dy*dy + dz*dz
+}
+
+// intern deduplicates repeated region and country names.
+func intern(names map[string]string, s string) string {
+	if v, ok := names[s]; ok {
+		return v
+	}
+
+	names[s] = s
+	return s
+}
@@ -0,0 +1,104 @@
+package mediameta
+
+import (
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestOfflineGeocoderReverse(t *testing.T) {
+	places, err := parseGeoCSV(strings.NewReader(`latitude,longitude,city,region,country
+48.8566,2.3522,Paris,Ile-de-France,France
+51.5072,-0.1276,London,England,United Kingdom
+35.6762,139.6503,Tokyo,Tokyo,Japan
+-33.8688,151.2093,Sydney,New South Wales,Australia
+64.1466,-21.9426,Reykjavik,Capital Region,Iceland
+21.3069,-157.8583,Honolulu,Hawaii,United States
+-36.8485,174.7633,Auckland,Auckland,New Zealand
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	g := newOfflineGeocoder(places)
+	cases := map[[2]float64]string{
+		{48.80, 2.13}:     "Paris",
+		{51.45, -0.30}:    "London",
+		{35.44, 139.64}:   "Tokyo",
+		{21.5, -158.0}:    "Honolulu",
+		{-36.9, 174.9}:    "Auckland",
+		{-36.9, -179.9}:   "",
+		{0, 0}:            "",
+		{64.0, -22.5}:     "Reykjavik",
+		{-33.95, 151.18}:  "Sydney",
+		{48.85, 2.35}:     "Paris",
+		{51.5072, -0.128}: "London",
+	}
+	for coord, want := range cases {
+		place := g.Reverse(coord[0], coord[1])
+		got := ""
+		if place != nil {
+			got = place.city
+		}
+		if got != want {
+			t.Errorf("Reverse(%v) = %q, want %q", coord, got, want)
+		}
+	}
+}
+
+func TestOfflineGeocoderMatchesBruteForce(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	places := make([]geoPlace, 2000)
+	for i := range places {
+		places[i] = newGeoPlace(r.Float64()*180-90, r.Float64()*360-180, "", "", "")
+		places[i].city = string(rune('a'+i%26)) + strings.Repeat("x", i/26)
+	}
+
+	g := newOfflineGeocoder(places)
+	for i := 0; i < 500; i++ {
+		lat, lng := r.Float64()*180-90, r.Float64()*360-180
+		target := sphericalToCartesian(lat, lng)
+
+		var want *geoPlace
+		for j := range g.places {
+			if want == nil || squaredDistance(g.places[j].pos, target) < squaredDistance(want.pos, target) {
+				want = &g.places[j]
+			}
+		}
+
+		got := g.Reverse(lat, lng)
+		if d := squaredDistance(want.pos, target); got == nil {
+			if d < 0.0002 {
+				t.Fatalf("Reverse(%f, %f) found nothing, want %q", lat, lng, want.city)
+			}
+		} else if squaredDistance(got.pos, target) != d {
+			t.Fatalf("Reverse(%f, %f) = %q, want %q", lat, lng, got.city, want.city)
+		}
+	}
+}
+
+func TestLoadOfflineGeocoderGeoNames(t *testing.T) {
+	dir := t.TempDir()
+	cities := "2988507\tParis\tParis\t\t48.85341\t2.3488\tP\tPPLC\tFR\t\t11\t75\t751\t75056\t2138551\t\t42\tEurope/Paris\t2024-01-01\n"
+	if err := os.WriteFile(filepath.Join(dir, "cities500.txt"), []byte(cities), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, geoNamesAdmin1File), []byte("FR.11\tÎle-de-France\tIle-de-France\t3012874\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, geoNamesCountryFile), []byte("#ISO\tISO3\tISO-Numeric\tfips\tCountry\nFR\tFRA\t250\tFR\tFrance\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	g, err := loadOfflineGeocoder(filepath.Join(dir, "cities500.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	metas := g.getGeocoding(48.86, 2.34)
+	if len(metas) != 3 || metas[0].Value != "Paris" || metas[1].Value != "Île-de-France" || metas[2].Value != "France" {
+		t.Fatalf("unexpected metas %+v", metas)
+	}
+}
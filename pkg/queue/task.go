@@ -104,9 +104,15 @@ const (
 	RelocateTaskType              = "relocate"
 	RemoteDownloadTaskType        = "remote_download"
 	ImportTaskType                = "import"
+	SnapshotRestoreTaskType       = "snapshot_restore"
+	DuplicateFinderTaskType       = "duplicate_finder"
+	TranscodeTaskType             = "transcode"
+	MediaRegenerateTaskType       = "media_regenerate"
+	UsageScanTaskType             = "usage_scan"
 
 	SlaveCreateArchiveTaskType = "slave_create_archive"
 	SlaveUploadTaskType        = "slave_upload"
+	SlaveTranscodeTaskType     = "slave_transcode"
 	SlaveExtractArchiveType    = "slave_extract_archive"
 )
 
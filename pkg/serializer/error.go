@@ -255,6 +255,8 @@ const (
 	CodeDomainNotLicensed = 40087
 	// CodeAnonymouseAccessDenied 匿名用户无法访问分享
 	CodeAnonymouseAccessDenied = 40088
+	// CodeChecksumMismatch checksum of uploaded data mismatch
+	CodeChecksumMismatch = 40089
 	// CodeDBError 数据库操作失败
 	CodeDBError = 50001
 	// CodeEncryptError 加密失败
@@ -98,6 +98,10 @@ type (
 		MediaMetaMusicSizeLimit(ctx context.Context) (int64, int64)
 		// MediaMetaFFProbeEnabled returns true if media meta ffprobe is enabled.
 		MediaMetaFFProbeEnabled(ctx context.Context) bool
+		// MediaMetaDocumentEnabled returns true if media meta document is enabled.
+		MediaMetaDocumentEnabled(ctx context.Context) bool
+		// MediaMetaDocumentSizeLimit returns the size limit of media meta document. first return value is for local sources;
+		MediaMetaDocumentSizeLimit(ctx context.Context) (int64, int64)
 		// MediaMetaFFProbeSizeLimit returns the size limit of media meta ffprobe. first return value is for local sources;
 		MediaMetaFFProbeSizeLimit(ctx context.Context) (int64, int64)
 		// MediaMetaFFProbePath returns the path of ffprobe executable.
@@ -106,7 +110,12 @@ type (
 		MediaMetaGeocodingEnabled(ctx context.Context) bool
 		// MediaMetaGeocodingMapboxAK returns the Mapbox access token.
 		MediaMetaGeocodingMapboxAK(ctx context.Context) string
-		// ThumbSize returns the size limit of thumbnails.
+		// MediaMetaGeocodingProvider returns the provider used for reverse geocoding.
+		MediaMetaGeocodingProvider(ctx context.Context) GeocodingProvider
+		// MediaMetaGeocodingOfflineDataset returns the path of dataset used by offline geocoder, relative
+		// paths are resolved against data folder.
+		MediaMetaGeocodingOfflineDataset(ctx context.Context) string
+		// ThumbSize returns the size limit of thumbnails, of the variant specified in context.
 		ThumbSize(ctx context.Context) (int, int)
 		// ThumbEncode returns the thumbnail encoding settings.
 		ThumbEncode(ctx context.Context) *ThumbEncode
@@ -114,6 +123,8 @@ type (
 		BuiltinThumbGeneratorEnabled(ctx context.Context) bool
 		// BuiltinThumbMaxSize returns the maximum size of builtin thumb generator.
 		BuiltinThumbMaxSize(ctx context.Context) int64
+		// BuiltinTextThumbExts returns extensions of text files rendered by builtin thumb generator.
+		BuiltinTextThumbExts(ctx context.Context) []string
 		// TempPath returns the path of temporary directory.
 		TempPath(ctx context.Context) string
 		// ThumbEntitySuffix returns the suffix of entity thumbnails.
@@ -132,6 +143,18 @@ type (
 		FFMpegThumbSeek(ctx context.Context) string
 		// FFMpegThumbMaxSize returns the maximum size of ffmpeg thumb generator.
 		FFMpegThumbMaxSize(ctx context.Context) int64
+		// FFMpegStoryboardEnabled returns true if video storyboard generation is enabled.
+		FFMpegStoryboardEnabled(ctx context.Context) bool
+		// FFMpegStoryboardOnUpload returns true if storyboards should be generated once new videos are uploaded.
+		FFMpegStoryboardOnUpload(ctx context.Context) bool
+		// FFMpegStoryboardMaxSize returns the maximum size of videos to generate storyboard for.
+		FFMpegStoryboardMaxSize(ctx context.Context) int64
+		// FFMpegStoryboardFrames returns the number of frames extracted into a storyboard.
+		FFMpegStoryboardFrames(ctx context.Context) int
+		// FFMpegStoryboardTile returns the tile width of each frame and the number of columns in a storyboard.
+		FFMpegStoryboardTile(ctx context.Context) (int, int)
+		// StoryboardEntitySuffix returns the suffix of storyboard entities.
+		StoryboardEntitySuffix(ctx context.Context) string
 		// VipsThumbGeneratorEnabled returns true if vips thumb generator is enabled.
 		VipsThumbGeneratorEnabled(ctx context.Context) bool
 		// VipsThumbExts returns the supported extensions of vips thumb generator.
@@ -154,6 +177,12 @@ type (
 		MusicCoverThumbMaxSize(ctx context.Context) int64
 		// MusicCoverThumbExts returns the supported extensions of music cover thumb generator.
 		MusicCoverThumbExts(ctx context.Context) []string
+		// EpubCoverThumbGeneratorEnabled returns true if EPUB cover thumb generator is enabled.
+		EpubCoverThumbGeneratorEnabled(ctx context.Context) bool
+		// EpubCoverThumbMaxSize returns the maximum size of EPUB cover thumb generator.
+		EpubCoverThumbMaxSize(ctx context.Context) int64
+		// EpubCoverThumbExts returns the supported extensions of EPUB cover thumb generator.
+		EpubCoverThumbExts(ctx context.Context) []string
 		// Cron returns the crontab settings.
 		Cron(ctx context.Context, t CronType) string
 		// Theme returns the theme settings.
@@ -208,6 +237,8 @@ type (
 		CustomHTML(ctx context.Context) *CustomHTML
 		// FFMpegExtraArgs returns the extra arguments of ffmpeg thumb generator.
 		FFMpegExtraArgs(ctx context.Context) string
+		// Transcode returns the video transcoding settings.
+		Transcode(ctx context.Context) *Transcode
 		// MasterEncryptKey returns the master encrypt key.
 		MasterEncryptKey(ctx context.Context) []byte
 		// MasterEncryptKeyVault returns the master encrypt key vault type.
@@ -218,6 +249,7 @@ type (
 		ShowEncryptionStatus(ctx context.Context) bool
 	}
 	UseFirstSiteUrlCtxKey = struct{}
+	ThumbVariantCtxKey    = struct{}
 )
 
 // NewProvider creates a new setting provider.
@@ -414,6 +446,10 @@ func (s *settingProvider) BuiltinThumbMaxSize(ctx context.Context) int64 {
 	return s.getInt64(ctx, "thumb_builtin_max_size", 78643200)
 }
 
+func (s *settingProvider) BuiltinTextThumbExts(ctx context.Context) []string {
+	return s.getStringList(ctx, "thumb_builtin_text_exts", []string{})
+}
+
 func (s *settingProvider) MusicCoverThumbGeneratorEnabled(ctx context.Context) bool {
 	return s.getBoolean(ctx, "thumb_music_cover_enabled", true)
 }
@@ -426,6 +462,18 @@ func (s *settingProvider) MusicCoverThumbExts(ctx context.Context) []string {
 	return s.getStringList(ctx, "thumb_music_cover_exts", []string{})
 }
 
+func (s *settingProvider) EpubCoverThumbGeneratorEnabled(ctx context.Context) bool {
+	return s.getBoolean(ctx, "thumb_epub_cover_enabled", true)
+}
+
+func (s *settingProvider) EpubCoverThumbMaxSize(ctx context.Context) int64 {
+	return s.getInt64(ctx, "thumb_epub_cover_max_size", 536870912)
+}
+
+func (s *settingProvider) EpubCoverThumbExts(ctx context.Context) []string {
+	return s.getStringList(ctx, "thumb_epub_cover_exts", []string{})
+}
+
 func (s *settingProvider) FFMpegPath(ctx context.Context) string {
 	return s.getString(ctx, "thumb_ffmpeg_path", "ffmpeg")
 }
@@ -446,10 +494,58 @@ func (s *settingProvider) FFMpegExtraArgs(ctx context.Context) string {
 	return s.getString(ctx, "thumb_ffmpeg_extra_args", "")
 }
 
+func (s *settingProvider) Transcode(ctx context.Context) *Transcode {
+	renditions := make([]TranscodeRendition, 0)
+	for _, r := range s.getStringList(ctx, "transcode_renditions", []string{"720:2800"}) {
+		var rendition TranscodeRendition
+		if _, err := fmt.Sscanf(strings.TrimSpace(r), "%d:%d", &rendition.Height, &rendition.Bitrate); err != nil ||
+			rendition.Height <= 0 || rendition.Bitrate <= 0 {
+			continue
+		}
+		renditions = append(renditions, rendition)
+	}
+
+	return &Transcode{
+		Enabled:         s.getBoolean(ctx, "transcode_enabled", false),
+		Auto:            s.getBoolean(ctx, "transcode_auto", false),
+		Exts:            s.getStringList(ctx, "transcode_exts", []string{}),
+		MaxSize:         s.getInt64(ctx, "transcode_max_size", 53687091200),
+		Renditions:      renditions,
+		SegmentDuration: s.getInt(ctx, "transcode_segment_duration", 6),
+		ExtraArgs:       s.getString(ctx, "transcode_extra_args", ""),
+		EntitySuffix:    s.getString(ctx, "transcode_entity_suffix", "{blob_path}/{blob_name}._hls"),
+		SessionTTL:      s.getInt(ctx, "transcode_session_ttl", 21600),
+	}
+}
+
 func (s *settingProvider) FFMpegThumbMaxSize(ctx context.Context) int64 {
 	return s.getInt64(ctx, "thumb_ffmpeg_max_size", 10737418240)
 }
 
+func (s *settingProvider) FFMpegStoryboardEnabled(ctx context.Context) bool {
+	return s.getBoolean(ctx, "thumb_ffmpeg_storyboard_enabled", false)
+}
+
+func (s *settingProvider) FFMpegStoryboardOnUpload(ctx context.Context) bool {
+	return s.getBoolean(ctx, "thumb_ffmpeg_storyboard_on_upload", false)
+}
+
+func (s *settingProvider) FFMpegStoryboardMaxSize(ctx context.Context) int64 {
+	return s.getInt64(ctx, "thumb_ffmpeg_storyboard_max_size", 10737418240)
+}
+
+func (s *settingProvider) FFMpegStoryboardFrames(ctx context.Context) int {
+	return s.getInt(ctx, "thumb_ffmpeg_storyboard_frames", 100)
+}
+
+func (s *settingProvider) FFMpegStoryboardTile(ctx context.Context) (int, int) {
+	return s.getInt(ctx, "thumb_ffmpeg_storyboard_tile_width", 160), s.getInt(ctx, "thumb_ffmpeg_storyboard_columns", 10)
+}
+
+func (s *settingProvider) StoryboardEntitySuffix(ctx context.Context) string {
+	return s.getString(ctx, "thumb_storyboard_entity_suffix", "{blob_path}/{blob_name}._storyboard")
+}
+
 func (s *settingProvider) VipsThumbGeneratorEnabled(ctx context.Context) bool {
 	return s.getBoolean(ctx, "thumb_vips_enabled", false)
 }
@@ -499,6 +595,12 @@ func (s *settingProvider) LibreOfficeThumbExts(ctx context.Context) []string {
 }
 
 func (s *settingProvider) ThumbSize(ctx context.Context) (int, int) {
+	switch ThumbVariantFromContext(ctx) {
+	case ThumbVariantSmall:
+		return s.getInt(ctx, "thumb_small_width", 128), s.getInt(ctx, "thumb_small_height", 128)
+	case ThumbVariantLarge:
+		return s.getInt(ctx, "thumb_large_width", 1600), s.getInt(ctx, "thumb_large_height", 1600)
+	}
 	return s.getInt(ctx, "thumb_width", 400), s.getInt(ctx, "thumb_height", 300)
 }
 
@@ -510,11 +612,11 @@ func (s *settingProvider) ThumbEncode(ctx context.Context) *ThumbEncode {
 }
 
 func (s *settingProvider) ThumbEntitySuffix(ctx context.Context) string {
-	return s.getString(ctx, "thumb_entity_suffix", "{blob_path}/{blob_name}._thumb")
+	return s.getString(ctx, "thumb_entity_suffix", "{blob_path}/{blob_name}._thumb") + ThumbVariantFromContext(ctx).Suffix()
 }
 
 func (s *settingProvider) ThumbSlaveSidecarSuffix(ctx context.Context) string {
-	return s.getString(ctx, "thumb_slave_sidecar_suffix", "._thumb_sidecar")
+	return s.getString(ctx, "thumb_slave_sidecar_suffix", "._thumb_sidecar") + ThumbVariantFromContext(ctx).Suffix()
 }
 
 func (s *settingProvider) ThumbGCAfterGen(ctx context.Context) bool {
@@ -537,6 +639,14 @@ func (s *settingProvider) MediaMetaFFProbeEnabled(ctx context.Context) bool {
 	return s.getBoolean(ctx, "media_meta_ffprobe", true)
 }
 
+func (s *settingProvider) MediaMetaDocumentSizeLimit(ctx context.Context) (int64, int64) {
+	return s.getInt64(ctx, "media_meta_document_size_local", 0), s.getInt64(ctx, "media_meta_document_size_remote", 0)
+}
+
+func (s *settingProvider) MediaMetaDocumentEnabled(ctx context.Context) bool {
+	return s.getBoolean(ctx, "media_meta_document", true)
+}
+
 func (s *settingProvider) MediaMetaMusicSizeLimit(ctx context.Context) (int64, int64) {
 	return s.getInt64(ctx, "media_meta_music_size_local", 0), s.getInt64(ctx, "media_meta_music_size_remote", 0)
 }
@@ -569,6 +679,14 @@ func (s *settingProvider) MediaMetaGeocodingMapboxAK(ctx context.Context) string
 	return s.getString(ctx, "media_meta_geocoding_mapbox_ak", "")
 }
 
+func (s *settingProvider) MediaMetaGeocodingProvider(ctx context.Context) GeocodingProvider {
+	return GeocodingProvider(s.getString(ctx, "media_meta_geocoding_provider", string(GeocodingProviderMapbox)))
+}
+
+func (s *settingProvider) MediaMetaGeocodingOfflineDataset(ctx context.Context) string {
+	return s.getString(ctx, "media_meta_geocoding_offline_dataset", "")
+}
+
 func (s *settingProvider) PublicResourceMaxAge(ctx context.Context) int {
 	return s.getInt(ctx, "public_resource_maxage", 0)
 }
@@ -623,6 +741,10 @@ func (s *settingProvider) DBFS(ctx context.Context) *DBFS {
 		MaxPageSize:                s.getInt(ctx, "max_page_size", 2000),
 		MaxRecursiveSearchedFolder: s.getInt(ctx, "max_recursive_searched_folder", 65535),
 		UseSSEForSearch:            s.getBoolean(ctx, "use_sse_for_search", false),
+		RecentFilesWindow:          time.Duration(s.getInt(ctx, "recent_files_window", 2592000)) * time.Second,
+		RecentFilesDedup:           time.Duration(s.getInt(ctx, "recent_files_dedup", 300)) * time.Second,
+		PhotosExts:                 s.getStringList(ctx, "photos_exts", []string{}),
+		MusicExts:                  s.getStringList(ctx, "music_exts", []string{}),
 	}
 }
 
@@ -897,3 +1019,16 @@ func (s *settingProvider) getBoolSet(ctx context.Context, name string) *boolset.
 func UseFirstSiteUrl(ctx context.Context) context.Context {
 	return context.WithValue(ctx, UseFirstSiteUrlCtxKey{}, true)
 }
+
+// WithThumbVariant sets the thumbnail variant that thumbnail related settings refer to.
+func WithThumbVariant(ctx context.Context, variant ThumbVariant) context.Context {
+	return context.WithValue(ctx, ThumbVariantCtxKey{}, variant)
+}
+
+// ThumbVariantFromContext returns the thumbnail variant in context, defaults to ThumbVariantMedium.
+func ThumbVariantFromContext(ctx context.Context) ThumbVariant {
+	if v, ok := ctx.Value(ThumbVariantCtxKey{}).(ThumbVariant); ok && v != "" {
+		return v
+	}
+	return ThumbVariantMedium
+}
@@ -4,6 +4,13 @@ import (
 	"time"
 )
 
+type GeocodingProvider string
+
+const (
+	GeocodingProviderMapbox  = GeocodingProvider("mapbox")
+	GeocodingProviderOffline = GeocodingProvider("offline")
+)
+
 type PWASetting struct {
 	SmallIcon       string
 	MediumIcon      string
@@ -77,6 +84,14 @@ type DBFS struct {
 	MaxPageSize                int
 	MaxRecursiveSearchedFolder int
 	UseSSEForSearch            bool
+	// RecentFilesWindow is how long file activities are kept and listed in recent files.
+	RecentFilesWindow time.Duration
+	// RecentFilesDedup is the minimum interval between two records of the same activity on a file.
+	RecentFilesDedup time.Duration
+	// PhotosExts is the extensions of image and video files listed in photos file system.
+	PhotosExts []string
+	// MusicExts is the extensions of audio files indexed in music library.
+	MusicExts []string
 }
 
 type (
@@ -91,11 +106,50 @@ type (
 	}
 )
 
+type (
+	// Transcode is the setting of video transcoding into HLS renditions.
+	Transcode struct {
+		Enabled         bool
+		Auto            bool
+		Exts            []string
+		MaxSize         int64
+		Renditions      []TranscodeRendition
+		SegmentDuration int
+		ExtraArgs       string
+		EntitySuffix    string
+		SessionTTL      int
+	}
+
+	// TranscodeRendition is a target rendition, configured as "<height>:<video bitrate in kbps>".
+	TranscodeRendition struct {
+		Height  int `json:"height"`
+		Bitrate int `json:"bitrate"`
+	}
+)
+
 type ThumbEncode struct {
 	Quality int
 	Format  string
 }
 
+// ThumbVariant is the size variant of thumbnails.
+type ThumbVariant string
+
+const (
+	ThumbVariantSmall  = ThumbVariant("small")
+	ThumbVariantMedium = ThumbVariant("medium")
+	ThumbVariantLarge  = ThumbVariant("large")
+)
+
+// Suffix returns the suffix appended to thumbnail entity paths of this variant. Medium variant
+// keeps the original path for compatibility.
+func (v ThumbVariant) Suffix() string {
+	if v == "" || v == ThumbVariantMedium {
+		return ""
+	}
+	return "_" + string(v)
+}
+
 var (
 	QueueTypeMediaMeta      = QueueType("media_meta")
 	QueueTypeIOIntense      = QueueType("io_intense")
@@ -103,6 +157,7 @@ var (
 	QueueTypeEntityRecycle  = QueueType("recycle")
 	QueueTypeSlave          = QueueType("slave")
 	QueueTypeRemoteDownload = QueueType("remote_download")
+	QueueTypeTranscode      = QueueType("transcode")
 )
 
 type CronType string
@@ -111,6 +166,11 @@ var (
 	CronTypeEntityCollect    = CronType("entity_collect")
 	CronTypeTrashBinCollect  = CronType("trash_bin_collect")
 	CronTypeOauthCredRefresh = CronType("oauth_cred_refresh")
+	CronTypeVersionRetention = CronType("version_retention")
+	CronTypeFolderSnapshot   = CronType("folder_snapshot")
+	CronTypeRecentFiles      = CronType("recent_files")
+	CronTypeUsageReport      = CronType("usage_report")
+	CronTypeFeedPoll         = CronType("feed_poll")
 )
 
 type Theme struct {
@@ -0,0 +1,239 @@
+// Package subsonic defines payloads of the Subsonic REST API, with OpenSubsonic additions.
+// See https://opensubsonic.netlify.app/docs/
+package subsonic
+
+import (
+	"encoding/xml"
+	"net/http"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/constants"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// Version is the Subsonic API version implemented.
+	Version    = "1.16.1"
+	ServerType = "cloudreve"
+	Namespace  = "http://subsonic.org/restapi"
+
+	StatusOK     = "ok"
+	StatusFailed = "failed"
+
+	FormatQuery   = "f"
+	FormatJson    = "json"
+	FormatJsonp   = "jsonp"
+	CallbackQuery = "callback"
+
+	// MusicFolderID is the ID of the only music folder, which contains all audio files of user.
+	MusicFolderID   = 1
+	MusicFolderName = "Music"
+)
+
+// Error codes defined by Subsonic API.
+const (
+	ErrGeneric           = 0
+	ErrMissingParameter  = 10
+	ErrClientTooOld      = 20
+	ErrServerTooOld      = 30
+	ErrWrongCredentials  = 40
+	ErrTokenNotSupported = 41
+	ErrAuthNotSupported  = 42
+	ErrNotAuthorized     = 50
+	ErrTrialExpired      = 60
+	ErrNotFound          = 70
+)
+
+type (
+	// Response is the root element of all responses. Only one of the payload fields is set.
+	Response struct {
+		XMLName       xml.Name `xml:"subsonic-response" json:"-"`
+		Xmlns         string   `xml:"xmlns,attr" json:"-"`
+		Status        string   `xml:"status,attr" json:"status"`
+		Version       string   `xml:"version,attr" json:"version"`
+		Type          string   `xml:"type,attr" json:"type"`
+		ServerVersion string   `xml:"serverVersion,attr" json:"serverVersion"`
+		OpenSubsonic  bool     `xml:"openSubsonic,attr" json:"openSubsonic"`
+
+		Error                  *Error                  `xml:"error,omitempty" json:"error,omitempty"`
+		License                *License                `xml:"license,omitempty" json:"license,omitempty"`
+		OpenSubsonicExtensions []OpenSubsonicExtension `xml:"openSubsonicExtensions,omitempty" json:"openSubsonicExtensions,omitempty"`
+		MusicFolders           *MusicFolders           `xml:"musicFolders,omitempty" json:"musicFolders,omitempty"`
+		Indexes                *Indexes                `xml:"indexes,omitempty" json:"indexes,omitempty"`
+		Artists                *Artists                `xml:"artists,omitempty" json:"artists,omitempty"`
+		Artist                 *ArtistWithAlbums       `xml:"artist,omitempty" json:"artist,omitempty"`
+		Album                  *AlbumWithSongs         `xml:"album,omitempty" json:"album,omitempty"`
+		Song                   *Child                  `xml:"song,omitempty" json:"song,omitempty"`
+		AlbumList2             *AlbumList              `xml:"albumList2,omitempty" json:"albumList2,omitempty"`
+		RandomSongs            *Songs                  `xml:"randomSongs,omitempty" json:"randomSongs,omitempty"`
+		SearchResult3          *SearchResult           `xml:"searchResult3,omitempty" json:"searchResult3,omitempty"`
+		Playlists              *Playlists              `xml:"playlists,omitempty" json:"playlists,omitempty"`
+		Playlist               *PlaylistWithSongs      `xml:"playlist,omitempty" json:"playlist,omitempty"`
+	}
+
+	Error struct {
+		Code    int    `xml:"code,attr" json:"code"`
+		Message string `xml:"message,attr" json:"message"`
+	}
+
+	License struct {
+		Valid bool `xml:"valid,attr" json:"valid"`
+	}
+
+	OpenSubsonicExtension struct {
+		Name     string `xml:"name,attr" json:"name"`
+		Versions []int  `xml:"versions" json:"versions"`
+	}
+
+	MusicFolders struct {
+		Folders []MusicFolder `xml:"musicFolder" json:"musicFolder"`
+	}
+
+	MusicFolder struct {
+		ID   int    `xml:"id,attr" json:"id"`
+		Name string `xml:"name,attr" json:"name"`
+	}
+
+	Indexes struct {
+		LastModified    int64   `xml:"lastModified,attr" json:"lastModified"`
+		IgnoredArticles string  `xml:"ignoredArticles,attr" json:"ignoredArticles"`
+		Index           []Index `xml:"index" json:"index"`
+	}
+
+	Artists struct {
+		IgnoredArticles string  `xml:"ignoredArticles,attr" json:"ignoredArticles"`
+		Index           []Index `xml:"index" json:"index"`
+	}
+
+	Index struct {
+		Name    string   `xml:"name,attr" json:"name"`
+		Artists []Artist `xml:"artist" json:"artist"`
+	}
+
+	Artist struct {
+		ID         string `xml:"id,attr" json:"id"`
+		Name       string `xml:"name,attr" json:"name"`
+		CoverArt   string `xml:"coverArt,attr,omitempty" json:"coverArt,omitempty"`
+		AlbumCount int    `xml:"albumCount,attr" json:"albumCount"`
+	}
+
+	ArtistWithAlbums struct {
+		Artist
+		Albums []Album `xml:"album" json:"album"`
+	}
+
+	Album struct {
+		ID        string    `xml:"id,attr" json:"id"`
+		Name      string    `xml:"name,attr" json:"name"`
+		Artist    string    `xml:"artist,attr,omitempty" json:"artist,omitempty"`
+		ArtistID  string    `xml:"artistId,attr,omitempty" json:"artistId,omitempty"`
+		CoverArt  string    `xml:"coverArt,attr,omitempty" json:"coverArt,omitempty"`
+		SongCount int       `xml:"songCount,attr" json:"songCount"`
+		Duration  int       `xml:"duration,attr" json:"duration"`
+		Created   time.Time `xml:"created,attr" json:"created"`
+		Year      int       `xml:"year,attr,omitempty" json:"year,omitempty"`
+		Genre     string    `xml:"genre,attr,omitempty" json:"genre,omitempty"`
+	}
+
+	AlbumWithSongs struct {
+		Album
+		Songs []Child `xml:"song" json:"song"`
+	}
+
+	AlbumList struct {
+		Albums []Album `xml:"album" json:"album"`
+	}
+
+	Songs struct {
+		Songs []Child `xml:"song" json:"song"`
+	}
+
+	// Child is a song or a directory, only songs are used in Cloudreve.
+	Child struct {
+		ID          string    `xml:"id,attr" json:"id"`
+		Parent      string    `xml:"parent,attr,omitempty" json:"parent,omitempty"`
+		IsDir       bool      `xml:"isDir,attr" json:"isDir"`
+		Title       string    `xml:"title,attr" json:"title"`
+		Album       string    `xml:"album,attr,omitempty" json:"album,omitempty"`
+		Artist      string    `xml:"artist,attr,omitempty" json:"artist,omitempty"`
+		Track       int       `xml:"track,attr,omitempty" json:"track,omitempty"`
+		Year        int       `xml:"year,attr,omitempty" json:"year,omitempty"`
+		Genre       string    `xml:"genre,attr,omitempty" json:"genre,omitempty"`
+		CoverArt    string    `xml:"coverArt,attr,omitempty" json:"coverArt,omitempty"`
+		Size        int64     `xml:"size,attr" json:"size"`
+		ContentType string    `xml:"contentType,attr,omitempty" json:"contentType,omitempty"`
+		Suffix      string    `xml:"suffix,attr,omitempty" json:"suffix,omitempty"`
+		Path        string    `xml:"path,attr,omitempty" json:"path,omitempty"`
+		DiscNumber  int       `xml:"discNumber,attr,omitempty" json:"discNumber,omitempty"`
+		Created     time.Time `xml:"created,attr" json:"created"`
+		AlbumID     string    `xml:"albumId,attr,omitempty" json:"albumId,omitempty"`
+		ArtistID    string    `xml:"artistId,attr,omitempty" json:"artistId,omitempty"`
+		Type        string    `xml:"type,attr" json:"type"`
+	}
+
+	SearchResult struct {
+		Artists []Artist `xml:"artist" json:"artist"`
+		Albums  []Album  `xml:"album" json:"album"`
+		Songs   []Child  `xml:"song" json:"song"`
+	}
+
+	Playlists struct {
+		Playlists []Playlist `xml:"playlist" json:"playlist"`
+	}
+
+	Playlist struct {
+		ID        string    `xml:"id,attr" json:"id"`
+		Name      string    `xml:"name,attr" json:"name"`
+		Comment   string    `xml:"comment,attr,omitempty" json:"comment,omitempty"`
+		Owner     string    `xml:"owner,attr" json:"owner"`
+		Public    bool      `xml:"public,attr" json:"public"`
+		SongCount int       `xml:"songCount,attr" json:"songCount"`
+		Duration  int       `xml:"duration,attr" json:"duration"`
+		Created   time.Time `xml:"created,attr" json:"created"`
+		Changed   time.Time `xml:"changed,attr" json:"changed"`
+	}
+
+	PlaylistWithSongs struct {
+		Playlist
+		Entries []Child `xml:"entry" json:"entry"`
+	}
+)
+
+// NewResponse creates a successful response.
+func NewResponse() *Response {
+	return &Response{
+		Xmlns:         Namespace,
+		Status:        StatusOK,
+		Version:       Version,
+		Type:          ServerType,
+		ServerVersion: constants.BackendVersion,
+		OpenSubsonic:  true,
+	}
+}
+
+// NewErrorResponse creates a failed response with given error code.
+func NewErrorResponse(code int, msg string) *Response {
+	res := NewResponse()
+	res.Status = StatusFailed
+	res.Error = &Error{Code: code, Message: msg}
+	return res
+}
+
+// Write writes the response in format requested by client. Subsonic clients expect HTTP 200 even
+// for failed requests.
+func Write(c *gin.Context, res *Response) {
+	switch c.Request.FormValue(FormatQuery) {
+	case FormatJson:
+		c.JSON(http.StatusOK, gin.H{"subsonic-response": res})
+	case FormatJsonp:
+		c.JSONP(http.StatusOK, gin.H{"subsonic-response": res})
+	default:
+		c.XML(http.StatusOK, res)
+	}
+}
+
+// Abort writes a failed response and stops the handler chain.
+func Abort(c *gin.Context, code int, msg string) {
+	Write(c, NewErrorResponse(code, msg))
+	c.Abort()
+}
@@ -0,0 +1,64 @@
+package subsonic
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestWrite(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	res := NewResponse()
+	res.Album = &AlbumWithSongs{
+		Album: Album{ID: "al-1", Name: "Album", SongCount: 1},
+		Songs: []Child{{ID: "song", Title: "Song", Type: "music"}},
+	}
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest("GET", "/rest/getAlbum?f=json", nil)
+	Write(c, res)
+
+	var decoded struct {
+		Response struct {
+			Status string `json:"status"`
+			Album  struct {
+				ID    string  `json:"id"`
+				Songs []Child `json:"song"`
+			} `json:"album"`
+		} `json:"subsonic-response"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &decoded); err != nil {
+		t.Fatalf("failed to decode json response: %s", err)
+	}
+	if decoded.Response.Status != StatusOK || decoded.Response.Album.ID != "al-1" || len(decoded.Response.Album.Songs) != 1 {
+		t.Fatalf("unexpected json response: %s", w.Body.String())
+	}
+
+	w = httptest.NewRecorder()
+	c, _ = gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest("GET", "/rest/getAlbum", nil)
+	Write(c, res)
+
+	if !strings.Contains(w.Body.String(), `xmlns="`+Namespace+`"`) {
+		t.Fatalf("xml response does not declare namespace: %s", w.Body.String())
+	}
+	var decodedXml Response
+	if err := xml.Unmarshal(w.Body.Bytes(), &decodedXml); err != nil {
+		t.Fatalf("failed to decode xml response: %s", err)
+	}
+	if decodedXml.Album == nil || decodedXml.Album.ID != "al-1" || decodedXml.Album.Songs[0].Title != "Song" {
+		t.Fatalf("unexpected xml response: %s", w.Body.String())
+	}
+}
+
+func TestNewErrorResponse(t *testing.T) {
+	res := NewErrorResponse(ErrNotFound, "not found")
+	if res.Status != StatusFailed || res.Error == nil || res.Error.Code != ErrNotFound {
+		t.Fatalf("unexpected error response: %+v", res)
+	}
+}
@@ -1,6 +1,7 @@
 package thumb
 
 import (
+	"compress/gzip"
 	"context"
 	"fmt"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager/entitysource"
@@ -14,14 +15,17 @@ import (
 	"io"
 	"path/filepath"
 	//"github.com/nfnt/resize"
+	"golang.org/x/image/bmp"
 	"golang.org/x/image/draw"
+	"golang.org/x/image/tiff"
+	"golang.org/x/image/webp"
 )
 
 const thumbTempFolder = "thumb"
 
 // BuiltinSupportedExts lists file extensions supported by the built-in
 // thumbnail generator. Extensions are lowercased and do not include the dot.
-var BuiltinSupportedExts = []string{"jpg", "jpeg", "png", "gif"}
+var BuiltinSupportedExts = []string{"jpg", "jpeg", "png", "gif", "webp", "bmp", "tif", "tiff", "ico", "cur", "svg", "svgz"}
 
 // Thumb 缩略图
 type Thumb struct {
@@ -46,6 +50,22 @@ func NewThumbFromFile(file io.Reader, ext string) (*Thumb, error) {
 		img, err = gif.Decode(file)
 	case "png":
 		img, err = png.Decode(file)
+	case "webp":
+		img, err = webp.Decode(file)
+	case "bmp":
+		img, err = bmp.Decode(file)
+	case "tif", "tiff":
+		img, err = tiff.Decode(file)
+	case "ico", "cur":
+		img, err = decodeIco(file)
+	case "svg":
+		img, err = decodeSVGLimited(file)
+	case "svgz":
+		var gz *gzip.Reader
+		if gz, err = gzip.NewReader(file); err == nil {
+			defer gz.Close()
+			img, err = decodeSVGLimited(gz)
+		}
 	default:
 		return nil, fmt.Errorf("unknown image format %q: %w", ext, ErrPassThrough)
 	}
@@ -59,6 +79,19 @@ func NewThumbFromFile(file io.Reader, ext string) (*Thumb, error) {
 	}, nil
 }
 
+// NewThumbFromText renders first lines of a text file as a thumbnail of given size.
+func NewThumbFromText(file io.Reader, width, height int) (*Thumb, error) {
+	img, err := renderText(file, width, height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render text: %w (%w)", err, ErrPassThrough)
+	}
+
+	return &Thumb{
+		src: img,
+		ext: "txt",
+	}, nil
+}
+
 // GetThumb 生成给定最大尺寸的缩略图
 func (image *Thumb) GetThumb(width, height uint) {
 	//image.src = resize.Thumbnail(width, height, image.src, resize.Lanczos3)
@@ -146,12 +179,20 @@ func (b Builtin) Generate(ctx context.Context, es entitysource.EntitySource, ext
 		return nil, fmt.Errorf("file is too big: %w", ErrPassThrough)
 	}
 
-	img, err := NewThumbFromFile(es, ext)
+	w, h := b.settings.ThumbSize(ctx)
+	var (
+		img *Thumb
+		err error
+	)
+	if util.IsInExtensionListExt(b.settings.BuiltinTextThumbExts(ctx), ext) {
+		img, err = NewThumbFromText(es, w, h)
+	} else {
+		img, err = NewThumbFromFile(es, ext)
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	w, h := b.settings.ThumbSize(ctx)
 	img.GetThumb(uint(w), uint(h))
 	tempPath := filepath.Join(
 		util.DataPath(b.settings.TempPath(ctx)),
@@ -0,0 +1,150 @@
+package thumb
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"image/color"
+	"strings"
+	"testing"
+)
+
+func TestParseSVGPath(t *testing.T) {
+	subpaths := parseSVGPath("M10-20l5.5.5H0v10zm1 1 2 2")
+	if len(subpaths) != 2 {
+		t.Fatalf("unexpected subpaths %+v", subpaths)
+	}
+
+	want := []svgPoint{{10, -20}, {15.5, -19.5}, {0, -19.5}, {0, -9.5}}
+	if len(subpaths[0].points) != len(want) || !subpaths[0].closed {
+		t.Fatalf("unexpected first subpath %+v", subpaths[0])
+	}
+	for i, p := range want {
+		if subpaths[0].points[i] != p {
+			t.Errorf("point %d: got %+v, want %+v", i, subpaths[0].points[i], p)
+		}
+	}
+
+	// Relative moveto after closepath starts from the subpath start.
+	if subpaths[1].points[0] != (svgPoint{11, -19}) || subpaths[1].points[1] != (svgPoint{13, -17}) {
+		t.Errorf("unexpected second subpath %+v", subpaths[1])
+	}
+}
+
+func TestParseSVGColor(t *testing.T) {
+	cases := map[string]color.NRGBA{
+		"#f00":                 {R: 255, A: 255},
+		"#00ff0080":            {G: 255, A: 128},
+		"rgb(0, 0, 255)":       {B: 255, A: 255},
+		"rgba(100%,0%,0%,0.5)": {R: 255, A: 127},
+		"white":                {R: 255, G: 255, B: 255, A: 255},
+		"currentColor":         {R: 1, A: 255},
+	}
+	for in, want := range cases {
+		got, ok := parseSVGColor(in, color.NRGBA{R: 1, A: 255})
+		if !ok || got != want {
+			t.Errorf("parseSVGColor(%q) = %v, %v, want %v", in, got, ok, want)
+		}
+	}
+}
+
+func TestDecodeSVG(t *testing.T) {
+	img, err := decodeSVG(strings.NewReader(`<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 10 10">
+<rect width="10" height="10" fill="#fff"/>
+<g transform="translate(5 5)"><circle r="2" style="fill: red"/></g>
+</svg>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := img.Bounds()
+	if b.Dx() != svgMaxSize/4 || b.Dy() != svgMaxSize/4 {
+		t.Fatalf("unexpected size %v", b)
+	}
+
+	if r, g, _, _ := img.At(b.Dx()/2, b.Dy()/2).RGBA(); r>>8 != 255 || g>>8 != 0 {
+		t.Errorf("center should be red, got %v", img.At(b.Dx()/2, b.Dy()/2))
+	}
+	if r, g, _, _ := img.At(2, 2).RGBA(); r>>8 != 255 || g>>8 != 255 {
+		t.Errorf("corner should be white, got %v", img.At(2, 2))
+	}
+}
+
+func TestNewThumbFromFile_SVGZ(t *testing.T) {
+	svgz := func(content string) *bytes.Buffer {
+		var buf bytes.Buffer
+		gz := gzip.NewWriter(&buf)
+		gz.Write([]byte(content))
+		gz.Close()
+		return &buf
+	}
+
+	if _, err := NewThumbFromFile(svgz(`<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 10 10"/>`), "svgz"); err != nil {
+		t.Fatalf("failed to decode svgz: %s", err)
+	}
+
+	// Decompressed markup larger than the limit is rejected.
+	bomb := svgz(`<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 10 10"><!--` +
+		strings.Repeat(" ", svgMaxFileSize) + `--></svg>`)
+	if bomb.Len() > svgMaxFileSize/100 {
+		t.Fatalf("unexpected compressed size %d", bomb.Len())
+	}
+	if _, err := NewThumbFromFile(bomb, "svgz"); err == nil || !strings.Contains(err.Error(), "exceeds") {
+		t.Errorf("expected size limit error, got %v", err)
+	}
+}
+
+func TestDecodeIco(t *testing.T) {
+	// 2x2 32-bit icon, bottom-up BGRA rows followed by AND mask.
+	var dib bytes.Buffer
+	header := make([]byte, dibInfoHeaderLen)
+	binary.LittleEndian.PutUint32(header[0:4], dibInfoHeaderLen)
+	binary.LittleEndian.PutUint32(header[4:8], 2)
+	binary.LittleEndian.PutUint32(header[8:12], 4)
+	binary.LittleEndian.PutUint16(header[12:14], 1)
+	binary.LittleEndian.PutUint16(header[14:16], 32)
+	dib.Write(header)
+	dib.Write([]byte{0, 0, 255, 255, 0, 255, 0, 255}) // bottom row: red, green
+	dib.Write([]byte{255, 0, 0, 255, 0, 0, 0, 0})     // top row: blue, transparent
+	dib.Write(make([]byte, 8))
+
+	var ico bytes.Buffer
+	ico.Write([]byte{0, 0, 1, 0, 1, 0})
+	entry := make([]byte, icoEntryLen)
+	entry[0], entry[1] = 2, 2
+	binary.LittleEndian.PutUint16(entry[6:8], 32)
+	binary.LittleEndian.PutUint32(entry[8:12], uint32(dib.Len()))
+	binary.LittleEndian.PutUint32(entry[12:16], icoHeaderLen+icoEntryLen)
+	ico.Write(entry)
+	ico.Write(dib.Bytes())
+
+	img, err := decodeIco(&ico)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[[2]int]color.NRGBA{
+		{0, 0}: {B: 255, A: 255},
+		{1, 0}: {},
+		{0, 1}: {R: 255, A: 255},
+		{1, 1}: {G: 255, A: 255},
+	}
+	for p, c := range want {
+		if got := color.NRGBAModel.Convert(img.At(p[0], p[1])); got != c {
+			t.Errorf("pixel %v: got %v, want %v", p, got, c)
+		}
+	}
+}
+
+func TestLooksLikeText(t *testing.T) {
+	if !looksLikeText([]byte("hello 世界")) {
+		t.Error("expect utf-8 text")
+	}
+	// Truncated multibyte sequence at the end
+	if !looksLikeText([]byte("hello \xe4\xb8")) {
+		t.Error("expect truncated utf-8 text")
+	}
+	if looksLikeText([]byte("PK\x03\x04\x00")) || looksLikeText([]byte("\xff\xfe\xfd abc")) {
+		t.Error("expect binary content")
+	}
+}
@@ -0,0 +1,76 @@
+package thumb
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager/entitysource"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/mediameta"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/gofrs/uuid"
+)
+
+func NewEpubCoverGenerator(l logging.Logger, settings setting.Provider) *EpubCoverGenerator {
+	return &EpubCoverGenerator{l: l, settings: settings}
+}
+
+// EpubCoverGenerator extracts the cover image of EPUB files, the image is then passed to
+// following generators to be resized.
+type EpubCoverGenerator struct {
+	l        logging.Logger
+	settings setting.Provider
+}
+
+func (v *EpubCoverGenerator) Generate(ctx context.Context, es entitysource.EntitySource, ext string, previous *Result) (*Result, error) {
+	if !util.IsInExtensionListExt(v.settings.EpubCoverThumbExts(ctx), ext) {
+		return nil, fmt.Errorf("unsupported ebook format: %w", ErrPassThrough)
+	}
+
+	if es.Entity().Size() > v.settings.EpubCoverThumbMaxSize(ctx) {
+		return nil, fmt.Errorf("file is too big: %w", ErrPassThrough)
+	}
+
+	cover, coverExt, err := mediameta.ReadEpubCover(es, es.Entity().Size())
+	if err != nil {
+		return nil, fmt.Errorf("failed to read epub cover: %w", err)
+	}
+
+	if coverExt == "" {
+		coverExt = "jpg"
+	}
+
+	tempPath := filepath.Join(
+		util.DataPath(v.settings.TempPath(ctx)),
+		thumbTempFolder,
+		fmt.Sprintf("thumb_%s.%s", uuid.Must(uuid.NewV4()).String(), coverExt),
+	)
+
+	thumbFile, err := util.CreatNestedFile(tempPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temp file: %w", err)
+	}
+
+	defer thumbFile.Close()
+
+	if _, err := thumbFile.Write(cover); err != nil {
+		return &Result{Path: tempPath}, fmt.Errorf("failed to write cover to file: %w", err)
+	}
+
+	return &Result{
+		Path:     tempPath,
+		Continue: true,
+		Cleanup:  []func(){func() { _ = os.Remove(tempPath) }},
+	}, nil
+}
+
+func (v *EpubCoverGenerator) Priority() int {
+	return 50
+}
+
+func (v *EpubCoverGenerator) Enabled(ctx context.Context) bool {
+	return v.settings.EpubCoverThumbGeneratorEnabled(ctx)
+}
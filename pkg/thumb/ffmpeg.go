@@ -48,24 +48,9 @@ func (f *FfmpegGenerator) Generate(ctx context.Context, es entitysource.EntitySo
 		return nil, fmt.Errorf("failed to create temp folder: %w", err)
 	}
 
-	input := ""
-	expire := time.Now().Add(urlTimeout)
-	if es.IsLocal() && !es.Entity().Encrypted() {
-		input = es.LocalPath(ctx)
-	} else {
-		opts := []entitysource.EntitySourceOption{
-			entitysource.WithContext(ctx),
-			entitysource.WithExpire(&expire),
-		}
-		if !es.Entity().Encrypted() {
-			opts = append(opts, entitysource.WithNoInternalProxy())
-		}
-		src, err := es.Url(ctx, opts...)
-		if err != nil {
-			return &Result{Path: tempOutputPath}, fmt.Errorf("failed to get entity url: %w", err)
-		}
-
-		input = src.Url
+	input, err := ffmpegInput(ctx, es)
+	if err != nil {
+		return &Result{Path: tempOutputPath}, err
 	}
 
 	// Invoke ffmpeg
@@ -100,6 +85,28 @@ func (f *FfmpegGenerator) Generate(ctx context.Context, es entitysource.EntitySo
 	return &Result{Path: tempOutputPath}, nil
 }
 
+// ffmpegInput returns the local path of the entity if possible, otherwise a temporary URL of it.
+func ffmpegInput(ctx context.Context, es entitysource.EntitySource) (string, error) {
+	if es.IsLocal() && !es.Entity().Encrypted() {
+		return es.LocalPath(ctx), nil
+	}
+
+	expire := time.Now().Add(urlTimeout)
+	opts := []entitysource.EntitySourceOption{
+		entitysource.WithContext(ctx),
+		entitysource.WithExpire(&expire),
+	}
+	if !es.Entity().Encrypted() {
+		opts = append(opts, entitysource.WithNoInternalProxy())
+	}
+	src, err := es.Url(ctx, opts...)
+	if err != nil {
+		return "", fmt.Errorf("failed to get entity url: %w", err)
+	}
+
+	return src.Url, nil
+}
+
 func (f *FfmpegGenerator) Priority() int {
 	return 200
 }
@@ -0,0 +1,171 @@
+package thumb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io"
+
+	"golang.org/x/image/bmp"
+)
+
+const (
+	icoHeaderLen      = 6
+	icoEntryLen       = 16
+	bmpFileHeaderLen  = 14
+	dibInfoHeaderLen  = 40
+	maxIcoEntries     = 256
+	icoPngSignature   = "\x89PNG\r\n\x1a\n"
+	icoDibCompressRGB = 0
+)
+
+type icoEntry struct {
+	width, height int
+	bitCount      int
+	size, offset  uint32
+}
+
+// decodeIco decodes the largest image in an ICO or CUR file. Images can be stored either as PNG or
+// as DIB with an additional transparency mask.
+func decodeIco(r io.Reader) (image.Image, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) < icoHeaderLen {
+		return nil, fmt.Errorf("ico: file too short")
+	}
+
+	reserved, typ := binary.LittleEndian.Uint16(data[0:2]), binary.LittleEndian.Uint16(data[2:4])
+	count := int(binary.LittleEndian.Uint16(data[4:6]))
+	if reserved != 0 || (typ != 1 && typ != 2) || count == 0 || count > maxIcoEntries ||
+		len(data) < icoHeaderLen+count*icoEntryLen {
+		return nil, fmt.Errorf("ico: invalid header")
+	}
+
+	var best *icoEntry
+	for i := 0; i < count; i++ {
+		b := data[icoHeaderLen+i*icoEntryLen:]
+		e := &icoEntry{
+			width:    int(b[0]),
+			height:   int(b[1]),
+			bitCount: int(binary.LittleEndian.Uint16(b[6:8])),
+			size:     binary.LittleEndian.Uint32(b[8:12]),
+			offset:   binary.LittleEndian.Uint32(b[12:16]),
+		}
+		// 0 means 256 pixels
+		if e.width == 0 {
+			e.width = 256
+		}
+		if e.height == 0 {
+			e.height = 256
+		}
+
+		if uint64(e.offset)+uint64(e.size) > uint64(len(data)) {
+			continue
+		}
+
+		if best == nil || e.width*e.height > best.width*best.height ||
+			(e.width*e.height == best.width*best.height && e.bitCount > best.bitCount) {
+			best = e
+		}
+	}
+
+	if best == nil {
+		return nil, fmt.Errorf("ico: no valid image entry")
+	}
+
+	payload := data[best.offset : best.offset+best.size]
+	if bytes.HasPrefix(payload, []byte(icoPngSignature)) {
+		return png.Decode(bytes.NewReader(payload))
+	}
+
+	return decodeIcoDib(payload)
+}
+
+// decodeIcoDib decodes a DIB image in ICO file, whose height in header covers both color bitmap and
+// the 1-bit AND mask.
+func decodeIcoDib(dib []byte) (image.Image, error) {
+	if len(dib) < dibInfoHeaderLen {
+		return nil, fmt.Errorf("ico: bitmap too short")
+	}
+
+	headerLen := binary.LittleEndian.Uint32(dib[0:4])
+	width := int(int32(binary.LittleEndian.Uint32(dib[4:8])))
+	height := int(int32(binary.LittleEndian.Uint32(dib[8:12]))) / 2
+	bitCount := int(binary.LittleEndian.Uint16(dib[14:16]))
+	compression := binary.LittleEndian.Uint32(dib[16:20])
+	colorUsed := int(binary.LittleEndian.Uint32(dib[32:36]))
+	if headerLen < dibInfoHeaderLen || uint32(len(dib)) < headerLen || width <= 0 || height <= 0 ||
+		compression != icoDibCompressRGB {
+		return nil, fmt.Errorf("ico: unsupported bitmap")
+	}
+
+	paletteLen := 0
+	if bitCount <= 8 {
+		if colorUsed == 0 {
+			colorUsed = 1 << bitCount
+		}
+		paletteLen = colorUsed * 4
+	}
+
+	pixelOffset := int(headerLen) + paletteLen
+	rowLen := ((width*bitCount + 31) / 32) * 4
+	maskRowLen := ((width + 31) / 32) * 4
+	if len(dib) < pixelOffset+rowLen*height {
+		return nil, fmt.Errorf("ico: bitmap data too short")
+	}
+
+	var img *image.NRGBA
+	if bitCount == 32 {
+		// 32-bit bitmaps carry their own alpha channel, which the bmp package ignores.
+		img = image.NewNRGBA(image.Rect(0, 0, width, height))
+		for y := 0; y < height; y++ {
+			row := dib[pixelOffset+(height-1-y)*rowLen:]
+			for x := 0; x < width; x++ {
+				p := row[x*4 : x*4+4]
+				img.SetNRGBA(x, y, color.NRGBA{R: p[2], G: p[1], B: p[0], A: p[3]})
+			}
+		}
+	} else {
+		// Prepend a BMP file header and fix the height, then leave it to the bmp package.
+		header := make([]byte, bmpFileHeaderLen+dibInfoHeaderLen)
+		copy(header, "BM")
+		binary.LittleEndian.PutUint32(header[2:6], uint32(len(header)+paletteLen+rowLen*height))
+		binary.LittleEndian.PutUint32(header[10:14], uint32(bmpFileHeaderLen+dibInfoHeaderLen+paletteLen))
+		copy(header[bmpFileHeaderLen:], dib[:dibInfoHeaderLen])
+		binary.LittleEndian.PutUint32(header[bmpFileHeaderLen:bmpFileHeaderLen+4], dibInfoHeaderLen)
+		binary.LittleEndian.PutUint32(header[bmpFileHeaderLen+8:bmpFileHeaderLen+12], uint32(height))
+
+		src, err := bmp.Decode(io.MultiReader(
+			bytes.NewReader(header),
+			bytes.NewReader(dib[headerLen:pixelOffset+rowLen*height]),
+		))
+		if err != nil {
+			return nil, fmt.Errorf("ico: %w", err)
+		}
+
+		img = image.NewNRGBA(src.Bounds())
+		draw.Draw(img, img.Bounds(), src, image.Point{}, draw.Src)
+
+		// Apply AND mask if present, set bits are transparent.
+		maskOffset := pixelOffset + rowLen*height
+		if len(dib) >= maskOffset+maskRowLen*height {
+			for y := 0; y < height; y++ {
+				row := dib[maskOffset+(height-1-y)*maskRowLen:]
+				for x := 0; x < width; x++ {
+					if row[x/8]&(0x80>>(x%8)) != 0 {
+						img.SetNRGBA(x, y, color.NRGBA{})
+					}
+				}
+			}
+		}
+	}
+
+	return img, nil
+}
@@ -72,6 +72,7 @@ func NewPipeline(settings setting.Provider, l logging.Logger) Generator {
 		NewVipsGenerator(l, settings),
 		NewLibreOfficeGenerator(l, settings),
 		NewMusicCoverGenerator(l, settings),
+		NewEpubCoverGenerator(l, settings),
 		NewLibRawGenerator(l, settings),
 	)
 	sort.Sort(generators)
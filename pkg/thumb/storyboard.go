@@ -0,0 +1,187 @@
+package thumb
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	_ "image/jpeg"
+	"io"
+	"math"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager/entitysource"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/gofrs/uuid"
+)
+
+const (
+	// StoryboardContentType is the MIME type of storyboard sprite sheets.
+	StoryboardContentType = "image/jpeg"
+	// minStoryboardInterval is the minimum duration in seconds between two storyboard frames.
+	minStoryboardInterval = 1.0
+)
+
+func NewStoryboardGenerator(l logging.Logger, settings setting.Provider) *StoryboardGenerator {
+	return &StoryboardGenerator{l: l, settings: settings}
+}
+
+// StoryboardGenerator extracts evenly spaced frames of a video into one sprite sheet with ffmpeg.
+type StoryboardGenerator struct {
+	l        logging.Logger
+	settings setting.Provider
+}
+
+// StoryboardResult is the generated sprite sheet and its frame layout.
+type StoryboardResult struct {
+	Path  string
+	Props types.StoryboardProps
+}
+
+func (f *StoryboardGenerator) Generate(ctx context.Context, es entitysource.EntitySource, ext string) (*StoryboardResult, error) {
+	if !f.Enabled(ctx) || !util.IsInExtensionListExt(f.settings.FFMpegThumbExts(ctx), ext) {
+		return nil, fmt.Errorf("unsupported video format: %w", ErrNotAvailable)
+	}
+
+	if es.Entity().Size() > f.settings.FFMpegStoryboardMaxSize(ctx) {
+		return nil, fmt.Errorf("file is too big: %w", ErrNotAvailable)
+	}
+
+	input, err := ffmpegInput(ctx, es)
+	if err != nil {
+		return nil, err
+	}
+
+	duration, err := f.probeDuration(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	tileWidth, columns := f.settings.FFMpegStoryboardTile(ctx)
+	tileWidth, columns = max(tileWidth, 16)/2*2, max(columns, 1)
+	count := max(f.settings.FFMpegStoryboardFrames(ctx), 1)
+	interval := duration / float64(count)
+	if interval < minStoryboardInterval {
+		interval = minStoryboardInterval
+		count = int(math.Ceil(duration / interval))
+	}
+	columns = min(columns, count)
+	rows := int(math.Ceil(float64(count) / float64(columns)))
+
+	res := &StoryboardResult{
+		Path: filepath.Join(
+			util.DataPath(f.settings.TempPath(ctx)),
+			thumbTempFolder,
+			fmt.Sprintf("storyboard_%s.jpg", uuid.Must(uuid.NewV4()).String()),
+		),
+		Props: types.StoryboardProps{
+			Interval:  interval,
+			Count:     count,
+			Columns:   columns,
+			TileWidth: tileWidth,
+		},
+	}
+
+	if err := util.CreatNestedFolder(filepath.Dir(res.Path)); err != nil {
+		return nil, fmt.Errorf("failed to create temp folder: %w", err)
+	}
+
+	args := make([]string, 0)
+	extraArgs := f.settings.FFMpegExtraArgs(ctx)
+	if extraArgs != "" {
+		args = append(args, strings.Split(extraArgs, " ")...)
+	}
+
+	args = append(args,
+		// Only decode key frames, much faster with negligible loss of accuracy for previews.
+		"-skip_frame", "nokey",
+		"-i", input,
+		"-an", "-sn",
+		"-vf", fmt.Sprintf("fps=1/%s,scale=%d:-2,tile=%dx%d",
+			strconv.FormatFloat(interval, 'f', 3, 64), tileWidth, columns, rows),
+		"-frames:v", "1",
+		"-q:v", "5",
+		"-y", res.Path,
+	)
+	cmd := exec.CommandContext(ctx, f.settings.FFMpegPath(ctx), args...)
+
+	var stdErr bytes.Buffer
+	cmd.Stderr = &stdErr
+	if err := cmd.Run(); err != nil {
+		f.l.Warning("Failed to invoke ffmpeg: %s", stdErr.String())
+		_ = os.Remove(res.Path)
+		return nil, fmt.Errorf("failed to invoke ffmpeg: %w, raw output: %s", err, stdErr.String())
+	}
+
+	// Tile height depends on aspect ratio and rotation of the video, read it from the sprite sheet.
+	sprite, err := os.Open(res.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open storyboard: %w", err)
+	}
+	defer sprite.Close()
+
+	cfg, _, err := image.DecodeConfig(sprite)
+	if err != nil {
+		_ = os.Remove(res.Path)
+		return nil, fmt.Errorf("failed to decode storyboard: %w", err)
+	}
+
+	res.Props.TileHeight = cfg.Height / rows
+	return res, nil
+}
+
+func (f *StoryboardGenerator) probeDuration(ctx context.Context, input string) (float64, error) {
+	cmd := exec.CommandContext(ctx,
+		f.settings.MediaMetaFFProbePath(ctx),
+		"-v", "error",
+		"-show_entries", "format=duration",
+		"-of", "default=noprint_wrappers=1:nokey=1",
+		input,
+	)
+
+	out, err := cmd.Output()
+	if err != nil {
+		return 0, fmt.Errorf("failed to invoke ffprobe: %w", err)
+	}
+
+	duration, err := strconv.ParseFloat(strings.TrimSpace(string(out)), 64)
+	if err != nil || duration <= 0 {
+		return 0, fmt.Errorf("invalid video duration %q: %w", out, ErrNotAvailable)
+	}
+
+	return duration, nil
+}
+
+func (f *StoryboardGenerator) Enabled(ctx context.Context) bool {
+	return f.settings.FFMpegStoryboardEnabled(ctx)
+}
+
+// WriteStoryboardVTT writes a WebVTT thumbnails track, each cue points to a frame within the sprite sheet
+// located by spriteUrl using media fragment.
+func WriteStoryboardVTT(w io.Writer, props *types.StoryboardProps, spriteUrl string) error {
+	var b strings.Builder
+	b.WriteString("WEBVTT\n")
+	columns := max(props.Columns, 1)
+	for i := 0; i < props.Count; i++ {
+		start := float64(i) * props.Interval
+		b.WriteString(fmt.Sprintf("\n%s --> %s\n%s#xywh=%d,%d,%d,%d\n",
+			vttTimestamp(start), vttTimestamp(start+props.Interval), spriteUrl,
+			(i%columns)*props.TileWidth, (i/columns)*props.TileHeight, props.TileWidth, props.TileHeight))
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// vttTimestamp formats seconds as hh:mm:ss.ttt
+func vttTimestamp(seconds float64) string {
+	ms := int64(math.Round(seconds * 1000))
+	return fmt.Sprintf("%02d:%02d:%02d.%03d", ms/3600000, ms/60000%60, ms/1000%60, ms%1000)
+}
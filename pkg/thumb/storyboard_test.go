@@ -0,0 +1,70 @@
+package thumb
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+)
+
+func TestVttTimestamp(t *testing.T) {
+	cases := map[float64]string{
+		0:          "00:00:00.000",
+		1.5:        "00:00:01.500",
+		59.9996:    "00:01:00.000",
+		61.25:      "00:01:01.250",
+		3723.004:   "01:02:03.004",
+		36000.0001: "10:00:00.000",
+	}
+	for seconds, want := range cases {
+		if got := vttTimestamp(seconds); got != want {
+			t.Errorf("vttTimestamp(%v): got %q, want %q", seconds, got, want)
+		}
+	}
+}
+
+func TestWriteStoryboardVTT(t *testing.T) {
+	props := &types.StoryboardProps{
+		Interval:   2.5,
+		Count:      5,
+		Columns:    2,
+		TileWidth:  160,
+		TileHeight: 90,
+	}
+
+	var b strings.Builder
+	if err := WriteStoryboardVTT(&b, props, "/sprite.jpg?sign=a"); err != nil {
+		t.Fatalf("failed to write vtt: %s", err)
+	}
+
+	want := `WEBVTT
+
+00:00:00.000 --> 00:00:02.500
+/sprite.jpg?sign=a#xywh=0,0,160,90
+
+00:00:02.500 --> 00:00:05.000
+/sprite.jpg?sign=a#xywh=160,0,160,90
+
+00:00:05.000 --> 00:00:07.500
+/sprite.jpg?sign=a#xywh=0,90,160,90
+
+00:00:07.500 --> 00:00:10.000
+/sprite.jpg?sign=a#xywh=160,90,160,90
+
+00:00:10.000 --> 00:00:12.500
+/sprite.jpg?sign=a#xywh=0,180,160,90
+`
+	if got := b.String(); got != want {
+		t.Errorf("unexpected vtt:\n%s\nwant:\n%s", got, want)
+	}
+
+	// Zero columns are treated as one.
+	b.Reset()
+	props = &types.StoryboardProps{Interval: 1, Count: 2, TileWidth: 16, TileHeight: 9}
+	if err := WriteStoryboardVTT(&b, props, "/sprite.jpg"); err != nil {
+		t.Fatalf("failed to write vtt: %s", err)
+	}
+	if got := b.String(); !strings.HasSuffix(got, "/sprite.jpg#xywh=0,9,16,9\n") {
+		t.Errorf("unexpected vtt with zero columns:\n%s", got)
+	}
+}
@@ -0,0 +1,993 @@
+package thumb
+
+import (
+	"encoding/xml"
+	"fmt"
+	"image"
+	"image/color"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"golang.org/x/image/colornames"
+	"golang.org/x/image/vector"
+)
+
+// SVG rasterization supports a subset of SVG that covers most icons and illustrations: basic shapes
+// and paths with solid fill and stroke, transforms and inherited presentation attributes. Gradients
+// are approximated by their first stop color. Text, filters, masks, clip paths and patterns are ignored.
+
+const (
+	// svgMaxSize is the maximum width or height of rasterized SVG images.
+	svgMaxSize     = 1024
+	svgDefaultW    = 300
+	svgDefaultH    = 150
+	svgCurveSteps  = 16
+	svgCircleSteps = 48
+	// svgMaxElements limits the number of rendered elements to avoid spending too much time on huge files.
+	svgMaxElements = 20000
+	// svgMaxFileSize limits bytes of SVG markup read, also bounds decompressed size of svgz files.
+	svgMaxFileSize = 10 * 1024 * 1024
+)
+
+type (
+	svgNode struct {
+		XMLName  xml.Name
+		Attrs    []xml.Attr `xml:",any,attr"`
+		Children []svgNode  `xml:",any"`
+	}
+
+	svgMatrix [6]float64
+
+	svgPaint struct {
+		none  bool
+		color color.NRGBA
+	}
+
+	svgStyle struct {
+		fill          svgPaint
+		stroke        svgPaint
+		color         color.NRGBA
+		strokeWidth   float64
+		opacity       float64
+		fillOpacity   float64
+		strokeOpacity float64
+		transform     svgMatrix
+		display       bool
+	}
+
+	svgPoint struct {
+		x, y float64
+	}
+
+	svgSubpath struct {
+		points []svgPoint
+		closed bool
+	}
+
+	svgRenderer struct {
+		dst       *image.RGBA
+		gradients map[string]color.NRGBA
+		elements  int
+	}
+)
+
+var svgIdentity = svgMatrix{1, 0, 0, 1, 0, 0}
+
+// decodeSVG rasterizes a SVG document.
+// decodeSVGLimited decodes an SVG image, it fails if the markup is larger than svgMaxFileSize.
+func decodeSVGLimited(r io.Reader) (image.Image, error) {
+	lr := &io.LimitedReader{R: r, N: svgMaxFileSize + 1}
+	img, err := decodeSVG(lr)
+	if lr.N <= 0 {
+		return nil, fmt.Errorf("svg: file exceeds %d bytes", svgMaxFileSize)
+	}
+
+	return img, err
+}
+
+func decodeSVG(r io.Reader) (image.Image, error) {
+	var root svgNode
+	if err := xml.NewDecoder(r).Decode(&root); err != nil {
+		return nil, fmt.Errorf("svg: failed to parse: %w", err)
+	}
+
+	if root.XMLName.Local != "svg" {
+		return nil, fmt.Errorf("svg: root element is %q", root.XMLName.Local)
+	}
+
+	attrs := svgAttrs(&root)
+	vb := parseSVGNumbers(attrs["viewBox"])
+	width, height := parseSVGLength(attrs["width"]), parseSVGLength(attrs["height"])
+	if len(vb) == 4 && vb[2] > 0 && vb[3] > 0 {
+		if width <= 0 && height <= 0 {
+			width, height = vb[2], vb[3]
+		} else if width <= 0 {
+			width = height * vb[2] / vb[3]
+		} else if height <= 0 {
+			height = width * vb[3] / vb[2]
+		}
+	} else {
+		vb = nil
+	}
+
+	if width <= 0 {
+		width = svgDefaultW
+	}
+	if height <= 0 {
+		height = svgDefaultH
+	}
+
+	// Fit into maximum size
+	scale := math.Min(1, svgMaxSize/math.Max(width, height))
+	if width < svgMaxSize/4 && height < svgMaxSize/4 {
+		// Small icons are upscaled for a sharper thumbnail.
+		scale = svgMaxSize / 4 / math.Max(width, height)
+	}
+	w, h := max(int(math.Round(width*scale)), 1), max(int(math.Round(height*scale)), 1)
+
+	base := svgMatrix{scale, 0, 0, scale, 0, 0}
+	if vb != nil {
+		// preserveAspectRatio="xMidYMid meet"
+		s := math.Min(float64(w)/vb[2], float64(h)/vb[3])
+		base = svgMatrix{s, 0, 0, s, (float64(w)-vb[2]*s)/2 - vb[0]*s, (float64(h)-vb[3]*s)/2 - vb[1]*s}
+	}
+
+	renderer := &svgRenderer{
+		dst:       image.NewRGBA(image.Rect(0, 0, w, h)),
+		gradients: make(map[string]color.NRGBA),
+	}
+	renderer.collectGradients(&root)
+
+	style := svgStyle{
+		fill:          svgPaint{color: color.NRGBA{A: 255}},
+		stroke:        svgPaint{none: true},
+		color:         color.NRGBA{A: 255},
+		strokeWidth:   1,
+		opacity:       1,
+		fillOpacity:   1,
+		strokeOpacity: 1,
+		transform:     base,
+		display:       true,
+	}
+	renderer.renderChildren(&root, renderer.applyStyle(style, attrs))
+	return renderer.dst, nil
+}
+
+func svgAttrs(n *svgNode) map[string]string {
+	attrs := make(map[string]string, len(n.Attrs))
+	for _, a := range n.Attrs {
+		// Namespace is dropped, so that xlink:href is read as href.
+		attrs[a.Name.Local] = strings.TrimSpace(a.Value)
+	}
+
+	// Inline style overrides presentation attributes
+	for _, decl := range strings.Split(attrs["style"], ";") {
+		k, v, found := strings.Cut(decl, ":")
+		if found {
+			attrs[strings.TrimSpace(k)] = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(v), "!important"))
+		}
+	}
+
+	return attrs
+}
+
+// collectGradients records first stop color of all gradients.
+func (r *svgRenderer) collectGradients(n *svgNode) {
+	refs := make(map[string]string)
+	var walk func(n *svgNode)
+	walk = func(n *svgNode) {
+		if n.XMLName.Local == "linearGradient" || n.XMLName.Local == "radialGradient" {
+			attrs := svgAttrs(n)
+			id := attrs["id"]
+			for i := range n.Children {
+				if n.Children[i].XMLName.Local != "stop" {
+					continue
+				}
+
+				stop := svgAttrs(&n.Children[i])
+				c, ok := parseSVGColor(stop["stop-color"], color.NRGBA{A: 255})
+				if !ok {
+					c = color.NRGBA{A: 255}
+				}
+				if opacity, err := strconv.ParseFloat(stop["stop-opacity"], 64); err == nil {
+					c.A = uint8(float64(c.A) * clamp01(opacity))
+				}
+				r.gradients[id] = c
+				break
+			}
+
+			if _, ok := r.gradients[id]; !ok && strings.HasPrefix(attrs["href"], "#") {
+				refs[id] = attrs["href"][1:]
+			}
+		}
+
+		for i := range n.Children {
+			walk(&n.Children[i])
+		}
+	}
+	walk(n)
+
+	// Gradients can inherit stops from others
+	for id, ref := range refs {
+		for i := 0; i < len(refs); i++ {
+			if c, ok := r.gradients[ref]; ok {
+				r.gradients[id] = c
+				break
+			}
+			if ref = refs[ref]; ref == "" {
+				break
+			}
+		}
+	}
+}
+
+func (r *svgRenderer) applyStyle(parent svgStyle, attrs map[string]string) svgStyle {
+	// Group opacity is approximated by multiplying it into descendants.
+	s := parent
+	if v, ok := attrs["color"]; ok {
+		if c, ok := parseSVGColor(v, parent.color); ok {
+			s.color = c
+		}
+	}
+	if v, ok := attrs["fill"]; ok {
+		s.fill = r.parsePaint(v, s)
+	}
+	if v, ok := attrs["stroke"]; ok {
+		s.stroke = r.parsePaint(v, s)
+	}
+	if v, ok := attrs["stroke-width"]; ok {
+		if width := parseSVGLength(v); width >= 0 {
+			s.strokeWidth = width
+		}
+	}
+	if v, err := strconv.ParseFloat(attrs["opacity"], 64); err == nil {
+		s.opacity *= clamp01(v)
+	}
+	if v, err := strconv.ParseFloat(attrs["fill-opacity"], 64); err == nil {
+		s.fillOpacity = clamp01(v)
+	}
+	if v, err := strconv.ParseFloat(attrs["stroke-opacity"], 64); err == nil {
+		s.strokeOpacity = clamp01(v)
+	}
+	if attrs["display"] == "none" || attrs["visibility"] == "hidden" {
+		s.display = false
+	}
+	if v, ok := attrs["transform"]; ok {
+		s.transform = s.transform.mul(parseSVGTransform(v))
+	}
+
+	return s
+}
+
+func (r *svgRenderer) parsePaint(v string, s svgStyle) svgPaint {
+	switch {
+	case v == "none" || v == "transparent":
+		return svgPaint{none: true}
+	case v == "currentColor":
+		return svgPaint{color: s.color}
+	case strings.HasPrefix(v, "url("):
+		ref, fallback, _ := strings.Cut(strings.TrimPrefix(v, "url("), ")")
+		if c, ok := r.gradients[strings.TrimPrefix(strings.Trim(strings.TrimSpace(ref), "'\""), "#")]; ok {
+			return svgPaint{color: c}
+		}
+		// Unknown paint server, try fallback color
+		if c, ok := parseSVGColor(fallback, s.color); ok {
+			return svgPaint{color: c}
+		}
+		return svgPaint{none: true}
+	}
+
+	if c, ok := parseSVGColor(v, s.color); ok {
+		return svgPaint{color: c}
+	}
+
+	return svgPaint{none: true}
+}
+
+func (r *svgRenderer) renderChildren(n *svgNode, style svgStyle) {
+	for i := range n.Children {
+		r.render(&n.Children[i], style)
+	}
+}
+
+func (r *svgRenderer) render(n *svgNode, parent svgStyle) {
+	if r.elements >= svgMaxElements {
+		return
+	}
+	r.elements++
+
+	attrs := svgAttrs(n)
+	style := r.applyStyle(parent, attrs)
+	if !style.display {
+		return
+	}
+
+	var subpaths []svgSubpath
+	switch n.XMLName.Local {
+	case "g", "a", "svg", "switch":
+		r.renderChildren(n, style)
+		return
+	case "path":
+		subpaths = parseSVGPath(attrs["d"])
+	case "rect":
+		subpaths = svgRect(attrs)
+	case "circle":
+		rad := parseSVGLength(attrs["r"])
+		subpaths = svgEllipse(parseSVGLength(attrs["cx"]), parseSVGLength(attrs["cy"]), rad, rad)
+	case "ellipse":
+		subpaths = svgEllipse(parseSVGLength(attrs["cx"]), parseSVGLength(attrs["cy"]),
+			parseSVGLength(attrs["rx"]), parseSVGLength(attrs["ry"]))
+	case "line":
+		subpaths = []svgSubpath{{points: []svgPoint{
+			{parseSVGLength(attrs["x1"]), parseSVGLength(attrs["y1"])},
+			{parseSVGLength(attrs["x2"]), parseSVGLength(attrs["y2"])},
+		}}}
+	case "polyline", "polygon":
+		nums := parseSVGNumbers(attrs["points"])
+		sp := svgSubpath{closed: n.XMLName.Local == "polygon"}
+		for i := 0; i+1 < len(nums); i += 2 {
+			sp.points = append(sp.points, svgPoint{nums[i], nums[i+1]})
+		}
+		subpaths = []svgSubpath{sp}
+	default:
+		// defs, symbol, text, etc.
+		return
+	}
+
+	// Transform into device space
+	for i := range subpaths {
+		for j := range subpaths[i].points {
+			subpaths[i].points[j] = style.transform.apply(subpaths[i].points[j])
+		}
+	}
+
+	if !style.fill.none && n.XMLName.Local != "line" {
+		r.fill(subpaths, style.fill.color, style.opacity*style.fillOpacity)
+	}
+
+	if !style.stroke.none && style.strokeWidth > 0 {
+		width := style.strokeWidth * style.transform.scale()
+		r.stroke(subpaths, width, style.stroke.color, style.opacity*style.strokeOpacity)
+	}
+}
+
+func (r *svgRenderer) fill(subpaths []svgSubpath, c color.NRGBA, opacity float64) {
+	z := vector.NewRasterizer(r.dst.Rect.Dx(), r.dst.Rect.Dy())
+	drawn := false
+	for _, sp := range subpaths {
+		if len(sp.points) < 3 {
+			continue
+		}
+
+		z.MoveTo(float32(sp.points[0].x), float32(sp.points[0].y))
+		for _, p := range sp.points[1:] {
+			z.LineTo(float32(p.x), float32(p.y))
+		}
+		z.ClosePath()
+		drawn = true
+	}
+
+	if drawn {
+		r.draw(z, c, opacity)
+	}
+}
+
+// stroke approximates strokes with a quad for each segment and round joins.
+func (r *svgRenderer) stroke(subpaths []svgSubpath, width float64, c color.NRGBA, opacity float64) {
+	z := vector.NewRasterizer(r.dst.Rect.Dx(), r.dst.Rect.Dy())
+	hw := math.Max(width, 0.5) / 2
+	drawn := false
+	for _, sp := range subpaths {
+		points := sp.points
+		if sp.closed && len(points) > 1 {
+			points = append(points, points[0])
+		}
+
+		for i := 0; i+1 < len(points); i++ {
+			p0, p1 := points[i], points[i+1]
+			dx, dy := p1.x-p0.x, p1.y-p0.y
+			l := math.Hypot(dx, dy)
+			if l == 0 {
+				continue
+			}
+
+			nx, ny := -dy/l*hw, dx/l*hw
+			z.MoveTo(float32(p0.x+nx), float32(p0.y+ny))
+			z.LineTo(float32(p1.x+nx), float32(p1.y+ny))
+			z.LineTo(float32(p1.x-nx), float32(p1.y-ny))
+			z.LineTo(float32(p0.x-nx), float32(p0.y-ny))
+			z.ClosePath()
+			drawn = true
+		}
+
+		if hw >= 1 {
+			for _, p := range points {
+				svgDisc(z, p, hw)
+			}
+		}
+	}
+
+	if drawn {
+		r.draw(z, c, opacity)
+	}
+}
+
+func (r *svgRenderer) draw(z *vector.Rasterizer, c color.NRGBA, opacity float64) {
+	c.A = uint8(float64(c.A) * clamp01(opacity))
+	if c.A == 0 {
+		return
+	}
+
+	z.Draw(r.dst, r.dst.Bounds(), image.NewUniform(c), image.Point{})
+}
+
+// svgDisc adds a circle with the same winding direction as stroke segments.
+func svgDisc(z *vector.Rasterizer, c svgPoint, radius float64) {
+	steps := 12
+	z.MoveTo(float32(c.x+radius), float32(c.y))
+	for i := 1; i < steps; i++ {
+		a := -2 * math.Pi * float64(i) / float64(steps)
+		z.LineTo(float32(c.x+radius*math.Cos(a)), float32(c.y+radius*math.Sin(a)))
+	}
+	z.ClosePath()
+}
+
+func svgRect(attrs map[string]string) []svgSubpath {
+	x, y := parseSVGLength(attrs["x"]), parseSVGLength(attrs["y"])
+	w, h := parseSVGLength(attrs["width"]), parseSVGLength(attrs["height"])
+	if w <= 0 || h <= 0 {
+		return nil
+	}
+
+	rx, hasRx := attrs["rx"]
+	ry, hasRy := attrs["ry"]
+	radiusX, radiusY := parseSVGLength(rx), parseSVGLength(ry)
+	if !hasRx {
+		radiusX = radiusY
+	}
+	if !hasRy {
+		radiusY = radiusX
+	}
+	radiusX, radiusY = math.Min(math.Max(radiusX, 0), w/2), math.Min(math.Max(radiusY, 0), h/2)
+
+	if radiusX == 0 || radiusY == 0 {
+		return []svgSubpath{{points: []svgPoint{{x, y}, {x + w, y}, {x + w, y + h}, {x, y + h}}, closed: true}}
+	}
+
+	sp := svgSubpath{closed: true}
+	corners := []struct {
+		cx, cy, start float64
+	}{
+		{x + w - radiusX, y + radiusY, -math.Pi / 2},
+		{x + w - radiusX, y + h - radiusY, 0},
+		{x + radiusX, y + h - radiusY, math.Pi / 2},
+		{x + radiusX, y + radiusY, math.Pi},
+	}
+	for _, c := range corners {
+		for i := 0; i <= svgCurveSteps/2; i++ {
+			a := c.start + math.Pi/2*float64(i)/float64(svgCurveSteps/2)
+			sp.points = append(sp.points, svgPoint{c.cx + radiusX*math.Cos(a), c.cy + radiusY*math.Sin(a)})
+		}
+	}
+
+	return []svgSubpath{sp}
+}
+
+func svgEllipse(cx, cy, rx, ry float64) []svgSubpath {
+	if rx <= 0 || ry <= 0 {
+		return nil
+	}
+
+	sp := svgSubpath{closed: true}
+	for i := 0; i < svgCircleSteps; i++ {
+		a := 2 * math.Pi * float64(i) / svgCircleSteps
+		sp.points = append(sp.points, svgPoint{cx + rx*math.Cos(a), cy + ry*math.Sin(a)})
+	}
+
+	return []svgSubpath{sp}
+}
+
+// parseSVGPath parses path data into flattened subpaths.
+func parseSVGPath(d string) []svgSubpath {
+	var (
+		res          []svgSubpath
+		cur          *svgSubpath
+		pos, start   svgPoint
+		lastCtrl     svgPoint
+		lastCmd      byte
+		tokenizer    = &svgPathTokenizer{s: d}
+		cmd          byte
+		hasPrevCtrlQ bool
+		hasPrevCtrlC bool
+	)
+
+	lineTo := func(p svgPoint) {
+		if cur == nil {
+			res = append(res, svgSubpath{points: []svgPoint{pos}})
+			cur = &res[len(res)-1]
+		}
+		cur.points = append(cur.points, p)
+		pos = p
+	}
+
+	for {
+		if c, ok := tokenizer.command(); ok {
+			cmd = c
+		} else if cmd == 0 || tokenizer.done() {
+			break
+		}
+
+		rel := cmd >= 'a' && cmd <= 'z'
+		upper := cmd
+		if rel {
+			upper = cmd - 'a' + 'A'
+		}
+		offset := func(p svgPoint) svgPoint {
+			if rel {
+				return svgPoint{pos.x + p.x, pos.y + p.y}
+			}
+			return p
+		}
+
+		hasPrevCtrlC = hasPrevCtrlC && (lastCmd == 'C' || lastCmd == 'S')
+		hasPrevCtrlQ = hasPrevCtrlQ && (lastCmd == 'Q' || lastCmd == 'T')
+
+		switch upper {
+		case 'Z':
+			if cur != nil {
+				cur.closed = true
+			}
+			pos = start
+			cur = nil
+			lastCmd = upper
+			// Z takes no argument, reset command so that numbers after it are invalid.
+			cmd = 0
+			continue
+		case 'M':
+			p, ok := tokenizer.point()
+			if !ok {
+				return res
+			}
+			pos = offset(p)
+			start = pos
+			res = append(res, svgSubpath{points: []svgPoint{pos}})
+			cur = &res[len(res)-1]
+			// Subsequent pairs are implicit lineto
+			if rel {
+				cmd = 'l'
+			} else {
+				cmd = 'L'
+			}
+		case 'L':
+			p, ok := tokenizer.point()
+			if !ok {
+				return res
+			}
+			lineTo(offset(p))
+		case 'H':
+			v, ok := tokenizer.number()
+			if !ok {
+				return res
+			}
+			if rel {
+				v += pos.x
+			}
+			lineTo(svgPoint{v, pos.y})
+		case 'V':
+			v, ok := tokenizer.number()
+			if !ok {
+				return res
+			}
+			if rel {
+				v += pos.y
+			}
+			lineTo(svgPoint{pos.x, v})
+		case 'C', 'S':
+			var c1 svgPoint
+			if upper == 'C' {
+				p, ok := tokenizer.point()
+				if !ok {
+					return res
+				}
+				c1 = offset(p)
+			} else if hasPrevCtrlC {
+				c1 = svgPoint{2*pos.x - lastCtrl.x, 2*pos.y - lastCtrl.y}
+			} else {
+				c1 = pos
+			}
+			p2, ok2 := tokenizer.point()
+			p3, ok3 := tokenizer.point()
+			if !ok2 || !ok3 {
+				return res
+			}
+			c2, end := offset(p2), offset(p3)
+			p0 := pos
+			for i := 1; i <= svgCurveSteps; i++ {
+				t := float64(i) / svgCurveSteps
+				mt := 1 - t
+				lineTo(svgPoint{
+					mt*mt*mt*p0.x + 3*mt*mt*t*c1.x + 3*mt*t*t*c2.x + t*t*t*end.x,
+					mt*mt*mt*p0.y + 3*mt*mt*t*c1.y + 3*mt*t*t*c2.y + t*t*t*end.y,
+				})
+			}
+			lastCtrl, hasPrevCtrlC = c2, true
+		case 'Q', 'T':
+			var c1 svgPoint
+			if upper == 'Q' {
+				p, ok := tokenizer.point()
+				if !ok {
+					return res
+				}
+				c1 = offset(p)
+			} else if hasPrevCtrlQ {
+				c1 = svgPoint{2*pos.x - lastCtrl.x, 2*pos.y - lastCtrl.y}
+			} else {
+				c1 = pos
+			}
+			p2, ok := tokenizer.point()
+			if !ok {
+				return res
+			}
+			end := offset(p2)
+			p0 := pos
+			for i := 1; i <= svgCurveSteps; i++ {
+				t := float64(i) / svgCurveSteps
+				mt := 1 - t
+				lineTo(svgPoint{
+					mt*mt*p0.x + 2*mt*t*c1.x + t*t*end.x,
+					mt*mt*p0.y + 2*mt*t*c1.y + t*t*end.y,
+				})
+			}
+			lastCtrl, hasPrevCtrlQ = c1, true
+		case 'A':
+			rx, ok1 := tokenizer.number()
+			ry, ok2 := tokenizer.number()
+			rotation, ok3 := tokenizer.number()
+			largeArc, ok4 := tokenizer.flag()
+			sweep, ok5 := tokenizer.flag()
+			p, ok6 := tokenizer.point()
+			if !(ok1 && ok2 && ok3 && ok4 && ok5 && ok6) {
+				return res
+			}
+			for _, pt := range svgArc(pos, offset(p), rx, ry, rotation, largeArc, sweep) {
+				lineTo(pt)
+			}
+		default:
+			return res
+		}
+
+		lastCmd = upper
+	}
+
+	return res
+}
+
+// svgArc flattens an elliptical arc, following the endpoint to center conversion in SVG spec appendix B.2.4.
+func svgArc(p0, p1 svgPoint, rx, ry, rotation float64, largeArc, sweep bool) []svgPoint {
+	if p0 == p1 {
+		return nil
+	}
+
+	rx, ry = math.Abs(rx), math.Abs(ry)
+	if rx == 0 || ry == 0 {
+		return []svgPoint{p1}
+	}
+
+	phi := rotation * math.Pi / 180
+	cosPhi, sinPhi := math.Cos(phi), math.Sin(phi)
+	dx, dy := (p0.x-p1.x)/2, (p0.y-p1.y)/2
+	x1p := cosPhi*dx + sinPhi*dy
+	y1p := -sinPhi*dx + cosPhi*dy
+
+	// Scale up radii if needed
+	lambda := x1p*x1p/(rx*rx) + y1p*y1p/(ry*ry)
+	if lambda > 1 {
+		s := math.Sqrt(lambda)
+		rx, ry = rx*s, ry*s
+	}
+
+	num := rx*rx*ry*ry - rx*rx*y1p*y1p - ry*ry*x1p*x1p
+	den := rx*rx*y1p*y1p + ry*ry*x1p*x1p
+	coef := 0.0
+	if den != 0 && num > 0 {
+		coef = math.Sqrt(num / den)
+	}
+	if largeArc == sweep {
+		coef = -coef
+	}
+	cxp, cyp := coef*rx*y1p/ry, -coef*ry*x1p/rx
+	cx := cosPhi*cxp - sinPhi*cyp + (p0.x+p1.x)/2
+	cy := sinPhi*cxp + cosPhi*cyp + (p0.y+p1.y)/2
+
+	angle := func(ux, uy, vx, vy float64) float64 {
+		return math.Atan2(ux*vy-uy*vx, ux*vx+uy*vy)
+	}
+	theta1 := angle(1, 0, (x1p-cxp)/rx, (y1p-cyp)/ry)
+	delta := angle((x1p-cxp)/rx, (y1p-cyp)/ry, (-x1p-cxp)/rx, (-y1p-cyp)/ry)
+	if !sweep && delta > 0 {
+		delta -= 2 * math.Pi
+	} else if sweep && delta < 0 {
+		delta += 2 * math.Pi
+	}
+
+	steps := max(int(math.Ceil(math.Abs(delta)/(math.Pi/2)*svgCurveSteps/2)), 1)
+	res := make([]svgPoint, 0, steps)
+	for i := 1; i <= steps; i++ {
+		t := theta1 + delta*float64(i)/float64(steps)
+		x, y := rx*math.Cos(t), ry*math.Sin(t)
+		res = append(res, svgPoint{cosPhi*x - sinPhi*y + cx, sinPhi*x + cosPhi*y + cy})
+	}
+	res[len(res)-1] = p1
+	return res
+}
+
+type svgPathTokenizer struct {
+	s string
+	i int
+}
+
+func (t *svgPathTokenizer) skipSeparators() {
+	for t.i < len(t.s) && (t.s[t.i] == ' ' || t.s[t.i] == ',' || t.s[t.i] == '\t' || t.s[t.i] == '\n' || t.s[t.i] == '\r') {
+		t.i++
+	}
+}
+
+func (t *svgPathTokenizer) done() bool {
+	t.skipSeparators()
+	return t.i >= len(t.s)
+}
+
+func (t *svgPathTokenizer) command() (byte, bool) {
+	t.skipSeparators()
+	if t.i < len(t.s) && strings.IndexByte("MmZzLlHhVvCcSsQqTtAa", t.s[t.i]) >= 0 {
+		t.i++
+		return t.s[t.i-1], true
+	}
+	return 0, false
+}
+
+func (t *svgPathTokenizer) flag() (bool, bool) {
+	t.skipSeparators()
+	if t.i < len(t.s) && (t.s[t.i] == '0' || t.s[t.i] == '1') {
+		t.i++
+		return t.s[t.i-1] == '1', true
+	}
+	return false, false
+}
+
+func (t *svgPathTokenizer) point() (svgPoint, bool) {
+	x, ok1 := t.number()
+	y, ok2 := t.number()
+	return svgPoint{x, y}, ok1 && ok2
+}
+
+func (t *svgPathTokenizer) number() (float64, bool) {
+	t.skipSeparators()
+	start := t.i
+	if t.i < len(t.s) && (t.s[t.i] == '-' || t.s[t.i] == '+') {
+		t.i++
+	}
+	seenDot, seenDigit := false, false
+	for t.i < len(t.s) {
+		c := t.s[t.i]
+		if c >= '0' && c <= '9' {
+			seenDigit = true
+		} else if c == '.' && !seenDot {
+			seenDot = true
+		} else {
+			break
+		}
+		t.i++
+	}
+	if seenDigit && t.i < len(t.s) && (t.s[t.i] == 'e' || t.s[t.i] == 'E') {
+		j := t.i + 1
+		if j < len(t.s) && (t.s[j] == '-' || t.s[j] == '+') {
+			j++
+		}
+		if j < len(t.s) && t.s[j] >= '0' && t.s[j] <= '9' {
+			for j < len(t.s) && t.s[j] >= '0' && t.s[j] <= '9' {
+				j++
+			}
+			t.i = j
+		}
+	}
+
+	if !seenDigit {
+		t.i = start
+		return 0, false
+	}
+
+	v, err := strconv.ParseFloat(t.s[start:t.i], 64)
+	return v, err == nil
+}
+
+// parseSVGNumbers parses a list of numbers separated by spaces or commas.
+func parseSVGNumbers(s string) []float64 {
+	t := &svgPathTokenizer{s: s}
+	res := make([]float64, 0)
+	for {
+		v, ok := t.number()
+		if !ok {
+			return res
+		}
+		res = append(res, v)
+	}
+}
+
+// parseSVGLength parses a length, units are ignored and percentages are treated as invalid.
+func parseSVGLength(s string) float64 {
+	if s == "" || strings.HasSuffix(s, "%") {
+		return 0
+	}
+
+	t := &svgPathTokenizer{s: s}
+	v, ok := t.number()
+	if !ok {
+		return 0
+	}
+
+	switch strings.TrimSpace(s[t.i:]) {
+	case "pt":
+		v *= 4.0 / 3
+	case "pc":
+		v *= 16
+	case "mm":
+		v *= 96 / 25.4
+	case "cm":
+		v *= 96 / 2.54
+	case "in":
+		v *= 96
+	case "em", "rem":
+		v *= 16
+	}
+	return v
+}
+
+func parseSVGTransform(s string) svgMatrix {
+	m := svgIdentity
+	for s != "" {
+		name, rest, found := strings.Cut(s, "(")
+		if !found {
+			break
+		}
+		args, rest, found := strings.Cut(rest, ")")
+		if !found {
+			break
+		}
+		s = rest
+
+		v := parseSVGNumbers(args)
+		arg := func(i int, def float64) float64 {
+			if i < len(v) {
+				return v[i]
+			}
+			return def
+		}
+
+		var t svgMatrix
+		switch strings.TrimSpace(strings.Trim(name, ", \t\n")) {
+		case "matrix":
+			if len(v) != 6 {
+				continue
+			}
+			t = svgMatrix{v[0], v[1], v[2], v[3], v[4], v[5]}
+		case "translate":
+			t = svgMatrix{1, 0, 0, 1, arg(0, 0), arg(1, 0)}
+		case "scale":
+			sx := arg(0, 1)
+			t = svgMatrix{sx, 0, 0, arg(1, sx), 0, 0}
+		case "rotate":
+			a := arg(0, 0) * math.Pi / 180
+			cx, cy := arg(1, 0), arg(2, 0)
+			t = svgMatrix{1, 0, 0, 1, cx, cy}.
+				mul(svgMatrix{math.Cos(a), math.Sin(a), -math.Sin(a), math.Cos(a), 0, 0}).
+				mul(svgMatrix{1, 0, 0, 1, -cx, -cy})
+		case "skewX":
+			t = svgMatrix{1, 0, math.Tan(arg(0, 0) * math.Pi / 180), 1, 0, 0}
+		case "skewY":
+			t = svgMatrix{1, math.Tan(arg(0, 0) * math.Pi / 180), 0, 1, 0, 0}
+		default:
+			continue
+		}
+
+		m = m.mul(t)
+	}
+
+	return m
+}
+
+// mul returns m × n, n is applied first.
+func (m svgMatrix) mul(n svgMatrix) svgMatrix {
+	return svgMatrix{
+		m[0]*n[0] + m[2]*n[1],
+		m[1]*n[0] + m[3]*n[1],
+		m[0]*n[2] + m[2]*n[3],
+		m[1]*n[2] + m[3]*n[3],
+		m[0]*n[4] + m[2]*n[5] + m[4],
+		m[1]*n[4] + m[3]*n[5] + m[5],
+	}
+}
+
+func (m svgMatrix) apply(p svgPoint) svgPoint {
+	return svgPoint{m[0]*p.x + m[2]*p.y + m[4], m[1]*p.x + m[3]*p.y + m[5]}
+}
+
+// scale returns the average scale factor, used to transform stroke width.
+func (m svgMatrix) scale() float64 {
+	return math.Sqrt(math.Abs(m[0]*m[3] - m[1]*m[2]))
+}
+
+// parseSVGColor parses named, hex, rgb() and rgba() colors.
+func parseSVGColor(s string, current color.NRGBA) (color.NRGBA, bool) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	switch {
+	case s == "":
+		return color.NRGBA{}, false
+	case s == "currentcolor":
+		return current, true
+	case strings.HasPrefix(s, "#"):
+		hex := s[1:]
+		if len(hex) == 3 || len(hex) == 4 {
+			expanded := make([]byte, 0, len(hex)*2)
+			for i := range hex {
+				expanded = append(expanded, hex[i], hex[i])
+			}
+			hex = string(expanded)
+		}
+		if len(hex) != 6 && len(hex) != 8 {
+			return color.NRGBA{}, false
+		}
+		v, err := strconv.ParseUint(hex, 16, 32)
+		if err != nil {
+			return color.NRGBA{}, false
+		}
+		if len(hex) == 6 {
+			return color.NRGBA{R: uint8(v >> 16), G: uint8(v >> 8), B: uint8(v), A: 255}, true
+		}
+		return color.NRGBA{R: uint8(v >> 24), G: uint8(v >> 16), B: uint8(v >> 8), A: uint8(v)}, true
+	case strings.HasPrefix(s, "rgb"):
+		_, args, found := strings.Cut(s, "(")
+		if !found {
+			return color.NRGBA{}, false
+		}
+		parts := strings.FieldsFunc(strings.TrimSuffix(args, ")"), func(r rune) bool {
+			return r == ',' || r == ' ' || r == '/'
+		})
+		if len(parts) < 3 {
+			return color.NRGBA{}, false
+		}
+		c := color.NRGBA{A: 255}
+		channels := []*uint8{&c.R, &c.G, &c.B}
+		for i, p := range parts[:3] {
+			if strings.HasSuffix(p, "%") {
+				v, _ := strconv.ParseFloat(strings.TrimSuffix(p, "%"), 64)
+				*channels[i] = uint8(clamp01(v/100) * 255)
+			} else {
+				v, _ := strconv.ParseFloat(p, 64)
+				*channels[i] = uint8(math.Max(0, math.Min(255, v)))
+			}
+		}
+		if len(parts) > 3 {
+			if strings.HasSuffix(parts[3], "%") {
+				v, _ := strconv.ParseFloat(strings.TrimSuffix(parts[3], "%"), 64)
+				c.A = uint8(clamp01(v/100) * 255)
+			} else {
+				v, _ := strconv.ParseFloat(parts[3], 64)
+				c.A = uint8(clamp01(v) * 255)
+			}
+		}
+		return c, true
+	}
+
+	if c, ok := colornames.Map[s]; ok {
+		return color.NRGBA{R: c.R, G: c.G, B: c.B, A: c.A}, true
+	}
+
+	return color.NRGBA{}, false
+}
+
+func clamp01(v float64) float64 {
+	return math.Max(0, math.Min(1, v))
+}
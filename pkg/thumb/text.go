@@ -0,0 +1,117 @@
+package thumb
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"io"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gomono"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+)
+
+const (
+	// textPreviewLimit is the maximum bytes read from text files.
+	textPreviewLimit = 16 * 1024
+	textFontSize     = 12
+	textLineHeight   = 16
+	textPadding      = 10
+	textTabWidth     = 4
+)
+
+var (
+	monoFont     *opentype.Font
+	monoFontErr  error
+	monoFontOnce sync.Once
+
+	textBackground = color.NRGBA{R: 255, G: 255, B: 255, A: 255}
+	textForeground = color.NRGBA{R: 0x33, G: 0x33, B: 0x33, A: 255}
+)
+
+// renderText renders first lines of a text file with monospace font into a width x height image.
+// Files that do not look like UTF-8 text are rejected.
+func renderText(r io.Reader, width, height int) (image.Image, error) {
+	content, err := io.ReadAll(io.LimitReader(r, textPreviewLimit))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read text: %w", err)
+	}
+
+	if !looksLikeText(content) {
+		return nil, fmt.Errorf("not a text file")
+	}
+
+	monoFontOnce.Do(func() {
+		monoFont, monoFontErr = opentype.Parse(gomono.TTF)
+	})
+	if monoFontErr != nil {
+		return nil, fmt.Errorf("failed to parse font: %w", monoFontErr)
+	}
+
+	face, err := opentype.NewFace(monoFont, &opentype.FaceOptions{
+		Size:    textFontSize,
+		DPI:     72,
+		Hinting: font.HintingFull,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create font face: %w", err)
+	}
+	defer face.Close()
+
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(img, img.Bounds(), image.NewUniform(textBackground), image.Point{}, draw.Src)
+
+	drawer := &font.Drawer{
+		Dst:  img,
+		Src:  image.NewUniform(textForeground),
+		Face: face,
+	}
+
+	maxWidth := fixed.I(width - textPadding)
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	scanner.Buffer(make([]byte, textPreviewLimit), textPreviewLimit)
+	for y := textPadding + textFontSize; y <= height-textPadding/2 && scanner.Scan(); y += textLineHeight {
+		line := strings.ReplaceAll(strings.TrimRight(scanner.Text(), "\r"), "\t", strings.Repeat(" ", textTabWidth))
+		drawer.Dot = fixed.P(textPadding, y)
+		for _, c := range line {
+			if c == utf8.RuneError || c < ' ' {
+				c = ' '
+			}
+			advance, ok := face.GlyphAdvance(c)
+			if !ok {
+				c = '?'
+			}
+			if drawer.Dot.X+advance > maxWidth {
+				break
+			}
+			drawer.DrawString(string(c))
+		}
+	}
+
+	return img, nil
+}
+
+// looksLikeText reports whether content is valid UTF-8 without NUL bytes. Content may be truncated
+// in the middle of a multibyte sequence.
+func looksLikeText(content []byte) bool {
+	if bytes.IndexByte(content, 0) >= 0 {
+		return false
+	}
+
+	for len(content) > 0 {
+		r, size := utf8.DecodeRune(content)
+		if r == utf8.RuneError && size <= 1 {
+			return len(content) < utf8.UTFMax && !utf8.FullRune(content)
+		}
+		content = content[size:]
+	}
+
+	return true
+}
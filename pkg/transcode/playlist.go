@@ -0,0 +1,171 @@
+package transcode
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+)
+
+// parseVariantPlaylist parses a single-file VOD media playlist generated by ffmpeg, in which
+// every segment is located by EXT-X-BYTERANGE.
+func parseVariantPlaylist(r io.Reader) (*types.TranscodeRendition, error) {
+	res := &types.TranscodeRendition{Segments: make([]types.TranscodeSegment, 0)}
+	var (
+		current    *types.TranscodeSegment
+		nextOffset int64
+	)
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case strings.HasPrefix(line, "#EXT-X-TARGETDURATION:"):
+			d, err := strconv.Atoi(strings.TrimPrefix(line, "#EXT-X-TARGETDURATION:"))
+			if err != nil {
+				return nil, fmt.Errorf("invalid target duration %q: %w", line, err)
+			}
+			res.TargetDuration = d
+		case strings.HasPrefix(line, "#EXTINF:"):
+			value, _, _ := strings.Cut(strings.TrimPrefix(line, "#EXTINF:"), ",")
+			d, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid segment duration %q: %w", line, err)
+			}
+			current = &types.TranscodeSegment{Duration: d, Offset: nextOffset}
+		case strings.HasPrefix(line, "#EXT-X-BYTERANGE:"):
+			if current == nil {
+				return nil, fmt.Errorf("byte range %q without segment info", line)
+			}
+
+			length, offset, hasOffset := strings.Cut(strings.TrimPrefix(line, "#EXT-X-BYTERANGE:"), "@")
+			l, err := strconv.ParseInt(length, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid byte range %q: %w", line, err)
+			}
+			current.Length = l
+			if hasOffset {
+				if current.Offset, err = strconv.ParseInt(offset, 10, 64); err != nil {
+					return nil, fmt.Errorf("invalid byte range %q: %w", line, err)
+				}
+			}
+		case line != "" && !strings.HasPrefix(line, "#"):
+			// Segment URI closes current segment
+			if current == nil || current.Length == 0 {
+				return nil, fmt.Errorf("segment %q is not located by byte range", line)
+			}
+
+			res.Segments = append(res.Segments, *current)
+			nextOffset = current.Offset + current.Length
+			current = nil
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read playlist: %w", err)
+	}
+
+	if len(res.Segments) == 0 {
+		return nil, fmt.Errorf("no segment found in playlist")
+	}
+
+	return res, nil
+}
+
+// parseStreamInf reads bandwidth, resolution and codecs of the first variant stream in a master playlist.
+func parseStreamInf(r io.Reader, rendition *types.TranscodeRendition) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if !strings.HasPrefix(line, "#EXT-X-STREAM-INF:") {
+			continue
+		}
+
+		attrs := parseAttributeList(strings.TrimPrefix(line, "#EXT-X-STREAM-INF:"))
+		bandwidth, err := strconv.Atoi(attrs["BANDWIDTH"])
+		if err != nil {
+			return fmt.Errorf("invalid bandwidth %q: %w", attrs["BANDWIDTH"], err)
+		}
+
+		rendition.Bandwidth = bandwidth
+		rendition.Codecs = attrs["CODECS"]
+		if resolution, ok := attrs["RESOLUTION"]; ok {
+			_, _ = fmt.Sscanf(resolution, "%dx%d", &rendition.Width, &rendition.Height)
+		}
+
+		return nil
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read master playlist: %w", err)
+	}
+
+	return fmt.Errorf("no stream info found in master playlist")
+}
+
+// parseAttributeList parses HLS attribute list like `BANDWIDTH=1,CODECS="a,b"`, quotes are removed.
+func parseAttributeList(s string) map[string]string {
+	res := make(map[string]string)
+	for s != "" {
+		key, rest, found := strings.Cut(s, "=")
+		if !found {
+			break
+		}
+
+		var value string
+		if strings.HasPrefix(rest, `"`) {
+			end := strings.Index(rest[1:], `"`)
+			if end < 0 {
+				value, rest = rest[1:], ""
+			} else {
+				value, rest = rest[1:end+1], rest[end+2:]
+			}
+			rest = strings.TrimPrefix(rest, ",")
+		} else {
+			value, rest, _ = strings.Cut(rest, ",")
+		}
+
+		res[strings.TrimSpace(key)] = value
+		s = rest
+	}
+
+	return res
+}
+
+// WriteMasterPlaylist writes a master playlist listing all renditions, variantUrl returns URL of
+// the media playlist of the i-th rendition.
+func WriteMasterPlaylist(w io.Writer, renditions []types.TranscodeRendition, variantUrl func(i int) string) error {
+	var b strings.Builder
+	b.WriteString("#EXTM3U\n#EXT-X-VERSION:4\n#EXT-X-INDEPENDENT-SEGMENTS\n")
+	for i, r := range renditions {
+		b.WriteString(fmt.Sprintf("#EXT-X-STREAM-INF:BANDWIDTH=%d", r.Bandwidth))
+		if r.Width > 0 && r.Height > 0 {
+			b.WriteString(fmt.Sprintf(",RESOLUTION=%dx%d", r.Width, r.Height))
+		}
+		if r.Codecs != "" {
+			b.WriteString(fmt.Sprintf(`,CODECS="%s"`, r.Codecs))
+		}
+		b.WriteString(fmt.Sprintf("\n%s\n", variantUrl(i)))
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// WriteVariantPlaylist writes a VOD media playlist of the rendition, all segments point to
+// byte ranges of the same url.
+func WriteVariantPlaylist(w io.Writer, rendition *types.TranscodeRendition, url string) error {
+	var b strings.Builder
+	b.WriteString("#EXTM3U\n#EXT-X-VERSION:4\n#EXT-X-PLAYLIST-TYPE:VOD\n#EXT-X-MEDIA-SEQUENCE:0\n")
+	b.WriteString(fmt.Sprintf("#EXT-X-TARGETDURATION:%d\n", rendition.TargetDuration))
+	for _, s := range rendition.Segments {
+		b.WriteString(fmt.Sprintf("#EXTINF:%.6f,\n#EXT-X-BYTERANGE:%d@%d\n%s\n", s.Duration, s.Length, s.Offset, url))
+	}
+	b.WriteString("#EXT-X-ENDLIST\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
@@ -0,0 +1,78 @@
+package transcode
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+)
+
+func TestParseVariantPlaylist(t *testing.T) {
+	src := `#EXTM3U
+#EXT-X-VERSION:4
+#EXT-X-TARGETDURATION:7
+#EXT-X-MEDIA-SEQUENCE:0
+#EXT-X-PLAYLIST-TYPE:VOD
+#EXTINF:6.006000,
+#EXT-X-BYTERANGE:1410072@0
+0.ts
+#EXTINF:3.503000,
+#EXT-X-BYTERANGE:700000
+0.ts
+#EXT-X-ENDLIST
+`
+
+	r, err := parseVariantPlaylist(strings.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r.TargetDuration != 7 || len(r.Segments) != 2 {
+		t.Fatalf("unexpected rendition %+v", r)
+	}
+
+	if r.Segments[0] != (types.TranscodeSegment{Duration: 6.006, Offset: 0, Length: 1410072}) {
+		t.Errorf("unexpected segment %+v", r.Segments[0])
+	}
+
+	if r.Segments[1] != (types.TranscodeSegment{Duration: 3.503, Offset: 1410072, Length: 700000}) {
+		t.Errorf("unexpected segment %+v", r.Segments[1])
+	}
+
+	if _, err := parseVariantPlaylist(strings.NewReader("#EXTM3U\n#EXTINF:6.0,\n0.ts\n")); err == nil {
+		t.Error("expect error for segment without byte range")
+	}
+}
+
+func TestParseStreamInf(t *testing.T) {
+	src := `#EXTM3U
+#EXT-X-VERSION:3
+#EXT-X-STREAM-INF:BANDWIDTH=3140800,RESOLUTION=1280x720,CODECS="avc1.64001f,mp4a.40.2"
+0.m3u8
+`
+
+	r := &types.TranscodeRendition{}
+	if err := parseStreamInf(strings.NewReader(src), r); err != nil {
+		t.Fatal(err)
+	}
+
+	if r.Bandwidth != 3140800 || r.Width != 1280 || r.Height != 720 || r.Codecs != "avc1.64001f,mp4a.40.2" {
+		t.Errorf("unexpected rendition %+v", r)
+	}
+}
+
+func TestWriteVariantPlaylist(t *testing.T) {
+	var b strings.Builder
+	err := WriteVariantPlaylist(&b, &types.TranscodeRendition{
+		TargetDuration: 6,
+		Segments:       []types.TranscodeSegment{{Duration: 6, Offset: 100, Length: 50}},
+	}, "https://example.com/hls")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(b.String(), "#EXTINF:6.000000,\n#EXT-X-BYTERANGE:50@100\nhttps://example.com/hls\n") ||
+		!strings.HasSuffix(b.String(), "#EXT-X-ENDLIST\n") {
+		t.Errorf("unexpected playlist %q", b.String())
+	}
+}
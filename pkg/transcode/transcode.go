@@ -0,0 +1,196 @@
+package transcode
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+)
+
+const (
+	// OutputName is the name of the file that all renditions are concatenated into.
+	OutputName = "hls.ts"
+	// ContentType is the MIME type of transcoded segments.
+	ContentType = "video/mp2t"
+	// PlaylistContentType is the MIME type of HLS playlists.
+	PlaylistContentType = "application/vnd.apple.mpegurl"
+
+	audioBitrate = 128
+)
+
+type (
+	// Options of a transcoding job.
+	Options struct {
+		FFMpegPath      string
+		ExtraArgs       string
+		SegmentDuration int
+		Renditions      []setting.TranscodeRendition
+	}
+
+	// Result is the output of a transcoding job.
+	Result struct {
+		// Path of the file that contains segments of all renditions.
+		Path       string                     `json:"path"`
+		Size       int64                      `json:"size"`
+		Renditions []types.TranscodeRendition `json:"renditions"`
+	}
+)
+
+// Transcode converts input, either a local path or an URL, into HLS renditions with ffmpeg.
+// Each rendition is encoded into a single MPEG-TS file, they are then concatenated into one
+// output file under workDir, with segment byte ranges shifted accordingly. onRendition is
+// called after each rendition is finished.
+func Transcode(ctx context.Context, l logging.Logger, input, workDir string, opts *Options, onRendition func(done int)) (*Result, error) {
+	if len(opts.Renditions) == 0 {
+		return nil, fmt.Errorf("no rendition configured")
+	}
+
+	if err := os.MkdirAll(workDir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create work dir: %w", err)
+	}
+
+	renditions := make([]types.TranscodeRendition, 0, len(opts.Renditions))
+	segmentFiles := make([]string, 0, len(opts.Renditions))
+	for i, r := range opts.Renditions {
+		l.Info("Transcoding %q into %dp rendition...", input, r.Height)
+		rendition, segmentFile, err := transcodeRendition(ctx, l, input, workDir, i, r, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to transcode %dp rendition: %w", r.Height, err)
+		}
+
+		renditions = append(renditions, *rendition)
+		segmentFiles = append(segmentFiles, segmentFile)
+		if onRendition != nil {
+			onRendition(i + 1)
+		}
+	}
+
+	// Concatenate all renditions into one file
+	outputPath := filepath.Join(workDir, OutputName)
+	output, err := os.Create(outputPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create output file: %w", err)
+	}
+	defer output.Close()
+
+	size := int64(0)
+	for i, segmentFile := range segmentFiles {
+		n, err := appendFile(output, segmentFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to concatenate rendition %q: %w", renditions[i].Name, err)
+		}
+
+		for j := range renditions[i].Segments {
+			seg := &renditions[i].Segments[j]
+			if seg.Offset+seg.Length > n {
+				return nil, fmt.Errorf("segment %d of rendition %q exceeds file size", j, renditions[i].Name)
+			}
+			seg.Offset += size
+		}
+
+		size += n
+		_ = os.Remove(segmentFile)
+	}
+
+	return &Result{
+		Path:       outputPath,
+		Size:       size,
+		Renditions: renditions,
+	}, nil
+}
+
+func transcodeRendition(ctx context.Context, l logging.Logger, input, workDir string, index int, r setting.TranscodeRendition,
+	opts *Options) (*types.TranscodeRendition, string, error) {
+	segmentFile := filepath.Join(workDir, fmt.Sprintf("%d.ts", index))
+	playlistFile := filepath.Join(workDir, fmt.Sprintf("%d.m3u8", index))
+	masterName := fmt.Sprintf("master_%d.m3u8", index)
+	segmentDuration := strconv.Itoa(max(opts.SegmentDuration, 1))
+
+	args := make([]string, 0)
+	if opts.ExtraArgs != "" {
+		args = append(args, strings.Split(opts.ExtraArgs, " ")...)
+	}
+
+	args = append(args,
+		"-i", input,
+		"-map", "0:v:0", "-map", "0:a:0?",
+		// Never upscale, height must be even for yuv420p
+		"-vf", fmt.Sprintf("scale=-2:'min(%d,trunc(ih/2)*2)'", r.Height),
+		"-c:v", "libx264", "-preset", "veryfast", "-profile:v", "high", "-pix_fmt", "yuv420p",
+		"-b:v", fmt.Sprintf("%dk", r.Bitrate),
+		"-maxrate", fmt.Sprintf("%dk", r.Bitrate*107/100),
+		"-bufsize", fmt.Sprintf("%dk", r.Bitrate*3/2),
+		// Align key frames with segment boundaries so that renditions can be switched seamlessly
+		"-force_key_frames", fmt.Sprintf("expr:gte(t,n_forced*%s)", segmentDuration),
+		"-sc_threshold", "0",
+		"-c:a", "aac", "-b:a", fmt.Sprintf("%dk", audioBitrate), "-ac", "2",
+		"-f", "hls",
+		"-hls_time", segmentDuration,
+		"-hls_playlist_type", "vod",
+		"-hls_flags", "single_file",
+		"-hls_segment_filename", segmentFile,
+		"-master_pl_name", masterName,
+		"-y", playlistFile,
+	)
+
+	cmd := exec.CommandContext(ctx, opts.FFMpegPath, args...)
+	var stdErr bytes.Buffer
+	cmd.Stderr = &stdErr
+	if err := cmd.Run(); err != nil {
+		l.Warning("Failed to invoke ffmpeg: %s", stdErr.String())
+		return nil, "", fmt.Errorf("failed to invoke ffmpeg: %w, raw output: %s", err, stdErr.String())
+	}
+
+	playlist, err := os.Open(playlistFile)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to open playlist: %w", err)
+	}
+	defer os.Remove(playlistFile)
+	defer playlist.Close()
+
+	rendition, err := parseVariantPlaylist(playlist)
+	if err != nil {
+		return nil, "", err
+	}
+
+	masterPath := filepath.Join(workDir, masterName)
+	master, err := os.Open(masterPath)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to open master playlist: %w", err)
+	}
+	defer os.Remove(masterPath)
+	defer master.Close()
+
+	if err := parseStreamInf(master, rendition); err != nil {
+		return nil, "", err
+	}
+
+	if rendition.Bandwidth == 0 {
+		rendition.Bandwidth = (r.Bitrate + audioBitrate) * 1000
+	}
+	rendition.Name = fmt.Sprintf("%dp", r.Height)
+	if rendition.Height > 0 {
+		rendition.Name = fmt.Sprintf("%dp", rendition.Height)
+	}
+
+	return rendition, segmentFile, nil
+}
+
+func appendFile(dst io.Writer, src string) (int64, error) {
+	f, err := os.Open(src)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	return io.Copy(dst, f)
+}
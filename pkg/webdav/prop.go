@@ -18,6 +18,7 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
 	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
 	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
 	"net/http"
 	"strconv"
 	"strings"
@@ -113,7 +114,7 @@ func (m *metadataDeadProps) DeadProps() (map[xml.Name]Property, error) {
 		}
 
 		spaceLocal := strings.SplitN(strings.TrimPrefix(k, DeadPropsMetadataPrefix), SpaceNameSeparator, 2)
-		name := xml.Name{spaceLocal[0], spaceLocal[1]}
+		name := xml.Name{Space: spaceLocal[0], Local: spaceLocal[1]}
 		propsStore := &DeadPropsStore{}
 		if err := json.Unmarshal([]byte(v), propsStore); err != nil {
 			return nil, err
@@ -267,6 +268,9 @@ var liveProps = map[xml.Name]struct {
 	findFn func(context.Context, manager.FileManager, fs.File) (string, error)
 	// dir is true if the property applies to directories.
 	dir bool
+	// explicit is true if the property is only returned when requested by
+	// name, but not by allprop.
+	explicit bool
 }{
 	{Space: "DAV:", Local: "resourcetype"}: {
 		findFn: findResourceType,
@@ -319,13 +323,18 @@ var liveProps = map[xml.Name]struct {
 		findFn: findSupportedLock,
 		dir:    true,
 	},
+	// RFC 4331 says that quota properties SHOULD NOT be returned by allprop,
+	// since they might be expensive to compute.
+	// https://datatracker.ietf.org/doc/html/rfc4331#section-3
 	{Space: "DAV:", Local: "quota-used-bytes"}: {
-		findFn: findQuotaUsedBytes,
-		dir:    true,
+		findFn:   findQuotaUsedBytes,
+		dir:      true,
+		explicit: true,
 	},
 	{Space: "DAV:", Local: "quota-available-bytes"}: {
-		findFn: findQuotaAvailableBytes,
-		dir:    true,
+		findFn:   findQuotaAvailableBytes,
+		dir:      true,
+		explicit: true,
 	},
 }
 
@@ -422,6 +431,9 @@ func allprop(c *gin.Context, file fs.File, fm manager.FileManager, include []xml
 	if err != nil {
 		return nil, err
 	}
+	pnames = lo.Filter(pnames, func(pn xml.Name, index int) bool {
+		return !liveProps[pn].explicit
+	})
 	// Add names from include if they are not already covered in pnames.
 	nameset := make(map[xml.Name]bool)
 	for _, pn := range pnames {
@@ -567,7 +579,8 @@ func findQuotaAvailableBytes(ctx context.Context, fm manager.FileManager, file f
 	if err != nil {
 		return "", err
 	}
-	return strconv.FormatInt(capacity.Total-capacity.Used, 10), nil
+	// Used space might exceed the total after the group or storage packs changed.
+	return strconv.FormatInt(max(capacity.Total-capacity.Used, 0), 10), nil
 }
 
 func findSupportedLock(ctx context.Context, fm manager.FileManager, file fs.File) (string, error) {
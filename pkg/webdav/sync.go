@@ -0,0 +1,229 @@
+package webdav
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/eventhub"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/dbfs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+)
+
+// syncTokenPrefix makes sync tokens valid URIs as required by RFC 6578, the rest of the token
+// is the ID of an eventhub subscriber listening on the collection.
+//
+// Each token is backed by a reserved offline subscriber, whose events are persisted in inventory
+// until the token is redeemed. Tokens are rotated on every report, and become invalid once the
+// subscriber expires, is evicted by newer tokens or the event hub restarts, in which case clients
+// fall back to a full sync.
+// DAV:sync-token is not offered as a live property, as every token costs a subscriber.
+const syncTokenPrefix = "http://cloudreve.org/ns/dav-sync/"
+
+// maxSyncTokens limits tokens kept for each user and collection, the oldest ones are
+// invalidated once exceeded.
+const maxSyncTokens = 8
+
+// handleReport handles REPORT requests, only DAV:sync-collection (RFC 6578) is supported.
+func handleReport(c *gin.Context, user *ent.User, fm manager.FileManager) (status int, err error) {
+	href, reqPath, status, err := stripPrefix(c.Request.URL.Path, user)
+	if err != nil {
+		return status, err
+	}
+
+	target, targetPath, err := fm.SharedAddressTranslation(c, reqPath)
+	if err != nil {
+		return purposeStatusCodeFromError(err), err
+	}
+
+	sc, status, err := readSyncCollection(c.Request.Body)
+	if err != nil {
+		if status == http.StatusForbidden {
+			_ = writeError(c.Writer, status, "supported-report")
+			return 0, err
+		}
+		return status, err
+	}
+
+	if target.Type() != types.FileTypeFolder {
+		_ = writeError(c.Writer, http.StatusForbidden, "supported-report")
+		return 0, errUnsupportedReport
+	}
+
+	depth := 1
+	if sc.SyncLevel == "infinite" {
+		depth = infiniteDepth
+	}
+
+	// Subscribe for the next token before redeeming the current one, so that no change
+	// falls in between. Changes happening meanwhile might be reported twice, which is harmless.
+	hub := dependency.FromContext(c).EventHub()
+	topic := target.ID()
+	next := uuid.Must(uuid.NewV4()).String()
+	limit := maxSyncTokens
+	if sc.SyncToken != "" {
+		// Current token is removed once redeemed, it should not be evicted by the next one.
+		limit++
+	}
+	if err := hub.Reserve(c, topic, next, limit); err != nil {
+		return http.StatusInternalServerError, err
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			hub.Remove(c, topic, next)
+		}
+	}()
+
+	base := path.Join(davPrefix, href)
+	var responses []*response
+	if sc.SyncToken == "" {
+		// Initial sync reports all members, excluding the collection itself.
+		err = fm.Walk(c, targetPath, depth, func(f fs.File, level int) error {
+			if level == 0 {
+				return nil
+			}
+
+			pstats, err := props(c, f, fm, sc.Prop)
+			if err != nil {
+				return err
+			}
+
+			responses = append(responses, makePropstatResponse(resourceHref(base, f, level), pstats))
+			return nil
+		}, dbfs.WithFilePublicMetadata())
+	} else {
+		current := strings.TrimPrefix(sc.SyncToken, syncTokenPrefix)
+		if _, uuidErr := uuid.FromString(current); uuidErr != nil || current == sc.SyncToken {
+			_ = writeError(c.Writer, http.StatusForbidden, "valid-sync-token")
+			return 0, eventhub.ErrSubscriberNotFound
+		}
+
+		events, err := hub.Take(c, topic, current)
+		if err != nil {
+			if errors.Is(err, eventhub.ErrSubscriberNotFound) {
+				_ = writeError(c.Writer, http.StatusForbidden, "valid-sync-token")
+				return 0, err
+			}
+			return http.StatusInternalServerError, err
+		}
+
+		// Events are consumed, the current token cannot be redeemed again even if this report fails.
+		hub.Remove(c, topic, current)
+		responses, err = syncChanges(c, fm, targetPath, base, events, depth, sc.Prop)
+	}
+	if err != nil {
+		return purposeStatusCodeFromError(err), err
+	}
+
+	if sc.Limit != nil && len(responses) > sc.Limit.NResults {
+		// Results are not truncated, see section 3.6 of RFC 6578.
+		_ = writeError(c.Writer, StatusInsufficientStorage, "number-of-matches-within-limits")
+		return 0, errSyncLimitExceeded
+	}
+
+	mw := multistatusWriter{w: c.Writer, syncToken: syncTokenPrefix + next}
+	for _, r := range responses {
+		if err := mw.write(r); err != nil {
+			return http.StatusInternalServerError, err
+		}
+	}
+
+	if err := mw.close(); err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	committed = true
+	return 0, nil
+}
+
+// syncChanges reports current state of members touched by given events. Paths of events are
+// relative to root, members beyond depth are ignored.
+func syncChanges(c *gin.Context, fm manager.FileManager, root *fs.URI, base string, events []*eventhub.Event,
+	depth int, pnames []xml.Name) ([]*response, error) {
+	var (
+		touched []string
+		// created are paths whose descendants should also be reported.
+		created = make(map[string]bool)
+		seen    = make(map[string]bool)
+	)
+	touch := func(p string, isNew bool) {
+		p = path.Clean(p)
+		if p == fs.Separator || (depth != infiniteDepth && strings.Count(p, fs.Separator) > 1) {
+			return
+		}
+
+		if !seen[p] {
+			seen[p] = true
+			touched = append(touched, p)
+		}
+		created[p] = created[p] || isNew
+	}
+
+	for _, e := range events {
+		switch e.Type {
+		case eventhub.EventTypeCreate:
+			touch(e.From, true)
+		case eventhub.EventTypeModify, eventhub.EventTypeDelete:
+			touch(e.From, false)
+		case eventhub.EventTypeRename:
+			touch(e.From, false)
+			touch(e.To, true)
+		}
+	}
+
+	var (
+		responses []*response
+		reported  = make(map[string]bool)
+	)
+	for _, p := range touched {
+		walkDepth := 0
+		if created[p] && depth == infiniteDepth {
+			walkDepth = infiniteDepth
+		}
+
+		memberBase := path.Join(base, p)
+		err := fm.Walk(c, root.JoinRaw(p), walkDepth, func(f fs.File, level int) error {
+			href := resourceHref(memberBase, f, level)
+			if reported[strings.TrimSuffix(href, fs.Separator)] {
+				return nil
+			}
+
+			pstats, err := props(c, f, fm, pnames)
+			if err != nil {
+				return err
+			}
+
+			reported[strings.TrimSuffix(href, fs.Separator)] = true
+			responses = append(responses, makePropstatResponse(href, pstats))
+			return nil
+		}, dbfs.WithFilePublicMetadata())
+		if err != nil {
+			if purposeStatusCodeFromError(err) != http.StatusNotFound {
+				return nil, err
+			}
+
+			// Removed members are reported with a status only, descendants of removed
+			// collections are not listed as permitted by section 3.5.2 of RFC 6578.
+			if !reported[memberBase] {
+				reported[memberBase] = true
+				responses = append(responses, &response{
+					Href:   []string{(&url.URL{Path: memberBase}).EscapedPath()},
+					Status: fmt.Sprintf("HTTP/1.1 %d %s", http.StatusNotFound, StatusText(http.StatusNotFound)),
+				})
+			}
+		}
+	}
+
+	return responses, nil
+}
@@ -81,6 +81,8 @@ func ServeHTTP(c *gin.Context) {
 		status, err = handlePropfind(c, u, fm)
 	case "PROPPATCH":
 		status, err = handleProppatch(c, u, fm)
+	case "REPORT":
+		status, err = handleReport(c, u, fm)
 	}
 	if status != 0 {
 		c.Writer.WriteHeader(status)
@@ -307,6 +309,8 @@ func handleOptions(c *gin.Context, user *ent.User, fm manager.FileManager) (stat
 				allow = append(allow, "COPY", "PROPFIND")
 				if target.Type() == types.FileTypeFile {
 					allow = append(allow, "GET", "HEAD", "POST")
+				} else {
+					allow = append(allow, "REPORT")
 				}
 			}
 			if update || create {
@@ -537,16 +541,7 @@ func handlePropfind(c *gin.Context, user *ent.User, fm manager.FileManager) (sta
 			return err
 		}
 
-		p := path.Join(davPrefix, href)
-		elements := f.Uri(false).Elements()
-		for i := 0; i < level; i++ {
-			p = path.Join(p, elements[len(elements)-level+i])
-		}
-		if f.Type() == types.FileTypeFolder {
-			p = util.FillSlash(p)
-		}
-
-		return mw.write(makePropstatResponse(p, pstats))
+		return mw.write(makePropstatResponse(resourceHref(path.Join(davPrefix, href), f, level), pstats))
 	}
 
 	if err := fm.Walk(c, targetPath, depth, walkFn, dbfs.WithFilePublicMetadata()); err != nil {
@@ -560,6 +555,20 @@ func handlePropfind(c *gin.Context, user *ent.User, fm manager.FileManager) (sta
 	return 0, nil
 }
 
+// resourceHref returns the href of f, which is found at the given level of a walk started from base.
+func resourceHref(base string, f fs.File, level int) string {
+	p := base
+	elements := f.Uri(false).Elements()
+	for i := 0; i < level; i++ {
+		p = path.Join(p, elements[len(elements)-level+i])
+	}
+	if f.Type() == types.FileTypeFolder {
+		p = util.FillSlash(p)
+	}
+
+	return p
+}
+
 func handleDelete(c *gin.Context, user *ent.User, fm manager.FileManager) (status int, err error) {
 	_, reqPath, status, err := stripPrefix(c.Request.URL.Path, user)
 	if err != nil {
@@ -842,12 +851,15 @@ var (
 	errInvalidPropfind         = errors.New("webdav: invalid propfind")
 	errInvalidProppatch        = errors.New("webdav: invalid proppatch")
 	errInvalidResponse         = errors.New("webdav: invalid response")
+	errInvalidSyncCollection   = errors.New("webdav: invalid sync-collection")
 	errInvalidTimeout          = errors.New("webdav: invalid timeout")
 	errNoFileSystem            = errors.New("webdav: no file system")
 	errNoLockSystem            = errors.New("webdav: no lock system")
 	errNotADirectory           = errors.New("webdav: not a directory")
 	errPrefixMismatch          = errors.New("webdav: prefix mismatch")
 	errRecursionTooDeep        = errors.New("webdav: recursion too deep")
+	errSyncLimitExceeded       = errors.New("webdav: sync-collection result limit exceeded")
 	errUnsupportedLockInfo     = errors.New("webdav: unsupported lock info")
 	errUnsupportedMethod       = errors.New("webdav: unsupported method")
+	errUnsupportedReport       = errors.New("webdav: unsupported report")
 )
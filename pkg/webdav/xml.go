@@ -313,6 +313,10 @@ type multistatusWriter struct {
 	// close will be emitted. Empty response descriptions are not
 	// written.
 	responseDescription string
+	// syncToken is the optional DAV:sync-token emitted at the end of a
+	// sync-collection report. A multistatus element is always written
+	// if it is not empty.
+	syncToken string
 
 	w   http.ResponseWriter
 	enc *ixml.Encoder
@@ -378,7 +382,12 @@ func (w *multistatusWriter) writeHeader() error {
 // been written.
 func (w *multistatusWriter) close() error {
 	if w.enc == nil {
-		return nil
+		if w.syncToken == "" {
+			return nil
+		}
+		if err := w.writeHeader(); err != nil {
+			return err
+		}
 	}
 	var end []ixml.Token
 	if w.responseDescription != "" {
@@ -389,6 +398,14 @@ func (w *multistatusWriter) close() error {
 			ixml.EndElement{Name: name},
 		)
 	}
+	if w.syncToken != "" {
+		name := ixml.Name{Space: "DAV:", Local: "sync-token"}
+		end = append(end,
+			ixml.StartElement{Name: name},
+			ixml.CharData(w.syncToken),
+			ixml.EndElement{Name: name},
+		)
+	}
 	end = append(end, ixml.EndElement{
 		Name: ixml.Name{Space: "DAV:", Local: "multistatus"},
 	})
@@ -518,3 +535,44 @@ func readProppatch(r io.Reader) (patches []Proppatch, status int, err error) {
 	}
 	return patches, 0, nil
 }
+
+// https://datatracker.ietf.org/doc/html/rfc6578#section-6.1
+type syncCollection struct {
+	XMLName   ixml.Name
+	SyncToken string `xml:"DAV: sync-token"`
+	SyncLevel string `xml:"DAV: sync-level"`
+	Limit     *struct {
+		NResults int `xml:"DAV: nresults"`
+	} `xml:"DAV: limit"`
+	Prop propfindProps `xml:"DAV: prop"`
+}
+
+func readSyncCollection(r io.Reader) (sc syncCollection, status int, err error) {
+	if err = ixml.NewDecoder(r).Decode(&sc); err != nil {
+		if err == io.EOF {
+			err = errInvalidSyncCollection
+		}
+		return syncCollection{}, http.StatusBadRequest, err
+	}
+
+	// Section 3.2 of RFC 3253 requires 403 with DAV:supported-report for unknown reports.
+	if sc.XMLName != (ixml.Name{Space: "DAV:", Local: "sync-collection"}) {
+		return syncCollection{}, http.StatusForbidden, errUnsupportedReport
+	}
+	if sc.SyncLevel != "1" && sc.SyncLevel != "infinite" {
+		return syncCollection{}, http.StatusBadRequest, errInvalidSyncCollection
+	}
+	if sc.Prop == nil {
+		return syncCollection{}, http.StatusBadRequest, errInvalidSyncCollection
+	}
+	return sc, 0, nil
+}
+
+// writeError writes a DAV:error response body with the given precondition element.
+// http://www.webdav.org/specs/rfc4918.html#ELEMENT_error
+func writeError(w http.ResponseWriter, status int, precondition string) error {
+	w.Header().Set("Content-Type", "text/xml; charset=utf-8")
+	w.WriteHeader(status)
+	_, err := fmt.Fprintf(w, `<?xml version="1.0" encoding="UTF-8"?><D:error xmlns:D="DAV:"><D:%s/></D:error>`, precondition)
+	return err
+}
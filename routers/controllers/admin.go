@@ -529,6 +529,16 @@ func AdminCleanupTask(c *gin.Context) {
 	c.JSON(200, serializer.Response{})
 }
 
+func AdminCreateMediaRegenerateTask(c *gin.Context) {
+	service := ParametersFromContext[*admin.MediaRegenerateService](c, admin.MediaRegenerateParamCtx{})
+	res, err := service.CreateTask(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
 func AdminListTasks(c *gin.Context) {
 	service := ParametersFromContext[*admin.AdminListService](c, admin.AdminListServiceParamsCtx{})
 	res, err := service.Tasks(c)
@@ -1,6 +1,11 @@
 package controllers
 
 import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
 	"github.com/cloudreve/Cloudreve/v4/pkg/request"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
 	"github.com/cloudreve/Cloudreve/v4/service/explorer"
@@ -141,6 +146,30 @@ func Thumb(c *gin.Context) {
 	c.JSON(200, serializer.Response{Data: res})
 }
 
+// Storyboard gets temporary URL of video storyboard and its WebVTT thumbnails track
+func Storyboard(c *gin.Context) {
+	service := ParametersFromContext[*explorer.FileStoryboardService](c, explorer.FileStoryboardParameterCtx{})
+	res, err := service.Get(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// ServeStoryboardVTT writes WebVTT thumbnails track of a video storyboard
+func ServeStoryboardVTT(c *gin.Context) {
+	service := ParametersFromContext[*explorer.StoryboardVTTService](c, explorer.StoryboardVTTParameterCtx{})
+	err := service.Serve(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+}
+
 // FileURL get temporary file url for preview or download
 func FileURL(c *gin.Context) {
 	service := ParametersFromContext[*explorer.FileURLService](c, explorer.FileURLParameterCtx{})
@@ -347,6 +376,29 @@ func Unpin(c *gin.Context) {
 	c.JSON(200, serializer.Response{})
 }
 
+// SaveSearch creates or updates a saved search
+func SaveSearch(c *gin.Context) {
+	service := ParametersFromContext[*explorer.SaveSearchService](c, explorer.SaveSearchParameterCtx{})
+	res, err := service.Save(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// DeleteSavedSearch removes a saved search
+func DeleteSavedSearch(c *gin.Context) {
+	err := explorer.DeleteSavedSearch(c, c.Param("id"))
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{})
+}
+
 // PatchMetadata patch metadata
 func PatchMetadata(c *gin.Context) {
 	service := ParametersFromContext[*explorer.PatchMetadataService](c, explorer.PatchMetadataParameterCtx{})
@@ -401,6 +453,148 @@ func DeleteVersion(c *gin.Context) {
 	c.JSON(200, serializer.Response{})
 }
 
+func PatchVersionRetention(c *gin.Context) {
+	service := ParametersFromContext[*explorer.PatchVersionRetentionService](c, explorer.PatchVersionRetentionParamCtx{})
+	err := service.Patch(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{})
+}
+
+// SaveAlbum creates or renames a photo album
+func SaveAlbum(c *gin.Context) {
+	service := ParametersFromContext[*explorer.SaveAlbumService](c, explorer.SaveAlbumParamCtx{})
+	resp, err := service.Save(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: resp})
+}
+
+// ListAlbums lists photo albums of current user
+func ListAlbums(c *gin.Context) {
+	resp, err := explorer.ListAlbums(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: resp})
+}
+
+// DeleteAlbum deletes a photo album
+func DeleteAlbum(c *gin.Context) {
+	err := explorer.DeleteAlbum(c, hashid.FromContext(c))
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{})
+}
+
+// AddAlbumFiles adds files into a photo album
+func AddAlbumFiles(c *gin.Context) {
+	service := ParametersFromContext[*explorer.AlbumFilesService](c, explorer.AlbumFilesParamCtx{})
+	err := service.Add(c, hashid.FromContext(c))
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{})
+}
+
+// RemoveAlbumFiles removes files from a photo album
+func RemoveAlbumFiles(c *gin.Context) {
+	service := ParametersFromContext[*explorer.AlbumFilesService](c, explorer.AlbumFilesParamCtx{})
+	err := service.Remove(c, hashid.FromContext(c))
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{})
+}
+
+func CreateSnapshot(c *gin.Context) {
+	service := ParametersFromContext[*explorer.CreateSnapshotService](c, explorer.CreateSnapshotParamCtx{})
+	resp, err := service.Create(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{
+		Data: resp,
+	})
+}
+
+func ListSnapshots(c *gin.Context) {
+	service := ParametersFromContext[*explorer.ListSnapshotService](c, explorer.ListSnapshotParamCtx{})
+	resp, err := service.List(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{
+		Data: resp,
+	})
+}
+
+func DeleteSnapshot(c *gin.Context) {
+	err := explorer.DeleteSnapshot(c, hashid.FromContext(c))
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{})
+}
+
+func PatchSnapshotSchedule(c *gin.Context) {
+	service := ParametersFromContext[*explorer.PatchSnapshotScheduleService](c, explorer.PatchSnapshotScheduleParamCtx{})
+	err := service.Patch(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{})
+}
+
+func RestoreSnapshot(c *gin.Context) {
+	service := ParametersFromContext[*explorer.SnapshotRestoreWorkflowService](c, explorer.CreateSnapshotRestoreParamCtx{})
+	resp, err := service.CreateRestoreTask(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	if resp != nil {
+		c.JSON(200, serializer.Response{
+			Data: resp,
+		})
+	}
+}
+
 func PatchView(c *gin.Context) {
 	service := ParametersFromContext[*explorer.PatchViewService](c, explorer.PatchViewParameterCtx{})
 	err := service.Patch(c)
@@ -427,6 +621,88 @@ func ListArchiveFiles(c *gin.Context) {
 	})
 }
 
+// ArchiveMemberUrl gets temporary URL for a single file in an archive
+func ArchiveMemberUrl(c *gin.Context) {
+	service := ParametersFromContext[*explorer.ArchiveMemberService](c, explorer.ArchiveMemberParamCtx{})
+	resp, err := service.GetUrl(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{
+		Data: resp,
+	})
+}
+
+// ArchiveMemberThumb gets temporary thumbnail URL for a single file in an archive
+func ArchiveMemberThumb(c *gin.Context) {
+	service := ParametersFromContext[*explorer.ArchiveMemberService](c, explorer.ArchiveMemberParamCtx{})
+	resp, err := service.GetThumb(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{
+		Data: resp,
+	})
+}
+
+// ServeArchiveMember streams a single file in an archive
+func ServeArchiveMember(c *gin.Context) {
+	service := ParametersFromContext[*explorer.ArchiveMemberContentService](c, explorer.ArchiveMemberContentParamCtx{})
+	err := service.Serve(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+}
+
+// GetTranscodePlaylist gets temporary URL of HLS playlist of a transcoded video
+func GetTranscodePlaylist(c *gin.Context) {
+	service := ParametersFromContext[*explorer.TranscodePlaylistService](c, explorer.TranscodePlaylistParamCtx{})
+	resp, err := service.GetPlaylist(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{
+		Data: resp,
+	})
+}
+
+// ServeTranscodePlaylist writes HLS playlist of a transcoded video
+func ServeTranscodePlaylist(c *gin.Context) {
+	service := ParametersFromContext[*explorer.TranscodePlaylistContentService](c, explorer.TranscodePlaylistContentParamCtx{})
+	err := service.Serve(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+}
+
+// CreateTranscode creates a task to transcode a video into HLS renditions
+func CreateTranscode(c *gin.Context) {
+	service := ParametersFromContext[*explorer.TranscodeWorkflowService](c, explorer.CreateTranscodeParamCtx{})
+	resp, err := service.CreateTask(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{
+		Data: resp,
+	})
+}
+
 func HandleExplorerEventsPush(c *gin.Context) {
 	service := ParametersFromContext[*explorer.ExplorerEventService](c, explorer.ExplorerEventParamCtx{})
 	err := service.HandleExplorerEventsPush(c)
@@ -436,3 +712,162 @@ func HandleExplorerEventsPush(c *gin.Context) {
 		return
 	}
 }
+
+// FindDuplicates creates a task to find duplicated files
+func FindDuplicates(c *gin.Context) {
+	service := ParametersFromContext[*explorer.DuplicateFinderWorkflowService](c, explorer.CreateDuplicateFinderParamCtx{})
+	resp, err := service.CreateDuplicateFinderTask(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: resp})
+}
+
+// TusOptions responds tus server capabilities
+func TusOptions(c *gin.Context) {
+	c.Header("Tus-Resumable", explorer.TusVersion)
+	c.Header("Tus-Version", explorer.TusVersion)
+	c.Header("Tus-Extension", explorer.TusExtensions)
+	c.Header("Tus-Checksum-Algorithm", explorer.TusChecksumAlgorithms)
+	c.Status(http.StatusNoContent)
+}
+
+// TusCreate creates a tus upload
+func TusCreate(c *gin.Context) {
+	if !tusPrecondition(c) {
+		return
+	}
+
+	service := ParametersFromContext[*explorer.TusService](c, explorer.TusParamCtx{})
+	upload, err := service.Create(c)
+	if err != nil {
+		tusError(c, err)
+		return
+	}
+
+	c.Header("Location", strings.TrimSuffix(c.Request.URL.Path, "/")+"/"+upload.ID)
+	tusUploadHeaders(c, upload)
+	c.Status(http.StatusCreated)
+}
+
+// TusHead gets offset of a tus upload
+func TusHead(c *gin.Context) {
+	if !tusPrecondition(c) {
+		return
+	}
+
+	service := ParametersFromContext[*explorer.TusService](c, explorer.TusParamCtx{})
+	upload, err := service.Head(c)
+	if err != nil {
+		tusError(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.Header("Upload-Length", strconv.FormatInt(upload.Length, 10))
+	tusUploadHeaders(c, upload)
+	c.Status(http.StatusOK)
+}
+
+// TusPatch writes data to a tus upload
+func TusPatch(c *gin.Context) {
+	if !tusPrecondition(c) {
+		return
+	}
+
+	if c.GetHeader("Content-Type") != explorer.TusContentType {
+		request.BlackHole(c.Request.Body)
+		c.AbortWithStatus(http.StatusUnsupportedMediaType)
+		return
+	}
+
+	service := ParametersFromContext[*explorer.TusService](c, explorer.TusParamCtx{})
+	upload, err := service.Patch(c)
+	if err != nil {
+		tusError(c, err)
+		return
+	}
+
+	tusUploadHeaders(c, upload)
+	c.Status(http.StatusNoContent)
+}
+
+// TusDelete terminates a tus upload
+func TusDelete(c *gin.Context) {
+	if !tusPrecondition(c) {
+		return
+	}
+
+	service := ParametersFromContext[*explorer.TusService](c, explorer.TusParamCtx{})
+	if err := service.Delete(c); err != nil {
+		tusError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// TusMethodOverride dispatches tus requests sent as POST with X-HTTP-Method-Override header,
+// for clients in environments that cannot send PATCH or DELETE requests.
+func TusMethodOverride(c *gin.Context) {
+	switch strings.ToUpper(c.GetHeader("X-HTTP-Method-Override")) {
+	case http.MethodPatch:
+		TusPatch(c)
+	case http.MethodDelete:
+		TusDelete(c)
+	case http.MethodHead:
+		TusHead(c)
+	default:
+		c.AbortWithStatus(http.StatusMethodNotAllowed)
+	}
+}
+
+// tusPrecondition checks the protocol version requested by client.
+func tusPrecondition(c *gin.Context) bool {
+	c.Header("Tus-Resumable", explorer.TusVersion)
+	if c.GetHeader("Tus-Resumable") != explorer.TusVersion {
+		c.Header("Tus-Version", explorer.TusVersion)
+		request.BlackHole(c.Request.Body)
+		c.AbortWithStatus(http.StatusPreconditionFailed)
+		return false
+	}
+
+	return true
+}
+
+func tusUploadHeaders(c *gin.Context, upload *explorer.TusUpload) {
+	c.Header("Upload-Offset", strconv.FormatInt(upload.Offset, 10))
+	if upload.Offset < upload.Length {
+		c.Header("Upload-Expires", upload.Expires.UTC().Format(http.TimeFormat))
+	}
+}
+
+// tusError responds error with HTTP status code expected by tus clients.
+func tusError(c *gin.Context, err error) {
+	res := serializer.Err(c, err)
+	status := http.StatusInternalServerError
+	switch res.Code {
+	case serializer.CodeUploadSessionExpired, serializer.CodeNotFound:
+		status = http.StatusNotFound
+	case serializer.CodeConflict:
+		status = http.StatusConflict
+	case serializer.CodeLockConflict:
+		status = http.StatusLocked
+	case serializer.CodeChecksumMismatch:
+		// Defined by tus checksum extension
+		status = 460
+	case serializer.CodeFileTooLarge, serializer.CodeInsufficientCapacity:
+		status = http.StatusRequestEntityTooLarge
+	case serializer.CodePolicyNotAllowed, serializer.CodeNoPermissionErr, serializer.CodeFileTypeNotAllowed:
+		status = http.StatusForbidden
+	case serializer.CodeParamErr, serializer.CodeObjectExist, serializer.CodeConflictUploadOngoing:
+		status = http.StatusBadRequest
+	}
+
+	request.BlackHole(c.Request.Body)
+	c.String(status, res.Msg)
+	c.Abort()
+}
@@ -0,0 +1,118 @@
+package controllers
+
+import (
+	"context"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/subsonic"
+	subsonicsvc "github.com/cloudreve/Cloudreve/v4/service/subsonic"
+	"github.com/gin-gonic/gin"
+)
+
+// FromSubsonicForm parses and validates Subsonic parameters from query or form body
+func FromSubsonicForm[T any](ctxKey any) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var service T
+		if err := c.ShouldBind(&service); err == nil {
+			c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), ctxKey, &service))
+			c.Next()
+		} else {
+			subsonic.Abort(c, subsonic.ErrMissingParameter, err.Error())
+		}
+	}
+}
+
+// SubsonicHandler responds result of a Subsonic method in format requested by client
+func SubsonicHandler(f func(c *gin.Context) (*subsonic.Response, error)) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		res, err := f(c)
+		if err != nil {
+			subsonicError(c, err)
+			return
+		}
+
+		subsonic.Write(c, res)
+	}
+}
+
+// SubsonicGetArtist gets an artist
+func SubsonicGetArtist(c *gin.Context) (*subsonic.Response, error) {
+	return ParametersFromContext[*subsonicsvc.IDService](c, subsonicsvc.IDParamCtx{}).GetArtist(c)
+}
+
+// SubsonicGetAlbum gets an album
+func SubsonicGetAlbum(c *gin.Context) (*subsonic.Response, error) {
+	return ParametersFromContext[*subsonicsvc.IDService](c, subsonicsvc.IDParamCtx{}).GetAlbum(c)
+}
+
+// SubsonicGetSong gets a song
+func SubsonicGetSong(c *gin.Context) (*subsonic.Response, error) {
+	return ParametersFromContext[*subsonicsvc.IDService](c, subsonicsvc.IDParamCtx{}).GetSong(c)
+}
+
+// SubsonicGetAlbumList2 lists albums
+func SubsonicGetAlbumList2(c *gin.Context) (*subsonic.Response, error) {
+	return ParametersFromContext[*subsonicsvc.AlbumListService](c, subsonicsvc.AlbumListParamCtx{}).GetAlbumList2(c)
+}
+
+// SubsonicGetRandomSongs lists random songs
+func SubsonicGetRandomSongs(c *gin.Context) (*subsonic.Response, error) {
+	return ParametersFromContext[*subsonicsvc.RandomSongsService](c, subsonicsvc.RandomSongsParamCtx{}).GetRandomSongs(c)
+}
+
+// SubsonicSearch3 searches artists, albums and songs
+func SubsonicSearch3(c *gin.Context) (*subsonic.Response, error) {
+	return ParametersFromContext[*subsonicsvc.SearchService](c, subsonicsvc.SearchParamCtx{}).Search3(c)
+}
+
+// SubsonicGetPlaylist gets a playlist
+func SubsonicGetPlaylist(c *gin.Context) (*subsonic.Response, error) {
+	return ParametersFromContext[*subsonicsvc.IDService](c, subsonicsvc.IDParamCtx{}).GetPlaylist(c)
+}
+
+// SubsonicCreatePlaylist creates a playlist
+func SubsonicCreatePlaylist(c *gin.Context) (*subsonic.Response, error) {
+	return ParametersFromContext[*subsonicsvc.CreatePlaylistService](c, subsonicsvc.CreatePlaylistParamCtx{}).Create(c)
+}
+
+// SubsonicUpdatePlaylist updates a playlist
+func SubsonicUpdatePlaylist(c *gin.Context) (*subsonic.Response, error) {
+	return ParametersFromContext[*subsonicsvc.UpdatePlaylistService](c, subsonicsvc.UpdatePlaylistParamCtx{}).Update(c)
+}
+
+// SubsonicDeletePlaylist deletes a playlist
+func SubsonicDeletePlaylist(c *gin.Context) (*subsonic.Response, error) {
+	return ParametersFromContext[*subsonicsvc.IDService](c, subsonicsvc.IDParamCtx{}).DeletePlaylist(c)
+}
+
+// SubsonicStream streams a song
+func SubsonicStream(c *gin.Context) {
+	service := ParametersFromContext[*subsonicsvc.IDService](c, subsonicsvc.IDParamCtx{})
+	if err := service.Stream(c); err != nil {
+		subsonicError(c, err)
+	}
+}
+
+// SubsonicGetCoverArt gets cover art of a song, album or artist
+func SubsonicGetCoverArt(c *gin.Context) {
+	service := ParametersFromContext[*subsonicsvc.CoverArtService](c, subsonicsvc.CoverArtParamCtx{})
+	if err := service.GetCoverArt(c); err != nil {
+		subsonicError(c, err)
+	}
+}
+
+// subsonicError responds error with error code defined by Subsonic API.
+func subsonicError(c *gin.Context, err error) {
+	res := serializer.Err(c, err)
+	code := subsonic.ErrGeneric
+	switch res.Code {
+	case serializer.CodeNotFound, serializer.CodeParentNotExist, serializer.CodeEntityNotExist:
+		code = subsonic.ErrNotFound
+	case serializer.CodeParamErr:
+		code = subsonic.ErrMissingParameter
+	case serializer.CodeNoPermissionErr, serializer.CodeGroupNotAllowed:
+		code = subsonic.ErrNotAuthorized
+	}
+
+	subsonic.Abort(c, code, res.Msg)
+}
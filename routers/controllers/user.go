@@ -226,6 +226,21 @@ func UserStorage(c *gin.Context) {
 	})
 }
 
+// UserUsage gets storage usage report of current user
+func UserUsage(c *gin.Context) {
+	service := ParametersFromContext[*user.UsageReportService](c, user.UsageReportParamCtx{})
+	res, err := service.Get(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{
+		Data: res,
+	})
+}
+
 // UserSetting 获取用户设定
 func UserSetting(c *gin.Context) {
 	res, err := user.GetUserSettings(c)
@@ -65,6 +65,34 @@ func DeleteDAVAccounts(c *gin.Context) {
 	c.JSON(200, serializer.Response{})
 }
 
+// ListCalDAVCollections lists calendars or address books visible to current user.
+func ListCalDAVCollections(c *gin.Context) {
+	service := ParametersFromContext[*setting.ListCalDAVCollectionsService](c, setting.ListCalDAVCollectionsParamCtx{})
+	resp, err := service.List(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{
+		Data: resp,
+	})
+}
+
+// ShareCalDAVCollection shares a calendar or address book with another user.
+func ShareCalDAVCollection(c *gin.Context) {
+	service := ParametersFromContext[*setting.ShareCalDAVCollectionService](c, setting.ShareCalDAVCollectionParamCtx{})
+	err := service.Share(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{})
+}
+
 //
 //// DeleteWebDAVAccounts 删除WebDAV账户
 //func DeleteWebDAVAccounts(c *gin.Context) {
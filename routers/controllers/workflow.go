@@ -66,3 +66,65 @@ func CancelDownloadTask(c *gin.Context) {
 
 	c.JSON(200, serializer.Response{})
 }
+
+// ListFeedSubscriptions lists feed subscriptions of current user
+func ListFeedSubscriptions(c *gin.Context) {
+	resp, err := explorer.ListFeedSubscriptions(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: resp})
+}
+
+// UpsertFeedSubscription creates or updates a feed subscription
+func UpsertFeedSubscription(c *gin.Context) {
+	service := ParametersFromContext[*explorer.UpsertFeedSubscriptionService](c, explorer.UpsertFeedSubscriptionParamCtx{})
+	resp, err := service.Upsert(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: resp})
+}
+
+// DeleteFeedSubscription deletes a feed subscription
+func DeleteFeedSubscription(c *gin.Context) {
+	err := explorer.DeleteFeedSubscription(c, hashid.FromContext(c))
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{})
+}
+
+// PollFeedSubscription polls a feed subscription immediately
+func PollFeedSubscription(c *gin.Context) {
+	resp, err := explorer.PollFeedSubscription(c, hashid.FromContext(c))
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: resp})
+}
+
+// ListFeedItems lists history items of a feed subscription
+func ListFeedItems(c *gin.Context) {
+	service := ParametersFromContext[*explorer.ListFeedItemsService](c, explorer.ListFeedItemsParamCtx{})
+	resp, err := service.List(c, hashid.FromContext(c))
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: resp})
+}
@@ -2,13 +2,16 @@ package routers
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/cloudreve/Cloudreve/v4/application/constants"
 	"github.com/cloudreve/Cloudreve/v4/application/dependency"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
 	"github.com/cloudreve/Cloudreve/v4/middleware"
+	"github.com/cloudreve/Cloudreve/v4/pkg/caldav"
 	"github.com/cloudreve/Cloudreve/v4/pkg/cluster"
 	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+	"github.com/cloudreve/Cloudreve/v4/pkg/dlna"
 	"github.com/cloudreve/Cloudreve/v4/pkg/downloader/slave"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
 	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
@@ -21,6 +24,7 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/service/node"
 	"github.com/cloudreve/Cloudreve/v4/service/setting"
 	sharesvc "github.com/cloudreve/Cloudreve/v4/service/share"
+	subsonicsvc "github.com/cloudreve/Cloudreve/v4/service/subsonic"
 	usersvc "github.com/cloudreve/Cloudreve/v4/service/user"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-contrib/gzip"
@@ -40,6 +44,24 @@ func InitRouter(dep dependency.Dep) *gin.Engine {
 
 }
 
+// InitDLNARouter initializes routes of the LAN media server, which is served on its own listener.
+func InitDLNARouter(dep dependency.Dep) *gin.Engine {
+	r := newGinEngine(dep)
+	group := r.Group(dlna.Prefix)
+	{
+		group.GET("device.xml", dlna.DeviceDescription)
+		group.GET("scpd/:service", dlna.ServiceDescription)
+		group.POST("control/:service", dlna.Control)
+		group.Handle("SUBSCRIBE", "event/:service", dlna.Subscribe)
+		group.Handle("UNSUBSCRIBE", "event/:service", dlna.Subscribe)
+		group.GET("media/:id", dlna.ServeMedia)
+		group.HEAD("media/:id", dlna.ServeMedia)
+		group.GET("art/:id", dlna.ServeAlbumArt)
+	}
+
+	return r
+}
+
 func newGinEngine(dep dependency.Dep) *gin.Engine {
 	r := gin.New()
 	r.ContextWithFallback = true
@@ -395,6 +417,22 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 					controllers.FromUri[explorer.ArchiveService](explorer.ArchiveParamCtx{}),
 					controllers.DownloadArchive,
 				)
+				// Read single file in an archive
+				file.GET("archive/member/:sessionID/:name",
+					middleware.Sandbox(),
+					controllers.FromUri[explorer.ArchiveMemberContentService](explorer.ArchiveMemberContentParamCtx{}),
+					controllers.ServeArchiveMember,
+				)
+				// HLS playlists of a transcoded video
+				file.GET("transcode/:sessionID/:playlist",
+					controllers.FromUri[explorer.TranscodePlaylistContentService](explorer.TranscodePlaylistContentParamCtx{}),
+					controllers.ServeTranscodePlaylist,
+				)
+				// WebVTT thumbnails track of a video storyboard
+				file.GET("storyboard/:sessionID/storyboard.vtt",
+					controllers.FromUri[explorer.StoryboardVTTService](explorer.StoryboardVTTParameterCtx{}),
+					controllers.ServeStoryboardVTT,
+				)
 			}
 
 			// Copy user session
@@ -537,6 +575,21 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 				controllers.FromJSON[explorer.ArchiveWorkflowService](explorer.CreateArchiveParamCtx{}),
 				controllers.ExtractArchive,
 			)
+			// Create task to restore files from a snapshot
+			wf.POST("restore",
+				controllers.FromJSON[explorer.SnapshotRestoreWorkflowService](explorer.CreateSnapshotRestoreParamCtx{}),
+				controllers.RestoreSnapshot,
+			)
+			// Create task to find duplicated files
+			wf.POST("duplicate",
+				controllers.FromJSON[explorer.DuplicateFinderWorkflowService](explorer.CreateDuplicateFinderParamCtx{}),
+				controllers.FindDuplicates,
+			)
+			// Create task to transcode a video into HLS renditions
+			wf.POST("transcode",
+				controllers.FromJSON[explorer.TranscodeWorkflowService](explorer.CreateTranscodeParamCtx{}),
+				controllers.CreateTranscode,
+			)
 
 			remoteDownload := wf.Group("download")
 			{
@@ -556,6 +609,34 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 					controllers.CancelDownloadTask,
 				)
 			}
+
+			// Feed subscriptions for automatic remote downloads
+			feed := wf.Group("feed")
+			{
+				// List feed subscriptions
+				feed.GET("", controllers.ListFeedSubscriptions)
+				// Create or update feed subscription
+				feed.PUT("",
+					controllers.FromJSON[explorer.UpsertFeedSubscriptionService](explorer.UpsertFeedSubscriptionParamCtx{}),
+					controllers.UpsertFeedSubscription,
+				)
+				// Delete feed subscription
+				feed.DELETE(":id",
+					middleware.HashID(hashid.FeedSubscriptionID),
+					controllers.DeleteFeedSubscription,
+				)
+				// Poll feed subscription now
+				feed.POST(":id/poll",
+					middleware.HashID(hashid.FeedSubscriptionID),
+					controllers.PollFeedSubscription,
+				)
+				// List history items of feed subscription
+				feed.GET(":id/items",
+					middleware.HashID(hashid.FeedSubscriptionID),
+					controllers.FromQuery[explorer.ListFeedItemsService](explorer.ListFeedItemsParamCtx{}),
+					controllers.ListFeedItems,
+				)
+			}
 		}
 
 		// 文件
@@ -570,6 +651,21 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 				controllers.FromQuery[explorer.ArchiveListFilesService](explorer.ArchiveListFilesParamCtx{}),
 				controllers.ListArchiveFiles,
 			)
+			// Get URL of a single file in an archive
+			file.POST("archive/member",
+				controllers.FromJSON[explorer.ArchiveMemberService](explorer.ArchiveMemberParamCtx{}),
+				controllers.ArchiveMemberUrl,
+			)
+			// Get thumbnail of a single file in an archive
+			file.GET("archive/thumb",
+				controllers.FromQuery[explorer.ArchiveMemberService](explorer.ArchiveMemberParamCtx{}),
+				controllers.ArchiveMemberThumb,
+			)
+			// Get HLS playlist of a transcoded video
+			file.GET("transcode",
+				controllers.FromQuery[explorer.TranscodePlaylistService](explorer.TranscodePlaylistParamCtx{}),
+				controllers.GetTranscodePlaylist,
+			)
 			// Create file
 			file.POST("create",
 				controllers.FromJSON[explorer.CreateFileService](explorer.CreateFileParameterCtx{}),
@@ -624,6 +720,12 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 				controllers.FromQuery[explorer.FileThumbService](explorer.FileThumbParameterCtx{}),
 				controllers.Thumb,
 			)
+			// get video storyboard
+			file.GET("storyboard",
+				middleware.ContextHint(),
+				controllers.FromQuery[explorer.FileStoryboardService](explorer.FileStoryboardParameterCtx{}),
+				controllers.Storyboard,
+			)
 			// Delete files
 			file.DELETE("",
 				controllers.FromJSON[explorer.DeleteFileService](explorer.DeleteFileParameterCtx{}),
@@ -665,6 +767,32 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 					controllers.DeleteUploadSession,
 				)
 			}
+			// tus resumable upload protocol
+			file.OPTIONS("tus", controllers.TusOptions)
+			file.OPTIONS("tus/:sessionId", controllers.TusOptions)
+			tus := file.Group("tus", middleware.LoginRequired())
+			{
+				tus.POST("",
+					controllers.FromUri[explorer.TusService](explorer.TusParamCtx{}),
+					controllers.TusCreate,
+				)
+				tus.HEAD(":sessionId",
+					controllers.FromUri[explorer.TusService](explorer.TusParamCtx{}),
+					controllers.TusHead,
+				)
+				tus.PATCH(":sessionId",
+					controllers.FromUri[explorer.TusService](explorer.TusParamCtx{}),
+					controllers.TusPatch,
+				)
+				tus.DELETE(":sessionId",
+					controllers.FromUri[explorer.TusService](explorer.TusParamCtx{}),
+					controllers.TusDelete,
+				)
+				tus.POST(":sessionId",
+					controllers.FromUri[explorer.TusService](explorer.TusParamCtx{}),
+					controllers.TusMethodOverride,
+				)
+			}
 			// Pin file
 			pin := file.Group("pin")
 			{
@@ -679,6 +807,17 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 					controllers.Unpin,
 				)
 			}
+			// Saved search
+			search := file.Group("search")
+			{
+				// Create or update saved search
+				search.PUT("",
+					controllers.FromJSON[explorer.SaveSearchService](explorer.SaveSearchParameterCtx{}),
+					controllers.SaveSearch,
+				)
+				// Delete saved search
+				search.DELETE(":id", controllers.DeleteSavedSearch)
+			}
 			// Get file info
 			file.GET("info",
 				controllers.FromQuery[explorer.GetFileInfoService](explorer.GetFileInfoParameterCtx{}),
@@ -697,6 +836,63 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 					controllers.FromJSON[explorer.DeleteVersionService](explorer.DeleteVersionParamCtx{}),
 					controllers.DeleteVersion,
 				)
+				// Set version retention schedule of a file or folder
+				version.PATCH("retention",
+					controllers.FromJSON[explorer.PatchVersionRetentionService](explorer.PatchVersionRetentionParamCtx{}),
+					controllers.PatchVersionRetention,
+				)
+			}
+			// Folder snapshots
+			snapshot := file.Group("snapshot")
+			{
+				// Take a snapshot of a folder
+				snapshot.PUT("",
+					controllers.FromJSON[explorer.CreateSnapshotService](explorer.CreateSnapshotParamCtx{}),
+					controllers.CreateSnapshot,
+				)
+				// List snapshots
+				snapshot.GET("",
+					controllers.FromQuery[explorer.ListSnapshotService](explorer.ListSnapshotParamCtx{}),
+					controllers.ListSnapshots,
+				)
+				// Delete a snapshot
+				snapshot.DELETE(":id",
+					middleware.HashID(hashid.SnapshotID),
+					controllers.DeleteSnapshot,
+				)
+				// Set snapshot schedule of a folder
+				snapshot.PATCH("schedule",
+					controllers.FromJSON[explorer.PatchSnapshotScheduleService](explorer.PatchSnapshotScheduleParamCtx{}),
+					controllers.PatchSnapshotSchedule,
+				)
+			}
+			// Photo albums
+			album := file.Group("album")
+			{
+				// Create or rename an album
+				album.PUT("",
+					controllers.FromJSON[explorer.SaveAlbumService](explorer.SaveAlbumParamCtx{}),
+					controllers.SaveAlbum,
+				)
+				// List albums
+				album.GET("", controllers.ListAlbums)
+				// Delete an album
+				album.DELETE(":id",
+					middleware.HashID(hashid.AlbumID),
+					controllers.DeleteAlbum,
+				)
+				// Add files into an album
+				album.POST(":id/files",
+					middleware.HashID(hashid.AlbumID),
+					controllers.FromJSON[explorer.AlbumFilesService](explorer.AlbumFilesParamCtx{}),
+					controllers.AddAlbumFiles,
+				)
+				// Remove files from an album
+				album.DELETE(":id/files",
+					middleware.HashID(hashid.AlbumID),
+					controllers.FromJSON[explorer.AlbumFilesService](explorer.AlbumFilesParamCtx{}),
+					controllers.RemoveAlbumFiles,
+				)
 			}
 			file.PUT("viewerSession",
 				controllers.FromJSON[explorer.CreateViewerSessionService](explorer.CreateViewerSessionParamCtx{}),
@@ -879,6 +1075,11 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 						controllers.FromJSON[adminsvc.CleanupTaskService](adminsvc.CleanupTaskParameterCtx{}),
 						controllers.AdminCleanupTask,
 					)
+					// Create task to regenerate media meta and thumbnails of existing files
+					queue.POST("regenerate",
+						controllers.FromJSON[adminsvc.MediaRegenerateService](adminsvc.MediaRegenerateParamCtx{}),
+						controllers.AdminCreateMediaRegenerateTask,
+					)
 					// // 列出任务
 					// queue.POST("list", controllers.AdminListTask)
 					// // 新建文件导入任务
@@ -1094,6 +1295,11 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 				user.GET("me", controllers.UserMe)
 				// 存储信息
 				user.GET("capacity", controllers.UserStorage)
+				// Storage usage breakdown
+				user.GET("usage",
+					controllers.FromQuery[usersvc.UsageReportService](usersvc.UsageReportParamCtx{}),
+					controllers.UserUsage,
+				)
 				// Search user by keywords
 				user.GET("search",
 					controllers.FromQuery[usersvc.SearchUserService](usersvc.SearchUserParamCtx{}),
@@ -1160,6 +1366,16 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 						middleware.HashID(hashid.DavAccountID),
 						controllers.DeleteDAVAccounts,
 					)
+					// List CalDAV calendars or CardDAV address books
+					dav.GET("collections",
+						controllers.FromQuery[setting.ListCalDAVCollectionsService](setting.ListCalDAVCollectionsParamCtx{}),
+						controllers.ListCalDAVCollections,
+					)
+					// Share or unshare a calendar or address book
+					dav.PUT("collections/share",
+						controllers.FromJSON[setting.ShareCalDAVCollectionService](setting.ShareCalDAVCollectionParamCtx{}),
+						controllers.ShareCalDAVCollection,
+					)
 				}
 				//// 获取账号信息
 				//devices.GET("dav", controllers.GetWebDAVAccounts)
@@ -1180,6 +1396,9 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 
 	// 初始化WebDAV相关路由
 	initWebDAV(r.Group("dav"))
+	initCalDAV(r, caldav.KindCalendar)
+	initCalDAV(r, caldav.KindAddressBook)
+	initSubsonic(r.Group("rest"))
 	return r
 }
 
@@ -1191,6 +1410,8 @@ func initWebDAV(group *gin.RouterGroup) {
 		group.Any("", webdav.ServeHTTP)
 		group.Handle("PROPFIND", "/*path", webdav.ServeHTTP)
 		group.Handle("PROPFIND", "", webdav.ServeHTTP)
+		group.Handle("REPORT", "/*path", webdav.ServeHTTP)
+		group.Handle("REPORT", "", webdav.ServeHTTP)
 		group.Handle("MKCOL", "/*path", webdav.ServeHTTP)
 		group.Handle("LOCK", "/*path", webdav.ServeHTTP)
 		group.Handle("UNLOCK", "/*path", webdav.ServeHTTP)
@@ -1200,3 +1421,94 @@ func initWebDAV(group *gin.RouterGroup) {
 
 	}
 }
+
+// initCalDAV initializes CalDAV or CardDAV routes and the well-known URI for service discovery.
+func initCalDAV(r *gin.Engine, kind caldav.Kind) {
+	handler := caldav.ServeHTTP(kind)
+	group := r.Group(kind.Prefix())
+	group.Use(middleware.CacheControl(), middleware.WebDAVAuth())
+	for _, method := range []string{"PROPFIND", "PROPPATCH", "REPORT", "MKCOL", "MKCALENDAR"} {
+		group.Handle(method, "", handler)
+		group.Handle(method, "/*path", handler)
+	}
+	group.Any("", handler)
+	group.Any("/*path", handler)
+
+	wellKnown := "/.well-known/" + strings.TrimPrefix(kind.Prefix(), "/")
+	r.Any(wellKnown, caldav.WellKnown(kind))
+	r.Handle("PROPFIND", wellKnown, caldav.WellKnown(kind))
+}
+
+// initSubsonic initializes Subsonic API routes, each method is also accessible with ".view" suffix.
+func initSubsonic(group *gin.RouterGroup) {
+	group.Use(middleware.CacheControl(), middleware.SubsonicAuth())
+	handle := func(method string, handlers ...gin.HandlerFunc) {
+		for _, path := range []string{method, method + ".view"} {
+			group.GET(path, handlers...)
+			group.POST(path, handlers...)
+		}
+	}
+
+	handle("ping", controllers.SubsonicHandler(subsonicsvc.Ping))
+	handle("getLicense", controllers.SubsonicHandler(subsonicsvc.GetLicense))
+	handle("getOpenSubsonicExtensions", controllers.SubsonicHandler(subsonicsvc.GetOpenSubsonicExtensions))
+	handle("getMusicFolders", controllers.SubsonicHandler(subsonicsvc.GetMusicFolders))
+	handle("getIndexes", controllers.SubsonicHandler(subsonicsvc.GetIndexes))
+	handle("getArtists", controllers.SubsonicHandler(subsonicsvc.GetArtists))
+	handle("getArtist",
+		controllers.FromSubsonicForm[subsonicsvc.IDService](subsonicsvc.IDParamCtx{}),
+		controllers.SubsonicHandler(controllers.SubsonicGetArtist),
+	)
+	handle("getAlbum",
+		controllers.FromSubsonicForm[subsonicsvc.IDService](subsonicsvc.IDParamCtx{}),
+		controllers.SubsonicHandler(controllers.SubsonicGetAlbum),
+	)
+	handle("getSong",
+		controllers.FromSubsonicForm[subsonicsvc.IDService](subsonicsvc.IDParamCtx{}),
+		controllers.SubsonicHandler(controllers.SubsonicGetSong),
+	)
+	handle("getAlbumList2",
+		controllers.FromSubsonicForm[subsonicsvc.AlbumListService](subsonicsvc.AlbumListParamCtx{}),
+		controllers.SubsonicHandler(controllers.SubsonicGetAlbumList2),
+	)
+	handle("getRandomSongs",
+		controllers.FromSubsonicForm[subsonicsvc.RandomSongsService](subsonicsvc.RandomSongsParamCtx{}),
+		controllers.SubsonicHandler(controllers.SubsonicGetRandomSongs),
+	)
+	handle("search3",
+		controllers.FromSubsonicForm[subsonicsvc.SearchService](subsonicsvc.SearchParamCtx{}),
+		controllers.SubsonicHandler(controllers.SubsonicSearch3),
+	)
+	handle("stream",
+		controllers.FromSubsonicForm[subsonicsvc.IDService](subsonicsvc.IDParamCtx{}),
+		controllers.SubsonicStream,
+	)
+	handle("download",
+		controllers.FromSubsonicForm[subsonicsvc.IDService](subsonicsvc.IDParamCtx{}),
+		controllers.SubsonicStream,
+	)
+	handle("getCoverArt",
+		controllers.FromSubsonicForm[subsonicsvc.CoverArtService](subsonicsvc.CoverArtParamCtx{}),
+		controllers.SubsonicGetCoverArt,
+	)
+	handle("getPlaylists", controllers.SubsonicHandler(subsonicsvc.GetPlaylists))
+	handle("getPlaylist",
+		controllers.FromSubsonicForm[subsonicsvc.IDService](subsonicsvc.IDParamCtx{}),
+		controllers.SubsonicHandler(controllers.SubsonicGetPlaylist),
+	)
+	handle("createPlaylist",
+		middleware.SubsonicWritable(),
+		controllers.FromSubsonicForm[subsonicsvc.CreatePlaylistService](subsonicsvc.CreatePlaylistParamCtx{}),
+		controllers.SubsonicHandler(controllers.SubsonicCreatePlaylist),
+	)
+	handle("updatePlaylist",
+		middleware.SubsonicWritable(),
+		controllers.FromSubsonicForm[subsonicsvc.UpdatePlaylistService](subsonicsvc.UpdatePlaylistParamCtx{}),
+		controllers.SubsonicHandler(controllers.SubsonicUpdatePlaylist),
+	)
+	handle("deletePlaylist",
+		middleware.SubsonicWritable(),
+		controllers.FromSubsonicForm[subsonicsvc.IDService](subsonicsvc.IDParamCtx{}),
+		controllers.SubsonicHandler(controllers.SubsonicDeletePlaylist),
+	)
+}
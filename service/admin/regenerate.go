@@ -0,0 +1,85 @@
+package admin
+
+import (
+	"github.com/cloudreve/Cloudreve/v4/application/constants"
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/workflows"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/gin-gonic/gin"
+)
+
+type (
+	MediaRegenerateService struct {
+		// Users whose files are scanned, in hash ID.
+		Users []string `json:"users"`
+		// Policies whose files are scanned.
+		Policies []int `json:"policies"`
+		// Folders to scan recursively.
+		Folders   []string `json:"folders"`
+		MediaMeta bool     `json:"media_meta"`
+		Thumbnail bool     `json:"thumbnail"`
+		Force     bool     `json:"force"`
+		// RateLimit is the maximum files processed per second, 0 for unlimited.
+		RateLimit float64 `json:"rate_limit" binding:"min=0"`
+	}
+	MediaRegenerateParamCtx struct{}
+)
+
+// CreateTask creates a task to back-fill media meta and thumbnails of existing files.
+func (s *MediaRegenerateService) CreateTask(c *gin.Context) (*GetTaskResponse, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	hasher := dep.HashIDEncoder()
+
+	if !s.MediaMeta && !s.Thumbnail {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Nothing to regenerate", nil)
+	}
+
+	if len(s.Users)+len(s.Policies)+len(s.Folders) == 0 {
+		return nil, serializer.NewError(serializer.CodeParamErr, "No target selected", nil)
+	}
+
+	users := make([]int, 0, len(s.Users))
+	for _, u := range s.Users {
+		uid, err := hasher.Decode(u, hashid.UserID)
+		if err != nil {
+			return nil, serializer.NewError(serializer.CodeParamErr, "Invalid user id", err)
+		}
+
+		users = append(users, uid)
+	}
+
+	folders := make([]string, 0, len(s.Folders))
+	for _, folder := range s.Folders {
+		uri, err := fs.NewUriFromString(folder)
+		if err != nil || uri.FileSystem() != constants.FileSystemMy {
+			return nil, serializer.NewError(serializer.CodeParamErr, "Invalid folder uri", err)
+		}
+
+		folders = append(folders, uri.String())
+	}
+
+	t, err := workflows.NewMediaRegenerateTask(c, user, users, s.Policies, folders, manager.MediaRegenerateArgs{
+		MediaMeta: s.MediaMeta,
+		Thumbnail: s.Thumbnail,
+		Force:     s.Force,
+	}, s.RateLimit)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeCreateTaskError, "Failed to create task", err)
+	}
+
+	if err := dep.IoIntenseQueue(c).QueueTask(c, t); err != nil {
+		return nil, serializer.NewError(serializer.CodeCreateTaskError, "Failed to queue task", err)
+	}
+
+	return &GetTaskResponse{
+		Task:       t.Model(),
+		Summary:    t.Summarize(hasher),
+		UserHashID: hashid.EncodeUserID(hasher, user.ID),
+		TaskHashID: hashid.EncodeTaskID(hasher, t.ID()),
+	}, nil
+}
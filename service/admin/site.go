@@ -223,6 +223,10 @@ var (
 		"media_meta_exif":                            mediaMetaPostProcessor,
 		"media_meta_music":                           mediaMetaPostProcessor,
 		"media_meta_ffprobe":                         mediaMetaPostProcessor,
+		"media_meta_document":                        mediaMetaPostProcessor,
+		"media_meta_geocoding":                       mediaMetaPostProcessor,
+		"media_meta_geocoding_provider":              mediaMetaPostProcessor,
+		"media_meta_geocoding_offline_dataset":       mediaMetaPostProcessor,
 		"smtpUser":                                   emailPostProcessor,
 		"smtpPass":                                   emailPostProcessor,
 		"smtpHost":                                   emailPostProcessor,
@@ -262,6 +266,12 @@ var (
 		"queue_remote_download_backoff_max_duration": remoteDownloadQueuePostProcessor,
 		"queue_remote_download_max_retry":            remoteDownloadQueuePostProcessor,
 		"queue_remote_download_retry_delay":          remoteDownloadQueuePostProcessor,
+		"queue_transcode_worker_num":                 transcodeQueuePostProcessor,
+		"queue_transcode_max_execution":              transcodeQueuePostProcessor,
+		"queue_transcode_backoff_factor":             transcodeQueuePostProcessor,
+		"queue_transcode_backoff_max_duration":       transcodeQueuePostProcessor,
+		"queue_transcode_max_retry":                  transcodeQueuePostProcessor,
+		"queue_transcode_retry_delay":                transcodeQueuePostProcessor,
 		"secret_key":                                 secretKeyPostProcessor,
 	}
 )
@@ -393,6 +403,12 @@ func remoteDownloadQueuePostProcessor(ctx context.Context, settings map[string]s
 	return nil
 }
 
+func transcodeQueuePostProcessor(ctx context.Context, settings map[string]string) error {
+	dep := dependency.FromContext(ctx)
+	dep.TranscodeQueue(context.WithValue(ctx, dependency.ReloadCtx{}, true)).Start()
+	return nil
+}
+
 func entityRecycleQueuePostProcessor(ctx context.Context, settings map[string]string) error {
 	dep := dependency.FromContext(ctx)
 	dep.EntityRecycleQueue(context.WithValue(ctx, dependency.ReloadCtx{}, true)).Start()
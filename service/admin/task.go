@@ -27,6 +27,7 @@ func GetQueueMetrics(c *gin.Context) ([]QueueMetric, error) {
 	ioIntense := dep.IoIntenseQueue(c)
 	remoteDownload := dep.RemoteDownloadQueue(c)
 	thumb := dep.ThumbQueue(c)
+	transcode := dep.TranscodeQueue(c)
 
 	res = append(res, QueueMetric{
 		Name:            setting.QueueTypeMediaMeta,
@@ -68,6 +69,14 @@ func GetQueueMetrics(c *gin.Context) ([]QueueMetric, error) {
 		SubmittedTasks:  thumb.SubmittedTasks(),
 		SuspendingTasks: thumb.SuspendingTasks(),
 	})
+	res = append(res, QueueMetric{
+		Name:            setting.QueueTypeTranscode,
+		BusyWorkers:     transcode.BusyWorkers(),
+		SuccessTasks:    transcode.SuccessTasks(),
+		FailureTasks:    transcode.FailureTasks(),
+		SubmittedTasks:  transcode.SubmittedTasks(),
+		SuspendingTasks: transcode.SuspendingTasks(),
+	})
 
 	return res, nil
 }
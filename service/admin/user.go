@@ -211,6 +211,11 @@ func (s *BatchUserService) Delete(c *gin.Context) error {
 	dep := dependency.FromContext(c)
 	userClient := dep.UserClient()
 	fileClient := dep.FileClient()
+	snapshotClient := dep.SnapshotClient()
+	fileActivityClient := dep.FileActivityClient()
+	feedClient := dep.FeedClient()
+	albumClient := dep.AlbumClient()
+	playlistClient := dep.PlaylistClient()
 
 	current := inventory.UserFromContext(c)
 	ae := serializer.NewAggregateError()
@@ -232,6 +237,66 @@ func (s *BatchUserService) Delete(c *gin.Context) error {
 			continue
 		}
 
+		sc, _, ctx, err := inventory.WithTx(ctx, snapshotClient)
+		if err != nil {
+			ae.Add(strconv.Itoa(id), serializer.NewError(serializer.CodeDBError, "Failed to start transaction", err))
+			continue
+		}
+
+		fac, _, ctx, err := inventory.WithTx(ctx, fileActivityClient)
+		if err != nil {
+			ae.Add(strconv.Itoa(id), serializer.NewError(serializer.CodeDBError, "Failed to start transaction", err))
+			continue
+		}
+
+		feedc, _, ctx, err := inventory.WithTx(ctx, feedClient)
+		if err != nil {
+			ae.Add(strconv.Itoa(id), serializer.NewError(serializer.CodeDBError, "Failed to start transaction", err))
+			continue
+		}
+
+		ac, _, ctx, err := inventory.WithTx(ctx, albumClient)
+		if err != nil {
+			ae.Add(strconv.Itoa(id), serializer.NewError(serializer.CodeDBError, "Failed to start transaction", err))
+			continue
+		}
+
+		pc, _, ctx, err := inventory.WithTx(ctx, playlistClient)
+		if err != nil {
+			ae.Add(strconv.Itoa(id), serializer.NewError(serializer.CodeDBError, "Failed to start transaction", err))
+			continue
+		}
+
+		if err := sc.DeleteByUser(ctx, id); err != nil {
+			_ = inventory.Rollback(tx)
+			ae.Add(strconv.Itoa(id), serializer.NewError(serializer.CodeDBError, "Failed to delete user snapshots", err))
+			continue
+		}
+
+		if err := fac.DeleteByUser(ctx, id); err != nil {
+			_ = inventory.Rollback(tx)
+			ae.Add(strconv.Itoa(id), serializer.NewError(serializer.CodeDBError, "Failed to delete user file activities", err))
+			continue
+		}
+
+		if err := feedc.DeleteByUser(ctx, id); err != nil {
+			_ = inventory.Rollback(tx)
+			ae.Add(strconv.Itoa(id), serializer.NewError(serializer.CodeDBError, "Failed to delete user feed subscriptions", err))
+			continue
+		}
+
+		if err := ac.DeleteByUser(ctx, id); err != nil {
+			_ = inventory.Rollback(tx)
+			ae.Add(strconv.Itoa(id), serializer.NewError(serializer.CodeDBError, "Failed to delete user albums", err))
+			continue
+		}
+
+		if err := pc.DeleteByUser(ctx, id); err != nil {
+			_ = inventory.Rollback(tx)
+			ae.Add(strconv.Itoa(id), serializer.NewError(serializer.CodeDBError, "Failed to delete user playlists", err))
+			continue
+		}
+
 		if err := fc.DeleteByUser(ctx, id); err != nil {
 			_ = inventory.Rollback(tx)
 			ae.Add(strconv.Itoa(id), serializer.NewError(serializer.CodeDBError, "Failed to delete user files", err))
@@ -248,6 +313,8 @@ func (s *BatchUserService) Delete(c *gin.Context) error {
 			ae.Add(strconv.Itoa(id), serializer.NewError(serializer.CodeDBError, "Failed to commit transaction", err))
 			continue
 		}
+
+		_ = dep.KV().Delete(manager.UsageReportCachePrefix, strconv.Itoa(id))
 	}
 
 	return ae.Aggregate()
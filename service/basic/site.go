@@ -137,6 +137,9 @@ func (s *GetSettingService) GetSiteConfig(c *gin.Context) (*SiteConfig, error) {
 			for _, e := range thumb.BuiltinSupportedExts {
 				exts[e] = true
 			}
+			for _, e := range settings.BuiltinTextThumbExts(c) {
+				exts[strings.ToLower(e)] = true
+			}
 		}
 		if settings.FFMpegThumbGeneratorEnabled(c) {
 			for _, e := range settings.FFMpegThumbExts(c) {
@@ -158,6 +161,11 @@ func (s *GetSettingService) GetSiteConfig(c *gin.Context) (*SiteConfig, error) {
 				exts[strings.ToLower(e)] = true
 			}
 		}
+		if settings.EpubCoverThumbGeneratorEnabled(c) {
+			for _, e := range settings.EpubCoverThumbExts(c) {
+				exts[strings.ToLower(e)] = true
+			}
+		}
 		if settings.LibRawThumbGeneratorEnabled(c) {
 			for _, e := range settings.LibRawThumbExts(c) {
 				exts[strings.ToLower(e)] = true
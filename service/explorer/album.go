@@ -0,0 +1,177 @@
+package explorer
+
+import (
+	"strings"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+type (
+	SaveAlbumParamCtx struct{}
+	SaveAlbumService  struct {
+		// ID of existing album to rename, new album is created if empty.
+		ID   string `json:"id"`
+		Name string `json:"name" binding:"required,max=255"`
+	}
+)
+
+// Save creates or renames an album
+func (s *SaveAlbumService) Save(c *gin.Context) (*Album, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	albumClient := dep.AlbumClient()
+
+	name := strings.TrimSpace(s.Name)
+	if name == "" || strings.Contains(name, fs.Separator) {
+		return nil, serializer.NewError(serializer.CodeParamErr, "invalid album name", nil)
+	}
+
+	existed, err := albumClient.GetByName(c, user.ID, name)
+	if err == nil && (s.ID == "" || existed.ID != albumIDFromHash(dep, s.ID)) {
+		return nil, serializer.NewError(serializer.CodeObjectExist, "album with the same name already exists", nil)
+	}
+
+	var album *ent.Album
+	if s.ID != "" {
+		album, err = albumFromHash(c, dep, s.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		album, err = albumClient.Rename(c, album, name)
+	} else {
+		album, err = albumClient.Create(c, user.ID, name)
+	}
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "failed to save album", err)
+	}
+
+	return BuildAlbum(album, dep.HashIDEncoder()), nil
+}
+
+// ListAlbums lists all albums of current user
+func ListAlbums(c *gin.Context) ([]Album, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+
+	albums, err := dep.AlbumClient().List(c, user.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "failed to list albums", err)
+	}
+
+	return lo.Map(albums, func(a *ent.Album, index int) Album {
+		return *BuildAlbum(a, dep.HashIDEncoder())
+	}), nil
+}
+
+// DeleteAlbum deletes an album of current user, files in it are not affected
+func DeleteAlbum(c *gin.Context, id int) error {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	albumClient := dep.AlbumClient()
+
+	album, err := albumClient.GetByID(c, id)
+	if err != nil || album.OwnerID != user.ID {
+		return serializer.NewError(serializer.CodeNotFound, "Album not exist", err)
+	}
+
+	ac, tx, ctx, err := inventory.WithTx(c, albumClient)
+	if err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to start transaction", err)
+	}
+
+	if err := ac.Delete(ctx, album.ID); err != nil {
+		_ = inventory.Rollback(tx)
+		return serializer.NewError(serializer.CodeDBError, "Failed to delete album", err)
+	}
+
+	if err := inventory.Commit(tx); err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to commit transaction", err)
+	}
+
+	return nil
+}
+
+type (
+	AlbumFilesParamCtx struct{}
+	AlbumFilesService  struct {
+		Uris []string `json:"uris" binding:"required,min=1"`
+	}
+)
+
+// Add adds files into album
+func (s *AlbumFilesService) Add(c *gin.Context, id int) error {
+	return s.patch(c, id, false)
+}
+
+// Remove removes files from album
+func (s *AlbumFilesService) Remove(c *gin.Context, id int) error {
+	return s.patch(c, id, true)
+}
+
+func (s *AlbumFilesService) patch(c *gin.Context, id int, remove bool) error {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	albumClient := dep.AlbumClient()
+	m := manager.NewFileManager(dep, user)
+	defer m.Recycle()
+
+	album, err := albumClient.GetByID(c, id)
+	if err != nil || album.OwnerID != user.ID {
+		return serializer.NewError(serializer.CodeNotFound, "Album not exist", err)
+	}
+
+	uris, err := fs.NewUriFromStrings(s.Uris...)
+	if err != nil {
+		return serializer.NewError(serializer.CodeParamErr, "unknown uri", err)
+	}
+
+	fileIDs := make([]int, 0, len(uris))
+	for _, uri := range uris {
+		file, err := m.Get(c, uri)
+		if err != nil {
+			return serializer.NewError(serializer.CodeNotFound, "File not found", err)
+		}
+
+		if file.OwnerID() != user.ID || file.Type() != types.FileTypeFile {
+			return serializer.NewError(serializer.CodeParamErr, "Only owned files can be put in album", nil)
+		}
+
+		fileIDs = append(fileIDs, file.ID())
+	}
+
+	if remove {
+		err = albumClient.RemoveFiles(c, album, fileIDs...)
+	} else {
+		err = albumClient.AddFiles(c, album, fileIDs...)
+	}
+	if err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to update album files", err)
+	}
+
+	return nil
+}
+
+func albumFromHash(c *gin.Context, dep dependency.Dep, id string) (*ent.Album, error) {
+	album, err := dep.AlbumClient().GetByID(c, albumIDFromHash(dep, id))
+	if err != nil || album.OwnerID != inventory.UserFromContext(c).ID {
+		return nil, serializer.NewError(serializer.CodeNotFound, "Album not exist", err)
+	}
+
+	return album, nil
+}
+
+// albumIDFromHash decodes album hash ID, 0 is returned for invalid ID.
+func albumIDFromHash(dep dependency.Dep, id string) int {
+	albumID, _ := dep.HashIDEncoder().Decode(id, hashid.AlbumID)
+	return albumID
+}
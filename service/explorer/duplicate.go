@@ -0,0 +1,66 @@
+package explorer
+
+import (
+	"github.com/cloudreve/Cloudreve/v4/application/constants"
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/workflows"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/gin-gonic/gin"
+)
+
+type (
+	DuplicateFinderWorkflowService struct {
+		// Uri is the folder to scan, defaults to user's root folder.
+		Uri string `json:"uri"`
+		// AllUsers scans files of all users, admin only and report only.
+		AllUsers bool   `json:"all_users"`
+		Action   string `json:"action" binding:"omitempty,eq=link|eq=delete"`
+	}
+	CreateDuplicateFinderParamCtx struct{}
+)
+
+// CreateDuplicateFinderTask creates a task to find, and optionally resolve duplicated files.
+func (service *DuplicateFinderWorkflowService) CreateDuplicateFinderTask(c *gin.Context) (*TaskResponse, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	hasher := dep.HashIDEncoder()
+
+	uri := ""
+	if service.AllUsers {
+		if !user.Edges.Group.Permissions.Enabled(int(types.GroupPermissionIsAdmin)) {
+			return nil, serializer.NewError(serializer.CodeGroupNotAllowed, "Only admin can scan files of all users", nil)
+		}
+
+		if service.Action != "" {
+			return nil, serializer.NewError(serializer.CodeParamErr, "Duplicates of all users can only be reported", nil)
+		}
+	} else {
+		uri = service.Uri
+		if uri == "" {
+			uri = constants.CloudreveScheme + "://" + string(constants.FileSystemMy)
+		}
+
+		parsed, err := fs.NewUriFromString(uri)
+		if err != nil || parsed.FileSystem() != constants.FileSystemMy {
+			return nil, serializer.NewError(serializer.CodeParamErr, "Invalid uri", err)
+		}
+
+		uri = parsed.String()
+	}
+
+	// Create task
+	t, err := workflows.NewDuplicateFinderTask(c, user, uri, service.AllUsers, manager.DuplicateAction(service.Action))
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeCreateTaskError, "Failed to create task", err)
+	}
+
+	if err := dep.IoIntenseQueue(c).QueueTask(c, t); err != nil {
+		return nil, serializer.NewError(serializer.CodeCreateTaskError, "Failed to queue task", err)
+	}
+
+	return BuildTaskResponse(t, nil, hasher), nil
+}
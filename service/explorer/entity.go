@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/cloudreve/Cloudreve/v4/application/dependency"
 	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
 	"github.com/cloudreve/Cloudreve/v4/pkg/cluster/routes"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
@@ -93,6 +94,33 @@ type (
 	}
 )
 
+type (
+	PatchVersionRetentionParamCtx struct{}
+	PatchVersionRetentionService  struct {
+		Uri      string                          `json:"uri" binding:"required"`
+		Schedule *types.VersionRetentionSchedule `json:"schedule"`
+	}
+)
+
+// Patch sets or removes the version retention schedule of a file or folder
+func (s *PatchVersionRetentionService) Patch(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	m := manager.NewFileManager(dep, user)
+	defer m.Recycle()
+
+	uri, err := fs.NewUriFromString(s.Uri)
+	if err != nil {
+		return serializer.NewError(serializer.CodeParamErr, "unknown uri", err)
+	}
+
+	if err := m.PatchVersionRetention(c, uri, s.Schedule); err != nil {
+		return fmt.Errorf("failed to patch version retention: %w", err)
+	}
+
+	return nil
+}
+
 // Delete deletes the version of the file
 func (s *DeleteVersionService) Delete(c *gin.Context) error {
 	dep := dependency.FromContext(c)
@@ -0,0 +1,218 @@
+package explorer
+
+import (
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/dbfs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/workflows"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+const maxFeedSubscriptions = 50
+
+// ListFeedSubscriptions lists feed subscriptions of current user
+func ListFeedSubscriptions(c *gin.Context) ([]FeedSubscription, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+
+	subscriptions, err := dep.FeedClient().ListByOwner(c, user.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to list feed subscriptions", err)
+	}
+
+	hasher := dep.HashIDEncoder()
+	return lo.Map(subscriptions, func(s *ent.FeedSubscription, index int) FeedSubscription {
+		return *BuildFeedSubscription(s, hasher)
+	}), nil
+}
+
+type (
+	UpsertFeedSubscriptionParamCtx struct{}
+	UpsertFeedSubscriptionService  struct {
+		// ID of existing subscription to update, new subscription is created if empty.
+		ID      string `json:"id"`
+		Name    string `json:"name" binding:"required,max=255"`
+		Url     string `json:"url" binding:"required"`
+		Include string `json:"include" binding:"max=255"`
+		Exclude string `json:"exclude" binding:"max=255"`
+		Dst     string `json:"dst" binding:"required"`
+		Enabled *bool  `json:"enabled"`
+	}
+)
+
+// Upsert creates or updates a feed subscription
+func (s *UpsertFeedSubscriptionService) Upsert(c *gin.Context) (*FeedSubscription, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	fc := dep.FeedClient()
+	m := manager.NewFileManager(dep, user)
+	defer m.Recycle()
+
+	if !user.Edges.Group.Permissions.Enabled(int(types.GroupPermissionRemoteDownload)) {
+		return nil, serializer.NewError(serializer.CodeGroupNotAllowed, "Group not allowed to download files", nil)
+	}
+
+	feedUrl, err := url.Parse(s.Url)
+	if err != nil || (!strings.EqualFold(feedUrl.Scheme, "http") && !strings.EqualFold(feedUrl.Scheme, "https")) {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid feed url", err)
+	}
+
+	if _, _, err := workflows.CompileFeedFilters(s.Include, s.Exclude); err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, err.Error(), nil)
+	}
+
+	dst, err := fs.NewUriFromString(s.Dst)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid destination", err)
+	}
+
+	if _, err := m.Get(c, dst, dbfs.WithRequiredCapabilities(dbfs.NavigatorCapabilityCreateFile)); err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid destination", err)
+	}
+
+	args := &inventory.UpsertFeedParameters{
+		OwnerID: user.ID,
+		Name:    s.Name,
+		Url:     feedUrl.String(),
+		Include: s.Include,
+		Exclude: s.Exclude,
+		Dst:     dst.String(),
+		Enabled: s.Enabled == nil || *s.Enabled,
+	}
+
+	if s.ID != "" {
+		existing, err := getFeedSubscription(c, s.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		args.ID = existing.ID
+	} else {
+		existing, err := fc.ListByOwner(c, user.ID)
+		if err != nil {
+			return nil, serializer.NewError(serializer.CodeDBError, "Failed to list feed subscriptions", err)
+		}
+
+		if len(existing) >= maxFeedSubscriptions {
+			return nil, serializer.NewError(serializer.CodeParamErr, "Too many feed subscriptions", nil)
+		}
+	}
+
+	subscription, err := fc.Upsert(c, args)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to save feed subscription", err)
+	}
+
+	return BuildFeedSubscription(subscription, dep.HashIDEncoder()), nil
+}
+
+// DeleteFeedSubscription deletes a feed subscription of current user along with its history
+func DeleteFeedSubscription(c *gin.Context, id int) error {
+	dep := dependency.FromContext(c)
+	subscription, err := getFeedSubscriptionByID(c, id)
+	if err != nil {
+		return err
+	}
+
+	if err := dep.FeedClient().Delete(c, subscription.ID); err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to delete feed subscription", err)
+	}
+
+	return nil
+}
+
+// PollFeedSubscription polls a feed subscription of current user immediately
+func PollFeedSubscription(c *gin.Context, id int) (*FeedSubscription, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	fc := dep.FeedClient()
+	subscription, err := getFeedSubscriptionByID(c, id)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription.Edges.Owner = user
+	_, pollErr := workflows.PollFeed(c, dep, subscription)
+	errMsg := ""
+	if pollErr != nil {
+		errMsg = pollErr.Error()
+	}
+
+	now := time.Now()
+	if err := fc.SetChecked(c, subscription.ID, now, errMsg); err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to update feed subscription", err)
+	}
+
+	if pollErr != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Failed to poll feed: "+errMsg, nil)
+	}
+
+	subscription.CheckedAt = &now
+	subscription.Error = errMsg
+	return BuildFeedSubscription(subscription, dep.HashIDEncoder()), nil
+}
+
+type (
+	ListFeedItemsParamCtx struct{}
+	ListFeedItemsService  struct {
+		Page     int  `form:"page" binding:"min=0"`
+		PageSize int  `form:"page_size" binding:"required,min=10,max=100"`
+		All      bool `form:"all"`
+	}
+)
+
+// List lists history items of a feed subscription, only matched items are listed unless All is set
+func (s *ListFeedItemsService) List(c *gin.Context, id int) (*ListFeedItemResponse, error) {
+	dep := dependency.FromContext(c)
+	subscription, err := getFeedSubscriptionByID(c, id)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := dep.FeedClient().ListItems(c, &inventory.ListFeedItemParameters{
+		PaginationArgs: &inventory.PaginationArgs{
+			Page:     s.Page,
+			PageSize: s.PageSize,
+			Order:    inventory.OrderDirectionDesc,
+		},
+		SubscriptionID: subscription.ID,
+		MatchedOnly:    !s.All,
+	})
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to list feed items", err)
+	}
+
+	return BuildListFeedItemResponse(res, dep.HashIDEncoder()), nil
+}
+
+func getFeedSubscription(c *gin.Context, id string) (*ent.FeedSubscription, error) {
+	dep := dependency.FromContext(c)
+	rawID, err := dep.HashIDEncoder().Decode(id, hashid.FeedSubscriptionID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeNotFound, "Feed subscription not exist", err)
+	}
+
+	return getFeedSubscriptionByID(c, rawID)
+}
+
+func getFeedSubscriptionByID(c *gin.Context, id int) (*ent.FeedSubscription, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	subscription, err := dep.FeedClient().GetByID(c, id)
+	if err != nil || subscription.OwnerID != user.ID {
+		return nil, serializer.NewError(serializer.CodeNotFound, "Feed subscription not exist", err)
+	}
+
+	return subscription, nil
+}
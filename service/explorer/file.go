@@ -4,7 +4,11 @@ import (
 	"context"
 	"encoding/gob"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
+	"path"
+	"strconv"
 	"time"
 
 	"github.com/cloudreve/Cloudreve/v4/application/dependency"
@@ -12,6 +16,7 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
 	"github.com/cloudreve/Cloudreve/v4/pkg/auth"
 	"github.com/cloudreve/Cloudreve/v4/pkg/cluster/routes"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/encrypt"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/dbfs"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
@@ -43,6 +48,9 @@ type FileAnonymousGetService struct {
 
 func init() {
 	gob.Register(ArchiveDownloadSession{})
+	gob.Register(ArchiveMemberSession{})
+	gob.Register(TranscodeSession{})
+	gob.Register(StoryboardSession{})
 }
 
 // ArchiveService 文件流式打包下載服务
@@ -70,14 +78,26 @@ func (service *ArchiveService) DownloadArchived(c *gin.Context) error {
 
 	util.WithValue(c, inventory.UserCtx{}, requester)
 
+	password := ""
+	if len(archiveSession.EncryptedPassword) > 0 {
+		decrypted, err := encrypt.DecriptKey(c, dep.MasterEncryptKeyVault(c), archiveSession.EncryptedPassword)
+		if err != nil {
+			return serializer.NewError(serializer.CodeInternalSetting, "Failed to decrypt archive password", err)
+		}
+		password = string(decrypted)
+	}
+
 	fm := manager.NewFileManager(dep, requester)
 	defer fm.Recycle()
 
 	// 开始打包
-	c.Header("Content-Disposition", "attachment;")
-	c.Header("Content-Type", "application/zip")
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=\"archive.%s\"", archiveSession.Format.Ext()))
+	c.Header("Content-Type", archiveSession.Format.ContentType())
 
-	if _, err := fm.CreateArchive(c, archiveSession.Uris, c.Writer); err != nil {
+	if _, err := fm.CreateArchive(c, archiveSession.Uris, c.Writer,
+		fs.WithArchiveFormat(string(archiveSession.Format)),
+		fs.WithArchivePassword(password),
+	); err != nil {
 		return serializer.NewError(serializer.CodeIOFailed, "Failed to create archive", err)
 	}
 
@@ -364,14 +384,19 @@ type (
 		SkipError         bool     `json:"skip_error"`
 		Archive           bool     `json:"archive"`
 		NoCache           bool     `json:"no_cache"`
+		ArchiveFormat     string   `json:"archive_format"`   // Only works if Archive is true.
+		ArchivePassword   string   `json:"archive_password"` // Only works if Archive is true.
 	}
 	FileURLResponse struct {
 		Urls    []manager.EntityUrl `json:"urls"`
 		Expires *time.Time          `json:"expires"`
 	}
 	ArchiveDownloadSession struct {
-		Uris        []*fs.URI `json:"uris"`
-		RequesterID int       `json:"requester_id"`
+		Uris        []*fs.URI             `json:"uris"`
+		RequesterID int                   `json:"requester_id"`
+		Format      manager.ArchiveFormat `json:"format"`
+		// EncryptedPassword is the archive password encrypted with master key.
+		EncryptedPassword []byte `json:"encrypted_password,omitempty"`
 	}
 )
 
@@ -398,10 +423,31 @@ func (s *FileURLService) GetArchiveDownloadSession(c *gin.Context) (*FileURLResp
 		return nil, serializer.NewError(serializer.CodeGroupNotAllowed, "", nil)
 	}
 
+	format := manager.ArchiveFormat(s.ArchiveFormat)
+	if !format.Valid() {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Unsupported archive format", nil)
+	}
+
+	if s.ArchivePassword != "" && !format.SupportEncryption() {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Archive format does not support password", nil)
+	}
+
 	// Create archive download session
 	archiveSession := &ArchiveDownloadSession{
 		Uris:        uris,
 		RequesterID: user.ID,
+		Format:      format,
+	}
+	if s.ArchivePassword != "" {
+		masterKey, err := dep.MasterEncryptKeyVault(c).GetMasterKey(c)
+		if err != nil {
+			return nil, serializer.NewError(serializer.CodeInternalSetting, "failed to get master key", err)
+		}
+
+		archiveSession.EncryptedPassword, err = encrypt.EncryptWithMasterKey(masterKey, []byte(s.ArchivePassword))
+		if err != nil {
+			return nil, serializer.NewError(serializer.CodeInternalSetting, "failed to encrypt archive password", err)
+		}
 	}
 	sessionId := uuid.Must(uuid.NewV4()).String()
 	ttl := settings.ArchiveDownloadSessionTTL(c)
@@ -485,7 +531,8 @@ func (s *FileURLService) Get(c *gin.Context) (*FileURLResponse, error) {
 type (
 	FileThumbParameterCtx struct{}
 	FileThumbService      struct {
-		Uri string `form:"uri" binding:"required"`
+		Uri  string `form:"uri" binding:"required"`
+		Size string `form:"size" binding:"omitempty,eq=small|eq=medium|eq=large"`
 	}
 	FileThumbResponse struct {
 		Url     string     `json:"url"`
@@ -505,7 +552,11 @@ func (s *FileThumbService) Get(c *gin.Context) (*FileThumbResponse, error) {
 		return nil, serializer.NewError(serializer.CodeParamErr, "unknown uri", err)
 	}
 
-	// Get thumbnail
+	// Get thumbnail of requested size
+	if s.Size != "" {
+		util.WithValue(c, setting.ThumbVariantCtxKey{}, setting.ThumbVariant(s.Size))
+	}
+
 	thumb, err := m.Thumbnail(c, uri)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get thumbnail: %w", err)
@@ -747,3 +798,163 @@ func (s *ArchiveListFilesService) List(c *gin.Context) (*ArchiveListFilesRespons
 
 	return BuildArchiveListFilesResponse(files), nil
 }
+
+type (
+	ArchiveMemberParamCtx struct{}
+	ArchiveMemberService  struct {
+		Uri          string `form:"uri" json:"uri" binding:"required"`
+		Entity       string `form:"entity" json:"entity"`
+		Path         string `form:"path" json:"path" binding:"required"`
+		TextEncoding string `form:"text_encoding" json:"text_encoding"`
+		Download     bool   `form:"download" json:"download"`
+	}
+	ArchiveMemberSession struct {
+		Uri          string `json:"uri"`
+		Entity       string `json:"entity"`
+		Member       string `json:"member"`
+		TextEncoding string `json:"text_encoding"`
+		RequesterID  int    `json:"requester_id"`
+		Download     bool   `json:"download"`
+		Thumb        bool   `json:"thumb"`
+	}
+)
+
+const (
+	ArchiveMemberSessionPrefix = "archive_member_"
+)
+
+// GetUrl generates temporary URL for reading a single file in the archive.
+func (s *ArchiveMemberService) GetUrl(c *gin.Context) (*FileURLResponse, error) {
+	memberUrl, expire, err := s.createSession(c, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FileURLResponse{
+		Urls:    []manager.EntityUrl{{Url: memberUrl}},
+		Expires: expire,
+	}, nil
+}
+
+// GetThumb generates temporary URL for thumbnail of a single file in the archive.
+func (s *ArchiveMemberService) GetThumb(c *gin.Context) (*FileThumbResponse, error) {
+	thumbUrl, expire, err := s.createSession(c, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FileThumbResponse{
+		Url:     thumbUrl,
+		Expires: expire,
+	}, nil
+}
+
+func (s *ArchiveMemberService) createSession(c *gin.Context, thumb bool) (string, *time.Time, error) {
+	dep := dependency.FromContext(c)
+	settings := dep.SettingProvider()
+	user := inventory.UserFromContext(c)
+
+	if !user.Edges.Group.Permissions.Enabled(int(types.GroupPermissionArchiveTask)) {
+		return "", nil, serializer.NewError(serializer.CodeGroupNotAllowed, "Group not allowed to extract archive files", nil)
+	}
+
+	uri, err := fs.NewUriFromString(s.Uri)
+	if err != nil {
+		return "", nil, serializer.NewError(serializer.CodeParamErr, "unknown uri", err)
+	}
+
+	session := &ArchiveMemberSession{
+		Uri:          uri.String(),
+		Entity:       s.Entity,
+		Member:       s.Path,
+		TextEncoding: s.TextEncoding,
+		RequesterID:  user.ID,
+		Download:     s.Download,
+		Thumb:        thumb,
+	}
+	sessionId := uuid.Must(uuid.NewV4()).String()
+	ttl := settings.ArchiveDownloadSessionTTL(c)
+	expire := time.Now().Add(time.Duration(ttl) * time.Second)
+	if err := dep.KV().Set(ArchiveMemberSessionPrefix+sessionId, *session, ttl); err != nil {
+		return "", nil, serializer.NewError(serializer.CodeInternalSetting, "failed to create archive member session", err)
+	}
+
+	name := path.Base(util.FormSlash(s.Path))
+	if thumb {
+		name += ".jpg"
+	}
+
+	memberUrl := routes.MasterArchiveMemberUrl(settings.SiteURL(c), sessionId, name)
+	finalUrl, err := auth.SignURI(c, dep.GeneralAuth(), memberUrl.String(), &expire)
+	if err != nil {
+		return "", nil, serializer.NewError(serializer.CodeInternalSetting, "failed to sign archive member url", err)
+	}
+
+	return finalUrl.String(), &expire, nil
+}
+
+type (
+	ArchiveMemberContentParamCtx struct{}
+	ArchiveMemberContentService  struct {
+		ID string `uri:"sessionID" binding:"required"`
+	}
+)
+
+// Serve streams a single file in the archive, or its thumbnail, to the client.
+func (s *ArchiveMemberContentService) Serve(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	sessionRaw, found := dep.KV().Get(ArchiveMemberSessionPrefix + s.ID)
+	if !found {
+		return serializer.NewError(serializer.CodeNotFound, "Archive member session not exist", nil)
+	}
+
+	// Switch to user context
+	session := sessionRaw.(ArchiveMemberSession)
+	requester, err := dep.UserClient().GetLoginUserByID(c, session.RequesterID)
+	if err != nil {
+		return serializer.NewError(serializer.CodeNotFound, "Requester not found", err)
+	}
+
+	util.WithValue(c, inventory.UserCtx{}, requester)
+
+	fm := manager.NewFileManager(dep, requester)
+	defer fm.Recycle()
+
+	uri, err := fs.NewUriFromString(session.Uri)
+	if err != nil {
+		return serializer.NewError(serializer.CodeParamErr, "unknown uri", err)
+	}
+
+	var member *manager.ArchiveMember
+	if session.Thumb {
+		member, err = fm.ArchiveMemberThumbnail(c, uri, session.Entity, session.Member, session.TextEncoding)
+	} else {
+		member, err = fm.OpenArchiveMember(c, uri, session.Entity, session.Member, session.TextEncoding)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to open archive member: %w", err)
+	}
+
+	defer member.Close()
+
+	disposition := "inline"
+	if session.Download {
+		disposition = "attachment"
+	}
+	c.Header("Content-Disposition", fmt.Sprintf("%s; filename*=UTF-8''%s", disposition, url.PathEscape(member.Name)))
+	c.Header("Content-Type", dep.MimeDetector(c).TypeByName(member.Name))
+
+	// Members supporting random access can be served with Range requests.
+	if rs, ok := member.Reader.(io.ReadSeeker); ok {
+		http.ServeContent(c.Writer, c.Request, member.Name, member.UpdatedAt, rs)
+		return nil
+	}
+
+	c.Header("Content-Length", strconv.FormatInt(member.Size, 10))
+	c.Status(http.StatusOK)
+	if _, err := io.Copy(c.Writer, member); err != nil {
+		dep.Logger().Warning("Failed to stream archive member %q: %s", member.Name, err)
+	}
+
+	return nil
+}
@@ -215,6 +215,130 @@ type WopiFileInfo struct {
 	EnableShare bool
 }
 
+type Snapshot struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Uri       string    `json:"uri"`
+	RootUri   string    `json:"root_uri"`
+	Files     int       `json:"files"`
+	Size      int64     `json:"size"`
+	Scheduled bool      `json:"scheduled,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ListSnapshotResponse struct {
+	Snapshots  []Snapshot                   `json:"snapshots"`
+	Pagination *inventory.PaginationResults `json:"pagination"`
+}
+
+func BuildSnapshot(s *ent.Snapshot, hasher hashid.Encoder) *Snapshot {
+	id := hashid.EncodeSnapshotID(hasher, s.ID)
+	return &Snapshot{
+		ID:        id,
+		Name:      s.Name,
+		Uri:       fs.NewSnapshotUri(id),
+		RootUri:   s.RootURI,
+		Files:     s.Files,
+		Size:      s.Size,
+		Scheduled: s.Scheduled,
+		CreatedAt: s.CreatedAt,
+	}
+}
+
+func BuildListSnapshotResponse(res *inventory.ListSnapshotResult, hasher hashid.Encoder) *ListSnapshotResponse {
+	return &ListSnapshotResponse{
+		Snapshots: lo.Map(res.Snapshots, func(s *ent.Snapshot, index int) Snapshot {
+			return *BuildSnapshot(s, hasher)
+		}),
+		Pagination: res.PaginationResults,
+	}
+}
+
+type Album struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Uri       string    `json:"uri"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func BuildAlbum(a *ent.Album, hasher hashid.Encoder) *Album {
+	return &Album{
+		ID:        hashid.EncodeAlbumID(hasher, a.ID),
+		Name:      a.Name,
+		Uri:       fs.NewAlbumUri(a.Name),
+		CreatedAt: a.CreatedAt,
+		UpdatedAt: a.UpdatedAt,
+	}
+}
+
+type FeedSubscription struct {
+	ID        string     `json:"id"`
+	Name      string     `json:"name"`
+	Url       string     `json:"url"`
+	Include   string     `json:"include,omitempty"`
+	Exclude   string     `json:"exclude,omitempty"`
+	Dst       string     `json:"dst"`
+	Enabled   bool       `json:"enabled"`
+	CheckedAt *time.Time `json:"checked_at,omitempty"`
+	Error     string     `json:"error,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+type FeedItem struct {
+	Guid        string     `json:"guid"`
+	Title       string     `json:"title"`
+	Link        string     `json:"link"`
+	PublishedAt *time.Time `json:"published_at,omitempty"`
+	Matched     bool       `json:"matched"`
+	TaskID      string     `json:"task_id,omitempty"`
+	Error       string     `json:"error,omitempty"`
+	SeenAt      time.Time  `json:"seen_at"`
+}
+
+type ListFeedItemResponse struct {
+	Items      []FeedItem                   `json:"items"`
+	Pagination *inventory.PaginationResults `json:"pagination"`
+}
+
+func BuildFeedSubscription(s *ent.FeedSubscription, hasher hashid.Encoder) *FeedSubscription {
+	return &FeedSubscription{
+		ID:        hashid.EncodeFeedSubscriptionID(hasher, s.ID),
+		Name:      s.Name,
+		Url:       s.URL,
+		Include:   s.Include,
+		Exclude:   s.Exclude,
+		Dst:       s.Dst,
+		Enabled:   s.Enabled,
+		CheckedAt: s.CheckedAt,
+		Error:     s.Error,
+		CreatedAt: s.CreatedAt,
+	}
+}
+
+func BuildListFeedItemResponse(res *inventory.ListFeedItemResult, hasher hashid.Encoder) *ListFeedItemResponse {
+	return &ListFeedItemResponse{
+		Items: lo.Map(res.Items, func(item *ent.FeedItem, index int) FeedItem {
+			taskID := ""
+			if item.TaskID != nil {
+				taskID = hashid.EncodeTaskID(hasher, *item.TaskID)
+			}
+
+			return FeedItem{
+				Guid:        item.GUID,
+				Title:       item.Title,
+				Link:        item.Link,
+				PublishedAt: item.PublishedAt,
+				Matched:     item.Matched,
+				TaskID:      taskID,
+				Error:       item.Error,
+				SeenAt:      item.SeenAt,
+			}
+		}),
+		Pagination: res.PaginationResults,
+	}
+}
+
 type ViewerSessionResponse struct {
 	Session *manager.ViewerSession `json:"session"`
 	WopiSrc string                 `json:"wopi_src,omitempty"`
@@ -250,18 +374,21 @@ type FileResponse struct {
 	Capability    *boolset.BooleanSet `json:"capability,omitempty"`
 	Owned         bool                `json:"owned,omitempty"`
 	PrimaryEntity string              `json:"primary_entity,omitempty"`
+	LastOpened    *time.Time          `json:"last_opened,omitempty"`
 
 	FolderSummary *fs.FolderSummary `json:"folder_summary,omitempty"`
 	ExtendedInfo  *ExtendedInfo     `json:"extended_info,omitempty"`
 }
 
 type ExtendedInfo struct {
-	StoragePolicy *StoragePolicy      `json:"storage_policy,omitempty"`
-	StorageUsed   int64               `json:"storage_used"`
-	Shares        []Share             `json:"shares,omitempty"`
-	Entities      []Entity            `json:"entities,omitempty"`
-	View          *types.ExplorerView `json:"view,omitempty"`
-	DirectLinks   []DirectLink        `json:"direct_links,omitempty"`
+	StoragePolicy    *StoragePolicy                  `json:"storage_policy,omitempty"`
+	StorageUsed      int64                           `json:"storage_used"`
+	Shares           []Share                         `json:"shares,omitempty"`
+	Entities         []Entity                        `json:"entities,omitempty"`
+	View             *types.ExplorerView             `json:"view,omitempty"`
+	VersionRetention *types.VersionRetentionSchedule `json:"version_retention,omitempty"`
+	Snapshot         *types.SnapshotSchedule         `json:"snapshot,omitempty"`
+	DirectLinks      []DirectLink                    `json:"direct_links,omitempty"`
 }
 
 type DirectLink struct {
@@ -402,6 +529,7 @@ func BuildFileResponse(ctx context.Context, u *ent.User, f fs.File, hasher hashi
 		FolderSummary: f.FolderSummary(),
 		ExtendedInfo:  BuildExtendedInfo(ctx, u, f, hasher),
 		PrimaryEntity: hashid.EncodeEntityID(hasher, f.PrimaryEntityID()),
+		LastOpened:    f.LastOpened(),
 	}
 	return res
 }
@@ -432,6 +560,8 @@ func BuildExtendedInfo(ctx context.Context, u *ent.User, f fs.File, hasher hashi
 			return *BuildShare(s, base, hasher, u, u, f.DisplayName(), f.Type(), true, false)
 		})
 		ext.View = extendedInfo.View
+		ext.VersionRetention = extendedInfo.VersionRetention
+		ext.Snapshot = extendedInfo.Snapshot
 	}
 
 	return ext
@@ -0,0 +1,109 @@
+package explorer
+
+import (
+	"strings"
+
+	"github.com/cloudreve/Cloudreve/v4/application/constants"
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+const maxSavedSearches = 50
+
+type (
+	SaveSearchService struct {
+		// ID of existing saved search to update, new saved search is created if empty.
+		ID   string `json:"id"`
+		Name string `json:"name" binding:"required,max=255"`
+		Uri  string `json:"uri" binding:"required"`
+	}
+	SaveSearchParameterCtx struct{}
+)
+
+// Save creates or updates a saved search
+func (service *SaveSearchService) Save(c *gin.Context) (*types.SavedSearch, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	userClient := dep.UserClient()
+
+	uri, err := fs.NewUriFromString(service.Uri)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "unknown uri", err)
+	}
+
+	if uri.FileSystem() != constants.FileSystemMy || uri.SearchParameters() == nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "uri must be a search in my files", nil)
+	}
+
+	// Saved searches are presented as folders named after them.
+	if strings.Contains(service.Name, fs.Separator) || service.Name == "." || service.Name == ".." {
+		return nil, serializer.NewError(serializer.CodeParamErr, "invalid name", nil)
+	}
+
+	if lo.ContainsBy(user.Settings.SavedSearches, func(s types.SavedSearch) bool {
+		return s.Name == service.Name && s.ID != service.ID
+	}) {
+		return nil, serializer.NewError(serializer.CodeObjectExist, "saved search with the same name already exists", nil)
+	}
+
+	saved := types.SavedSearch{
+		ID:   service.ID,
+		Name: service.Name,
+		Uri:  uri.String(),
+	}
+
+	if service.ID != "" {
+		_, index, found := lo.FindIndexOf(user.Settings.SavedSearches, func(s types.SavedSearch) bool {
+			return s.ID == service.ID
+		})
+		if !found {
+			return nil, serializer.NewError(serializer.CodeNotFound, "saved search not exist", nil)
+		}
+
+		user.Settings.SavedSearches[index] = saved
+	} else {
+		if len(user.Settings.SavedSearches) >= maxSavedSearches {
+			return nil, serializer.NewError(serializer.CodeParamErr, "too many saved searches", nil)
+		}
+
+		for {
+			saved.ID = util.RandStringRunes(8)
+			if !lo.ContainsBy(user.Settings.SavedSearches, func(s types.SavedSearch) bool {
+				return s.ID == saved.ID
+			}) {
+				break
+			}
+		}
+
+		user.Settings.SavedSearches = append(user.Settings.SavedSearches, saved)
+	}
+
+	if err := userClient.SaveSettings(c, user); err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "failed to save settings", err)
+	}
+
+	return &saved, nil
+}
+
+// DeleteSavedSearch removes a saved search
+func DeleteSavedSearch(c *gin.Context, id string) error {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	userClient := dep.UserClient()
+
+	user.Settings.SavedSearches = lo.Filter(user.Settings.SavedSearches, func(s types.SavedSearch, index int) bool {
+		return s.ID != id
+	})
+
+	if err := userClient.SaveSettings(c, user); err != nil {
+		return serializer.NewError(serializer.CodeDBError, "failed to save settings", err)
+	}
+
+	return nil
+}
@@ -15,6 +15,8 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager/entitysource"
 	"github.com/cloudreve/Cloudreve/v4/pkg/mediameta"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
 	"github.com/gin-gonic/gin"
 	"github.com/samber/lo"
 )
@@ -159,6 +161,11 @@ func (s *SlaveThumbService) Thumb(c *gin.Context) error {
 	m := manager.NewFileManager(dep, nil)
 	defer m.Recycle()
 
+	switch variant := setting.ThumbVariant(c.Query("size")); variant {
+	case setting.ThumbVariantSmall, setting.ThumbVariantLarge:
+		util.WithValue(c, setting.ThumbVariantCtxKey{}, variant)
+	}
+
 	src, err := base64.URLEncoding.DecodeString(s.Src)
 	if err != nil {
 		return fmt.Errorf("failed to decode src: %w", err)
@@ -230,16 +237,18 @@ func (service *SlaveDeleteFileService) Delete(c *gin.Context) ([]string, error)
 	defer m.Recycle()
 	d := m.LocalDriver(nil)
 
-	// Try to delete thumbnail sidecar
-	sidecarSuffix := dep.SettingProvider().ThumbSlaveSidecarSuffix(c)
-	failed, err := d.Delete(c, lo.Map(service.Files, func(item string, index int) string {
-		return item + sidecarSuffix
-	})...)
-	if err != nil {
-		dep.Logger().Warning("Failed to delete thumbnail sidecar [%s]: %s", strings.Join(failed, ", "), err)
+	// Try to delete thumbnail sidecars of all sizes
+	for _, variant := range []setting.ThumbVariant{setting.ThumbVariantSmall, setting.ThumbVariantMedium, setting.ThumbVariantLarge} {
+		sidecarSuffix := dep.SettingProvider().ThumbSlaveSidecarSuffix(setting.WithThumbVariant(c, variant))
+		failed, err := d.Delete(c, lo.Map(service.Files, func(item string, index int) string {
+			return item + sidecarSuffix
+		})...)
+		if err != nil {
+			dep.Logger().Warning("Failed to delete thumbnail sidecar [%s]: %s", strings.Join(failed, ", "), err)
+		}
 	}
 
-	failed, err = d.Delete(c, service.Files...)
+	failed, err := d.Delete(c, service.Files...)
 	if err != nil {
 		return failed, fmt.Errorf("slave failed to delete file: %w", err)
 	}
@@ -0,0 +1,215 @@
+package explorer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cloudreve/Cloudreve/v4/application/constants"
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/workflows"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/gin-gonic/gin"
+)
+
+type (
+	CreateSnapshotParamCtx struct{}
+	CreateSnapshotService  struct {
+		Uri  string `json:"uri" binding:"required"`
+		Name string `json:"name" binding:"max=255"`
+	}
+)
+
+// Create takes a snapshot of given folder
+func (s *CreateSnapshotService) Create(c *gin.Context) (*Snapshot, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	m := manager.NewFileManager(dep, user)
+	defer m.Recycle()
+
+	uri, err := fs.NewUriFromString(s.Uri)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "unknown uri", err)
+	}
+
+	snapshot, err := m.CreateSnapshot(c, uri, s.Name, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create snapshot: %w", err)
+	}
+
+	return BuildSnapshot(snapshot, dep.HashIDEncoder()), nil
+}
+
+type (
+	ListSnapshotParamCtx struct{}
+	ListSnapshotService  struct {
+		Uri      string `form:"uri"`
+		Page     int    `form:"page" binding:"min=0"`
+		PageSize int    `form:"page_size" binding:"required,min=10,max=100"`
+	}
+)
+
+// List lists snapshots of current user, optionally filtered by snapshotted folder
+func (s *ListSnapshotService) List(c *gin.Context) (*ListSnapshotResponse, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	m := manager.NewFileManager(dep, user)
+	defer m.Recycle()
+
+	args := &inventory.ListSnapshotParameters{
+		PaginationArgs: &inventory.PaginationArgs{
+			Page:     s.Page,
+			PageSize: s.PageSize,
+			Order:    inventory.OrderDirectionDesc,
+		},
+		OwnerID: user.ID,
+	}
+
+	if s.Uri != "" {
+		uri, err := fs.NewUriFromString(s.Uri)
+		if err != nil {
+			return nil, serializer.NewError(serializer.CodeParamErr, "unknown uri", err)
+		}
+
+		folder, err := m.Get(c, uri)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get folder: %w", err)
+		}
+
+		args.FileID = folder.ID()
+	}
+
+	res, err := dep.SnapshotClient().List(c, args)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to list snapshots", err)
+	}
+
+	return BuildListSnapshotResponse(res, dep.HashIDEncoder()), nil
+}
+
+// DeleteSnapshot deletes a snapshot of current user
+func DeleteSnapshot(c *gin.Context, id int) error {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	m := manager.NewFileManager(dep, user)
+	defer m.Recycle()
+
+	snapshot, err := dep.SnapshotClient().GetByID(c, id)
+	if err != nil || snapshot.OwnerID != user.ID {
+		return serializer.NewError(serializer.CodeNotFound, "Snapshot not exist", err)
+	}
+
+	return m.DeleteSnapshots(c, snapshot)
+}
+
+type (
+	PatchSnapshotScheduleParamCtx struct{}
+	PatchSnapshotScheduleService  struct {
+		Uri      string                  `json:"uri" binding:"required"`
+		Schedule *types.SnapshotSchedule `json:"schedule"`
+	}
+)
+
+// Patch sets or removes the snapshot schedule of a folder
+func (s *PatchSnapshotScheduleService) Patch(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	m := manager.NewFileManager(dep, user)
+	defer m.Recycle()
+
+	uri, err := fs.NewUriFromString(s.Uri)
+	if err != nil {
+		return serializer.NewError(serializer.CodeParamErr, "unknown uri", err)
+	}
+
+	if err := m.PatchSnapshotSchedule(c, uri, s.Schedule); err != nil {
+		return fmt.Errorf("failed to patch snapshot schedule: %w", err)
+	}
+
+	return nil
+}
+
+type (
+	SnapshotRestoreWorkflowService struct {
+		Src         string `json:"src" binding:"required"`
+		Dst         string `json:"dst"`
+		RemoveExtra bool   `json:"remove_extra"`
+	}
+	CreateSnapshotRestoreParamCtx struct{}
+)
+
+// CreateRestoreTask creates a task to restore files from a snapshot. If Dst is not specified,
+// files are restored to where they were when the snapshot was taken.
+func (service *SnapshotRestoreWorkflowService) CreateRestoreTask(c *gin.Context) (*TaskResponse, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	hasher := dep.HashIDEncoder()
+	m := manager.NewFileManager(dep, user)
+	defer m.Recycle()
+
+	src, err := fs.NewUriFromString(service.Src)
+	if err != nil || src.FileSystem() != constants.FileSystemSnapshot {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid source", err)
+	}
+
+	// Validate source
+	if _, err := m.Get(c, src); err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid source", err)
+	}
+
+	elements := src.Elements()
+	if len(elements) == 0 {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Snapshot not specified", nil)
+	}
+
+	dst := service.Dst
+	if dst == "" {
+		snapshot, err := snapshotFromUri(c, dep, elements[0])
+		if err != nil {
+			return nil, err
+		}
+
+		rootUri, err := fs.NewUriFromString(snapshot.RootURI)
+		if err != nil {
+			return nil, serializer.NewError(serializer.CodeParamErr, "Invalid snapshot root", err)
+		}
+
+		dst = rootUri.JoinRaw(strings.Join(elements[1:], fs.Separator)).String()
+	}
+
+	dstUri, err := fs.NewUriFromString(dst)
+	if err != nil || dstUri.FileSystem() != constants.FileSystemMy {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid destination", err)
+	}
+
+	// Create task
+	t, err := workflows.NewSnapshotRestoreTask(c, user, src.String(), dstUri.String(), service.RemoveExtra)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeCreateTaskError, "Failed to create task", err)
+	}
+
+	if err := dep.IoIntenseQueue(c).QueueTask(c, t); err != nil {
+		return nil, serializer.NewError(serializer.CodeCreateTaskError, "Failed to queue task", err)
+	}
+
+	return BuildTaskResponse(t, nil, hasher), nil
+}
+
+func snapshotFromUri(c *gin.Context, dep dependency.Dep, id string) (*ent.Snapshot, error) {
+	snapshotID, err := dep.HashIDEncoder().Decode(id, hashid.SnapshotID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid snapshot id", err)
+	}
+
+	snapshot, err := dep.SnapshotClient().GetByID(c, snapshotID)
+	if err != nil || snapshot.OwnerID != inventory.UserFromContext(c).ID {
+		return nil, serializer.NewError(serializer.CodeNotFound, "Snapshot not exist", err)
+	}
+
+	return snapshot, nil
+}
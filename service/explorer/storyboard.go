@@ -0,0 +1,114 @@
+package explorer
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cluster/routes"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager/entitysource"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/thumb"
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+)
+
+type (
+	FileStoryboardParameterCtx struct{}
+	FileStoryboardService      struct {
+		Uri string `form:"uri" binding:"required"`
+	}
+	StoryboardSession struct {
+		SpriteUrl string                `json:"sprite_url"`
+		Props     types.StoryboardProps `json:"props"`
+	}
+	FileStoryboardResponse struct {
+		// Url of the WebVTT thumbnails track.
+		Url       string     `json:"url"`
+		SpriteUrl string     `json:"sprite_url"`
+		Expires   *time.Time `json:"expires"`
+		Interval  float64    `json:"interval"`
+		Count     int        `json:"count"`
+	}
+)
+
+const (
+	StoryboardSessionPrefix = "storyboard_"
+)
+
+// Get generates temporary URL of the storyboard sprite sheet and its WebVTT thumbnails track.
+func (s *FileStoryboardService) Get(c *gin.Context) (*FileStoryboardResponse, error) {
+	dep := dependency.FromContext(c)
+	settings := dep.SettingProvider()
+	user := inventory.UserFromContext(c)
+	m := manager.NewFileManager(dep, user)
+	defer m.Recycle()
+
+	uri, err := fs.NewUriFromString(s.Uri)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "unknown uri", err)
+	}
+
+	es, props, err := m.Storyboard(c, uri)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get storyboard: %w", err)
+	}
+	defer es.Close()
+
+	expire := time.Now().Add(settings.EntityUrlValidDuration(c))
+	spriteUrl, err := es.Url(c, entitysource.WithExpire(&expire))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get storyboard url: %w", err)
+	}
+
+	session := &StoryboardSession{
+		SpriteUrl: spriteUrl.Url,
+		Props:     *props,
+	}
+	sessionId := uuid.Must(uuid.NewV4()).String()
+	ttl := int(time.Until(expire).Seconds())
+	if err := dep.KV().Set(StoryboardSessionPrefix+sessionId, *session, ttl); err != nil {
+		return nil, serializer.NewError(serializer.CodeInternalSetting, "failed to create storyboard session", err)
+	}
+
+	vttUrl := routes.MasterStoryboardVTTUrl(settings.SiteURL(c), sessionId)
+	finalUrl, err := auth.SignURI(c, dep.GeneralAuth(), vttUrl.String(), &expire)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeInternalSetting, "failed to sign storyboard url", err)
+	}
+
+	return &FileStoryboardResponse{
+		Url:       finalUrl.String(),
+		SpriteUrl: spriteUrl.Url,
+		Expires:   &expire,
+		Interval:  props.Interval,
+		Count:     props.Count,
+	}, nil
+}
+
+type (
+	StoryboardVTTParameterCtx struct{}
+	StoryboardVTTService      struct {
+		ID string `uri:"sessionID" binding:"required"`
+	}
+)
+
+// Serve writes the WebVTT thumbnails track of a storyboard.
+func (s *StoryboardVTTService) Serve(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	sessionRaw, found := dep.KV().Get(StoryboardSessionPrefix + s.ID)
+	if !found {
+		return serializer.NewError(serializer.CodeNotFound, "Storyboard session not exist", nil)
+	}
+
+	session := sessionRaw.(StoryboardSession)
+	c.Header("Content-Type", "text/vtt; charset=utf-8")
+	c.Status(http.StatusOK)
+	return thumb.WriteStoryboardVTT(c.Writer, &session.Props, session.SpriteUrl)
+}
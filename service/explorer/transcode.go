@@ -0,0 +1,225 @@
+package explorer
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cluster/routes"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/workflows"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/transcode"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/samber/lo"
+)
+
+type (
+	TranscodeWorkflowService struct {
+		Uri string `json:"uri" binding:"required"`
+	}
+	CreateTranscodeParamCtx struct{}
+)
+
+// CreateTask creates a task to transcode the video into HLS renditions.
+func (service *TranscodeWorkflowService) CreateTask(c *gin.Context) (*TaskResponse, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	hasher := dep.HashIDEncoder()
+
+	if !user.Edges.Group.Permissions.Enabled(int(types.GroupPermissionTranscode)) {
+		return nil, serializer.NewError(serializer.CodeGroupNotAllowed, "Group not allowed to transcode videos", nil)
+	}
+
+	uri, err := fs.NewUriFromString(service.Uri)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "unknown uri", err)
+	}
+
+	fm := manager.NewFileManager(dep, user)
+	defer fm.Recycle()
+
+	_, source, err := fm.TranscodeSource(c, uri)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create task
+	t, err := workflows.NewTranscodeTask(c, uri.String(), source.ID(), user)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeCreateTaskError, "Failed to create task", err)
+	}
+
+	if err := dep.TranscodeQueue(c).QueueTask(c, t); err != nil {
+		return nil, serializer.NewError(serializer.CodeCreateTaskError, "Failed to queue task", err)
+	}
+
+	return BuildTaskResponse(t, nil, hasher), nil
+}
+
+type (
+	TranscodePlaylistParamCtx struct{}
+	TranscodePlaylistService  struct {
+		Uri string `form:"uri" json:"uri" binding:"required"`
+	}
+	TranscodeSession struct {
+		Uri         string `json:"uri"`
+		RequesterID int    `json:"requester_id"`
+	}
+	TranscodeRenditionResponse struct {
+		Name      string `json:"name"`
+		Bandwidth int    `json:"bandwidth"`
+		Width     int    `json:"width,omitempty"`
+		Height    int    `json:"height,omitempty"`
+	}
+	TranscodePlaylistResponse struct {
+		Url        string                       `json:"url"`
+		Expires    *time.Time                   `json:"expires"`
+		Renditions []TranscodeRenditionResponse `json:"renditions"`
+	}
+)
+
+const (
+	TranscodeSessionPrefix  = "transcode_"
+	transcodeMasterPlaylist = "master.m3u8"
+)
+
+// GetPlaylist generates temporary URL of the HLS master playlist of a transcoded video.
+func (s *TranscodePlaylistService) GetPlaylist(c *gin.Context) (*TranscodePlaylistResponse, error) {
+	dep := dependency.FromContext(c)
+	settings := dep.SettingProvider()
+	user := inventory.UserFromContext(c)
+
+	if !user.Edges.Group.Permissions.Enabled(int(types.GroupPermissionTranscode)) {
+		return nil, serializer.NewError(serializer.CodeGroupNotAllowed, "Group not allowed to play transcoded videos", nil)
+	}
+
+	uri, err := fs.NewUriFromString(s.Uri)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "unknown uri", err)
+	}
+
+	fm := manager.NewFileManager(dep, user)
+	defer fm.Recycle()
+
+	ttl := settings.Transcode(c).SessionTTL
+	expire := time.Now().Add(time.Duration(ttl) * time.Second)
+	_, props, err := fm.TranscodeUrl(c, uri, &expire)
+	if err != nil {
+		return nil, fmt.Errorf("transcoded video not available: %w", err)
+	}
+
+	session := &TranscodeSession{
+		Uri:         uri.String(),
+		RequesterID: user.ID,
+	}
+	sessionId := uuid.Must(uuid.NewV4()).String()
+	if err := dep.KV().Set(TranscodeSessionPrefix+sessionId, *session, ttl); err != nil {
+		return nil, serializer.NewError(serializer.CodeInternalSetting, "failed to create transcode session", err)
+	}
+
+	playlistUrl, err := signTranscodePlaylistUrl(c, dep, sessionId, transcodeMasterPlaylist, &expire)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TranscodePlaylistResponse{
+		Url:     playlistUrl,
+		Expires: &expire,
+		Renditions: lo.Map(props.Renditions, func(r types.TranscodeRendition, _ int) TranscodeRenditionResponse {
+			return TranscodeRenditionResponse{
+				Name:      r.Name,
+				Bandwidth: r.Bandwidth,
+				Width:     r.Width,
+				Height:    r.Height,
+			}
+		}),
+	}, nil
+}
+
+type (
+	TranscodePlaylistContentParamCtx struct{}
+	TranscodePlaylistContentService  struct {
+		ID       string `uri:"sessionID" binding:"required"`
+		Playlist string `uri:"playlist" binding:"required"`
+	}
+)
+
+// Serve writes the master playlist, or media playlist of a single rendition to the client.
+func (s *TranscodePlaylistContentService) Serve(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	sessionRaw, found := dep.KV().Get(TranscodeSessionPrefix + s.ID)
+	if !found {
+		return serializer.NewError(serializer.CodeNotFound, "Transcode session not exist", nil)
+	}
+
+	// Switch to user context
+	session := sessionRaw.(TranscodeSession)
+	requester, err := dep.UserClient().GetLoginUserByID(c, session.RequesterID)
+	if err != nil {
+		return serializer.NewError(serializer.CodeNotFound, "Requester not found", err)
+	}
+
+	util.WithValue(c, inventory.UserCtx{}, requester)
+
+	fm := manager.NewFileManager(dep, requester)
+	defer fm.Recycle()
+
+	uri, err := fs.NewUriFromString(session.Uri)
+	if err != nil {
+		return serializer.NewError(serializer.CodeParamErr, "unknown uri", err)
+	}
+
+	expire := time.Now().Add(time.Duration(dep.SettingProvider().Transcode(c).SessionTTL) * time.Second)
+	entityUrl, props, err := fm.TranscodeUrl(c, uri, &expire)
+	if err != nil {
+		return fmt.Errorf("transcoded video not available: %w", err)
+	}
+
+	if s.Playlist == transcodeMasterPlaylist {
+		variantUrls := make([]string, len(props.Renditions))
+		for i := range props.Renditions {
+			if variantUrls[i], err = signTranscodePlaylistUrl(c, dep, s.ID, fmt.Sprintf("%d.m3u8", i), &expire); err != nil {
+				return err
+			}
+		}
+
+		writePlaylistHeader(c)
+		return transcode.WriteMasterPlaylist(c.Writer, props.Renditions, func(i int) string {
+			return variantUrls[i]
+		})
+	}
+
+	index, err := strconv.Atoi(strings.TrimSuffix(s.Playlist, ".m3u8"))
+	if err != nil || !strings.HasSuffix(s.Playlist, ".m3u8") || index < 0 || index >= len(props.Renditions) {
+		return serializer.NewError(serializer.CodeNotFound, "Playlist not exist", err)
+	}
+
+	writePlaylistHeader(c)
+	return transcode.WriteVariantPlaylist(c.Writer, &props.Renditions[index], entityUrl)
+}
+
+func writePlaylistHeader(c *gin.Context) {
+	c.Header("Content-Type", transcode.PlaylistContentType)
+	c.Header("Cache-Control", "no-cache")
+	c.Status(http.StatusOK)
+}
+
+func signTranscodePlaylistUrl(c *gin.Context, dep dependency.Dep, sessionId, playlist string, expire *time.Time) (string, error) {
+	playlistUrl := routes.MasterTranscodePlaylistUrl(dep.SettingProvider().SiteURL(c), sessionId, playlist)
+	finalUrl, err := auth.SignURI(c, dep.GeneralAuth(), playlistUrl.String(), expire)
+	if err != nil {
+		return "", serializer.NewError(serializer.CodeInternalSetting, "failed to sign playlist url", err)
+	}
+
+	return finalUrl.String(), nil
+}
@@ -0,0 +1,502 @@
+package explorer
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cluster"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	TusVersion            = "1.0.0"
+	TusExtensions         = "creation,creation-with-upload,termination,checksum,expiration"
+	TusChecksumAlgorithms = "md5,sha1,sha256"
+	TusContentType        = "application/offset+octet-stream"
+
+	tusOffsetCachePrefix = "tus_offset_"
+	tusStagingFolder     = "tus"
+)
+
+var (
+	ErrTusOffsetMismatch   = serializer.NewError(serializer.CodeConflict, "Upload-Offset does not match current offset", nil)
+	ErrTusChecksumMismatch = serializer.NewError(serializer.CodeChecksumMismatch, "Checksum of uploaded data mismatch", nil)
+	ErrTusUploadLocked     = serializer.NewError(serializer.CodeLockConflict, "Upload is being modified by another request", nil)
+
+	// tusLocks serializes requests modifying the same upload within this instance.
+	tusLocks = &tusLockMap{locks: make(map[string]*tusLock)}
+)
+
+type (
+	TusParamCtx struct{}
+	// TusService handles uploads in tus resumable upload protocol. Uploads are mapped to upload
+	// sessions, data is written to the placeholder file directly for local storage policy, or
+	// staged on master and relayed to storage once completed for policies with relay enabled.
+	TusService struct {
+		ID string `uri:"sessionId"`
+	}
+
+	// TusUpload is the state of a tus upload.
+	TusUpload struct {
+		ID      string
+		Offset  int64
+		Length  int64
+		Expires time.Time
+	}
+)
+
+// Create creates an upload session from tus creation request, data in request body is written
+// if presented (creation-with-upload extension).
+func (s *TusService) Create(c *gin.Context) (*TusUpload, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+
+	length, err := strconv.ParseInt(c.GetHeader("Upload-Length"), 10, 64)
+	if err != nil || length < 0 {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid Upload-Length", err)
+	}
+
+	metadata, err := parseTusMetadata(c.GetHeader("Upload-Metadata"))
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid Upload-Metadata", err)
+	}
+
+	dst := metadata["uri"]
+	if dst == "" {
+		name := metadata["filename"]
+		if name == "" {
+			name = metadata["name"]
+		}
+		if name == "" {
+			return nil, serializer.NewError(serializer.CodeParamErr, "Destination not specified in Upload-Metadata", nil)
+		}
+
+		folder, err := fs.NewUriFromString(fs.NewMyUri(""))
+		if metadata["folder"] != "" {
+			folder, err = fs.NewUriFromString(metadata["folder"])
+		}
+		if err != nil {
+			return nil, serializer.NewError(serializer.CodeParamErr, "unknown uri", err)
+		}
+
+		dst = folder.Join(name).String()
+	}
+
+	mimeType := metadata["filetype"]
+	if mimeType == "" {
+		mimeType = metadata["type"]
+	}
+
+	lastModified, _ := strconv.ParseInt(metadata["last_modified"], 10, 64)
+	createService := &CreateUploadSessionService{
+		Uri:          dst,
+		Size:         length,
+		LastModified: lastModified,
+		MimeType:     mimeType,
+		PolicyID:     metadata["policy_id"],
+	}
+
+	sweepTusStaging(c, dep)
+	credential, err := createService.Create(c)
+	if err != nil {
+		return nil, err
+	}
+
+	m := manager.NewFileManager(dep, user)
+	defer m.Recycle()
+
+	session, err := tusSession(c, dep, credential.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Data must be received by Cloudreve server.
+	if session.Policy.Type != types.PolicyTypeLocal && !session.Policy.Settings.Relay {
+		if err := m.CancelUploadSession(c, session.Props.Uri, credential.SessionID); err != nil {
+			dep.Logger().Warning("Failed to cancel tus upload session %q: %s", credential.SessionID, err)
+		}
+		return nil, serializer.NewError(serializer.CodePolicyNotAllowed,
+			fmt.Sprintf("tus upload is not supported by storage policy %q of type %q, only local storage or policies with upload relay enabled are supported",
+				session.Policy.Name, session.Policy.Type), nil)
+	}
+
+	upload := &TusUpload{
+		ID:      credential.SessionID,
+		Length:  length,
+		Expires: session.Props.ExpireAt,
+	}
+
+	// Empty file is completed on creation, otherwise write initial data if presented.
+	if length == 0 || c.GetHeader("Content-Type") == TusContentType {
+		upload.Offset, err = tusWrite(c, dep, m, session, 0)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return upload, nil
+}
+
+// Head returns the current state of a tus upload.
+func (s *TusService) Head(c *gin.Context) (*TusUpload, error) {
+	dep := dependency.FromContext(c)
+	session, err := tusSession(c, dep, s.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TusUpload{
+		ID:      s.ID,
+		Offset:  tusOffset(dep, s.ID),
+		Length:  session.Props.Size,
+		Expires: session.Props.ExpireAt,
+	}, nil
+}
+
+// Patch writes data in request body at given offset.
+func (s *TusService) Patch(c *gin.Context) (*TusUpload, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	session, err := tusSession(c, dep, s.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	offset, err := strconv.ParseInt(c.GetHeader("Upload-Offset"), 10, 64)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid Upload-Offset", err)
+	}
+
+	unlock, ok := tusLocks.tryLock(s.ID)
+	if !ok {
+		return nil, ErrTusUploadLocked
+	}
+	defer unlock()
+
+	if offset != tusOffset(dep, s.ID) {
+		return nil, ErrTusOffsetMismatch
+	}
+
+	m := manager.NewFileManager(dep, user)
+	defer m.Recycle()
+
+	newOffset, err := tusWrite(c, dep, m, session, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TusUpload{
+		ID:      s.ID,
+		Offset:  newOffset,
+		Length:  session.Props.Size,
+		Expires: session.Props.ExpireAt,
+	}, nil
+}
+
+// Delete terminates a tus upload.
+func (s *TusService) Delete(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	session, err := tusSession(c, dep, s.ID)
+	if err != nil {
+		return err
+	}
+
+	unlock, ok := tusLocks.tryLock(s.ID)
+	if !ok {
+		return ErrTusUploadLocked
+	}
+	defer unlock()
+
+	m := manager.NewFileManager(dep, user)
+	defer m.Recycle()
+
+	if err := m.CancelUploadSession(c, session.Props.Uri, s.ID); err != nil {
+		return err
+	}
+
+	cleanupTusUpload(c, dep, s.ID)
+	return nil
+}
+
+// tusWrite writes request body of a tus upload at given offset, and completes the upload once all
+// data is received. Returns the new offset.
+func tusWrite(c *gin.Context, dep dependency.Dep, m manager.FileManager, session *fs.UploadSession, offset int64) (int64, error) {
+	if _, err := m.ConfirmUploadSession(c, session, 0); err != nil {
+		return offset, err
+	}
+
+	var (
+		checksum []byte
+		h        hash.Hash
+	)
+	if header := c.GetHeader("Upload-Checksum"); header != "" {
+		var err error
+		h, checksum, err = parseTusChecksum(header)
+		if err != nil {
+			return offset, err
+		}
+	}
+
+	src := &tusCountingReader{r: io.LimitReader(c.Request.Body, session.Props.Size-offset)}
+	var body io.Reader = src
+	if h != nil {
+		body = io.TeeReader(src, h)
+	}
+
+	ctx := context.WithValue(c, cluster.SlaveNodeIDCtx{}, strconv.Itoa(session.Policy.NodeID))
+	var writeErr error
+	if session.Policy.Type == types.PolicyTypeLocal {
+		writeErr = m.Upload(ctx, &fs.UploadRequest{
+			File:   io.NopCloser(body),
+			Offset: offset,
+			Props:  session.Props.Copy(),
+			Mode:   fs.ModeOverwrite,
+		}, session.Policy, session)
+	} else {
+		writeErr = writeTusStaging(tusStagingPath(c, dep, session.Props.UploadSessionID), body, offset)
+	}
+
+	if h != nil {
+		// Data not matching checksum is discarded, following request will overwrite it.
+		if writeErr == nil && string(h.Sum(nil)) != string(checksum) {
+			writeErr = ErrTusChecksumMismatch
+		}
+		if writeErr != nil {
+			return offset, writeErr
+		}
+	}
+
+	// Keep received data even if connection is interrupted, so that client can resume from it.
+	newOffset := offset + src.n
+	ttl := max(1, int(time.Until(session.Props.ExpireAt).Seconds()))
+	if err := dep.KV().Set(tusOffsetCachePrefix+session.Props.UploadSessionID, newOffset, ttl); err != nil {
+		return offset, fmt.Errorf("failed to save upload offset: %w", err)
+	}
+
+	if writeErr != nil {
+		return newOffset, writeErr
+	}
+
+	if newOffset < session.Props.Size {
+		return newOffset, nil
+	}
+
+	if session.Policy.Type != types.PolicyTypeLocal {
+		if err := relayTusStaging(ctx, m, session, tusStagingPath(c, dep, session.Props.UploadSessionID)); err != nil {
+			return newOffset, err
+		}
+	}
+
+	if _, err := m.CompleteUpload(ctx, session); err != nil {
+		return newOffset, fmt.Errorf("failed to complete upload: %w", err)
+	}
+
+	cleanupTusUpload(c, dep, session.Props.UploadSessionID)
+	return newOffset, nil
+}
+
+// relayTusStaging uploads staged file to storage.
+func relayTusStaging(ctx context.Context, m manager.FileManager, session *fs.UploadSession, staging string) error {
+	f, err := os.Open(staging)
+	if err != nil {
+		return serializer.NewError(serializer.CodeIOFailed, "Failed to open staged file", err)
+	}
+	defer f.Close()
+
+	return m.Upload(ctx, &fs.UploadRequest{
+		File:   f,
+		Seeker: f,
+		Props:  session.Props.Copy(),
+		Mode:   fs.ModeOverwrite,
+	}, session.Policy, session)
+}
+
+func writeTusStaging(staging string, body io.Reader, offset int64) error {
+	if err := os.MkdirAll(filepath.Dir(staging), 0700); err != nil {
+		return serializer.NewError(serializer.CodeIOFailed, "Failed to create staging folder", err)
+	}
+
+	f, err := os.OpenFile(staging, os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return serializer.NewError(serializer.CodeIOFailed, "Failed to open staged file", err)
+	}
+	defer f.Close()
+
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		return serializer.NewError(serializer.CodeIOFailed, "Failed to seek staged file", err)
+	}
+
+	if _, err := io.Copy(f, body); err != nil {
+		return serializer.NewError(serializer.CodeIOFailed, "Failed to write staged file", err)
+	}
+
+	return nil
+}
+
+func tusSession(c *gin.Context, dep dependency.Dep, id string) (*fs.UploadSession, error) {
+	sessionRaw, ok := dep.KV().Get(manager.UploadSessionCachePrefix + id)
+	if !ok {
+		return nil, serializer.NewError(serializer.CodeUploadSessionExpired, "", nil)
+	}
+
+	session := sessionRaw.(fs.UploadSession)
+	if session.UID != inventory.UserFromContext(c).ID {
+		return nil, serializer.NewError(serializer.CodeUploadSessionExpired, "", nil)
+	}
+
+	return &session, nil
+}
+
+func tusOffset(dep dependency.Dep, id string) int64 {
+	if offset, ok := dep.KV().Get(tusOffsetCachePrefix + id); ok {
+		return offset.(int64)
+	}
+
+	return 0
+}
+
+func tusStagingPath(ctx context.Context, dep dependency.Dep, id string) string {
+	return filepath.Join(util.DataPath(dep.SettingProvider().TempPath(ctx)), tusStagingFolder, id)
+}
+
+func cleanupTusUpload(ctx context.Context, dep dependency.Dep, id string) {
+	_ = dep.KV().Delete(tusOffsetCachePrefix, id)
+	if err := os.Remove(tusStagingPath(ctx, dep, id)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		dep.Logger().Warning("Failed to remove staged tus upload %q: %s", id, err)
+	}
+}
+
+// sweepTusStaging removes staged files of abandoned uploads whose session is already expired.
+func sweepTusStaging(ctx context.Context, dep dependency.Dep) {
+	folder := filepath.Join(util.DataPath(dep.SettingProvider().TempPath(ctx)), tusStagingFolder)
+	entries, err := os.ReadDir(folder)
+	if err != nil {
+		return
+	}
+
+	ttl := dep.SettingProvider().UploadSessionTTL(ctx)
+	for _, entry := range entries {
+		info, err := entry.Info()
+		if err != nil || time.Since(info.ModTime()) < ttl {
+			continue
+		}
+
+		_ = os.Remove(filepath.Join(folder, entry.Name()))
+	}
+}
+
+// parseTusMetadata parses Upload-Metadata header, which consists of comma separated key value
+// pairs, values are base64 encoded.
+func parseTusMetadata(header string) (map[string]string, error) {
+	res := make(map[string]string)
+	for _, pair := range strings.Split(header, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		key, encoded, _ := strings.Cut(pair, " ")
+		value, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+		if err != nil {
+			return nil, fmt.Errorf("invalid value of %q: %w", key, err)
+		}
+
+		res[key] = string(value)
+	}
+
+	return res, nil
+}
+
+// parseTusChecksum parses Upload-Checksum header in "<algorithm> <base64 checksum>" format.
+func parseTusChecksum(header string) (hash.Hash, []byte, error) {
+	algorithm, encoded, _ := strings.Cut(header, " ")
+	checksum, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, nil, serializer.NewError(serializer.CodeParamErr, "Invalid Upload-Checksum", err)
+	}
+
+	switch strings.ToLower(algorithm) {
+	case "md5":
+		return md5.New(), checksum, nil
+	case "sha1":
+		return sha1.New(), checksum, nil
+	case "sha256":
+		return sha256.New(), checksum, nil
+	default:
+		return nil, nil, serializer.NewError(serializer.CodeParamErr, fmt.Sprintf("Unsupported checksum algorithm %q", algorithm), nil)
+	}
+}
+
+type (
+	tusLockMap struct {
+		mu    sync.Mutex
+		locks map[string]*tusLock
+	}
+	tusLock struct {
+		sync.Mutex
+		refs int
+	}
+)
+
+// tryLock acquires lock of given upload without blocking, returns a function to release it.
+func (l *tusLockMap) tryLock(id string) (func(), bool) {
+	l.mu.Lock()
+	lock, ok := l.locks[id]
+	if !ok {
+		lock = &tusLock{}
+		l.locks[id] = lock
+	}
+	lock.refs++
+	l.mu.Unlock()
+
+	release := func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		lock.refs--
+		if lock.refs == 0 {
+			delete(l.locks, id)
+		}
+	}
+
+	if !lock.TryLock() {
+		release()
+		return nil, false
+	}
+
+	return func() {
+		lock.Unlock()
+		release()
+	}, true
+}
+
+type tusCountingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (r *tusCountingReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.n += int64(n)
+	return n, err
+}
@@ -175,6 +175,7 @@ type (
 		Encoding string   `json:"encoding"`
 		Password string   `json:"password"`
 		FileMask []string `json:"file_mask"`
+		Format   string   `json:"format"`
 	}
 	CreateArchiveParamCtx struct{}
 )
@@ -234,6 +235,18 @@ func (service *ArchiveWorkflowService) CreateCompressTask(c *gin.Context) (*Task
 		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid destination", err)
 	}
 
+	format := manager.ArchiveFormat(service.Format)
+	if format == "" {
+		format = manager.ArchiveFormatFromName(dst.Name())
+	}
+	if !format.Valid() {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Unsupported archive format", nil)
+	}
+
+	if service.Password != "" && !format.SupportEncryption() {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Archive format does not support password", nil)
+	}
+
 	// Create a placeholder file then delete it to validate the destination
 	session, err := m.PrepareUpload(c, &fs.UploadRequest{
 		Props: &fs.UploadProps{
@@ -249,7 +262,7 @@ func (service *ArchiveWorkflowService) CreateCompressTask(c *gin.Context) (*Task
 	m.OnUploadFailed(c, session)
 
 	// Create task
-	t, err := workflows.NewCreateArchiveTask(c, service.Src, service.Dst)
+	t, err := workflows.NewCreateArchiveTask(c, service.Src, service.Dst, format, service.Password)
 	if err != nil {
 		return nil, serializer.NewError(serializer.CodeCreateTaskError, "Failed to create task", err)
 	}
@@ -333,7 +346,7 @@ func (service *ListTaskService) ListTasks(c *gin.Context) (*TaskListResponse, er
 			PageToken:           service.NextPageToken,
 			PageSize:            service.PageSize,
 		},
-		Types:  []string{queue.CreateArchiveTaskType, queue.ExtractArchiveTaskType, queue.RelocateTaskType, queue.ImportTaskType},
+		Types:  []string{queue.CreateArchiveTaskType, queue.ExtractArchiveTaskType, queue.RelocateTaskType, queue.ImportTaskType, queue.SnapshotRestoreTaskType, queue.DuplicateFinderTaskType, queue.MediaRegenerateTaskType},
 		UserID: user.ID,
 	}
 
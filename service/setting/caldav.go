@@ -0,0 +1,60 @@
+package setting
+
+import (
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/caldav"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/gin-gonic/gin"
+)
+
+type (
+	ListCalDAVCollectionsService struct {
+		Type string `form:"type" binding:"required,eq=calendar|eq=addressbook"`
+	}
+	ListCalDAVCollectionsParamCtx struct{}
+)
+
+// List lists calendars or address books owned by or shared with current user.
+func (service *ListCalDAVCollectionsService) List(c *gin.Context) ([]caldav.Collection, error) {
+	u := inventory.UserFromContext(c)
+	if !u.Edges.Group.Permissions.Enabled(int(types.GroupPermissionWebDAV)) {
+		return nil, serializer.NewError(serializer.CodeGroupNotAllowed, "WebDAV is not enabled for this user group", nil)
+	}
+
+	res, err := caldav.ListCollections(c, u, caldav.Kind(service.Type))
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to list collections", err)
+	}
+
+	return res, nil
+}
+
+type (
+	ShareCalDAVCollectionService struct {
+		Type       string `json:"type" binding:"required,eq=calendar|eq=addressbook"`
+		Name       string `json:"name" binding:"required,min=1,max=255"`
+		Email      string `json:"email" binding:"required,email"`
+		Permission string `json:"permission" binding:"omitempty,eq=read|eq=write"`
+	}
+	ShareCalDAVCollectionParamCtx struct{}
+)
+
+// Share shares a calendar or address book of current user with another user, empty permission revokes
+// the share.
+func (service *ShareCalDAVCollectionService) Share(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	u := inventory.UserFromContext(c)
+	if !u.Edges.Group.Permissions.Enabled(int(types.GroupPermissionWebDAV)) {
+		return serializer.NewError(serializer.CodeGroupNotAllowed, "WebDAV is not enabled for this user group", nil)
+	}
+
+	target, err := dep.UserClient().GetByEmail(c, service.Email)
+	if err != nil || target.Status != user.StatusActive {
+		return serializer.NewError(serializer.CodeUserNotFound, "User not found", err)
+	}
+
+	return caldav.ShareCollection(c, u, caldav.Kind(service.Type), service.Name, target, caldav.Permission(service.Permission))
+}
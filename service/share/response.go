@@ -23,7 +23,9 @@ func BuildListShareResponse(res *inventory.ListShareResult, hasher hashid.Encode
 	for _, share := range res.Shares {
 		expired := inventory.IsValidShare(share) != nil
 		shareName := share.Edges.File.Name
-		if share.Edges.File.FileChildren == 0 && len(share.Edges.File.Edges.Metadata) >= 0 {
+		if share.Props != nil && share.Props.AlbumID > 0 {
+			shareName = share.Props.AlbumName
+		} else if share.Edges.File.FileChildren == 0 && len(share.Edges.File.Edges.Metadata) >= 0 {
 			// For files in trash bin, read the real name from metadata
 			restoreUri, found := lo.Find(share.Edges.File.Edges.Metadata, func(m *ent.Metadata) bool {
 				return m.Name == dbfs.MetadataRestoreUri
@@ -86,8 +86,13 @@ func (s *ShareInfoService) Get(c *gin.Context) (*explorer.Share, error) {
 		unlocked = false
 	}
 
+	shareName := share.Edges.File.Name
+	if share.Props != nil && share.Props.AlbumID > 0 {
+		shareName = share.Props.AlbumName
+	}
+
 	base := dep.SettingProvider().SiteURL(c)
-	res := explorer.BuildShare(share, base, dep.HashIDEncoder(), u, share.Edges.User, share.Edges.File.Name,
+	res := explorer.BuildShare(share, base, dep.HashIDEncoder(), u, share.Edges.User, shareName,
 		types.FileType(share.Edges.File.Type), unlocked, false)
 
 	if s.OwnerExtended && share.Edges.User.ID == u.ID {
@@ -106,6 +111,16 @@ func (s *ShareInfoService) Get(c *gin.Context) (*explorer.Share, error) {
 		}
 
 		res.SourceUri = root.Uri(true).String()
+
+		// Album shares point to the album in photos file system instead of the shared root folder.
+		if share.Props != nil && share.Props.AlbumID > 0 {
+			album, err := dep.AlbumClient().GetByID(c, share.Props.AlbumID)
+			if err != nil {
+				return nil, serializer.NewError(serializer.CodeNotFound, "Album not found", err)
+			}
+
+			res.SourceUri = fs.NewAlbumUri(album.Name)
+		}
 	}
 
 	return res, nil
@@ -0,0 +1,445 @@
+package subsonic
+
+import (
+	"math/rand"
+	"path"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/subsonic"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+const (
+	albumListMaxSize = 500
+	songTypeMusic    = "music"
+	indexOthers      = "#"
+)
+
+// Ping checks connectivity of server and validity of credential
+func Ping(c *gin.Context) (*subsonic.Response, error) {
+	return subsonic.NewResponse(), nil
+}
+
+// GetLicense returns license of server, which is always valid
+func GetLicense(c *gin.Context) (*subsonic.Response, error) {
+	res := subsonic.NewResponse()
+	res.License = &subsonic.License{Valid: true}
+	return res, nil
+}
+
+// GetOpenSubsonicExtensions lists OpenSubsonic extensions supported
+func GetOpenSubsonicExtensions(c *gin.Context) (*subsonic.Response, error) {
+	res := subsonic.NewResponse()
+	res.OpenSubsonicExtensions = []subsonic.OpenSubsonicExtension{
+		{Name: "formPost", Versions: []int{1}},
+	}
+	return res, nil
+}
+
+// GetMusicFolders lists music folders, all audio files of user are in the same folder
+func GetMusicFolders(c *gin.Context) (*subsonic.Response, error) {
+	res := subsonic.NewResponse()
+	res.MusicFolders = &subsonic.MusicFolders{
+		Folders: []subsonic.MusicFolder{{ID: subsonic.MusicFolderID, Name: subsonic.MusicFolderName}},
+	}
+	return res, nil
+}
+
+// GetArtists lists all album artists indexed by their initials
+func GetArtists(c *gin.Context) (*subsonic.Response, error) {
+	library, err := musicLibrary(c)
+	if err != nil {
+		return nil, err
+	}
+
+	res := subsonic.NewResponse()
+	res.Artists = &subsonic.Artists{Index: buildIndexes(c, library)}
+	return res, nil
+}
+
+// GetIndexes lists all album artists indexed by their initials, for clients browsing by folders
+func GetIndexes(c *gin.Context) (*subsonic.Response, error) {
+	library, err := musicLibrary(c)
+	if err != nil {
+		return nil, err
+	}
+
+	res := subsonic.NewResponse()
+	res.Indexes = &subsonic.Indexes{
+		LastModified: library.IndexedAt.UnixMilli(),
+		Index:        buildIndexes(c, library),
+	}
+	return res, nil
+}
+
+type (
+	IDParamCtx struct{}
+	IDService  struct {
+		ID string `form:"id" binding:"required"`
+	}
+)
+
+// GetArtist returns an artist with its albums
+func (s *IDService) GetArtist(c *gin.Context) (*subsonic.Response, error) {
+	library, err := musicLibrary(c)
+	if err != nil {
+		return nil, err
+	}
+
+	artist, ok := library.Artist(s.ID)
+	if !ok {
+		return nil, serializer.NewError(serializer.CodeNotFound, "Artist not found", nil)
+	}
+
+	res := subsonic.NewResponse()
+	res.Artist = &subsonic.ArtistWithAlbums{
+		Artist: buildArtist(c, library, artist),
+		Albums: lo.Map(library.ArtistAlbums(artist), func(album *manager.MusicAlbum, index int) subsonic.Album {
+			return buildAlbum(c, album)
+		}),
+	}
+	return res, nil
+}
+
+// GetAlbum returns an album with its songs
+func (s *IDService) GetAlbum(c *gin.Context) (*subsonic.Response, error) {
+	library, err := musicLibrary(c)
+	if err != nil {
+		return nil, err
+	}
+
+	album, ok := library.Album(s.ID)
+	if !ok {
+		return nil, serializer.NewError(serializer.CodeNotFound, "Album not found", nil)
+	}
+
+	res := subsonic.NewResponse()
+	res.Album = &subsonic.AlbumWithSongs{
+		Album: buildAlbum(c, album),
+		Songs: buildSongs(c, library.AlbumTracks(album)),
+	}
+	return res, nil
+}
+
+// GetSong returns a song
+func (s *IDService) GetSong(c *gin.Context) (*subsonic.Response, error) {
+	library, err := musicLibrary(c)
+	if err != nil {
+		return nil, err
+	}
+
+	track, err := trackFromID(c, library, s.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := subsonic.NewResponse()
+	song := buildSong(c, track)
+	res.Song = &song
+	return res, nil
+}
+
+type (
+	AlbumListParamCtx struct{}
+	AlbumListService  struct {
+		Type     string `form:"type" binding:"required"`
+		Size     int    `form:"size,default=10" binding:"min=0"`
+		Offset   int    `form:"offset" binding:"min=0"`
+		FromYear int    `form:"fromYear"`
+		ToYear   int    `form:"toYear"`
+		Genre    string `form:"genre"`
+	}
+)
+
+// GetAlbumList2 lists albums in given order, albums are grouped by tags
+func (s *AlbumListService) GetAlbumList2(c *gin.Context) (*subsonic.Response, error) {
+	library, err := musicLibrary(c)
+	if err != nil {
+		return nil, err
+	}
+
+	albums := make([]*manager.MusicAlbum, len(library.Albums))
+	copy(albums, library.Albums)
+	switch s.Type {
+	case "random":
+		rand.Shuffle(len(albums), func(i, j int) {
+			albums[i], albums[j] = albums[j], albums[i]
+		})
+	case "newest":
+		sortAlbums(albums, func(a, b *manager.MusicAlbum) bool {
+			return a.CreatedAt.After(b.CreatedAt)
+		})
+	case "alphabeticalByName":
+		sortAlbums(albums, func(a, b *manager.MusicAlbum) bool {
+			return strings.ToLower(a.Name) < strings.ToLower(b.Name)
+		})
+	case "alphabeticalByArtist":
+		// Albums in library are already sorted by artist.
+	case "byYear":
+		from, to := s.FromYear, s.ToYear
+		albums = lo.Filter(albums, func(album *manager.MusicAlbum, index int) bool {
+			return album.Year >= min(from, to) && album.Year <= max(from, to)
+		})
+		sortAlbums(albums, func(a, b *manager.MusicAlbum) bool {
+			if from > to {
+				return a.Year > b.Year
+			}
+			return a.Year < b.Year
+		})
+	case "byGenre":
+		albums = lo.Filter(albums, func(album *manager.MusicAlbum, index int) bool {
+			return strings.EqualFold(album.Genre, s.Genre)
+		})
+	case "frequent", "recent", "highest", "starred":
+		// Plays, ratings and stars are not tracked.
+		albums = nil
+	default:
+		return nil, serializer.NewError(serializer.CodeParamErr, "Unknown album list type", nil)
+	}
+
+	res := subsonic.NewResponse()
+	res.AlbumList2 = &subsonic.AlbumList{
+		Albums: lo.Map(paginate(albums, s.Offset, min(s.Size, albumListMaxSize)), func(album *manager.MusicAlbum, index int) subsonic.Album {
+			return buildAlbum(c, album)
+		}),
+	}
+	return res, nil
+}
+
+type (
+	RandomSongsParamCtx struct{}
+	RandomSongsService  struct {
+		Size     int    `form:"size,default=10" binding:"min=0"`
+		Genre    string `form:"genre"`
+		FromYear int    `form:"fromYear"`
+		ToYear   int    `form:"toYear"`
+	}
+)
+
+// GetRandomSongs returns random songs matching given criteria
+func (s *RandomSongsService) GetRandomSongs(c *gin.Context) (*subsonic.Response, error) {
+	library, err := musicLibrary(c)
+	if err != nil {
+		return nil, err
+	}
+
+	tracks := lo.Filter(library.Tracks, func(track *manager.MusicTrack, index int) bool {
+		return (s.Genre == "" || strings.EqualFold(track.Genre, s.Genre)) &&
+			(s.FromYear == 0 || track.Year >= s.FromYear) &&
+			(s.ToYear == 0 || track.Year <= s.ToYear)
+	})
+	rand.Shuffle(len(tracks), func(i, j int) {
+		tracks[i], tracks[j] = tracks[j], tracks[i]
+	})
+
+	res := subsonic.NewResponse()
+	res.RandomSongs = &subsonic.Songs{Songs: buildSongs(c, paginate(tracks, 0, min(s.Size, albumListMaxSize)))}
+	return res, nil
+}
+
+type (
+	SearchParamCtx struct{}
+	SearchService  struct {
+		Query        string `form:"query"`
+		ArtistCount  int    `form:"artistCount,default=20" binding:"min=0"`
+		ArtistOffset int    `form:"artistOffset" binding:"min=0"`
+		AlbumCount   int    `form:"albumCount,default=20" binding:"min=0"`
+		AlbumOffset  int    `form:"albumOffset" binding:"min=0"`
+		SongCount    int    `form:"songCount,default=20" binding:"min=0"`
+		SongOffset   int    `form:"songOffset" binding:"min=0"`
+	}
+)
+
+// Search3 searches artists, albums and songs by name. Empty query matches everything, which is
+// used by clients to sync the whole library.
+func (s *SearchService) Search3(c *gin.Context) (*subsonic.Response, error) {
+	library, err := musicLibrary(c)
+	if err != nil {
+		return nil, err
+	}
+
+	keyword := strings.ToLower(strings.Trim(s.Query, `"`))
+	match := func(values ...string) bool {
+		return keyword == "" || lo.SomeBy(values, func(v string) bool {
+			return strings.Contains(strings.ToLower(v), keyword)
+		})
+	}
+
+	artists := lo.Filter(library.Artists, func(artist *manager.MusicArtist, index int) bool {
+		return match(artist.Name)
+	})
+	albums := lo.Filter(library.Albums, func(album *manager.MusicAlbum, index int) bool {
+		return match(album.Name, album.Artist)
+	})
+	tracks := lo.Filter(library.Tracks, func(track *manager.MusicTrack, index int) bool {
+		return match(track.Title, track.Artist, track.Album, track.Name)
+	})
+
+	res := subsonic.NewResponse()
+	res.SearchResult3 = &subsonic.SearchResult{
+		Artists: lo.Map(paginate(artists, s.ArtistOffset, s.ArtistCount), func(artist *manager.MusicArtist, index int) subsonic.Artist {
+			return buildArtist(c, library, artist)
+		}),
+		Albums: lo.Map(paginate(albums, s.AlbumOffset, s.AlbumCount), func(album *manager.MusicAlbum, index int) subsonic.Album {
+			return buildAlbum(c, album)
+		}),
+		Songs: buildSongs(c, paginate(tracks, s.SongOffset, s.SongCount)),
+	}
+	return res, nil
+}
+
+// musicLibrary returns music library of current user.
+func musicLibrary(c *gin.Context) (*manager.MusicLibrary, error) {
+	dep := dependency.FromContext(c)
+	m := manager.NewFileManager(dep, inventory.UserFromContext(c))
+	defer m.Recycle()
+
+	return m.MusicLibrary(c)
+}
+
+// trackFromID returns the track of given song ID.
+func trackFromID(c *gin.Context, library *manager.MusicLibrary, id string) (*manager.MusicTrack, error) {
+	fileID, err := dependency.FromContext(c).HashIDEncoder().Decode(id, hashid.FileID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeNotFound, "Song not found", err)
+	}
+
+	track, ok := library.Track(fileID)
+	if !ok {
+		return nil, serializer.NewError(serializer.CodeNotFound, "Song not found", nil)
+	}
+
+	return track, nil
+}
+
+func buildIndexes(c *gin.Context, library *manager.MusicLibrary) []subsonic.Index {
+	indexes := make([]subsonic.Index, 0)
+	for _, artist := range library.Artists {
+		name := indexName(artist.Name)
+		if len(indexes) == 0 || indexes[len(indexes)-1].Name != name {
+			indexes = append(indexes, subsonic.Index{Name: name})
+		}
+
+		current := &indexes[len(indexes)-1]
+		current.Artists = append(current.Artists, buildArtist(c, library, artist))
+	}
+
+	// Artists not starting with a letter are listed at last.
+	others, found := lo.Find(indexes, func(index subsonic.Index) bool {
+		return index.Name == indexOthers
+	})
+	if found {
+		indexes = append(lo.Filter(indexes, func(index subsonic.Index, i int) bool {
+			return index.Name != indexOthers
+		}), others)
+	}
+
+	return indexes
+}
+
+// indexName returns the index of given artist name, which is its uppercase initial letter.
+func indexName(name string) string {
+	for _, r := range name {
+		if unicode.IsLetter(r) {
+			return string(unicode.ToUpper(r))
+		}
+
+		break
+	}
+
+	return indexOthers
+}
+
+func buildArtist(c *gin.Context, library *manager.MusicLibrary, artist *manager.MusicArtist) subsonic.Artist {
+	res := subsonic.Artist{
+		ID:         artist.ID,
+		Name:       artist.Name,
+		AlbumCount: len(artist.AlbumIDs),
+	}
+
+	if albums := library.ArtistAlbums(artist); len(albums) > 0 {
+		res.CoverArt = albumCoverArt(c, albums[0])
+	}
+
+	return res
+}
+
+func buildAlbum(c *gin.Context, album *manager.MusicAlbum) subsonic.Album {
+	return subsonic.Album{
+		ID:        album.ID,
+		Name:      album.Name,
+		Artist:    album.Artist,
+		ArtistID:  album.ArtistID,
+		CoverArt:  albumCoverArt(c, album),
+		SongCount: len(album.TrackIDs),
+		Created:   album.CreatedAt,
+		Year:      album.Year,
+		Genre:     album.Genre,
+	}
+}
+
+func buildSongs(c *gin.Context, tracks []*manager.MusicTrack) []subsonic.Child {
+	return lo.Map(tracks, func(track *manager.MusicTrack, index int) subsonic.Child {
+		return buildSong(c, track)
+	})
+}
+
+func buildSong(c *gin.Context, track *manager.MusicTrack) subsonic.Child {
+	dep := dependency.FromContext(c)
+	id := hashid.EncodeFileID(dep.HashIDEncoder(), track.FileID)
+	res := subsonic.Child{
+		ID:          id,
+		Parent:      track.AlbumID,
+		Title:       track.Title,
+		Album:       track.Album,
+		Artist:      track.Artist,
+		Track:       track.Track,
+		Year:        track.Year,
+		Genre:       track.Genre,
+		CoverArt:    id,
+		Size:        track.Size,
+		ContentType: dep.MimeDetector(c).TypeByName(track.Name),
+		Suffix:      strings.TrimPrefix(strings.ToLower(path.Ext(track.Name)), "."),
+		DiscNumber:  track.Disc,
+		Created:     track.CreatedAt,
+		AlbumID:     track.AlbumID,
+		ArtistID:    track.ArtistID,
+		Type:        songTypeMusic,
+	}
+
+	if uri, err := fs.NewUriFromString(track.Uri); err == nil {
+		res.Path = uri.PathTrimmed()
+	}
+
+	return res
+}
+
+// albumCoverArt returns cover art ID of given album, which is the ID of its first song.
+func albumCoverArt(c *gin.Context, album *manager.MusicAlbum) string {
+	if len(album.TrackIDs) == 0 {
+		return ""
+	}
+
+	return hashid.EncodeFileID(dependency.FromContext(c).HashIDEncoder(), album.TrackIDs[0])
+}
+
+func sortAlbums(albums []*manager.MusicAlbum, less func(a, b *manager.MusicAlbum) bool) {
+	sort.SliceStable(albums, func(i, j int) bool {
+		return less(albums[i], albums[j])
+	})
+}
+
+func paginate[T any](items []T, offset, size int) []T {
+	start := min(offset, len(items))
+	end := min(start+size, len(items))
+	return items[start:end]
+}
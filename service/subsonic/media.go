@@ -0,0 +1,142 @@
+package subsonic
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager/entitysource"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/gin-gonic/gin"
+)
+
+// Stream serves content of a song. Transcoding is not supported, original file is always served.
+func (s *IDService) Stream(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	m := manager.NewFileManager(dep, user)
+	defer m.Recycle()
+
+	library, err := m.MusicLibrary(c)
+	if err != nil {
+		return err
+	}
+
+	track, err := trackFromID(c, library, s.ID)
+	if err != nil {
+		return err
+	}
+
+	uri, err := fs.NewUriFromString(track.Uri)
+	if err != nil {
+		return serializer.NewError(serializer.CodeNotFound, "Song not found", err)
+	}
+
+	file, err := m.Get(c, uri)
+	if err != nil {
+		return fmt.Errorf("failed to get song file: %w", err)
+	}
+
+	es, err := m.GetEntitySource(c, file.PrimaryEntityID())
+	if err != nil {
+		return fmt.Errorf("failed to get entity source: %w", err)
+	}
+	defer es.Close()
+
+	es.Apply(entitysource.WithSpeedLimit(int64(user.Edges.Group.SpeedLimit)), entitysource.WithDisplayName(file.DisplayName()))
+	return serveEntity(c, user, es)
+}
+
+type (
+	CoverArtParamCtx struct{}
+	CoverArtService  struct {
+		ID   string `form:"id" binding:"required"`
+		Size int    `form:"size" binding:"min=0"`
+	}
+)
+
+// GetCoverArt serves thumbnail of a song, album or artist. Thumbnail of albums and artists is the one
+// of their first song.
+func (s *CoverArtService) GetCoverArt(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	m := manager.NewFileManager(dep, user)
+	defer m.Recycle()
+
+	library, err := m.MusicLibrary(c)
+	if err != nil {
+		return err
+	}
+
+	id := s.ID
+	if artist, ok := library.Artist(id); ok {
+		if albums := library.ArtistAlbums(artist); len(albums) > 0 {
+			id = albumCoverArt(c, albums[0])
+		}
+	} else if album, ok := library.Album(id); ok {
+		id = albumCoverArt(c, album)
+	}
+
+	track, err := trackFromID(c, library, id)
+	if err != nil {
+		return err
+	}
+
+	uri, err := fs.NewUriFromString(track.Uri)
+	if err != nil {
+		return serializer.NewError(serializer.CodeNotFound, "Song not found", err)
+	}
+
+	if s.Size > 0 {
+		util.WithValue(c, setting.ThumbVariantCtxKey{}, coverArtVariant(c, dep.SettingProvider(), s.Size))
+	}
+
+	thumb, err := m.Thumbnail(c, uri)
+	if err != nil {
+		return serializer.NewError(serializer.CodeNotFound, "Cover art not found", err)
+	}
+	defer thumb.Close()
+
+	return serveEntity(c, user, thumb)
+}
+
+// coverArtVariant picks the smallest thumbnail variant covering requested size.
+func coverArtVariant(c *gin.Context, settings setting.Provider, size int) setting.ThumbVariant {
+	for _, variant := range []setting.ThumbVariant{setting.ThumbVariantSmall, setting.ThumbVariantMedium} {
+		w, h := settings.ThumbSize(setting.WithThumbVariant(c, variant))
+		if size <= max(w, h) {
+			return variant
+		}
+	}
+
+	return setting.ThumbVariantLarge
+}
+
+// serveEntity serves entity content directly if proxy is required, otherwise redirects client to
+// the entity URL.
+func serveEntity(c *gin.Context, user *ent.User, es entitysource.EntitySource) error {
+	if es.ShouldInternalProxy() ||
+		(user.Edges.DavAccounts[0].Options.Enabled(int(types.DavAccountProxy)) &&
+			user.Edges.Group.Permissions.Enabled(int(types.GroupPermissionWebDAVProxy))) {
+		es.Serve(c.Writer, c.Request)
+		return nil
+	}
+
+	settings := dependency.FromContext(c).SettingProvider()
+	expire := time.Now().Add(settings.EntityUrlValidDuration(c))
+	src, err := es.Url(c, entitysource.WithExpire(&expire))
+	if err != nil {
+		return fmt.Errorf("failed to get entity url: %w", err)
+	}
+
+	c.Redirect(http.StatusFound, src.Url)
+	return nil
+}
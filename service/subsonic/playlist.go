@@ -0,0 +1,240 @@
+package subsonic
+
+import (
+	"strings"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/subsonic"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+// GetPlaylists lists all playlists of current user
+func GetPlaylists(c *gin.Context) (*subsonic.Response, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	library, err := musicLibrary(c)
+	if err != nil {
+		return nil, err
+	}
+
+	playlists, err := dep.PlaylistClient().List(c, user.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to list playlists", err)
+	}
+
+	res := subsonic.NewResponse()
+	res.Playlists = &subsonic.Playlists{
+		Playlists: lo.Map(playlists, func(p *ent.Playlist, index int) subsonic.Playlist {
+			return buildPlaylist(c, p, playlistTracks(library, p))
+		}),
+	}
+	return res, nil
+}
+
+// GetPlaylist returns a playlist with its songs
+func (s *IDService) GetPlaylist(c *gin.Context) (*subsonic.Response, error) {
+	library, err := musicLibrary(c)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := playlistFromID(c, s.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return playlistResponse(c, library, p), nil
+}
+
+// DeletePlaylist deletes a playlist
+func (s *IDService) DeletePlaylist(c *gin.Context) (*subsonic.Response, error) {
+	p, err := playlistFromID(c, s.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := dependency.FromContext(c).PlaylistClient().Delete(c, p.ID); err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to delete playlist", err)
+	}
+
+	return subsonic.NewResponse(), nil
+}
+
+type (
+	CreatePlaylistParamCtx struct{}
+	CreatePlaylistService  struct {
+		// PlaylistID of existing playlist to overwrite, new playlist is created if empty.
+		PlaylistID string   `form:"playlistId"`
+		Name       string   `form:"name"`
+		SongIDs    []string `form:"songId"`
+	}
+)
+
+// Create creates a playlist, or replaces songs of an existing one
+func (s *CreatePlaylistService) Create(c *gin.Context) (*subsonic.Response, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	library, err := musicLibrary(c)
+	if err != nil {
+		return nil, err
+	}
+
+	fileIDs, err := songFileIDs(c, library, s.SongIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	args := &inventory.UpsertPlaylistParameters{
+		OwnerID: user.ID,
+		Name:    strings.TrimSpace(s.Name),
+		FileIDs: fileIDs,
+	}
+	if s.PlaylistID != "" {
+		existed, err := playlistFromID(c, s.PlaylistID)
+		if err != nil {
+			return nil, err
+		}
+
+		args.ID, args.Comment = existed.ID, existed.Comment
+		if args.Name == "" {
+			args.Name = existed.Name
+		}
+	}
+
+	if args.Name == "" {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Playlist name is required", nil)
+	}
+
+	p, err := dep.PlaylistClient().Upsert(c, args)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to save playlist", err)
+	}
+
+	return playlistResponse(c, library, p), nil
+}
+
+type (
+	UpdatePlaylistParamCtx struct{}
+	UpdatePlaylistService  struct {
+		PlaylistID string  `form:"playlistId" binding:"required"`
+		Name       *string `form:"name"`
+		Comment    *string `form:"comment"`
+		// SongIDsToAdd are appended to the end of playlist.
+		SongIDsToAdd []string `form:"songIdToAdd"`
+		// SongIndexesToRemove are positions of songs in playlist before update.
+		SongIndexesToRemove []int `form:"songIndexToRemove"`
+	}
+)
+
+// Update renames a playlist, or adds and removes its songs
+func (s *UpdatePlaylistService) Update(c *gin.Context) (*subsonic.Response, error) {
+	dep := dependency.FromContext(c)
+	library, err := musicLibrary(c)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := playlistFromID(c, s.PlaylistID)
+	if err != nil {
+		return nil, err
+	}
+
+	toAdd, err := songFileIDs(c, library, s.SongIDsToAdd)
+	if err != nil {
+		return nil, err
+	}
+
+	// Positions refer to songs listed to client, which skip files no longer in library.
+	removed := lo.SliceToMap(s.SongIndexesToRemove, func(i int) (int, bool) {
+		return i, true
+	})
+	fileIDs := lo.FilterMap(playlistTracks(library, p), func(track *manager.MusicTrack, index int) (int, bool) {
+		return track.FileID, !removed[index]
+	})
+
+	args := &inventory.UpsertPlaylistParameters{
+		ID:      p.ID,
+		OwnerID: p.OwnerID,
+		Name:    p.Name,
+		Comment: p.Comment,
+		FileIDs: append(fileIDs, toAdd...),
+	}
+	if s.Name != nil && strings.TrimSpace(*s.Name) != "" {
+		args.Name = strings.TrimSpace(*s.Name)
+	}
+	if s.Comment != nil {
+		args.Comment = *s.Comment
+	}
+
+	if _, err := dep.PlaylistClient().Upsert(c, args); err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to save playlist", err)
+	}
+
+	return subsonic.NewResponse(), nil
+}
+
+// playlistFromID returns the playlist of current user with given hash ID.
+func playlistFromID(c *gin.Context, id string) (*ent.Playlist, error) {
+	dep := dependency.FromContext(c)
+	playlistID, err := dep.HashIDEncoder().Decode(id, hashid.PlaylistID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeNotFound, "Playlist not found", err)
+	}
+
+	p, err := dep.PlaylistClient().GetByID(c, playlistID)
+	if err != nil || p.OwnerID != inventory.UserFromContext(c).ID {
+		return nil, serializer.NewError(serializer.CodeNotFound, "Playlist not found", err)
+	}
+
+	return p, nil
+}
+
+// songFileIDs converts song IDs into file IDs, all songs must exist in library.
+func songFileIDs(c *gin.Context, library *manager.MusicLibrary, ids []string) ([]int, error) {
+	res := make([]int, 0, len(ids))
+	for _, id := range ids {
+		track, err := trackFromID(c, library, id)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, track.FileID)
+	}
+
+	return res, nil
+}
+
+// playlistTracks returns tracks in playlist, files no longer in library are skipped.
+func playlistTracks(library *manager.MusicLibrary, p *ent.Playlist) []*manager.MusicTrack {
+	return lo.FilterMap(p.FileIds, func(id int, index int) (*manager.MusicTrack, bool) {
+		return library.Track(id)
+	})
+}
+
+func playlistResponse(c *gin.Context, library *manager.MusicLibrary, p *ent.Playlist) *subsonic.Response {
+	tracks := playlistTracks(library, p)
+	res := subsonic.NewResponse()
+	res.Playlist = &subsonic.PlaylistWithSongs{
+		Playlist: buildPlaylist(c, p, tracks),
+		Entries:  buildSongs(c, tracks),
+	}
+	return res
+}
+
+func buildPlaylist(c *gin.Context, p *ent.Playlist, tracks []*manager.MusicTrack) subsonic.Playlist {
+	return subsonic.Playlist{
+		ID:        hashid.EncodePlaylistID(dependency.FromContext(c).HashIDEncoder(), p.ID),
+		Name:      p.Name,
+		Comment:   p.Comment,
+		Owner:     inventory.UserFromContext(c).Email,
+		SongCount: len(tracks),
+		Created:   p.CreatedAt,
+		Changed:   p.UpdatedAt,
+	}
+}
@@ -30,6 +30,38 @@ func GetUserCapacity(c *gin.Context) (*fs.Capacity, error) {
 	return m.Capacity(c)
 }
 
+type (
+	UsageReportService struct {
+		// Refresh queues a full rescan of all files, which also picks up changes not made through
+		// file events.
+		Refresh bool `form:"refresh"`
+	}
+	UsageReportParamCtx struct{}
+)
+
+// Get returns the storage usage report of current user.
+func (s *UsageReportService) Get(c *gin.Context) (*manager.UsageReport, error) {
+	user := inventory.UserFromContext(c)
+	dep := dependency.FromContext(c)
+	m := manager.NewFileManager(dep, user)
+	defer m.Recycle()
+
+	report, err := m.UsageReport(c)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.Refresh && !report.Pending {
+		if err := m.QueueUsageRescan(c); err != nil {
+			return nil, err
+		}
+
+		report.Stale = true
+	}
+
+	return report, nil
+}
+
 type (
 	SearchUserService struct {
 		Keyword string `form:"keyword" binding:"required,min=2"`
@@ -22,23 +22,25 @@ type PreparePasskeyLoginResponse struct {
 }
 
 type UserSettings struct {
-	VersionRetentionEnabled bool      `json:"version_retention_enabled"`
-	VersionRetentionExt     []string  `json:"version_retention_ext,omitempty"`
-	VersionRetentionMax     int       `json:"version_retention_max,omitempty"`
-	Paswordless             bool      `json:"passwordless"`
-	TwoFAEnabled            bool      `json:"two_fa_enabled"`
-	Passkeys                []Passkey `json:"passkeys,omitempty"`
-	DisableViewSync         bool      `json:"disable_view_sync"`
-	ShareLinksInProfile     string    `json:"share_links_in_profile"`
+	VersionRetentionEnabled  bool                            `json:"version_retention_enabled"`
+	VersionRetentionExt      []string                        `json:"version_retention_ext,omitempty"`
+	VersionRetentionMax      int                             `json:"version_retention_max,omitempty"`
+	VersionRetentionSchedule *types.VersionRetentionSchedule `json:"version_retention_schedule,omitempty"`
+	Paswordless              bool                            `json:"passwordless"`
+	TwoFAEnabled             bool                            `json:"two_fa_enabled"`
+	Passkeys                 []Passkey                       `json:"passkeys,omitempty"`
+	DisableViewSync          bool                            `json:"disable_view_sync"`
+	ShareLinksInProfile      string                          `json:"share_links_in_profile"`
 }
 
 func BuildUserSettings(u *ent.User, passkeys []*ent.Passkey, parser *uaparser.Parser) *UserSettings {
 	return &UserSettings{
-		VersionRetentionEnabled: u.Settings.VersionRetention,
-		VersionRetentionExt:     u.Settings.VersionRetentionExt,
-		VersionRetentionMax:     u.Settings.VersionRetentionMax,
-		TwoFAEnabled:            u.TwoFactorSecret != "",
-		Paswordless:             u.Password == "",
+		VersionRetentionEnabled:  u.Settings.VersionRetention,
+		VersionRetentionExt:      u.Settings.VersionRetentionExt,
+		VersionRetentionMax:      u.Settings.VersionRetentionMax,
+		VersionRetentionSchedule: u.Settings.VersionRetentionSchedule,
+		TwoFAEnabled:             u.TwoFactorSecret != "",
+		Paswordless:              u.Password == "",
 		Passkeys: lo.Map(passkeys, func(item *ent.Passkey, index int) Passkey {
 			return BuildPasskey(item)
 		}),
@@ -109,6 +111,7 @@ type User struct {
 	Anonymous           bool                           `json:"anonymous,omitempty"`
 	Group               *Group                         `json:"group,omitempty"`
 	Pined               []types.PinedFile              `json:"pined,omitempty"`
+	SavedSearches       []types.SavedSearch            `json:"saved_searches,omitempty"`
 	Language            string                         `json:"language,omitempty"`
 	DisableViewSync     bool                           `json:"disable_view_sync,omitempty"`
 	ShareLinksInProfile types.ShareLinksInProfileLevel `json:"share_links_in_profile,omitempty"`
@@ -166,6 +169,7 @@ func BuildUser(user *ent.User, idEncoder hashid.Encoder) User {
 		Anonymous:           user.ID == 0,
 		Group:               BuildGroup(user.Edges.Group, idEncoder),
 		Pined:               user.Settings.Pined,
+		SavedSearches:       user.Settings.SavedSearches,
 		Language:            user.Settings.Language,
 		DisableViewSync:     user.Settings.DisableViewSync,
 		ShareLinksInProfile: user.Settings.ShareLinksInProfile,
@@ -211,18 +211,19 @@ func updateAvatarFile(ctx context.Context, u *ent.User, contentType string, file
 
 type (
 	PatchUserSetting struct {
-		Nick                    *string   `json:"nick" binding:"omitempty,min=1,max=255"`
-		Language                *string   `json:"language" binding:"omitempty,min=1,max=255"`
-		PreferredTheme          *string   `json:"preferred_theme" binding:"omitempty,hexcolor|rgb|rgba|hsl"`
-		VersionRetentionEnabled *bool     `json:"version_retention_enabled" binding:"omitempty"`
-		VersionRetentionExt     *[]string `json:"version_retention_ext" binding:"omitempty"`
-		VersionRetentionMax     *int      `json:"version_retention_max" binding:"omitempty,min=0"`
-		CurrentPassword         *string   `json:"current_password" binding:"omitempty,min=4,max=128"`
-		NewPassword             *string   `json:"new_password" binding:"omitempty,min=6,max=128"`
-		TwoFAEnabled            *bool     `json:"two_fa_enabled" binding:"omitempty"`
-		TwoFACode               *string   `json:"two_fa_code" binding:"omitempty"`
-		DisableViewSync         *bool     `json:"disable_view_sync" binding:"omitempty"`
-		ShareLinksInProfile     *string   `json:"share_links_in_profile" binding:"omitempty"`
+		Nick                     *string                         `json:"nick" binding:"omitempty,min=1,max=255"`
+		Language                 *string                         `json:"language" binding:"omitempty,min=1,max=255"`
+		PreferredTheme           *string                         `json:"preferred_theme" binding:"omitempty,hexcolor|rgb|rgba|hsl"`
+		VersionRetentionEnabled  *bool                           `json:"version_retention_enabled" binding:"omitempty"`
+		VersionRetentionExt      *[]string                       `json:"version_retention_ext" binding:"omitempty"`
+		VersionRetentionMax      *int                            `json:"version_retention_max" binding:"omitempty,min=0"`
+		VersionRetentionSchedule *types.VersionRetentionSchedule `json:"version_retention_schedule" binding:"omitempty"`
+		CurrentPassword          *string                         `json:"current_password" binding:"omitempty,min=4,max=128"`
+		NewPassword              *string                         `json:"new_password" binding:"omitempty,min=6,max=128"`
+		TwoFAEnabled             *bool                           `json:"two_fa_enabled" binding:"omitempty"`
+		TwoFACode                *string                         `json:"two_fa_code" binding:"omitempty"`
+		DisableViewSync          *bool                           `json:"disable_view_sync" binding:"omitempty"`
+		ShareLinksInProfile      *string                         `json:"share_links_in_profile" binding:"omitempty"`
 	}
 	PatchUserSettingParamsCtx struct{}
 )
@@ -264,6 +265,14 @@ func (s *PatchUserSetting) Patch(c *gin.Context) error {
 		saveSetting = true
 	}
 
+	if s.VersionRetentionSchedule != nil {
+		u.Settings.VersionRetentionSchedule = s.VersionRetentionSchedule
+		if len(s.VersionRetentionSchedule.Rules) == 0 {
+			u.Settings.VersionRetentionSchedule = nil
+		}
+		saveSetting = true
+	}
+
 	if s.DisableViewSync != nil {
 		u.Settings.DisableViewSync = *s.DisableViewSync
 		saveSetting = true